@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/logging"
+	"go.uber.org/zap"
+)
+
+const pruneAuditsCommand = "prune-audits"
+
+// pruneAudits prunes audits once and writes a per table report to w.
+func pruneAudits(application app.Service, w io.Writer) error {
+	pruned, err := application.AuditsPrune(context.Background())
+	if err != nil {
+		return err
+	}
+	tables := make([]string, 0, len(pruned))
+	for table := range pruned {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	var total int64
+	for _, table := range tables {
+		fmt.Fprintf(w, "%s: %d revisions pruned\n", table, pruned[table])
+		total += pruned[table]
+	}
+	fmt.Fprintf(w, "total: %d revisions pruned\n", total)
+	return nil
+}
+
+// runAuditsPruning prunes audits every interval for as long as the process
+// runs.
+func runAuditsPruning(
+	application app.Service,
+	interval time.Duration,
+	logger *zap.Logger,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := jobContext(logger, "audits_pruning")
+		pruned, err := application.AuditsPrune(ctx)
+		if err != nil {
+			logging.FromContext(ctx).Error(
+				"audits pruning failed",
+				zap.Error(err),
+			)
+			continue
+		}
+		fields := make([]zap.Field, 0, len(pruned))
+		for table, count := range pruned {
+			fields = append(fields, zap.Int64(table, count))
+		}
+		logging.FromContext(ctx).Info("audits pruned", fields...)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/logging"
+	"go.uber.org/zap"
+)
+
+// runIdempotencyKeysPruning deletes the expired idempotency keys every
+// interval for as long as the process runs.
+func runIdempotencyKeysPruning(
+	application app.Service,
+	interval time.Duration,
+	logger *zap.Logger,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := jobContext(logger, "idempotency_keys_pruning")
+		pruned, err := application.IdempotencyKeysPrune(ctx)
+		if err != nil {
+			logging.FromContext(ctx).Error(
+				"idempotency keys pruning failed",
+				zap.Error(err),
+			)
+			continue
+		}
+		logging.FromContext(ctx).Info(
+			"idempotency keys pruned",
+			zap.Int("count", pruned),
+		)
+	}
+}
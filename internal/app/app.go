@@ -2,12 +2,16 @@ package app
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/aria3ppp/watch-server/internal/dto"
 	"github.com/aria3ppp/watch-server/internal/hasher"
 	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/notifier"
 	"github.com/aria3ppp/watch-server/internal/repo"
 	"github.com/aria3ppp/watch-server/internal/search"
+	"github.com/aria3ppp/watch-server/internal/storage"
 	"github.com/aria3ppp/watch-server/internal/token"
 )
 
@@ -31,6 +35,28 @@ type Service interface {
 		id int,
 		req *dto.UserDeleteRequest,
 	) error
+	UserAuthorize(
+		ctx context.Context,
+		userID int,
+		pendingDeletionAllowed bool,
+	) error
+	UserRestore(ctx context.Context, userID int) error
+	UsersAnonymize(ctx context.Context) (int, error)
+	IdempotencyKeyBegin(
+		ctx context.Context,
+		userID int,
+		key string,
+		requestHash string,
+	) (*models.IdempotencyKey, error)
+	IdempotencyKeyEnd(
+		ctx context.Context,
+		userID int,
+		key string,
+		responseStatus int,
+		responseBody string,
+	) error
+	IdempotencyKeyRelease(ctx context.Context, userID int, key string) error
+	IdempotencyKeysPrune(ctx context.Context) (int, error)
 	UserEmailUpdate(
 		ctx context.Context,
 		userID int,
@@ -49,13 +75,126 @@ type Service interface {
 		ctx context.Context,
 		refreshToken string,
 	) (accessToken string, err error)
+	UserAuditsGetAll(
+		ctx context.Context,
+		userID int,
+		offset, limit int,
+	) (audits []*models.UsersAudit, total int, err error)
+	UserAuditsGetPage(
+		ctx context.Context,
+		userID int,
+		cursor string,
+		limit int,
+	) (audits []*models.UsersAudit, prevCursor, nextCursor string, err error)
+
+	// User following
+	UserFollow(ctx context.Context, followerID int, followedID int) error
+	UserUnfollow(ctx context.Context, followerID int, followedID int) error
+	UserFollowersGetAll(
+		ctx context.Context,
+		userID int,
+		offset, limit int,
+	) (followers []*models.User, total int, err error)
+	UserFollowingsGetAll(
+		ctx context.Context,
+		userID int,
+		offset, limit int,
+	) (followings []*models.User, total int, err error)
+
+	// Feed
+	FeedGet(
+		ctx context.Context,
+		userID int,
+		cursor string,
+		limit int,
+	) (activities []*repo.FeedActivity, nextCursor string, err error)
+
+	// Contribution
+	ContributionsGetAll(
+		ctx context.Context,
+		userID int,
+		offset, limit int,
+	) (contributions []*repo.Contribution, total int, err error)
+	ContributorsGetAll(
+		ctx context.Context,
+		since, until time.Time,
+		offset, limit int,
+	) (contributors []*repo.Contributor, total int, err error)
+
+	// Proposal
+	ProposalGet(
+		ctx context.Context,
+		id int,
+		userID int,
+	) (*models.Proposal, error)
+	ProposalsGetAll(
+		ctx context.Context,
+		moderatorID int,
+		status string,
+		offset, limit int,
+	) (proposals []*models.Proposal, total int, err error)
+	ProposalApprove(ctx context.Context, id int, moderatorID int) error
+	ProposalReject(ctx context.Context, id int, moderatorID int) error
+
+	// Post
+	PostGet(ctx context.Context, id int) (*Post, error)
+	PostsGetAllByMovie(
+		ctx context.Context,
+		movieID int,
+		offset, limit int,
+	) (posts []*Post, total int, err error)
+	PostsGetAllBySeries(
+		ctx context.Context,
+		seriesID int,
+		offset, limit int,
+	) (posts []*Post, total int, err error)
+	PostRepliesGetAll(
+		ctx context.Context,
+		id int,
+		offset, limit int,
+	) (replies []*Post, total int, err error)
+	PostCreate(
+		ctx context.Context,
+		userID int,
+		req *dto.PostCreateRequest,
+	) (postID int, err error)
+	PostUpdate(
+		ctx context.Context,
+		id int,
+		userID int,
+		req *dto.PostUpdateRequest,
+	) error
+	PostDelete(ctx context.Context, id int, userID int) error
+	PostRevisionsGetAll(
+		ctx context.Context,
+		id int,
+		offset, limit int,
+	) (revisions []*models.PostRevision, total int, err error)
+
+	// Audit
+	AuditsPrune(ctx context.Context) (pruned map[string]int64, err error)
 
 	// Movie
-	MovieGet(ctx context.Context, id int) (*models.Film, error)
+	MovieGet(
+		ctx context.Context,
+		id int,
+		includeInvalidated bool,
+	) (*models.Film, error)
 	MoviesGetAll(
 		ctx context.Context,
+		filter repo.ListFilter,
+		sort repo.ListSort,
 		offset, limit int,
+		includeInvalidated bool,
 	) (movies []*models.Film, total int, err error)
+	MoviesGetPage(
+		ctx context.Context,
+		filter repo.ListFilter,
+		sort repo.ListSort,
+		cursor string,
+		limit int,
+		includeInvalidated bool,
+	) (movies []*models.Film, prevCursor, nextCursor string, err error)
 	MovieCreate(
 		ctx context.Context,
 		contributorID int,
@@ -66,29 +205,70 @@ type Service interface {
 		id int,
 		contributorID int,
 		req *dto.MovieUpdateRequest,
-	) error
+	) (proposal *models.Proposal, err error)
 	MovieInvalidate(
 		ctx context.Context,
 		id int,
 		contributorID int,
 		req *dto.InvalidationRequest,
 	) error
+	MovieRestore(
+		ctx context.Context,
+		id int,
+		contributorID int,
+	) error
 	MovieAuditsGetAll(
 		ctx context.Context,
 		id int,
 		offset, limit int,
 	) (audits []*models.FilmsAudit, total int, err error)
+	MovieAuditsGetPage(
+		ctx context.Context,
+		id int,
+		cursor string,
+		limit int,
+	) (audits []*models.FilmsAudit, prevCursor, nextCursor string, err error)
+	MovieAuditRevert(
+		ctx context.Context,
+		id int,
+		contributedAt time.Time,
+		contributorID int,
+	) (proposal *models.Proposal, err error)
+	MovieAuditDiff(
+		ctx context.Context,
+		id int,
+		from, to time.Time,
+	) (*repo.AuditDiff, error)
+	MovieAuditDiffsGetAll(
+		ctx context.Context,
+		id int,
+		offset, limit int,
+	) (diffs []*repo.AuditDiff, total int, err error)
 	MoviesSearch(
 		ctx context.Context,
 		req *dto.SearchRequest,
 		offset, limit int,
 	) (results []*models.Film, total int, err error)
+	MoviesSearchPage(
+		ctx context.Context,
+		req *dto.SearchRequest,
+		cursor string,
+		limit int,
+	) (results []*models.Film, prevCursor, nextCursor string, err error)
 
 	// Series
-	SeriesGet(ctx context.Context, id int) (*models.Series, error)
+	SeriesGet(
+		ctx context.Context,
+		id int,
+		includeInvalidated bool,
+		loads ...string,
+	) (*models.Series, error)
 	SeriesesGetAll(
 		ctx context.Context,
+		filter repo.ListFilter,
+		sort repo.ListSort,
 		offset, limit int,
+		includeInvalidated bool,
 	) (series []*models.Series, total int, err error)
 	SeriesCreate(
 		ctx context.Context,
@@ -107,52 +287,155 @@ type Service interface {
 		contributorID int,
 		req *dto.InvalidationRequest,
 	) error
+	SeriesRestore(
+		ctx context.Context,
+		seriesID int,
+		contributorID int,
+		cascade bool,
+	) error
 	SeriesAuditsGetAll(
 		ctx context.Context,
 		id int,
 		offset, limit int,
 	) (audits []*models.SeriesesAudit, total int, err error)
+	SeriesAuditsGetPage(
+		ctx context.Context,
+		id int,
+		cursor string,
+		limit int,
+	) (audits []*models.SeriesesAudit, prevCursor, nextCursor string, err error)
+	SeriesAuditRevert(
+		ctx context.Context,
+		id int,
+		contributedAt time.Time,
+		contributorID int,
+	) error
+	SeriesAuditDiff(
+		ctx context.Context,
+		id int,
+		from, to time.Time,
+	) (*repo.AuditDiff, error)
+	SeriesAuditDiffsGetAll(
+		ctx context.Context,
+		id int,
+		offset, limit int,
+	) (diffs []*repo.AuditDiff, total int, err error)
 	SeriesesSearch(
 		ctx context.Context,
 		req *dto.SearchRequest,
 		offset, limit int,
 	) (results []*models.Series, total int, err error)
+	SeriesesSearchPage(
+		ctx context.Context,
+		req *dto.SearchRequest,
+		cursor string,
+		limit int,
+	) (results []*models.Series, prevCursor, nextCursor string, err error)
+
+	// Season
+	SeasonGet(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		includeInvalidated bool,
+		loads ...string,
+	) (*models.Season, error)
+	SeasonsGetAllBySeries(
+		ctx context.Context,
+		seriesID int,
+		offset, limit int,
+		includeInvalidated bool,
+	) (seasons []*repo.SeasonWithEpisodesCount, total int, err error)
+	SeasonPut(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		contributorID int,
+		req *dto.SeasonPutRequest,
+	) error
+	SeasonUpdate(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		contributorID int,
+		req *dto.SeasonUpdateRequest,
+	) error
+	SeasonInvalidate(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		contributorID int,
+		req *dto.InvalidationRequest,
+	) error
+	SeasonRestore(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		contributorID int,
+	) error
+	SeasonAuditsGetAll(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		offset, limit int,
+	) (audits []*models.SeasonsAudit, total int, err error)
+	SeasonAuditsGetPage(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		cursor string,
+		limit int,
+	) (audits []*models.SeasonsAudit, prevCursor, nextCursor string, err error)
 
 	// Episode
 	EpisodeGet(
 		ctx context.Context,
 		seriesID, seasonNumber, episodeNumber int,
+		includeInvalidated bool,
+		loads ...string,
 	) (*models.Film, error)
+	EpisodesCountBySeries(
+		ctx context.Context,
+		seriesID int,
+		includeInvalidated bool,
+	) (int, error)
+	EpisodesCountBySeason(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		includeInvalidated bool,
+	) (int, error)
 	EpisodesGetAllBySeries(
 		ctx context.Context,
 		seriesID int,
 		offset, limit int,
+		includeInvalidated bool,
 	) (episodes []*models.Film, total int, err error)
+	EpisodesGetPageBySeries(
+		ctx context.Context,
+		seriesID int,
+		cursor string,
+		limit int,
+		includeInvalidated bool,
+	) (episodes []*models.Film, prevCursor, nextCursor string, err error)
 	EpisodesGetAllBySeason(
 		ctx context.Context,
 		seriesID int,
 		seasonNumber int,
 		offset, limit int,
+		includeInvalidated bool,
 	) (episodes []*models.Film, total int, err error)
 	EpisodePut(
 		ctx context.Context,
 		seriesID, seasonNumber, episodeNumber int,
 		contributorID int,
 		req *dto.EpisodePutRequest,
-	) error
+	) (proposal *models.Proposal, err error)
 	EpisodesPutAllBySeason(
 		ctx context.Context,
 		seriesID int,
 		seasonNumber int,
 		contributorID int,
 		req *dto.EpisodesPutAllBySeasonRequest,
-	) error
+	) (proposal *models.Proposal, err error)
 	EpisodeUpdate(
 		ctx context.Context,
 		seriesID, seasonNumber, episodeNumber int,
 		contributorID int,
 		req *dto.EpisodeUpdateRequest,
-	) error
+	) (proposal *models.Proposal, err error)
 	EpisodeInvalidate(
 		ctx context.Context,
 		seriesID, seasonNumber, episodeNumber int,
@@ -165,11 +448,148 @@ type Service interface {
 		contributorID int,
 		req *dto.InvalidationRequest,
 	) error
+	EpisodeRestore(
+		ctx context.Context,
+		seriesID, seasonNumber, episodeNumber int,
+		contributorID int,
+	) error
+	EpisodesRestoreAllBySeason(
+		ctx context.Context,
+		seriesID, seasonNumber,
+		contributorID int,
+	) error
 	EpisodeAuditsGetAll(
 		ctx context.Context,
 		seriesID, seasonNumber, episodeNumber int,
 		offset, limit int,
 	) (audits []*models.FilmsAudit, total int, err error)
+	EpisodeAuditsGetPage(
+		ctx context.Context,
+		seriesID, seasonNumber, episodeNumber int,
+		cursor string,
+		limit int,
+	) (audits []*models.FilmsAudit, prevCursor, nextCursor string, err error)
+	EpisodeAuditRevert(
+		ctx context.Context,
+		seriesID, seasonNumber, episodeNumber int,
+		contributedAt time.Time,
+		contributorID int,
+	) (proposal *models.Proposal, err error)
+
+	// Film media
+	FilmMediaGet(
+		ctx context.Context,
+		filmID, mediaID int,
+	) (*models.FilmMediaURL, error)
+	FilmMediaGetAll(
+		ctx context.Context,
+		filmID int,
+		offset, limit int,
+	) (media []*models.FilmMediaURL, total int, err error)
+	FilmMediaUpload(
+		ctx context.Context,
+		filmID int,
+		contributorID int,
+		content io.Reader,
+	) (mediaID int, err error)
+	FilmMediaInvalidate(
+		ctx context.Context,
+		filmID, mediaID int,
+		contributorID int,
+		req *dto.InvalidationRequest,
+	) error
+	MediaBlobGet(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Artist
+	ArtistGet(ctx context.Context, id int) (*models.Artist, error)
+	ArtistCreate(
+		ctx context.Context,
+		contributorID int,
+		req *dto.ArtistCreateRequest,
+	) (artistID int, err error)
+	ArtistImageGet(
+		ctx context.Context,
+		artistID, imageID int,
+	) (*models.ArtistImageURL, error)
+	ArtistImagesGetAll(
+		ctx context.Context,
+		artistID int,
+		offset, limit int,
+	) (images []*models.ArtistImageURL, total int, err error)
+	ArtistImageUpload(
+		ctx context.Context,
+		artistID int,
+		contributorID int,
+		content io.Reader,
+	) (imageID int, err error)
+	ArtistImageInvalidate(
+		ctx context.Context,
+		artistID, imageID int,
+		contributorID int,
+		req *dto.InvalidationRequest,
+	) error
+
+	// Playlist
+	PlaylistGet(
+		ctx context.Context,
+		id int,
+		userID int,
+	) (*models.Playlist, error)
+	PlaylistsGetAllByUser(
+		ctx context.Context,
+		userID int,
+		offset, limit int,
+	) (playlists []*models.Playlist, total int, err error)
+	PlaylistCreate(
+		ctx context.Context,
+		userID int,
+		req *dto.PlaylistCreateRequest,
+	) (playlistID int, err error)
+	PlaylistUpdate(
+		ctx context.Context,
+		id int,
+		userID int,
+		req *dto.PlaylistUpdateRequest,
+	) error
+	PlaylistDelete(ctx context.Context, id int, userID int) error
+	PlaylistFilmsGetAll(
+		ctx context.Context,
+		id int,
+		userID int,
+		offset, limit int,
+	) (films []*models.Film, total int, err error)
+	PlaylistFilmAdd(
+		ctx context.Context,
+		id int,
+		filmID int,
+		userID int,
+	) error
+	PlaylistFilmRemove(
+		ctx context.Context,
+		id int,
+		filmID int,
+		userID int,
+	) error
+	PlaylistFilmsReorder(
+		ctx context.Context,
+		id int,
+		userID int,
+		req *dto.PlaylistFilmsReorderRequest,
+	) error
+	PlaylistCover(ctx context.Context, id int, userID int) ([]byte, error)
+	PlaylistAddToWatchlist(
+		ctx context.Context,
+		id int,
+		userID int,
+	) (added int, err error)
+
+	// Watchlist
+	WatchlistGetAll(
+		ctx context.Context,
+		userID int,
+		offset, limit int,
+	) (watchlist []*models.Watchlist, total int, err error)
+	WatchlistMarkWatched(ctx context.Context, userID int, filmID int) error
 }
 
 type Application struct {
@@ -177,6 +597,8 @@ type Application struct {
 	token      token.Service
 	search     search.Service
 	hasher     hasher.Interface
+	storage    storage.Service
+	notifier   notifier.Service
 }
 
 var _ Service = (*Application)(nil)
@@ -186,11 +608,15 @@ func NewApplication(
 	tokenService token.Service,
 	searchService search.Service,
 	hasher hasher.Interface,
+	storageService storage.Service,
+	notifierService notifier.Service,
 ) *Application {
 	return &Application{
 		repository: repo,
 		token:      tokenService,
 		search:     searchService,
 		hasher:     hasher,
+		storage:    storageService,
+		notifier:   notifierService,
 	}
 }
@@ -0,0 +1,175 @@
+package app
+
+import (
+	"context"
+	"io"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+)
+
+func (a *Application) ArtistGet(
+	ctx context.Context,
+	id int,
+) (*models.Artist, error) {
+	artist, err := a.repository.ArtistGet(ctx, id)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return artist, nil
+}
+
+func (a *Application) ArtistCreate(
+	ctx context.Context,
+	contributorID int,
+	req *dto.ArtistCreateRequest,
+) (artistID int, err error) {
+	insertArtist := &models.Artist{
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+		Bio:       req.Bio,
+		Birthdate: req.Birthdate,
+	}
+
+	err = a.repository.ArtistCreate(ctx, contributorID, insertArtist)
+	if err != nil {
+		return 0, err
+	}
+
+	return insertArtist.ID, nil
+}
+
+//------------------------------------------------------------------------------
+
+func (a *Application) ArtistImageGet(
+	ctx context.Context,
+	artistID, imageID int,
+) (*models.ArtistImageURL, error) {
+	image, err := a.repository.ArtistImageGet(ctx, artistID, imageID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return image, nil
+}
+
+func (a *Application) ArtistImagesGetAll(
+	ctx context.Context,
+	artistID int,
+	offset, limit int,
+) (images []*models.ArtistImageURL, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the artist exists
+			_, err := tx.ArtistGet(ctx, artistID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			images, err = tx.ArtistImagesGetAllByArtist(ctx, artistID, offset, limit)
+			if err != nil {
+				return err
+			}
+			total, err = tx.ArtistImagesCountByArtist(ctx, artistID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return images, total, nil
+}
+
+// ArtistImageUpload stores an uploaded image of an artist along with its
+// thumbnail, the same way film media images are. Other media types are
+// rejected.
+func (a *Application) ArtistImageUpload(
+	ctx context.Context,
+	artistID int,
+	contributorID int,
+	content io.Reader,
+) (imageID int, err error) {
+	// first check the artist exists
+	_, err = a.repository.ArtistGet(ctx, artistID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return 0, ErrNotFound
+		}
+		return 0, err
+	}
+
+	mimeType, content, err := sniffMimeType(content)
+	if err != nil {
+		return 0, err
+	}
+	if mediaType, _ := mediaTypeOf(mimeType); mediaType != MediaTypeImage {
+		return 0, ErrUnsupportedMediaType
+	}
+
+	image := &models.ArtistImageURL{
+		ArtistID: artistID,
+		MimeType: mimeType,
+	}
+
+	image.ImageURL, err = newBlobKey()
+	if err != nil {
+		return 0, err
+	}
+	image.ThumbnailURL = image.ImageURL + "_thumb"
+
+	image.SizeInBytes, err = a.putImage(
+		ctx,
+		image.ImageURL,
+		image.ThumbnailURL,
+		&maxSizeReader{
+			r:         content,
+			remaining: config.Config.Validation.Media.Image.MaxSizeInBytes,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	err = a.repository.ArtistImageCreate(ctx, contributorID, image)
+	if err != nil {
+		a.deleteUnreferencedBlob(ctx, image.ImageURL)
+		a.deleteUnreferencedBlob(ctx, image.ThumbnailURL)
+		return 0, err
+	}
+
+	return image.ID, nil
+}
+
+// ArtistImageInvalidate marks an image invalid. Its blobs are kept as the
+// audit rows keep referencing them.
+func (a *Application) ArtistImageInvalidate(
+	ctx context.Context,
+	artistID, imageID int,
+	contributorID int,
+	req *dto.InvalidationRequest,
+) error {
+	err := a.repository.ArtistImageInvalidate(
+		ctx,
+		artistID,
+		imageID,
+		contributorID,
+		req.Invalidation,
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
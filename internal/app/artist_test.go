@@ -0,0 +1,229 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watch-server/internal/storage/mock_storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtistGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		artistID  = 1
+		expError  = errors.New("error")
+		expArtist = &models.Artist{ID: artistID, FirstName: "first"}
+	)
+
+	type TestCase struct {
+		name      string
+		getArtist *models.Artist
+		getErr    error
+		expArtist *models.Artist
+		expErr    error
+	}
+
+	testCases := []TestCase{
+		{name: "error", getErr: expError, expErr: expError},
+		{name: "not found", getErr: repo.ErrNoRecord, expErr: app.ErrNotFound},
+		{name: "ok", getArtist: expArtist, expArtist: expArtist},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				ArtistGet(ctx, artistID).
+				Return(tc.getArtist, tc.getErr)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			artist, err := app.ArtistGet(ctx, artistID)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expArtist, artist)
+		})
+	}
+}
+
+func TestArtistImageUpload(t *testing.T) {
+	// the same limits as TestFilmMediaUpload sets as they run in parallel
+	config.Config.Validation.Media.Image.MaxSizeInBytes = 1 << 20
+	config.Config.Validation.Media.Image.MaxPixels = 16 * 8
+	config.Config.Validation.Media.Image.MimeTypes = []string{"image/png"}
+	config.Config.Validation.Media.Video.MaxSizeInBytes = 64
+	config.Config.Validation.Media.Video.MimeTypes = []string{"video/mp4"}
+	config.Config.Validation.Media.Thumbnail.MaxDimension = 4
+
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		artistID      = 1
+		contributorID = 1
+		expImageID    = 10
+		expError      = errors.New("error")
+	)
+
+	var pngContent bytes.Buffer
+	err := png.Encode(&pngContent, image.NewRGBA(image.Rect(0, 0, 16, 8)))
+	require.NoError(t, err)
+	var largePNGContent bytes.Buffer
+	err = png.Encode(&largePNGContent, image.NewRGBA(image.Rect(0, 0, 16, 9)))
+	require.NoError(t, err)
+
+	mp4Content := []byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom")
+
+	type TestCase struct {
+		name        string
+		content     []byte
+		artistErr   error
+		expPuts     int
+		putErr      error
+		expCreate   bool
+		createErr   error
+		expCleanups int
+		expImageID  int
+		expErr      error
+	}
+
+	testCases := []TestCase{
+		{
+			name:      "artist not found",
+			content:   pngContent.Bytes(),
+			artistErr: repo.ErrNoRecord,
+			expErr:    app.ErrNotFound,
+		},
+		{
+			name:    "unsupported media type",
+			content: []byte("plain text"),
+			expErr:  app.ErrUnsupportedMediaType,
+		},
+		{
+			name:    "videos are not accepted",
+			content: mp4Content,
+			expErr:  app.ErrUnsupportedMediaType,
+		},
+		{
+			name:    "image of too many pixels",
+			content: largePNGContent.Bytes(),
+			expErr:  app.ErrMediaTooLarge,
+		},
+		{
+			name:    "storage error",
+			content: pngContent.Bytes(),
+			expPuts: 1,
+			putErr:  expError,
+			expErr:  expError,
+		},
+		{
+			name:        "ArtistImageCreate error",
+			content:     pngContent.Bytes(),
+			expPuts:     2,
+			expCreate:   true,
+			createErr:   expError,
+			expCleanups: 2,
+			expErr:      expError,
+		},
+		{
+			name:       "ok",
+			content:    pngContent.Bytes(),
+			expPuts:    2,
+			expCreate:  true,
+			expImageID: expImageID,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+			mockStorage := mock_storage.NewMockService(controller)
+
+			mockRepo.EXPECT().
+				ArtistGet(ctx, artistID).
+				Return(&models.Artist{ID: artistID}, tc.artistErr)
+
+			stored := make(map[string][]byte)
+			mockStorage.EXPECT().
+				Put(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, key string, content io.Reader) error {
+					data, err := io.ReadAll(content)
+					if err != nil {
+						return err
+					}
+					if tc.putErr != nil {
+						return tc.putErr
+					}
+					stored[key] = data
+					return nil
+				}).
+				Times(tc.expPuts)
+
+			if tc.expCreate {
+				mockRepo.EXPECT().
+					ArtistImageCreate(ctx, contributorID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int, image *models.ArtistImageURL) error {
+						require.Equal(artistID, image.ArtistID)
+						require.Equal("image/png", image.MimeType)
+						require.Equal(tc.content, stored[image.ImageURL])
+						require.Equal(int64(len(tc.content)), image.SizeInBytes)
+						thumb, err := jpeg.DecodeConfig(
+							bytes.NewReader(stored[image.ThumbnailURL]),
+						)
+						require.NoError(err)
+						require.Equal(4, thumb.Width)
+						require.Equal(2, thumb.Height)
+						image.ID = tc.expImageID
+						return tc.createErr
+					})
+			}
+
+			mockRepo.EXPECT().
+				MediaBlobReferenced(ctx, gomock.Any()).
+				Return(false, nil).
+				Times(tc.expCleanups)
+			mockStorage.EXPECT().
+				Delete(ctx, gomock.Any()).
+				Return(nil).
+				Times(tc.expCleanups)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, mockStorage, nil)
+
+			imageID, err := app.ArtistImageUpload(
+				ctx,
+				artistID,
+				contributorID,
+				bytes.NewReader(tc.content),
+			)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expImageID, imageID)
+		})
+	}
+}
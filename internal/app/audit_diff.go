@@ -0,0 +1,16 @@
+package app
+
+import "math"
+
+// auditRevisionsRange returns the audits range needed to diff a page of
+// revisions against their predecessors. The current row is the latest
+// revision, so only the first page starts with it.
+func auditRevisionsRange(offset, limit int) (auditsOffset, auditsLimit int) {
+	if offset == 0 {
+		return 0, limit
+	}
+	if limit < math.MaxInt {
+		limit++
+	}
+	return offset - 1, limit
+}
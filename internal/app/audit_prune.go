@@ -0,0 +1,40 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/repo"
+)
+
+// AuditsPrune deletes the audit revisions beyond the configured retention
+// and returns the number of deleted revisions by audit table.
+func (a *Application) AuditsPrune(
+	ctx context.Context,
+) (pruned map[string]int64, err error) {
+	retentionConfig := config.Config.Audit.Retention
+	retention := repo.AuditRetention{
+		KeepRevisions: retentionConfig.KeepRevisions,
+		MaxRevisions:  retentionConfig.MaxRevisions,
+	}
+	if retentionConfig.MaxAgeInDays > 0 {
+		retention.Before = time.Now().AddDate(
+			0,
+			0,
+			-retentionConfig.MaxAgeInDays,
+		)
+	}
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			var err error
+			pruned, err = tx.AuditsPrune(ctx, retention)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pruned, nil
+}
@@ -0,0 +1,60 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditsPrune(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	config.Config.Audit.Retention.KeepRevisions = 10
+	config.Config.Audit.Retention.MaxRevisions = 50
+	config.Config.Audit.Retention.MaxAgeInDays = 30
+
+	expPruned := map[string]int64{
+		models.TableNames.FilmsAudit:    3,
+		models.TableNames.SeriesesAudit: 0,
+	}
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	mockRepo.EXPECT().
+		Transaction(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(_ context.Context, _ repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+
+	var retention repo.AuditRetention
+	mockRepo.EXPECT().
+		AuditsPrune(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, r repo.AuditRetention) (map[string]int64, error) {
+			retention = r
+			return expPruned, nil
+		})
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	pruned, err := application.AuditsPrune(ctx)
+	require.NoError(err)
+	require.Equal(expPruned, pruned)
+
+	require.Equal(10, retention.KeepRevisions)
+	require.Equal(50, retention.MaxRevisions)
+	require.WithinDuration(
+		time.Now().AddDate(0, 0, -30),
+		retention.Before,
+		time.Minute,
+	)
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/repo"
+)
+
+// ContributionsGetAll returns the films and serieses revisions userID made,
+// newest first.
+func (a *Application) ContributionsGetAll(
+	ctx context.Context,
+	userID int,
+	offset, limit int,
+) (contributions []*repo.Contribution, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the user exists
+			_, err := tx.UserGet(ctx, userID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch contributions
+			contributions, err = tx.ContributionsGetAll(
+				ctx,
+				userID,
+				offset,
+				limit,
+			)
+			if err != nil {
+				return err
+			}
+			// count total contributions
+			total, err = tx.ContributionsCount(ctx, userID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return contributions, total, nil
+}
+
+// ContributorsGetAll ranks the users by their contributions made from since
+// until until, either one might be zero to leave the window open on that end.
+func (a *Application) ContributorsGetAll(
+	ctx context.Context,
+	since, until time.Time,
+	offset, limit int,
+) (contributors []*repo.Contributor, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			var err error
+			contributors, err = tx.ContributorsGetAll(
+				ctx,
+				since,
+				until,
+				offset,
+				limit,
+			)
+			if err != nil {
+				return err
+			}
+			total, err = tx.ContributorsCount(ctx, since, until)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return contributors, total, nil
+}
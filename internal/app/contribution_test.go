@@ -0,0 +1,172 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContributionsGetAll(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		userID           = 1
+		offset           = 0
+		limit            = 10
+		expContributions = []*repo.Contribution{
+			{
+				Record: repo.ContributionRecordMovie,
+				ID:     1,
+				Title:  "movie",
+				Action: repo.ContributionActionCreate,
+			},
+		}
+		expTotal = len(expContributions)
+	)
+
+	type UserGetExp struct {
+		err error
+	}
+	type UserGet struct {
+		exp UserGetExp
+	}
+	type Exp struct {
+		contributions []*repo.Contribution
+		total         int
+		err           error
+	}
+	type TestCase struct {
+		name    string
+		userGet UserGet
+		exp     Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "user not found",
+			userGet: UserGet{
+				exp: UserGetExp{
+					err: repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "ok",
+			userGet: UserGet{
+				exp: UserGetExp{
+					err: nil,
+				},
+			},
+			exp: Exp{
+				contributions: expContributions,
+				total:         expTotal,
+				err:           nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			userGetCall := mockRepo.EXPECT().
+				UserGet(ctx, userID).
+				Return(&models.User{ID: userID}, tc.userGet.exp.err)
+
+			if tc.userGet.exp.err == nil {
+				getAllCall := mockRepo.EXPECT().
+					ContributionsGetAll(ctx, userID, offset, limit).
+					Return(expContributions, nil).
+					After(userGetCall)
+				mockRepo.EXPECT().
+					ContributionsCount(ctx, userID).
+					Return(expTotal, nil).
+					After(getAllCall)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			contributions, total, err := app.ContributionsGetAll(
+				ctx,
+				userID,
+				offset,
+				limit,
+			)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.contributions, contributions)
+			require.Equal(tc.exp.total, total)
+		})
+	}
+}
+
+func TestContributorsGetAll(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		since           = time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+		until           = time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+		offset          = 0
+		limit           = 10
+		expContributors = []*repo.Contributor{
+			{Rank: 1, UserID: 2, Contributions: 5},
+			{Rank: 2, UserID: 1, Contributions: 3},
+		}
+		expTotal = len(expContributors)
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	mockRepo.EXPECT().
+		Transaction(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(_ context.Context, _ repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	getAllCall := mockRepo.EXPECT().
+		ContributorsGetAll(ctx, since, until, offset, limit).
+		Return(expContributors, nil)
+	mockRepo.EXPECT().
+		ContributorsCount(ctx, since, until).
+		Return(expTotal, nil).
+		After(getAllCall)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	contributors, total, err := application.ContributorsGetAll(
+		ctx,
+		since,
+		until,
+		offset,
+		limit,
+	)
+	require.NoError(err)
+	require.Equal(expContributors, contributors)
+	require.Equal(expTotal, total)
+}
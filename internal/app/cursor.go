@@ -0,0 +1,177 @@
+package app
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/search"
+)
+
+// Page cursors are opaque to clients: the url-safe base64 encoding of the
+// json of the paging direction and the keyset of the row they point at.
+type pageCursor struct {
+	Backward bool              `json:"b,omitempty"`
+	Keyset   []json.RawMessage `json:"k"`
+}
+
+func encodePageCursor(backward bool, keyset []any) string {
+	cursor := pageCursor{Backward: backward}
+	for _, value := range keyset {
+		// keysets hold numbers, strings, times and their nullable kinds only
+		encoded, _ := json.Marshal(value)
+		cursor.Keyset = append(cursor.Keyset, encoded)
+	}
+	encoded, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// decodePage returns the keyset page of up to limit rows cursor refers to,
+// an empty cursor refers to the first page. The keyset values are decoded
+// into the types pointed to by keyset. An extra row is asked for to know
+// whether there's a page further.
+func decodePage(
+	cursor string,
+	limit int,
+	keyset ...any,
+) (*repo.KeysetPage, error) {
+	page := &repo.KeysetPage{Limit: limit + 1}
+	if cursor == "" {
+		return page, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrCursorInvalid
+	}
+	var c pageCursor
+	err = json.Unmarshal(decoded, &c)
+	if err != nil || len(c.Keyset) != len(keyset) {
+		return nil, ErrCursorInvalid
+	}
+	page.Backward = c.Backward
+	page.After = make([]any, len(keyset))
+	for i, value := range keyset {
+		if err := json.Unmarshal(c.Keyset[i], value); err != nil {
+			return nil, ErrCursorInvalid
+		}
+		page.After[i] = reflect.ValueOf(value).Elem().Interface()
+	}
+	return page, nil
+}
+
+// pageRows trims the extra row fetched for page and returns the cursors of
+// the pages around it, empty at the ends of the list.
+func pageRows[T any](
+	rows []T,
+	page *repo.KeysetPage,
+	keyset func(T) []any,
+) (items []T, prevCursor, nextCursor string) {
+	limit := page.Limit - 1
+	further := len(rows) > limit
+	if further {
+		if page.Backward {
+			// the rows are in list order so the extra one is the first
+			rows = rows[len(rows)-limit:]
+		} else {
+			rows = rows[:limit]
+		}
+	}
+
+	// a page next to a row has that row on its other side
+	hasPrev, hasNext := page.After != nil, further
+	if page.Backward {
+		hasPrev, hasNext = further, true
+	}
+	if len(rows) == 0 {
+		// an empty page is bounded by its cursor alone
+		if page.After != nil {
+			if page.Backward {
+				nextCursor = encodePageCursor(false, page.After)
+			} else {
+				prevCursor = encodePageCursor(true, page.After)
+			}
+		}
+		return rows, prevCursor, nextCursor
+	}
+	if hasPrev {
+		prevCursor = encodePageCursor(true, keyset(rows[0]))
+	}
+	if hasNext {
+		nextCursor = encodePageCursor(false, keyset(rows[len(rows)-1]))
+	}
+	return rows, prevCursor, nextCursor
+}
+
+// sortKeyset returns the keyset of the rows of a list sorted by sort and
+// then by the unique tiebreaker column, the way repo orders them, along with
+// the targets decoding the keysets of its cursors. T is a pointer to a model
+// struct having the sorted columns.
+func sortKeyset[T any](
+	sort repo.ListSort,
+	tiebreaker string,
+) (keyset func(T) []any, targets []any) {
+	typ := reflect.TypeOf(new(T)).Elem().Elem()
+	columns := sort.Columns(tiebreaker)
+	fields := make([]int, len(columns))
+	for i, column := range columns {
+		field, ok := columnField(typ, column)
+		if !ok {
+			panic(fmt.Sprintf("app: %s has no column %q", typ, column))
+		}
+		fields[i] = field.Index[0]
+		targets = append(targets, reflect.New(field.Type).Interface())
+	}
+	keyset = func(row T) []any {
+		value := reflect.ValueOf(row).Elem()
+		values := make([]any, len(fields))
+		for i, field := range fields {
+			values[i] = value.Field(field).Interface()
+		}
+		return values
+	}
+	return keyset, targets
+}
+
+// columnField returns the field of a model struct holding column
+func columnField(typ reflect.Type, column string) (reflect.StructField, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		if typ.Field(i).Tag.Get("boil") == column {
+			return typ.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// the keysets of the keyset paginated lists
+
+func episodeKeyset(episode *models.Film) []any {
+	return []any{episode.SeasonNumber.Int, episode.EpisodeNumber.Int}
+}
+
+func filmsAuditKeyset(audit *models.FilmsAudit) []any {
+	return []any{audit.ContributedAt, audit.ContributedBy}
+}
+
+func seriesesAuditKeyset(audit *models.SeriesesAudit) []any {
+	return []any{audit.ContributedAt, audit.ContributedBy}
+}
+
+func seasonsAuditKeyset(audit *models.SeasonsAudit) []any {
+	return []any{audit.ContributedAt, audit.ContributedBy}
+}
+
+func usersAuditKeyset(audit *models.UsersAudit) []any {
+	return []any{audit.ContributedAt}
+}
+
+func movieHitKeyset(hit *search.MovieHit) []any {
+	return []any{hit.Score, hit.ID}
+}
+
+func seriesHitKeyset(hit *search.SeriesHit) []any {
+	return []any{hit.Score, hit.ID}
+}
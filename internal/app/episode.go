@@ -2,21 +2,29 @@ package app
 
 import (
 	"context"
+	"time"
 
 	"github.com/aria3ppp/watch-server/internal/dto"
 	"github.com/aria3ppp/watch-server/internal/models"
 	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/volatiletech/null/v8"
 )
 
+// EpisodeGet fetches an episode along with the relations to load, named by
+// models.FilmRels.
 func (a *Application) EpisodeGet(
 	ctx context.Context,
 	seriesID, seasonNumber, episodeNumber int,
+	includeInvalidated bool,
+	loads ...string,
 ) (*models.Film, error) {
 	episode, err := a.repository.EpisodeGet(
 		ctx,
 		seriesID,
 		seasonNumber,
 		episodeNumber,
+		includeInvalidated,
+		loads...,
 	)
 	if err != nil {
 		if err == repo.ErrNoRecord {
@@ -27,10 +35,32 @@ func (a *Application) EpisodeGet(
 	return episode, nil
 }
 
+func (a *Application) EpisodesCountBySeries(
+	ctx context.Context,
+	seriesID int,
+	includeInvalidated bool,
+) (int, error) {
+	return a.repository.EpisodesCountBySeries(ctx, seriesID, includeInvalidated)
+}
+
+func (a *Application) EpisodesCountBySeason(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	includeInvalidated bool,
+) (int, error) {
+	return a.repository.EpisodesCountBySeason(
+		ctx,
+		seriesID,
+		seasonNumber,
+		includeInvalidated,
+	)
+}
+
 func (a *Application) EpisodesGetAllBySeries(
 	ctx context.Context,
 	seriesID int,
 	offset, limit int,
+	includeInvalidated bool,
 ) (episodes []*models.Film, total int, err error) {
 	err = a.repository.Transaction(
 		ctx,
@@ -41,11 +71,16 @@ func (a *Application) EpisodesGetAllBySeries(
 				seriesID,
 				offset,
 				limit,
+				includeInvalidated,
 			)
 			if err != nil {
 				return err
 			}
-			total, err = tx.EpisodesCountBySeries(ctx, seriesID)
+			total, err = tx.EpisodesCountBySeries(
+				ctx,
+				seriesID,
+				includeInvalidated,
+			)
 			return err
 		},
 	)
@@ -55,11 +90,38 @@ func (a *Application) EpisodesGetAllBySeries(
 	return episodes, total, nil
 }
 
+// EpisodesGetPageBySeries is the cursor paginated counterpart of
+// EpisodesGetAllBySeries.
+func (a *Application) EpisodesGetPageBySeries(
+	ctx context.Context,
+	seriesID int,
+	cursor string,
+	limit int,
+	includeInvalidated bool,
+) (episodes []*models.Film, prevCursor, nextCursor string, err error) {
+	page, err := decodePage(cursor, limit, new(int), new(int))
+	if err != nil {
+		return nil, "", "", err
+	}
+	episodes, err = a.repository.EpisodesGetPageBySeries(
+		ctx,
+		seriesID,
+		page,
+		includeInvalidated,
+	)
+	if err != nil {
+		return nil, "", "", err
+	}
+	episodes, prevCursor, nextCursor = pageRows(episodes, page, episodeKeyset)
+	return episodes, prevCursor, nextCursor, nil
+}
+
 func (a *Application) EpisodesGetAllBySeason(
 	ctx context.Context,
 	seriesID int,
 	seasonNumber int,
 	offset, limit int,
+	includeInvalidated bool,
 ) (episodes []*models.Film, total int, err error) {
 	err = a.repository.Transaction(
 		ctx,
@@ -71,11 +133,17 @@ func (a *Application) EpisodesGetAllBySeason(
 				seasonNumber,
 				offset,
 				limit,
+				includeInvalidated,
 			)
 			if err != nil {
 				return err
 			}
-			total, err = tx.EpisodesCountBySeason(ctx, seriesID, seasonNumber)
+			total, err = tx.EpisodesCountBySeason(
+				ctx,
+				seriesID,
+				seasonNumber,
+				includeInvalidated,
+			)
 			return err
 		},
 	)
@@ -87,98 +155,300 @@ func (a *Application) EpisodesGetAllBySeason(
 
 //------------------------------------------------------------------------------
 
+// EpisodePut applies the put right away if contributorID is trusted or
+// queues it as a proposal for the moderators otherwise.
 func (a *Application) EpisodePut(
 	ctx context.Context,
 	seriesID, seasonNumber, episodeNumber int,
 	contributorID int,
 	req *dto.EpisodePutRequest,
-) error {
-	err := a.repository.Transaction(
+) (proposal *models.Proposal, err error) {
+	err = a.repository.Transaction(
 		ctx,
 		func(ctx context.Context, tx repo.Service) error {
-			// first check seris exists
-			_, err := tx.SeriesGet(ctx, seriesID)
+			trusted, err := contributorTrusted(ctx, tx, contributorID)
 			if err != nil {
+				return err
+			}
+			if trusted {
+				return episodePut(
+					ctx,
+					tx,
+					seriesID,
+					seasonNumber,
+					episodeNumber,
+					contributorID,
+					req,
+				)
+			}
+			// check the series exists before proposing
+			if _, err := tx.SeriesGet(ctx, seriesID, true); err != nil {
 				if err == repo.ErrNoRecord {
 					return ErrNotFound
 				}
 				return err
 			}
-			// then put episode
-			err = a.repository.EpisodePut(
+			proposal = &models.Proposal{
+				Kind:          repo.ProposalKindEpisodePut,
+				SeriesID:      null.IntFrom(seriesID),
+				SeasonNumber:  null.IntFrom(seasonNumber),
+				EpisodeNumber: null.IntFrom(episodeNumber),
+				ProposedBy:    contributorID,
+			}
+			// an existing episode must still be at the version the put was
+			// made against if any, which the proposal is then based on
+			episode, err := tx.EpisodeGet(
 				ctx,
 				seriesID,
 				seasonNumber,
 				episodeNumber,
-				contributorID,
-				&models.Film{
-					Title:        req.Title,
-					Descriptions: req.Descriptions,
-					DateReleased: req.DateReleased,
-					Duration:     req.Duration,
-				},
+				true,
 			)
-			return err
+			switch err {
+			case nil:
+				if !repo.IfMatch(ctx, episode.ContributedAt) {
+					return ErrPreconditionFailed
+				}
+				proposal.BaseContributedAt = null.TimeFrom(episode.ContributedAt)
+			case repo.ErrNoRecord:
+				// only a conditional put misses the episode
+				if repo.Conditional(ctx) {
+					return ErrNotFound
+				}
+			default:
+				return err
+			}
+			return proposalCreate(ctx, tx, proposal, req)
 		},
 	)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+func episodePut(
+	ctx context.Context,
+	tx repo.Service,
+	seriesID, seasonNumber, episodeNumber int,
+	contributorID int,
+	req *dto.EpisodePutRequest,
+) error {
+	// first check seris exists
+	_, err := tx.SeriesGet(ctx, seriesID, true)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	// create the season if it's not there yet
+	err = tx.SeasonCreateIfNotExists(
+		ctx,
+		seriesID,
+		seasonNumber,
+		contributorID,
+	)
+	if err != nil {
+		return err
+	}
+	// then put episode
+	err = tx.EpisodePut(
+		ctx,
+		seriesID,
+		seasonNumber,
+		episodeNumber,
+		contributorID,
+		&models.Film{
+			Title:        req.Title,
+			Descriptions: req.Descriptions,
+			DateReleased: req.DateReleased,
+			Duration:     req.Duration,
+		},
+	)
+	if err != nil {
+		// only a conditional put misses the episode
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		if err == repo.ErrPreconditionFailed {
+			return ErrPreconditionFailed
+		}
+		return err
+	}
+	return nil
 }
 
+// EpisodesPutAllBySeason replaces the episodes of a season right away if
+// contributorID is trusted or queues the put as a proposal for the
+// moderators otherwise.
 func (a *Application) EpisodesPutAllBySeason(
 	ctx context.Context,
 	seriesID int,
 	seasonNumber int,
 	contributorID int,
 	req *dto.EpisodesPutAllBySeasonRequest,
-) error {
-	err := a.repository.Transaction(
+) (proposal *models.Proposal, err error) {
+	err = a.repository.Transaction(
 		ctx,
 		func(ctx context.Context, tx repo.Service) error {
-			// check series id exists
-			if _, err := tx.SeriesGet(ctx, seriesID); err != nil {
+			trusted, err := contributorTrusted(ctx, tx, contributorID)
+			if err != nil {
+				return err
+			}
+			if trusted {
+				return episodesPutAllBySeason(
+					ctx,
+					tx,
+					seriesID,
+					seasonNumber,
+					contributorID,
+					req,
+				)
+			}
+			// check the series exists before proposing
+			if _, err := tx.SeriesGet(ctx, seriesID, true); err != nil {
 				if err == repo.ErrNoRecord {
 					return ErrNotFound
 				}
 				return err
 			}
-			// replace episodes
-			for i, e := range req.Episodes {
-				episodeNumber := i + 1
-				err := tx.EpisodePut(
+			proposal = &models.Proposal{
+				Kind:         repo.ProposalKindSeasonEpisodesPut,
+				SeriesID:     null.IntFrom(seriesID),
+				SeasonNumber: null.IntFrom(seasonNumber),
+				ProposedBy:   contributorID,
+			}
+			return proposalCreate(ctx, tx, proposal, req)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+func episodesPutAllBySeason(
+	ctx context.Context,
+	tx repo.Service,
+	seriesID int,
+	seasonNumber int,
+	contributorID int,
+	req *dto.EpisodesPutAllBySeasonRequest,
+) error {
+	// check series id exists
+	if _, err := tx.SeriesGet(ctx, seriesID, true); err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	// create the season if it's not there yet
+	err := tx.SeasonCreateIfNotExists(
+		ctx,
+		seriesID,
+		seasonNumber,
+		contributorID,
+	)
+	if err != nil {
+		return err
+	}
+	// replace episodes
+	for i, e := range req.Episodes {
+		episodeNumber := i + 1
+		err := tx.EpisodePut(
+			ctx,
+			seriesID,
+			seasonNumber,
+			episodeNumber,
+			contributorID,
+			&models.Film{
+				Title:        e.Title,
+				Descriptions: e.Descriptions,
+				DateReleased: e.DateReleased,
+				Duration:     e.Duration,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//------------------------------------------------------------------------------
+
+// EpisodeUpdate applies the update right away if contributorID is trusted or
+// queues it as a proposal for the moderators otherwise.
+func (a *Application) EpisodeUpdate(
+	ctx context.Context,
+	seriesID, seasonNumber, episodeNumber int,
+	contributorID int,
+	req *dto.EpisodeUpdateRequest,
+) (proposal *models.Proposal, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			trusted, err := contributorTrusted(ctx, tx, contributorID)
+			if err != nil {
+				return err
+			}
+			if trusted {
+				return episodeUpdate(
 					ctx,
+					tx,
 					seriesID,
 					seasonNumber,
 					episodeNumber,
 					contributorID,
-					&models.Film{
-						Title:        e.Title,
-						Descriptions: e.Descriptions,
-						DateReleased: e.DateReleased,
-						Duration:     e.Duration,
-					},
+					req,
 				)
-				if err != nil {
-					return err
+			}
+			// check the episode exists, and is still at the version the
+			// update was made against if any, before proposing
+			episode, err := tx.EpisodeGet(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+				true,
+			)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
 				}
+				return err
 			}
-
-			return nil
+			if !repo.IfMatch(ctx, episode.ContributedAt) {
+				return ErrPreconditionFailed
+			}
+			proposal = &models.Proposal{
+				Kind:              repo.ProposalKindEpisodeUpdate,
+				SeriesID:          null.IntFrom(seriesID),
+				SeasonNumber:      null.IntFrom(seasonNumber),
+				EpisodeNumber:     null.IntFrom(episodeNumber),
+				ProposedBy:        contributorID,
+				BaseContributedAt: null.TimeFrom(episode.ContributedAt),
+			}
+			return proposalCreate(ctx, tx, proposal, req)
 		},
 	)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return proposal, nil
 }
 
-//------------------------------------------------------------------------------
-
-func (a *Application) EpisodeUpdate(
+func episodeUpdate(
 	ctx context.Context,
+	tx repo.Service,
 	seriesID, seasonNumber, episodeNumber int,
 	contributorID int,
 	req *dto.EpisodeUpdateRequest,
 ) error {
 	columns := episodeUpdateRequestToValidMap(req)
 
-	err := a.repository.EpisodeUpdate(
+	err := tx.EpisodeUpdate(
 		ctx,
 		seriesID,
 		seasonNumber,
@@ -190,6 +460,9 @@ func (a *Application) EpisodeUpdate(
 		if err == repo.ErrNoRecord {
 			return ErrNotFound
 		}
+		if err == repo.ErrPreconditionFailed {
+			return ErrPreconditionFailed
+		}
 		return err
 	}
 
@@ -233,6 +506,9 @@ func (a *Application) EpisodeInvalidate(
 		if err == repo.ErrNoRecord {
 			return ErrNotFound
 		}
+		if err == repo.ErrPreconditionFailed {
+			return ErrPreconditionFailed
+		}
 		return err
 	}
 	return nil
@@ -260,6 +536,61 @@ func (a *Application) EpisodesInvalidateAllBySeason(
 	return nil
 }
 
+func (a *Application) EpisodeRestore(
+	ctx context.Context,
+	seriesID, seasonNumber, episodeNumber int,
+	contributorID int,
+) error {
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			episode, err := tx.EpisodeGet(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+				true,
+			)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			if !episode.Invalidation.Valid {
+				return nil
+			}
+			return tx.EpisodeRestore(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+				contributorID,
+			)
+		},
+	)
+}
+
+func (a *Application) EpisodesRestoreAllBySeason(
+	ctx context.Context,
+	seriesID, seasonNumber,
+	contributorID int,
+) error {
+	err := a.repository.EpisodesRestoreAllBySeason(
+		ctx,
+		seriesID,
+		seasonNumber,
+		contributorID,
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
 //------------------------------------------------------------------------------
 
 func (a *Application) EpisodeAuditsGetAll(
@@ -271,7 +602,13 @@ func (a *Application) EpisodeAuditsGetAll(
 		ctx,
 		func(ctx context.Context, tx repo.Service) error {
 			// first check the episode exists
-			_, err := tx.EpisodeGet(ctx, seriesID, seasonNumber, episodeNumber)
+			_, err := tx.EpisodeGet(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+				true,
+			)
 			if err != nil {
 				if err == repo.ErrNoRecord {
 					return ErrNotFound
@@ -305,3 +642,181 @@ func (a *Application) EpisodeAuditsGetAll(
 	}
 	return audits, total, nil
 }
+
+func (a *Application) EpisodeAuditsGetPage(
+	ctx context.Context,
+	seriesID, seasonNumber, episodeNumber int,
+	cursor string,
+	limit int,
+) (audits []*models.FilmsAudit, prevCursor, nextCursor string, err error) {
+	page, err := decodePage(cursor, limit, new(time.Time), new(int))
+	if err != nil {
+		return nil, "", "", err
+	}
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the episode exists
+			_, err := tx.EpisodeGet(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+				true,
+			)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			audits, err = tx.EpisodeAuditsGetPage(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+				page,
+			)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, "", "", err
+	}
+	audits, prevCursor, nextCursor = pageRows(audits, page, filmsAuditKeyset)
+	return audits, prevCursor, nextCursor, nil
+}
+
+// EpisodeAuditRevert restores the episode snapshot audited at contributedAt
+// as a new contribution of contributorID right away if they're trusted or
+// queues the revert as a proposal for the moderators otherwise.
+func (a *Application) EpisodeAuditRevert(
+	ctx context.Context,
+	seriesID, seasonNumber, episodeNumber int,
+	contributedAt time.Time,
+	contributorID int,
+) (proposal *models.Proposal, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			trusted, err := contributorTrusted(ctx, tx, contributorID)
+			if err != nil {
+				return err
+			}
+			if trusted {
+				return episodeAuditRevert(
+					ctx,
+					tx,
+					seriesID,
+					seasonNumber,
+					episodeNumber,
+					contributedAt,
+					contributorID,
+				)
+			}
+			episode, cols, err := episodeAuditRevertMap(
+				ctx,
+				tx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+				contributedAt,
+			)
+			if err != nil {
+				return err
+			}
+			// the proposal shows the restored columns and is based on the
+			// current version of the episode
+			proposal = &models.Proposal{
+				Kind:                repo.ProposalKindEpisodeRevert,
+				SeriesID:            null.IntFrom(seriesID),
+				SeasonNumber:        null.IntFrom(seasonNumber),
+				EpisodeNumber:       null.IntFrom(episodeNumber),
+				ProposedBy:          contributorID,
+				BaseContributedAt:   null.TimeFrom(episode.ContributedAt),
+				RevertContributedAt: null.TimeFrom(contributedAt),
+			}
+			return proposalCreate(ctx, tx, proposal, cols)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+func episodeAuditRevert(
+	ctx context.Context,
+	tx repo.Service,
+	seriesID, seasonNumber, episodeNumber int,
+	contributedAt time.Time,
+	contributorID int,
+) error {
+	_, cols, err := episodeAuditRevertMap(
+		ctx,
+		tx,
+		seriesID,
+		seasonNumber,
+		episodeNumber,
+		contributedAt,
+	)
+	if err != nil {
+		return err
+	}
+	err = tx.EpisodeUpdate(
+		ctx,
+		seriesID,
+		seasonNumber,
+		episodeNumber,
+		contributorID,
+		cols,
+	)
+	if err == repo.ErrPreconditionFailed {
+		return ErrPreconditionFailed
+	}
+	return err
+}
+
+// episodeAuditRevertMap returns the episode at its number and the columns
+// restored by reverting it to its snapshot audited at contributedAt.
+func episodeAuditRevertMap(
+	ctx context.Context,
+	tx repo.Service,
+	seriesID, seasonNumber, episodeNumber int,
+	contributedAt time.Time,
+) (*models.Film, map[string]any, error) {
+	// only the audits of the current episode at this number can be
+	// reverted to, not the ones of an episode numbered so before
+	episode, err := tx.EpisodeGet(
+		ctx,
+		seriesID,
+		seasonNumber,
+		episodeNumber,
+		true,
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, nil, ErrNotFound
+		}
+		return nil, nil, err
+	}
+	audit, err := tx.EpisodeAuditGet(
+		ctx,
+		episode.ID,
+		seriesID,
+		seasonNumber,
+		episodeNumber,
+		contributedAt,
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, nil, ErrNotFound
+		}
+		return nil, nil, err
+	}
+	cols, err := repo.EpisodeAudits.RevertMap(audit)
+	if err != nil {
+		return nil, nil, err
+	}
+	return episode, cols, nil
+}
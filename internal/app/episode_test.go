@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 	_ "unsafe"
 
 	"github.com/aria3ppp/watch-server/internal/app"
@@ -104,16 +105,18 @@ func TestEpisodeGet(t *testing.T) {
 					seriesID,
 					seasonNumber,
 					episodeNumber,
+					true,
 				).
 				Return(tc.get.exp.episode, tc.get.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			episode, err := app.EpisodeGet(
 				ctx,
 				seriesID,
 				seasonNumber,
 				episodeNumber,
+				true,
 			)
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.episode, episode)
@@ -265,24 +268,25 @@ func TestEpisodesGetAllBySeries(t *testing.T) {
 				Return(tc.tx.exp.err)
 
 			getAllCall := mockRepo.EXPECT().
-				EpisodesGetAllBySeries(ctx, seriesID, offset, limit).
+				EpisodesGetAllBySeries(ctx, seriesID, offset, limit, true).
 				Return(tc.getAllBySeries.exp.episodes, tc.getAllBySeries.exp.err).
 				After(txCall)
 
 			if tc.getAllBySeries.exp.err == nil {
 				mockRepo.EXPECT().
-					EpisodesCountBySeries(ctx, seriesID).
+					EpisodesCountBySeries(ctx, seriesID, true).
 					Return(tc.countBySeries.exp.total, tc.countBySeries.exp.err).
 					After(getAllCall)
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			episodes, total, err := app.EpisodesGetAllBySeries(
 				ctx,
 				seriesID,
 				offset,
 				limit,
+				true,
 			)
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.episodes, episodes)
@@ -436,18 +440,18 @@ func TestEpisodesGetAllBySeason(t *testing.T) {
 				Return(tc.tx.exp.err)
 
 			getAllCall := mockRepo.EXPECT().
-				EpisodesGetAllBySeason(ctx, seriesID, seasonNumber, offset, limit).
+				EpisodesGetAllBySeason(ctx, seriesID, seasonNumber, offset, limit, true).
 				Return(tc.getAllBySeason.exp.episodes, tc.getAllBySeason.exp.err).
 				After(txCall)
 
 			if tc.getAllBySeason.exp.err == nil {
 				mockRepo.EXPECT().
-					EpisodesCountBySeason(ctx, seriesID, seasonNumber).
+					EpisodesCountBySeason(ctx, seriesID, seasonNumber, true).
 					Return(tc.countBySeason.exp.total, tc.countBySeason.exp.err).
 					After(getAllCall)
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			episodes, total, err := app.EpisodesGetAllBySeason(
 				ctx,
@@ -455,6 +459,7 @@ func TestEpisodesGetAllBySeason(t *testing.T) {
 				seasonNumber,
 				offset,
 				limit,
+				true,
 			)
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.episodes, episodes)
@@ -480,8 +485,9 @@ func TestEpisodePut(t *testing.T) {
 		req = &dto.EpisodePutRequest{
 			Title: "episode",
 		}
-		expSeriesGetError  = errors.New("SeriesGet error")
-		expEpisodePutError = errors.New("EpisodePut error")
+		expSeriesGetError    = errors.New("SeriesGet error")
+		expSeasonCreateError = errors.New("SeasonCreateIfNotExists error")
+		expEpisodePutError   = errors.New("EpisodePut error")
 	)
 
 	type TxExp struct {
@@ -497,6 +503,12 @@ func TestEpisodePut(t *testing.T) {
 	type GetSeries struct {
 		exp GetSeriesExp
 	}
+	type CreateSeasonExp struct {
+		err error
+	}
+	type CreateSeason struct {
+		exp CreateSeasonExp
+	}
 	type PutExp struct {
 		err error
 	}
@@ -507,11 +519,12 @@ func TestEpisodePut(t *testing.T) {
 		err error
 	}
 	type TestCase struct {
-		name      string
-		tx        Tx
-		getSeries GetSeries
-		put       Put
-		exp       Exp
+		name         string
+		tx           Tx
+		getSeries    GetSeries
+		createSeason CreateSeason
+		put          Put
+		exp          Exp
 	}
 
 	testCases := []TestCase{
@@ -549,6 +562,26 @@ func TestEpisodePut(t *testing.T) {
 				err: expSeriesGetError,
 			},
 		},
+		{
+			name: "SeasonCreateIfNotExists error",
+			tx: Tx{
+				exp: TxExp{
+					err: expSeasonCreateError,
+				},
+			},
+			getSeries: GetSeries{
+				exp: GetSeriesExp{
+					series: expSeries,
+					err:    nil,
+				},
+			},
+			createSeason: CreateSeason{
+				exp: CreateSeasonExp{err: expSeasonCreateError},
+			},
+			exp: Exp{
+				err: expSeasonCreateError,
+			},
+		},
 		{
 			name: "EpisodePut error",
 			tx: Tx{
@@ -609,32 +642,44 @@ func TestEpisodePut(t *testing.T) {
 				Return(tc.tx.exp.err)
 
 			seriesGetCall := mockRepo.EXPECT().
-				SeriesGet(ctx, seriesID).
+				SeriesGet(ctx, seriesID, true).
 				Return(tc.getSeries.exp.series, tc.getSeries.exp.err).
 				After(txCall)
 
 			if tc.getSeries.exp.err == nil {
-				mockRepo.EXPECT().
-					EpisodePut(
+				seasonCreateCall := mockRepo.EXPECT().
+					SeasonCreateIfNotExists(
 						ctx,
 						seriesID,
 						seasonNumber,
-						episodeNumber,
 						contributorID,
-						&models.Film{
-							Title:        req.Title,
-							Descriptions: req.Descriptions,
-							DateReleased: req.DateReleased,
-							Duration:     req.Duration,
-						},
 					).
-					Return(tc.put.exp.err).
+					Return(tc.createSeason.exp.err).
 					After(seriesGetCall)
+
+				if tc.createSeason.exp.err == nil {
+					mockRepo.EXPECT().
+						EpisodePut(
+							ctx,
+							seriesID,
+							seasonNumber,
+							episodeNumber,
+							contributorID,
+							&models.Film{
+								Title:        req.Title,
+								Descriptions: req.Descriptions,
+								DateReleased: req.DateReleased,
+								Duration:     req.Duration,
+							},
+						).
+						Return(tc.put.exp.err).
+						After(seasonCreateCall)
+				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
-			err := app.EpisodePut(
+			_, err := app.EpisodePut(
 				ctx,
 				seriesID,
 				seasonNumber,
@@ -668,8 +713,9 @@ func TestEpisodesPutAllBySeason(t *testing.T) {
 				},
 			},
 		}
-		expSeriesGetError = errors.New("SeriesGet error")
-		expReplaceError   = errors.New("replace error")
+		expSeriesGetError    = errors.New("SeriesGet error")
+		expSeasonCreateError = errors.New("SeasonCreateIfNotExists error")
+		expReplaceError      = errors.New("replace error")
 	)
 
 	type TxExp struct {
@@ -685,6 +731,12 @@ func TestEpisodesPutAllBySeason(t *testing.T) {
 	type SeriesGet struct {
 		exp SeriesGetExp
 	}
+	type CreateSeasonExp struct {
+		err error
+	}
+	type CreateSeason struct {
+		exp CreateSeasonExp
+	}
 	type ReplaceEpisodesExp struct {
 		err error
 	}
@@ -698,6 +750,7 @@ func TestEpisodesPutAllBySeason(t *testing.T) {
 		name            string
 		tx              Tx
 		serieGet        SeriesGet
+		createSeason    CreateSeason
 		replaceEpisodes ReplaceEpisodes
 		exp             Exp
 	}
@@ -733,6 +786,24 @@ func TestEpisodesPutAllBySeason(t *testing.T) {
 			},
 		},
 
+		{
+			name: "SeasonCreateIfNotExists error",
+			tx: Tx{
+				exp: TxExp{
+					err: expSeasonCreateError,
+				},
+			},
+			serieGet: SeriesGet{
+				exp: SeriesGetExp{series: expSeries},
+			},
+			createSeason: CreateSeason{
+				exp: CreateSeasonExp{err: expSeasonCreateError},
+			},
+			exp: Exp{
+				err: expSeasonCreateError,
+			},
+		},
+
 		{
 			name: "last element replace error",
 			tx: Tx{
@@ -791,11 +862,25 @@ func TestEpisodesPutAllBySeason(t *testing.T) {
 				Return(tc.tx.exp.err)
 
 			prevCall := mockRepo.EXPECT().
-				SeriesGet(ctx, seriesID).
+				SeriesGet(ctx, seriesID, true).
 				Return(tc.serieGet.exp.series, tc.serieGet.exp.err).
 				After(txCall)
 
-			if tc.serieGet.exp.err == nil && len(req.Episodes) > 0 {
+			if tc.serieGet.exp.err == nil {
+				prevCall = mockRepo.EXPECT().
+					SeasonCreateIfNotExists(
+						ctx,
+						seriesID,
+						seasonNumber,
+						contributorID,
+					).
+					Return(tc.createSeason.exp.err).
+					After(prevCall)
+			}
+
+			if tc.serieGet.exp.err == nil &&
+				tc.createSeason.exp.err == nil &&
+				len(req.Episodes) > 0 {
 				for i, expEpisode := range req.Episodes {
 					episodeNumber := i + 1
 					episodePutReq := &models.Film{
@@ -830,9 +915,9 @@ func TestEpisodesPutAllBySeason(t *testing.T) {
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
-			err := app.EpisodesPutAllBySeason(
+			_, err := app.EpisodesPutAllBySeason(
 				ctx,
 				seriesID,
 				seasonNumber,
@@ -932,13 +1017,19 @@ func TestEpisodeUpdate(t *testing.T) {
 			controller := gomock.NewController(t)
 			mockRepo := mock_repo.NewMockRepositoryTx(controller)
 
+			mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
 			mockRepo.EXPECT().
 				EpisodeUpdate(ctx, seriesID, seasonNumber, episodeNumber, contributorID, episodeUpdateRequestToValidMap(req)).
 				Return(tc.update.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
-			err := app.EpisodeUpdate(
+			_, err := app.EpisodeUpdate(
 				ctx,
 				seriesID,
 				seasonNumber,
@@ -1034,7 +1125,7 @@ func TestEpisodeInvalidate(t *testing.T) {
 				EpisodeInvalidate(ctx, seriesID, seasonNumber, episodeNumber, contributorID, req.Invalidation).
 				Return(tc.episodeInvalidate.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.EpisodeInvalidate(
 				ctx,
@@ -1131,7 +1222,7 @@ func TestEpisodesInvalidateAllBySeason(t *testing.T) {
 				EpisodesInvalidateAllBySeason(ctx, seriesID, seasonNumber, contributorID, req.Invalidation).
 				Return(tc.episodesInvalidateAllBySeason.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.EpisodesInvalidateAllBySeason(
 				ctx,
@@ -1357,7 +1448,7 @@ func TestEpisodeAuditsGetAll(t *testing.T) {
 				Return(tc.tx.exp.err)
 
 			seriesGetCall := mockRepo.EXPECT().
-				EpisodeGet(ctx, seriesID, seasonNumber, episodeNumber).
+				EpisodeGet(ctx, seriesID, seasonNumber, episodeNumber, true).
 				Return(tc.episodeGet.exp.episode, tc.episodeGet.exp.err).
 				After(txCall)
 
@@ -1375,7 +1466,7 @@ func TestEpisodeAuditsGetAll(t *testing.T) {
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			audits, total, err := app.EpisodeAuditsGetAll(
 				ctx,
@@ -1390,3 +1481,156 @@ func TestEpisodeAuditsGetAll(t *testing.T) {
 		})
 	}
 }
+
+func TestEpisodeAuditRevert(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id            = 1
+		seriesID      = 1
+		seasonNumber  = 1
+		episodeNumber = 1
+		contributorID = 2
+		contributedAt = time.Date(2022, 10, 19, 12, 0, 0, 0, time.UTC)
+
+		expEpisode = &models.Film{
+			ID:            id,
+			SeriesID:      null.IntFrom(seriesID),
+			SeasonNumber:  null.IntFrom(seasonNumber),
+			EpisodeNumber: null.IntFrom(episodeNumber),
+		}
+		expAudit = &models.FilmsAudit{
+			ID:            id,
+			Title:         "old title",
+			DateReleased:  time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			ContributedBy: 1,
+			ContributedAt: contributedAt,
+		}
+		expUpdate = map[string]any{
+			models.FilmColumns.Title:        expAudit.Title,
+			models.FilmColumns.Descriptions: expAudit.Descriptions,
+			models.FilmColumns.DateReleased: expAudit.DateReleased,
+			models.FilmColumns.Duration:     expAudit.Duration,
+			models.FilmColumns.Invalidation: expAudit.Invalidation,
+		}
+
+		expEpisodeGetError    = errors.New("EpisodeGet error")
+		expEpisodeUpdateError = errors.New("EpisodeUpdate error")
+	)
+
+	type TestCase struct {
+		name        string
+		episodeErr  error
+		auditErr    error
+		updateErr   error
+		expAuditGet bool
+		expUpdate   bool
+		expErr      error
+	}
+
+	testCases := []TestCase{
+		{
+			name:       "episode not found",
+			episodeErr: repo.ErrNoRecord,
+			expErr:     app.ErrNotFound,
+		},
+		{
+			name:       "EpisodeGet error",
+			episodeErr: expEpisodeGetError,
+			expErr:     expEpisodeGetError,
+		},
+		{
+			name:        "audit not found",
+			auditErr:    repo.ErrNoRecord,
+			expAuditGet: true,
+			expErr:      app.ErrNotFound,
+		},
+		{
+			name:        "EpisodeUpdate error",
+			updateErr:   expEpisodeUpdateError,
+			expAuditGet: true,
+			expUpdate:   true,
+			expErr:      expEpisodeUpdateError,
+		},
+		{
+			name:        "ok",
+			expAuditGet: true,
+			expUpdate:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			var txErr error
+			txCall := mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(_ context.Context, _ repo.Service) error) error {
+					txErr = fn(ctx, mockRepo)
+					return txErr
+				})
+
+			var episode *models.Film
+			if tc.episodeErr == nil {
+				episode = expEpisode
+			}
+			episodeGetCall := mockRepo.EXPECT().
+				EpisodeGet(ctx, seriesID, seasonNumber, episodeNumber, true).
+				Return(episode, tc.episodeErr).
+				After(txCall)
+
+			if tc.expAuditGet {
+				var audit *models.FilmsAudit
+				if tc.auditErr == nil {
+					audit = expAudit
+				}
+				auditGetCall := mockRepo.EXPECT().
+					EpisodeAuditGet(
+						ctx,
+						id,
+						seriesID,
+						seasonNumber,
+						episodeNumber,
+						contributedAt,
+					).
+					Return(audit, tc.auditErr).
+					After(episodeGetCall)
+
+				if tc.expUpdate {
+					mockRepo.EXPECT().
+						EpisodeUpdate(
+							ctx,
+							seriesID,
+							seasonNumber,
+							episodeNumber,
+							contributorID,
+							expUpdate,
+						).
+						Return(tc.updateErr).
+						After(auditGetCall)
+				}
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			_, err := app.EpisodeAuditRevert(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+				contributedAt,
+				contributorID,
+			)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expErr, txErr)
+		})
+	}
+}
@@ -5,9 +5,26 @@ import (
 )
 
 var (
-	ErrNotFound          = errors.New("not found")
-	ErrEmailAlreadyUsed  = errors.New("email already used")
-	ErrIncorrectPassword = errors.New("incorrect password")
-	ErrTokenInvalid      = errors.New("token invalid")
-	ErrSameNewPassword   = errors.New("same new password")
+	ErrNotFound            = errors.New("not found")
+	ErrEmailAlreadyUsed    = errors.New("email already used")
+	ErrIncorrectPassword   = errors.New("incorrect password")
+	ErrTokenInvalid        = errors.New("token invalid")
+	ErrUserDeletionPending = errors.New("user deletion pending")
+	ErrSameNewPassword     = errors.New("same new password")
+
+	ErrMediaTooLarge        = errors.New("media too large")
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+
+	ErrForbidden             = errors.New("forbidden")
+	ErrPlaylistOrderMismatch = errors.New("playlist order mismatch")
+
+	ErrFollowSelf    = errors.New("follow self")
+	ErrCursorInvalid = errors.New("cursor invalid")
+
+	ErrProposalReviewed   = errors.New("proposal reviewed")
+	ErrProposalOutdated   = errors.New("proposal outdated")
+	ErrPreconditionFailed = errors.New("precondition failed")
+
+	ErrIdempotencyKeyReused   = errors.New("idempotency key reused")
+	ErrIdempotencyKeyInFlight = errors.New("idempotency key in flight")
 )
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/repo"
+)
+
+// FeedGet returns up to limit activities of the users followed by userID
+// starting after cursor, an empty cursor starts from the newest activity. The
+// returned nextCursor is empty on the last page.
+func (a *Application) FeedGet(
+	ctx context.Context,
+	userID int,
+	cursor string,
+	limit int,
+) (activities []*repo.FeedActivity, nextCursor string, err error) {
+	var before *repo.FeedPosition
+	if cursor != "" {
+		before, err = decodeFeedCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	// fetch an extra activity to know whether there's a next page
+	activities, err = a.repository.FeedGetAll(ctx, userID, before, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(activities) > limit {
+		activities = activities[:limit]
+		last := activities[len(activities)-1]
+		nextCursor = encodeFeedCursor(&repo.FeedPosition{
+			OccurredAt: last.OccurredAt,
+			Key:        last.Key,
+		})
+	}
+	return activities, nextCursor, nil
+}
+
+// feed cursors are opaque to clients: the url-safe base64 encoding of the
+// position time and key separated by a pipe.
+const feedCursorSeparator = "|"
+
+func encodeFeedCursor(position *repo.FeedPosition) string {
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(
+			position.OccurredAt.Format(time.RFC3339Nano) +
+				feedCursorSeparator +
+				position.Key,
+		),
+	)
+}
+
+func decodeFeedCursor(cursor string) (*repo.FeedPosition, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrCursorInvalid
+	}
+	occurredAt, key, found := strings.Cut(string(decoded), feedCursorSeparator)
+	if !found {
+		return nil, ErrCursorInvalid
+	}
+	t, err := time.Parse(time.RFC3339Nano, occurredAt)
+	if err != nil {
+		return nil, ErrCursorInvalid
+	}
+	return &repo.FeedPosition{OccurredAt: t, Key: key}, nil
+}
@@ -0,0 +1,70 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedGet(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		userID = 1
+		limit  = 2
+		now    = time.Date(2000, 1, 1, 0, 0, 0, 123456000, time.UTC)
+
+		activities = []*repo.FeedActivity{
+			{Activity: repo.FeedActivityWatched, OccurredAt: now, Key: "c"},
+			{Activity: repo.FeedActivityFilm, OccurredAt: now, Key: "b"},
+			{Activity: repo.FeedActivitySeries, OccurredAt: now.Add(-time.Hour), Key: "a"},
+		}
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	// invalid cursors
+
+	for _, cursor := range []string{"!", "bm9zZXBhcmF0b3I", "bm90LWEtdGltZXxh"} {
+		_, _, err := application.FeedGet(ctx, userID, cursor, limit)
+		require.Equal(app.ErrCursorInvalid, err)
+	}
+
+	// first page has a next cursor
+
+	mockRepo.EXPECT().
+		FeedGetAll(ctx, userID, nil, limit+1).
+		Return(activities, nil)
+
+	page, nextCursor, err := application.FeedGet(ctx, userID, "", limit)
+	require.NoError(err)
+	require.Equal(activities[:limit], page)
+	require.NotEmpty(nextCursor)
+
+	// the next cursor points at the last activity of the previous page
+
+	mockRepo.EXPECT().
+		FeedGetAll(
+			ctx,
+			userID,
+			&repo.FeedPosition{OccurredAt: now, Key: "b"},
+			limit+1,
+		).
+		Return(activities[limit:], nil)
+
+	page, nextCursor, err = application.FeedGet(ctx, userID, nextCursor, limit)
+	require.NoError(err)
+	require.Equal(activities[limit:], page)
+	require.Empty(nextCursor)
+}
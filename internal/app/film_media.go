@@ -0,0 +1,291 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"net/http"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/logging"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/storage"
+	"github.com/aria3ppp/watch-server/internal/thumbnail"
+	"github.com/volatiletech/null/v8"
+	"go.uber.org/zap"
+)
+
+const (
+	MediaTypeImage = "image"
+	MediaTypeVideo = "video"
+)
+
+func (a *Application) FilmMediaGet(
+	ctx context.Context,
+	filmID, mediaID int,
+) (*models.FilmMediaURL, error) {
+	media, err := a.repository.FilmMediaGet(ctx, filmID, mediaID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return media, nil
+}
+
+func (a *Application) FilmMediaGetAll(
+	ctx context.Context,
+	filmID int,
+	offset, limit int,
+) (media []*models.FilmMediaURL, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the film exists
+			_, err := tx.FilmGet(ctx, filmID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			media, err = tx.FilmMediaGetAllByFilm(ctx, filmID, offset, limit)
+			if err != nil {
+				return err
+			}
+			total, err = tx.FilmMediaCountByFilm(ctx, filmID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return media, total, nil
+}
+
+//------------------------------------------------------------------------------
+
+// FilmMediaUpload sniffs the content type of an uploaded file, checks it
+// against the configured media limits and stores it along with a thumbnail
+// for images. Blobs are stored before the record is inserted and removed again
+// if the insert fails.
+func (a *Application) FilmMediaUpload(
+	ctx context.Context,
+	filmID int,
+	contributorID int,
+	content io.Reader,
+) (mediaID int, err error) {
+	// first check the film exists
+	_, err = a.repository.FilmGet(ctx, filmID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return 0, ErrNotFound
+		}
+		return 0, err
+	}
+
+	mimeType, content, err := sniffMimeType(content)
+	if err != nil {
+		return 0, err
+	}
+
+	mediaType, maxSize := mediaTypeOf(mimeType)
+	if mediaType == "" {
+		return 0, ErrUnsupportedMediaType
+	}
+	limited := &maxSizeReader{r: content, remaining: maxSize}
+
+	media := &models.FilmMediaURL{
+		FilmID:    filmID,
+		MediaType: mediaType,
+		MimeType:  mimeType,
+	}
+
+	media.MediaURL, err = newBlobKey()
+	if err != nil {
+		return 0, err
+	}
+
+	if mediaType == MediaTypeImage {
+		thumbnailKey := media.MediaURL + "_thumb"
+		media.SizeInBytes, err = a.putImage(ctx, media.MediaURL, thumbnailKey, limited)
+		if err != nil {
+			return 0, err
+		}
+		media.ThumbnailURL = null.StringFrom(thumbnailKey)
+	} else {
+		err = a.storage.Put(ctx, media.MediaURL, limited)
+		if err != nil {
+			return 0, err
+		}
+		media.SizeInBytes = maxSize - limited.remaining
+	}
+
+	err = a.repository.FilmMediaCreate(ctx, contributorID, media)
+	if err != nil {
+		a.deleteUnreferencedBlob(ctx, media.MediaURL)
+		if media.ThumbnailURL.Valid {
+			a.deleteUnreferencedBlob(ctx, media.ThumbnailURL.String)
+		}
+		return 0, err
+	}
+
+	return media.ID, nil
+}
+
+// putImage stores an image along with its thumbnail and returns the size of
+// the image. The image is removed again if storing the thumbnail fails.
+func (a *Application) putImage(
+	ctx context.Context,
+	key, thumbnailKey string,
+	content io.Reader,
+) (size int64, err error) {
+	// images are small enough to be held in memory for the thumbnail
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return 0, err
+	}
+	thumb, err := thumbnail.Generate(
+		bytes.NewReader(data),
+		config.Config.Validation.Media.Thumbnail.MaxDimension,
+		config.Config.Validation.Media.Image.MaxPixels,
+	)
+	if err != nil {
+		if err == thumbnail.ErrTooManyPixels {
+			return 0, ErrMediaTooLarge
+		}
+		return 0, ErrUnsupportedMediaType
+	}
+	err = a.storage.Put(ctx, key, bytes.NewReader(data))
+	if err != nil {
+		return 0, err
+	}
+	err = a.storage.Put(ctx, thumbnailKey, bytes.NewReader(thumb))
+	if err != nil {
+		a.deleteUnreferencedBlob(ctx, key)
+		return 0, err
+	}
+	return int64(len(data)), nil
+}
+
+// sniffMimeType detects the content type of content by its first 512 bytes
+// and returns a reader reading content from the start.
+func sniffMimeType(content io.Reader) (mimeType string, _ io.Reader, err error) {
+	buffered := bufio.NewReaderSize(content, 512)
+	head, err := buffered.Peek(512)
+	if err != nil && err != io.EOF {
+		return "", nil, err
+	}
+	return http.DetectContentType(head), buffered, nil
+}
+
+// mediaTypeOf returns the media type and size limit configured for mimeType,
+// or an empty media type if mimeType is not allowed.
+func mediaTypeOf(mimeType string) (mediaType string, maxSize int64) {
+	mediaConfig := config.Config.Validation.Media
+	for _, t := range mediaConfig.Image.MimeTypes {
+		if t == mimeType {
+			return MediaTypeImage, mediaConfig.Image.MaxSizeInBytes
+		}
+	}
+	for _, t := range mediaConfig.Video.MimeTypes {
+		if t == mimeType {
+			return MediaTypeVideo, mediaConfig.Video.MaxSizeInBytes
+		}
+	}
+	return "", 0
+}
+
+func newBlobKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// deleteUnreferencedBlob is a best effort cleanup: a blob referenced by any
+// film media or artist image record or audit row is kept.
+func (a *Application) deleteUnreferencedBlob(ctx context.Context, key string) {
+	referenced, err := a.repository.MediaBlobReferenced(ctx, key)
+	if err != nil {
+		logging.FromContext(ctx).Warn(
+			"app.deleteUnreferencedBlob: checking blob references failed",
+			zap.String("key", key),
+			zap.Error(err),
+		)
+		return
+	}
+	if referenced {
+		return
+	}
+	if err := a.storage.Delete(ctx, key); err != nil {
+		logging.FromContext(ctx).Warn(
+			"app.deleteUnreferencedBlob: deleting blob failed",
+			zap.String("key", key),
+			zap.Error(err),
+		)
+	}
+}
+
+// maxSizeReader fails with ErrMediaTooLarge once more than remaining bytes
+// are read.
+type maxSizeReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (r *maxSizeReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return n, ErrMediaTooLarge
+	}
+	return n, err
+}
+
+//------------------------------------------------------------------------------
+
+// FilmMediaInvalidate marks a media record invalid. Its blobs are kept as the
+// audit rows keep referencing them.
+func (a *Application) FilmMediaInvalidate(
+	ctx context.Context,
+	filmID, mediaID int,
+	contributorID int,
+	req *dto.InvalidationRequest,
+) error {
+	err := a.repository.FilmMediaInvalidate(
+		ctx,
+		filmID,
+		mediaID,
+		contributorID,
+		req.Invalidation,
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (a *Application) MediaBlobGet(
+	ctx context.Context,
+	key string,
+) (io.ReadCloser, error) {
+	blob, err := a.storage.Get(ctx, key)
+	if err != nil {
+		if err == storage.ErrNotFound || err == storage.ErrInvalidKey {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return blob, nil
+}
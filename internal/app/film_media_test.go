@@ -0,0 +1,323 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watch-server/internal/storage"
+	"github.com/aria3ppp/watch-server/internal/storage/mock_storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilmMediaGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		filmID   = 1
+		mediaID  = 1
+		expError = errors.New("error")
+		expMedia = &models.FilmMediaURL{
+			ID:        mediaID,
+			FilmID:    filmID,
+			MediaURL:  "key",
+			MediaType: app.MediaTypeImage,
+		}
+	)
+
+	type GetExp struct {
+		media *models.FilmMediaURL
+		err   error
+	}
+	type Get struct {
+		exp GetExp
+	}
+	type Exp struct {
+		media *models.FilmMediaURL
+		err   error
+	}
+	type TestCase struct {
+		name string
+		get  Get
+		exp  Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "error",
+			get:  Get{exp: GetExp{media: nil, err: expError}},
+			exp:  Exp{media: nil, err: expError},
+		},
+		{
+			name: "not found",
+			get:  Get{exp: GetExp{media: nil, err: repo.ErrNoRecord}},
+			exp:  Exp{media: nil, err: app.ErrNotFound},
+		},
+		{
+			name: "ok",
+			get:  Get{exp: GetExp{media: expMedia, err: nil}},
+			exp:  Exp{media: expMedia, err: nil},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				FilmMediaGet(ctx, filmID, mediaID).
+				Return(tc.get.exp.media, tc.get.exp.err)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			media, err := app.FilmMediaGet(ctx, filmID, mediaID)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.media, media)
+		})
+	}
+}
+
+func TestFilmMediaUpload(t *testing.T) {
+	config.Config.Validation.Media.Image.MaxSizeInBytes = 1 << 20
+	config.Config.Validation.Media.Image.MaxPixels = 16 * 8
+	config.Config.Validation.Media.Image.MimeTypes = []string{"image/png"}
+	config.Config.Validation.Media.Video.MaxSizeInBytes = 64
+	config.Config.Validation.Media.Video.MimeTypes = []string{"video/mp4"}
+	config.Config.Validation.Media.Thumbnail.MaxDimension = 4
+
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		filmID        = 1
+		contributorID = 1
+		expMediaID    = 10
+		expError      = errors.New("error")
+	)
+
+	var pngContent bytes.Buffer
+	err := png.Encode(&pngContent, image.NewRGBA(image.Rect(0, 0, 16, 8)))
+	require.NoError(t, err)
+	var largePNGContent bytes.Buffer
+	err = png.Encode(&largePNGContent, image.NewRGBA(image.Rect(0, 0, 16, 9)))
+	require.NoError(t, err)
+
+	// an mp4 file type box followed by some payload
+	mp4Content := append(
+		[]byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom"),
+		bytes.Repeat([]byte{1}, 16)...,
+	)
+	largeMP4Content := append(mp4Content, bytes.Repeat([]byte{1}, 64)...)
+
+	type TestCase struct {
+		name         string
+		content      []byte
+		filmErr      error
+		expPuts      int
+		putErr       error
+		createErr    error
+		expMediaType string
+		expThumbnail bool
+		expCleanups  int
+		expMediaID   int
+		expErr       error
+	}
+
+	testCases := []TestCase{
+		{
+			name:    "film not found",
+			content: pngContent.Bytes(),
+			filmErr: repo.ErrNoRecord,
+			expErr:  app.ErrNotFound,
+		},
+		{
+			name:    "unsupported media type",
+			content: []byte("plain text"),
+			expErr:  app.ErrUnsupportedMediaType,
+		},
+		{
+			name:    "media too large",
+			content: largeMP4Content,
+			expPuts: 1,
+			expErr:  app.ErrMediaTooLarge,
+		},
+		{
+			name:    "image of too many pixels",
+			content: largePNGContent.Bytes(),
+			expErr:  app.ErrMediaTooLarge,
+		},
+		{
+			name:    "storage error",
+			content: pngContent.Bytes(),
+			expPuts: 1,
+			putErr:  expError,
+			expErr:  expError,
+		},
+		{
+			name:         "FilmMediaCreate error",
+			content:      pngContent.Bytes(),
+			expPuts:      2,
+			createErr:    expError,
+			expMediaType: app.MediaTypeImage,
+			expThumbnail: true,
+			expCleanups:  2,
+			expErr:       expError,
+		},
+		{
+			name:         "ok image",
+			content:      pngContent.Bytes(),
+			expPuts:      2,
+			expMediaType: app.MediaTypeImage,
+			expThumbnail: true,
+			expMediaID:   expMediaID,
+		},
+		{
+			name:         "ok video",
+			content:      mp4Content,
+			expPuts:      1,
+			expMediaType: app.MediaTypeVideo,
+			expMediaID:   expMediaID,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+			mockStorage := mock_storage.NewMockService(controller)
+
+			mockRepo.EXPECT().
+				FilmGet(ctx, filmID).
+				Return(&models.Film{ID: filmID}, tc.filmErr)
+
+			stored := make(map[string][]byte)
+			mockStorage.EXPECT().
+				Put(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, key string, content io.Reader) error {
+					data, err := io.ReadAll(content)
+					if err != nil {
+						return err
+					}
+					if tc.putErr != nil {
+						return tc.putErr
+					}
+					stored[key] = data
+					return nil
+				}).
+				Times(tc.expPuts)
+
+			if tc.expMediaType != "" {
+				mockRepo.EXPECT().
+					FilmMediaCreate(ctx, contributorID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int, media *models.FilmMediaURL) error {
+						require.Equal(filmID, media.FilmID)
+						require.Equal(tc.expMediaType, media.MediaType)
+						require.Equal(tc.content, stored[media.MediaURL])
+						require.Equal(int64(len(tc.content)), media.SizeInBytes)
+						require.Equal(tc.expThumbnail, media.ThumbnailURL.Valid)
+						if tc.expThumbnail {
+							thumb, err := jpeg.DecodeConfig(
+								bytes.NewReader(stored[media.ThumbnailURL.String]),
+							)
+							require.NoError(err)
+							require.Equal(4, thumb.Width)
+							require.Equal(2, thumb.Height)
+						}
+						media.ID = tc.expMediaID
+						return tc.createErr
+					})
+			}
+
+			mockRepo.EXPECT().
+				MediaBlobReferenced(ctx, gomock.Any()).
+				Return(false, nil).
+				Times(tc.expCleanups)
+			mockStorage.EXPECT().
+				Delete(ctx, gomock.Any()).
+				Return(nil).
+				Times(tc.expCleanups)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, mockStorage, nil)
+
+			mediaID, err := app.FilmMediaUpload(
+				ctx,
+				filmID,
+				contributorID,
+				bytes.NewReader(tc.content),
+			)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expMediaID, mediaID)
+		})
+	}
+}
+
+func TestMediaBlobGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		key      = "key"
+		expError = errors.New("error")
+		expBlob  = io.NopCloser(bytes.NewReader([]byte("blob")))
+	)
+
+	type TestCase struct {
+		name    string
+		getBlob io.ReadCloser
+		getErr  error
+		expBlob io.ReadCloser
+		expErr  error
+	}
+
+	testCases := []TestCase{
+		{name: "error", getErr: expError, expErr: expError},
+		{name: "not found", getErr: storage.ErrNotFound, expErr: app.ErrNotFound},
+		{name: "invalid key", getErr: storage.ErrInvalidKey, expErr: app.ErrNotFound},
+		{name: "ok", getBlob: expBlob, expBlob: expBlob},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockStorage := mock_storage.NewMockService(controller)
+
+			mockStorage.EXPECT().
+				Get(ctx, key).
+				Return(tc.getBlob, tc.getErr)
+
+			app := app.NewApplication(nil, nil, nil, nil, mockStorage, nil)
+
+			blob, err := app.MediaBlobGet(ctx, key)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expBlob, blob)
+		})
+	}
+}
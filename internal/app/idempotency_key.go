@@ -0,0 +1,120 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+)
+
+// IdempotencyKeyBegin reserves the key of a user for the request hashed to
+// requestHash. It returns the key holding the response to replay if the
+// request was answered already, nil if the request is to be answered now and
+// its response stored by IdempotencyKeyEnd.
+func (a *Application) IdempotencyKeyBegin(
+	ctx context.Context,
+	userID int,
+	key string,
+	requestHash string,
+) (*models.IdempotencyKey, error) {
+	reserved, err := a.repository.IdempotencyKeyReserve(
+		ctx,
+		userID,
+		key,
+		requestHash,
+		idempotencyKeysExpiredBefore(),
+		idempotencyKeyLeaseExpiredBefore(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	stored, err := a.repository.IdempotencyKeyGet(ctx, userID, key)
+	if err != nil {
+		// released by the request it was reserved for in the meantime
+		if err == repo.ErrNoRecord {
+			return nil, ErrIdempotencyKeyInFlight
+		}
+		return nil, err
+	}
+	if stored.RequestHash != requestHash {
+		return nil, ErrIdempotencyKeyReused
+	}
+	if !stored.ResponseStatus.Valid {
+		return nil, ErrIdempotencyKeyInFlight
+	}
+	return stored, nil
+}
+
+// IdempotencyKeyEnd stores the response to the request the key of a user was
+// reserved for by IdempotencyKeyBegin.
+func (a *Application) IdempotencyKeyEnd(
+	ctx context.Context,
+	userID int,
+	key string,
+	responseStatus int,
+	responseBody string,
+) error {
+	err := a.repository.IdempotencyKeyComplete(
+		ctx,
+		userID,
+		key,
+		responseStatus,
+		responseBody,
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// IdempotencyKeyRelease frees the key of a user reserved for a request that
+// failed, so that its retries are answered anew.
+func (a *Application) IdempotencyKeyRelease(
+	ctx context.Context,
+	userID int,
+	key string,
+) error {
+	err := a.repository.IdempotencyKeyDelete(ctx, userID, key)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// IdempotencyKeysPrune deletes the expired keys and returns how many were.
+func (a *Application) IdempotencyKeysPrune(ctx context.Context) (int, error) {
+	return a.repository.IdempotencyKeysPrune(
+		ctx,
+		idempotencyKeysExpiredBefore(),
+	)
+}
+
+// idempotencyKeysExpiredBefore returns the time the keys created before have
+// expired
+func idempotencyKeysExpiredBefore() time.Time {
+	return time.Now().Add(
+		-time.Hour * time.Duration(config.Config.Idempotency.TTLInHours),
+	)
+}
+
+// idempotencyKeyLeaseExpiredBefore returns the time the keys reserved before
+// and still in flight have outlived the request they were reserved for, that
+// can't run past the handler timeout
+func idempotencyKeyLeaseExpiredBefore() time.Time {
+	return time.Now().Add(
+		-time.Second *
+			time.Duration(config.Config.Servic.Server.HandlerTimeoutInSeconds),
+	)
+}
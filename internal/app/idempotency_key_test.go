@@ -0,0 +1,167 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestIdempotencyKeyBegin(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		userID      = 1
+		key         = "key"
+		requestHash = "hash"
+		answered    = &models.IdempotencyKey{
+			UserID:         userID,
+			Key:            key,
+			RequestHash:    requestHash,
+			ResponseStatus: null.IntFrom(http.StatusOK),
+			ResponseBody:   null.StringFrom(`{"status":"OK"}`),
+		}
+		expError = errors.New("error")
+	)
+
+	type ReserveExp struct {
+		reserved bool
+		err      error
+	}
+	type GetExp struct {
+		idempotencyKey *models.IdempotencyKey
+		err            error
+	}
+	type Exp struct {
+		idempotencyKey *models.IdempotencyKey
+		err            error
+	}
+	type TestCase struct {
+		name    string
+		reserve ReserveExp
+		get     *GetExp
+		exp     Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name:    "reserve error",
+			reserve: ReserveExp{err: expError},
+			exp:     Exp{err: expError},
+		},
+
+		{
+			name:    "reserved",
+			reserve: ReserveExp{reserved: true},
+			exp:     Exp{},
+		},
+
+		{
+			name: "released in the meantime",
+			get:  &GetExp{err: repo.ErrNoRecord},
+			exp:  Exp{err: app.ErrIdempotencyKeyInFlight},
+		},
+
+		{
+			name: "reused",
+			get: &GetExp{
+				idempotencyKey: &models.IdempotencyKey{
+					UserID:      userID,
+					Key:         key,
+					RequestHash: "other hash",
+				},
+			},
+			exp: Exp{err: app.ErrIdempotencyKeyReused},
+		},
+
+		{
+			name: "in flight",
+			get: &GetExp{
+				idempotencyKey: &models.IdempotencyKey{
+					UserID:      userID,
+					Key:         key,
+					RequestHash: requestHash,
+				},
+			},
+			exp: Exp{err: app.ErrIdempotencyKeyInFlight},
+		},
+
+		{
+			name: "answered",
+			get:  &GetExp{idempotencyKey: answered},
+			exp:  Exp{idempotencyKey: answered},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				IdempotencyKeyReserve(ctx, userID, key, requestHash, gomock.Any(), gomock.Any()).
+				Return(tc.reserve.reserved, tc.reserve.err)
+			if tc.get != nil {
+				mockRepo.EXPECT().
+					IdempotencyKeyGet(ctx, userID, key).
+					Return(tc.get.idempotencyKey, tc.get.err)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			idempotencyKey, err := app.IdempotencyKeyBegin(
+				ctx,
+				userID,
+				key,
+				requestHash,
+			)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.idempotencyKey, idempotencyKey)
+		})
+	}
+}
+
+func TestIdempotencyKeyBeginLease(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	ctx := context.Background()
+	timeout := time.Second *
+		time.Duration(config.Config.Servic.Server.HandlerTimeoutInSeconds)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	// a key in flight is taken over once its request outlived the handler
+	// timeout
+	before := time.Now()
+	mockRepo.EXPECT().
+		IdempotencyKeyReserve(ctx, 1, "key", "hash", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, _, _ string, _, leaseExpiredBefore time.Time) (bool, error) {
+			require.False(leaseExpiredBefore.Before(before.Add(-timeout)))
+			require.False(leaseExpiredBefore.After(time.Now().Add(-timeout)))
+			return true, nil
+		})
+
+	app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	idempotencyKey, err := app.IdempotencyKeyBegin(ctx, 1, "key", "hash")
+	require.NoError(err)
+	require.Nil(idempotencyKey)
+}
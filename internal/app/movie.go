@@ -2,17 +2,20 @@ package app
 
 import (
 	"context"
+	"time"
 
 	"github.com/aria3ppp/watch-server/internal/dto"
 	"github.com/aria3ppp/watch-server/internal/models"
 	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/volatiletech/null/v8"
 )
 
 func (a *Application) MovieGet(
 	ctx context.Context,
 	id int,
+	includeInvalidated bool,
 ) (*models.Film, error) {
-	movie, err := a.repository.MovieGet(ctx, id)
+	movie, err := a.repository.MovieGet(ctx, id, includeInvalidated)
 	if err != nil {
 		if err == repo.ErrNoRecord {
 			return nil, ErrNotFound
@@ -24,17 +27,27 @@ func (a *Application) MovieGet(
 
 func (a *Application) MoviesGetAll(
 	ctx context.Context,
+	filter repo.ListFilter,
+	sort repo.ListSort,
 	offset, limit int,
+	includeInvalidated bool,
 ) (movies []*models.Film, total int, err error) {
 	err = a.repository.Transaction(
 		ctx,
 		func(ctx context.Context, tx repo.Service) error {
 			var err error
-			movies, err = tx.MoviesGetAll(ctx, offset, limit)
+			movies, err = tx.MoviesGetAll(
+				ctx,
+				filter,
+				sort,
+				offset,
+				limit,
+				includeInvalidated,
+			)
 			if err != nil {
 				return err
 			}
-			total, err = tx.MoviesCount(ctx)
+			total, err = tx.MoviesCount(ctx, filter, includeInvalidated)
 			return err
 		},
 	)
@@ -44,6 +57,39 @@ func (a *Application) MoviesGetAll(
 	return movies, total, nil
 }
 
+// MoviesGetPage is the cursor paginated counterpart of MoviesGetAll, an empty
+// cursor refers to the first page. The returned cursors are empty at the ends
+// of the list. The cursors page the list in the sort they were made with.
+func (a *Application) MoviesGetPage(
+	ctx context.Context,
+	filter repo.ListFilter,
+	sort repo.ListSort,
+	cursor string,
+	limit int,
+	includeInvalidated bool,
+) (movies []*models.Film, prevCursor, nextCursor string, err error) {
+	movieKeyset, targets := sortKeyset[*models.Film](
+		sort,
+		models.FilmColumns.ID,
+	)
+	page, err := decodePage(cursor, limit, targets...)
+	if err != nil {
+		return nil, "", "", err
+	}
+	movies, err = a.repository.MoviesGetPage(
+		ctx,
+		filter,
+		sort,
+		page,
+		includeInvalidated,
+	)
+	if err != nil {
+		return nil, "", "", err
+	}
+	movies, prevCursor, nextCursor = pageRows(movies, page, movieKeyset)
+	return movies, prevCursor, nextCursor, nil
+}
+
 func (a *Application) MovieCreate(
 	ctx context.Context,
 	contributorID int,
@@ -64,19 +110,68 @@ func (a *Application) MovieCreate(
 	return insertMovie.ID, nil
 }
 
+// MovieUpdate applies the update right away if contributorID is trusted or
+// queues it as a proposal for the moderators otherwise.
 func (a *Application) MovieUpdate(
 	ctx context.Context,
 	id int,
 	contributorID int,
 	req *dto.MovieUpdateRequest,
+) (proposal *models.Proposal, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			trusted, err := contributorTrusted(ctx, tx, contributorID)
+			if err != nil {
+				return err
+			}
+			if trusted {
+				return movieUpdate(ctx, tx, id, contributorID, req)
+			}
+			// check the movie exists, and is still at the version the
+			// update was made against if any, before proposing
+			movie, err := tx.MovieGet(ctx, id, true)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			if !repo.IfMatch(ctx, movie.ContributedAt) {
+				return ErrPreconditionFailed
+			}
+			proposal = &models.Proposal{
+				Kind:              repo.ProposalKindMovieUpdate,
+				FilmID:            null.IntFrom(id),
+				ProposedBy:        contributorID,
+				BaseContributedAt: null.TimeFrom(movie.ContributedAt),
+			}
+			return proposalCreate(ctx, tx, proposal, req)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+func movieUpdate(
+	ctx context.Context,
+	tx repo.Service,
+	id int,
+	contributorID int,
+	req *dto.MovieUpdateRequest,
 ) error {
 	columns := movieUpdateRequestToValidMap(req)
 
-	err := a.repository.MovieUpdate(ctx, id, contributorID, columns)
+	err := tx.MovieUpdate(ctx, id, contributorID, columns)
 	if err != nil {
 		if err == repo.ErrNoRecord {
 			return ErrNotFound
 		}
+		if err == repo.ErrPreconditionFailed {
+			return ErrPreconditionFailed
+		}
 		return err
 	}
 
@@ -118,11 +213,39 @@ func (a *Application) MovieInvalidate(
 		if err == repo.ErrNoRecord {
 			return ErrNotFound
 		}
+		if err == repo.ErrPreconditionFailed {
+			return ErrPreconditionFailed
+		}
 		return err
 	}
 	return nil
 }
 
+// MovieRestore clears the invalidation of a movie, restoring a movie that is
+// not invalidated is a no-op.
+func (a *Application) MovieRestore(
+	ctx context.Context,
+	id int,
+	contributorID int,
+) error {
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			movie, err := tx.MovieGet(ctx, id, true)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			if !movie.Invalidation.Valid {
+				return nil
+			}
+			return tx.MovieRestore(ctx, id, contributorID)
+		},
+	)
+}
+
 func (a *Application) MovieAuditsGetAll(
 	ctx context.Context,
 	id int,
@@ -132,7 +255,7 @@ func (a *Application) MovieAuditsGetAll(
 		ctx,
 		func(ctx context.Context, tx repo.Service) error {
 			// first check the movie exists
-			_, err := tx.MovieGet(ctx, id)
+			_, err := tx.MovieGet(ctx, id, true)
 			if err != nil {
 				if err == repo.ErrNoRecord {
 					return ErrNotFound
@@ -155,10 +278,255 @@ func (a *Application) MovieAuditsGetAll(
 	return audits, total, nil
 }
 
+func (a *Application) MovieAuditsGetPage(
+	ctx context.Context,
+	id int,
+	cursor string,
+	limit int,
+) (audits []*models.FilmsAudit, prevCursor, nextCursor string, err error) {
+	page, err := decodePage(cursor, limit, new(time.Time), new(int))
+	if err != nil {
+		return nil, "", "", err
+	}
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, id, true)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			audits, err = tx.MovieAuditsGetPage(ctx, id, page)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, "", "", err
+	}
+	audits, prevCursor, nextCursor = pageRows(audits, page, filmsAuditKeyset)
+	return audits, prevCursor, nextCursor, nil
+}
+
+// MovieAuditRevert restores the movie snapshot audited at contributedAt as a
+// new contribution of contributorID right away if they're trusted or queues
+// the revert as a proposal for the moderators otherwise.
+func (a *Application) MovieAuditRevert(
+	ctx context.Context,
+	id int,
+	contributedAt time.Time,
+	contributorID int,
+) (proposal *models.Proposal, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			trusted, err := contributorTrusted(ctx, tx, contributorID)
+			if err != nil {
+				return err
+			}
+			if trusted {
+				return movieAuditRevert(ctx, tx, id, contributedAt, contributorID)
+			}
+			movie, err := tx.MovieGet(ctx, id, true)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			cols, err := movieAuditRevertMap(ctx, tx, id, contributedAt)
+			if err != nil {
+				return err
+			}
+			// the proposal shows the restored columns and is based on the
+			// current version of the movie
+			proposal = &models.Proposal{
+				Kind:                repo.ProposalKindMovieRevert,
+				FilmID:              null.IntFrom(id),
+				ProposedBy:          contributorID,
+				BaseContributedAt:   null.TimeFrom(movie.ContributedAt),
+				RevertContributedAt: null.TimeFrom(contributedAt),
+			}
+			return proposalCreate(ctx, tx, proposal, cols)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+func movieAuditRevert(
+	ctx context.Context,
+	tx repo.Service,
+	id int,
+	contributedAt time.Time,
+	contributorID int,
+) error {
+	cols, err := movieAuditRevertMap(ctx, tx, id, contributedAt)
+	if err != nil {
+		return err
+	}
+	err = tx.MovieUpdate(ctx, id, contributorID, cols)
+	if err == repo.ErrPreconditionFailed {
+		return ErrPreconditionFailed
+	}
+	return err
+}
+
+// movieAuditRevertMap returns the columns restored by reverting the movie to
+// its snapshot audited at contributedAt.
+func movieAuditRevertMap(
+	ctx context.Context,
+	tx repo.Service,
+	id int,
+	contributedAt time.Time,
+) (map[string]any, error) {
+	audit, err := tx.MovieAuditGet(ctx, id, contributedAt)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return repo.MovieAudits.RevertMap(audit)
+}
+
+// MovieAuditDiff compares two revisions of a movie by their contribution
+// times, the current movie being its latest revision.
+func (a *Application) MovieAuditDiff(
+	ctx context.Context,
+	id int,
+	from, to time.Time,
+) (diff *repo.AuditDiff, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			fromRevision, err := movieRevisionGet(ctx, tx, id, from)
+			if err != nil {
+				return err
+			}
+			toRevision, err := movieRevisionGet(ctx, tx, id, to)
+			if err != nil {
+				return err
+			}
+			diff, err = repo.MovieAudits.Diff(fromRevision, toRevision)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// MovieAuditDiffsGetAll diffs each revision of a movie, newest first, against
+// its predecessor.
+func (a *Application) MovieAuditDiffsGetAll(
+	ctx context.Context,
+	id int,
+	offset, limit int,
+) (diffs []*repo.AuditDiff, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			movie, err := tx.MovieGet(ctx, id, true)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			auditsOffset, auditsLimit := auditRevisionsRange(offset, limit)
+			audits, err := tx.MovieAuditsGetAll(ctx, id, auditsOffset, auditsLimit)
+			if err != nil {
+				return err
+			}
+			revisions := make([]any, 0, len(audits)+1)
+			if offset == 0 {
+				revisions = append(revisions, movie)
+			}
+			for _, audit := range audits {
+				revisions = append(revisions, audit)
+			}
+			diffs, err = repo.MovieAudits.DiffAll(revisions)
+			if err != nil {
+				return err
+			}
+			// every audit is the predecessor of exactly one revision
+			total, err = tx.MovieAuditsCount(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return diffs, total, nil
+}
+
+// movieRevisionGet returns the movie row if it was contributed at contributedAt
+// or the audit contributed then otherwise.
+func movieRevisionGet(
+	ctx context.Context,
+	tx repo.Service,
+	id int,
+	contributedAt time.Time,
+) (any, error) {
+	movie, err := tx.MovieGet(ctx, id, true)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if movie.ContributedAt.Equal(contributedAt) {
+		return movie, nil
+	}
+	audit, err := tx.MovieAuditGet(ctx, id, contributedAt)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return audit, nil
+}
+
 func (a *Application) MoviesSearch(
 	ctx context.Context,
 	req *dto.SearchRequest,
 	offset, limit int,
 ) (results []*models.Film, total int, err error) {
-	panic("not implemented")
+	results, total, err = a.search.SearchMovies(ctx, req.Query, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	return results, total, nil
+}
+
+// MoviesSearchPage is the cursor paginated counterpart of MoviesSearch,
+// keyed by the relevance and then the id of the results. The results aren't
+// counted.
+func (a *Application) MoviesSearchPage(
+	ctx context.Context,
+	req *dto.SearchRequest,
+	cursor string,
+	limit int,
+) (results []*models.Film, prevCursor, nextCursor string, err error) {
+	page, err := decodePage(cursor, limit, new(float64), new(string))
+	if err != nil {
+		return nil, "", "", err
+	}
+	hits, err := a.search.SearchMoviesPage(ctx, req.Query, page)
+	if err != nil {
+		return nil, "", "", err
+	}
+	hits, prevCursor, nextCursor = pageRows(hits, page, movieHitKeyset)
+	results = make([]*models.Film, len(hits))
+	for i, hit := range hits {
+		results[i] = hit.Movie
+	}
+	return results, prevCursor, nextCursor, nil
 }
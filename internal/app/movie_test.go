@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 	_ "unsafe"
 
 	"github.com/aria3ppp/watch-server/internal/app"
@@ -11,6 +12,8 @@ import (
 	"github.com/aria3ppp/watch-server/internal/models"
 	"github.com/aria3ppp/watch-server/internal/repo"
 	"github.com/aria3ppp/watch-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watch-server/internal/search"
+	"github.com/aria3ppp/watch-server/internal/search/mock_search"
 	"github.com/aria3ppp/watch-server/internal/testutils"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -97,12 +100,12 @@ func TestMovieGet(t *testing.T) {
 			mockRepo := mock_repo.NewMockRepositoryTx(controller)
 
 			mockRepo.EXPECT().
-				MovieGet(ctx, id).
+				MovieGet(ctx, id, true).
 				Return(tc.get.exp.movie, tc.get.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
-			movie, err := app.MovieGet(ctx, id)
+			movie, err := app.MovieGet(ctx, id, true)
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.movie, movie)
 		})
@@ -117,6 +120,10 @@ func TestMoviesGetAll(t *testing.T) {
 
 		offset = 0
 		limit  = 50
+		filter = repo.ListFilter{
+			{Column: models.FilmColumns.Title, Operator: repo.FilterContains, Value: "title"},
+		}
+		sort = repo.ListSort{{Column: models.FilmColumns.Title, Desc: true}}
 
 		expMovies            = []*models.Film{{Title: "movie"}}
 		expTotal             = 1000
@@ -248,20 +255,20 @@ func TestMoviesGetAll(t *testing.T) {
 				Return(tc.tx.exp.err)
 
 			getAllCall := mockRepo.EXPECT().
-				MoviesGetAll(ctx, offset, limit).
+				MoviesGetAll(ctx, filter, sort, offset, limit, true).
 				Return(tc.getAll.exp.movies, tc.getAll.exp.err).
 				After(txCall)
 
 			if tc.getAll.exp.err == nil {
 				mockRepo.EXPECT().
-					MoviesCount(ctx).
+					MoviesCount(ctx, filter, true).
 					Return(tc.count.exp.total, tc.count.exp.err).
 					After(getAllCall)
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
-			movies, total, err := app.MoviesGetAll(ctx, offset, limit)
+			movies, total, err := app.MoviesGetAll(ctx, filter, sort, offset, limit, true)
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.movies, movies)
 			require.Equal(tc.exp.total, total)
@@ -269,6 +276,200 @@ func TestMoviesGetAll(t *testing.T) {
 	}
 }
 
+func TestMoviesGetPage(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx   = context.Background()
+		limit = 2
+
+		movies = []*models.Film{{ID: 1}, {ID: 2}, {ID: 3}}
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	// invalid cursors
+
+	for _, cursor := range []string{"!", "bm90LWpzb24", "eyJrIjpbXX0", "eyJrIjpbIngiXX0"} {
+		_, _, _, err := application.MoviesGetPage(ctx, nil, nil, cursor, limit, true)
+		require.Equal(app.ErrCursorInvalid, err)
+	}
+
+	// first page has only a next cursor
+
+	mockRepo.EXPECT().
+		MoviesGetPage(ctx, nil, nil, &repo.KeysetPage{Limit: limit + 1}, true).
+		Return(movies, nil)
+
+	page, prevCursor, nextCursor, err := application.MoviesGetPage(ctx, nil, nil, "", limit, true)
+	require.NoError(err)
+	require.Equal(movies[:limit], page)
+	require.Empty(prevCursor)
+	require.NotEmpty(nextCursor)
+
+	// the next page is after the last movie of the first page
+
+	mockRepo.EXPECT().
+		MoviesGetPage(
+			ctx,
+			nil,
+			nil,
+			&repo.KeysetPage{After: []any{2}, Limit: limit + 1},
+			true,
+		).
+		Return(movies[limit:], nil)
+
+	page, prevCursor, nextCursor, err = application.MoviesGetPage(ctx, nil, nil, nextCursor, limit, true)
+	require.NoError(err)
+	require.Equal(movies[limit:], page)
+	require.NotEmpty(prevCursor)
+	require.Empty(nextCursor)
+
+	// the previous page is before the first movie of the last page
+
+	mockRepo.EXPECT().
+		MoviesGetPage(
+			ctx,
+			nil,
+			nil,
+			&repo.KeysetPage{After: []any{3}, Backward: true, Limit: limit + 1},
+			true,
+		).
+		Return(movies[:limit], nil)
+
+	page, prevCursor, nextCursor, err = application.MoviesGetPage(ctx, nil, nil, prevCursor, limit, true)
+	require.NoError(err)
+	require.Equal(movies[:limit], page)
+	require.Empty(prevCursor)
+	require.NotEmpty(nextCursor)
+
+	// sorted pages are keyed by the sort columns and then the id, missing
+	// values included
+
+	sort := repo.ListSort{{Column: models.FilmColumns.Duration, Desc: true}}
+	sorted := []*models.Film{
+		{ID: 2, Duration: null.IntFrom(90)},
+		{ID: 1},
+		{ID: 3},
+	}
+
+	mockRepo.EXPECT().
+		MoviesGetPage(ctx, nil, sort, &repo.KeysetPage{Limit: limit + 1}, true).
+		Return(sorted, nil)
+
+	page, _, nextCursor, err = application.MoviesGetPage(ctx, nil, sort, "", limit, true)
+	require.NoError(err)
+	require.Equal(sorted[:limit], page)
+
+	mockRepo.EXPECT().
+		MoviesGetPage(
+			ctx,
+			nil,
+			sort,
+			&repo.KeysetPage{After: []any{null.Int{}, 1}, Limit: limit + 1},
+			true,
+		).
+		Return(sorted[limit:], nil)
+
+	page, prevCursor, _, err = application.MoviesGetPage(ctx, nil, sort, nextCursor, limit, true)
+	require.NoError(err)
+	require.Equal(sorted[limit:], page)
+
+	mockRepo.EXPECT().
+		MoviesGetPage(
+			ctx,
+			nil,
+			sort,
+			&repo.KeysetPage{After: []any{null.Int{}, 3}, Backward: true, Limit: limit + 1},
+			true,
+		).
+		Return(sorted[:limit], nil)
+
+	page, _, _, err = application.MoviesGetPage(ctx, nil, sort, prevCursor, limit, true)
+	require.NoError(err)
+	require.Equal(sorted[:limit], page)
+
+	// a cursor of another sort is invalid
+
+	_, _, _, err = application.MoviesGetPage(ctx, nil, nil, nextCursor, limit, true)
+	require.Equal(app.ErrCursorInvalid, err)
+}
+
+func TestMoviesSearchPage(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx   = context.Background()
+		req   = &dto.SearchRequest{Query: "query"}
+		limit = 2
+
+		hits = []*search.MovieHit{
+			{Movie: &models.Film{ID: 3}, Rank: search.Rank{Score: 2.5, ID: "3"}},
+			{Movie: &models.Film{ID: 1}, Rank: search.Rank{Score: 1.5, ID: "1"}},
+			{Movie: &models.Film{ID: 2}, Rank: search.Rank{Score: 1.5, ID: "2"}},
+		}
+		movies = []*models.Film{hits[0].Movie, hits[1].Movie, hits[2].Movie}
+	)
+
+	controller := gomock.NewController(t)
+	mockSearch := mock_search.NewMockService(controller)
+
+	application := app.NewApplication(nil, nil, mockSearch, nil, nil, nil)
+
+	// invalid cursor
+
+	_, _, _, err := application.MoviesSearchPage(ctx, req, "eyJrIjpbMV19", limit)
+	require.Equal(app.ErrCursorInvalid, err)
+
+	// first page has only a next cursor
+
+	mockSearch.EXPECT().
+		SearchMoviesPage(ctx, req.Query, &repo.KeysetPage{Limit: limit + 1}).
+		Return(hits, nil)
+
+	page, prevCursor, nextCursor, err := application.MoviesSearchPage(ctx, req, "", limit)
+	require.NoError(err)
+	require.Equal(movies[:limit], page)
+	require.Empty(prevCursor)
+	require.NotEmpty(nextCursor)
+
+	// the next page is past the score and id of the last movie of the first
+	// page
+
+	mockSearch.EXPECT().
+		SearchMoviesPage(
+			ctx,
+			req.Query,
+			&repo.KeysetPage{After: []any{1.5, "1"}, Limit: limit + 1},
+		).
+		Return(hits[limit:], nil)
+
+	page, prevCursor, nextCursor, err = application.MoviesSearchPage(ctx, req, nextCursor, limit)
+	require.NoError(err)
+	require.Equal(movies[limit:], page)
+	require.NotEmpty(prevCursor)
+	require.Empty(nextCursor)
+
+	// the previous page is before the first movie of the last page
+
+	mockSearch.EXPECT().
+		SearchMoviesPage(
+			ctx,
+			req.Query,
+			&repo.KeysetPage{After: []any{1.5, "2"}, Backward: true, Limit: limit + 1},
+		).
+		Return(hits[:limit], nil)
+
+	page, prevCursor, nextCursor, err = application.MoviesSearchPage(ctx, req, prevCursor, limit)
+	require.NoError(err)
+	require.Equal(movies[:limit], page)
+	require.Empty(prevCursor)
+	require.NotEmpty(nextCursor)
+}
+
 func TestMovieCreate(t *testing.T) {
 	t.Parallel()
 
@@ -348,7 +549,7 @@ func TestMovieCreate(t *testing.T) {
 				}).
 				Return(tc.create.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			id, err := app.MovieCreate(ctx, contributorID, req)
 			require.Equal(tc.exp.err, err)
@@ -421,6 +622,18 @@ func TestMovieUpdate(t *testing.T) {
 			},
 		},
 
+		{
+			name: "precondition failed",
+			update: Update{
+				exp: UpdateExp{
+					err: repo.ErrPreconditionFailed,
+				},
+			},
+			exp: Exp{
+				err: app.ErrPreconditionFailed,
+			},
+		},
+
 		{
 			name: "ok",
 			update: Update{
@@ -443,13 +656,19 @@ func TestMovieUpdate(t *testing.T) {
 			controller := gomock.NewController(t)
 			mockRepo := mock_repo.NewMockRepositoryTx(controller)
 
+			mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
 			mockRepo.EXPECT().
 				MovieUpdate(ctx, id, contributorID, movieUpdateRequestToValidMap(req)).
 				Return(tc.update.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
-			err := app.MovieUpdate(ctx, id, contributorID, req)
+			_, err := app.MovieUpdate(ctx, id, contributorID, req)
 			require.Equal(tc.exp.err, err)
 		})
 	}
@@ -536,7 +755,7 @@ func TestMovieInvalidate(t *testing.T) {
 				MovieInvalidate(ctx, id, contributorID, req.Invalidation).
 				Return(tc.movieInvalidate.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.MovieInvalidate(ctx, id, contributorID, req)
 			require.Equal(tc.exp.err, err)
@@ -754,7 +973,7 @@ func TestMovieAuditsGetAll(t *testing.T) {
 				Return(tc.tx.exp.err)
 
 			movieGetCall := mockRepo.EXPECT().
-				MovieGet(ctx, id).
+				MovieGet(ctx, id, true).
 				Return(tc.movieGet.exp.movie, tc.movieGet.exp.err).
 				After(txCall)
 
@@ -772,7 +991,7 @@ func TestMovieAuditsGetAll(t *testing.T) {
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			audits, total, err := app.MovieAuditsGetAll(ctx, id, offset, limit)
 			require.Equal(tc.exp.err, err)
@@ -781,3 +1000,384 @@ func TestMovieAuditsGetAll(t *testing.T) {
 		})
 	}
 }
+
+func TestMovieAuditRevert(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id            = 1
+		contributorID = 2
+		contributedAt = time.Date(2022, 10, 19, 12, 0, 0, 0, time.UTC)
+
+		expAudit = &models.FilmsAudit{
+			ID:            id,
+			Title:         "old title",
+			Descriptions:  null.StringFrom("old descriptions"),
+			DateReleased:  time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			Duration:      null.IntFrom(90),
+			ContributedBy: 1,
+			ContributedAt: contributedAt,
+		}
+		expUpdate = map[string]any{
+			models.FilmColumns.Title:        expAudit.Title,
+			models.FilmColumns.Descriptions: expAudit.Descriptions,
+			models.FilmColumns.DateReleased: expAudit.DateReleased,
+			models.FilmColumns.Duration:     expAudit.Duration,
+			models.FilmColumns.Invalidation: expAudit.Invalidation,
+		}
+
+		expMovieUpdateError = errors.New("MovieUpdate error")
+	)
+
+	type AuditGetExp struct {
+		audit *models.FilmsAudit
+		err   error
+	}
+	type AuditGet struct {
+		exp AuditGetExp
+	}
+	type UpdateExp struct {
+		err error
+	}
+	type Update struct {
+		exp UpdateExp
+	}
+	type Exp struct {
+		err error
+	}
+	type TestCase struct {
+		name     string
+		auditGet AuditGet
+		update   Update
+		exp      Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "not found",
+			auditGet: AuditGet{
+				exp: AuditGetExp{
+					audit: nil,
+					err:   repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "MovieUpdate error",
+			auditGet: AuditGet{
+				exp: AuditGetExp{
+					audit: expAudit,
+					err:   nil,
+				},
+			},
+			update: Update{
+				exp: UpdateExp{
+					err: expMovieUpdateError,
+				},
+			},
+			exp: Exp{
+				err: expMovieUpdateError,
+			},
+		},
+
+		{
+			name: "ok",
+			auditGet: AuditGet{
+				exp: AuditGetExp{
+					audit: expAudit,
+					err:   nil,
+				},
+			},
+			update: Update{
+				exp: UpdateExp{
+					err: nil,
+				},
+			},
+			exp: Exp{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			var txErr error
+			txCall := mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(_ context.Context, _ repo.Service) error) error {
+					txErr = fn(ctx, mockRepo)
+					return txErr
+				})
+
+			auditGetCall := mockRepo.EXPECT().
+				MovieAuditGet(ctx, id, contributedAt).
+				Return(tc.auditGet.exp.audit, tc.auditGet.exp.err).
+				After(txCall)
+
+			if tc.auditGet.exp.err == nil {
+				mockRepo.EXPECT().
+					MovieUpdate(ctx, id, contributorID, expUpdate).
+					Return(tc.update.exp.err).
+					After(auditGetCall)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			_, err := app.MovieAuditRevert(ctx, id, contributedAt, contributorID)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.err, txErr)
+		})
+	}
+}
+
+func TestMovieAuditDiff(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id   = 1
+		from = time.Date(2022, 10, 19, 12, 0, 0, 0, time.UTC)
+		to   = time.Date(2022, 10, 20, 12, 0, 0, 0, time.UTC)
+
+		expAudit = &models.FilmsAudit{
+			ID:            id,
+			Title:         "old title",
+			DateReleased:  time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			Duration:      null.IntFrom(90),
+			ContributedBy: 1,
+			ContributedAt: from,
+		}
+		expMovie = &models.Film{
+			ID:            id,
+			Title:         "new title",
+			Descriptions:  null.StringFrom("descriptions"),
+			DateReleased:  time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			Duration:      null.IntFrom(90),
+			ContributedBy: 2,
+			ContributedAt: to,
+		}
+	)
+
+	type MovieGetExp struct {
+		movie *models.Film
+		err   error
+	}
+	type MovieGet struct {
+		exp MovieGetExp
+	}
+	type AuditGetExp struct {
+		audit *models.FilmsAudit
+		err   error
+	}
+	type AuditGet struct {
+		exp AuditGetExp
+	}
+	type Exp struct {
+		diff *repo.AuditDiff
+		err  error
+	}
+	type TestCase struct {
+		name     string
+		movieGet MovieGet
+		auditGet AuditGet
+		exp      Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "movie not found",
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: nil,
+					err:   repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				diff: nil,
+				err:  app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "audit not found",
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: expMovie,
+					err:   nil,
+				},
+			},
+			auditGet: AuditGet{
+				exp: AuditGetExp{
+					audit: nil,
+					err:   repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				diff: nil,
+				err:  app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "ok",
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: expMovie,
+					err:   nil,
+				},
+			},
+			auditGet: AuditGet{
+				exp: AuditGetExp{
+					audit: expAudit,
+					err:   nil,
+				},
+			},
+			exp: Exp{
+				diff: &repo.AuditDiff{
+					FromContributedAt: from,
+					ToContributedAt:   to,
+					ContributedBy:     expMovie.ContributedBy,
+					Changes: []*repo.AuditChange{
+						{
+							Column: models.FilmColumns.Title,
+							Old:    expAudit.Title,
+							New:    expMovie.Title,
+						},
+						{
+							Column: models.FilmColumns.Descriptions,
+							Old:    expAudit.Descriptions,
+							New:    expMovie.Descriptions,
+						},
+					},
+				},
+				err: nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			txCall := mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			mockRepo.EXPECT().
+				MovieGet(ctx, id, true).
+				Return(tc.movieGet.exp.movie, tc.movieGet.exp.err).
+				MinTimes(1).
+				After(txCall)
+
+			if tc.movieGet.exp.err == nil {
+				mockRepo.EXPECT().
+					MovieAuditGet(ctx, id, from).
+					Return(tc.auditGet.exp.audit, tc.auditGet.exp.err)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			diff, err := app.MovieAuditDiff(ctx, id, from, to)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.diff, diff)
+		})
+	}
+}
+
+func TestMovieAuditDiffsGetAll(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		id = 1
+
+		movie = &models.Film{
+			ID:            id,
+			Title:         "t3",
+			ContributedBy: 3,
+			ContributedAt: time.Date(2022, 10, 21, 0, 0, 0, 0, time.UTC),
+		}
+		audits = []*models.FilmsAudit{
+			{
+				ID:            id,
+				Title:         "t2",
+				ContributedBy: 2,
+				ContributedAt: time.Date(2022, 10, 20, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				ID:            id,
+				Title:         "t1",
+				ContributedBy: 1,
+				ContributedAt: time.Date(2022, 10, 19, 0, 0, 0, 0, time.UTC),
+			},
+		}
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	mockRepo.EXPECT().
+		Transaction(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(_ context.Context, _ repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		}).
+		Times(2)
+	mockRepo.EXPECT().MovieGet(ctx, id, true).Return(movie, nil).Times(2)
+	mockRepo.EXPECT().MovieAuditsCount(ctx, id).Return(len(audits), nil).Times(2)
+
+	// the first page starts with the current movie
+	mockRepo.EXPECT().MovieAuditsGetAll(ctx, id, 0, 1).Return(audits[:1], nil)
+	// later pages start with an audit and need one more as predecessor
+	mockRepo.EXPECT().MovieAuditsGetAll(ctx, id, 0, 2).Return(audits, nil)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	diffs, total, err := application.MovieAuditDiffsGetAll(ctx, id, 0, 1)
+	require.NoError(err)
+	require.Equal(2, total)
+	require.Equal(1, len(diffs))
+	require.Equal(movie.ContributedBy, diffs[0].ContributedBy)
+	require.Equal(audits[0].ContributedAt, diffs[0].FromContributedAt)
+	require.Equal(
+		[]*repo.AuditChange{
+			{Column: models.FilmColumns.Title, Old: "t2", New: "t3"},
+		},
+		diffs[0].Changes,
+	)
+
+	diffs, total, err = application.MovieAuditDiffsGetAll(ctx, id, 1, 1)
+	require.NoError(err)
+	require.Equal(2, total)
+	require.Equal(1, len(diffs))
+	require.Equal(audits[0].ContributedBy, diffs[0].ContributedBy)
+	require.Equal(audits[1].ContributedAt, diffs[0].FromContributedAt)
+	require.Equal(
+		[]*repo.AuditChange{
+			{Column: models.FilmColumns.Title, Old: "t1", New: "t2"},
+		},
+		diffs[0].Changes,
+	)
+}
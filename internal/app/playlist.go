@@ -0,0 +1,390 @@
+package app
+
+import (
+	"context"
+	"io"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/thumbnail"
+)
+
+// number of member films pictured on a playlist cover
+const playlistCoverImages = 4
+
+// playlistGetByUser fetches a playlist on behalf of userID. Private playlists
+// of other users are reported as not found and modifying a public playlist of
+// another user is forbidden. The playlist to modify is locked until tx ends.
+func playlistGetByUser(
+	ctx context.Context,
+	tx repo.Service,
+	id int,
+	userID int,
+	modify bool,
+) (playlist *models.Playlist, err error) {
+	if modify {
+		playlist, err = tx.PlaylistGetForUpdate(ctx, id)
+	} else {
+		playlist, err = tx.PlaylistGet(ctx, id)
+	}
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if playlist.UserID != userID {
+		if !playlist.IsPublic {
+			return nil, ErrNotFound
+		}
+		if modify {
+			return nil, ErrForbidden
+		}
+	}
+	return playlist, nil
+}
+
+func (a *Application) PlaylistGet(
+	ctx context.Context,
+	id int,
+	userID int,
+) (*models.Playlist, error) {
+	return playlistGetByUser(ctx, a.repository, id, userID, false)
+}
+
+func (a *Application) PlaylistsGetAllByUser(
+	ctx context.Context,
+	userID int,
+	offset, limit int,
+) (playlists []*models.Playlist, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			var err error
+			playlists, err = tx.PlaylistsGetAllByUser(
+				ctx,
+				userID,
+				offset,
+				limit,
+			)
+			if err != nil {
+				return err
+			}
+			total, err = tx.PlaylistsCountByUser(ctx, userID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return playlists, total, nil
+}
+
+func (a *Application) PlaylistCreate(
+	ctx context.Context,
+	userID int,
+	req *dto.PlaylistCreateRequest,
+) (playlistID int, err error) {
+	insertPlaylist := &models.Playlist{
+		UserID:       userID,
+		Title:        req.Title,
+		Descriptions: req.Descriptions,
+		IsPublic:     req.IsPublic,
+	}
+
+	err = a.repository.PlaylistCreate(ctx, insertPlaylist)
+	if err != nil {
+		return 0, err
+	}
+
+	return insertPlaylist.ID, nil
+}
+
+func (a *Application) PlaylistUpdate(
+	ctx context.Context,
+	id int,
+	userID int,
+	req *dto.PlaylistUpdateRequest,
+) error {
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := playlistGetByUser(ctx, tx, id, userID, true)
+			if err != nil {
+				return err
+			}
+			columns := playlistUpdateRequestToValidMap(req)
+			if len(columns) == 0 {
+				return nil
+			}
+			return tx.PlaylistUpdate(ctx, id, columns)
+		},
+	)
+}
+
+func playlistUpdateRequestToValidMap(
+	req *dto.PlaylistUpdateRequest,
+) map[string]any {
+	m := make(map[string]any)
+	if req.Title.Valid {
+		m[models.PlaylistColumns.Title] = req.Title.String
+	}
+	if req.Descriptions.Valid {
+		m[models.PlaylistColumns.Descriptions] = req.Descriptions.String
+	}
+	if req.IsPublic.Valid {
+		m[models.PlaylistColumns.IsPublic] = req.IsPublic.Bool
+	}
+	return m
+}
+
+func (a *Application) PlaylistDelete(
+	ctx context.Context,
+	id int,
+	userID int,
+) error {
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := playlistGetByUser(ctx, tx, id, userID, true)
+			if err != nil {
+				return err
+			}
+			return tx.PlaylistDelete(ctx, id)
+		},
+	)
+}
+
+//------------------------------------------------------------------------------
+
+func (a *Application) PlaylistFilmsGetAll(
+	ctx context.Context,
+	id int,
+	userID int,
+	offset, limit int,
+) (films []*models.Film, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := playlistGetByUser(ctx, tx, id, userID, false)
+			if err != nil {
+				return err
+			}
+			films, err = tx.PlaylistFilmsGetAll(ctx, id, offset, limit)
+			if err != nil {
+				return err
+			}
+			total, err = tx.PlaylistFilmsCount(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return films, total, nil
+}
+
+func (a *Application) PlaylistFilmAdd(
+	ctx context.Context,
+	id int,
+	filmID int,
+	userID int,
+) error {
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := playlistGetByUser(ctx, tx, id, userID, true)
+			if err != nil {
+				return err
+			}
+			// check the film exists
+			_, err = tx.FilmGet(ctx, filmID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			return tx.PlaylistFilmAdd(ctx, id, filmID)
+		},
+	)
+}
+
+func (a *Application) PlaylistFilmRemove(
+	ctx context.Context,
+	id int,
+	filmID int,
+	userID int,
+) error {
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := playlistGetByUser(ctx, tx, id, userID, true)
+			if err != nil {
+				return err
+			}
+			err = tx.PlaylistFilmRemove(ctx, id, filmID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			return nil
+		},
+	)
+}
+
+// PlaylistFilmsReorder requires req.FilmIDs to list every playlist film
+// exactly once.
+func (a *Application) PlaylistFilmsReorder(
+	ctx context.Context,
+	id int,
+	userID int,
+	req *dto.PlaylistFilmsReorderRequest,
+) error {
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := playlistGetByUser(ctx, tx, id, userID, true)
+			if err != nil {
+				return err
+			}
+			filmIDs, err := tx.PlaylistFilmIDs(ctx, id)
+			if err != nil {
+				return err
+			}
+			if !isPermutation(filmIDs, req.FilmIDs) {
+				return ErrPlaylistOrderMismatch
+			}
+			return tx.PlaylistFilmsReorder(ctx, id, req.FilmIDs)
+		},
+	)
+}
+
+func isPermutation(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[int]int, len(a))
+	for _, n := range a {
+		counts[n]++
+	}
+	for _, n := range b {
+		if counts[n] == 0 {
+			return false
+		}
+		counts[n]--
+	}
+	return true
+}
+
+// PlaylistCover generates a jpeg collage from the image thumbnails of the
+// first playlist films that have one.
+func (a *Application) PlaylistCover(
+	ctx context.Context,
+	id int,
+	userID int,
+) ([]byte, error) {
+	var keys []string
+	err := a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := playlistGetByUser(ctx, tx, id, userID, false)
+			if err != nil {
+				return err
+			}
+			keys, err = tx.PlaylistCoverThumbnails(
+				ctx,
+				id,
+				playlistCoverImages,
+			)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, ErrNotFound
+	}
+
+	srcs := make([]io.Reader, len(keys))
+	for i, key := range keys {
+		blob, err := a.storage.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		defer blob.Close()
+		srcs[i] = blob
+	}
+
+	return thumbnail.Collage(srcs, config.Config.Validation.Media.Cover.Size)
+}
+
+//------------------------------------------------------------------------------
+
+// PlaylistAddToWatchlist adds every film on the playlist to the user's
+// watchlist and returns the number of films newly added.
+func (a *Application) PlaylistAddToWatchlist(
+	ctx context.Context,
+	id int,
+	userID int,
+) (added int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := playlistGetByUser(ctx, tx, id, userID, false)
+			if err != nil {
+				return err
+			}
+			added, err = tx.WatchlistAddPlaylist(ctx, userID, id)
+			return err
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return added, nil
+}
+
+func (a *Application) WatchlistGetAll(
+	ctx context.Context,
+	userID int,
+	offset, limit int,
+) (watchlist []*models.Watchlist, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			var err error
+			watchlist, err = tx.WatchlistGetAll(ctx, userID, offset, limit)
+			if err != nil {
+				return err
+			}
+			total, err = tx.WatchlistCount(ctx, userID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return watchlist, total, nil
+}
+
+// WatchlistMarkWatched marks a film on the user's watchlist as watched.
+func (a *Application) WatchlistMarkWatched(
+	ctx context.Context,
+	userID int,
+	filmID int,
+) error {
+	err := a.repository.WatchlistMarkWatched(ctx, userID, filmID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
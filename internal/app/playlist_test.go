@@ -0,0 +1,279 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestPlaylistGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id          = 1
+		ownerID     = 1
+		otherUserID = 2
+		expError    = errors.New("error")
+
+		privatePlaylist = &models.Playlist{ID: id, UserID: ownerID}
+		publicPlaylist  = &models.Playlist{ID: id, UserID: ownerID, IsPublic: true}
+	)
+
+	type GetExp struct {
+		playlist *models.Playlist
+		err      error
+	}
+	type Get struct {
+		exp GetExp
+	}
+	type Exp struct {
+		playlist *models.Playlist
+		err      error
+	}
+	type TestCase struct {
+		name   string
+		userID int
+		get    Get
+		exp    Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "error",
+			userID: ownerID,
+			get:    Get{exp: GetExp{playlist: nil, err: expError}},
+			exp:    Exp{playlist: nil, err: expError},
+		},
+		{
+			name:   "not found",
+			userID: ownerID,
+			get:    Get{exp: GetExp{playlist: nil, err: repo.ErrNoRecord}},
+			exp:    Exp{playlist: nil, err: app.ErrNotFound},
+		},
+		{
+			name:   "private playlist of another user",
+			userID: otherUserID,
+			get:    Get{exp: GetExp{playlist: privatePlaylist, err: nil}},
+			exp:    Exp{playlist: nil, err: app.ErrNotFound},
+		},
+		{
+			name:   "private playlist of owner",
+			userID: ownerID,
+			get:    Get{exp: GetExp{playlist: privatePlaylist, err: nil}},
+			exp:    Exp{playlist: privatePlaylist, err: nil},
+		},
+		{
+			name:   "public playlist of another user",
+			userID: otherUserID,
+			get:    Get{exp: GetExp{playlist: publicPlaylist, err: nil}},
+			exp:    Exp{playlist: publicPlaylist, err: nil},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				PlaylistGet(ctx, id).
+				Return(tc.get.exp.playlist, tc.get.exp.err)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			playlist, err := app.PlaylistGet(ctx, id, tc.userID)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.playlist, playlist)
+		})
+	}
+}
+
+func TestPlaylistUpdate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id          = 1
+		ownerID     = 1
+		otherUserID = 2
+		req         = &dto.PlaylistUpdateRequest{
+			Title:    null.StringFrom("playlist"),
+			IsPublic: null.BoolFrom(true),
+		}
+		expColumns = map[string]any{
+			models.PlaylistColumns.Title:    req.Title.String,
+			models.PlaylistColumns.IsPublic: req.IsPublic.Bool,
+		}
+		expUpdateError = errors.New("PlaylistUpdate error")
+	)
+
+	type TestCase struct {
+		name      string
+		userID    int
+		playlist  *models.Playlist
+		expUpdate bool
+		updateErr error
+		expErr    error
+	}
+
+	testCases := []TestCase{
+		{
+			name:     "private playlist of another user",
+			userID:   otherUserID,
+			playlist: &models.Playlist{ID: id, UserID: ownerID},
+			expErr:   app.ErrNotFound,
+		},
+		{
+			name:     "public playlist of another user",
+			userID:   otherUserID,
+			playlist: &models.Playlist{ID: id, UserID: ownerID, IsPublic: true},
+			expErr:   app.ErrForbidden,
+		},
+		{
+			name:      "PlaylistUpdate error",
+			userID:    ownerID,
+			playlist:  &models.Playlist{ID: id, UserID: ownerID},
+			expUpdate: true,
+			updateErr: expUpdateError,
+			expErr:    expUpdateError,
+		},
+		{
+			name:      "ok",
+			userID:    ownerID,
+			playlist:  &models.Playlist{ID: id, UserID: ownerID},
+			expUpdate: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			mockRepo.EXPECT().
+				PlaylistGetForUpdate(ctx, id).
+				Return(tc.playlist, nil)
+
+			if tc.expUpdate {
+				mockRepo.EXPECT().
+					PlaylistUpdate(ctx, id, expColumns).
+					Return(tc.updateErr)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.PlaylistUpdate(ctx, id, tc.userID, req)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestPlaylistFilmsReorder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id       = 1
+		userID   = 1
+		playlist = &models.Playlist{ID: id, UserID: userID}
+		filmIDs  = []int{1, 2, 3}
+	)
+
+	type TestCase struct {
+		name       string
+		reqFilmIDs []int
+		expReorder bool
+		expErr     error
+	}
+
+	testCases := []TestCase{
+		{
+			name:       "missing film",
+			reqFilmIDs: []int{3, 1},
+			expErr:     app.ErrPlaylistOrderMismatch,
+		},
+		{
+			name:       "duplicate film",
+			reqFilmIDs: []int{3, 1, 1},
+			expErr:     app.ErrPlaylistOrderMismatch,
+		},
+		{
+			name:       "unknown film",
+			reqFilmIDs: []int{3, 1, 4},
+			expErr:     app.ErrPlaylistOrderMismatch,
+		},
+		{
+			name:       "ok",
+			reqFilmIDs: []int{3, 1, 2},
+			expReorder: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			mockRepo.EXPECT().
+				PlaylistGetForUpdate(ctx, id).
+				Return(playlist, nil)
+
+			mockRepo.EXPECT().
+				PlaylistFilmIDs(ctx, id).
+				Return(filmIDs, nil)
+
+			if tc.expReorder {
+				mockRepo.EXPECT().
+					PlaylistFilmsReorder(ctx, id, tc.reqFilmIDs).
+					Return(nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.PlaylistFilmsReorder(
+				ctx,
+				id,
+				userID,
+				&dto.PlaylistFilmsReorderRequest{FilmIDs: tc.reqFilmIDs},
+			)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
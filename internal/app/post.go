@@ -0,0 +1,330 @@
+package app
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/markdown"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+)
+
+// Post is a post with its markdown body rendered to sanitized html.
+type Post struct {
+	*repo.PostWithReferences
+	BodyHTML string `json:"body_html"`
+}
+
+func renderPost(post *repo.PostWithReferences) (*Post, error) {
+	html, err := markdown.Render(post.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &Post{PostWithReferences: post, BodyHTML: html}, nil
+}
+
+func renderPosts(posts []*repo.PostWithReferences) ([]*Post, error) {
+	rendered := make([]*Post, len(posts))
+	for i, post := range posts {
+		var err error
+		rendered[i], err = renderPost(post)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rendered, nil
+}
+
+// postGetByUser fetches a post to be modified by userID which must be the
+// poster. Deleted posts are reported as not found.
+func postGetByUser(
+	ctx context.Context,
+	tx repo.Service,
+	id int,
+	userID int,
+) (*repo.PostWithReferences, error) {
+	post, err := tx.PostGet(ctx, id)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if post.DeletedAt.Valid {
+		return nil, ErrNotFound
+	}
+	if post.UserID != userID {
+		return nil, ErrForbidden
+	}
+	return post, nil
+}
+
+func (a *Application) PostGet(ctx context.Context, id int) (*Post, error) {
+	post, err := a.repository.PostGet(ctx, id)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return renderPost(post)
+}
+
+func (a *Application) PostsGetAllByMovie(
+	ctx context.Context,
+	movieID int,
+	offset, limit int,
+) (posts []*Post, total int, err error) {
+	var unrendered []*repo.PostWithReferences
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// check the movie exists
+			_, err := tx.MovieGet(ctx, movieID, true)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			unrendered, err = tx.PostsGetAllByFilm(ctx, movieID, offset, limit)
+			if err != nil {
+				return err
+			}
+			total, err = tx.PostsCountByFilm(ctx, movieID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	posts, err = renderPosts(unrendered)
+	if err != nil {
+		return nil, 0, err
+	}
+	return posts, total, nil
+}
+
+func (a *Application) PostsGetAllBySeries(
+	ctx context.Context,
+	seriesID int,
+	offset, limit int,
+) (posts []*Post, total int, err error) {
+	var unrendered []*repo.PostWithReferences
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// check the series exists
+			_, err := tx.SeriesGet(ctx, seriesID, true)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			unrendered, err = tx.PostsGetAllBySeries(
+				ctx,
+				seriesID,
+				offset,
+				limit,
+			)
+			if err != nil {
+				return err
+			}
+			total, err = tx.PostsCountBySeries(ctx, seriesID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	posts, err = renderPosts(unrendered)
+	if err != nil {
+		return nil, 0, err
+	}
+	return posts, total, nil
+}
+
+func (a *Application) PostRepliesGetAll(
+	ctx context.Context,
+	id int,
+	offset, limit int,
+) (replies []*Post, total int, err error) {
+	var unrendered []*repo.PostWithReferences
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// check the post exists
+			_, err := tx.PostGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			unrendered, err = tx.PostRepliesGetAll(ctx, id, offset, limit)
+			if err != nil {
+				return err
+			}
+			total, err = tx.PostRepliesCount(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	replies, err = renderPosts(unrendered)
+	if err != nil {
+		return nil, 0, err
+	}
+	return replies, total, nil
+}
+
+// PostCreate reports ErrNotFound if the replied post or any of the referenced
+// records don't exist.
+func (a *Application) PostCreate(
+	ctx context.Context,
+	userID int,
+	req *dto.PostCreateRequest,
+) (postID int, err error) {
+	insertPost := &models.Post{
+		UserID: userID,
+		PostID: req.PostID,
+		Body:   req.Body,
+	}
+
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			if req.PostID.Valid {
+				_, err := tx.PostGet(ctx, req.PostID.Int)
+				if err != nil {
+					if err == repo.ErrNoRecord {
+						return ErrNotFound
+					}
+					return err
+				}
+			}
+			err := postReferencesCheck(ctx, tx, req)
+			if err != nil {
+				return err
+			}
+			return tx.PostCreate(
+				ctx,
+				insertPost,
+				req.FilmIDs,
+				req.SeriesIDs,
+				req.UserIDs,
+				req.ArtistIDs,
+			)
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return insertPost.ID, nil
+}
+
+func postReferencesCheck(
+	ctx context.Context,
+	tx repo.Service,
+	req *dto.PostCreateRequest,
+) error {
+	var err error
+	for _, id := range req.FilmIDs {
+		if _, err = tx.FilmGet(ctx, id); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		for _, id := range req.SeriesIDs {
+			if _, err = tx.SeriesGet(ctx, id, true); err != nil {
+				break
+			}
+		}
+	}
+	if err == nil {
+		for _, id := range req.UserIDs {
+			if _, err = tx.UserGet(ctx, id); err != nil {
+				break
+			}
+		}
+	}
+	if err == nil {
+		for _, id := range req.ArtistIDs {
+			if _, err = tx.ArtistGet(ctx, id); err != nil {
+				break
+			}
+		}
+	}
+	if err == repo.ErrNoRecord {
+		return ErrNotFound
+	}
+	return err
+}
+
+func (a *Application) PostUpdate(
+	ctx context.Context,
+	id int,
+	userID int,
+	req *dto.PostUpdateRequest,
+) error {
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := postGetByUser(ctx, tx, id, userID)
+			if err != nil {
+				return err
+			}
+			return tx.PostUpdate(ctx, id, req.Body)
+		},
+	)
+}
+
+func (a *Application) PostDelete(
+	ctx context.Context,
+	id int,
+	userID int,
+) error {
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := postGetByUser(ctx, tx, id, userID)
+			if err != nil {
+				return err
+			}
+			return tx.PostDelete(ctx, id)
+		},
+	)
+}
+
+func (a *Application) PostRevisionsGetAll(
+	ctx context.Context,
+	id int,
+	offset, limit int,
+) (revisions []*models.PostRevision, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// check the post exists
+			_, err := tx.PostGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			revisions, err = tx.PostRevisionsGetAll(ctx, id, offset, limit)
+			if err != nil {
+				return err
+			}
+			total, err = tx.PostRevisionsCount(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return revisions, total, nil
+}
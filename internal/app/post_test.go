@@ -0,0 +1,258 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestPostGet(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		id   = 1
+		post = &repo.PostWithReferences{
+			Post: models.Post{ID: id, Body: "**bold** <script>alert(1)</script>"},
+		}
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	mockRepo.EXPECT().
+		PostGet(ctx, id).
+		Return(post, nil)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	gotPost, err := application.PostGet(ctx, id)
+	require.NoError(err)
+	require.Equal(post, gotPost.PostWithReferences)
+	require.Equal(
+		"<p><strong>bold</strong> <!-- raw HTML omitted -->alert(1)<!-- raw HTML omitted --></p>\n",
+		gotPost.BodyHTML,
+	)
+}
+
+func TestPostCreate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		userID   = 1
+		expError = errors.New("error")
+	)
+
+	type TestCase struct {
+		name   string
+		req    *dto.PostCreateRequest
+		expect func(mockRepo *mock_repo.MockRepositoryTx)
+		expErr error
+	}
+
+	testCases := []TestCase{
+		{
+			name: "replied post not found",
+			req:  &dto.PostCreateRequest{Body: "body", PostID: null.IntFrom(2)},
+			expect: func(mockRepo *mock_repo.MockRepositoryTx) {
+				mockRepo.EXPECT().
+					PostGet(ctx, 2).
+					Return(nil, repo.ErrNoRecord)
+			},
+			expErr: app.ErrNotFound,
+		},
+		{
+			name: "referenced film not found",
+			req:  &dto.PostCreateRequest{Body: "body", FilmIDs: []int{1, 2}},
+			expect: func(mockRepo *mock_repo.MockRepositoryTx) {
+				mockRepo.EXPECT().
+					FilmGet(ctx, 1).
+					Return(&models.Film{ID: 1}, nil)
+				mockRepo.EXPECT().
+					FilmGet(ctx, 2).
+					Return(nil, repo.ErrNoRecord)
+			},
+			expErr: app.ErrNotFound,
+		},
+		{
+			name: "referenced user error",
+			req:  &dto.PostCreateRequest{Body: "body", UserIDs: []int{3}},
+			expect: func(mockRepo *mock_repo.MockRepositoryTx) {
+				mockRepo.EXPECT().
+					UserGet(ctx, 3).
+					Return(nil, expError)
+			},
+			expErr: expError,
+		},
+		{
+			name: "referenced artist not found",
+			req:  &dto.PostCreateRequest{Body: "body", ArtistIDs: []int{5}},
+			expect: func(mockRepo *mock_repo.MockRepositoryTx) {
+				mockRepo.EXPECT().
+					ArtistGet(ctx, 5).
+					Return(nil, repo.ErrNoRecord)
+			},
+			expErr: app.ErrNotFound,
+		},
+		{
+			name: "ok",
+			req: &dto.PostCreateRequest{
+				Body:      "body",
+				PostID:    null.IntFrom(2),
+				SeriesIDs: []int{4},
+				ArtistIDs: []int{5},
+			},
+			expect: func(mockRepo *mock_repo.MockRepositoryTx) {
+				mockRepo.EXPECT().
+					PostGet(ctx, 2).
+					Return(&repo.PostWithReferences{}, nil)
+				mockRepo.EXPECT().
+					SeriesGet(ctx, 4, true).
+					Return(&models.Series{ID: 4}, nil)
+				mockRepo.EXPECT().
+					ArtistGet(ctx, 5).
+					Return(&models.Artist{ID: 5}, nil)
+				mockRepo.EXPECT().
+					PostCreate(
+						ctx,
+						&models.Post{
+							UserID: userID,
+							PostID: null.IntFrom(2),
+							Body:   "body",
+						},
+						nil,
+						[]int{4},
+						nil,
+						[]int{5},
+					).
+					Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			tc.expect(mockRepo)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			_, err := app.PostCreate(ctx, userID, tc.req)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestPostUpdate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id          = 1
+		ownerID     = 1
+		otherUserID = 2
+		req         = &dto.PostUpdateRequest{Body: "new body"}
+	)
+
+	type TestCase struct {
+		name      string
+		userID    int
+		getErr    error
+		deleted   bool
+		expUpdate bool
+		expErr    error
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "not found",
+			userID: ownerID,
+			getErr: repo.ErrNoRecord,
+			expErr: app.ErrNotFound,
+		},
+		{
+			name:   "post of another user",
+			userID: otherUserID,
+			expErr: app.ErrForbidden,
+		},
+		{
+			name:    "deleted post",
+			userID:  ownerID,
+			deleted: true,
+			expErr:  app.ErrNotFound,
+		},
+		{
+			name:      "ok",
+			userID:    ownerID,
+			expUpdate: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			var post *repo.PostWithReferences
+			if tc.getErr == nil {
+				post = &repo.PostWithReferences{
+					Post: models.Post{
+						ID:        id,
+						UserID:    ownerID,
+						DeletedAt: null.NewTime(time.Now(), tc.deleted),
+					},
+				}
+			}
+			mockRepo.EXPECT().
+				PostGet(ctx, id).
+				Return(post, tc.getErr)
+
+			if tc.expUpdate {
+				mockRepo.EXPECT().
+					PostUpdate(ctx, id, req.Body).
+					Return(nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.PostUpdate(ctx, id, tc.userID, req)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
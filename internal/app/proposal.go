@@ -0,0 +1,292 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+)
+
+// ProposalGet returns a proposal to its proposer or to a moderator.
+func (a *Application) ProposalGet(
+	ctx context.Context,
+	id int,
+	userID int,
+) (*models.Proposal, error) {
+	proposal, err := a.repository.ProposalGet(ctx, id)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if proposal.ProposedBy != userID && !isModerator(userID) {
+		return nil, ErrForbidden
+	}
+	return proposal, nil
+}
+
+// ProposalsGetAll lists the proposals of status to a moderator, oldest first.
+func (a *Application) ProposalsGetAll(
+	ctx context.Context,
+	moderatorID int,
+	status string,
+	offset, limit int,
+) (proposals []*models.Proposal, total int, err error) {
+	if !isModerator(moderatorID) {
+		return nil, 0, ErrForbidden
+	}
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			var err error
+			proposals, err = tx.ProposalsGetAll(ctx, status, offset, limit)
+			if err != nil {
+				return err
+			}
+			total, err = tx.ProposalsCount(ctx, status)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return proposals, total, nil
+}
+
+// ProposalApprove applies a pending proposal as a contribution of its
+// proposer, just as if they were trusted when proposing it. A proposal made
+// against a record changed since is outdated and left pending.
+func (a *Application) ProposalApprove(
+	ctx context.Context,
+	id int,
+	moderatorID int,
+) error {
+	if !isModerator(moderatorID) {
+		return ErrForbidden
+	}
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			proposal, err := pendingProposalGet(ctx, tx, id)
+			if err != nil {
+				return err
+			}
+			// the change is recorded as made through the proposing request
+			applyCtx := repo.WithContributionMetadata(
+				ctx,
+				&repo.ContributionMetadata{
+					EditSummary: proposal.EditSummary,
+					RequestID:   proposal.RequestID,
+					ClientIP:    proposal.ClientIP,
+					UserAgent:   proposal.UserAgent,
+				},
+			)
+			// and applies only to the version it was proposed against
+			if proposal.BaseContributedAt.Valid {
+				applyCtx = repo.WithIfMatch(
+					applyCtx,
+					proposal.BaseContributedAt.Time,
+				)
+			}
+			switch proposal.Kind {
+			case repo.ProposalKindMovieUpdate:
+				var req dto.MovieUpdateRequest
+				if err := json.Unmarshal(proposal.Changes, &req); err != nil {
+					return err
+				}
+				err = movieUpdate(
+					applyCtx,
+					tx,
+					proposal.FilmID.Int,
+					proposal.ProposedBy,
+					&req,
+				)
+			case repo.ProposalKindEpisodePut:
+				var req dto.EpisodePutRequest
+				if err := json.Unmarshal(proposal.Changes, &req); err != nil {
+					return err
+				}
+				// an episode proposed as new must not have been put since
+				if !proposal.BaseContributedAt.Valid {
+					_, err := tx.EpisodeGet(
+						ctx,
+						proposal.SeriesID.Int,
+						proposal.SeasonNumber.Int,
+						proposal.EpisodeNumber.Int,
+						true,
+					)
+					if err == nil {
+						return ErrProposalOutdated
+					}
+					if err != repo.ErrNoRecord {
+						return err
+					}
+				}
+				err = episodePut(
+					applyCtx,
+					tx,
+					proposal.SeriesID.Int,
+					proposal.SeasonNumber.Int,
+					proposal.EpisodeNumber.Int,
+					proposal.ProposedBy,
+					&req,
+				)
+			case repo.ProposalKindEpisodeUpdate:
+				var req dto.EpisodeUpdateRequest
+				if err := json.Unmarshal(proposal.Changes, &req); err != nil {
+					return err
+				}
+				err = episodeUpdate(
+					applyCtx,
+					tx,
+					proposal.SeriesID.Int,
+					proposal.SeasonNumber.Int,
+					proposal.EpisodeNumber.Int,
+					proposal.ProposedBy,
+					&req,
+				)
+			case repo.ProposalKindSeasonEpisodesPut:
+				var req dto.EpisodesPutAllBySeasonRequest
+				if err := json.Unmarshal(proposal.Changes, &req); err != nil {
+					return err
+				}
+				err = episodesPutAllBySeason(
+					applyCtx,
+					tx,
+					proposal.SeriesID.Int,
+					proposal.SeasonNumber.Int,
+					proposal.ProposedBy,
+					&req,
+				)
+			// a revert restores the audited snapshot, the changes only show
+			// it to the moderators
+			case repo.ProposalKindMovieRevert:
+				err = movieAuditRevert(
+					applyCtx,
+					tx,
+					proposal.FilmID.Int,
+					proposal.RevertContributedAt.Time,
+					proposal.ProposedBy,
+				)
+			case repo.ProposalKindEpisodeRevert:
+				err = episodeAuditRevert(
+					applyCtx,
+					tx,
+					proposal.SeriesID.Int,
+					proposal.SeasonNumber.Int,
+					proposal.EpisodeNumber.Int,
+					proposal.RevertContributedAt.Time,
+					proposal.ProposedBy,
+				)
+			}
+			if err != nil {
+				if err == ErrPreconditionFailed {
+					return ErrProposalOutdated
+				}
+				return err
+			}
+			return tx.ProposalReview(
+				ctx,
+				id,
+				moderatorID,
+				repo.ProposalStatusApproved,
+			)
+		},
+	)
+}
+
+// ProposalReject discards a pending proposal.
+func (a *Application) ProposalReject(
+	ctx context.Context,
+	id int,
+	moderatorID int,
+) error {
+	if !isModerator(moderatorID) {
+		return ErrForbidden
+	}
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			if _, err := pendingProposalGet(ctx, tx, id); err != nil {
+				return err
+			}
+			return tx.ProposalReview(
+				ctx,
+				id,
+				moderatorID,
+				repo.ProposalStatusRejected,
+			)
+		},
+	)
+}
+
+func pendingProposalGet(
+	ctx context.Context,
+	tx repo.Service,
+	id int,
+) (*models.Proposal, error) {
+	proposal, err := tx.ProposalGet(ctx, id)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if proposal.Status != repo.ProposalStatusPending {
+		return nil, ErrProposalReviewed
+	}
+	return proposal, nil
+}
+
+func proposalCreate(
+	ctx context.Context,
+	tx repo.Service,
+	proposal *models.Proposal,
+	req any,
+) error {
+	changes, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	proposal.Changes = changes
+	// keep the request metadata to record it once approved
+	md := repo.ContributionMetadataFromContext(ctx)
+	proposal.EditSummary = md.EditSummary
+	proposal.RequestID = md.RequestID
+	proposal.ClientIP = md.ClientIP
+	proposal.UserAgent = md.UserAgent
+	return tx.ProposalCreate(ctx, proposal)
+}
+
+// contributorTrusted reports whether the edits of contributorID apply right
+// away, that is moderation is off, they're a moderator or enough of their
+// proposals were approved. The records they created don't count, creating
+// records is no proof of good edits.
+func contributorTrusted(
+	ctx context.Context,
+	tx repo.Service,
+	contributorID int,
+) (bool, error) {
+	threshold := config.Config.Moderation.TrustThreshold
+	if threshold <= 0 || isModerator(contributorID) {
+		return true, nil
+	}
+	approved, err := tx.ProposalsApprovedCount(ctx, contributorID)
+	if err != nil {
+		return false, err
+	}
+	return approved >= threshold, nil
+}
+
+func isModerator(userID int) bool {
+	for _, id := range config.Config.Moderation.Moderators {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
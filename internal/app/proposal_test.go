@@ -0,0 +1,846 @@
+package app_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+// setModeration enables moderation for the running test, it must not be
+// called from parallel tests as the rest of app tests expect it off.
+func setModeration(t *testing.T, trustThreshold int, moderators ...int) {
+	config.Config.Moderation.TrustThreshold = trustThreshold
+	config.Config.Moderation.Moderators = moderators
+	t.Cleanup(func() {
+		config.Config.Moderation.TrustThreshold = 0
+		config.Config.Moderation.Moderators = nil
+	})
+}
+
+func TestMovieUpdateProposed(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		id            = 1
+		contributorID = 2
+		version       = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		req           = &dto.MovieUpdateRequest{
+			Title: null.StringFrom("title"),
+		}
+	)
+
+	setModeration(t, 5)
+
+	changes, err := json.Marshal(req)
+	require.NoError(err)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	mockRepo.EXPECT().
+		Transaction(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		ProposalsApprovedCount(ctx, contributorID).
+		Return(4, nil)
+	mockRepo.EXPECT().
+		MovieGet(ctx, id, true).
+		Return(&models.Film{ID: id, ContributedAt: version}, nil)
+	// the proposal is based on the current version of the movie
+	mockRepo.EXPECT().
+		ProposalCreate(ctx, &models.Proposal{
+			Kind:              repo.ProposalKindMovieUpdate,
+			FilmID:            null.IntFrom(id),
+			Changes:           changes,
+			ProposedBy:        contributorID,
+			BaseContributedAt: null.TimeFrom(version),
+		}).
+		Return(nil)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	proposal, err := application.MovieUpdate(ctx, id, contributorID, req)
+	require.NoError(err)
+	require.Equal(repo.ProposalKindMovieUpdate, proposal.Kind)
+	require.Equal(contributorID, proposal.ProposedBy)
+}
+
+func TestMovieUpdateProposedPreconditionFailed(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		version = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		ctx     = repo.WithIfMatch(context.Background(), version)
+
+		id            = 1
+		contributorID = 2
+		req           = &dto.MovieUpdateRequest{
+			Title: null.StringFrom("title"),
+		}
+	)
+
+	setModeration(t, 5)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	mockRepo.EXPECT().
+		Transaction(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		ProposalsApprovedCount(ctx, contributorID).
+		Return(4, nil)
+	// the movie changed since
+	mockRepo.EXPECT().
+		MovieGet(ctx, id, true).
+		Return(&models.Film{ID: id, ContributedAt: version.Add(time.Second)}, nil)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	proposal, err := application.MovieUpdate(ctx, id, contributorID, req)
+	require.Equal(app.ErrPreconditionFailed, err)
+	require.Nil(proposal)
+}
+
+func TestEpisodePutProposed(t *testing.T) {
+	var (
+		seriesID      = 1
+		seasonNumber  = 1
+		episodeNumber = 1
+		contributorID = 2
+		version       = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		req           = &dto.EpisodePutRequest{
+			Title:        "title",
+			DateReleased: version,
+		}
+	)
+
+	setModeration(t, 5)
+
+	changes, err := json.Marshal(req)
+	require.NoError(t, err)
+
+	type TestCase struct {
+		name        string
+		ifMatch     []time.Time
+		episode     *models.Film
+		expProposal *models.Proposal
+		expErr      error
+	}
+
+	testCases := []TestCase{
+		{
+			name: "new episode",
+			expProposal: &models.Proposal{
+				Kind:          repo.ProposalKindEpisodePut,
+				SeriesID:      null.IntFrom(seriesID),
+				SeasonNumber:  null.IntFrom(seasonNumber),
+				EpisodeNumber: null.IntFrom(episodeNumber),
+				Changes:       changes,
+				ProposedBy:    contributorID,
+			},
+		},
+		{
+			name:    "conditional put of a new episode",
+			ifMatch: []time.Time{version},
+			expErr:  app.ErrNotFound,
+		},
+		{
+			name:    "episode changed since",
+			ifMatch: []time.Time{version},
+			episode: &models.Film{ContributedAt: version.Add(time.Second)},
+			expErr:  app.ErrPreconditionFailed,
+		},
+		{
+			name:    "existing episode",
+			ifMatch: []time.Time{version},
+			episode: &models.Film{ContributedAt: version},
+			expProposal: &models.Proposal{
+				Kind:              repo.ProposalKindEpisodePut,
+				SeriesID:          null.IntFrom(seriesID),
+				SeasonNumber:      null.IntFrom(seasonNumber),
+				EpisodeNumber:     null.IntFrom(episodeNumber),
+				Changes:           changes,
+				ProposedBy:        contributorID,
+				BaseContributedAt: null.TimeFrom(version),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			ctx := context.Background()
+			if tc.ifMatch != nil {
+				ctx = repo.WithIfMatch(ctx, tc.ifMatch...)
+			}
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				ProposalsApprovedCount(ctx, contributorID).
+				Return(4, nil)
+			mockRepo.EXPECT().
+				SeriesGet(ctx, seriesID, true).
+				Return(&models.Series{ID: seriesID}, nil)
+			episodeErr := error(nil)
+			if tc.episode == nil {
+				episodeErr = repo.ErrNoRecord
+			}
+			mockRepo.EXPECT().
+				EpisodeGet(ctx, seriesID, seasonNumber, episodeNumber, true).
+				Return(tc.episode, episodeErr)
+			if tc.expProposal != nil {
+				mockRepo.EXPECT().
+					ProposalCreate(ctx, tc.expProposal).
+					Return(nil)
+			}
+
+			application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			proposal, err := application.EpisodePut(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+				contributorID,
+				req,
+			)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expProposal, proposal)
+		})
+	}
+}
+
+func TestEpisodeUpdateProposed(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		seriesID      = 1
+		seasonNumber  = 1
+		episodeNumber = 1
+		contributorID = 2
+		version       = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		req           = &dto.EpisodeUpdateRequest{
+			Title: null.StringFrom("title"),
+		}
+	)
+
+	setModeration(t, 5)
+
+	changes, err := json.Marshal(req)
+	require.NoError(err)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	mockRepo.EXPECT().
+		Transaction(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		ProposalsApprovedCount(ctx, contributorID).
+		Return(4, nil)
+	mockRepo.EXPECT().
+		EpisodeGet(ctx, seriesID, seasonNumber, episodeNumber, true).
+		Return(&models.Film{ContributedAt: version}, nil)
+	// the episode is left as is and the update pending
+	expProposal := &models.Proposal{
+		Kind:              repo.ProposalKindEpisodeUpdate,
+		SeriesID:          null.IntFrom(seriesID),
+		SeasonNumber:      null.IntFrom(seasonNumber),
+		EpisodeNumber:     null.IntFrom(episodeNumber),
+		Changes:           changes,
+		ProposedBy:        contributorID,
+		BaseContributedAt: null.TimeFrom(version),
+	}
+	mockRepo.EXPECT().
+		ProposalCreate(ctx, expProposal).
+		Return(nil)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	proposal, err := application.EpisodeUpdate(
+		ctx,
+		seriesID,
+		seasonNumber,
+		episodeNumber,
+		contributorID,
+		req,
+	)
+	require.NoError(err)
+	require.Equal(expProposal, proposal)
+}
+
+func TestEpisodesPutAllBySeasonProposed(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		seriesID      = 1
+		seasonNumber  = 1
+		contributorID = 2
+		req           = &dto.EpisodesPutAllBySeasonRequest{
+			Episodes: []*dto.EpisodePutRequest{
+				{
+					Title:        "title",
+					DateReleased: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		}
+	)
+
+	setModeration(t, 5)
+
+	changes, err := json.Marshal(req)
+	require.NoError(err)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	mockRepo.EXPECT().
+		Transaction(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		ProposalsApprovedCount(ctx, contributorID).
+		Return(4, nil)
+	mockRepo.EXPECT().
+		SeriesGet(ctx, seriesID, true).
+		Return(&models.Series{ID: seriesID}, nil)
+	// neither the season nor its episodes are put, the put is pending
+	expProposal := &models.Proposal{
+		Kind:         repo.ProposalKindSeasonEpisodesPut,
+		SeriesID:     null.IntFrom(seriesID),
+		SeasonNumber: null.IntFrom(seasonNumber),
+		Changes:      changes,
+		ProposedBy:   contributorID,
+	}
+	mockRepo.EXPECT().
+		ProposalCreate(ctx, expProposal).
+		Return(nil)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	proposal, err := application.EpisodesPutAllBySeason(
+		ctx,
+		seriesID,
+		seasonNumber,
+		contributorID,
+		req,
+	)
+	require.NoError(err)
+	require.Equal(expProposal, proposal)
+}
+
+func TestMovieAuditRevertProposed(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		id            = 1
+		contributorID = 2
+		version       = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		contributedAt = time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC)
+		audit         = &models.FilmsAudit{
+			ID:            id,
+			Title:         "old title",
+			DateReleased:  time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+			ContributedAt: contributedAt,
+		}
+	)
+
+	setModeration(t, 5)
+
+	cols, err := repo.MovieAudits.RevertMap(audit)
+	require.NoError(err)
+	changes, err := json.Marshal(cols)
+	require.NoError(err)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	mockRepo.EXPECT().
+		Transaction(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		ProposalsApprovedCount(ctx, contributorID).
+		Return(4, nil)
+	mockRepo.EXPECT().
+		MovieGet(ctx, id, true).
+		Return(&models.Film{ID: id, ContributedAt: version}, nil)
+	mockRepo.EXPECT().
+		MovieAuditGet(ctx, id, contributedAt).
+		Return(audit, nil)
+	// the movie is left as is and the revert pending
+	expProposal := &models.Proposal{
+		Kind:                repo.ProposalKindMovieRevert,
+		FilmID:              null.IntFrom(id),
+		Changes:             changes,
+		ProposedBy:          contributorID,
+		BaseContributedAt:   null.TimeFrom(version),
+		RevertContributedAt: null.TimeFrom(contributedAt),
+	}
+	mockRepo.EXPECT().
+		ProposalCreate(ctx, expProposal).
+		Return(nil)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	proposal, err := application.MovieAuditRevert(
+		ctx,
+		id,
+		contributedAt,
+		contributorID,
+	)
+	require.NoError(err)
+	require.Equal(expProposal, proposal)
+}
+
+func TestEpisodeAuditRevertProposed(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		episodeID     = 1
+		seriesID      = 1
+		seasonNumber  = 1
+		episodeNumber = 1
+		contributorID = 2
+		version       = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		contributedAt = time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC)
+		audit         = &models.FilmsAudit{
+			ID:            episodeID,
+			Title:         "old title",
+			DateReleased:  time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+			ContributedAt: contributedAt,
+		}
+	)
+
+	setModeration(t, 5)
+
+	cols, err := repo.EpisodeAudits.RevertMap(audit)
+	require.NoError(err)
+	changes, err := json.Marshal(cols)
+	require.NoError(err)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	mockRepo.EXPECT().
+		Transaction(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		ProposalsApprovedCount(ctx, contributorID).
+		Return(4, nil)
+	mockRepo.EXPECT().
+		EpisodeGet(ctx, seriesID, seasonNumber, episodeNumber, true).
+		Return(&models.Film{ID: episodeID, ContributedAt: version}, nil)
+	mockRepo.EXPECT().
+		EpisodeAuditGet(
+			ctx,
+			episodeID,
+			seriesID,
+			seasonNumber,
+			episodeNumber,
+			contributedAt,
+		).
+		Return(audit, nil)
+	// the episode is left as is and the revert pending
+	expProposal := &models.Proposal{
+		Kind:                repo.ProposalKindEpisodeRevert,
+		SeriesID:            null.IntFrom(seriesID),
+		SeasonNumber:        null.IntFrom(seasonNumber),
+		EpisodeNumber:       null.IntFrom(episodeNumber),
+		Changes:             changes,
+		ProposedBy:          contributorID,
+		BaseContributedAt:   null.TimeFrom(version),
+		RevertContributedAt: null.TimeFrom(contributedAt),
+	}
+	mockRepo.EXPECT().
+		ProposalCreate(ctx, expProposal).
+		Return(nil)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	proposal, err := application.EpisodeAuditRevert(
+		ctx,
+		seriesID,
+		seasonNumber,
+		episodeNumber,
+		contributedAt,
+		contributorID,
+	)
+	require.NoError(err)
+	require.Equal(expProposal, proposal)
+}
+
+func TestProposalApproveMovieRevert(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		id            = 1
+		moderatorID   = 1
+		proposerID    = 2
+		filmID        = 3
+		version       = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		contributedAt = time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC)
+		audit         = &models.FilmsAudit{
+			ID:            filmID,
+			Title:         "old title",
+			ContributedAt: contributedAt,
+		}
+	)
+
+	setModeration(t, 5, moderatorID)
+
+	cols, err := repo.MovieAudits.RevertMap(audit)
+	require.NoError(err)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	mockRepo.EXPECT().
+		Transaction(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		ProposalGet(ctx, id).
+		Return(&models.Proposal{
+			ID:                  id,
+			Kind:                repo.ProposalKindMovieRevert,
+			FilmID:              null.IntFrom(filmID),
+			Changes:             []byte(`{}`),
+			ProposedBy:          proposerID,
+			Status:              repo.ProposalStatusPending,
+			BaseContributedAt:   null.TimeFrom(version),
+			RevertContributedAt: null.TimeFrom(contributedAt),
+		}, nil)
+	// the audited snapshot is restored on behalf of the proposer while the
+	// movie is still at the version the revert was proposed against
+	mockRepo.EXPECT().
+		MovieAuditGet(gomock.Any(), filmID, contributedAt).
+		Return(audit, nil)
+	updateCall := mockRepo.EXPECT().
+		MovieUpdate(gomock.Any(), filmID, proposerID, cols).
+		DoAndReturn(func(ctx context.Context, _, _ int, _ map[string]any) error {
+			require.True(repo.IfMatch(ctx, version))
+			require.False(repo.IfMatch(ctx, version.Add(time.Second)))
+			return nil
+		})
+	mockRepo.EXPECT().
+		ProposalReview(ctx, id, moderatorID, repo.ProposalStatusApproved).
+		Return(nil).
+		After(updateCall)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	err = application.ProposalApprove(ctx, id, moderatorID)
+	require.NoError(err)
+}
+
+func TestProposalApprove(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		id          = 1
+		moderatorID = 1
+		proposerID  = 2
+		filmID      = 3
+		version     = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		req         = &dto.MovieUpdateRequest{
+			Title: null.StringFrom("title"),
+		}
+	)
+
+	setModeration(t, 5, moderatorID)
+
+	changes, err := json.Marshal(req)
+	require.NoError(t, err)
+
+	pending := &models.Proposal{
+		ID:          id,
+		Kind:        repo.ProposalKindMovieUpdate,
+		FilmID:      null.IntFrom(filmID),
+		Changes:     changes,
+		ProposedBy:  proposerID,
+		Status:      repo.ProposalStatusPending,
+		EditSummary: null.StringFrom("fix title"),
+		UserAgent:   null.StringFrom("agent"),
+
+		BaseContributedAt: null.TimeFrom(version),
+	}
+	approved := *pending
+	approved.Status = repo.ProposalStatusApproved
+
+	type ProposalGetExp struct {
+		proposal *models.Proposal
+		err      error
+	}
+	type ProposalGet struct {
+		exp ProposalGetExp
+	}
+	type UpdateExp struct {
+		err error
+	}
+	type Update struct {
+		exp UpdateExp
+	}
+	type Exp struct {
+		err error
+	}
+	type TestCase struct {
+		name        string
+		moderatorID int
+		proposalGet ProposalGet
+		update      *Update
+		exp         Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name:        "not a moderator",
+			moderatorID: proposerID,
+			exp: Exp{
+				err: app.ErrForbidden,
+			},
+		},
+
+		{
+			name:        "not found",
+			moderatorID: moderatorID,
+			proposalGet: ProposalGet{
+				exp: ProposalGetExp{
+					err: repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+
+		{
+			name:        "reviewed",
+			moderatorID: moderatorID,
+			proposalGet: ProposalGet{
+				exp: ProposalGetExp{
+					proposal: &approved,
+				},
+			},
+			exp: Exp{
+				err: app.ErrProposalReviewed,
+			},
+		},
+
+		{
+			name:        "outdated",
+			moderatorID: moderatorID,
+			proposalGet: ProposalGet{
+				exp: ProposalGetExp{
+					proposal: pending,
+				},
+			},
+			update: &Update{
+				exp: UpdateExp{
+					err: repo.ErrPreconditionFailed,
+				},
+			},
+			exp: Exp{
+				err: app.ErrProposalOutdated,
+			},
+		},
+
+		{
+			name:        "ok",
+			moderatorID: moderatorID,
+			proposalGet: ProposalGet{
+				exp: ProposalGetExp{
+					proposal: pending,
+				},
+			},
+			update: &Update{
+				exp: UpdateExp{
+					err: nil,
+				},
+			},
+			exp: Exp{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			if tc.moderatorID == moderatorID {
+				mockRepo.EXPECT().
+					Transaction(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+						return fn(ctx, mockRepo)
+					})
+				mockRepo.EXPECT().
+					ProposalGet(ctx, id).
+					Return(tc.proposalGet.exp.proposal, tc.proposalGet.exp.err)
+			}
+
+			if tc.update != nil {
+				// the change is applied on behalf of the proposer, is
+				// recorded as made through the proposing request and is
+				// conditional on the version it was proposed against
+				updateCall := mockRepo.EXPECT().
+					MovieUpdate(gomock.Any(), filmID, proposerID, map[string]any{
+						models.FilmColumns.Title: req.Title.String,
+					}).
+					DoAndReturn(func(ctx context.Context, _, _ int, _ map[string]any) error {
+						require.Equal(
+							&repo.ContributionMetadata{
+								EditSummary: pending.EditSummary,
+								UserAgent:   pending.UserAgent,
+							},
+							repo.ContributionMetadataFromContext(ctx),
+						)
+						require.True(repo.IfMatch(ctx, version))
+						require.False(repo.IfMatch(ctx, version.Add(time.Second)))
+						return tc.update.exp.err
+					})
+				if tc.update.exp.err == nil {
+					mockRepo.EXPECT().
+						ProposalReview(
+							ctx,
+							id,
+							moderatorID,
+							repo.ProposalStatusApproved,
+						).
+						Return(nil).
+						After(updateCall)
+				}
+			}
+
+			application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := application.ProposalApprove(ctx, id, tc.moderatorID)
+			require.Equal(tc.exp.err, err)
+		})
+	}
+}
+
+func TestProposalApproveNewEpisodeOutdated(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		id          = 1
+		moderatorID = 1
+		seriesID    = 3
+	)
+
+	setModeration(t, 5, moderatorID)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	mockRepo.EXPECT().
+		Transaction(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		ProposalGet(ctx, id).
+		Return(&models.Proposal{
+			ID:            id,
+			Kind:          repo.ProposalKindEpisodePut,
+			SeriesID:      null.IntFrom(seriesID),
+			SeasonNumber:  null.IntFrom(1),
+			EpisodeNumber: null.IntFrom(1),
+			Changes:       []byte(`{"title":"title"}`),
+			ProposedBy:    2,
+			Status:        repo.ProposalStatusPending,
+		}, nil)
+	// the episode proposed as new has been put since
+	mockRepo.EXPECT().
+		EpisodeGet(ctx, seriesID, 1, 1, true).
+		Return(&models.Film{ID: 4}, nil)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	err := application.ProposalApprove(ctx, id, moderatorID)
+	require.Equal(app.ErrProposalOutdated, err)
+}
+
+func TestProposalReject(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		id          = 1
+		moderatorID = 1
+	)
+
+	setModeration(t, 5, moderatorID)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	mockRepo.EXPECT().
+		Transaction(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		ProposalGet(ctx, id).
+		Return(&models.Proposal{ID: id, Status: repo.ProposalStatusPending}, nil)
+	mockRepo.EXPECT().
+		ProposalReview(ctx, id, moderatorID, repo.ProposalStatusRejected).
+		Return(nil)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	err := application.ProposalReject(ctx, id, moderatorID)
+	require.NoError(err)
+}
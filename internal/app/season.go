@@ -0,0 +1,291 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+)
+
+// SeasonGet fetches a season along with the relations to load, named by
+// models.SeasonRels.
+func (a *Application) SeasonGet(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	includeInvalidated bool,
+	loads ...string,
+) (*models.Season, error) {
+	season, err := a.repository.SeasonGet(
+		ctx,
+		seriesID,
+		seasonNumber,
+		includeInvalidated,
+		loads...,
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return season, nil
+}
+
+func (a *Application) SeasonsGetAllBySeries(
+	ctx context.Context,
+	seriesID int,
+	offset, limit int,
+	includeInvalidated bool,
+) (seasons []*repo.SeasonWithEpisodesCount, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			var err error
+			seasons, err = tx.SeasonsGetAllBySeries(
+				ctx,
+				seriesID,
+				offset,
+				limit,
+				includeInvalidated,
+			)
+			if err != nil {
+				return err
+			}
+			total, err = tx.SeasonsCountBySeries(
+				ctx,
+				seriesID,
+				includeInvalidated,
+			)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return seasons, total, nil
+}
+
+//------------------------------------------------------------------------------
+
+func (a *Application) SeasonPut(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	contributorID int,
+	req *dto.SeasonPutRequest,
+) error {
+	err := a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check series exists
+			_, err := tx.SeriesGet(ctx, seriesID, true)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// then put season
+			return tx.SeasonPut(
+				ctx,
+				seriesID,
+				seasonNumber,
+				contributorID,
+				&models.Season{
+					Title:        req.Title,
+					Descriptions: req.Descriptions,
+					DateStarted:  req.DateStarted,
+					DateEnded:    req.DateEnded,
+				},
+			)
+		},
+	)
+	return err
+}
+
+func (a *Application) SeasonUpdate(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	contributorID int,
+	req *dto.SeasonUpdateRequest,
+) error {
+	columns := seasonUpdateRequestToValidMap(req)
+
+	err := a.repository.SeasonUpdate(
+		ctx,
+		seriesID,
+		seasonNumber,
+		contributorID,
+		columns,
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func seasonUpdateRequestToValidMap(
+	req *dto.SeasonUpdateRequest,
+) map[string]any {
+	m := make(map[string]any)
+	if req.Title.Valid {
+		m[models.SeasonColumns.Title] = req.Title.String
+	}
+	if req.Descriptions.Valid {
+		m[models.SeasonColumns.Descriptions] = req.Descriptions.String
+	}
+	if req.DateStarted.Valid {
+		m[models.SeasonColumns.DateStarted] = req.DateStarted.Time
+	}
+	if req.DateEnded.Valid {
+		m[models.SeasonColumns.DateEnded] = req.DateEnded.Time
+	}
+	return m
+}
+
+func (a *Application) SeasonInvalidate(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	contributorID int,
+	req *dto.InvalidationRequest,
+) error {
+	err := a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// first invalidate season itself
+			err := tx.SeasonInvalidate(
+				ctx,
+				seriesID,
+				seasonNumber,
+				contributorID,
+				req.Invalidation,
+			)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// then invalidate the season episodes if any
+			err = tx.EpisodesInvalidateAllBySeason(
+				ctx,
+				seriesID,
+				seasonNumber,
+				contributorID,
+				req.Invalidation,
+			)
+			if err != nil && err != repo.ErrNoRecord {
+				return err
+			}
+			return nil
+		},
+	)
+	return err
+}
+
+// SeasonRestore clears the invalidation of a season, its episodes are left
+// to EpisodesRestoreAllBySeason.
+func (a *Application) SeasonRestore(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	contributorID int,
+) error {
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			season, err := tx.SeasonGet(ctx, seriesID, seasonNumber, true)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			if !season.Invalidation.Valid {
+				return nil
+			}
+			return tx.SeasonRestore(ctx, seriesID, seasonNumber, contributorID)
+		},
+	)
+}
+
+//------------------------------------------------------------------------------
+
+func (a *Application) SeasonAuditsGetAll(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	offset, limit int,
+) (audits []*models.SeasonsAudit, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the season exists
+			_, err := tx.SeasonGet(ctx, seriesID, seasonNumber, true)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch audits
+			audits, err = tx.SeasonAuditsGetAll(
+				ctx,
+				seriesID,
+				seasonNumber,
+				offset,
+				limit,
+			)
+			if err != nil {
+				return err
+			}
+			// count total audits
+			total, err = tx.SeasonAuditsCount(ctx, seriesID, seasonNumber)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
+
+func (a *Application) SeasonAuditsGetPage(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	cursor string,
+	limit int,
+) (audits []*models.SeasonsAudit, prevCursor, nextCursor string, err error) {
+	page, err := decodePage(cursor, limit, new(time.Time), new(int))
+	if err != nil {
+		return nil, "", "", err
+	}
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the season exists
+			_, err := tx.SeasonGet(ctx, seriesID, seasonNumber, true)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			audits, err = tx.SeasonAuditsGetPage(
+				ctx,
+				seriesID,
+				seasonNumber,
+				page,
+			)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, "", "", err
+	}
+	audits, prevCursor, nextCursor = pageRows(audits, page, seasonsAuditKeyset)
+	return audits, prevCursor, nextCursor, nil
+}
@@ -0,0 +1,780 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	_ "unsafe"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestSeasonGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		seriesID     = 1
+		seasonNumber = 1
+		expError     = errors.New("error")
+		expSeason    = &models.Season{
+			SeriesID:     seriesID,
+			SeasonNumber: seasonNumber,
+			Title:        null.StringFrom("season"),
+		}
+	)
+
+	type GetExp struct {
+		season *models.Season
+		err    error
+	}
+	type Get struct {
+		exp GetExp
+	}
+	type Exp struct {
+		season *models.Season
+		err    error
+	}
+	type TestCase struct {
+		name string
+		get  Get
+		exp  Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "error",
+			get: Get{
+				exp: GetExp{
+					season: nil,
+					err:    expError,
+				},
+			},
+			exp: Exp{
+				season: nil,
+				err:    expError,
+			},
+		},
+		{
+			name: "not found",
+			get: Get{
+				exp: GetExp{
+					season: nil,
+					err:    repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				season: nil,
+				err:    app.ErrNotFound,
+			},
+		},
+		{
+			name: "ok",
+			get: Get{
+				exp: GetExp{
+					season: expSeason,
+					err:    nil,
+				},
+			},
+			exp: Exp{
+				season: expSeason,
+				err:    nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				SeasonGet(ctx, seriesID, seasonNumber, true).
+				Return(tc.get.exp.season, tc.get.exp.err)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			season, err := app.SeasonGet(ctx, seriesID, seasonNumber, true)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.season, season)
+		})
+	}
+}
+
+func TestSeasonsGetAllBySeries(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		seriesID = 1
+		offset   = 0
+		limit    = 50
+
+		expSeasons = []*repo.SeasonWithEpisodesCount{
+			{
+				Season: models.Season{
+					SeriesID:     seriesID,
+					SeasonNumber: 1,
+				},
+				EpisodesCount: 10,
+			},
+		}
+		expTotal              = 1
+		expSeasonsGetAllError = errors.New("SeasonsGetAllBySeries error")
+		expSeasonsCountError  = errors.New("SeasonsCountBySeries error")
+	)
+
+	type TxExp struct {
+		err error
+	}
+	type Tx struct {
+		exp TxExp
+	}
+	type GetAllExp struct {
+		seasons []*repo.SeasonWithEpisodesCount
+		err     error
+	}
+	type GetAll struct {
+		exp GetAllExp
+	}
+	type CountExp struct {
+		total int
+		err   error
+	}
+	type Count struct {
+		exp CountExp
+	}
+	type Exp struct {
+		seasons []*repo.SeasonWithEpisodesCount
+		total   int
+		err     error
+	}
+	type TestCase struct {
+		name   string
+		tx     Tx
+		getAll GetAll
+		count  Count
+		exp    Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "SeasonsGetAllBySeries error",
+			tx: Tx{
+				exp: TxExp{
+					err: expSeasonsGetAllError,
+				},
+			},
+			getAll: GetAll{
+				exp: GetAllExp{
+					seasons: nil,
+					err:     expSeasonsGetAllError,
+				},
+			},
+			exp: Exp{
+				seasons: nil,
+				total:   0,
+				err:     expSeasonsGetAllError,
+			},
+		},
+
+		{
+			name: "SeasonsCountBySeries error",
+			tx: Tx{
+				exp: TxExp{
+					err: expSeasonsCountError,
+				},
+			},
+			getAll: GetAll{
+				exp: GetAllExp{
+					seasons: expSeasons,
+					err:     nil,
+				},
+			},
+			count: Count{
+				exp: CountExp{
+					total: 0,
+					err:   expSeasonsCountError,
+				},
+			},
+			exp: Exp{
+				seasons: nil,
+				total:   0,
+				err:     expSeasonsCountError,
+			},
+		},
+
+		{
+			name: "ok",
+			tx: Tx{
+				exp: TxExp{
+					err: nil,
+				},
+			},
+			getAll: GetAll{
+				exp: GetAllExp{
+					seasons: expSeasons,
+					err:     nil,
+				},
+			},
+			count: Count{
+				exp: CountExp{
+					total: expTotal,
+					err:   nil,
+				},
+			},
+			exp: Exp{
+				seasons: expSeasons,
+				total:   expTotal,
+				err:     nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			txCall := mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				Do(func(ctx context.Context, fn func(_ context.Context, _ repo.Service) error) {
+					fn(ctx, mockRepo)
+				}).
+				Return(tc.tx.exp.err)
+
+			getAllCall := mockRepo.EXPECT().
+				SeasonsGetAllBySeries(ctx, seriesID, offset, limit, true).
+				Return(tc.getAll.exp.seasons, tc.getAll.exp.err).
+				After(txCall)
+
+			if tc.getAll.exp.err == nil {
+				mockRepo.EXPECT().
+					SeasonsCountBySeries(ctx, seriesID, true).
+					Return(tc.count.exp.total, tc.count.exp.err).
+					After(getAllCall)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			seasons, total, err := app.SeasonsGetAllBySeries(
+				ctx,
+				seriesID,
+				offset,
+				limit,
+				true,
+			)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.seasons, seasons)
+			require.Equal(tc.exp.total, total)
+		})
+	}
+}
+
+func TestSeasonPut(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		seriesID      = 1
+		seasonNumber  = 1
+		contributorID = 1
+		expSeries     = &models.Series{
+			ID:    seriesID,
+			Title: "series",
+		}
+		req = &dto.SeasonPutRequest{
+			Title: null.StringFrom("season"),
+		}
+		expSeriesGetError = errors.New("SeriesGet error")
+		expSeasonPutError = errors.New("SeasonPut error")
+	)
+
+	type TxExp struct {
+		err error
+	}
+	type Tx struct {
+		exp TxExp
+	}
+	type GetSeriesExp struct {
+		series *models.Series
+		err    error
+	}
+	type GetSeries struct {
+		exp GetSeriesExp
+	}
+	type PutExp struct {
+		err error
+	}
+	type Put struct {
+		exp PutExp
+	}
+	type Exp struct {
+		err error
+	}
+	type TestCase struct {
+		name      string
+		tx        Tx
+		getSeries GetSeries
+		put       Put
+		exp       Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "not found",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrNotFound,
+				},
+			},
+			getSeries: GetSeries{
+				exp: GetSeriesExp{
+					series: nil,
+					err:    repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+		{
+			name: "SeriesGet error",
+			tx: Tx{
+				exp: TxExp{
+					err: expSeriesGetError,
+				},
+			},
+			getSeries: GetSeries{
+				exp: GetSeriesExp{
+					series: nil,
+					err:    expSeriesGetError,
+				},
+			},
+			exp: Exp{
+				err: expSeriesGetError,
+			},
+		},
+		{
+			name: "SeasonPut error",
+			tx: Tx{
+				exp: TxExp{
+					err: expSeasonPutError,
+				},
+			},
+			getSeries: GetSeries{
+				exp: GetSeriesExp{
+					series: expSeries,
+					err:    nil,
+				},
+			},
+			put: Put{
+				exp: PutExp{err: expSeasonPutError},
+			},
+			exp: Exp{
+				err: expSeasonPutError,
+			},
+		},
+		{
+			name: "ok",
+			tx: Tx{
+				exp: TxExp{
+					err: nil,
+				},
+			},
+			getSeries: GetSeries{
+				exp: GetSeriesExp{
+					series: expSeries,
+					err:    nil,
+				},
+			},
+			put: Put{
+				exp: PutExp{err: nil},
+			},
+			exp: Exp{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			txCall := mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				Do(func(ctx context.Context, fn func(_ context.Context, _ repo.Service) error) {
+					fn(ctx, mockRepo)
+				}).
+				Return(tc.tx.exp.err)
+
+			seriesGetCall := mockRepo.EXPECT().
+				SeriesGet(ctx, seriesID, true).
+				Return(tc.getSeries.exp.series, tc.getSeries.exp.err).
+				After(txCall)
+
+			if tc.getSeries.exp.err == nil {
+				mockRepo.EXPECT().
+					SeasonPut(
+						ctx,
+						seriesID,
+						seasonNumber,
+						contributorID,
+						&models.Season{
+							Title:        req.Title,
+							Descriptions: req.Descriptions,
+							DateStarted:  req.DateStarted,
+							DateEnded:    req.DateEnded,
+						},
+					).
+					Return(tc.put.exp.err).
+					After(seriesGetCall)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.SeasonPut(
+				ctx,
+				seriesID,
+				seasonNumber,
+				contributorID,
+				req,
+			)
+			require.Equal(tc.exp.err, err)
+		})
+	}
+}
+
+//go:linkname seasonUpdateRequestToValidMap github.com/aria3ppp/watch-server/internal/app.seasonUpdateRequestToValidMap
+func seasonUpdateRequestToValidMap(
+	req *dto.SeasonUpdateRequest,
+) map[string]any
+
+func TestSeasonUpdate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		seriesID      = 1
+		seasonNumber  = 1
+		contributorID = 1
+		req           = &dto.SeasonUpdateRequest{
+			Title:        null.StringFrom("season"),
+			Descriptions: null.StringFrom("descriptions"),
+		}
+		expError = errors.New("error")
+	)
+
+	type UpdateExp struct {
+		err error
+	}
+	type Update struct {
+		exp UpdateExp
+	}
+	type Exp struct {
+		err error
+	}
+	type TestCase struct {
+		name   string
+		update Update
+		exp    Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "error",
+			update: Update{
+				exp: UpdateExp{
+					err: expError,
+				},
+			},
+			exp: Exp{
+				err: expError,
+			},
+		},
+		{
+			name: "not found",
+			update: Update{
+				exp: UpdateExp{
+					err: repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+		{
+			name: "ok",
+			update: Update{
+				exp: UpdateExp{
+					err: nil,
+				},
+			},
+			exp: Exp{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				SeasonUpdate(
+					ctx,
+					seriesID,
+					seasonNumber,
+					contributorID,
+					seasonUpdateRequestToValidMap(req),
+				).
+				Return(tc.update.exp.err)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.SeasonUpdate(
+				ctx,
+				seriesID,
+				seasonNumber,
+				contributorID,
+				req,
+			)
+			require.Equal(tc.exp.err, err)
+		})
+	}
+}
+
+func TestSeasonInvalidate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		seriesID      = 1
+		seasonNumber  = 1
+		contributorID = 1
+		req           = &dto.InvalidationRequest{
+			Invalidation: "invalidation",
+		}
+
+		expSeasonInvalidateError   = errors.New("SeasonInvalidate error")
+		expEpisodesInvalidateError = errors.New(
+			"EpisodesInvalidateAllBySeason error",
+		)
+	)
+
+	type TxExp struct {
+		err error
+	}
+	type Tx struct {
+		exp TxExp
+	}
+	type SeasonInvalidateExp struct {
+		err error
+	}
+	type SeasonInvalidate struct {
+		exp SeasonInvalidateExp
+	}
+	type EpisodesInvalidateAllExp struct {
+		err error
+	}
+	type EpisodesInvalidateAll struct {
+		exp EpisodesInvalidateAllExp
+	}
+	type Exp struct {
+		err error
+	}
+	type TestCase struct {
+		name                  string
+		tx                    Tx
+		seasonInvalidate      SeasonInvalidate
+		episodesInvalidateAll EpisodesInvalidateAll
+		exp                   Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "SeasonInvalidate error",
+			tx: Tx{
+				exp: TxExp{
+					err: expSeasonInvalidateError,
+				},
+			},
+			seasonInvalidate: SeasonInvalidate{
+				exp: SeasonInvalidateExp{
+					err: expSeasonInvalidateError,
+				},
+			},
+			exp: Exp{
+				err: expSeasonInvalidateError,
+			},
+		},
+
+		{
+			name: "not found",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrNotFound,
+				},
+			},
+			seasonInvalidate: SeasonInvalidate{
+				exp: SeasonInvalidateExp{
+					err: repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "EpisodesInvalidateAllBySeason error",
+			tx: Tx{
+				exp: TxExp{
+					err: expEpisodesInvalidateError,
+				},
+			},
+			seasonInvalidate: SeasonInvalidate{
+				exp: SeasonInvalidateExp{
+					err: nil,
+				},
+			},
+			episodesInvalidateAll: EpisodesInvalidateAll{
+				exp: EpisodesInvalidateAllExp{
+					err: expEpisodesInvalidateError,
+				},
+			},
+			exp: Exp{
+				err: expEpisodesInvalidateError,
+			},
+		},
+
+		{
+			name: "no episodes",
+			tx: Tx{
+				exp: TxExp{
+					err: nil,
+				},
+			},
+			seasonInvalidate: SeasonInvalidate{
+				exp: SeasonInvalidateExp{
+					err: nil,
+				},
+			},
+			episodesInvalidateAll: EpisodesInvalidateAll{
+				exp: EpisodesInvalidateAllExp{
+					err: repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				err: nil,
+			},
+		},
+
+		{
+			name: "ok",
+			tx: Tx{
+				exp: TxExp{
+					err: nil,
+				},
+			},
+			seasonInvalidate: SeasonInvalidate{
+				exp: SeasonInvalidateExp{
+					err: nil,
+				},
+			},
+			episodesInvalidateAll: EpisodesInvalidateAll{
+				exp: EpisodesInvalidateAllExp{
+					err: nil,
+				},
+			},
+			exp: Exp{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			var txErr error
+			txCall := mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				Do(func(ctx context.Context, fn func(_ context.Context, _ repo.Service) error) {
+					txErr = fn(ctx, mockRepo)
+				}).
+				Return(tc.tx.exp.err)
+
+			seasonInvalidateCall := mockRepo.EXPECT().
+				SeasonInvalidate(
+					ctx,
+					seriesID,
+					seasonNumber,
+					contributorID,
+					req.Invalidation,
+				).
+				Return(tc.seasonInvalidate.exp.err).
+				After(txCall)
+
+			if tc.seasonInvalidate.exp.err == nil {
+				mockRepo.EXPECT().
+					EpisodesInvalidateAllBySeason(
+						ctx,
+						seriesID,
+						seasonNumber,
+						contributorID,
+						req.Invalidation,
+					).
+					Return(tc.episodesInvalidateAll.exp.err).
+					After(seasonInvalidateCall)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.SeasonInvalidate(
+				ctx,
+				seriesID,
+				seasonNumber,
+				contributorID,
+				req,
+			)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.tx.exp.err, txErr)
+		})
+	}
+}
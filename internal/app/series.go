@@ -2,17 +2,27 @@ package app
 
 import (
 	"context"
+	"time"
 
 	"github.com/aria3ppp/watch-server/internal/dto"
 	"github.com/aria3ppp/watch-server/internal/models"
 	"github.com/aria3ppp/watch-server/internal/repo"
 )
 
+// SeriesGet fetches a series along with the relations to load, named by
+// models.SeriesRels.
 func (a *Application) SeriesGet(
 	ctx context.Context,
 	id int,
+	includeInvalidated bool,
+	loads ...string,
 ) (*models.Series, error) {
-	series, err := a.repository.SeriesGet(ctx, id)
+	series, err := a.repository.SeriesGet(
+		ctx,
+		id,
+		includeInvalidated,
+		loads...,
+	)
 	if err != nil {
 		if err == repo.ErrNoRecord {
 			return nil, ErrNotFound
@@ -24,17 +34,27 @@ func (a *Application) SeriesGet(
 
 func (a *Application) SeriesesGetAll(
 	ctx context.Context,
+	filter repo.ListFilter,
+	sort repo.ListSort,
 	offset, limit int,
+	includeInvalidated bool,
 ) (series []*models.Series, total int, err error) {
 	err = a.repository.Transaction(
 		ctx,
 		func(ctx context.Context, tx repo.Service) error {
 			var err error
-			series, err = tx.SeriesesGetAll(ctx, offset, limit)
+			series, err = tx.SeriesesGetAll(
+				ctx,
+				filter,
+				sort,
+				offset,
+				limit,
+				includeInvalidated,
+			)
 			if err != nil {
 				return err
 			}
-			total, err = tx.SeriesesCount(ctx)
+			total, err = tx.SeriesesCount(ctx, filter, includeInvalidated)
 			return err
 		},
 	)
@@ -77,6 +97,9 @@ func (a *Application) SeriesUpdate(
 		if err == repo.ErrNoRecord {
 			return ErrNotFound
 		}
+		if err == repo.ErrPreconditionFailed {
+			return ErrPreconditionFailed
+		}
 		return err
 	}
 
@@ -122,6 +145,9 @@ func (a *Application) SeriesInvalidate(
 				if err == repo.ErrNoRecord {
 					return ErrNotFound
 				}
+				if err == repo.ErrPreconditionFailed {
+					return ErrPreconditionFailed
+				}
 				return err
 			}
 			// then invalidate all the related episodes if any
@@ -140,6 +166,51 @@ func (a *Application) SeriesInvalidate(
 	return err
 }
 
+// SeriesRestore clears the invalidation of a series. With cascade set the
+// episodes invalidated along with the series are restored as well.
+func (a *Application) SeriesRestore(
+	ctx context.Context,
+	seriesID int,
+	contributorID int,
+	cascade bool,
+) error {
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			series, err := tx.SeriesGet(ctx, seriesID, true)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			if !series.Invalidation.Valid {
+				return nil
+			}
+			err = tx.SeriesRestore(ctx, seriesID, contributorID)
+			if err != nil {
+				return err
+			}
+			if !cascade {
+				return nil
+			}
+			// episodes invalidated by SeriesInvalidate share the series
+			// invalidation reason and contribution time
+			err = tx.EpisodesRestoreAllBySeries(
+				ctx,
+				seriesID,
+				contributorID,
+				series.ContributedAt,
+				series.Invalidation.String,
+			)
+			if err != nil && err != repo.ErrNoRecord {
+				return err
+			}
+			return nil
+		},
+	)
+}
+
 func (a *Application) SeriesAuditsGetAll(
 	ctx context.Context,
 	id int,
@@ -149,7 +220,7 @@ func (a *Application) SeriesAuditsGetAll(
 		ctx,
 		func(ctx context.Context, tx repo.Service) error {
 			// first check the series exists
-			_, err := tx.SeriesGet(ctx, id)
+			_, err := tx.SeriesGet(ctx, id, true)
 			if err != nil {
 				if err == repo.ErrNoRecord {
 					return ErrNotFound
@@ -177,10 +248,198 @@ func (a *Application) SeriesAuditsGetAll(
 	return audits, total, nil
 }
 
+func (a *Application) SeriesAuditsGetPage(
+	ctx context.Context,
+	id int,
+	cursor string,
+	limit int,
+) (audits []*models.SeriesesAudit, prevCursor, nextCursor string, err error) {
+	page, err := decodePage(cursor, limit, new(time.Time), new(int))
+	if err != nil {
+		return nil, "", "", err
+	}
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the series exists
+			_, err := tx.SeriesGet(ctx, id, true)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			audits, err = tx.SeriesAuditsGetPage(ctx, id, page)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, "", "", err
+	}
+	audits, prevCursor, nextCursor = pageRows(audits, page, seriesesAuditKeyset)
+	return audits, prevCursor, nextCursor, nil
+}
+
+// SeriesAuditRevert restores the series snapshot audited at contributedAt as
+// a new contribution of contributorID.
+func (a *Application) SeriesAuditRevert(
+	ctx context.Context,
+	id int,
+	contributedAt time.Time,
+	contributorID int,
+) error {
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			audit, err := tx.SeriesAuditGet(ctx, id, contributedAt)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			cols, err := repo.SeriesAudits.RevertMap(audit)
+			if err != nil {
+				return err
+			}
+			return tx.SeriesUpdate(ctx, id, contributorID, cols)
+		},
+	)
+}
+
+// SeriesAuditDiff compares two revisions of a series by their contribution
+// times, the current series being its latest revision.
+func (a *Application) SeriesAuditDiff(
+	ctx context.Context,
+	id int,
+	from, to time.Time,
+) (diff *repo.AuditDiff, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			fromRevision, err := seriesRevisionGet(ctx, tx, id, from)
+			if err != nil {
+				return err
+			}
+			toRevision, err := seriesRevisionGet(ctx, tx, id, to)
+			if err != nil {
+				return err
+			}
+			diff, err = repo.SeriesAudits.Diff(fromRevision, toRevision)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// SeriesAuditDiffsGetAll diffs each revision of a series, newest first, against
+// its predecessor.
+func (a *Application) SeriesAuditDiffsGetAll(
+	ctx context.Context,
+	id int,
+	offset, limit int,
+) (diffs []*repo.AuditDiff, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			series, err := tx.SeriesGet(ctx, id, true)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			auditsOffset, auditsLimit := auditRevisionsRange(offset, limit)
+			audits, err := tx.SeriesAuditsGetAll(ctx, id, auditsOffset, auditsLimit)
+			if err != nil {
+				return err
+			}
+			revisions := make([]any, 0, len(audits)+1)
+			if offset == 0 {
+				revisions = append(revisions, series)
+			}
+			for _, audit := range audits {
+				revisions = append(revisions, audit)
+			}
+			diffs, err = repo.SeriesAudits.DiffAll(revisions)
+			if err != nil {
+				return err
+			}
+			// every audit is the predecessor of exactly one revision
+			total, err = tx.SeriesAuditsCount(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return diffs, total, nil
+}
+
+// seriesRevisionGet returns the series row if it was contributed at contributedAt
+// or the audit contributed then otherwise.
+func seriesRevisionGet(
+	ctx context.Context,
+	tx repo.Service,
+	id int,
+	contributedAt time.Time,
+) (any, error) {
+	series, err := tx.SeriesGet(ctx, id, true)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if series.ContributedAt.Equal(contributedAt) {
+		return series, nil
+	}
+	audit, err := tx.SeriesAuditGet(ctx, id, contributedAt)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return audit, nil
+}
+
 func (a *Application) SeriesesSearch(
 	ctx context.Context,
 	req *dto.SearchRequest,
 	offset, limit int,
 ) (results []*models.Series, total int, err error) {
-	panic("not implemented")
+	results, total, err = a.search.SearchSerieses(ctx, req.Query, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	return results, total, nil
+}
+
+// SeriesesSearchPage is the cursor paginated counterpart of SeriesesSearch,
+// keyed by the relevance and then the id of the results. The results aren't
+// counted.
+func (a *Application) SeriesesSearchPage(
+	ctx context.Context,
+	req *dto.SearchRequest,
+	cursor string,
+	limit int,
+) (results []*models.Series, prevCursor, nextCursor string, err error) {
+	page, err := decodePage(cursor, limit, new(float64), new(string))
+	if err != nil {
+		return nil, "", "", err
+	}
+	hits, err := a.search.SearchSeriesesPage(ctx, req.Query, page)
+	if err != nil {
+		return nil, "", "", err
+	}
+	hits, prevCursor, nextCursor = pageRows(hits, page, seriesHitKeyset)
+	results = make([]*models.Series, len(hits))
+	for i, hit := range hits {
+		results[i] = hit.Series
+	}
+	return results, prevCursor, nextCursor, nil
 }
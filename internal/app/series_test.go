@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 	_ "unsafe"
 
 	"github.com/aria3ppp/watch-server/internal/app"
@@ -97,12 +98,12 @@ func TestSeriesGet(t *testing.T) {
 			mockRepo := mock_repo.NewMockRepositoryTx(controller)
 
 			mockRepo.EXPECT().
-				SeriesGet(ctx, id).
+				SeriesGet(ctx, id, true).
 				Return(tc.get.exp.series, tc.get.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
-			series, err := app.SeriesGet(ctx, id)
+			series, err := app.SeriesGet(ctx, id, true)
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.series, series)
 		})
@@ -117,6 +118,10 @@ func TestSeriesesGetAll(t *testing.T) {
 
 		offset = 0
 		limit  = 50
+		filter = repo.ListFilter{
+			{Column: models.SeriesColumns.Title, Operator: repo.FilterContains, Value: "title"},
+		}
+		sort = repo.ListSort{{Column: models.SeriesColumns.Title, Desc: true}}
 
 		expSerieses            = []*models.Series{{Title: "series"}}
 		expTotal               = 1000
@@ -248,20 +253,20 @@ func TestSeriesesGetAll(t *testing.T) {
 				Return(tc.tx.exp.err)
 
 			getAllCall := mockRepo.EXPECT().
-				SeriesesGetAll(ctx, offset, limit).
+				SeriesesGetAll(ctx, filter, sort, offset, limit, true).
 				Return(tc.getAll.exp.serieses, tc.getAll.exp.err).
 				After(txCall)
 
 			if tc.getAll.exp.err == nil {
 				mockRepo.EXPECT().
-					SeriesesCount(ctx).
+					SeriesesCount(ctx, filter, true).
 					Return(tc.count.exp.total, tc.count.exp.err).
 					After(getAllCall)
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
-			serieses, total, err := app.SeriesesGetAll(ctx, offset, limit)
+			serieses, total, err := app.SeriesesGetAll(ctx, filter, sort, offset, limit, true)
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.serieses, serieses)
 			require.Equal(tc.exp.total, total)
@@ -351,7 +356,7 @@ func TestSeriesCreate(t *testing.T) {
 				}).
 				Return(tc.upsert.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			id, err := app.SeriesCreate(ctx, contributorID, req)
 			require.Equal(tc.exp.err, err)
@@ -452,7 +457,7 @@ func TestSeriesUpdate(t *testing.T) {
 				SeriesUpdate(ctx, seriesID, contributorID, seriesUpdateRequestToValidMap(req)).
 				Return(tc.update.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.SeriesUpdate(
 				ctx,
@@ -621,7 +626,7 @@ func TestSeriesInvalidate(t *testing.T) {
 					After(seriessInvalidate)
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.SeriesInvalidate(
 				ctx,
@@ -845,7 +850,7 @@ func TestSeriesAuditsGetAll(t *testing.T) {
 				Return(tc.tx.exp.err)
 
 			seriesGetCall := mockRepo.EXPECT().
-				SeriesGet(ctx, seriesID).
+				SeriesGet(ctx, seriesID, true).
 				Return(tc.seriesGet.exp.series, tc.seriesGet.exp.err).
 				After(txCall)
 
@@ -863,7 +868,7 @@ func TestSeriesAuditsGetAll(t *testing.T) {
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			audits, total, err := app.SeriesAuditsGetAll(
 				ctx,
@@ -876,3 +881,142 @@ func TestSeriesAuditsGetAll(t *testing.T) {
 		})
 	}
 }
+
+func TestSeriesRestore(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		seriesID      = 1
+		contributorID = 2
+		invalidatedAt = time.Date(2022, 10, 19, 12, 0, 0, 0, time.UTC)
+
+		validSeries = &models.Series{
+			ID:    seriesID,
+			Title: "series",
+		}
+		invalidatedSeries = &models.Series{
+			ID:            seriesID,
+			Title:         "series",
+			ContributedAt: invalidatedAt,
+			Invalidation:  null.StringFrom("invalidation"),
+		}
+	)
+
+	type SeriesGetExp struct {
+		series *models.Series
+		err    error
+	}
+	type EpisodesRestoreExp struct {
+		err error
+	}
+	type Exp struct {
+		err error
+	}
+	type TestCase struct {
+		name            string
+		cascade         bool
+		seriesGet       SeriesGetExp
+		restore         bool
+		episodesRestore *EpisodesRestoreExp
+		exp             Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "not found",
+			seriesGet: SeriesGetExp{
+				series: nil,
+				err:    repo.ErrNoRecord,
+			},
+			exp: Exp{err: app.ErrNotFound},
+		},
+
+		{
+			name: "not invalidated",
+			seriesGet: SeriesGetExp{
+				series: validSeries,
+				err:    nil,
+			},
+			exp: Exp{err: nil},
+		},
+
+		{
+			name: "ok",
+			seriesGet: SeriesGetExp{
+				series: invalidatedSeries,
+				err:    nil,
+			},
+			restore: true,
+			exp:     Exp{err: nil},
+		},
+
+		{
+			name:    "ok cascade",
+			cascade: true,
+			seriesGet: SeriesGetExp{
+				series: invalidatedSeries,
+				err:    nil,
+			},
+			restore:         true,
+			episodesRestore: &EpisodesRestoreExp{err: nil},
+			exp:             Exp{err: nil},
+		},
+
+		{
+			name:    "ok cascade without episodes",
+			cascade: true,
+			seriesGet: SeriesGetExp{
+				series: invalidatedSeries,
+				err:    nil,
+			},
+			restore:         true,
+			episodesRestore: &EpisodesRestoreExp{err: repo.ErrNoRecord},
+			exp:             Exp{err: nil},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			mockRepo.EXPECT().
+				SeriesGet(ctx, seriesID, true).
+				Return(tc.seriesGet.series, tc.seriesGet.err)
+
+			if tc.restore {
+				mockRepo.EXPECT().
+					SeriesRestore(ctx, seriesID, contributorID).
+					Return(nil)
+			}
+			if tc.episodesRestore != nil {
+				mockRepo.EXPECT().
+					EpisodesRestoreAllBySeries(
+						ctx,
+						seriesID,
+						contributorID,
+						invalidatedAt,
+						invalidatedSeries.Invalidation.String,
+					).
+					Return(tc.episodesRestore.err)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.SeriesRestore(ctx, seriesID, contributorID, tc.cascade)
+			require.Equal(tc.exp.err, err)
+		})
+	}
+}
@@ -0,0 +1,694 @@
+// Code generated by tracegen. DO NOT EDIT.
+
+package app
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// tracedService records a span for each call to the methods of next
+type tracedService struct {
+	next Service
+}
+
+var _ Service = &tracedService{}
+
+func (s *tracedService) UserGet(ctx context.Context, id int) (_ *models.User, err error) {
+	ctx, span := tracing.Start(ctx, "app.UserGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserGet(ctx, id)
+}
+
+func (s *tracedService) UserCreate(ctx context.Context, req *dto.UserCreateRequest) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.UserCreate")
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserCreate(ctx, req)
+}
+
+func (s *tracedService) UserUpdate(ctx context.Context, id int, req *dto.UserUpdateRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.UserUpdate", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserUpdate(ctx, id, req)
+}
+
+func (s *tracedService) UserDelete(ctx context.Context, id int, req *dto.UserDeleteRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.UserDelete", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserDelete(ctx, id, req)
+}
+
+func (s *tracedService) UserAuthorize(ctx context.Context, userID int, pendingDeletionAllowed bool) (err error) {
+	ctx, span := tracing.Start(ctx, "app.UserAuthorize", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserAuthorize(ctx, userID, pendingDeletionAllowed)
+}
+
+func (s *tracedService) UserRestore(ctx context.Context, userID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.UserRestore", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserRestore(ctx, userID)
+}
+
+func (s *tracedService) UsersAnonymize(ctx context.Context) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.UsersAnonymize")
+	defer func() { tracing.End(span, err) }()
+	return s.next.UsersAnonymize(ctx)
+}
+
+func (s *tracedService) IdempotencyKeyBegin(ctx context.Context, userID int, key string, requestHash string) (_ *models.IdempotencyKey, err error) {
+	ctx, span := tracing.Start(ctx, "app.IdempotencyKeyBegin", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.IdempotencyKeyBegin(ctx, userID, key, requestHash)
+}
+
+func (s *tracedService) IdempotencyKeyEnd(ctx context.Context, userID int, key string, responseStatus int, responseBody string) (err error) {
+	ctx, span := tracing.Start(ctx, "app.IdempotencyKeyEnd", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.IdempotencyKeyEnd(ctx, userID, key, responseStatus, responseBody)
+}
+
+func (s *tracedService) IdempotencyKeyRelease(ctx context.Context, userID int, key string) (err error) {
+	ctx, span := tracing.Start(ctx, "app.IdempotencyKeyRelease", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.IdempotencyKeyRelease(ctx, userID, key)
+}
+
+func (s *tracedService) IdempotencyKeysPrune(ctx context.Context) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.IdempotencyKeysPrune")
+	defer func() { tracing.End(span, err) }()
+	return s.next.IdempotencyKeysPrune(ctx)
+}
+
+func (s *tracedService) UserEmailUpdate(ctx context.Context, userID int, req *dto.UserEmailUpdateRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.UserEmailUpdate", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserEmailUpdate(ctx, userID, req)
+}
+
+func (s *tracedService) UserPasswordUpdate(ctx context.Context, id int, req *dto.UserPasswordUpdateRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.UserPasswordUpdate", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserPasswordUpdate(ctx, id, req)
+}
+
+func (s *tracedService) UserLogin(ctx context.Context, req *dto.UserLoginRequest) (_ string, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "app.UserLogin")
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserLogin(ctx, req)
+}
+
+func (s *tracedService) UserRefreshToken(ctx context.Context, refreshToken string) (_ string, err error) {
+	ctx, span := tracing.Start(ctx, "app.UserRefreshToken")
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserRefreshToken(ctx, refreshToken)
+}
+
+func (s *tracedService) UserAuditsGetAll(ctx context.Context, userID int, offset int, limit int) (_ []*models.UsersAudit, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.UserAuditsGetAll", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserAuditsGetAll(ctx, userID, offset, limit)
+}
+
+func (s *tracedService) UserAuditsGetPage(ctx context.Context, userID int, cursor string, limit int) (_ []*models.UsersAudit, _ string, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "app.UserAuditsGetPage", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserAuditsGetPage(ctx, userID, cursor, limit)
+}
+
+func (s *tracedService) UserFollow(ctx context.Context, followerID int, followedID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.UserFollow", attribute.Int("follower_id", followerID), attribute.Int("followed_id", followedID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserFollow(ctx, followerID, followedID)
+}
+
+func (s *tracedService) UserUnfollow(ctx context.Context, followerID int, followedID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.UserUnfollow", attribute.Int("follower_id", followerID), attribute.Int("followed_id", followedID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserUnfollow(ctx, followerID, followedID)
+}
+
+func (s *tracedService) UserFollowersGetAll(ctx context.Context, userID int, offset int, limit int) (_ []*models.User, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.UserFollowersGetAll", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserFollowersGetAll(ctx, userID, offset, limit)
+}
+
+func (s *tracedService) UserFollowingsGetAll(ctx context.Context, userID int, offset int, limit int) (_ []*models.User, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.UserFollowingsGetAll", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserFollowingsGetAll(ctx, userID, offset, limit)
+}
+
+func (s *tracedService) FeedGet(ctx context.Context, userID int, cursor string, limit int) (_ []*repo.FeedActivity, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "app.FeedGet", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.FeedGet(ctx, userID, cursor, limit)
+}
+
+func (s *tracedService) ContributionsGetAll(ctx context.Context, userID int, offset int, limit int) (_ []*repo.Contribution, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.ContributionsGetAll", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ContributionsGetAll(ctx, userID, offset, limit)
+}
+
+func (s *tracedService) ContributorsGetAll(ctx context.Context, since time.Time, until time.Time, offset int, limit int) (_ []*repo.Contributor, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.ContributorsGetAll")
+	defer func() { tracing.End(span, err) }()
+	return s.next.ContributorsGetAll(ctx, since, until, offset, limit)
+}
+
+func (s *tracedService) ProposalGet(ctx context.Context, id int, userID int) (_ *models.Proposal, err error) {
+	ctx, span := tracing.Start(ctx, "app.ProposalGet", attribute.Int("id", id), attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ProposalGet(ctx, id, userID)
+}
+
+func (s *tracedService) ProposalsGetAll(ctx context.Context, moderatorID int, status string, offset int, limit int) (_ []*models.Proposal, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.ProposalsGetAll", attribute.Int("moderator_id", moderatorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ProposalsGetAll(ctx, moderatorID, status, offset, limit)
+}
+
+func (s *tracedService) ProposalApprove(ctx context.Context, id int, moderatorID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.ProposalApprove", attribute.Int("id", id), attribute.Int("moderator_id", moderatorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ProposalApprove(ctx, id, moderatorID)
+}
+
+func (s *tracedService) ProposalReject(ctx context.Context, id int, moderatorID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.ProposalReject", attribute.Int("id", id), attribute.Int("moderator_id", moderatorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ProposalReject(ctx, id, moderatorID)
+}
+
+func (s *tracedService) PostGet(ctx context.Context, id int) (_ *Post, err error) {
+	ctx, span := tracing.Start(ctx, "app.PostGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostGet(ctx, id)
+}
+
+func (s *tracedService) PostsGetAllByMovie(ctx context.Context, movieID int, offset int, limit int) (_ []*Post, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.PostsGetAllByMovie", attribute.Int("movie_id", movieID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostsGetAllByMovie(ctx, movieID, offset, limit)
+}
+
+func (s *tracedService) PostsGetAllBySeries(ctx context.Context, seriesID int, offset int, limit int) (_ []*Post, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.PostsGetAllBySeries", attribute.Int("series_id", seriesID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostsGetAllBySeries(ctx, seriesID, offset, limit)
+}
+
+func (s *tracedService) PostRepliesGetAll(ctx context.Context, id int, offset int, limit int) (_ []*Post, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.PostRepliesGetAll", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostRepliesGetAll(ctx, id, offset, limit)
+}
+
+func (s *tracedService) PostCreate(ctx context.Context, userID int, req *dto.PostCreateRequest) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.PostCreate", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostCreate(ctx, userID, req)
+}
+
+func (s *tracedService) PostUpdate(ctx context.Context, id int, userID int, req *dto.PostUpdateRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.PostUpdate", attribute.Int("id", id), attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostUpdate(ctx, id, userID, req)
+}
+
+func (s *tracedService) PostDelete(ctx context.Context, id int, userID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.PostDelete", attribute.Int("id", id), attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostDelete(ctx, id, userID)
+}
+
+func (s *tracedService) PostRevisionsGetAll(ctx context.Context, id int, offset int, limit int) (_ []*models.PostRevision, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.PostRevisionsGetAll", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostRevisionsGetAll(ctx, id, offset, limit)
+}
+
+func (s *tracedService) AuditsPrune(ctx context.Context) (_ map[string]int64, err error) {
+	ctx, span := tracing.Start(ctx, "app.AuditsPrune")
+	defer func() { tracing.End(span, err) }()
+	return s.next.AuditsPrune(ctx)
+}
+
+func (s *tracedService) MovieGet(ctx context.Context, id int, includeInvalidated bool) (_ *models.Film, err error) {
+	ctx, span := tracing.Start(ctx, "app.MovieGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieGet(ctx, id, includeInvalidated)
+}
+
+func (s *tracedService) MoviesGetAll(ctx context.Context, filter repo.ListFilter, sort repo.ListSort, offset int, limit int, includeInvalidated bool) (_ []*models.Film, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.MoviesGetAll")
+	defer func() { tracing.End(span, err) }()
+	return s.next.MoviesGetAll(ctx, filter, sort, offset, limit, includeInvalidated)
+}
+
+func (s *tracedService) MoviesGetPage(ctx context.Context, filter repo.ListFilter, sort repo.ListSort, cursor string, limit int, includeInvalidated bool) (_ []*models.Film, _ string, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "app.MoviesGetPage")
+	defer func() { tracing.End(span, err) }()
+	return s.next.MoviesGetPage(ctx, filter, sort, cursor, limit, includeInvalidated)
+}
+
+func (s *tracedService) MovieCreate(ctx context.Context, contributorID int, req *dto.MovieCreateRequest) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.MovieCreate", attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieCreate(ctx, contributorID, req)
+}
+
+func (s *tracedService) MovieUpdate(ctx context.Context, id int, contributorID int, req *dto.MovieUpdateRequest) (_ *models.Proposal, err error) {
+	ctx, span := tracing.Start(ctx, "app.MovieUpdate", attribute.Int("id", id), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieUpdate(ctx, id, contributorID, req)
+}
+
+func (s *tracedService) MovieInvalidate(ctx context.Context, id int, contributorID int, req *dto.InvalidationRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.MovieInvalidate", attribute.Int("id", id), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieInvalidate(ctx, id, contributorID, req)
+}
+
+func (s *tracedService) MovieRestore(ctx context.Context, id int, contributorID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.MovieRestore", attribute.Int("id", id), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieRestore(ctx, id, contributorID)
+}
+
+func (s *tracedService) MovieAuditsGetAll(ctx context.Context, id int, offset int, limit int) (_ []*models.FilmsAudit, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.MovieAuditsGetAll", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieAuditsGetAll(ctx, id, offset, limit)
+}
+
+func (s *tracedService) MovieAuditsGetPage(ctx context.Context, id int, cursor string, limit int) (_ []*models.FilmsAudit, _ string, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "app.MovieAuditsGetPage", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieAuditsGetPage(ctx, id, cursor, limit)
+}
+
+func (s *tracedService) MovieAuditRevert(ctx context.Context, id int, contributedAt time.Time, contributorID int) (_ *models.Proposal, err error) {
+	ctx, span := tracing.Start(ctx, "app.MovieAuditRevert", attribute.Int("id", id), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieAuditRevert(ctx, id, contributedAt, contributorID)
+}
+
+func (s *tracedService) MovieAuditDiff(ctx context.Context, id int, from time.Time, to time.Time) (_ *repo.AuditDiff, err error) {
+	ctx, span := tracing.Start(ctx, "app.MovieAuditDiff", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieAuditDiff(ctx, id, from, to)
+}
+
+func (s *tracedService) MovieAuditDiffsGetAll(ctx context.Context, id int, offset int, limit int) (_ []*repo.AuditDiff, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.MovieAuditDiffsGetAll", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieAuditDiffsGetAll(ctx, id, offset, limit)
+}
+
+func (s *tracedService) MoviesSearch(ctx context.Context, req *dto.SearchRequest, offset int, limit int) (_ []*models.Film, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.MoviesSearch")
+	defer func() { tracing.End(span, err) }()
+	return s.next.MoviesSearch(ctx, req, offset, limit)
+}
+
+func (s *tracedService) MoviesSearchPage(ctx context.Context, req *dto.SearchRequest, cursor string, limit int) (_ []*models.Film, _ string, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "app.MoviesSearchPage")
+	defer func() { tracing.End(span, err) }()
+	return s.next.MoviesSearchPage(ctx, req, cursor, limit)
+}
+
+func (s *tracedService) SeriesGet(ctx context.Context, id int, includeInvalidated bool, loads ...string) (_ *models.Series, err error) {
+	ctx, span := tracing.Start(ctx, "app.SeriesGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesGet(ctx, id, includeInvalidated, loads...)
+}
+
+func (s *tracedService) SeriesesGetAll(ctx context.Context, filter repo.ListFilter, sort repo.ListSort, offset int, limit int, includeInvalidated bool) (_ []*models.Series, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.SeriesesGetAll")
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesesGetAll(ctx, filter, sort, offset, limit, includeInvalidated)
+}
+
+func (s *tracedService) SeriesCreate(ctx context.Context, contributorID int, req *dto.SeriesCreateRequest) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.SeriesCreate", attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesCreate(ctx, contributorID, req)
+}
+
+func (s *tracedService) SeriesUpdate(ctx context.Context, seriesID int, contributorID int, req *dto.SeriesUpdateRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.SeriesUpdate", attribute.Int("series_id", seriesID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesUpdate(ctx, seriesID, contributorID, req)
+}
+
+func (s *tracedService) SeriesInvalidate(ctx context.Context, seriesID int, contributorID int, req *dto.InvalidationRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.SeriesInvalidate", attribute.Int("series_id", seriesID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesInvalidate(ctx, seriesID, contributorID, req)
+}
+
+func (s *tracedService) SeriesRestore(ctx context.Context, seriesID int, contributorID int, cascade bool) (err error) {
+	ctx, span := tracing.Start(ctx, "app.SeriesRestore", attribute.Int("series_id", seriesID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesRestore(ctx, seriesID, contributorID, cascade)
+}
+
+func (s *tracedService) SeriesAuditsGetAll(ctx context.Context, id int, offset int, limit int) (_ []*models.SeriesesAudit, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.SeriesAuditsGetAll", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesAuditsGetAll(ctx, id, offset, limit)
+}
+
+func (s *tracedService) SeriesAuditsGetPage(ctx context.Context, id int, cursor string, limit int) (_ []*models.SeriesesAudit, _ string, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "app.SeriesAuditsGetPage", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesAuditsGetPage(ctx, id, cursor, limit)
+}
+
+func (s *tracedService) SeriesAuditRevert(ctx context.Context, id int, contributedAt time.Time, contributorID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.SeriesAuditRevert", attribute.Int("id", id), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesAuditRevert(ctx, id, contributedAt, contributorID)
+}
+
+func (s *tracedService) SeriesAuditDiff(ctx context.Context, id int, from time.Time, to time.Time) (_ *repo.AuditDiff, err error) {
+	ctx, span := tracing.Start(ctx, "app.SeriesAuditDiff", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesAuditDiff(ctx, id, from, to)
+}
+
+func (s *tracedService) SeriesAuditDiffsGetAll(ctx context.Context, id int, offset int, limit int) (_ []*repo.AuditDiff, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.SeriesAuditDiffsGetAll", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesAuditDiffsGetAll(ctx, id, offset, limit)
+}
+
+func (s *tracedService) SeriesesSearch(ctx context.Context, req *dto.SearchRequest, offset int, limit int) (_ []*models.Series, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.SeriesesSearch")
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesesSearch(ctx, req, offset, limit)
+}
+
+func (s *tracedService) SeriesesSearchPage(ctx context.Context, req *dto.SearchRequest, cursor string, limit int) (_ []*models.Series, _ string, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "app.SeriesesSearchPage")
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesesSearchPage(ctx, req, cursor, limit)
+}
+
+func (s *tracedService) SeasonGet(ctx context.Context, seriesID int, seasonNumber int, includeInvalidated bool, loads ...string) (_ *models.Season, err error) {
+	ctx, span := tracing.Start(ctx, "app.SeasonGet", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonGet(ctx, seriesID, seasonNumber, includeInvalidated, loads...)
+}
+
+func (s *tracedService) SeasonsGetAllBySeries(ctx context.Context, seriesID int, offset int, limit int, includeInvalidated bool) (_ []*repo.SeasonWithEpisodesCount, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.SeasonsGetAllBySeries", attribute.Int("series_id", seriesID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonsGetAllBySeries(ctx, seriesID, offset, limit, includeInvalidated)
+}
+
+func (s *tracedService) SeasonPut(ctx context.Context, seriesID int, seasonNumber int, contributorID int, req *dto.SeasonPutRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.SeasonPut", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonPut(ctx, seriesID, seasonNumber, contributorID, req)
+}
+
+func (s *tracedService) SeasonUpdate(ctx context.Context, seriesID int, seasonNumber int, contributorID int, req *dto.SeasonUpdateRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.SeasonUpdate", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonUpdate(ctx, seriesID, seasonNumber, contributorID, req)
+}
+
+func (s *tracedService) SeasonInvalidate(ctx context.Context, seriesID int, seasonNumber int, contributorID int, req *dto.InvalidationRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.SeasonInvalidate", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonInvalidate(ctx, seriesID, seasonNumber, contributorID, req)
+}
+
+func (s *tracedService) SeasonRestore(ctx context.Context, seriesID int, seasonNumber int, contributorID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.SeasonRestore", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonRestore(ctx, seriesID, seasonNumber, contributorID)
+}
+
+func (s *tracedService) SeasonAuditsGetAll(ctx context.Context, seriesID int, seasonNumber int, offset int, limit int) (_ []*models.SeasonsAudit, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.SeasonAuditsGetAll", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonAuditsGetAll(ctx, seriesID, seasonNumber, offset, limit)
+}
+
+func (s *tracedService) SeasonAuditsGetPage(ctx context.Context, seriesID int, seasonNumber int, cursor string, limit int) (_ []*models.SeasonsAudit, _ string, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "app.SeasonAuditsGetPage", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonAuditsGetPage(ctx, seriesID, seasonNumber, cursor, limit)
+}
+
+func (s *tracedService) EpisodeGet(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, includeInvalidated bool, loads ...string) (_ *models.Film, err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodeGet", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeGet(ctx, seriesID, seasonNumber, episodeNumber, includeInvalidated, loads...)
+}
+
+func (s *tracedService) EpisodesCountBySeries(ctx context.Context, seriesID int, includeInvalidated bool) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodesCountBySeries", attribute.Int("series_id", seriesID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesCountBySeries(ctx, seriesID, includeInvalidated)
+}
+
+func (s *tracedService) EpisodesCountBySeason(ctx context.Context, seriesID int, seasonNumber int, includeInvalidated bool) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodesCountBySeason", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesCountBySeason(ctx, seriesID, seasonNumber, includeInvalidated)
+}
+
+func (s *tracedService) EpisodesGetAllBySeries(ctx context.Context, seriesID int, offset int, limit int, includeInvalidated bool) (_ []*models.Film, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodesGetAllBySeries", attribute.Int("series_id", seriesID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesGetAllBySeries(ctx, seriesID, offset, limit, includeInvalidated)
+}
+
+func (s *tracedService) EpisodesGetPageBySeries(ctx context.Context, seriesID int, cursor string, limit int, includeInvalidated bool) (_ []*models.Film, _ string, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodesGetPageBySeries", attribute.Int("series_id", seriesID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesGetPageBySeries(ctx, seriesID, cursor, limit, includeInvalidated)
+}
+
+func (s *tracedService) EpisodesGetAllBySeason(ctx context.Context, seriesID int, seasonNumber int, offset int, limit int, includeInvalidated bool) (_ []*models.Film, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodesGetAllBySeason", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesGetAllBySeason(ctx, seriesID, seasonNumber, offset, limit, includeInvalidated)
+}
+
+func (s *tracedService) EpisodePut(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, contributorID int, req *dto.EpisodePutRequest) (_ *models.Proposal, err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodePut", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodePut(ctx, seriesID, seasonNumber, episodeNumber, contributorID, req)
+}
+
+func (s *tracedService) EpisodesPutAllBySeason(ctx context.Context, seriesID int, seasonNumber int, contributorID int, req *dto.EpisodesPutAllBySeasonRequest) (_ *models.Proposal, err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodesPutAllBySeason", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesPutAllBySeason(ctx, seriesID, seasonNumber, contributorID, req)
+}
+
+func (s *tracedService) EpisodeUpdate(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, contributorID int, req *dto.EpisodeUpdateRequest) (_ *models.Proposal, err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodeUpdate", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeUpdate(ctx, seriesID, seasonNumber, episodeNumber, contributorID, req)
+}
+
+func (s *tracedService) EpisodeInvalidate(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, contributorID int, req *dto.InvalidationRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodeInvalidate", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeInvalidate(ctx, seriesID, seasonNumber, episodeNumber, contributorID, req)
+}
+
+func (s *tracedService) EpisodesInvalidateAllBySeason(ctx context.Context, seriesID int, seasonNumber int, contributorID int, req *dto.InvalidationRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodesInvalidateAllBySeason", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesInvalidateAllBySeason(ctx, seriesID, seasonNumber, contributorID, req)
+}
+
+func (s *tracedService) EpisodeRestore(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, contributorID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodeRestore", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeRestore(ctx, seriesID, seasonNumber, episodeNumber, contributorID)
+}
+
+func (s *tracedService) EpisodesRestoreAllBySeason(ctx context.Context, seriesID int, seasonNumber int, contributorID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodesRestoreAllBySeason", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesRestoreAllBySeason(ctx, seriesID, seasonNumber, contributorID)
+}
+
+func (s *tracedService) EpisodeAuditsGetAll(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, offset int, limit int) (_ []*models.FilmsAudit, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodeAuditsGetAll", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeAuditsGetAll(ctx, seriesID, seasonNumber, episodeNumber, offset, limit)
+}
+
+func (s *tracedService) EpisodeAuditsGetPage(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, cursor string, limit int) (_ []*models.FilmsAudit, _ string, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodeAuditsGetPage", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeAuditsGetPage(ctx, seriesID, seasonNumber, episodeNumber, cursor, limit)
+}
+
+func (s *tracedService) EpisodeAuditRevert(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, contributedAt time.Time, contributorID int) (_ *models.Proposal, err error) {
+	ctx, span := tracing.Start(ctx, "app.EpisodeAuditRevert", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeAuditRevert(ctx, seriesID, seasonNumber, episodeNumber, contributedAt, contributorID)
+}
+
+func (s *tracedService) FilmMediaGet(ctx context.Context, filmID int, mediaID int) (_ *models.FilmMediaURL, err error) {
+	ctx, span := tracing.Start(ctx, "app.FilmMediaGet", attribute.Int("film_id", filmID), attribute.Int("media_id", mediaID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.FilmMediaGet(ctx, filmID, mediaID)
+}
+
+func (s *tracedService) FilmMediaGetAll(ctx context.Context, filmID int, offset int, limit int) (_ []*models.FilmMediaURL, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.FilmMediaGetAll", attribute.Int("film_id", filmID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.FilmMediaGetAll(ctx, filmID, offset, limit)
+}
+
+func (s *tracedService) FilmMediaUpload(ctx context.Context, filmID int, contributorID int, content io.Reader) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.FilmMediaUpload", attribute.Int("film_id", filmID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.FilmMediaUpload(ctx, filmID, contributorID, content)
+}
+
+func (s *tracedService) FilmMediaInvalidate(ctx context.Context, filmID int, mediaID int, contributorID int, req *dto.InvalidationRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.FilmMediaInvalidate", attribute.Int("film_id", filmID), attribute.Int("media_id", mediaID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.FilmMediaInvalidate(ctx, filmID, mediaID, contributorID, req)
+}
+
+func (s *tracedService) MediaBlobGet(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	ctx, span := tracing.Start(ctx, "app.MediaBlobGet")
+	defer func() { tracing.End(span, err) }()
+	return s.next.MediaBlobGet(ctx, key)
+}
+
+func (s *tracedService) ArtistGet(ctx context.Context, id int) (_ *models.Artist, err error) {
+	ctx, span := tracing.Start(ctx, "app.ArtistGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ArtistGet(ctx, id)
+}
+
+func (s *tracedService) ArtistCreate(ctx context.Context, contributorID int, req *dto.ArtistCreateRequest) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.ArtistCreate", attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ArtistCreate(ctx, contributorID, req)
+}
+
+func (s *tracedService) ArtistImageGet(ctx context.Context, artistID int, imageID int) (_ *models.ArtistImageURL, err error) {
+	ctx, span := tracing.Start(ctx, "app.ArtistImageGet", attribute.Int("artist_id", artistID), attribute.Int("image_id", imageID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ArtistImageGet(ctx, artistID, imageID)
+}
+
+func (s *tracedService) ArtistImagesGetAll(ctx context.Context, artistID int, offset int, limit int) (_ []*models.ArtistImageURL, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.ArtistImagesGetAll", attribute.Int("artist_id", artistID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ArtistImagesGetAll(ctx, artistID, offset, limit)
+}
+
+func (s *tracedService) ArtistImageUpload(ctx context.Context, artistID int, contributorID int, content io.Reader) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.ArtistImageUpload", attribute.Int("artist_id", artistID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ArtistImageUpload(ctx, artistID, contributorID, content)
+}
+
+func (s *tracedService) ArtistImageInvalidate(ctx context.Context, artistID int, imageID int, contributorID int, req *dto.InvalidationRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.ArtistImageInvalidate", attribute.Int("artist_id", artistID), attribute.Int("image_id", imageID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ArtistImageInvalidate(ctx, artistID, imageID, contributorID, req)
+}
+
+func (s *tracedService) PlaylistGet(ctx context.Context, id int, userID int) (_ *models.Playlist, err error) {
+	ctx, span := tracing.Start(ctx, "app.PlaylistGet", attribute.Int("id", id), attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistGet(ctx, id, userID)
+}
+
+func (s *tracedService) PlaylistsGetAllByUser(ctx context.Context, userID int, offset int, limit int) (_ []*models.Playlist, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.PlaylistsGetAllByUser", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistsGetAllByUser(ctx, userID, offset, limit)
+}
+
+func (s *tracedService) PlaylistCreate(ctx context.Context, userID int, req *dto.PlaylistCreateRequest) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.PlaylistCreate", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistCreate(ctx, userID, req)
+}
+
+func (s *tracedService) PlaylistUpdate(ctx context.Context, id int, userID int, req *dto.PlaylistUpdateRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.PlaylistUpdate", attribute.Int("id", id), attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistUpdate(ctx, id, userID, req)
+}
+
+func (s *tracedService) PlaylistDelete(ctx context.Context, id int, userID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.PlaylistDelete", attribute.Int("id", id), attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistDelete(ctx, id, userID)
+}
+
+func (s *tracedService) PlaylistFilmsGetAll(ctx context.Context, id int, userID int, offset int, limit int) (_ []*models.Film, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.PlaylistFilmsGetAll", attribute.Int("id", id), attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistFilmsGetAll(ctx, id, userID, offset, limit)
+}
+
+func (s *tracedService) PlaylistFilmAdd(ctx context.Context, id int, filmID int, userID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.PlaylistFilmAdd", attribute.Int("id", id), attribute.Int("film_id", filmID), attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistFilmAdd(ctx, id, filmID, userID)
+}
+
+func (s *tracedService) PlaylistFilmRemove(ctx context.Context, id int, filmID int, userID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.PlaylistFilmRemove", attribute.Int("id", id), attribute.Int("film_id", filmID), attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistFilmRemove(ctx, id, filmID, userID)
+}
+
+func (s *tracedService) PlaylistFilmsReorder(ctx context.Context, id int, userID int, req *dto.PlaylistFilmsReorderRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "app.PlaylistFilmsReorder", attribute.Int("id", id), attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistFilmsReorder(ctx, id, userID, req)
+}
+
+func (s *tracedService) PlaylistCover(ctx context.Context, id int, userID int) (_ []byte, err error) {
+	ctx, span := tracing.Start(ctx, "app.PlaylistCover", attribute.Int("id", id), attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistCover(ctx, id, userID)
+}
+
+func (s *tracedService) PlaylistAddToWatchlist(ctx context.Context, id int, userID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.PlaylistAddToWatchlist", attribute.Int("id", id), attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistAddToWatchlist(ctx, id, userID)
+}
+
+func (s *tracedService) WatchlistGetAll(ctx context.Context, userID int, offset int, limit int) (_ []*models.Watchlist, _ int, err error) {
+	ctx, span := tracing.Start(ctx, "app.WatchlistGetAll", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.WatchlistGetAll(ctx, userID, offset, limit)
+}
+
+func (s *tracedService) WatchlistMarkWatched(ctx context.Context, userID int, filmID int) (err error) {
+	ctx, span := tracing.Start(ctx, "app.WatchlistMarkWatched", attribute.Int("user_id", userID), attribute.Int("film_id", filmID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.WatchlistMarkWatched(ctx, userID, filmID)
+}
@@ -0,0 +1,8 @@
+package app
+
+//go:generate go run ../tracing/tracegen -source app.go -type Service -prefix app -destination service_traced.go
+
+// NewTracedService returns next recording a span for each of its method calls
+func NewTracedService(next Service) Service {
+	return &tracedService{next: next}
+}
@@ -2,10 +2,12 @@ package app
 
 import (
 	"context"
+	"time"
 
 	"github.com/aria3ppp/watch-server/internal/dto"
 	"github.com/aria3ppp/watch-server/internal/hasher"
 	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/notifier"
 	"github.com/aria3ppp/watch-server/internal/repo"
 	"github.com/aria3ppp/watch-server/internal/token"
 	"golang.org/x/crypto/bcrypt"
@@ -137,6 +139,10 @@ func (a *Application) UserRefreshToken(
 		}
 		return "", err
 	}
+	// users deleted or about to be are not handed new tokens
+	if err := a.UserAuthorize(ctx, payload.UserID, false); err != nil {
+		return "", err
+	}
 	// generate new access token
 	return a.token.GenerateAccessToken(payload)
 }
@@ -188,21 +194,57 @@ func (a *Application) UserEmailUpdate(
 	userID int,
 	req *dto.UserEmailUpdateRequest,
 ) error {
-	// update email
-	if err := a.repository.UserUpdate(
+	err := a.repository.Transaction(
 		ctx,
-		userID,
-		map[string]any{
-			models.UserColumns.Email: req.Email,
+		func(ctx context.Context, tx repo.Service) error {
+			// check user with this id exists
+			user, err := tx.UserGet(ctx, userID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// anonymized users are gone for good
+			if user.DeletedAt.Valid {
+				return ErrNotFound
+			}
+
+			// nothing to change
+			if user.Email == req.Email {
+				return nil
+			}
+
+			// update email
+			if err = tx.UserUpdate(
+				ctx,
+				userID,
+				map[string]any{
+					models.UserColumns.Email: req.Email,
+				},
+			); err != nil {
+				return err
+			}
+
+			// let both the previous and the new address know of the change
+			now := time.Now()
+			for _, email := range []string{user.Email, req.Email} {
+				err := a.notifier.Notify(ctx, &notifier.Notification{
+					Kind:   notifier.KindEmailChanged,
+					UserID: userID,
+					Email:  email,
+					At:     now,
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
 		},
-	); err != nil {
-		if err == repo.ErrNoRecord {
-			return ErrNotFound
-		}
-		return err
-	}
+	)
 
-	return nil
+	return err
 }
 
 //------------------------------------------------------------------------------
@@ -228,6 +270,10 @@ func (a *Application) UserPasswordUpdate(
 				}
 				return err
 			}
+			// anonymized users are gone for good
+			if user.DeletedAt.Valid {
+				return ErrNotFound
+			}
 
 			// check current password match
 			err = a.hasher.CompareHashAndPassword(
@@ -265,7 +311,13 @@ func (a *Application) UserPasswordUpdate(
 				return err
 			}
 
-			return nil
+			// notify the user of the change
+			return a.notifier.Notify(ctx, &notifier.Notification{
+				Kind:   notifier.KindPasswordChanged,
+				UserID: userID,
+				Email:  user.Email,
+				At:     time.Now(),
+			})
 		},
 	)
 
@@ -274,6 +326,78 @@ func (a *Application) UserPasswordUpdate(
 
 //------------------------------------------------------------------------------
 
+func (a *Application) UserAuditsGetAll(
+	ctx context.Context,
+	userID int,
+	offset, limit int,
+) (audits []*models.UsersAudit, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the user exists
+			user, err := tx.UserGet(ctx, userID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			if user.DeletedAt.Valid {
+				return ErrNotFound
+			}
+			// fetch audits
+			audits, err = tx.UserAuditsGetAll(ctx, userID, offset, limit)
+			if err != nil {
+				return err
+			}
+			// count total audits
+			total, err = tx.UserAuditsCount(ctx, userID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
+
+func (a *Application) UserAuditsGetPage(
+	ctx context.Context,
+	userID int,
+	cursor string,
+	limit int,
+) (audits []*models.UsersAudit, prevCursor, nextCursor string, err error) {
+	page, err := decodePage(cursor, limit, new(time.Time))
+	if err != nil {
+		return nil, "", "", err
+	}
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the user exists
+			user, err := tx.UserGet(ctx, userID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			if user.DeletedAt.Valid {
+				return ErrNotFound
+			}
+			audits, err = tx.UserAuditsGetPage(ctx, userID, page)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, "", "", err
+	}
+	audits, prevCursor, nextCursor = pageRows(audits, page, usersAuditKeyset)
+	return audits, prevCursor, nextCursor, nil
+}
+
+//------------------------------------------------------------------------------
+
 func (a *Application) UserDelete(
 	ctx context.Context,
 	userID int,
@@ -290,6 +414,10 @@ func (a *Application) UserDelete(
 				}
 				return err
 			}
+			// anonymized users are gone for good
+			if user.DeletedAt.Valid {
+				return ErrNotFound
+			}
 
 			// check password match
 			err = a.hasher.CompareHashAndPassword(
@@ -303,16 +431,9 @@ func (a *Application) UserDelete(
 				return err
 			}
 
-			// delete user
-			if err = tx.UserDelete(ctx, userID); err != nil {
-				// as we running in transaction; user existance have already been checked by UserGet
-				// if err == repo.ErrNoRecord {
-				// 	return ErrNotFound
-				// }
-				return err
-			}
-
-			return nil
+			// schedule the deletion, the user is anonymized after the grace
+			// period unless restored before then
+			return tx.UserDeletionCreate(ctx, userID)
 		},
 	)
 
@@ -0,0 +1,77 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/repo"
+)
+
+// UserAuthorize tells whether the user of a token may still act on their
+// account: anonymized users may not, and neither may the users of a pending
+// deletion unless pendingDeletionAllowed, for them to restore their account.
+func (a *Application) UserAuthorize(
+	ctx context.Context,
+	userID int,
+	pendingDeletionAllowed bool,
+) error {
+	status, err := a.repository.UserStatusGet(ctx, userID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrTokenInvalid
+		}
+		return err
+	}
+	if status.DeletedAt.Valid {
+		return ErrTokenInvalid
+	}
+	if status.DeletionRequestedAt.Valid && !pendingDeletionAllowed {
+		return ErrUserDeletionPending
+	}
+	return nil
+}
+
+// UserRestore cancels the pending deletion of a user.
+func (a *Application) UserRestore(ctx context.Context, userID int) error {
+	err := a.repository.UserDeletionDelete(ctx, userID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// UsersAnonymize anonymizes the users whose deletion was requested before the
+// grace period and returns how many were.
+func (a *Application) UsersAnonymize(
+	ctx context.Context,
+) (anonymized int, err error) {
+	requestedBefore := time.Now().AddDate(
+		0,
+		0,
+		-config.Config.Account.Deletion.GracePeriodInDays,
+	)
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			deletions, err := tx.UserDeletionsGetAllDue(ctx, requestedBefore)
+			if err != nil {
+				return err
+			}
+			for _, deletion := range deletions {
+				if err := tx.UserAnonymize(ctx, deletion.UserID); err != nil {
+					return err
+				}
+			}
+			anonymized = len(deletions)
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return anonymized, nil
+}
@@ -0,0 +1,194 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestUserRestore(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		userID                     = 1
+		expUserDeletionDeleteError = errors.New("UserDeletionDelete error")
+	)
+
+	type TestCase struct {
+		name   string
+		err    error
+		expErr error
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "no pending deletion",
+			err:    repo.ErrNoRecord,
+			expErr: app.ErrNotFound,
+		},
+		{
+			name:   "UserDeletionDelete error",
+			err:    expUserDeletionDeleteError,
+			expErr: expUserDeletionDeleteError,
+		},
+		{
+			name:   "ok",
+			err:    nil,
+			expErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				UserDeletionDelete(ctx, userID).
+				Return(tc.err)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.UserRestore(ctx, userID)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestUserAuthorize(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		userID                = 1
+		expUserStatusGetError = errors.New("UserStatusGet error")
+		requestedAt           = null.TimeFrom(time.Now())
+	)
+
+	type TestCase struct {
+		name                   string
+		status                 *repo.UserStatus
+		err                    error
+		pendingDeletionAllowed bool
+		expErr                 error
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "user not found",
+			err:    repo.ErrNoRecord,
+			expErr: app.ErrTokenInvalid,
+		},
+		{
+			name:   "UserStatusGet error",
+			err:    expUserStatusGetError,
+			expErr: expUserStatusGetError,
+		},
+		{
+			name: "user anonymized",
+			status: &repo.UserStatus{
+				DeletedAt: null.TimeFrom(time.Now()),
+			},
+			pendingDeletionAllowed: true,
+			expErr:                 app.ErrTokenInvalid,
+		},
+		{
+			name:   "user deletion pending",
+			status: &repo.UserStatus{DeletionRequestedAt: requestedAt},
+			expErr: app.ErrUserDeletionPending,
+		},
+		{
+			name:                   "user deletion pending allowed",
+			status:                 &repo.UserStatus{DeletionRequestedAt: requestedAt},
+			pendingDeletionAllowed: true,
+			expErr:                 nil,
+		},
+		{
+			name:   "ok",
+			status: &repo.UserStatus{},
+			expErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				UserStatusGet(ctx, userID).
+				Return(tc.status, tc.err)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.UserAuthorize(ctx, userID, tc.pendingDeletionAllowed)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestUsersAnonymize(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+
+	gracePeriod := config.Config.Account.Deletion.GracePeriodInDays
+	config.Config.Account.Deletion.GracePeriodInDays = 30
+	t.Cleanup(func() {
+		config.Config.Account.Deletion.GracePeriodInDays = gracePeriod
+	})
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+	before := time.Now().AddDate(0, 0, -30)
+
+	mockRepo.EXPECT().
+		Transaction(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	getAllDueCall := mockRepo.EXPECT().
+		UserDeletionsGetAllDue(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, requestedBefore time.Time) ([]*models.UserDeletion, error) {
+			// only deletions older than the grace period are due
+			require.False(requestedBefore.Before(before))
+			require.False(requestedBefore.After(time.Now().AddDate(0, 0, -30)))
+			return []*models.UserDeletion{{UserID: 1}, {UserID: 2}}, nil
+		})
+	firstCall := mockRepo.EXPECT().
+		UserAnonymize(ctx, 1).
+		Return(nil).
+		After(getAllDueCall)
+	mockRepo.EXPECT().
+		UserAnonymize(ctx, 2).
+		Return(nil).
+		After(firstCall)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	anonymized, err := application.UsersAnonymize(ctx)
+	require.NoError(err)
+	require.Equal(2, anonymized)
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+)
+
+func (a *Application) UserFollow(
+	ctx context.Context,
+	followerID int,
+	followedID int,
+) error {
+	if followerID == followedID {
+		return ErrFollowSelf
+	}
+	return a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// check the followed user exists
+			followed, err := tx.UserGet(ctx, followedID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			if followed.DeletedAt.Valid {
+				return ErrNotFound
+			}
+			return tx.UserFollow(ctx, followerID, followedID)
+		},
+	)
+}
+
+func (a *Application) UserUnfollow(
+	ctx context.Context,
+	followerID int,
+	followedID int,
+) error {
+	err := a.repository.UserUnfollow(ctx, followerID, followedID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (a *Application) UserFollowersGetAll(
+	ctx context.Context,
+	userID int,
+	offset, limit int,
+) (followers []*models.User, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// check the user exists
+			_, err := tx.UserGet(ctx, userID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			followers, err = tx.UserFollowersGetAll(ctx, userID, offset, limit)
+			if err != nil {
+				return err
+			}
+			total, err = tx.UserFollowersCount(ctx, userID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return followers, total, nil
+}
+
+func (a *Application) UserFollowingsGetAll(
+	ctx context.Context,
+	userID int,
+	offset, limit int,
+) (followings []*models.User, total int, err error) {
+	err = a.repository.Transaction(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			// check the user exists
+			_, err := tx.UserGet(ctx, userID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			followings, err = tx.UserFollowingsGetAll(
+				ctx,
+				userID,
+				offset,
+				limit,
+			)
+			if err != nil {
+				return err
+			}
+			total, err = tx.UserFollowingsCount(ctx, userID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return followings, total, nil
+}
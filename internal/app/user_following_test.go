@@ -0,0 +1,99 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserFollow(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		followerID = 1
+		followedID = 2
+		expError   = errors.New("error")
+	)
+
+	type TestCase struct {
+		name       string
+		followedID int
+		expGet     bool
+		getErr     error
+		expFollow  bool
+		followErr  error
+		expErr     error
+	}
+
+	testCases := []TestCase{
+		{
+			name:       "follow self",
+			followedID: followerID,
+			expErr:     app.ErrFollowSelf,
+		},
+		{
+			name:       "user not found",
+			followedID: followedID,
+			expGet:     true,
+			getErr:     repo.ErrNoRecord,
+			expErr:     app.ErrNotFound,
+		},
+		{
+			name:       "UserFollow error",
+			followedID: followedID,
+			expGet:     true,
+			expFollow:  true,
+			followErr:  expError,
+			expErr:     expError,
+		},
+		{
+			name:       "ok",
+			followedID: followedID,
+			expGet:     true,
+			expFollow:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			if tc.expGet {
+				mockRepo.EXPECT().
+					Transaction(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repo.Service) error) error {
+						return fn(ctx, mockRepo)
+					})
+
+				mockRepo.EXPECT().
+					UserGet(ctx, tc.followedID).
+					Return(&models.User{ID: tc.followedID}, tc.getErr)
+			}
+
+			if tc.expFollow {
+				mockRepo.EXPECT().
+					UserFollow(ctx, followerID, tc.followedID).
+					Return(tc.followErr)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.UserFollow(ctx, followerID, tc.followedID)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
@@ -11,8 +11,11 @@ import (
 	"github.com/aria3ppp/watch-server/internal/hasher"
 	"github.com/aria3ppp/watch-server/internal/hasher/mock_hasher"
 	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/notifier"
+	"github.com/aria3ppp/watch-server/internal/notifier/mock_notifier"
 	"github.com/aria3ppp/watch-server/internal/repo"
 	"github.com/aria3ppp/watch-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watch-server/internal/testutils"
 	"github.com/aria3ppp/watch-server/internal/token"
 	"github.com/aria3ppp/watch-server/internal/token/mock_token"
 	"github.com/golang/mock/gomock"
@@ -103,7 +106,7 @@ func TestUserGet(t *testing.T) {
 				UserGet(ctx, id).
 				Return(tc.get.exp.series, tc.get.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			user, err := app.UserGet(ctx, id)
 			require.Equal(tc.exp.err, err)
@@ -339,7 +342,7 @@ func TestUserCreate(t *testing.T) {
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, mockHasher)
+			app := app.NewApplication(mockRepo, nil, nil, mockHasher, nil, nil)
 
 			userID, err := app.UserCreate(ctx, req)
 			require.Equal(tc.exp.err, err)
@@ -615,6 +618,8 @@ func TestUserLogin(t *testing.T) {
 				mockTokenService,
 				nil,
 				mockHasher,
+				nil,
+				nil,
 			)
 
 			tAccess, tRefresh, err := app.UserLogin(ctx, req)
@@ -636,6 +641,7 @@ func TestUserRefreshToken(t *testing.T) {
 		refreshToken                = "refresh token"
 		expNewAccessToken           = "new access token"
 		expValidateTokenError       = errors.New("ValidateToken error")
+		expUserStatusGetError       = errors.New("UserStatusGet error")
 		expGenerateAccessTokenError = errors.New("GenerateAccessToken error")
 	)
 
@@ -653,6 +659,13 @@ func TestUserRefreshToken(t *testing.T) {
 	type ValidateToken struct {
 		exp ValidateTokenExp
 	}
+	type UserStatusGetExp struct {
+		status *repo.UserStatus
+		err    error
+	}
+	type UserStatusGet struct {
+		exp UserStatusGetExp
+	}
 	type Exp struct {
 		accessToken string
 		err         error
@@ -660,6 +673,7 @@ func TestUserRefreshToken(t *testing.T) {
 	type TestCase struct {
 		name                string
 		validateToken       ValidateToken
+		userStatusGet       UserStatusGet
 		generateAccessToken GenerateAccessToken
 		exp                 Exp
 	}
@@ -693,6 +707,92 @@ func TestUserRefreshToken(t *testing.T) {
 			},
 		},
 
+		{
+			name: "user not found",
+			validateToken: ValidateToken{
+				exp: ValidateTokenExp{
+					payload: expPayload,
+					err:     nil,
+				},
+			},
+			userStatusGet: UserStatusGet{
+				exp: UserStatusGetExp{
+					status: nil,
+					err:    repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				accessToken: "",
+				err:         expTokenInvalidError,
+			},
+		},
+
+		{
+			name: "UserStatusGet error",
+			validateToken: ValidateToken{
+				exp: ValidateTokenExp{
+					payload: expPayload,
+					err:     nil,
+				},
+			},
+			userStatusGet: UserStatusGet{
+				exp: UserStatusGetExp{
+					status: nil,
+					err:    expUserStatusGetError,
+				},
+			},
+			exp: Exp{
+				accessToken: "",
+				err:         expUserStatusGetError,
+			},
+		},
+
+		{
+			name: "user anonymized",
+			validateToken: ValidateToken{
+				exp: ValidateTokenExp{
+					payload: expPayload,
+					err:     nil,
+				},
+			},
+			userStatusGet: UserStatusGet{
+				exp: UserStatusGetExp{
+					status: &repo.UserStatus{
+						DeletedAt: null.TimeFrom(testutils.Date(2000, 1, 1)),
+					},
+					err: nil,
+				},
+			},
+			exp: Exp{
+				accessToken: "",
+				err:         expTokenInvalidError,
+			},
+		},
+
+		{
+			name: "user deletion pending",
+			validateToken: ValidateToken{
+				exp: ValidateTokenExp{
+					payload: expPayload,
+					err:     nil,
+				},
+			},
+			userStatusGet: UserStatusGet{
+				exp: UserStatusGetExp{
+					status: &repo.UserStatus{
+						DeletionRequestedAt: null.TimeFrom(
+							testutils.Date(2000, 1, 1),
+						),
+					},
+					err: nil,
+				},
+			},
+			exp: Exp{
+				accessToken: "",
+				err:         app.ErrUserDeletionPending,
+			},
+		},
+
 		{
 			name: "GenerateAccessToken error",
 			validateToken: ValidateToken{
@@ -701,6 +801,12 @@ func TestUserRefreshToken(t *testing.T) {
 					err:     nil,
 				},
 			},
+			userStatusGet: UserStatusGet{
+				exp: UserStatusGetExp{
+					status: &repo.UserStatus{},
+					err:    nil,
+				},
+			},
 			generateAccessToken: GenerateAccessToken{
 				exp: GenerateAccessTokenExp{
 					token: "",
@@ -721,6 +827,12 @@ func TestUserRefreshToken(t *testing.T) {
 					err:     nil,
 				},
 			},
+			userStatusGet: UserStatusGet{
+				exp: UserStatusGetExp{
+					status: &repo.UserStatus{},
+					err:    nil,
+				},
+			},
 			generateAccessToken: GenerateAccessToken{
 				exp: GenerateAccessTokenExp{
 					token: expNewAccessToken,
@@ -742,19 +854,30 @@ func TestUserRefreshToken(t *testing.T) {
 
 			controller := gomock.NewController(t)
 			mockTokenService := mock_token.NewMockService(controller)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
 
 			validateTokenCall := mockTokenService.EXPECT().
 				ValidateToken(refreshToken).
 				Return(tc.validateToken.exp.payload, tc.validateToken.exp.err)
 
 			if tc.validateToken.exp.err == nil {
-				mockTokenService.EXPECT().
-					GenerateAccessToken(expPayload).
-					Return(tc.generateAccessToken.exp.token, tc.generateAccessToken.exp.err).
+				userStatusGetCall := mockRepo.EXPECT().
+					UserStatusGet(ctx, expPayload.UserID).
+					Return(tc.userStatusGet.exp.status, tc.userStatusGet.exp.err).
 					After(validateTokenCall)
+
+				status := tc.userStatusGet.exp.status
+				if tc.userStatusGet.exp.err == nil &&
+					!status.DeletedAt.Valid &&
+					!status.DeletionRequestedAt.Valid {
+					mockTokenService.EXPECT().
+						GenerateAccessToken(expPayload).
+						Return(tc.generateAccessToken.exp.token, tc.generateAccessToken.exp.err).
+						After(userStatusGetCall)
+				}
 			}
 
-			app := app.NewApplication(nil, mockTokenService, nil, nil)
+			app := app.NewApplication(mockRepo, mockTokenService, nil, nil, nil, nil)
 
 			accessToken, err := app.UserRefreshToken(ctx, refreshToken)
 			require.Equal(tc.exp.err, err)
@@ -847,7 +970,7 @@ func TestUserUpdate(t *testing.T) {
 				UserUpdate(ctx, userID, columns).
 				Return(tc.userUpdate.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.UserUpdate(ctx, userID, req)
 			require.Equal(tc.exp.err, err)
@@ -863,36 +986,58 @@ func TestUserEmailUpdate(t *testing.T) {
 
 		userID = 1
 		req    = &dto.UserEmailUpdateRequest{
+			Email: "new email",
+		}
+		expUser = &models.User{
+			ID:    userID,
 			Email: "email",
 		}
 		columns = map[string]any{
 			models.UserColumns.Email: req.Email,
 		}
 		expNotFoundError   = app.ErrNotFound
+		expUserGetError    = errors.New("UserGet error")
 		expUserUpdateError = errors.New("UserUpdate error")
+		expNotifyError     = errors.New("Notify error")
 	)
 
+	type UserGetExp struct {
+		user *models.User
+		err  error
+	}
+	type UserGet struct {
+		exp UserGetExp
+	}
 	type UserUpdateExp struct {
 		err error
 	}
 	type UserUpdate struct {
 		exp UserUpdateExp
 	}
+	type NotifyExp struct {
+		err error
+	}
+	type Notify struct {
+		exp NotifyExp
+	}
 	type Exp struct {
 		err error
 	}
 	type TestCase struct {
 		name       string
+		userGet    UserGet
 		userUpdate UserUpdate
+		notify     Notify
 		exp        Exp
 	}
 
 	testCases := []TestCase{
 		{
 			name: "user not found",
-			userUpdate: UserUpdate{
-				exp: UserUpdateExp{
-					err: repo.ErrNoRecord,
+			userGet: UserGet{
+				exp: UserGetExp{
+					user: nil,
+					err:  repo.ErrNoRecord,
 				},
 			},
 			exp: Exp{
@@ -900,8 +1045,40 @@ func TestUserEmailUpdate(t *testing.T) {
 			},
 		},
 
+		{
+			name: "UserGet error",
+			userGet: UserGet{
+				exp: UserGetExp{
+					user: nil,
+					err:  expUserGetError,
+				},
+			},
+			exp: Exp{
+				err: expUserGetError,
+			},
+		},
+
+		{
+			name: "same email",
+			userGet: UserGet{
+				exp: UserGetExp{
+					user: &models.User{ID: userID, Email: req.Email},
+					err:  nil,
+				},
+			},
+			exp: Exp{
+				err: nil,
+			},
+		},
+
 		{
 			name: "UserUpdate error",
+			userGet: UserGet{
+				exp: UserGetExp{
+					user: expUser,
+					err:  nil,
+				},
+			},
 			userUpdate: UserUpdate{
 				exp: UserUpdateExp{
 					err: expUserUpdateError,
@@ -912,13 +1089,47 @@ func TestUserEmailUpdate(t *testing.T) {
 			},
 		},
 
+		{
+			name: "Notify error",
+			userGet: UserGet{
+				exp: UserGetExp{
+					user: expUser,
+					err:  nil,
+				},
+			},
+			userUpdate: UserUpdate{
+				exp: UserUpdateExp{
+					err: nil,
+				},
+			},
+			notify: Notify{
+				exp: NotifyExp{
+					err: expNotifyError,
+				},
+			},
+			exp: Exp{
+				err: expNotifyError,
+			},
+		},
+
 		{
 			name: "ok",
+			userGet: UserGet{
+				exp: UserGetExp{
+					user: expUser,
+					err:  nil,
+				},
+			},
 			userUpdate: UserUpdate{
 				exp: UserUpdateExp{
 					err: nil,
 				},
 			},
+			notify: Notify{
+				exp: NotifyExp{
+					err: nil,
+				},
+			},
 			exp: Exp{
 				err: nil,
 			},
@@ -933,12 +1144,49 @@ func TestUserEmailUpdate(t *testing.T) {
 
 			controller := gomock.NewController(t)
 			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+			mockNotifier := mock_notifier.NewMockService(controller)
 
 			mockRepo.EXPECT().
-				UserUpdate(ctx, userID, columns).
-				Return(tc.userUpdate.exp.err)
+				Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
 
-			app := app.NewApplication(mockRepo, nil, nil, nil)
+			userGetCall := mockRepo.EXPECT().
+				UserGet(ctx, userID).
+				Return(tc.userGet.exp.user, tc.userGet.exp.err)
+
+			if tc.userGet.exp.err == nil && tc.userGet.exp.user.Email != req.Email {
+				userUpdateCall := mockRepo.EXPECT().
+					UserUpdate(ctx, userID, columns).
+					Return(tc.userUpdate.exp.err).
+					After(userGetCall)
+
+				if tc.userUpdate.exp.err == nil {
+					// the previous address is notified first
+					var notified []string
+					notifyCall := mockNotifier.EXPECT().
+						Notify(ctx, gomock.Any()).
+						DoAndReturn(func(_ context.Context, n *notifier.Notification) error {
+							require.Equal(notifier.KindEmailChanged, n.Kind)
+							require.Equal(userID, n.UserID)
+							notified = append(notified, n.Email)
+							return tc.notify.exp.err
+						}).
+						After(userUpdateCall)
+					if tc.notify.exp.err == nil {
+						notifyCall.Times(2)
+						t.Cleanup(func() {
+							require.Equal(
+								[]string{expUser.Email, req.Email},
+								notified,
+							)
+						})
+					}
+				}
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, mockNotifier)
 
 			err := app.UserEmailUpdate(ctx, userID, req)
 			require.Equal(tc.exp.err, err)
@@ -977,6 +1225,7 @@ func TestUserPasswordUpdate(t *testing.T) {
 			"GenerateFromPassword error",
 		)
 		expUserUpdateError = errors.New("UserUpdate error")
+		expNotifyError     = errors.New("Notify error")
 	)
 
 	type UserGetExp struct {
@@ -1005,6 +1254,12 @@ func TestUserPasswordUpdate(t *testing.T) {
 	type UserUpdate struct {
 		exp UserUpdateExp
 	}
+	type NotifyExp struct {
+		err error
+	}
+	type Notify struct {
+		exp NotifyExp
+	}
 	type TxExp struct {
 		err error
 	}
@@ -1022,6 +1277,7 @@ func TestUserPasswordUpdate(t *testing.T) {
 		compareHash          CompareHash
 		generateFromPassword GenerateFromPassword
 		userUpdate           UserUpdate
+		notify               Notify
 		exp                  Exp
 	}
 
@@ -1186,6 +1442,46 @@ func TestUserPasswordUpdate(t *testing.T) {
 			},
 		},
 
+		{
+			name: "Notify error",
+			req:  req,
+			tx: Tx{
+				exp: TxExp{
+					err: expNotifyError,
+				},
+			},
+			userGet: UserGet{
+				exp: UserGetExp{
+					user: expUser,
+					err:  nil,
+				},
+			},
+			compareHash: CompareHash{
+				exp: CompareHashExp{
+					err: nil,
+				},
+			},
+			generateFromPassword: GenerateFromPassword{
+				exp: GenerateFromPasswordExp{
+					hashedPassword: expUser.HashedPassword,
+					err:            nil,
+				},
+			},
+			userUpdate: UserUpdate{
+				exp: UserUpdateExp{
+					err: nil,
+				},
+			},
+			notify: Notify{
+				exp: NotifyExp{
+					err: expNotifyError,
+				},
+			},
+			exp: Exp{
+				err: expNotifyError,
+			},
+		},
+
 		{
 			name: "ok",
 			req:  req,
@@ -1231,6 +1527,7 @@ func TestUserPasswordUpdate(t *testing.T) {
 			controller := gomock.NewController(t)
 			mockRepo := mock_repo.NewMockRepositoryTx(controller)
 			mockHasher := mock_hasher.NewMockInterface(controller)
+			mockNotifier := mock_notifier.NewMockService(controller)
 
 			if tc.req.CurrentPassword != tc.req.NewPassword {
 				txCall := mockRepo.EXPECT().
@@ -1258,16 +1555,35 @@ func TestUserPasswordUpdate(t *testing.T) {
 							After(compareHashAndPasswordCall)
 
 						if tc.generateFromPassword.exp.err == nil {
-							mockRepo.EXPECT().
+							userUpdateCall := mockRepo.EXPECT().
 								UserUpdate(ctx, userID, columns).
 								Return(tc.userUpdate.exp.err).
 								After(generateFromPasswordCall)
+
+							if tc.userUpdate.exp.err == nil {
+								mockNotifier.EXPECT().
+									Notify(ctx, gomock.Any()).
+									DoAndReturn(func(_ context.Context, n *notifier.Notification) error {
+										require.Equal(notifier.KindPasswordChanged, n.Kind)
+										require.Equal(userID, n.UserID)
+										require.Equal(expUser.Email, n.Email)
+										return tc.notify.exp.err
+									}).
+									After(userUpdateCall)
+							}
 						}
 					}
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, mockHasher)
+			app := app.NewApplication(
+				mockRepo,
+				nil,
+				nil,
+				mockHasher,
+				nil,
+				mockNotifier,
+			)
 
 			err := app.UserPasswordUpdate(ctx, userID, tc.req)
 			require.Equal(tc.exp.err, err)
@@ -1296,7 +1612,7 @@ func TestUserDelete(t *testing.T) {
 		expCompareHashAndPasswordError = errors.New(
 			"CompareHashAndPassword error",
 		)
-		expUserDeleteError = errors.New("UserDelete error")
+		expUserDeletionCreateError = errors.New("UserDeletionCreate error")
 	)
 
 	type UserGetExp struct {
@@ -1312,11 +1628,11 @@ func TestUserDelete(t *testing.T) {
 	type CompareHash struct {
 		exp CompareHashExp
 	}
-	type UserDeleteExp struct {
+	type UserDeletionCreateExp struct {
 		err error
 	}
-	type UserDelete struct {
-		exp UserDeleteExp
+	type UserDeletionCreate struct {
+		exp UserDeletionCreateExp
 	}
 	type TxExp struct {
 		err error
@@ -1328,12 +1644,12 @@ func TestUserDelete(t *testing.T) {
 		err error
 	}
 	type TestCase struct {
-		name        string
-		tx          Tx
-		userGet     UserGet
-		compareHash CompareHash
-		userDelete  UserDelete
-		exp         Exp
+		name               string
+		tx                 Tx
+		userGet            UserGet
+		compareHash        CompareHash
+		userDeletionCreate UserDeletionCreate
+		exp                Exp
 	}
 
 	testCases := []TestCase{
@@ -1353,6 +1669,27 @@ func TestUserDelete(t *testing.T) {
 			exp: struct{ err error }{err: expNotFoundError},
 		},
 
+		{
+			name: "user anonymized",
+			tx: Tx{
+				exp: TxExp{
+					err: expNotFoundError,
+				},
+			},
+			userGet: UserGet{
+				exp: UserGetExp{
+					user: &models.User{
+						ID:        userID,
+						DeletedAt: null.TimeFrom(testutils.Date(2000, 1, 1)),
+					},
+					err: nil,
+				},
+			},
+			exp: Exp{
+				err: expNotFoundError,
+			},
+		},
+
 		{
 			name: "UserGet error",
 			tx: Tx{
@@ -1418,10 +1755,10 @@ func TestUserDelete(t *testing.T) {
 		},
 
 		{
-			name: "UserDelete error",
+			name: "UserDeletionCreate error",
 			tx: Tx{
 				exp: TxExp{
-					err: expUserDeleteError,
+					err: expUserDeletionCreateError,
 				},
 			},
 			userGet: UserGet{
@@ -1433,13 +1770,13 @@ func TestUserDelete(t *testing.T) {
 			compareHash: CompareHash{
 				exp: CompareHashExp{err: nil},
 			},
-			userDelete: UserDelete{
-				exp: UserDeleteExp{
-					err: expUserDeleteError,
+			userDeletionCreate: UserDeletionCreate{
+				exp: UserDeletionCreateExp{
+					err: expUserDeletionCreateError,
 				},
 			},
 			exp: Exp{
-				err: expUserDeleteError,
+				err: expUserDeletionCreateError,
 			},
 		},
 
@@ -1459,8 +1796,8 @@ func TestUserDelete(t *testing.T) {
 			compareHash: CompareHash{
 				exp: CompareHashExp{err: nil},
 			},
-			userDelete: UserDelete{
-				exp: UserDeleteExp{
+			userDeletionCreate: UserDeletionCreate{
+				exp: UserDeletionCreateExp{
 					err: nil,
 				},
 			},
@@ -1492,7 +1829,7 @@ func TestUserDelete(t *testing.T) {
 				Return(tc.userGet.exp.user, tc.userGet.exp.err).
 				After(txCall)
 
-			if tc.userGet.exp.err == nil {
+			if tc.userGet.exp.err == nil && !tc.userGet.exp.user.DeletedAt.Valid {
 				compareHashAndPasswordCall := mockHasher.EXPECT().
 					CompareHashAndPassword([]byte(expUser.HashedPassword), []byte(req.Password)).
 					Return(tc.compareHash.exp.err).
@@ -1500,16 +1837,131 @@ func TestUserDelete(t *testing.T) {
 
 				if tc.compareHash.exp.err == nil {
 					mockRepo.EXPECT().
-						UserDelete(ctx, userID).
-						Return(tc.userDelete.exp.err).
+						UserDeletionCreate(ctx, userID).
+						Return(tc.userDeletionCreate.exp.err).
 						After(compareHashAndPasswordCall)
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, mockHasher)
+			app := app.NewApplication(mockRepo, nil, nil, mockHasher, nil, nil)
 
 			err := app.UserDelete(ctx, userID, req)
 			require.Equal(tc.exp.err, err)
 		})
 	}
 }
+
+func TestUserAuditsGetAll(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		userID    = 1
+		offset    = 0
+		limit     = 10
+		expAudits = []*models.UsersAudit{
+			{ID: userID, Email: "email 2"},
+			{ID: userID, Email: "email 1"},
+		}
+		expTotal = len(expAudits)
+
+		expUserGetError = errors.New("UserGet error")
+	)
+
+	type UserGetExp struct {
+		err error
+	}
+	type UserGet struct {
+		exp UserGetExp
+	}
+	type Exp struct {
+		audits []*models.UsersAudit
+		total  int
+		err    error
+	}
+	type TestCase struct {
+		name    string
+		userGet UserGet
+		exp     Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "user not found",
+			userGet: UserGet{
+				exp: UserGetExp{
+					err: repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "UserGet error",
+			userGet: UserGet{
+				exp: UserGetExp{
+					err: expUserGetError,
+				},
+			},
+			exp: Exp{
+				err: expUserGetError,
+			},
+		},
+
+		{
+			name: "ok",
+			userGet: UserGet{
+				exp: UserGetExp{
+					err: nil,
+				},
+			},
+			exp: Exp{
+				audits: expAudits,
+				total:  expTotal,
+				err:    nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockRepositoryTx(controller)
+
+			mockRepo.EXPECT().
+				Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			userGetCall := mockRepo.EXPECT().
+				UserGet(ctx, userID).
+				Return(&models.User{ID: userID}, tc.userGet.exp.err)
+
+			if tc.userGet.exp.err == nil {
+				auditsGetAllCall := mockRepo.EXPECT().
+					UserAuditsGetAll(ctx, userID, offset, limit).
+					Return(expAudits, nil).
+					After(userGetCall)
+				mockRepo.EXPECT().
+					UserAuditsCount(ctx, userID).
+					Return(expTotal, nil).
+					After(auditsGetAllCall)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			audits, total, err := app.UserAuditsGetAll(ctx, userID, offset, limit)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.audits, audits)
+			require.Equal(tc.exp.total, total)
+		})
+	}
+}
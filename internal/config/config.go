@@ -30,11 +30,12 @@ type config struct {
 		} `yaml:"database" env-required:"true"`
 
 		Server struct {
-			Production               bool   `yaml:"production" env:"SERVER_PRODUCTION" env-default:"false"`
-			Logfile                  string `yaml:"logfile" env:"SERVER_LOGFILE" env-required:"true"`
-			Port                     uint16 `yaml:"port" env:"SERVER_PORT" env-required:"true"`
-			HandlerTimeoutInSeconds  int    `yaml:"handler_timeout_in_seconds" env-required:"true"`
-			ShutdownTimeoutInSeconds int    `yaml:"shutdown_timeout_in_seconds" env-required:"true"`
+			Production               bool     `yaml:"production" env:"SERVER_PRODUCTION" env-default:"false"`
+			Logfile                  string   `yaml:"logfile" env:"SERVER_LOGFILE" env-required:"true"`
+			Port                     uint16   `yaml:"port" env:"SERVER_PORT" env-required:"true"`
+			HandlerTimeoutInSeconds  int      `yaml:"handler_timeout_in_seconds" env-required:"true"`
+			ShutdownTimeoutInSeconds int      `yaml:"shutdown_timeout_in_seconds" env-required:"true"`
+			TrustedProxies           []string `yaml:"trusted_proxies" env:"SERVER_TRUSTED_PROXIES"`
 		} `yaml:"server" env-required:"true"`
 
 		Token struct {
@@ -54,6 +55,20 @@ type config struct {
 		Elasticsearch struct {
 			Url string `yaml:"url" env:"ELASTICSEARCH_URL" env-required:"true"`
 		} `yaml:"elasticsearch" env-required:"true"`
+
+		Storage struct {
+			Local struct {
+				Root string `yaml:"root" env:"STORAGE_LOCAL_ROOT" env-required:"true"`
+			} `yaml:"local" env-required:"true"`
+		} `yaml:"storage" env-required:"true"`
+
+		Tracing struct {
+			Exporter string `yaml:"exporter" env:"TRACING_EXPORTER" env-required:"true"`
+			OTLP     struct {
+				Endpoint string `yaml:"endpoint" env:"TRACING_OTLP_ENDPOINT"`
+			} `yaml:"otlp"`
+			SampleRatio float64 `yaml:"sample_ratio"`
+		} `yaml:"tracing" env-required:"true"`
 	} `yaml:"service" env-required:"true"`
 
 	Pagination struct {
@@ -67,8 +82,83 @@ type config struct {
 			MinValue     int    `yaml:"min_value" env-required:"true"`
 			MaxValue     int    `yaml:"max_value" env-required:"true"`
 		} `yaml:"page_size" env-required:"true"`
+		Cursor struct {
+			VarName string `yaml:"var_name" env-required:"true"`
+		} `yaml:"cursor" env-required:"true"`
 	} `yaml:"pagination" env-required:"true"`
 
+	Listing struct {
+		Sort struct {
+			VarName string `yaml:"var_name" env-required:"true"`
+		} `yaml:"sort" env-required:"true"`
+	} `yaml:"listing" env-required:"true"`
+
+	Shaping struct {
+		Fields struct {
+			VarName string `yaml:"var_name" env-required:"true"`
+		} `yaml:"fields" env-required:"true"`
+		Include struct {
+			VarName string `yaml:"var_name" env-required:"true"`
+		} `yaml:"include" env-required:"true"`
+	} `yaml:"shaping" env-required:"true"`
+
+	Invalidation struct {
+		Include struct {
+			VarName string `yaml:"var_name" env-required:"true"`
+		} `yaml:"include" env-required:"true"`
+		Cascade struct {
+			VarName string `yaml:"var_name" env-required:"true"`
+		} `yaml:"cascade" env-required:"true"`
+	} `yaml:"invalidation" env-required:"true"`
+
+	Audit struct {
+		Retention struct {
+			KeepRevisions     int `yaml:"keep_revisions" env-required:"true"`
+			MaxRevisions      int `yaml:"max_revisions"`
+			MaxAgeInDays      int `yaml:"max_age_in_days"`
+			IntervalInMinutes int `yaml:"interval_in_minutes"`
+		} `yaml:"retention" env-required:"true"`
+	} `yaml:"audit" env-required:"true"`
+
+	Account struct {
+		Deletion struct {
+			GracePeriodInDays int `yaml:"grace_period_in_days"`
+			IntervalInMinutes int `yaml:"interval_in_minutes"`
+		} `yaml:"deletion" env-required:"true"`
+	} `yaml:"account" env-required:"true"`
+
+	Idempotency struct {
+		TTLInHours        int `yaml:"ttl_in_hours" env-required:"true"`
+		IntervalInMinutes int `yaml:"interval_in_minutes"`
+	} `yaml:"idempotency" env-required:"true"`
+
+	RateLimit struct {
+		Store             string `yaml:"store" env-required:"true"`
+		MaxBuckets        int    `yaml:"max_buckets"`
+		IntervalInMinutes int    `yaml:"interval_in_minutes"`
+		Read              struct {
+			PerMinute int `yaml:"per_minute"`
+			Burst     int `yaml:"burst"`
+		} `yaml:"read" env-required:"true"`
+		Write struct {
+			PerMinute int `yaml:"per_minute"`
+			Burst     int `yaml:"burst"`
+		} `yaml:"write" env-required:"true"`
+		Login struct {
+			PerMinute int `yaml:"per_minute"`
+			Burst     int `yaml:"burst"`
+		} `yaml:"login" env-required:"true"`
+		UserCreate struct {
+			PerMinute int `yaml:"per_minute"`
+			Burst     int `yaml:"burst"`
+		} `yaml:"user_create" env-required:"true"`
+	} `yaml:"rate_limit" env-required:"true"`
+
+	Moderation struct {
+		TrustThreshold int   `yaml:"trust_threshold"`
+		Moderators     []int `yaml:"moderators"`
+	} `yaml:"moderation" env-required:"true"`
+
 	Validation struct {
 		Request struct {
 			Search struct {
@@ -81,6 +171,12 @@ type config struct {
 				MinLength int `yaml:"min_length" env-required:"true"`
 				MaxLength int `yaml:"max_length" env-required:"true"`
 			} `yaml:"invalidation" env-required:"true"`
+			EditSummary struct {
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"edit_summary" env-required:"true"`
+			IdempotencyKey struct {
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"idempotency_key" env-required:"true"`
 			Array struct {
 				MaxLength int `yaml:"max_length" env-required:"true"`
 			} `yaml:"array" env-required:"true"`
@@ -172,5 +268,91 @@ type config struct {
 				} `yaml:"min_value" env-required:"true"`
 			} `yaml:"date_ended" env-required:"true"`
 		} `yaml:"series" env-required:"true"`
+
+		Season struct {
+			Title struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"title" env-required:"true"`
+			Descriptions struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"descriptions" env-required:"true"`
+			DateStarted struct {
+				MinValue struct {
+					Year  int `yaml:"year"  env-required:"true"`
+					Month int `yaml:"month"  env-required:"true"`
+					Day   int `yaml:"day"  env-required:"true"`
+				} `yaml:"min_value" env-required:"true"`
+			} `yaml:"date_started" env-required:"true"`
+			DateEnded struct {
+				MinValue struct {
+					Year  int `yaml:"year"  env-required:"true"`
+					Month int `yaml:"month"  env-required:"true"`
+					Day   int `yaml:"day"  env-required:"true"`
+				} `yaml:"min_value" env-required:"true"`
+			} `yaml:"date_ended" env-required:"true"`
+		} `yaml:"season" env-required:"true"`
+
+		Playlist struct {
+			Title struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"title" env-required:"true"`
+			Descriptions struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"descriptions" env-required:"true"`
+		} `yaml:"playlist" env-required:"true"`
+
+		Post struct {
+			Body struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"body" env-required:"true"`
+			References struct {
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"references" env-required:"true"`
+		} `yaml:"post" env-required:"true"`
+
+		Artist struct {
+			FirstName struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"first_name" env-required:"true"`
+			LastName struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"last_name" env-required:"true"`
+			Bio struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"bio" env-required:"true"`
+			Birthdate struct {
+				MinValue struct {
+					Year  int `yaml:"year"  env-required:"true"`
+					Month int `yaml:"month"  env-required:"true"`
+					Day   int `yaml:"day"  env-required:"true"`
+				} `yaml:"min_value" env-required:"true"`
+			} `yaml:"birthdate" env-required:"true"`
+		} `yaml:"artist" env-required:"true"`
+
+		Media struct {
+			Image struct {
+				MaxSizeInBytes int64    `yaml:"max_size_in_bytes" env-required:"true"`
+				MaxPixels      int      `yaml:"max_pixels" env-required:"true"`
+				MimeTypes      []string `yaml:"mime_types" env-required:"true"`
+			} `yaml:"image" env-required:"true"`
+			Video struct {
+				MaxSizeInBytes int64    `yaml:"max_size_in_bytes" env-required:"true"`
+				MimeTypes      []string `yaml:"mime_types" env-required:"true"`
+			} `yaml:"video" env-required:"true"`
+			Thumbnail struct {
+				MaxDimension int `yaml:"max_dimension" env-required:"true"`
+			} `yaml:"thumbnail" env-required:"true"`
+			Cover struct {
+				Size int `yaml:"size" env-required:"true"`
+			} `yaml:"cover" env-required:"true"`
+		} `yaml:"media" env-required:"true"`
 	} `yaml:"validation" env-required:"true"`
 }
@@ -726,6 +726,374 @@ func (r EpisodesPutAllBySeasonRequest) Validate() error {
 	)
 }
 
+// #############################################################################
+// #############################################################################
+// -----------------------------------------------------------------------------
+// SeasonPutRequest
+// -----------------------------------------------------------------------------
+// #############################################################################
+// #############################################################################
+type SeasonPutRequest struct {
+	Title        null.String `json:"title"`
+	Descriptions null.String `json:"descriptions"`
+	DateStarted  null.Time   `json:"date_started"`
+	DateEnded    null.Time   `json:"date_ended"`
+}
+
+var _ validation.Validatable = SeasonPutRequest{}
+
+func (r SeasonPutRequest) Validate() error {
+	timeNow := time.Now()
+
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Title,
+			validation.When(
+				r.Title.Valid,
+				validation.Required,
+				validation.Length(
+					config.Config.Validation.Season.Title.MinLength,
+					config.Config.Validation.Season.Title.MaxLength,
+				),
+			),
+		),
+		validation.Field(
+			&r.Descriptions,
+			validation.When(
+				r.Descriptions.Valid,
+				validation.Required,
+				validation.Length(
+					config.Config.Validation.Season.Descriptions.MinLength,
+					config.Config.Validation.Season.Descriptions.MaxLength,
+				),
+			),
+		),
+		validation.Field(
+			&r.DateStarted,
+			validation.When(
+				r.DateStarted.Valid,
+				validation.Required,
+				validation.Min(
+					time.Date(
+						config.Config.Validation.Season.DateStarted.MinValue.Year,
+						time.Month(
+							config.Config.Validation.Season.DateStarted.MinValue.Month,
+						),
+						config.Config.Validation.Season.DateStarted.MinValue.Day,
+						0, 0, 0, 0, time.UTC,
+					),
+				),
+				validation.Max(
+					time.Date(
+						timeNow.Year(), timeNow.Month(), timeNow.Day(),
+						0, 0, 0, 0, time.UTC,
+					),
+				),
+			),
+		),
+		validation.Field(
+			&r.DateEnded,
+			validation.When(
+				r.DateEnded.Valid,
+				validation.Required,
+				validation.Min(
+					time.Date(
+						config.Config.Validation.Season.DateEnded.MinValue.Year,
+						time.Month(
+							config.Config.Validation.Season.DateEnded.MinValue.Month,
+						),
+						config.Config.Validation.Season.DateEnded.MinValue.Day,
+						0, 0, 0, 0, time.UTC,
+					),
+				),
+				validation.Max(
+					time.Date(
+						timeNow.Year(), timeNow.Month(), timeNow.Day(),
+						0, 0, 0, 0, time.UTC,
+					),
+				),
+			),
+		),
+	)
+}
+
+// #############################################################################
+// #############################################################################
+// -----------------------------------------------------------------------------
+// SeasonUpdateRequest
+// -----------------------------------------------------------------------------
+// #############################################################################
+// #############################################################################
+type SeasonUpdateRequest SeasonPutRequest
+
+var _ validation.Validatable = SeasonUpdateRequest{}
+
+func (r SeasonUpdateRequest) Validate() error { return (SeasonPutRequest(r)).Validate() }
+
+// #############################################################################
+// #############################################################################
+// -----------------------------------------------------------------------------
+// PlaylistCreateRequest
+// -----------------------------------------------------------------------------
+// #############################################################################
+// #############################################################################
+type PlaylistCreateRequest struct {
+	Title        string      `json:"title"`
+	Descriptions null.String `json:"descriptions"`
+	IsPublic     bool        `json:"is_public"`
+}
+
+var _ validation.Validatable = PlaylistCreateRequest{}
+
+func (r PlaylistCreateRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Title,
+			validation.Required,
+			validation.Length(
+				config.Config.Validation.Playlist.Title.MinLength,
+				config.Config.Validation.Playlist.Title.MaxLength,
+			),
+		),
+		validation.Field(
+			&r.Descriptions,
+			validation.When(
+				r.Descriptions.Valid,
+				validation.Required,
+				validation.Length(
+					config.Config.Validation.Playlist.Descriptions.MinLength,
+					config.Config.Validation.Playlist.Descriptions.MaxLength,
+				),
+			),
+		),
+	)
+}
+
+// #############################################################################
+// #############################################################################
+// -----------------------------------------------------------------------------
+// PlaylistUpdateRequest
+// -----------------------------------------------------------------------------
+// #############################################################################
+// #############################################################################
+type PlaylistUpdateRequest struct {
+	Title        null.String `json:"title"`
+	Descriptions null.String `json:"descriptions"`
+	IsPublic     null.Bool   `json:"is_public"`
+}
+
+var _ validation.Validatable = PlaylistUpdateRequest{}
+
+func (r PlaylistUpdateRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Title,
+			validation.When(
+				r.Title.Valid,
+				validation.Required,
+				validation.Length(
+					config.Config.Validation.Playlist.Title.MinLength,
+					config.Config.Validation.Playlist.Title.MaxLength,
+				),
+			),
+		),
+		validation.Field(
+			&r.Descriptions,
+			validation.When(
+				r.Descriptions.Valid,
+				validation.Required,
+				validation.Length(
+					config.Config.Validation.Playlist.Descriptions.MinLength,
+					config.Config.Validation.Playlist.Descriptions.MaxLength,
+				),
+			),
+		),
+	)
+}
+
+// #############################################################################
+// #############################################################################
+// -----------------------------------------------------------------------------
+// PlaylistFilmsReorderRequest
+// -----------------------------------------------------------------------------
+// #############################################################################
+// #############################################################################
+type PlaylistFilmsReorderRequest struct {
+	FilmIDs []int `json:"film_ids"`
+}
+
+var _ validation.Validatable = PlaylistFilmsReorderRequest{}
+
+func (r PlaylistFilmsReorderRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.FilmIDs,
+			validation.Required,
+			validation.Length(
+				1,
+				config.Config.Validation.Request.Array.MaxLength,
+			),
+			validation.Each(validation.Min(1)),
+		),
+	)
+}
+
+// #############################################################################
+// #############################################################################
+// -----------------------------------------------------------------------------
+// PostCreateRequest
+// -----------------------------------------------------------------------------
+// #############################################################################
+// #############################################################################
+type PostCreateRequest struct {
+	Body string `json:"body"`
+	// PostID is the replied post
+	PostID    null.Int `json:"post_id"`
+	FilmIDs   []int    `json:"film_ids"`
+	SeriesIDs []int    `json:"series_ids"`
+	UserIDs   []int    `json:"user_ids"`
+	ArtistIDs []int    `json:"artist_ids"`
+}
+
+var _ validation.Validatable = PostCreateRequest{}
+
+func (r PostCreateRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Body,
+			validation.Required,
+			validation.Length(
+				config.Config.Validation.Post.Body.MinLength,
+				config.Config.Validation.Post.Body.MaxLength,
+			),
+		),
+		validation.Field(
+			&r.PostID,
+			validation.When(r.PostID.Valid, validation.Min(1)),
+		),
+		validation.Field(&r.FilmIDs, postReferencesValidationRules()...),
+		validation.Field(&r.SeriesIDs, postReferencesValidationRules()...),
+		validation.Field(&r.UserIDs, postReferencesValidationRules()...),
+		validation.Field(&r.ArtistIDs, postReferencesValidationRules()...),
+	)
+}
+
+func postReferencesValidationRules() []validation.Rule {
+	return []validation.Rule{
+		validation.Length(0, config.Config.Validation.Post.References.MaxLength),
+		validation.Each(validation.Min(1)),
+	}
+}
+
+// #############################################################################
+// #############################################################################
+// -----------------------------------------------------------------------------
+// PostUpdateRequest
+// -----------------------------------------------------------------------------
+// #############################################################################
+// #############################################################################
+type PostUpdateRequest struct {
+	Body string `json:"body"`
+}
+
+var _ validation.Validatable = PostUpdateRequest{}
+
+func (r PostUpdateRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Body,
+			validation.Required,
+			validation.Length(
+				config.Config.Validation.Post.Body.MinLength,
+				config.Config.Validation.Post.Body.MaxLength,
+			),
+		),
+	)
+}
+
+// #############################################################################
+// #############################################################################
+// -----------------------------------------------------------------------------
+// ArtistCreateRequest
+// -----------------------------------------------------------------------------
+// #############################################################################
+// #############################################################################
+type ArtistCreateRequest struct {
+	FirstName string      `json:"first_name"`
+	LastName  null.String `json:"last_name"`
+	Bio       null.String `json:"bio"`
+	Birthdate null.Time   `json:"birthdate"`
+}
+
+var _ validation.Validatable = ArtistCreateRequest{}
+
+func (r ArtistCreateRequest) Validate() error {
+	timeNow := time.Now()
+
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.FirstName,
+			validation.Required,
+			validation.Length(
+				config.Config.Validation.Artist.FirstName.MinLength,
+				config.Config.Validation.Artist.FirstName.MaxLength,
+			),
+		),
+		validation.Field(
+			&r.LastName,
+			validation.When(
+				r.LastName.Valid,
+				validation.Required,
+				validation.Length(
+					config.Config.Validation.Artist.LastName.MinLength,
+					config.Config.Validation.Artist.LastName.MaxLength,
+				),
+			),
+		),
+		validation.Field(
+			&r.Bio,
+			validation.When(
+				r.Bio.Valid,
+				validation.Required,
+				validation.Length(
+					config.Config.Validation.Artist.Bio.MinLength,
+					config.Config.Validation.Artist.Bio.MaxLength,
+				),
+			),
+		),
+		validation.Field(
+			&r.Birthdate,
+			validation.When(
+				r.Birthdate.Valid,
+				validation.Required,
+				validation.Min(
+					time.Date(
+						config.Config.Validation.Artist.Birthdate.MinValue.Year,
+						time.Month(
+							config.Config.Validation.Artist.Birthdate.MinValue.Month,
+						),
+						config.Config.Validation.Artist.Birthdate.MinValue.Day,
+						0, 0, 0, 0, time.UTC,
+					),
+				),
+				validation.Max(
+					time.Date(
+						timeNow.Year(), timeNow.Month(), timeNow.Day(),
+						0, 0, 0, 0, time.UTC,
+					),
+				),
+			),
+		),
+	)
+}
+
 // #############################################################################
 // #############################################################################
 // -----------------------------------------------------------------------------
@@ -762,7 +1130,7 @@ func (r InvalidationRequest) Validate() error {
 // #############################################################################
 
 type SearchRequest struct {
-	Query string `json:"query"`
+	Query string `json:"query" query:"query"`
 }
 
 var _ validation.Validatable = SearchRequest{}
@@ -0,0 +1,33 @@
+package logging
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+type loggerKey struct{}
+
+// WithLogger returns a copy of ctx carrying logger, so that the layers a
+// request goes through log with the fields identifying the request.
+func WithLogger(ctx context.Context, logger *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the logger carried by ctx, the global logger if none.
+func FromContext(ctx context.Context) *zap.Logger {
+	return FromContextOr(ctx, zap.L())
+}
+
+// FromContextOr returns the logger carried by ctx, fallback if none.
+func FromContextOr(ctx context.Context, fallback *zap.Logger) *zap.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*zap.Logger); ok {
+		return logger
+	}
+	return fallback
+}
+
+// With returns a copy of ctx carrying its logger with fields added
+func With(ctx context.Context, fields ...zap.Field) context.Context {
+	return WithLogger(ctx, FromContext(ctx).With(fields...))
+}
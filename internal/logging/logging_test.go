@@ -0,0 +1,47 @@
+package logging_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/logging"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestFromContext(t *testing.T) {
+	require := require.New(t)
+
+	// the global logger outside of requests
+	require.Equal(zap.L(), logging.FromContext(context.Background()))
+
+	core, logs := observer.New(zap.InfoLevel)
+	ctx := logging.WithLogger(context.Background(), zap.New(core))
+	ctx = logging.With(ctx, zap.String("request_id", "id"))
+
+	logging.FromContext(ctx).Info("message", zap.Int("user_id", 1))
+
+	require.Equal(1, logs.Len())
+	entry := logs.All()[0]
+	require.Equal("message", entry.Message)
+	require.Equal(
+		map[string]any{"request_id": "id", "user_id": int64(1)},
+		entry.ContextMap(),
+	)
+}
+
+func TestFromContextOr(t *testing.T) {
+	require := require.New(t)
+
+	fallback, logger := zap.NewNop(), zap.NewExample()
+
+	require.Same(fallback, logging.FromContextOr(context.Background(), fallback))
+	require.Same(
+		logger,
+		logging.FromContextOr(
+			logging.WithLogger(context.Background(), logger),
+			fallback,
+		),
+	)
+}
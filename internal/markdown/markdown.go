@@ -0,0 +1,20 @@
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+)
+
+// renderer drops raw html and links with dangerous schemes (javascript:,
+// vbscript:, file: and non-image data:) so its output is safe to embed.
+var renderer = goldmark.New()
+
+// Render converts the markdown source to sanitized html.
+func Render(source string) (string, error) {
+	var html bytes.Buffer
+	if err := renderer.Convert([]byte(source), &html); err != nil {
+		return "", err
+	}
+	return html.String(), nil
+}
@@ -0,0 +1,48 @@
+package markdown_test
+
+import (
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/markdown"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	testCases := []struct {
+		name   string
+		source string
+		exp    string
+	}{
+		{
+			name:   "markdown",
+			source: "# title\n\n**bold** [link](https://example.com)",
+			exp:    "<h1>title</h1>\n<p><strong>bold</strong> <a href=\"https://example.com\">link</a></p>\n",
+		},
+		{
+			name:   "raw html",
+			source: "<script>alert(1)</script>",
+			exp:    "<!-- raw HTML omitted -->\n",
+		},
+		{
+			name:   "inline raw html",
+			source: "text <img src=x onerror=alert(1)>",
+			exp:    "<p>text <!-- raw HTML omitted --></p>\n",
+		},
+		{
+			name:   "dangerous link",
+			source: "[link](javascript:alert(1))",
+			exp:    "<p><a href=\"\">link</a></p>\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			html, err := markdown.Render(tc.source)
+			require.NoError(err)
+			require.Equal(tc.exp, html)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "watch"
+
+// Registry holds the metrics of the service. It's apart from the default
+// registry so that only ours are exposed, and gathered in process by tests.
+var Registry = prometheus.NewRegistry()
+
+var (
+	HTTPRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests served by route, method and status.",
+		},
+		[]string{"route", "method", "status"},
+	)
+	HTTPRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latency of the HTTP requests by route, method and status.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"route", "method", "status"},
+	)
+
+	DBTransactionDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "db_transaction_duration_seconds",
+			Help:      "Duration of the database transactions by outcome, commit or rollback.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"outcome"},
+	)
+
+	ElasticsearchRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "elasticsearch_request_duration_seconds",
+			Help:      "Latency of the Elasticsearch requests by method.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+	ElasticsearchRequestErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "elasticsearch_request_errors_total",
+			Help:      "Elasticsearch requests failed or answered a server error, by method.",
+		},
+		[]string{"method"},
+	)
+
+	LoginFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "login_failures_total",
+			Help:      "Failed logins by reason.",
+		},
+		[]string{"reason"},
+	)
+	TokenValidationFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "token_validation_failures_total",
+			Help:      "Rejected bearer tokens by reason.",
+		},
+		[]string{"reason"},
+	)
+)
+
+// the reasons of the login and token validation failures
+const (
+	ReasonEmailNotFound     = "email_not_found"
+	ReasonIncorrectPassword = "incorrect_password"
+	ReasonTokenMissing      = "missing_or_malformed"
+	ReasonTokenInvalid      = "invalid"
+)
+
+// the outcomes of the database transactions
+const (
+	OutcomeCommit   = "commit"
+	OutcomeRollback = "rollback"
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequestsTotal,
+		HTTPRequestDuration,
+		DBTransactionDuration,
+		ElasticsearchRequestDuration,
+		ElasticsearchRequestErrorsTotal,
+		LoginFailuresTotal,
+		TokenValidationFailuresTotal,
+	)
+}
+
+// RegisterDB exposes the connection pool stats of db
+func RegisterDB(db *sql.DB) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, namespace))
+}
+
+// Handler serves the metrics of Registry to the Prometheus scrapes
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
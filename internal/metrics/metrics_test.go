@@ -0,0 +1,61 @@
+package metrics_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/metrics"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	require := require.New(t)
+
+	// instrument elasticsearch requests
+	transport := metrics.InstrumentElasticsearch(
+		testutils.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			status := http.StatusOK
+			if req.URL.Path == "/fail" {
+				status = http.StatusServiceUnavailable
+			}
+			return &http.Response{StatusCode: status, Body: http.NoBody}, nil
+		}),
+	)
+	for _, path := range []string{"/ok", "/fail"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		_, err := transport.RoundTrip(req)
+		require.NoError(err)
+	}
+
+	metrics.LoginFailuresTotal.
+		WithLabelValues(metrics.ReasonIncorrectPassword).
+		Inc()
+
+	// scrape the registry in process
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(
+		rec,
+		httptest.NewRequest(http.MethodGet, "/metrics", nil),
+	)
+	require.Equal(http.StatusOK, rec.Code)
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(err)
+
+	require.Contains(
+		string(body),
+		`watch_elasticsearch_request_duration_seconds_count{method="GET"} 2`,
+	)
+	require.Contains(
+		string(body),
+		`watch_elasticsearch_request_errors_total{method="GET"} 1`,
+	)
+	require.Contains(
+		string(body),
+		`watch_login_failures_total{reason="incorrect_password"} 1`,
+	)
+	// the runtime metrics are exposed too
+	require.Contains(string(body), "go_goroutines")
+}
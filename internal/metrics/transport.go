@@ -0,0 +1,31 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+)
+
+// elasticsearchTransport times the round trips to Elasticsearch
+type elasticsearchTransport struct {
+	next http.RoundTripper
+}
+
+// InstrumentElasticsearch returns next recording the latency and errors of
+// the Elasticsearch requests it makes.
+func InstrumentElasticsearch(next http.RoundTripper) http.RoundTripper {
+	return &elasticsearchTransport{next: next}
+}
+
+func (t *elasticsearchTransport) RoundTrip(
+	req *http.Request,
+) (*http.Response, error) {
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+	ElasticsearchRequestDuration.
+		WithLabelValues(req.Method).
+		Observe(time.Since(start).Seconds())
+	if err != nil || res.StatusCode >= http.StatusInternalServerError {
+		ElasticsearchRequestErrorsTotal.WithLabelValues(req.Method).Inc()
+	}
+	return res, err
+}
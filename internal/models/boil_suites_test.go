@@ -12,112 +12,368 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrls)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAudits)
+	t.Run("Artists", testArtists)
+	t.Run("ArtistsAudits", testArtistsAudits)
+	t.Run("FilmMediaUrls", testFilmMediaUrls)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAudits)
 	t.Run("Films", testFilms)
 	t.Run("FilmsAudits", testFilmsAudits)
+	t.Run("IdempotencyKeys", testIdempotencyKeys)
+	t.Run("PlaylistFilms", testPlaylistFilms)
+	t.Run("Playlists", testPlaylists)
+	t.Run("PostRevisions", testPostRevisions)
+	t.Run("Posts", testPosts)
+	t.Run("Proposals", testProposals)
+	t.Run("Seasons", testSeasons)
+	t.Run("SeasonsAudits", testSeasonsAudits)
 	t.Run("Serieses", testSerieses)
 	t.Run("SeriesesAudits", testSeriesesAudits)
+	t.Run("UserDeletions", testUserDeletions)
+	t.Run("UserFollowings", testUserFollowings)
 	t.Run("Users", testUsers)
+	t.Run("UsersAudits", testUsersAudits)
+	t.Run("Watchlists", testWatchlists)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsDelete)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsDelete)
+	t.Run("Artists", testArtistsDelete)
+	t.Run("ArtistsAudits", testArtistsAuditsDelete)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsDelete)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsDelete)
 	t.Run("Films", testFilmsDelete)
 	t.Run("FilmsAudits", testFilmsAuditsDelete)
+	t.Run("IdempotencyKeys", testIdempotencyKeysDelete)
+	t.Run("PlaylistFilms", testPlaylistFilmsDelete)
+	t.Run("Playlists", testPlaylistsDelete)
+	t.Run("PostRevisions", testPostRevisionsDelete)
+	t.Run("Posts", testPostsDelete)
+	t.Run("Proposals", testProposalsDelete)
+	t.Run("Seasons", testSeasonsDelete)
+	t.Run("SeasonsAudits", testSeasonsAuditsDelete)
 	t.Run("Serieses", testSeriesesDelete)
 	t.Run("SeriesesAudits", testSeriesesAuditsDelete)
+	t.Run("UserDeletions", testUserDeletionsDelete)
+	t.Run("UserFollowings", testUserFollowingsDelete)
 	t.Run("Users", testUsersDelete)
+	t.Run("UsersAudits", testUsersAuditsDelete)
+	t.Run("Watchlists", testWatchlistsDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsQueryDeleteAll)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsQueryDeleteAll)
+	t.Run("Artists", testArtistsQueryDeleteAll)
+	t.Run("ArtistsAudits", testArtistsAuditsQueryDeleteAll)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsQueryDeleteAll)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsQueryDeleteAll)
 	t.Run("Films", testFilmsQueryDeleteAll)
 	t.Run("FilmsAudits", testFilmsAuditsQueryDeleteAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysQueryDeleteAll)
+	t.Run("PlaylistFilms", testPlaylistFilmsQueryDeleteAll)
+	t.Run("Playlists", testPlaylistsQueryDeleteAll)
+	t.Run("PostRevisions", testPostRevisionsQueryDeleteAll)
+	t.Run("Posts", testPostsQueryDeleteAll)
+	t.Run("Proposals", testProposalsQueryDeleteAll)
+	t.Run("Seasons", testSeasonsQueryDeleteAll)
+	t.Run("SeasonsAudits", testSeasonsAuditsQueryDeleteAll)
 	t.Run("Serieses", testSeriesesQueryDeleteAll)
 	t.Run("SeriesesAudits", testSeriesesAuditsQueryDeleteAll)
+	t.Run("UserDeletions", testUserDeletionsQueryDeleteAll)
+	t.Run("UserFollowings", testUserFollowingsQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
+	t.Run("UsersAudits", testUsersAuditsQueryDeleteAll)
+	t.Run("Watchlists", testWatchlistsQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsSliceDeleteAll)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsSliceDeleteAll)
+	t.Run("Artists", testArtistsSliceDeleteAll)
+	t.Run("ArtistsAudits", testArtistsAuditsSliceDeleteAll)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsSliceDeleteAll)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsSliceDeleteAll)
 	t.Run("Films", testFilmsSliceDeleteAll)
 	t.Run("FilmsAudits", testFilmsAuditsSliceDeleteAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysSliceDeleteAll)
+	t.Run("PlaylistFilms", testPlaylistFilmsSliceDeleteAll)
+	t.Run("Playlists", testPlaylistsSliceDeleteAll)
+	t.Run("PostRevisions", testPostRevisionsSliceDeleteAll)
+	t.Run("Posts", testPostsSliceDeleteAll)
+	t.Run("Proposals", testProposalsSliceDeleteAll)
+	t.Run("Seasons", testSeasonsSliceDeleteAll)
+	t.Run("SeasonsAudits", testSeasonsAuditsSliceDeleteAll)
 	t.Run("Serieses", testSeriesesSliceDeleteAll)
 	t.Run("SeriesesAudits", testSeriesesAuditsSliceDeleteAll)
+	t.Run("UserDeletions", testUserDeletionsSliceDeleteAll)
+	t.Run("UserFollowings", testUserFollowingsSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
+	t.Run("UsersAudits", testUsersAuditsSliceDeleteAll)
+	t.Run("Watchlists", testWatchlistsSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsExists)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsExists)
+	t.Run("Artists", testArtistsExists)
+	t.Run("ArtistsAudits", testArtistsAuditsExists)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsExists)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsExists)
 	t.Run("Films", testFilmsExists)
 	t.Run("FilmsAudits", testFilmsAuditsExists)
+	t.Run("IdempotencyKeys", testIdempotencyKeysExists)
+	t.Run("PlaylistFilms", testPlaylistFilmsExists)
+	t.Run("Playlists", testPlaylistsExists)
+	t.Run("PostRevisions", testPostRevisionsExists)
+	t.Run("Posts", testPostsExists)
+	t.Run("Proposals", testProposalsExists)
+	t.Run("Seasons", testSeasonsExists)
+	t.Run("SeasonsAudits", testSeasonsAuditsExists)
 	t.Run("Serieses", testSeriesesExists)
 	t.Run("SeriesesAudits", testSeriesesAuditsExists)
+	t.Run("UserDeletions", testUserDeletionsExists)
+	t.Run("UserFollowings", testUserFollowingsExists)
 	t.Run("Users", testUsersExists)
+	t.Run("UsersAudits", testUsersAuditsExists)
+	t.Run("Watchlists", testWatchlistsExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsFind)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsFind)
+	t.Run("Artists", testArtistsFind)
+	t.Run("ArtistsAudits", testArtistsAuditsFind)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsFind)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsFind)
 	t.Run("Films", testFilmsFind)
 	t.Run("FilmsAudits", testFilmsAuditsFind)
+	t.Run("IdempotencyKeys", testIdempotencyKeysFind)
+	t.Run("PlaylistFilms", testPlaylistFilmsFind)
+	t.Run("Playlists", testPlaylistsFind)
+	t.Run("PostRevisions", testPostRevisionsFind)
+	t.Run("Posts", testPostsFind)
+	t.Run("Proposals", testProposalsFind)
+	t.Run("Seasons", testSeasonsFind)
+	t.Run("SeasonsAudits", testSeasonsAuditsFind)
 	t.Run("Serieses", testSeriesesFind)
 	t.Run("SeriesesAudits", testSeriesesAuditsFind)
+	t.Run("UserDeletions", testUserDeletionsFind)
+	t.Run("UserFollowings", testUserFollowingsFind)
 	t.Run("Users", testUsersFind)
+	t.Run("UsersAudits", testUsersAuditsFind)
+	t.Run("Watchlists", testWatchlistsFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsBind)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsBind)
+	t.Run("Artists", testArtistsBind)
+	t.Run("ArtistsAudits", testArtistsAuditsBind)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsBind)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsBind)
 	t.Run("Films", testFilmsBind)
 	t.Run("FilmsAudits", testFilmsAuditsBind)
+	t.Run("IdempotencyKeys", testIdempotencyKeysBind)
+	t.Run("PlaylistFilms", testPlaylistFilmsBind)
+	t.Run("Playlists", testPlaylistsBind)
+	t.Run("PostRevisions", testPostRevisionsBind)
+	t.Run("Posts", testPostsBind)
+	t.Run("Proposals", testProposalsBind)
+	t.Run("Seasons", testSeasonsBind)
+	t.Run("SeasonsAudits", testSeasonsAuditsBind)
 	t.Run("Serieses", testSeriesesBind)
 	t.Run("SeriesesAudits", testSeriesesAuditsBind)
+	t.Run("UserDeletions", testUserDeletionsBind)
+	t.Run("UserFollowings", testUserFollowingsBind)
 	t.Run("Users", testUsersBind)
+	t.Run("UsersAudits", testUsersAuditsBind)
+	t.Run("Watchlists", testWatchlistsBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsOne)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsOne)
+	t.Run("Artists", testArtistsOne)
+	t.Run("ArtistsAudits", testArtistsAuditsOne)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsOne)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsOne)
 	t.Run("Films", testFilmsOne)
 	t.Run("FilmsAudits", testFilmsAuditsOne)
+	t.Run("IdempotencyKeys", testIdempotencyKeysOne)
+	t.Run("PlaylistFilms", testPlaylistFilmsOne)
+	t.Run("Playlists", testPlaylistsOne)
+	t.Run("PostRevisions", testPostRevisionsOne)
+	t.Run("Posts", testPostsOne)
+	t.Run("Proposals", testProposalsOne)
+	t.Run("Seasons", testSeasonsOne)
+	t.Run("SeasonsAudits", testSeasonsAuditsOne)
 	t.Run("Serieses", testSeriesesOne)
 	t.Run("SeriesesAudits", testSeriesesAuditsOne)
+	t.Run("UserDeletions", testUserDeletionsOne)
+	t.Run("UserFollowings", testUserFollowingsOne)
 	t.Run("Users", testUsersOne)
+	t.Run("UsersAudits", testUsersAuditsOne)
+	t.Run("Watchlists", testWatchlistsOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsAll)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsAll)
+	t.Run("Artists", testArtistsAll)
+	t.Run("ArtistsAudits", testArtistsAuditsAll)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsAll)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsAll)
 	t.Run("Films", testFilmsAll)
 	t.Run("FilmsAudits", testFilmsAuditsAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysAll)
+	t.Run("PlaylistFilms", testPlaylistFilmsAll)
+	t.Run("Playlists", testPlaylistsAll)
+	t.Run("PostRevisions", testPostRevisionsAll)
+	t.Run("Posts", testPostsAll)
+	t.Run("Proposals", testProposalsAll)
+	t.Run("Seasons", testSeasonsAll)
+	t.Run("SeasonsAudits", testSeasonsAuditsAll)
 	t.Run("Serieses", testSeriesesAll)
 	t.Run("SeriesesAudits", testSeriesesAuditsAll)
+	t.Run("UserDeletions", testUserDeletionsAll)
+	t.Run("UserFollowings", testUserFollowingsAll)
 	t.Run("Users", testUsersAll)
+	t.Run("UsersAudits", testUsersAuditsAll)
+	t.Run("Watchlists", testWatchlistsAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsCount)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsCount)
+	t.Run("Artists", testArtistsCount)
+	t.Run("ArtistsAudits", testArtistsAuditsCount)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsCount)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsCount)
 	t.Run("Films", testFilmsCount)
 	t.Run("FilmsAudits", testFilmsAuditsCount)
+	t.Run("IdempotencyKeys", testIdempotencyKeysCount)
+	t.Run("PlaylistFilms", testPlaylistFilmsCount)
+	t.Run("Playlists", testPlaylistsCount)
+	t.Run("PostRevisions", testPostRevisionsCount)
+	t.Run("Posts", testPostsCount)
+	t.Run("Proposals", testProposalsCount)
+	t.Run("Seasons", testSeasonsCount)
+	t.Run("SeasonsAudits", testSeasonsAuditsCount)
 	t.Run("Serieses", testSeriesesCount)
 	t.Run("SeriesesAudits", testSeriesesAuditsCount)
+	t.Run("UserDeletions", testUserDeletionsCount)
+	t.Run("UserFollowings", testUserFollowingsCount)
 	t.Run("Users", testUsersCount)
+	t.Run("UsersAudits", testUsersAuditsCount)
+	t.Run("Watchlists", testWatchlistsCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsHooks)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsHooks)
+	t.Run("Artists", testArtistsHooks)
+	t.Run("ArtistsAudits", testArtistsAuditsHooks)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsHooks)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsHooks)
 	t.Run("Films", testFilmsHooks)
 	t.Run("FilmsAudits", testFilmsAuditsHooks)
+	t.Run("IdempotencyKeys", testIdempotencyKeysHooks)
+	t.Run("PlaylistFilms", testPlaylistFilmsHooks)
+	t.Run("Playlists", testPlaylistsHooks)
+	t.Run("PostRevisions", testPostRevisionsHooks)
+	t.Run("Posts", testPostsHooks)
+	t.Run("Proposals", testProposalsHooks)
+	t.Run("Seasons", testSeasonsHooks)
+	t.Run("SeasonsAudits", testSeasonsAuditsHooks)
 	t.Run("Serieses", testSeriesesHooks)
 	t.Run("SeriesesAudits", testSeriesesAuditsHooks)
+	t.Run("UserDeletions", testUserDeletionsHooks)
+	t.Run("UserFollowings", testUserFollowingsHooks)
 	t.Run("Users", testUsersHooks)
+	t.Run("UsersAudits", testUsersAuditsHooks)
+	t.Run("Watchlists", testWatchlistsHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsInsert)
+	t.Run("ArtistImageUrls", testArtistImageUrlsInsertWhitelist)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsInsert)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsInsertWhitelist)
+	t.Run("Artists", testArtistsInsert)
+	t.Run("Artists", testArtistsInsertWhitelist)
+	t.Run("ArtistsAudits", testArtistsAuditsInsert)
+	t.Run("ArtistsAudits", testArtistsAuditsInsertWhitelist)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsInsert)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsInsertWhitelist)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsInsert)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsInsertWhitelist)
 	t.Run("Films", testFilmsInsert)
 	t.Run("Films", testFilmsInsertWhitelist)
 	t.Run("FilmsAudits", testFilmsAuditsInsert)
+	t.Run("IdempotencyKeys", testIdempotencyKeysInsert)
 	t.Run("FilmsAudits", testFilmsAuditsInsertWhitelist)
+	t.Run("IdempotencyKeys", testIdempotencyKeysInsertWhitelist)
+	t.Run("PlaylistFilms", testPlaylistFilmsInsert)
+	t.Run("PlaylistFilms", testPlaylistFilmsInsertWhitelist)
+	t.Run("Playlists", testPlaylistsInsert)
+	t.Run("Playlists", testPlaylistsInsertWhitelist)
+	t.Run("PostRevisions", testPostRevisionsInsert)
+	t.Run("PostRevisions", testPostRevisionsInsertWhitelist)
+	t.Run("Posts", testPostsInsert)
+	t.Run("Posts", testPostsInsertWhitelist)
+	t.Run("Proposals", testProposalsInsert)
+	t.Run("Proposals", testProposalsInsertWhitelist)
+	t.Run("Seasons", testSeasonsInsert)
+	t.Run("Seasons", testSeasonsInsertWhitelist)
+	t.Run("SeasonsAudits", testSeasonsAuditsInsert)
+	t.Run("SeasonsAudits", testSeasonsAuditsInsertWhitelist)
 	t.Run("Serieses", testSeriesesInsert)
 	t.Run("Serieses", testSeriesesInsertWhitelist)
 	t.Run("SeriesesAudits", testSeriesesAuditsInsert)
 	t.Run("SeriesesAudits", testSeriesesAuditsInsertWhitelist)
+	t.Run("UserDeletions", testUserDeletionsInsert)
+	t.Run("UserDeletions", testUserDeletionsInsertWhitelist)
+	t.Run("UserFollowings", testUserFollowingsInsert)
+	t.Run("UserFollowings", testUserFollowingsInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
+	t.Run("UsersAudits", testUsersAuditsInsert)
+	t.Run("UsersAudits", testUsersAuditsInsertWhitelist)
+	t.Run("Watchlists", testWatchlistsInsert)
+	t.Run("Watchlists", testWatchlistsInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("ArtistImageURLToUserUsingContributingUser", testArtistImageURLToOneUserUsingContributingUser)
+	t.Run("ArtistImageURLToArtistUsingArtist", testArtistImageURLToOneArtistUsingArtist)
+	t.Run("ArtistToUserUsingContributingUser", testArtistToOneUserUsingContributingUser)
+	t.Run("FilmMediaURLToUserUsingContributingUser", testFilmMediaURLToOneUserUsingContributingUser)
+	t.Run("FilmMediaURLToFilmUsingFilm", testFilmMediaURLToOneFilmUsingFilm)
 	t.Run("FilmToUserUsingContributingUser", testFilmToOneUserUsingContributingUser)
 	t.Run("FilmToSeriesUsingSeries", testFilmToOneSeriesUsingSeries)
+	t.Run("PlaylistFilmToPlaylistUsingPlaylist", testPlaylistFilmToOnePlaylistUsingPlaylist)
+	t.Run("PlaylistFilmToFilmUsingFilm", testPlaylistFilmToOneFilmUsingFilm)
+	t.Run("PlaylistToUserUsingUser", testPlaylistToOneUserUsingUser)
+	t.Run("PostRevisionToPostUsingPost", testPostRevisionToOnePostUsingPost)
+	t.Run("PostToUserUsingUser", testPostToOneUserUsingUser)
+	t.Run("PostToPostUsingParentPost", testPostToOnePostUsingParentPost)
+	t.Run("ProposalToFilmUsingFilm", testProposalToOneFilmUsingFilm)
+	t.Run("ProposalToSeriesUsingSeries", testProposalToOneSeriesUsingSeries)
+	t.Run("ProposalToUserUsingProposedByUser", testProposalToOneUserUsingProposedByUser)
+	t.Run("ProposalToUserUsingReviewedByUser", testProposalToOneUserUsingReviewedByUser)
+	t.Run("SeasonToUserUsingContributingUser", testSeasonToOneUserUsingContributingUser)
+	t.Run("SeasonToSeriesUsingSeries", testSeasonToOneSeriesUsingSeries)
 	t.Run("SeriesToUserUsingContributingUser", testSeriesToOneUserUsingContributingUser)
+	t.Run("UserDeletionToUserUsingUser", testUserDeletionToOneUserUsingUser)
+	t.Run("UserFollowingToUserUsingFollower", testUserFollowingToOneUserUsingFollower)
+	t.Run("UserFollowingToUserUsingFollowed", testUserFollowingToOneUserUsingFollowed)
+	t.Run("WatchlistToUserUsingUser", testWatchlistToOneUserUsingUser)
+	t.Run("WatchlistToFilmUsingFilm", testWatchlistToOneFilmUsingFilm)
 }
 
 // TestOneToOne tests cannot be run in parallel
@@ -127,23 +383,77 @@ func TestOneToOne(t *testing.T) {}
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("ArtistToArtistImageUrls", testArtistToManyArtistImageUrls)
+	t.Run("FilmToFilmMediaUrls", testFilmToManyFilmMediaUrls)
+	t.Run("FilmToPlaylistFilms", testFilmToManyPlaylistFilms)
+	t.Run("FilmToPosts", testFilmToManyPosts)
+	t.Run("FilmToProposals", testFilmToManyProposals)
+	t.Run("FilmToWatchlists", testFilmToManyWatchlists)
+	t.Run("PlaylistToPlaylistFilms", testPlaylistToManyPlaylistFilms)
+	t.Run("PostToFilms", testPostToManyFilms)
+	t.Run("PostToPostRevisions", testPostToManyPostRevisions)
+	t.Run("PostToSerieses", testPostToManySerieses)
+	t.Run("PostToReferencedUsers", testPostToManyReferencedUsers)
+	t.Run("PostToReplies", testPostToManyReplies)
 	t.Run("SeriesToSeriesFilms", testSeriesToManySeriesFilms)
+	t.Run("SeriesToPosts", testSeriesToManyPosts)
+	t.Run("SeriesToSeriesProposals", testSeriesToManySeriesProposals)
+	t.Run("SeriesToSeriesSeasons", testSeriesToManySeriesSeasons)
+	t.Run("UserToContributedArtistImageUrls", testUserToManyContributedArtistImageUrls)
+	t.Run("UserToContributedArtists", testUserToManyContributedArtists)
+	t.Run("UserToContributedFilmMediaUrls", testUserToManyContributedFilmMediaUrls)
 	t.Run("UserToContributedFilms", testUserToManyContributedFilms)
+	t.Run("UserToPlaylists", testUserToManyPlaylists)
+	t.Run("UserToReferencingPosts", testUserToManyReferencingPosts)
+	t.Run("UserToPosts", testUserToManyPosts)
+	t.Run("UserToProposedByProposals", testUserToManyProposedByProposals)
+	t.Run("UserToReviewedByProposals", testUserToManyReviewedByProposals)
+	t.Run("UserToContributedSeasons", testUserToManyContributedSeasons)
 	t.Run("UserToContributedSerieses", testUserToManyContributedSerieses)
+	t.Run("UserToUserDeletions", testUserToManyUserDeletions)
+	t.Run("UserToFollowerUserFollowings", testUserToManyFollowerUserFollowings)
+	t.Run("UserToFollowedUserFollowings", testUserToManyFollowedUserFollowings)
+	t.Run("UserToWatchlists", testUserToManyWatchlists)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("ArtistImageURLToUserUsingContributedArtistImageUrls", testArtistImageURLToOneSetOpUserUsingContributingUser)
+	t.Run("ArtistImageURLToArtistUsingArtistImageUrls", testArtistImageURLToOneSetOpArtistUsingArtist)
+	t.Run("ArtistToUserUsingContributedArtists", testArtistToOneSetOpUserUsingContributingUser)
+	t.Run("FilmMediaURLToUserUsingContributedFilmMediaUrls", testFilmMediaURLToOneSetOpUserUsingContributingUser)
+	t.Run("FilmMediaURLToFilmUsingFilmMediaUrls", testFilmMediaURLToOneSetOpFilmUsingFilm)
 	t.Run("FilmToUserUsingContributedFilms", testFilmToOneSetOpUserUsingContributingUser)
 	t.Run("FilmToSeriesUsingSeriesFilms", testFilmToOneSetOpSeriesUsingSeries)
+	t.Run("PlaylistFilmToPlaylistUsingPlaylistFilms", testPlaylistFilmToOneSetOpPlaylistUsingPlaylist)
+	t.Run("PlaylistFilmToFilmUsingPlaylistFilms", testPlaylistFilmToOneSetOpFilmUsingFilm)
+	t.Run("PlaylistToUserUsingPlaylists", testPlaylistToOneSetOpUserUsingUser)
+	t.Run("PostRevisionToPostUsingPostRevisions", testPostRevisionToOneSetOpPostUsingPost)
+	t.Run("PostToUserUsingPosts", testPostToOneSetOpUserUsingUser)
+	t.Run("PostToPostUsingReplies", testPostToOneSetOpPostUsingParentPost)
+	t.Run("ProposalToFilmUsingProposals", testProposalToOneSetOpFilmUsingFilm)
+	t.Run("ProposalToSeriesUsingSeriesProposals", testProposalToOneSetOpSeriesUsingSeries)
+	t.Run("ProposalToUserUsingProposedByProposals", testProposalToOneSetOpUserUsingProposedByUser)
+	t.Run("ProposalToUserUsingReviewedByProposals", testProposalToOneSetOpUserUsingReviewedByUser)
+	t.Run("SeasonToUserUsingContributedSeasons", testSeasonToOneSetOpUserUsingContributingUser)
+	t.Run("SeasonToSeriesUsingSeriesSeasons", testSeasonToOneSetOpSeriesUsingSeries)
 	t.Run("SeriesToUserUsingContributedSerieses", testSeriesToOneSetOpUserUsingContributingUser)
+	t.Run("UserDeletionToUserUsingUserDeletions", testUserDeletionToOneSetOpUserUsingUser)
+	t.Run("UserFollowingToUserUsingFollowerUserFollowings", testUserFollowingToOneSetOpUserUsingFollower)
+	t.Run("UserFollowingToUserUsingFollowedUserFollowings", testUserFollowingToOneSetOpUserUsingFollowed)
+	t.Run("WatchlistToUserUsingWatchlists", testWatchlistToOneSetOpUserUsingUser)
+	t.Run("WatchlistToFilmUsingWatchlists", testWatchlistToOneSetOpFilmUsingFilm)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneRemove(t *testing.T) {
 	t.Run("FilmToSeriesUsingSeriesFilms", testFilmToOneRemoveOpSeriesUsingSeries)
+	t.Run("PostToPostUsingReplies", testPostToOneRemoveOpPostUsingParentPost)
+	t.Run("ProposalToFilmUsingProposals", testProposalToOneRemoveOpFilmUsingFilm)
+	t.Run("ProposalToSeriesUsingSeriesProposals", testProposalToOneRemoveOpSeriesUsingSeries)
+	t.Run("ProposalToUserUsingReviewedByProposals", testProposalToOneRemoveOpUserUsingReviewedByUser)
 }
 
 // TestOneToOneSet tests cannot be run in parallel
@@ -157,59 +467,197 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("ArtistToArtistImageUrls", testArtistToManyAddOpArtistImageUrls)
+	t.Run("FilmToFilmMediaUrls", testFilmToManyAddOpFilmMediaUrls)
+	t.Run("FilmToPlaylistFilms", testFilmToManyAddOpPlaylistFilms)
+	t.Run("FilmToPosts", testFilmToManyAddOpPosts)
+	t.Run("FilmToProposals", testFilmToManyAddOpProposals)
+	t.Run("FilmToWatchlists", testFilmToManyAddOpWatchlists)
+	t.Run("PlaylistToPlaylistFilms", testPlaylistToManyAddOpPlaylistFilms)
+	t.Run("PostToFilms", testPostToManyAddOpFilms)
+	t.Run("PostToPostRevisions", testPostToManyAddOpPostRevisions)
+	t.Run("PostToSerieses", testPostToManyAddOpSerieses)
+	t.Run("PostToReferencedUsers", testPostToManyAddOpReferencedUsers)
+	t.Run("PostToReplies", testPostToManyAddOpReplies)
 	t.Run("SeriesToSeriesFilms", testSeriesToManyAddOpSeriesFilms)
+	t.Run("SeriesToPosts", testSeriesToManyAddOpPosts)
+	t.Run("SeriesToSeriesProposals", testSeriesToManyAddOpSeriesProposals)
+	t.Run("SeriesToSeriesSeasons", testSeriesToManyAddOpSeriesSeasons)
+	t.Run("UserToContributedArtistImageUrls", testUserToManyAddOpContributedArtistImageUrls)
+	t.Run("UserToContributedArtists", testUserToManyAddOpContributedArtists)
+	t.Run("UserToContributedFilmMediaUrls", testUserToManyAddOpContributedFilmMediaUrls)
 	t.Run("UserToContributedFilms", testUserToManyAddOpContributedFilms)
+	t.Run("UserToPlaylists", testUserToManyAddOpPlaylists)
+	t.Run("UserToReferencingPosts", testUserToManyAddOpReferencingPosts)
+	t.Run("UserToPosts", testUserToManyAddOpPosts)
+	t.Run("UserToProposedByProposals", testUserToManyAddOpProposedByProposals)
+	t.Run("UserToReviewedByProposals", testUserToManyAddOpReviewedByProposals)
+	t.Run("UserToContributedSeasons", testUserToManyAddOpContributedSeasons)
 	t.Run("UserToContributedSerieses", testUserToManyAddOpContributedSerieses)
+	t.Run("UserToUserDeletions", testUserToManyAddOpUserDeletions)
+	t.Run("UserToFollowerUserFollowings", testUserToManyAddOpFollowerUserFollowings)
+	t.Run("UserToFollowedUserFollowings", testUserToManyAddOpFollowedUserFollowings)
+	t.Run("UserToWatchlists", testUserToManyAddOpWatchlists)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManySet(t *testing.T) {
+	t.Run("FilmToPosts", testFilmToManySetOpPosts)
+	t.Run("FilmToProposals", testFilmToManySetOpProposals)
+	t.Run("PostToFilms", testPostToManySetOpFilms)
+	t.Run("PostToSerieses", testPostToManySetOpSerieses)
+	t.Run("PostToReferencedUsers", testPostToManySetOpReferencedUsers)
+	t.Run("PostToReplies", testPostToManySetOpReplies)
 	t.Run("SeriesToSeriesFilms", testSeriesToManySetOpSeriesFilms)
+	t.Run("SeriesToPosts", testSeriesToManySetOpPosts)
+	t.Run("SeriesToSeriesProposals", testSeriesToManySetOpSeriesProposals)
+	t.Run("UserToReferencingPosts", testUserToManySetOpReferencingPosts)
+	t.Run("UserToReviewedByProposals", testUserToManySetOpReviewedByProposals)
 }
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyRemove(t *testing.T) {
+	t.Run("FilmToPosts", testFilmToManyRemoveOpPosts)
+	t.Run("FilmToProposals", testFilmToManyRemoveOpProposals)
+	t.Run("PostToFilms", testPostToManyRemoveOpFilms)
+	t.Run("PostToSerieses", testPostToManyRemoveOpSerieses)
+	t.Run("PostToReferencedUsers", testPostToManyRemoveOpReferencedUsers)
+	t.Run("PostToReplies", testPostToManyRemoveOpReplies)
 	t.Run("SeriesToSeriesFilms", testSeriesToManyRemoveOpSeriesFilms)
+	t.Run("SeriesToPosts", testSeriesToManyRemoveOpPosts)
+	t.Run("SeriesToSeriesProposals", testSeriesToManyRemoveOpSeriesProposals)
+	t.Run("UserToReferencingPosts", testUserToManyRemoveOpReferencingPosts)
+	t.Run("UserToReviewedByProposals", testUserToManyRemoveOpReviewedByProposals)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsReload)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsReload)
+	t.Run("Artists", testArtistsReload)
+	t.Run("ArtistsAudits", testArtistsAuditsReload)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsReload)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsReload)
 	t.Run("Films", testFilmsReload)
 	t.Run("FilmsAudits", testFilmsAuditsReload)
+	t.Run("IdempotencyKeys", testIdempotencyKeysReload)
+	t.Run("PlaylistFilms", testPlaylistFilmsReload)
+	t.Run("Playlists", testPlaylistsReload)
+	t.Run("PostRevisions", testPostRevisionsReload)
+	t.Run("Posts", testPostsReload)
+	t.Run("Proposals", testProposalsReload)
+	t.Run("Seasons", testSeasonsReload)
+	t.Run("SeasonsAudits", testSeasonsAuditsReload)
 	t.Run("Serieses", testSeriesesReload)
 	t.Run("SeriesesAudits", testSeriesesAuditsReload)
+	t.Run("UserDeletions", testUserDeletionsReload)
+	t.Run("UserFollowings", testUserFollowingsReload)
 	t.Run("Users", testUsersReload)
+	t.Run("UsersAudits", testUsersAuditsReload)
+	t.Run("Watchlists", testWatchlistsReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsReloadAll)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsReloadAll)
+	t.Run("Artists", testArtistsReloadAll)
+	t.Run("ArtistsAudits", testArtistsAuditsReloadAll)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsReloadAll)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsReloadAll)
 	t.Run("Films", testFilmsReloadAll)
 	t.Run("FilmsAudits", testFilmsAuditsReloadAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysReloadAll)
+	t.Run("PlaylistFilms", testPlaylistFilmsReloadAll)
+	t.Run("Playlists", testPlaylistsReloadAll)
+	t.Run("PostRevisions", testPostRevisionsReloadAll)
+	t.Run("Posts", testPostsReloadAll)
+	t.Run("Proposals", testProposalsReloadAll)
+	t.Run("Seasons", testSeasonsReloadAll)
+	t.Run("SeasonsAudits", testSeasonsAuditsReloadAll)
 	t.Run("Serieses", testSeriesesReloadAll)
 	t.Run("SeriesesAudits", testSeriesesAuditsReloadAll)
+	t.Run("UserDeletions", testUserDeletionsReloadAll)
+	t.Run("UserFollowings", testUserFollowingsReloadAll)
 	t.Run("Users", testUsersReloadAll)
+	t.Run("UsersAudits", testUsersAuditsReloadAll)
+	t.Run("Watchlists", testWatchlistsReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsSelect)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsSelect)
+	t.Run("Artists", testArtistsSelect)
+	t.Run("ArtistsAudits", testArtistsAuditsSelect)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsSelect)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsSelect)
 	t.Run("Films", testFilmsSelect)
 	t.Run("FilmsAudits", testFilmsAuditsSelect)
+	t.Run("IdempotencyKeys", testIdempotencyKeysSelect)
+	t.Run("PlaylistFilms", testPlaylistFilmsSelect)
+	t.Run("Playlists", testPlaylistsSelect)
+	t.Run("PostRevisions", testPostRevisionsSelect)
+	t.Run("Posts", testPostsSelect)
+	t.Run("Proposals", testProposalsSelect)
+	t.Run("Seasons", testSeasonsSelect)
+	t.Run("SeasonsAudits", testSeasonsAuditsSelect)
 	t.Run("Serieses", testSeriesesSelect)
 	t.Run("SeriesesAudits", testSeriesesAuditsSelect)
+	t.Run("UserDeletions", testUserDeletionsSelect)
+	t.Run("UserFollowings", testUserFollowingsSelect)
 	t.Run("Users", testUsersSelect)
+	t.Run("UsersAudits", testUsersAuditsSelect)
+	t.Run("Watchlists", testWatchlistsSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsUpdate)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsUpdate)
+	t.Run("Artists", testArtistsUpdate)
+	t.Run("ArtistsAudits", testArtistsAuditsUpdate)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsUpdate)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsUpdate)
 	t.Run("Films", testFilmsUpdate)
 	t.Run("FilmsAudits", testFilmsAuditsUpdate)
+	t.Run("IdempotencyKeys", testIdempotencyKeysUpdate)
+	t.Run("PlaylistFilms", testPlaylistFilmsUpdate)
+	t.Run("Playlists", testPlaylistsUpdate)
+	t.Run("PostRevisions", testPostRevisionsUpdate)
+	t.Run("Posts", testPostsUpdate)
+	t.Run("Proposals", testProposalsUpdate)
+	t.Run("Seasons", testSeasonsUpdate)
+	t.Run("SeasonsAudits", testSeasonsAuditsUpdate)
 	t.Run("Serieses", testSeriesesUpdate)
 	t.Run("SeriesesAudits", testSeriesesAuditsUpdate)
+	t.Run("UserDeletions", testUserDeletionsUpdate)
+	t.Run("UserFollowings", testUserFollowingsUpdate)
 	t.Run("Users", testUsersUpdate)
+	t.Run("UsersAudits", testUsersAuditsUpdate)
+	t.Run("Watchlists", testWatchlistsUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsSliceUpdateAll)
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsSliceUpdateAll)
+	t.Run("Artists", testArtistsSliceUpdateAll)
+	t.Run("ArtistsAudits", testArtistsAuditsSliceUpdateAll)
+	t.Run("FilmMediaUrls", testFilmMediaUrlsSliceUpdateAll)
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsSliceUpdateAll)
 	t.Run("Films", testFilmsSliceUpdateAll)
 	t.Run("FilmsAudits", testFilmsAuditsSliceUpdateAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysSliceUpdateAll)
+	t.Run("PlaylistFilms", testPlaylistFilmsSliceUpdateAll)
+	t.Run("Playlists", testPlaylistsSliceUpdateAll)
+	t.Run("PostRevisions", testPostRevisionsSliceUpdateAll)
+	t.Run("Posts", testPostsSliceUpdateAll)
+	t.Run("Proposals", testProposalsSliceUpdateAll)
+	t.Run("Seasons", testSeasonsSliceUpdateAll)
+	t.Run("SeasonsAudits", testSeasonsAuditsSliceUpdateAll)
 	t.Run("Serieses", testSeriesesSliceUpdateAll)
 	t.Run("SeriesesAudits", testSeriesesAuditsSliceUpdateAll)
+	t.Run("UserDeletions", testUserDeletionsSliceUpdateAll)
+	t.Run("UserFollowings", testUserFollowingsSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
+	t.Run("UsersAudits", testUsersAuditsSliceUpdateAll)
+	t.Run("Watchlists", testWatchlistsSliceUpdateAll)
 }
@@ -4,15 +4,59 @@
 package models
 
 var TableNames = struct {
-	Films         string
-	FilmsAudit    string
-	Serieses      string
-	SeriesesAudit string
-	Users         string
+	ArtistImageUrls      string
+	ArtistImageUrlsAudit string
+	Artists              string
+	ArtistsAudit         string
+	FilmMediaUrls        string
+	FilmMediaUrlsAudit   string
+	Films                string
+	FilmsAudit           string
+	IdempotencyKeys      string
+	PlaylistFilms        string
+	Playlists            string
+	PostArtists          string
+	PostFilms            string
+	PostRevisions        string
+	PostSerieses         string
+	PostUsers            string
+	Posts                string
+	Proposals            string
+	Seasons              string
+	SeasonsAudit         string
+	Serieses             string
+	SeriesesAudit        string
+	UserDeletions        string
+	UserFollowings       string
+	Users                string
+	UsersAudit           string
+	Watchlists           string
 }{
-	Films:         "films",
-	FilmsAudit:    "films_audit",
-	Serieses:      "serieses",
-	SeriesesAudit: "serieses_audit",
-	Users:         "users",
+	ArtistImageUrls:      "artist_image_urls",
+	ArtistImageUrlsAudit: "artist_image_urls_audit",
+	Artists:              "artists",
+	ArtistsAudit:         "artists_audit",
+	FilmMediaUrls:        "film_media_urls",
+	FilmMediaUrlsAudit:   "film_media_urls_audit",
+	Films:                "films",
+	FilmsAudit:           "films_audit",
+	IdempotencyKeys:      "idempotency_keys",
+	PlaylistFilms:        "playlist_films",
+	Playlists:            "playlists",
+	PostArtists:          "post_artists",
+	PostFilms:            "post_films",
+	PostRevisions:        "post_revisions",
+	PostSerieses:         "post_serieses",
+	PostUsers:            "post_users",
+	Posts:                "posts",
+	Proposals:            "proposals",
+	Seasons:              "seasons",
+	SeasonsAudit:         "seasons_audit",
+	Serieses:             "serieses",
+	SeriesesAudit:        "serieses_audit",
+	UserDeletions:        "user_deletions",
+	UserFollowings:       "user_followings",
+	Users:                "users",
+	UsersAudit:           "users_audit",
+	Watchlists:           "watchlists",
 }
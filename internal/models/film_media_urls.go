@@ -0,0 +1,1320 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// FilmMediaURL is an object representing the database table.
+type FilmMediaURL struct {
+	ID            int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	FilmID        int         `boil:"film_id" json:"film_id" toml:"film_id" yaml:"film_id"`
+	MediaURL      string      `boil:"media_url" json:"media_url" toml:"media_url" yaml:"media_url"`
+	MediaType     string      `boil:"media_type" json:"media_type" toml:"media_type" yaml:"media_type"`
+	MimeType      string      `boil:"mime_type" json:"mime_type" toml:"mime_type" yaml:"mime_type"`
+	SizeInBytes   int64       `boil:"size_in_bytes" json:"size_in_bytes" toml:"size_in_bytes" yaml:"size_in_bytes"`
+	ThumbnailURL  null.String `boil:"thumbnail_url" json:"thumbnail_url,omitempty" toml:"thumbnail_url" yaml:"thumbnail_url,omitempty"`
+	ContributedBy int         `boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt time.Time   `boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation  null.String `boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+
+	R *filmMediaURLR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L filmMediaURLL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FilmMediaURLColumns = struct {
+	ID            string
+	FilmID        string
+	MediaURL      string
+	MediaType     string
+	MimeType      string
+	SizeInBytes   string
+	ThumbnailURL  string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	ID:            "id",
+	FilmID:        "film_id",
+	MediaURL:      "media_url",
+	MediaType:     "media_type",
+	MimeType:      "mime_type",
+	SizeInBytes:   "size_in_bytes",
+	ThumbnailURL:  "thumbnail_url",
+	ContributedBy: "contributed_by",
+	ContributedAt: "contributed_at",
+	Invalidation:  "invalidation",
+}
+
+var FilmMediaURLTableColumns = struct {
+	ID            string
+	FilmID        string
+	MediaURL      string
+	MediaType     string
+	MimeType      string
+	SizeInBytes   string
+	ThumbnailURL  string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	ID:            "film_media_urls.id",
+	FilmID:        "film_media_urls.film_id",
+	MediaURL:      "film_media_urls.media_url",
+	MediaType:     "film_media_urls.media_type",
+	MimeType:      "film_media_urls.mime_type",
+	SizeInBytes:   "film_media_urls.size_in_bytes",
+	ThumbnailURL:  "film_media_urls.thumbnail_url",
+	ContributedBy: "film_media_urls.contributed_by",
+	ContributedAt: "film_media_urls.contributed_at",
+	Invalidation:  "film_media_urls.invalidation",
+}
+
+// Generated where
+
+var FilmMediaURLWhere = struct {
+	ID            whereHelperint
+	FilmID        whereHelperint
+	MediaURL      whereHelperstring
+	MediaType     whereHelperstring
+	MimeType      whereHelperstring
+	SizeInBytes   whereHelperint64
+	ThumbnailURL  whereHelpernull_String
+	ContributedBy whereHelperint
+	ContributedAt whereHelpertime_Time
+	Invalidation  whereHelpernull_String
+}{
+	ID:            whereHelperint{field: "\"film_media_urls\".\"id\""},
+	FilmID:        whereHelperint{field: "\"film_media_urls\".\"film_id\""},
+	MediaURL:      whereHelperstring{field: "\"film_media_urls\".\"media_url\""},
+	MediaType:     whereHelperstring{field: "\"film_media_urls\".\"media_type\""},
+	MimeType:      whereHelperstring{field: "\"film_media_urls\".\"mime_type\""},
+	SizeInBytes:   whereHelperint64{field: "\"film_media_urls\".\"size_in_bytes\""},
+	ThumbnailURL:  whereHelpernull_String{field: "\"film_media_urls\".\"thumbnail_url\""},
+	ContributedBy: whereHelperint{field: "\"film_media_urls\".\"contributed_by\""},
+	ContributedAt: whereHelpertime_Time{field: "\"film_media_urls\".\"contributed_at\""},
+	Invalidation:  whereHelpernull_String{field: "\"film_media_urls\".\"invalidation\""},
+}
+
+// FilmMediaURLRels is where relationship names are stored.
+var FilmMediaURLRels = struct {
+	ContributingUser string
+	Film             string
+}{
+	ContributingUser: "ContributingUser",
+	Film:             "Film",
+}
+
+// filmMediaURLR is where relationships are stored.
+type filmMediaURLR struct {
+	ContributingUser *User `boil:"ContributingUser" json:"ContributingUser" toml:"ContributingUser" yaml:"ContributingUser"`
+	Film             *Film `boil:"Film" json:"Film" toml:"Film" yaml:"Film"`
+}
+
+// NewStruct creates a new relationship struct
+func (*filmMediaURLR) NewStruct() *filmMediaURLR {
+	return &filmMediaURLR{}
+}
+
+func (r *filmMediaURLR) GetContributingUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.ContributingUser
+}
+
+func (r *filmMediaURLR) GetFilm() *Film {
+	if r == nil {
+		return nil
+	}
+	return r.Film
+}
+
+// filmMediaURLL is where Load methods for each relationship are stored.
+type filmMediaURLL struct{}
+
+var (
+	filmMediaURLAllColumns            = []string{"id", "film_id", "media_url", "media_type", "mime_type", "size_in_bytes", "thumbnail_url", "contributed_by", "contributed_at", "invalidation"}
+	filmMediaURLColumnsWithoutDefault = []string{"film_id", "media_url", "media_type", "mime_type", "size_in_bytes", "contributed_by"}
+	filmMediaURLColumnsWithDefault    = []string{"id", "thumbnail_url", "contributed_at", "invalidation"}
+	filmMediaURLPrimaryKeyColumns     = []string{"id"}
+	filmMediaURLGeneratedColumns      = []string{}
+)
+
+type (
+	// FilmMediaURLSlice is an alias for a slice of pointers to FilmMediaURL.
+	// This should almost always be used instead of []FilmMediaURL.
+	FilmMediaURLSlice []*FilmMediaURL
+	// FilmMediaURLHook is the signature for custom FilmMediaURL hook methods
+	FilmMediaURLHook func(context.Context, boil.ContextExecutor, *FilmMediaURL) error
+
+	filmMediaURLQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	filmMediaURLType                 = reflect.TypeOf(&FilmMediaURL{})
+	filmMediaURLMapping              = queries.MakeStructMapping(filmMediaURLType)
+	filmMediaURLPrimaryKeyMapping, _ = queries.BindMapping(filmMediaURLType, filmMediaURLMapping, filmMediaURLPrimaryKeyColumns)
+	filmMediaURLInsertCacheMut       sync.RWMutex
+	filmMediaURLInsertCache          = make(map[string]insertCache)
+	filmMediaURLUpdateCacheMut       sync.RWMutex
+	filmMediaURLUpdateCache          = make(map[string]updateCache)
+	filmMediaURLUpsertCacheMut       sync.RWMutex
+	filmMediaURLUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var filmMediaURLAfterSelectHooks []FilmMediaURLHook
+
+var filmMediaURLBeforeInsertHooks []FilmMediaURLHook
+var filmMediaURLAfterInsertHooks []FilmMediaURLHook
+
+var filmMediaURLBeforeUpdateHooks []FilmMediaURLHook
+var filmMediaURLAfterUpdateHooks []FilmMediaURLHook
+
+var filmMediaURLBeforeDeleteHooks []FilmMediaURLHook
+var filmMediaURLAfterDeleteHooks []FilmMediaURLHook
+
+var filmMediaURLBeforeUpsertHooks []FilmMediaURLHook
+var filmMediaURLAfterUpsertHooks []FilmMediaURLHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FilmMediaURL) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaURLAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FilmMediaURL) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaURLBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FilmMediaURL) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaURLAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FilmMediaURL) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaURLBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FilmMediaURL) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaURLAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FilmMediaURL) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaURLBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FilmMediaURL) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaURLAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FilmMediaURL) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaURLBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FilmMediaURL) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaURLAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFilmMediaURLHook registers your hook function for all future operations.
+func AddFilmMediaURLHook(hookPoint boil.HookPoint, filmMediaURLHook FilmMediaURLHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		filmMediaURLAfterSelectHooks = append(filmMediaURLAfterSelectHooks, filmMediaURLHook)
+	case boil.BeforeInsertHook:
+		filmMediaURLBeforeInsertHooks = append(filmMediaURLBeforeInsertHooks, filmMediaURLHook)
+	case boil.AfterInsertHook:
+		filmMediaURLAfterInsertHooks = append(filmMediaURLAfterInsertHooks, filmMediaURLHook)
+	case boil.BeforeUpdateHook:
+		filmMediaURLBeforeUpdateHooks = append(filmMediaURLBeforeUpdateHooks, filmMediaURLHook)
+	case boil.AfterUpdateHook:
+		filmMediaURLAfterUpdateHooks = append(filmMediaURLAfterUpdateHooks, filmMediaURLHook)
+	case boil.BeforeDeleteHook:
+		filmMediaURLBeforeDeleteHooks = append(filmMediaURLBeforeDeleteHooks, filmMediaURLHook)
+	case boil.AfterDeleteHook:
+		filmMediaURLAfterDeleteHooks = append(filmMediaURLAfterDeleteHooks, filmMediaURLHook)
+	case boil.BeforeUpsertHook:
+		filmMediaURLBeforeUpsertHooks = append(filmMediaURLBeforeUpsertHooks, filmMediaURLHook)
+	case boil.AfterUpsertHook:
+		filmMediaURLAfterUpsertHooks = append(filmMediaURLAfterUpsertHooks, filmMediaURLHook)
+	}
+}
+
+// One returns a single filmMediaURL record from the query.
+func (q filmMediaURLQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FilmMediaURL, error) {
+	o := &FilmMediaURL{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for film_media_urls")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all FilmMediaURL records from the query.
+func (q filmMediaURLQuery) All(ctx context.Context, exec boil.ContextExecutor) (FilmMediaURLSlice, error) {
+	var o []*FilmMediaURL
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to FilmMediaURL slice")
+	}
+
+	if len(filmMediaURLAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all FilmMediaURL records in the query.
+func (q filmMediaURLQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count film_media_urls rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q filmMediaURLQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if film_media_urls exists")
+	}
+
+	return count > 0, nil
+}
+
+// ContributingUser pointed to by the foreign key.
+func (o *FilmMediaURL) ContributingUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ContributedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Film pointed to by the foreign key.
+func (o *FilmMediaURL) Film(mods ...qm.QueryMod) filmQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FilmID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Films(queryMods...)
+}
+
+// LoadContributingUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (filmMediaURLL) LoadContributingUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilmMediaURL interface{}, mods queries.Applicator) error {
+	var slice []*FilmMediaURL
+	var object *FilmMediaURL
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilmMediaURL.(*FilmMediaURL)
+		if !ok {
+			object = new(FilmMediaURL)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilmMediaURL)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilmMediaURL))
+			}
+		}
+	} else {
+		s, ok := maybeFilmMediaURL.(*[]*FilmMediaURL)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilmMediaURL)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilmMediaURL))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmMediaURLR{}
+		}
+		args = append(args, object.ContributedBy)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmMediaURLR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ContributedBy {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ContributedBy)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(filmMediaURLAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ContributingUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ContributedFilmMediaUrls = append(foreign.R.ContributedFilmMediaUrls, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ContributedBy == foreign.ID {
+				local.R.ContributingUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ContributedFilmMediaUrls = append(foreign.R.ContributedFilmMediaUrls, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFilm allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (filmMediaURLL) LoadFilm(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilmMediaURL interface{}, mods queries.Applicator) error {
+	var slice []*FilmMediaURL
+	var object *FilmMediaURL
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilmMediaURL.(*FilmMediaURL)
+		if !ok {
+			object = new(FilmMediaURL)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilmMediaURL)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilmMediaURL))
+			}
+		}
+	} else {
+		s, ok := maybeFilmMediaURL.(*[]*FilmMediaURL)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilmMediaURL)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilmMediaURL))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmMediaURLR{}
+		}
+		args = append(args, object.FilmID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmMediaURLR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FilmID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FilmID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`films`),
+		qm.WhereIn(`films.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Film")
+	}
+
+	var resultSlice []*Film
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Film")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+	}
+
+	if len(filmMediaURLAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Film = foreign
+		if foreign.R == nil {
+			foreign.R = &filmR{}
+		}
+		foreign.R.FilmMediaUrls = append(foreign.R.FilmMediaUrls, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FilmID == foreign.ID {
+				local.R.Film = foreign
+				if foreign.R == nil {
+					foreign.R = &filmR{}
+				}
+				foreign.R.FilmMediaUrls = append(foreign.R.FilmMediaUrls, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetContributingUser of the filmMediaURL to the related item.
+// Sets o.R.ContributingUser to related.
+// Adds o to related.R.ContributedFilmMediaUrls.
+func (o *FilmMediaURL) SetContributingUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"film_media_urls\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+		strmangle.WhereClause("\"", "\"", 2, filmMediaURLPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ContributedBy = related.ID
+	if o.R == nil {
+		o.R = &filmMediaURLR{
+			ContributingUser: related,
+		}
+	} else {
+		o.R.ContributingUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ContributedFilmMediaUrls: FilmMediaURLSlice{o},
+		}
+	} else {
+		related.R.ContributedFilmMediaUrls = append(related.R.ContributedFilmMediaUrls, o)
+	}
+
+	return nil
+}
+
+// SetFilm of the filmMediaURL to the related item.
+// Sets o.R.Film to related.
+// Adds o to related.R.FilmMediaUrls.
+func (o *FilmMediaURL) SetFilm(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Film) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"film_media_urls\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+		strmangle.WhereClause("\"", "\"", 2, filmMediaURLPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FilmID = related.ID
+	if o.R == nil {
+		o.R = &filmMediaURLR{
+			Film: related,
+		}
+	} else {
+		o.R.Film = related
+	}
+
+	if related.R == nil {
+		related.R = &filmR{
+			FilmMediaUrls: FilmMediaURLSlice{o},
+		}
+	} else {
+		related.R.FilmMediaUrls = append(related.R.FilmMediaUrls, o)
+	}
+
+	return nil
+}
+
+// FilmMediaUrls retrieves all the records using an executor.
+func FilmMediaUrls(mods ...qm.QueryMod) filmMediaURLQuery {
+	mods = append(mods, qm.From("\"film_media_urls\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"film_media_urls\".*"})
+	}
+
+	return filmMediaURLQuery{q}
+}
+
+// FindFilmMediaURL retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFilmMediaURL(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*FilmMediaURL, error) {
+	filmMediaURLObj := &FilmMediaURL{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"film_media_urls\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, filmMediaURLObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from film_media_urls")
+	}
+
+	if err = filmMediaURLObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return filmMediaURLObj, err
+	}
+
+	return filmMediaURLObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FilmMediaURL) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no film_media_urls provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(filmMediaURLColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	filmMediaURLInsertCacheMut.RLock()
+	cache, cached := filmMediaURLInsertCache[key]
+	filmMediaURLInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			filmMediaURLAllColumns,
+			filmMediaURLColumnsWithDefault,
+			filmMediaURLColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(filmMediaURLType, filmMediaURLMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(filmMediaURLType, filmMediaURLMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"film_media_urls\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"film_media_urls\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into film_media_urls")
+	}
+
+	if !cached {
+		filmMediaURLInsertCacheMut.Lock()
+		filmMediaURLInsertCache[key] = cache
+		filmMediaURLInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the FilmMediaURL.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FilmMediaURL) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	filmMediaURLUpdateCacheMut.RLock()
+	cache, cached := filmMediaURLUpdateCache[key]
+	filmMediaURLUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			filmMediaURLAllColumns,
+			filmMediaURLPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update film_media_urls, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"film_media_urls\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, filmMediaURLPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(filmMediaURLType, filmMediaURLMapping, append(wl, filmMediaURLPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update film_media_urls row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for film_media_urls")
+	}
+
+	if !cached {
+		filmMediaURLUpdateCacheMut.Lock()
+		filmMediaURLUpdateCache[key] = cache
+		filmMediaURLUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q filmMediaURLQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for film_media_urls")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for film_media_urls")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FilmMediaURLSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), filmMediaURLPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"film_media_urls\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, filmMediaURLPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in filmMediaURL slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all filmMediaURL")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *FilmMediaURL) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no film_media_urls provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(filmMediaURLColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	filmMediaURLUpsertCacheMut.RLock()
+	cache, cached := filmMediaURLUpsertCache[key]
+	filmMediaURLUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			filmMediaURLAllColumns,
+			filmMediaURLColumnsWithDefault,
+			filmMediaURLColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			filmMediaURLAllColumns,
+			filmMediaURLPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert film_media_urls, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(filmMediaURLPrimaryKeyColumns))
+			copy(conflict, filmMediaURLPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"film_media_urls\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(filmMediaURLType, filmMediaURLMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(filmMediaURLType, filmMediaURLMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert film_media_urls")
+	}
+
+	if !cached {
+		filmMediaURLUpsertCacheMut.Lock()
+		filmMediaURLUpsertCache[key] = cache
+		filmMediaURLUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single FilmMediaURL record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FilmMediaURL) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no FilmMediaURL provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), filmMediaURLPrimaryKeyMapping)
+	sql := "DELETE FROM \"film_media_urls\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from film_media_urls")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for film_media_urls")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q filmMediaURLQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no filmMediaURLQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from film_media_urls")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for film_media_urls")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FilmMediaURLSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(filmMediaURLBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), filmMediaURLPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"film_media_urls\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, filmMediaURLPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from filmMediaURL slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for film_media_urls")
+	}
+
+	if len(filmMediaURLAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FilmMediaURL) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFilmMediaURL(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FilmMediaURLSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FilmMediaURLSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), filmMediaURLPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"film_media_urls\".* FROM \"film_media_urls\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, filmMediaURLPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in FilmMediaURLSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FilmMediaURLExists checks if the FilmMediaURL row exists.
+func FilmMediaURLExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"film_media_urls\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if film_media_urls exists")
+	}
+
+	return exists, nil
+}
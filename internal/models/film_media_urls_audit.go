@@ -0,0 +1,943 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// FilmMediaUrlsAudit is an object representing the database table.
+type FilmMediaUrlsAudit struct {
+	ID            int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	FilmID        int         `boil:"film_id" json:"film_id" toml:"film_id" yaml:"film_id"`
+	MediaURL      string      `boil:"media_url" json:"media_url" toml:"media_url" yaml:"media_url"`
+	MediaType     string      `boil:"media_type" json:"media_type" toml:"media_type" yaml:"media_type"`
+	MimeType      string      `boil:"mime_type" json:"mime_type" toml:"mime_type" yaml:"mime_type"`
+	SizeInBytes   int64       `boil:"size_in_bytes" json:"size_in_bytes" toml:"size_in_bytes" yaml:"size_in_bytes"`
+	ThumbnailURL  null.String `boil:"thumbnail_url" json:"thumbnail_url,omitempty" toml:"thumbnail_url" yaml:"thumbnail_url,omitempty"`
+	ContributedBy int         `boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt time.Time   `boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation  null.String `boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+
+	R *filmMediaUrlsAuditR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L filmMediaUrlsAuditL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FilmMediaUrlsAuditColumns = struct {
+	ID            string
+	FilmID        string
+	MediaURL      string
+	MediaType     string
+	MimeType      string
+	SizeInBytes   string
+	ThumbnailURL  string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	ID:            "id",
+	FilmID:        "film_id",
+	MediaURL:      "media_url",
+	MediaType:     "media_type",
+	MimeType:      "mime_type",
+	SizeInBytes:   "size_in_bytes",
+	ThumbnailURL:  "thumbnail_url",
+	ContributedBy: "contributed_by",
+	ContributedAt: "contributed_at",
+	Invalidation:  "invalidation",
+}
+
+var FilmMediaUrlsAuditTableColumns = struct {
+	ID            string
+	FilmID        string
+	MediaURL      string
+	MediaType     string
+	MimeType      string
+	SizeInBytes   string
+	ThumbnailURL  string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	ID:            "film_media_urls_audit.id",
+	FilmID:        "film_media_urls_audit.film_id",
+	MediaURL:      "film_media_urls_audit.media_url",
+	MediaType:     "film_media_urls_audit.media_type",
+	MimeType:      "film_media_urls_audit.mime_type",
+	SizeInBytes:   "film_media_urls_audit.size_in_bytes",
+	ThumbnailURL:  "film_media_urls_audit.thumbnail_url",
+	ContributedBy: "film_media_urls_audit.contributed_by",
+	ContributedAt: "film_media_urls_audit.contributed_at",
+	Invalidation:  "film_media_urls_audit.invalidation",
+}
+
+// Generated where
+
+var FilmMediaUrlsAuditWhere = struct {
+	ID            whereHelperint
+	FilmID        whereHelperint
+	MediaURL      whereHelperstring
+	MediaType     whereHelperstring
+	MimeType      whereHelperstring
+	SizeInBytes   whereHelperint64
+	ThumbnailURL  whereHelpernull_String
+	ContributedBy whereHelperint
+	ContributedAt whereHelpertime_Time
+	Invalidation  whereHelpernull_String
+}{
+	ID:            whereHelperint{field: "\"film_media_urls_audit\".\"id\""},
+	FilmID:        whereHelperint{field: "\"film_media_urls_audit\".\"film_id\""},
+	MediaURL:      whereHelperstring{field: "\"film_media_urls_audit\".\"media_url\""},
+	MediaType:     whereHelperstring{field: "\"film_media_urls_audit\".\"media_type\""},
+	MimeType:      whereHelperstring{field: "\"film_media_urls_audit\".\"mime_type\""},
+	SizeInBytes:   whereHelperint64{field: "\"film_media_urls_audit\".\"size_in_bytes\""},
+	ThumbnailURL:  whereHelpernull_String{field: "\"film_media_urls_audit\".\"thumbnail_url\""},
+	ContributedBy: whereHelperint{field: "\"film_media_urls_audit\".\"contributed_by\""},
+	ContributedAt: whereHelpertime_Time{field: "\"film_media_urls_audit\".\"contributed_at\""},
+	Invalidation:  whereHelpernull_String{field: "\"film_media_urls_audit\".\"invalidation\""},
+}
+
+// FilmMediaUrlsAuditRels is where relationship names are stored.
+var FilmMediaUrlsAuditRels = struct {
+}{}
+
+// filmMediaUrlsAuditR is where relationships are stored.
+type filmMediaUrlsAuditR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*filmMediaUrlsAuditR) NewStruct() *filmMediaUrlsAuditR {
+	return &filmMediaUrlsAuditR{}
+}
+
+// filmMediaUrlsAuditL is where Load methods for each relationship are stored.
+type filmMediaUrlsAuditL struct{}
+
+var (
+	filmMediaUrlsAuditAllColumns            = []string{"id", "film_id", "media_url", "media_type", "mime_type", "size_in_bytes", "thumbnail_url", "contributed_by", "contributed_at", "invalidation"}
+	filmMediaUrlsAuditColumnsWithoutDefault = []string{"id", "film_id", "media_url", "media_type", "mime_type", "size_in_bytes", "contributed_by", "contributed_at"}
+	filmMediaUrlsAuditColumnsWithDefault    = []string{"thumbnail_url", "invalidation"}
+	filmMediaUrlsAuditPrimaryKeyColumns     = []string{"id", "contributed_by", "contributed_at"}
+	filmMediaUrlsAuditGeneratedColumns      = []string{}
+)
+
+type (
+	// FilmMediaUrlsAuditSlice is an alias for a slice of pointers to FilmMediaUrlsAudit.
+	// This should almost always be used instead of []FilmMediaUrlsAudit.
+	FilmMediaUrlsAuditSlice []*FilmMediaUrlsAudit
+	// FilmMediaUrlsAuditHook is the signature for custom FilmMediaUrlsAudit hook methods
+	FilmMediaUrlsAuditHook func(context.Context, boil.ContextExecutor, *FilmMediaUrlsAudit) error
+
+	filmMediaUrlsAuditQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	filmMediaUrlsAuditType                 = reflect.TypeOf(&FilmMediaUrlsAudit{})
+	filmMediaUrlsAuditMapping              = queries.MakeStructMapping(filmMediaUrlsAuditType)
+	filmMediaUrlsAuditPrimaryKeyMapping, _ = queries.BindMapping(filmMediaUrlsAuditType, filmMediaUrlsAuditMapping, filmMediaUrlsAuditPrimaryKeyColumns)
+	filmMediaUrlsAuditInsertCacheMut       sync.RWMutex
+	filmMediaUrlsAuditInsertCache          = make(map[string]insertCache)
+	filmMediaUrlsAuditUpdateCacheMut       sync.RWMutex
+	filmMediaUrlsAuditUpdateCache          = make(map[string]updateCache)
+	filmMediaUrlsAuditUpsertCacheMut       sync.RWMutex
+	filmMediaUrlsAuditUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var filmMediaUrlsAuditAfterSelectHooks []FilmMediaUrlsAuditHook
+
+var filmMediaUrlsAuditBeforeInsertHooks []FilmMediaUrlsAuditHook
+var filmMediaUrlsAuditAfterInsertHooks []FilmMediaUrlsAuditHook
+
+var filmMediaUrlsAuditBeforeUpdateHooks []FilmMediaUrlsAuditHook
+var filmMediaUrlsAuditAfterUpdateHooks []FilmMediaUrlsAuditHook
+
+var filmMediaUrlsAuditBeforeDeleteHooks []FilmMediaUrlsAuditHook
+var filmMediaUrlsAuditAfterDeleteHooks []FilmMediaUrlsAuditHook
+
+var filmMediaUrlsAuditBeforeUpsertHooks []FilmMediaUrlsAuditHook
+var filmMediaUrlsAuditAfterUpsertHooks []FilmMediaUrlsAuditHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FilmMediaUrlsAudit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaUrlsAuditAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FilmMediaUrlsAudit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaUrlsAuditBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FilmMediaUrlsAudit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaUrlsAuditAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FilmMediaUrlsAudit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaUrlsAuditBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FilmMediaUrlsAudit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaUrlsAuditAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FilmMediaUrlsAudit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaUrlsAuditBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FilmMediaUrlsAudit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaUrlsAuditAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FilmMediaUrlsAudit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaUrlsAuditBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FilmMediaUrlsAudit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmMediaUrlsAuditAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFilmMediaUrlsAuditHook registers your hook function for all future operations.
+func AddFilmMediaUrlsAuditHook(hookPoint boil.HookPoint, filmMediaUrlsAuditHook FilmMediaUrlsAuditHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		filmMediaUrlsAuditAfterSelectHooks = append(filmMediaUrlsAuditAfterSelectHooks, filmMediaUrlsAuditHook)
+	case boil.BeforeInsertHook:
+		filmMediaUrlsAuditBeforeInsertHooks = append(filmMediaUrlsAuditBeforeInsertHooks, filmMediaUrlsAuditHook)
+	case boil.AfterInsertHook:
+		filmMediaUrlsAuditAfterInsertHooks = append(filmMediaUrlsAuditAfterInsertHooks, filmMediaUrlsAuditHook)
+	case boil.BeforeUpdateHook:
+		filmMediaUrlsAuditBeforeUpdateHooks = append(filmMediaUrlsAuditBeforeUpdateHooks, filmMediaUrlsAuditHook)
+	case boil.AfterUpdateHook:
+		filmMediaUrlsAuditAfterUpdateHooks = append(filmMediaUrlsAuditAfterUpdateHooks, filmMediaUrlsAuditHook)
+	case boil.BeforeDeleteHook:
+		filmMediaUrlsAuditBeforeDeleteHooks = append(filmMediaUrlsAuditBeforeDeleteHooks, filmMediaUrlsAuditHook)
+	case boil.AfterDeleteHook:
+		filmMediaUrlsAuditAfterDeleteHooks = append(filmMediaUrlsAuditAfterDeleteHooks, filmMediaUrlsAuditHook)
+	case boil.BeforeUpsertHook:
+		filmMediaUrlsAuditBeforeUpsertHooks = append(filmMediaUrlsAuditBeforeUpsertHooks, filmMediaUrlsAuditHook)
+	case boil.AfterUpsertHook:
+		filmMediaUrlsAuditAfterUpsertHooks = append(filmMediaUrlsAuditAfterUpsertHooks, filmMediaUrlsAuditHook)
+	}
+}
+
+// One returns a single filmMediaUrlsAudit record from the query.
+func (q filmMediaUrlsAuditQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FilmMediaUrlsAudit, error) {
+	o := &FilmMediaUrlsAudit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for film_media_urls_audit")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all FilmMediaUrlsAudit records from the query.
+func (q filmMediaUrlsAuditQuery) All(ctx context.Context, exec boil.ContextExecutor) (FilmMediaUrlsAuditSlice, error) {
+	var o []*FilmMediaUrlsAudit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to FilmMediaUrlsAudit slice")
+	}
+
+	if len(filmMediaUrlsAuditAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all FilmMediaUrlsAudit records in the query.
+func (q filmMediaUrlsAuditQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count film_media_urls_audit rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q filmMediaUrlsAuditQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if film_media_urls_audit exists")
+	}
+
+	return count > 0, nil
+}
+
+// FilmMediaUrlsAudits retrieves all the records using an executor.
+func FilmMediaUrlsAudits(mods ...qm.QueryMod) filmMediaUrlsAuditQuery {
+	mods = append(mods, qm.From("\"film_media_urls_audit\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"film_media_urls_audit\".*"})
+	}
+
+	return filmMediaUrlsAuditQuery{q}
+}
+
+// FindFilmMediaUrlsAudit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFilmMediaUrlsAudit(ctx context.Context, exec boil.ContextExecutor, iD int, contributedBy int, contributedAt time.Time, selectCols ...string) (*FilmMediaUrlsAudit, error) {
+	filmMediaUrlsAuditObj := &FilmMediaUrlsAudit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"film_media_urls_audit\" where \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3", sel,
+	)
+
+	q := queries.Raw(query, iD, contributedBy, contributedAt)
+
+	err := q.Bind(ctx, exec, filmMediaUrlsAuditObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from film_media_urls_audit")
+	}
+
+	if err = filmMediaUrlsAuditObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return filmMediaUrlsAuditObj, err
+	}
+
+	return filmMediaUrlsAuditObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FilmMediaUrlsAudit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no film_media_urls_audit provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(filmMediaUrlsAuditColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	filmMediaUrlsAuditInsertCacheMut.RLock()
+	cache, cached := filmMediaUrlsAuditInsertCache[key]
+	filmMediaUrlsAuditInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			filmMediaUrlsAuditAllColumns,
+			filmMediaUrlsAuditColumnsWithDefault,
+			filmMediaUrlsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(filmMediaUrlsAuditType, filmMediaUrlsAuditMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(filmMediaUrlsAuditType, filmMediaUrlsAuditMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"film_media_urls_audit\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"film_media_urls_audit\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into film_media_urls_audit")
+	}
+
+	if !cached {
+		filmMediaUrlsAuditInsertCacheMut.Lock()
+		filmMediaUrlsAuditInsertCache[key] = cache
+		filmMediaUrlsAuditInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the FilmMediaUrlsAudit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FilmMediaUrlsAudit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	filmMediaUrlsAuditUpdateCacheMut.RLock()
+	cache, cached := filmMediaUrlsAuditUpdateCache[key]
+	filmMediaUrlsAuditUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			filmMediaUrlsAuditAllColumns,
+			filmMediaUrlsAuditPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update film_media_urls_audit, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"film_media_urls_audit\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, filmMediaUrlsAuditPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(filmMediaUrlsAuditType, filmMediaUrlsAuditMapping, append(wl, filmMediaUrlsAuditPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update film_media_urls_audit row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for film_media_urls_audit")
+	}
+
+	if !cached {
+		filmMediaUrlsAuditUpdateCacheMut.Lock()
+		filmMediaUrlsAuditUpdateCache[key] = cache
+		filmMediaUrlsAuditUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q filmMediaUrlsAuditQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for film_media_urls_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for film_media_urls_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FilmMediaUrlsAuditSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), filmMediaUrlsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"film_media_urls_audit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, filmMediaUrlsAuditPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in filmMediaUrlsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all filmMediaUrlsAudit")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *FilmMediaUrlsAudit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no film_media_urls_audit provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(filmMediaUrlsAuditColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	filmMediaUrlsAuditUpsertCacheMut.RLock()
+	cache, cached := filmMediaUrlsAuditUpsertCache[key]
+	filmMediaUrlsAuditUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			filmMediaUrlsAuditAllColumns,
+			filmMediaUrlsAuditColumnsWithDefault,
+			filmMediaUrlsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			filmMediaUrlsAuditAllColumns,
+			filmMediaUrlsAuditPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert film_media_urls_audit, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(filmMediaUrlsAuditPrimaryKeyColumns))
+			copy(conflict, filmMediaUrlsAuditPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"film_media_urls_audit\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(filmMediaUrlsAuditType, filmMediaUrlsAuditMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(filmMediaUrlsAuditType, filmMediaUrlsAuditMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert film_media_urls_audit")
+	}
+
+	if !cached {
+		filmMediaUrlsAuditUpsertCacheMut.Lock()
+		filmMediaUrlsAuditUpsertCache[key] = cache
+		filmMediaUrlsAuditUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single FilmMediaUrlsAudit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FilmMediaUrlsAudit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no FilmMediaUrlsAudit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), filmMediaUrlsAuditPrimaryKeyMapping)
+	sql := "DELETE FROM \"film_media_urls_audit\" WHERE \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from film_media_urls_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for film_media_urls_audit")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q filmMediaUrlsAuditQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no filmMediaUrlsAuditQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from film_media_urls_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for film_media_urls_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FilmMediaUrlsAuditSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(filmMediaUrlsAuditBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), filmMediaUrlsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"film_media_urls_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, filmMediaUrlsAuditPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from filmMediaUrlsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for film_media_urls_audit")
+	}
+
+	if len(filmMediaUrlsAuditAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FilmMediaUrlsAudit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFilmMediaUrlsAudit(ctx, exec, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FilmMediaUrlsAuditSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FilmMediaUrlsAuditSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), filmMediaUrlsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"film_media_urls_audit\".* FROM \"film_media_urls_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, filmMediaUrlsAuditPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in FilmMediaUrlsAuditSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FilmMediaUrlsAuditExists checks if the FilmMediaUrlsAudit row exists.
+func FilmMediaUrlsAuditExists(ctx context.Context, exec boil.ContextExecutor, iD int, contributedBy int, contributedAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"film_media_urls_audit\" where \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD, contributedBy, contributedAt)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD, contributedBy, contributedAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if film_media_urls_audit exists")
+	}
+
+	return exists, nil
+}
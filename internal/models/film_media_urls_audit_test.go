@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFilmMediaUrlsAudits(t *testing.T) {
+	t.Parallel()
+
+	query := FilmMediaUrlsAudits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFilmMediaUrlsAuditsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FilmMediaUrlsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFilmMediaUrlsAuditsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := FilmMediaUrlsAudits().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FilmMediaUrlsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFilmMediaUrlsAuditsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FilmMediaUrlsAuditSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FilmMediaUrlsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFilmMediaUrlsAuditsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FilmMediaUrlsAuditExists(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Errorf("Unable to check if FilmMediaUrlsAudit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FilmMediaUrlsAuditExists to return true, but got false.")
+	}
+}
+
+func testFilmMediaUrlsAuditsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	filmMediaUrlsAuditFound, err := FindFilmMediaUrlsAudit(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if filmMediaUrlsAuditFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFilmMediaUrlsAuditsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = FilmMediaUrlsAudits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFilmMediaUrlsAuditsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := FilmMediaUrlsAudits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFilmMediaUrlsAuditsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	filmMediaUrlsAuditOne := &FilmMediaUrlsAudit{}
+	filmMediaUrlsAuditTwo := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, filmMediaUrlsAuditOne, filmMediaUrlsAuditDBTypes, false, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, filmMediaUrlsAuditTwo, filmMediaUrlsAuditDBTypes, false, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = filmMediaUrlsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = filmMediaUrlsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FilmMediaUrlsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFilmMediaUrlsAuditsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	filmMediaUrlsAuditOne := &FilmMediaUrlsAudit{}
+	filmMediaUrlsAuditTwo := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, filmMediaUrlsAuditOne, filmMediaUrlsAuditDBTypes, false, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, filmMediaUrlsAuditTwo, filmMediaUrlsAuditDBTypes, false, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = filmMediaUrlsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = filmMediaUrlsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmMediaUrlsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func filmMediaUrlsAuditBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaUrlsAudit) error {
+	*o = FilmMediaUrlsAudit{}
+	return nil
+}
+
+func filmMediaUrlsAuditAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaUrlsAudit) error {
+	*o = FilmMediaUrlsAudit{}
+	return nil
+}
+
+func filmMediaUrlsAuditAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaUrlsAudit) error {
+	*o = FilmMediaUrlsAudit{}
+	return nil
+}
+
+func filmMediaUrlsAuditBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaUrlsAudit) error {
+	*o = FilmMediaUrlsAudit{}
+	return nil
+}
+
+func filmMediaUrlsAuditAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaUrlsAudit) error {
+	*o = FilmMediaUrlsAudit{}
+	return nil
+}
+
+func filmMediaUrlsAuditBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaUrlsAudit) error {
+	*o = FilmMediaUrlsAudit{}
+	return nil
+}
+
+func filmMediaUrlsAuditAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaUrlsAudit) error {
+	*o = FilmMediaUrlsAudit{}
+	return nil
+}
+
+func filmMediaUrlsAuditBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaUrlsAudit) error {
+	*o = FilmMediaUrlsAudit{}
+	return nil
+}
+
+func filmMediaUrlsAuditAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaUrlsAudit) error {
+	*o = FilmMediaUrlsAudit{}
+	return nil
+}
+
+func testFilmMediaUrlsAuditsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &FilmMediaUrlsAudit{}
+	o := &FilmMediaUrlsAudit{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit object: %s", err)
+	}
+
+	AddFilmMediaUrlsAuditHook(boil.BeforeInsertHook, filmMediaUrlsAuditBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	filmMediaUrlsAuditBeforeInsertHooks = []FilmMediaUrlsAuditHook{}
+
+	AddFilmMediaUrlsAuditHook(boil.AfterInsertHook, filmMediaUrlsAuditAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	filmMediaUrlsAuditAfterInsertHooks = []FilmMediaUrlsAuditHook{}
+
+	AddFilmMediaUrlsAuditHook(boil.AfterSelectHook, filmMediaUrlsAuditAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	filmMediaUrlsAuditAfterSelectHooks = []FilmMediaUrlsAuditHook{}
+
+	AddFilmMediaUrlsAuditHook(boil.BeforeUpdateHook, filmMediaUrlsAuditBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	filmMediaUrlsAuditBeforeUpdateHooks = []FilmMediaUrlsAuditHook{}
+
+	AddFilmMediaUrlsAuditHook(boil.AfterUpdateHook, filmMediaUrlsAuditAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	filmMediaUrlsAuditAfterUpdateHooks = []FilmMediaUrlsAuditHook{}
+
+	AddFilmMediaUrlsAuditHook(boil.BeforeDeleteHook, filmMediaUrlsAuditBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	filmMediaUrlsAuditBeforeDeleteHooks = []FilmMediaUrlsAuditHook{}
+
+	AddFilmMediaUrlsAuditHook(boil.AfterDeleteHook, filmMediaUrlsAuditAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	filmMediaUrlsAuditAfterDeleteHooks = []FilmMediaUrlsAuditHook{}
+
+	AddFilmMediaUrlsAuditHook(boil.BeforeUpsertHook, filmMediaUrlsAuditBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	filmMediaUrlsAuditBeforeUpsertHooks = []FilmMediaUrlsAuditHook{}
+
+	AddFilmMediaUrlsAuditHook(boil.AfterUpsertHook, filmMediaUrlsAuditAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	filmMediaUrlsAuditAfterUpsertHooks = []FilmMediaUrlsAuditHook{}
+}
+
+func testFilmMediaUrlsAuditsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmMediaUrlsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFilmMediaUrlsAuditsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(filmMediaUrlsAuditColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmMediaUrlsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFilmMediaUrlsAuditsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFilmMediaUrlsAuditsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FilmMediaUrlsAuditSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFilmMediaUrlsAuditsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FilmMediaUrlsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	filmMediaUrlsAuditDBTypes = map[string]string{`ID`: `integer`, `FilmID`: `integer`, `MediaURL`: `character varying`, `MediaType`: `character varying`, `MimeType`: `character varying`, `SizeInBytes`: `bigint`, `ThumbnailURL`: `character varying`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`}
+	_                         = bytes.MinRead
+)
+
+func testFilmMediaUrlsAuditsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(filmMediaUrlsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(filmMediaUrlsAuditAllColumns) == len(filmMediaUrlsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmMediaUrlsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFilmMediaUrlsAuditsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(filmMediaUrlsAuditAllColumns) == len(filmMediaUrlsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmMediaUrlsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, filmMediaUrlsAuditDBTypes, true, filmMediaUrlsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(filmMediaUrlsAuditAllColumns, filmMediaUrlsAuditPrimaryKeyColumns) {
+		fields = filmMediaUrlsAuditAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			filmMediaUrlsAuditAllColumns,
+			filmMediaUrlsAuditPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FilmMediaUrlsAuditSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testFilmMediaUrlsAuditsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(filmMediaUrlsAuditAllColumns) == len(filmMediaUrlsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := FilmMediaUrlsAudit{}
+	if err = randomize.Struct(seed, &o, filmMediaUrlsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FilmMediaUrlsAudit: %s", err)
+	}
+
+	count, err := FilmMediaUrlsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, filmMediaUrlsAuditDBTypes, false, filmMediaUrlsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaUrlsAudit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FilmMediaUrlsAudit: %s", err)
+	}
+
+	count, err = FilmMediaUrlsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
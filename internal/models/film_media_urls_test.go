@@ -0,0 +1,949 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFilmMediaUrls(t *testing.T) {
+	t.Parallel()
+
+	query := FilmMediaUrls()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFilmMediaUrlsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaURL{}
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FilmMediaUrls().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFilmMediaUrlsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaURL{}
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := FilmMediaUrls().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FilmMediaUrls().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFilmMediaUrlsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaURL{}
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FilmMediaURLSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FilmMediaUrls().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFilmMediaUrlsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaURL{}
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FilmMediaURLExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if FilmMediaURL exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FilmMediaURLExists to return true, but got false.")
+	}
+}
+
+func testFilmMediaUrlsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaURL{}
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	filmMediaURLFound, err := FindFilmMediaURL(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if filmMediaURLFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFilmMediaUrlsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaURL{}
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = FilmMediaUrls().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFilmMediaUrlsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaURL{}
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := FilmMediaUrls().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFilmMediaUrlsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	filmMediaURLOne := &FilmMediaURL{}
+	filmMediaURLTwo := &FilmMediaURL{}
+	if err = randomize.Struct(seed, filmMediaURLOne, filmMediaURLDBTypes, false, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+	if err = randomize.Struct(seed, filmMediaURLTwo, filmMediaURLDBTypes, false, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = filmMediaURLOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = filmMediaURLTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FilmMediaUrls().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFilmMediaUrlsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	filmMediaURLOne := &FilmMediaURL{}
+	filmMediaURLTwo := &FilmMediaURL{}
+	if err = randomize.Struct(seed, filmMediaURLOne, filmMediaURLDBTypes, false, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+	if err = randomize.Struct(seed, filmMediaURLTwo, filmMediaURLDBTypes, false, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = filmMediaURLOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = filmMediaURLTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmMediaUrls().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func filmMediaURLBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaURL) error {
+	*o = FilmMediaURL{}
+	return nil
+}
+
+func filmMediaURLAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaURL) error {
+	*o = FilmMediaURL{}
+	return nil
+}
+
+func filmMediaURLAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaURL) error {
+	*o = FilmMediaURL{}
+	return nil
+}
+
+func filmMediaURLBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaURL) error {
+	*o = FilmMediaURL{}
+	return nil
+}
+
+func filmMediaURLAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaURL) error {
+	*o = FilmMediaURL{}
+	return nil
+}
+
+func filmMediaURLBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaURL) error {
+	*o = FilmMediaURL{}
+	return nil
+}
+
+func filmMediaURLAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaURL) error {
+	*o = FilmMediaURL{}
+	return nil
+}
+
+func filmMediaURLBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaURL) error {
+	*o = FilmMediaURL{}
+	return nil
+}
+
+func filmMediaURLAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FilmMediaURL) error {
+	*o = FilmMediaURL{}
+	return nil
+}
+
+func testFilmMediaUrlsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &FilmMediaURL{}
+	o := &FilmMediaURL{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL object: %s", err)
+	}
+
+	AddFilmMediaURLHook(boil.BeforeInsertHook, filmMediaURLBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	filmMediaURLBeforeInsertHooks = []FilmMediaURLHook{}
+
+	AddFilmMediaURLHook(boil.AfterInsertHook, filmMediaURLAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	filmMediaURLAfterInsertHooks = []FilmMediaURLHook{}
+
+	AddFilmMediaURLHook(boil.AfterSelectHook, filmMediaURLAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	filmMediaURLAfterSelectHooks = []FilmMediaURLHook{}
+
+	AddFilmMediaURLHook(boil.BeforeUpdateHook, filmMediaURLBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	filmMediaURLBeforeUpdateHooks = []FilmMediaURLHook{}
+
+	AddFilmMediaURLHook(boil.AfterUpdateHook, filmMediaURLAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	filmMediaURLAfterUpdateHooks = []FilmMediaURLHook{}
+
+	AddFilmMediaURLHook(boil.BeforeDeleteHook, filmMediaURLBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	filmMediaURLBeforeDeleteHooks = []FilmMediaURLHook{}
+
+	AddFilmMediaURLHook(boil.AfterDeleteHook, filmMediaURLAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	filmMediaURLAfterDeleteHooks = []FilmMediaURLHook{}
+
+	AddFilmMediaURLHook(boil.BeforeUpsertHook, filmMediaURLBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	filmMediaURLBeforeUpsertHooks = []FilmMediaURLHook{}
+
+	AddFilmMediaURLHook(boil.AfterUpsertHook, filmMediaURLAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	filmMediaURLAfterUpsertHooks = []FilmMediaURLHook{}
+}
+
+func testFilmMediaUrlsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaURL{}
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmMediaUrls().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFilmMediaUrlsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaURL{}
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(filmMediaURLColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmMediaUrls().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFilmMediaURLToOneUserUsingContributingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local FilmMediaURL
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, filmMediaURLDBTypes, false, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ContributedBy = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ContributingUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := FilmMediaURLSlice{&local}
+	if err = local.L.LoadContributingUser(ctx, tx, false, (*[]*FilmMediaURL)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ContributingUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ContributingUser = nil
+	if err = local.L.LoadContributingUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ContributingUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testFilmMediaURLToOneFilmUsingFilm(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local FilmMediaURL
+	var foreign Film
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, filmMediaURLDBTypes, false, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.FilmID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Film().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := FilmMediaURLSlice{&local}
+	if err = local.L.LoadFilm(ctx, tx, false, (*[]*FilmMediaURL)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Film = nil
+	if err = local.L.LoadFilm(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testFilmMediaURLToOneSetOpUserUsingContributingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FilmMediaURL
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmMediaURLDBTypes, false, strmangle.SetComplement(filmMediaURLPrimaryKeyColumns, filmMediaURLColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetContributingUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ContributingUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ContributedFilmMediaUrls[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ContributedBy != x.ID {
+			t.Error("foreign key was wrong value", a.ContributedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ContributedBy))
+		reflect.Indirect(reflect.ValueOf(&a.ContributedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ContributedBy != x.ID {
+			t.Error("foreign key was wrong value", a.ContributedBy, x.ID)
+		}
+	}
+}
+func testFilmMediaURLToOneSetOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FilmMediaURL
+	var b, c Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmMediaURLDBTypes, false, strmangle.SetComplement(filmMediaURLPrimaryKeyColumns, filmMediaURLColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Film{&b, &c} {
+		err = a.SetFilm(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Film != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.FilmMediaUrls[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.FilmID != x.ID {
+			t.Error("foreign key was wrong value", a.FilmID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.FilmID))
+		reflect.Indirect(reflect.ValueOf(&a.FilmID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.FilmID != x.ID {
+			t.Error("foreign key was wrong value", a.FilmID, x.ID)
+		}
+	}
+}
+
+func testFilmMediaUrlsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaURL{}
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFilmMediaUrlsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaURL{}
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FilmMediaURLSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFilmMediaUrlsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaURL{}
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FilmMediaUrls().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	filmMediaURLDBTypes = map[string]string{`ID`: `integer`, `FilmID`: `integer`, `MediaURL`: `character varying`, `MediaType`: `character varying`, `MimeType`: `character varying`, `SizeInBytes`: `bigint`, `ThumbnailURL`: `character varying`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`}
+	_                   = bytes.MinRead
+)
+
+func testFilmMediaUrlsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(filmMediaURLPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(filmMediaURLAllColumns) == len(filmMediaURLPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaURL{}
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmMediaUrls().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFilmMediaUrlsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(filmMediaURLAllColumns) == len(filmMediaURLPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmMediaURL{}
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmMediaUrls().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, filmMediaURLDBTypes, true, filmMediaURLPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(filmMediaURLAllColumns, filmMediaURLPrimaryKeyColumns) {
+		fields = filmMediaURLAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			filmMediaURLAllColumns,
+			filmMediaURLPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FilmMediaURLSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testFilmMediaUrlsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(filmMediaURLAllColumns) == len(filmMediaURLPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := FilmMediaURL{}
+	if err = randomize.Struct(seed, &o, filmMediaURLDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FilmMediaURL: %s", err)
+	}
+
+	count, err := FilmMediaUrls().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, filmMediaURLDBTypes, false, filmMediaURLPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FilmMediaURL struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FilmMediaURL: %s", err)
+	}
+
+	count, err = FilmMediaUrls().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
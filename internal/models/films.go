@@ -35,6 +35,10 @@ type Film struct {
 	ContributedBy int         `boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
 	ContributedAt time.Time   `boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
 	Invalidation  null.String `boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	EditSummary   null.String `boil:"edit_summary" json:"edit_summary,omitempty" toml:"edit_summary" yaml:"edit_summary,omitempty"`
+	RequestID     null.String `boil:"request_id" json:"request_id,omitempty" toml:"request_id" yaml:"request_id,omitempty"`
+	ClientIP      null.String `boil:"client_ip" json:"client_ip,omitempty" toml:"client_ip" yaml:"client_ip,omitempty"`
+	UserAgent     null.String `boil:"user_agent" json:"user_agent,omitempty" toml:"user_agent" yaml:"user_agent,omitempty"`
 
 	R *filmR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L filmL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -52,6 +56,10 @@ var FilmColumns = struct {
 	ContributedBy string
 	ContributedAt string
 	Invalidation  string
+	EditSummary   string
+	RequestID     string
+	ClientIP      string
+	UserAgent     string
 }{
 	ID:            "id",
 	Title:         "title",
@@ -64,6 +72,10 @@ var FilmColumns = struct {
 	ContributedBy: "contributed_by",
 	ContributedAt: "contributed_at",
 	Invalidation:  "invalidation",
+	EditSummary:   "edit_summary",
+	RequestID:     "request_id",
+	ClientIP:      "client_ip",
+	UserAgent:     "user_agent",
 }
 
 var FilmTableColumns = struct {
@@ -78,6 +90,10 @@ var FilmTableColumns = struct {
 	ContributedBy string
 	ContributedAt string
 	Invalidation  string
+	EditSummary   string
+	RequestID     string
+	ClientIP      string
+	UserAgent     string
 }{
 	ID:            "films.id",
 	Title:         "films.title",
@@ -90,115 +106,14 @@ var FilmTableColumns = struct {
 	ContributedBy: "films.contributed_by",
 	ContributedAt: "films.contributed_at",
 	Invalidation:  "films.invalidation",
+	EditSummary:   "films.edit_summary",
+	RequestID:     "films.request_id",
+	ClientIP:      "films.client_ip",
+	UserAgent:     "films.user_agent",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 type whereHelpernull_Int struct{ field string }
 
 func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
@@ -249,6 +164,10 @@ var FilmWhere = struct {
 	ContributedBy whereHelperint
 	ContributedAt whereHelpertime_Time
 	Invalidation  whereHelpernull_String
+	EditSummary   whereHelpernull_String
+	RequestID     whereHelpernull_String
+	ClientIP      whereHelpernull_String
+	UserAgent     whereHelpernull_String
 }{
 	ID:            whereHelperint{field: "\"films\".\"id\""},
 	Title:         whereHelperstring{field: "\"films\".\"title\""},
@@ -261,21 +180,40 @@ var FilmWhere = struct {
 	ContributedBy: whereHelperint{field: "\"films\".\"contributed_by\""},
 	ContributedAt: whereHelpertime_Time{field: "\"films\".\"contributed_at\""},
 	Invalidation:  whereHelpernull_String{field: "\"films\".\"invalidation\""},
+	EditSummary:   whereHelpernull_String{field: "\"films\".\"edit_summary\""},
+	RequestID:     whereHelpernull_String{field: "\"films\".\"request_id\""},
+	ClientIP:      whereHelpernull_String{field: "\"films\".\"client_ip\""},
+	UserAgent:     whereHelpernull_String{field: "\"films\".\"user_agent\""},
 }
 
 // FilmRels is where relationship names are stored.
 var FilmRels = struct {
 	ContributingUser string
 	Series           string
+	FilmMediaUrls    string
+	PlaylistFilms    string
+	Posts            string
+	Proposals        string
+	Watchlists       string
 }{
 	ContributingUser: "ContributingUser",
 	Series:           "Series",
+	FilmMediaUrls:    "FilmMediaUrls",
+	PlaylistFilms:    "PlaylistFilms",
+	Posts:            "Posts",
+	Proposals:        "Proposals",
+	Watchlists:       "Watchlists",
 }
 
 // filmR is where relationships are stored.
 type filmR struct {
-	ContributingUser *User   `boil:"ContributingUser" json:"ContributingUser" toml:"ContributingUser" yaml:"ContributingUser"`
-	Series           *Series `boil:"Series" json:"Series" toml:"Series" yaml:"Series"`
+	ContributingUser *User             `boil:"ContributingUser" json:"ContributingUser" toml:"ContributingUser" yaml:"ContributingUser"`
+	Series           *Series           `boil:"Series" json:"Series" toml:"Series" yaml:"Series"`
+	FilmMediaUrls    FilmMediaURLSlice `boil:"FilmMediaUrls" json:"FilmMediaUrls" toml:"FilmMediaUrls" yaml:"FilmMediaUrls"`
+	PlaylistFilms    PlaylistFilmSlice `boil:"PlaylistFilms" json:"PlaylistFilms" toml:"PlaylistFilms" yaml:"PlaylistFilms"`
+	Posts            PostSlice         `boil:"Posts" json:"Posts" toml:"Posts" yaml:"Posts"`
+	Proposals        ProposalSlice     `boil:"Proposals" json:"Proposals" toml:"Proposals" yaml:"Proposals"`
+	Watchlists       WatchlistSlice    `boil:"Watchlists" json:"Watchlists" toml:"Watchlists" yaml:"Watchlists"`
 }
 
 // NewStruct creates a new relationship struct
@@ -297,13 +235,48 @@ func (r *filmR) GetSeries() *Series {
 	return r.Series
 }
 
+func (r *filmR) GetFilmMediaUrls() FilmMediaURLSlice {
+	if r == nil {
+		return nil
+	}
+	return r.FilmMediaUrls
+}
+
+func (r *filmR) GetPlaylistFilms() PlaylistFilmSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PlaylistFilms
+}
+
+func (r *filmR) GetPosts() PostSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Posts
+}
+
+func (r *filmR) GetProposals() ProposalSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Proposals
+}
+
+func (r *filmR) GetWatchlists() WatchlistSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Watchlists
+}
+
 // filmL is where Load methods for each relationship are stored.
 type filmL struct{}
 
 var (
-	filmAllColumns            = []string{"id", "title", "descriptions", "date_released", "duration", "series_id", "season_number", "episode_number", "contributed_by", "contributed_at", "invalidation"}
+	filmAllColumns            = []string{"id", "title", "descriptions", "date_released", "duration", "series_id", "season_number", "episode_number", "contributed_by", "contributed_at", "invalidation", "edit_summary", "request_id", "client_ip", "user_agent"}
 	filmColumnsWithoutDefault = []string{"title", "date_released", "contributed_by"}
-	filmColumnsWithDefault    = []string{"id", "descriptions", "duration", "series_id", "season_number", "episode_number", "contributed_at", "invalidation"}
+	filmColumnsWithDefault    = []string{"id", "descriptions", "duration", "series_id", "season_number", "episode_number", "contributed_at", "invalidation", "edit_summary", "request_id", "client_ip", "user_agent"}
 	filmPrimaryKeyColumns     = []string{"id"}
 	filmGeneratedColumns      = []string{}
 )
@@ -608,6 +581,77 @@ func (o *Film) Series(mods ...qm.QueryMod) seriesQuery {
 	return Serieses(queryMods...)
 }
 
+// FilmMediaUrls retrieves all the film_media_url's FilmMediaUrls with an executor.
+func (o *Film) FilmMediaUrls(mods ...qm.QueryMod) filmMediaURLQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"film_media_urls\".\"film_id\"=?", o.ID),
+	)
+
+	return FilmMediaUrls(queryMods...)
+}
+
+// PlaylistFilms retrieves all the playlist_film's PlaylistFilms with an executor.
+func (o *Film) PlaylistFilms(mods ...qm.QueryMod) playlistFilmQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"playlist_films\".\"film_id\"=?", o.ID),
+	)
+
+	return PlaylistFilms(queryMods...)
+}
+
+// Posts retrieves all the post's Posts with an executor.
+func (o *Film) Posts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"post_films\" on \"posts\".\"id\" = \"post_films\".\"post_id\""),
+		qm.Where("\"post_films\".\"film_id\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
+// Proposals retrieves all the proposal's Proposals with an executor.
+func (o *Film) Proposals(mods ...qm.QueryMod) proposalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"proposals\".\"film_id\"=?", o.ID),
+	)
+
+	return Proposals(queryMods...)
+}
+
+// Watchlists retrieves all the watchlist's Watchlists with an executor.
+func (o *Film) Watchlists(mods ...qm.QueryMod) watchlistQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"watchlists\".\"film_id\"=?", o.ID),
+	)
+
+	return Watchlists(queryMods...)
+}
+
 // LoadContributingUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (filmL) LoadContributingUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
@@ -852,102 +896,689 @@ func (filmL) LoadSeries(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// SetContributingUser of the film to the related item.
-// Sets o.R.ContributingUser to related.
-// Adds o to related.R.ContributedFilms.
-func (o *Film) SetContributingUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"films\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
-		strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadFilmMediaUrls allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadFilmMediaUrls(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
 
-	o.ContributedBy = related.ID
-	if o.R == nil {
-		o.R = &filmR{
-			ContributingUser: related,
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
 		}
 	} else {
-		o.R.ContributingUser = related
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			ContributedFilms: FilmSlice{o},
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		related.R.ContributedFilms = append(related.R.ContributedFilms, o)
-	}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
 
-	return nil
-}
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
 
-// SetSeries of the film to the related item.
-// Sets o.R.Series to related.
-// Adds o to related.R.SeriesFilms.
-func (o *Film) SetSeries(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Series) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+			args = append(args, obj.ID)
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"films\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
-		strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`film_media_urls`),
+		qm.WhereIn(`film_media_urls.film_id in ?`, args...),
 	)
-	values := []interface{}{related.ID, o.ID}
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load film_media_urls")
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	var resultSlice []*FilmMediaURL
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice film_media_urls")
 	}
 
-	queries.Assign(&o.SeriesID, related.ID)
-	if o.R == nil {
-		o.R = &filmR{
-			Series: related,
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on film_media_urls")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for film_media_urls")
+	}
+
+	if len(filmMediaURLAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-	} else {
-		o.R.Series = related
+	}
+	if singular {
+		object.R.FilmMediaUrls = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &filmMediaURLR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
 	}
 
-	if related.R == nil {
-		related.R = &seriesR{
-			SeriesFilms: FilmSlice{o},
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FilmID {
+				local.R.FilmMediaUrls = append(local.R.FilmMediaUrls, foreign)
+				if foreign.R == nil {
+					foreign.R = &filmMediaURLR{}
+				}
+				foreign.R.Film = local
+				break
+			}
 		}
-	} else {
-		related.R.SeriesFilms = append(related.R.SeriesFilms, o)
 	}
 
 	return nil
 }
 
-// RemoveSeries relationship.
-// Sets o.R.Series to nil.
+// LoadPlaylistFilms allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadPlaylistFilms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`playlist_films`),
+		qm.WhereIn(`playlist_films.film_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load playlist_films")
+	}
+
+	var resultSlice []*PlaylistFilm
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice playlist_films")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on playlist_films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for playlist_films")
+	}
+
+	if len(playlistFilmAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PlaylistFilms = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &playlistFilmR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FilmID {
+				local.R.PlaylistFilms = append(local.R.PlaylistFilms, foreign)
+				if foreign.R == nil {
+					foreign.R = &playlistFilmR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"posts\".\"id\", \"posts\".\"user_id\", \"posts\".\"post_id\", \"posts\".\"body\", \"posts\".\"posted_at\", \"posts\".\"edited_at\", \"a\".\"film_id\""),
+		qm.From("\"posts\""),
+		qm.InnerJoin("\"post_films\" as \"a\" on \"posts\".\"id\" = \"a\".\"post_id\""),
+		qm.WhereIn("\"a\".\"film_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posts")
+	}
+
+	var resultSlice []*Post
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Post)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.UserID, &one.PostID, &one.Body, &one.PostedAt, &one.EditedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for posts")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice posts")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Posts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.Films = append(foreign.R.Films, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Posts = append(local.R.Posts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.Films = append(foreign.R.Films, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProposals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadProposals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`proposals`),
+		qm.WhereIn(`proposals.film_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load proposals")
+	}
+
+	var resultSlice []*Proposal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice proposals")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on proposals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for proposals")
+	}
+
+	if len(proposalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Proposals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &proposalR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.FilmID) {
+				local.R.Proposals = append(local.R.Proposals, foreign)
+				if foreign.R == nil {
+					foreign.R = &proposalR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWatchlists allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadWatchlists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`watchlists`),
+		qm.WhereIn(`watchlists.film_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load watchlists")
+	}
+
+	var resultSlice []*Watchlist
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice watchlists")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on watchlists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for watchlists")
+	}
+
+	if len(watchlistAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Watchlists = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &watchlistR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FilmID {
+				local.R.Watchlists = append(local.R.Watchlists, foreign)
+				if foreign.R == nil {
+					foreign.R = &watchlistR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetContributingUser of the film to the related item.
+// Sets o.R.ContributingUser to related.
+// Adds o to related.R.ContributedFilms.
+func (o *Film) SetContributingUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"films\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+		strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ContributedBy = related.ID
+	if o.R == nil {
+		o.R = &filmR{
+			ContributingUser: related,
+		}
+	} else {
+		o.R.ContributingUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ContributedFilms: FilmSlice{o},
+		}
+	} else {
+		related.R.ContributedFilms = append(related.R.ContributedFilms, o)
+	}
+
+	return nil
+}
+
+// SetSeries of the film to the related item.
+// Sets o.R.Series to related.
+// Adds o to related.R.SeriesFilms.
+func (o *Film) SetSeries(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Series) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"films\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+		strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SeriesID, related.ID)
+	if o.R == nil {
+		o.R = &filmR{
+			Series: related,
+		}
+	} else {
+		o.R.Series = related
+	}
+
+	if related.R == nil {
+		related.R = &seriesR{
+			SeriesFilms: FilmSlice{o},
+		}
+	} else {
+		related.R.SeriesFilms = append(related.R.SeriesFilms, o)
+	}
+
+	return nil
+}
+
+// RemoveSeries relationship.
+// Sets o.R.Series to nil.
 // Removes o from all passed in related items' relationships struct.
 func (o *Film) RemoveSeries(ctx context.Context, exec boil.ContextExecutor, related *Series) error {
 	var err error
@@ -979,6 +1610,437 @@ func (o *Film) RemoveSeries(ctx context.Context, exec boil.ContextExecutor, rela
 	return nil
 }
 
+// AddFilmMediaUrls adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.FilmMediaUrls.
+// Sets related.R.Film appropriately.
+func (o *Film) AddFilmMediaUrls(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FilmMediaURL) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FilmID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"film_media_urls\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, filmMediaURLPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FilmID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &filmR{
+			FilmMediaUrls: related,
+		}
+	} else {
+		o.R.FilmMediaUrls = append(o.R.FilmMediaUrls, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &filmMediaURLR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
+// AddPlaylistFilms adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.PlaylistFilms.
+// Sets related.R.Film appropriately.
+func (o *Film) AddPlaylistFilms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlaylistFilm) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FilmID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"playlist_films\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, playlistFilmPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.PlaylistID, rel.FilmID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FilmID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &filmR{
+			PlaylistFilms: related,
+		}
+	} else {
+		o.R.PlaylistFilms = append(o.R.PlaylistFilms, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &playlistFilmR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
+// AddPosts adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.Posts.
+// Sets related.R.Films appropriately.
+func (o *Film) AddPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"post_films\" (\"film_id\", \"post_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &filmR{
+			Posts: related,
+		}
+	} else {
+		o.R.Posts = append(o.R.Posts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				Films: FilmSlice{o},
+			}
+		} else {
+			rel.R.Films = append(rel.R.Films, o)
+		}
+	}
+	return nil
+}
+
+// SetPosts removes all previously related items of the
+// film replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Films's Posts accordingly.
+// Replaces o.R.Posts with related.
+// Sets related.R.Films's Posts accordingly.
+func (o *Film) SetPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	query := "delete from \"post_films\" where \"film_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removePostsFromFilmsSlice(o, related)
+	if o.R != nil {
+		o.R.Posts = nil
+	}
+
+	return o.AddPosts(ctx, exec, insert, related...)
+}
+
+// RemovePosts relationships from objects passed in.
+// Removes related items from R.Posts (uses pointer comparison, removal does not keep order)
+// Sets related.R.Films.
+func (o *Film) RemovePosts(ctx context.Context, exec boil.ContextExecutor, related ...*Post) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"post_films\" where \"film_id\" = $1 and \"post_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removePostsFromFilmsSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Posts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Posts)
+			if ln > 1 && i < ln-1 {
+				o.R.Posts[i] = o.R.Posts[ln-1]
+			}
+			o.R.Posts = o.R.Posts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removePostsFromFilmsSlice(o *Film, related []*Post) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Films {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Films)
+			if ln > 1 && i < ln-1 {
+				rel.R.Films[i] = rel.R.Films[ln-1]
+			}
+			rel.R.Films = rel.R.Films[:ln-1]
+			break
+		}
+	}
+}
+
+// AddProposals adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.Proposals.
+// Sets related.R.Film appropriately.
+func (o *Film) AddProposals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Proposal) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.FilmID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"proposals\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, proposalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.FilmID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &filmR{
+			Proposals: related,
+		}
+	} else {
+		o.R.Proposals = append(o.R.Proposals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &proposalR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
+// SetProposals removes all previously related items of the
+// film replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Film's Proposals accordingly.
+// Replaces o.R.Proposals with related.
+// Sets related.R.Film's Proposals accordingly.
+func (o *Film) SetProposals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Proposal) error {
+	query := "update \"proposals\" set \"film_id\" = null where \"film_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Proposals {
+			queries.SetScanner(&rel.FilmID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Film = nil
+		}
+		o.R.Proposals = nil
+	}
+
+	return o.AddProposals(ctx, exec, insert, related...)
+}
+
+// RemoveProposals relationships from objects passed in.
+// Removes related items from R.Proposals (uses pointer comparison, removal does not keep order)
+// Sets related.R.Film.
+func (o *Film) RemoveProposals(ctx context.Context, exec boil.ContextExecutor, related ...*Proposal) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.FilmID, nil)
+		if rel.R != nil {
+			rel.R.Film = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Proposals {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Proposals)
+			if ln > 1 && i < ln-1 {
+				o.R.Proposals[i] = o.R.Proposals[ln-1]
+			}
+			o.R.Proposals = o.R.Proposals[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddWatchlists adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.Watchlists.
+// Sets related.R.Film appropriately.
+func (o *Film) AddWatchlists(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Watchlist) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FilmID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"watchlists\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, watchlistPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.FilmID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FilmID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &filmR{
+			Watchlists: related,
+		}
+	} else {
+		o.R.Watchlists = append(o.R.Watchlists, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &watchlistR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
 // Films retrieves all the records using an executor.
 func Films(mods ...qm.QueryMod) filmQuery {
 	mods = append(mods, qm.From("\"films\""))
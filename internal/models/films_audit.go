@@ -35,6 +35,10 @@ type FilmsAudit struct {
 	ContributedBy int         `boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
 	ContributedAt time.Time   `boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
 	Invalidation  null.String `boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	EditSummary   null.String `boil:"edit_summary" json:"edit_summary,omitempty" toml:"edit_summary" yaml:"edit_summary,omitempty"`
+	RequestID     null.String `boil:"request_id" json:"request_id,omitempty" toml:"request_id" yaml:"request_id,omitempty"`
+	ClientIP      null.String `boil:"client_ip" json:"client_ip,omitempty" toml:"client_ip" yaml:"client_ip,omitempty"`
+	UserAgent     null.String `boil:"user_agent" json:"user_agent,omitempty" toml:"user_agent" yaml:"user_agent,omitempty"`
 
 	R *filmsAuditR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L filmsAuditL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -52,6 +56,10 @@ var FilmsAuditColumns = struct {
 	ContributedBy string
 	ContributedAt string
 	Invalidation  string
+	EditSummary   string
+	RequestID     string
+	ClientIP      string
+	UserAgent     string
 }{
 	ID:            "id",
 	Title:         "title",
@@ -64,6 +72,10 @@ var FilmsAuditColumns = struct {
 	ContributedBy: "contributed_by",
 	ContributedAt: "contributed_at",
 	Invalidation:  "invalidation",
+	EditSummary:   "edit_summary",
+	RequestID:     "request_id",
+	ClientIP:      "client_ip",
+	UserAgent:     "user_agent",
 }
 
 var FilmsAuditTableColumns = struct {
@@ -78,6 +90,10 @@ var FilmsAuditTableColumns = struct {
 	ContributedBy string
 	ContributedAt string
 	Invalidation  string
+	EditSummary   string
+	RequestID     string
+	ClientIP      string
+	UserAgent     string
 }{
 	ID:            "films_audit.id",
 	Title:         "films_audit.title",
@@ -90,6 +106,10 @@ var FilmsAuditTableColumns = struct {
 	ContributedBy: "films_audit.contributed_by",
 	ContributedAt: "films_audit.contributed_at",
 	Invalidation:  "films_audit.invalidation",
+	EditSummary:   "films_audit.edit_summary",
+	RequestID:     "films_audit.request_id",
+	ClientIP:      "films_audit.client_ip",
+	UserAgent:     "films_audit.user_agent",
 }
 
 // Generated where
@@ -106,6 +126,10 @@ var FilmsAuditWhere = struct {
 	ContributedBy whereHelperint
 	ContributedAt whereHelpertime_Time
 	Invalidation  whereHelpernull_String
+	EditSummary   whereHelpernull_String
+	RequestID     whereHelpernull_String
+	ClientIP      whereHelpernull_String
+	UserAgent     whereHelpernull_String
 }{
 	ID:            whereHelperint{field: "\"films_audit\".\"id\""},
 	Title:         whereHelperstring{field: "\"films_audit\".\"title\""},
@@ -118,6 +142,10 @@ var FilmsAuditWhere = struct {
 	ContributedBy: whereHelperint{field: "\"films_audit\".\"contributed_by\""},
 	ContributedAt: whereHelpertime_Time{field: "\"films_audit\".\"contributed_at\""},
 	Invalidation:  whereHelpernull_String{field: "\"films_audit\".\"invalidation\""},
+	EditSummary:   whereHelpernull_String{field: "\"films_audit\".\"edit_summary\""},
+	RequestID:     whereHelpernull_String{field: "\"films_audit\".\"request_id\""},
+	ClientIP:      whereHelpernull_String{field: "\"films_audit\".\"client_ip\""},
+	UserAgent:     whereHelpernull_String{field: "\"films_audit\".\"user_agent\""},
 }
 
 // FilmsAuditRels is where relationship names are stored.
@@ -137,9 +165,9 @@ func (*filmsAuditR) NewStruct() *filmsAuditR {
 type filmsAuditL struct{}
 
 var (
-	filmsAuditAllColumns            = []string{"id", "title", "descriptions", "date_released", "duration", "series_id", "season_number", "episode_number", "contributed_by", "contributed_at", "invalidation"}
+	filmsAuditAllColumns            = []string{"id", "title", "descriptions", "date_released", "duration", "series_id", "season_number", "episode_number", "contributed_by", "contributed_at", "invalidation", "edit_summary", "request_id", "client_ip", "user_agent"}
 	filmsAuditColumnsWithoutDefault = []string{"id", "title", "date_released", "contributed_by", "contributed_at"}
-	filmsAuditColumnsWithDefault    = []string{"descriptions", "duration", "series_id", "season_number", "episode_number", "invalidation"}
+	filmsAuditColumnsWithDefault    = []string{"descriptions", "duration", "series_id", "season_number", "episode_number", "invalidation", "edit_summary", "request_id", "client_ip", "user_agent"}
 	filmsAuditPrimaryKeyColumns     = []string{"id", "contributed_by", "contributed_at"}
 	filmsAuditGeneratedColumns      = []string{}
 )
@@ -0,0 +1,1277 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PlaylistFilm is an object representing the database table.
+type PlaylistFilm struct {
+	PlaylistID int       `boil:"playlist_id" json:"playlist_id" toml:"playlist_id" yaml:"playlist_id"`
+	FilmID     int       `boil:"film_id" json:"film_id" toml:"film_id" yaml:"film_id"`
+	Position   int       `boil:"position" json:"position" toml:"position" yaml:"position"`
+	AddedAt    time.Time `boil:"added_at" json:"added_at" toml:"added_at" yaml:"added_at"`
+
+	R *playlistFilmR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L playlistFilmL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PlaylistFilmColumns = struct {
+	PlaylistID string
+	FilmID     string
+	Position   string
+	AddedAt    string
+}{
+	PlaylistID: "playlist_id",
+	FilmID:     "film_id",
+	Position:   "position",
+	AddedAt:    "added_at",
+}
+
+var PlaylistFilmTableColumns = struct {
+	PlaylistID string
+	FilmID     string
+	Position   string
+	AddedAt    string
+}{
+	PlaylistID: "playlist_films.playlist_id",
+	FilmID:     "playlist_films.film_id",
+	Position:   "playlist_films.position",
+	AddedAt:    "playlist_films.added_at",
+}
+
+// Generated where
+
+var PlaylistFilmWhere = struct {
+	PlaylistID whereHelperint
+	FilmID     whereHelperint
+	Position   whereHelperint
+	AddedAt    whereHelpertime_Time
+}{
+	PlaylistID: whereHelperint{field: "\"playlist_films\".\"playlist_id\""},
+	FilmID:     whereHelperint{field: "\"playlist_films\".\"film_id\""},
+	Position:   whereHelperint{field: "\"playlist_films\".\"position\""},
+	AddedAt:    whereHelpertime_Time{field: "\"playlist_films\".\"added_at\""},
+}
+
+// PlaylistFilmRels is where relationship names are stored.
+var PlaylistFilmRels = struct {
+	Playlist string
+	Film     string
+}{
+	Playlist: "Playlist",
+	Film:     "Film",
+}
+
+// playlistFilmR is where relationships are stored.
+type playlistFilmR struct {
+	Playlist *Playlist `boil:"Playlist" json:"Playlist" toml:"Playlist" yaml:"Playlist"`
+	Film     *Film     `boil:"Film" json:"Film" toml:"Film" yaml:"Film"`
+}
+
+// NewStruct creates a new relationship struct
+func (*playlistFilmR) NewStruct() *playlistFilmR {
+	return &playlistFilmR{}
+}
+
+func (r *playlistFilmR) GetPlaylist() *Playlist {
+	if r == nil {
+		return nil
+	}
+	return r.Playlist
+}
+
+func (r *playlistFilmR) GetFilm() *Film {
+	if r == nil {
+		return nil
+	}
+	return r.Film
+}
+
+// playlistFilmL is where Load methods for each relationship are stored.
+type playlistFilmL struct{}
+
+var (
+	playlistFilmAllColumns            = []string{"playlist_id", "film_id", "position", "added_at"}
+	playlistFilmColumnsWithoutDefault = []string{"playlist_id", "film_id", "position"}
+	playlistFilmColumnsWithDefault    = []string{"added_at"}
+	playlistFilmPrimaryKeyColumns     = []string{"playlist_id", "film_id"}
+	playlistFilmGeneratedColumns      = []string{}
+)
+
+type (
+	// PlaylistFilmSlice is an alias for a slice of pointers to PlaylistFilm.
+	// This should almost always be used instead of []PlaylistFilm.
+	PlaylistFilmSlice []*PlaylistFilm
+	// PlaylistFilmHook is the signature for custom PlaylistFilm hook methods
+	PlaylistFilmHook func(context.Context, boil.ContextExecutor, *PlaylistFilm) error
+
+	playlistFilmQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	playlistFilmType                 = reflect.TypeOf(&PlaylistFilm{})
+	playlistFilmMapping              = queries.MakeStructMapping(playlistFilmType)
+	playlistFilmPrimaryKeyMapping, _ = queries.BindMapping(playlistFilmType, playlistFilmMapping, playlistFilmPrimaryKeyColumns)
+	playlistFilmInsertCacheMut       sync.RWMutex
+	playlistFilmInsertCache          = make(map[string]insertCache)
+	playlistFilmUpdateCacheMut       sync.RWMutex
+	playlistFilmUpdateCache          = make(map[string]updateCache)
+	playlistFilmUpsertCacheMut       sync.RWMutex
+	playlistFilmUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var playlistFilmAfterSelectHooks []PlaylistFilmHook
+
+var playlistFilmBeforeInsertHooks []PlaylistFilmHook
+var playlistFilmAfterInsertHooks []PlaylistFilmHook
+
+var playlistFilmBeforeUpdateHooks []PlaylistFilmHook
+var playlistFilmAfterUpdateHooks []PlaylistFilmHook
+
+var playlistFilmBeforeDeleteHooks []PlaylistFilmHook
+var playlistFilmAfterDeleteHooks []PlaylistFilmHook
+
+var playlistFilmBeforeUpsertHooks []PlaylistFilmHook
+var playlistFilmAfterUpsertHooks []PlaylistFilmHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PlaylistFilm) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistFilmAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PlaylistFilm) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistFilmBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PlaylistFilm) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistFilmAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PlaylistFilm) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistFilmBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PlaylistFilm) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistFilmAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PlaylistFilm) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistFilmBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PlaylistFilm) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistFilmAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PlaylistFilm) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistFilmBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PlaylistFilm) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playlistFilmAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPlaylistFilmHook registers your hook function for all future operations.
+func AddPlaylistFilmHook(hookPoint boil.HookPoint, playlistFilmHook PlaylistFilmHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		playlistFilmAfterSelectHooks = append(playlistFilmAfterSelectHooks, playlistFilmHook)
+	case boil.BeforeInsertHook:
+		playlistFilmBeforeInsertHooks = append(playlistFilmBeforeInsertHooks, playlistFilmHook)
+	case boil.AfterInsertHook:
+		playlistFilmAfterInsertHooks = append(playlistFilmAfterInsertHooks, playlistFilmHook)
+	case boil.BeforeUpdateHook:
+		playlistFilmBeforeUpdateHooks = append(playlistFilmBeforeUpdateHooks, playlistFilmHook)
+	case boil.AfterUpdateHook:
+		playlistFilmAfterUpdateHooks = append(playlistFilmAfterUpdateHooks, playlistFilmHook)
+	case boil.BeforeDeleteHook:
+		playlistFilmBeforeDeleteHooks = append(playlistFilmBeforeDeleteHooks, playlistFilmHook)
+	case boil.AfterDeleteHook:
+		playlistFilmAfterDeleteHooks = append(playlistFilmAfterDeleteHooks, playlistFilmHook)
+	case boil.BeforeUpsertHook:
+		playlistFilmBeforeUpsertHooks = append(playlistFilmBeforeUpsertHooks, playlistFilmHook)
+	case boil.AfterUpsertHook:
+		playlistFilmAfterUpsertHooks = append(playlistFilmAfterUpsertHooks, playlistFilmHook)
+	}
+}
+
+// One returns a single playlistFilm record from the query.
+func (q playlistFilmQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PlaylistFilm, error) {
+	o := &PlaylistFilm{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for playlist_films")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PlaylistFilm records from the query.
+func (q playlistFilmQuery) All(ctx context.Context, exec boil.ContextExecutor) (PlaylistFilmSlice, error) {
+	var o []*PlaylistFilm
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PlaylistFilm slice")
+	}
+
+	if len(playlistFilmAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PlaylistFilm records in the query.
+func (q playlistFilmQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count playlist_films rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q playlistFilmQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if playlist_films exists")
+	}
+
+	return count > 0, nil
+}
+
+// Playlist pointed to by the foreign key.
+func (o *PlaylistFilm) Playlist(mods ...qm.QueryMod) playlistQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PlaylistID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Playlists(queryMods...)
+}
+
+// Film pointed to by the foreign key.
+func (o *PlaylistFilm) Film(mods ...qm.QueryMod) filmQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FilmID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Films(queryMods...)
+}
+
+// LoadPlaylist allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (playlistFilmL) LoadPlaylist(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlaylistFilm interface{}, mods queries.Applicator) error {
+	var slice []*PlaylistFilm
+	var object *PlaylistFilm
+
+	if singular {
+		var ok bool
+		object, ok = maybePlaylistFilm.(*PlaylistFilm)
+		if !ok {
+			object = new(PlaylistFilm)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePlaylistFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePlaylistFilm))
+			}
+		}
+	} else {
+		s, ok := maybePlaylistFilm.(*[]*PlaylistFilm)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePlaylistFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePlaylistFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &playlistFilmR{}
+		}
+		args = append(args, object.PlaylistID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &playlistFilmR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PlaylistID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PlaylistID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`playlists`),
+		qm.WhereIn(`playlists.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Playlist")
+	}
+
+	var resultSlice []*Playlist
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Playlist")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for playlists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for playlists")
+	}
+
+	if len(playlistFilmAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Playlist = foreign
+		if foreign.R == nil {
+			foreign.R = &playlistR{}
+		}
+		foreign.R.PlaylistFilms = append(foreign.R.PlaylistFilms, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PlaylistID == foreign.ID {
+				local.R.Playlist = foreign
+				if foreign.R == nil {
+					foreign.R = &playlistR{}
+				}
+				foreign.R.PlaylistFilms = append(foreign.R.PlaylistFilms, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFilm allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (playlistFilmL) LoadFilm(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlaylistFilm interface{}, mods queries.Applicator) error {
+	var slice []*PlaylistFilm
+	var object *PlaylistFilm
+
+	if singular {
+		var ok bool
+		object, ok = maybePlaylistFilm.(*PlaylistFilm)
+		if !ok {
+			object = new(PlaylistFilm)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePlaylistFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePlaylistFilm))
+			}
+		}
+	} else {
+		s, ok := maybePlaylistFilm.(*[]*PlaylistFilm)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePlaylistFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePlaylistFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &playlistFilmR{}
+		}
+		args = append(args, object.FilmID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &playlistFilmR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FilmID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FilmID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`films`),
+		qm.WhereIn(`films.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Film")
+	}
+
+	var resultSlice []*Film
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Film")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+	}
+
+	if len(playlistFilmAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Film = foreign
+		if foreign.R == nil {
+			foreign.R = &filmR{}
+		}
+		foreign.R.PlaylistFilms = append(foreign.R.PlaylistFilms, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FilmID == foreign.ID {
+				local.R.Film = foreign
+				if foreign.R == nil {
+					foreign.R = &filmR{}
+				}
+				foreign.R.PlaylistFilms = append(foreign.R.PlaylistFilms, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPlaylist of the playlistFilm to the related item.
+// Sets o.R.Playlist to related.
+// Adds o to related.R.PlaylistFilms.
+func (o *PlaylistFilm) SetPlaylist(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Playlist) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"playlist_films\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"playlist_id"}),
+		strmangle.WhereClause("\"", "\"", 2, playlistFilmPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.PlaylistID, o.FilmID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PlaylistID = related.ID
+	if o.R == nil {
+		o.R = &playlistFilmR{
+			Playlist: related,
+		}
+	} else {
+		o.R.Playlist = related
+	}
+
+	if related.R == nil {
+		related.R = &playlistR{
+			PlaylistFilms: PlaylistFilmSlice{o},
+		}
+	} else {
+		related.R.PlaylistFilms = append(related.R.PlaylistFilms, o)
+	}
+
+	return nil
+}
+
+// SetFilm of the playlistFilm to the related item.
+// Sets o.R.Film to related.
+// Adds o to related.R.PlaylistFilms.
+func (o *PlaylistFilm) SetFilm(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Film) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"playlist_films\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+		strmangle.WhereClause("\"", "\"", 2, playlistFilmPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.PlaylistID, o.FilmID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FilmID = related.ID
+	if o.R == nil {
+		o.R = &playlistFilmR{
+			Film: related,
+		}
+	} else {
+		o.R.Film = related
+	}
+
+	if related.R == nil {
+		related.R = &filmR{
+			PlaylistFilms: PlaylistFilmSlice{o},
+		}
+	} else {
+		related.R.PlaylistFilms = append(related.R.PlaylistFilms, o)
+	}
+
+	return nil
+}
+
+// PlaylistFilms retrieves all the records using an executor.
+func PlaylistFilms(mods ...qm.QueryMod) playlistFilmQuery {
+	mods = append(mods, qm.From("\"playlist_films\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"playlist_films\".*"})
+	}
+
+	return playlistFilmQuery{q}
+}
+
+// FindPlaylistFilm retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPlaylistFilm(ctx context.Context, exec boil.ContextExecutor, playlistID int, filmID int, selectCols ...string) (*PlaylistFilm, error) {
+	playlistFilmObj := &PlaylistFilm{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"playlist_films\" where \"playlist_id\"=$1 AND \"film_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, playlistID, filmID)
+
+	err := q.Bind(ctx, exec, playlistFilmObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from playlist_films")
+	}
+
+	if err = playlistFilmObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return playlistFilmObj, err
+	}
+
+	return playlistFilmObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PlaylistFilm) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no playlist_films provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(playlistFilmColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	playlistFilmInsertCacheMut.RLock()
+	cache, cached := playlistFilmInsertCache[key]
+	playlistFilmInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			playlistFilmAllColumns,
+			playlistFilmColumnsWithDefault,
+			playlistFilmColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(playlistFilmType, playlistFilmMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(playlistFilmType, playlistFilmMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"playlist_films\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"playlist_films\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into playlist_films")
+	}
+
+	if !cached {
+		playlistFilmInsertCacheMut.Lock()
+		playlistFilmInsertCache[key] = cache
+		playlistFilmInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PlaylistFilm.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PlaylistFilm) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	playlistFilmUpdateCacheMut.RLock()
+	cache, cached := playlistFilmUpdateCache[key]
+	playlistFilmUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			playlistFilmAllColumns,
+			playlistFilmPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update playlist_films, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"playlist_films\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, playlistFilmPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(playlistFilmType, playlistFilmMapping, append(wl, playlistFilmPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update playlist_films row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for playlist_films")
+	}
+
+	if !cached {
+		playlistFilmUpdateCacheMut.Lock()
+		playlistFilmUpdateCache[key] = cache
+		playlistFilmUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q playlistFilmQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for playlist_films")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for playlist_films")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PlaylistFilmSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), playlistFilmPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"playlist_films\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, playlistFilmPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in playlistFilm slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all playlistFilm")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PlaylistFilm) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no playlist_films provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(playlistFilmColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	playlistFilmUpsertCacheMut.RLock()
+	cache, cached := playlistFilmUpsertCache[key]
+	playlistFilmUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			playlistFilmAllColumns,
+			playlistFilmColumnsWithDefault,
+			playlistFilmColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			playlistFilmAllColumns,
+			playlistFilmPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert playlist_films, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(playlistFilmPrimaryKeyColumns))
+			copy(conflict, playlistFilmPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"playlist_films\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(playlistFilmType, playlistFilmMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(playlistFilmType, playlistFilmMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert playlist_films")
+	}
+
+	if !cached {
+		playlistFilmUpsertCacheMut.Lock()
+		playlistFilmUpsertCache[key] = cache
+		playlistFilmUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PlaylistFilm record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PlaylistFilm) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PlaylistFilm provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), playlistFilmPrimaryKeyMapping)
+	sql := "DELETE FROM \"playlist_films\" WHERE \"playlist_id\"=$1 AND \"film_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from playlist_films")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for playlist_films")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q playlistFilmQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no playlistFilmQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from playlist_films")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for playlist_films")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PlaylistFilmSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(playlistFilmBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), playlistFilmPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"playlist_films\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, playlistFilmPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from playlistFilm slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for playlist_films")
+	}
+
+	if len(playlistFilmAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PlaylistFilm) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPlaylistFilm(ctx, exec, o.PlaylistID, o.FilmID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PlaylistFilmSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PlaylistFilmSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), playlistFilmPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"playlist_films\".* FROM \"playlist_films\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, playlistFilmPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PlaylistFilmSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PlaylistFilmExists checks if the PlaylistFilm row exists.
+func PlaylistFilmExists(ctx context.Context, exec boil.ContextExecutor, playlistID int, filmID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"playlist_films\" where \"playlist_id\"=$1 AND \"film_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, playlistID, filmID)
+	}
+	row := exec.QueryRowContext(ctx, sql, playlistID, filmID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if playlist_films exists")
+	}
+
+	return exists, nil
+}
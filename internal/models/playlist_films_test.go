@@ -0,0 +1,941 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPlaylistFilms(t *testing.T) {
+	t.Parallel()
+
+	query := PlaylistFilms()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPlaylistFilmsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistFilm{}
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PlaylistFilms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPlaylistFilmsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistFilm{}
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PlaylistFilms().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PlaylistFilms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPlaylistFilmsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistFilm{}
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PlaylistFilmSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PlaylistFilms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPlaylistFilmsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistFilm{}
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PlaylistFilmExists(ctx, tx, o.PlaylistID, o.FilmID)
+	if err != nil {
+		t.Errorf("Unable to check if PlaylistFilm exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PlaylistFilmExists to return true, but got false.")
+	}
+}
+
+func testPlaylistFilmsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistFilm{}
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	playlistFilmFound, err := FindPlaylistFilm(ctx, tx, o.PlaylistID, o.FilmID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if playlistFilmFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPlaylistFilmsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistFilm{}
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PlaylistFilms().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPlaylistFilmsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistFilm{}
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PlaylistFilms().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPlaylistFilmsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	playlistFilmOne := &PlaylistFilm{}
+	playlistFilmTwo := &PlaylistFilm{}
+	if err = randomize.Struct(seed, playlistFilmOne, playlistFilmDBTypes, false, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+	if err = randomize.Struct(seed, playlistFilmTwo, playlistFilmDBTypes, false, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = playlistFilmOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = playlistFilmTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PlaylistFilms().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPlaylistFilmsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	playlistFilmOne := &PlaylistFilm{}
+	playlistFilmTwo := &PlaylistFilm{}
+	if err = randomize.Struct(seed, playlistFilmOne, playlistFilmDBTypes, false, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+	if err = randomize.Struct(seed, playlistFilmTwo, playlistFilmDBTypes, false, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = playlistFilmOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = playlistFilmTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaylistFilms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func playlistFilmBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistFilm) error {
+	*o = PlaylistFilm{}
+	return nil
+}
+
+func playlistFilmAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistFilm) error {
+	*o = PlaylistFilm{}
+	return nil
+}
+
+func playlistFilmAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistFilm) error {
+	*o = PlaylistFilm{}
+	return nil
+}
+
+func playlistFilmBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistFilm) error {
+	*o = PlaylistFilm{}
+	return nil
+}
+
+func playlistFilmAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistFilm) error {
+	*o = PlaylistFilm{}
+	return nil
+}
+
+func playlistFilmBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistFilm) error {
+	*o = PlaylistFilm{}
+	return nil
+}
+
+func playlistFilmAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistFilm) error {
+	*o = PlaylistFilm{}
+	return nil
+}
+
+func playlistFilmBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistFilm) error {
+	*o = PlaylistFilm{}
+	return nil
+}
+
+func playlistFilmAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PlaylistFilm) error {
+	*o = PlaylistFilm{}
+	return nil
+}
+
+func testPlaylistFilmsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PlaylistFilm{}
+	o := &PlaylistFilm{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm object: %s", err)
+	}
+
+	AddPlaylistFilmHook(boil.BeforeInsertHook, playlistFilmBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	playlistFilmBeforeInsertHooks = []PlaylistFilmHook{}
+
+	AddPlaylistFilmHook(boil.AfterInsertHook, playlistFilmAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	playlistFilmAfterInsertHooks = []PlaylistFilmHook{}
+
+	AddPlaylistFilmHook(boil.AfterSelectHook, playlistFilmAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	playlistFilmAfterSelectHooks = []PlaylistFilmHook{}
+
+	AddPlaylistFilmHook(boil.BeforeUpdateHook, playlistFilmBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	playlistFilmBeforeUpdateHooks = []PlaylistFilmHook{}
+
+	AddPlaylistFilmHook(boil.AfterUpdateHook, playlistFilmAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	playlistFilmAfterUpdateHooks = []PlaylistFilmHook{}
+
+	AddPlaylistFilmHook(boil.BeforeDeleteHook, playlistFilmBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	playlistFilmBeforeDeleteHooks = []PlaylistFilmHook{}
+
+	AddPlaylistFilmHook(boil.AfterDeleteHook, playlistFilmAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	playlistFilmAfterDeleteHooks = []PlaylistFilmHook{}
+
+	AddPlaylistFilmHook(boil.BeforeUpsertHook, playlistFilmBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	playlistFilmBeforeUpsertHooks = []PlaylistFilmHook{}
+
+	AddPlaylistFilmHook(boil.AfterUpsertHook, playlistFilmAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	playlistFilmAfterUpsertHooks = []PlaylistFilmHook{}
+}
+
+func testPlaylistFilmsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistFilm{}
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaylistFilms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPlaylistFilmsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistFilm{}
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(playlistFilmColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaylistFilms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPlaylistFilmToOnePlaylistUsingPlaylist(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PlaylistFilm
+	var foreign Playlist
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, playlistFilmDBTypes, false, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, playlistDBTypes, false, playlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Playlist struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.PlaylistID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Playlist().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PlaylistFilmSlice{&local}
+	if err = local.L.LoadPlaylist(ctx, tx, false, (*[]*PlaylistFilm)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Playlist == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Playlist = nil
+	if err = local.L.LoadPlaylist(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Playlist == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testPlaylistFilmToOneFilmUsingFilm(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PlaylistFilm
+	var foreign Film
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, playlistFilmDBTypes, false, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.FilmID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Film().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PlaylistFilmSlice{&local}
+	if err = local.L.LoadFilm(ctx, tx, false, (*[]*PlaylistFilm)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Film = nil
+	if err = local.L.LoadFilm(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testPlaylistFilmToOneSetOpPlaylistUsingPlaylist(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PlaylistFilm
+	var b, c Playlist
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, playlistFilmDBTypes, false, strmangle.SetComplement(playlistFilmPrimaryKeyColumns, playlistFilmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, playlistDBTypes, false, strmangle.SetComplement(playlistPrimaryKeyColumns, playlistColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, playlistDBTypes, false, strmangle.SetComplement(playlistPrimaryKeyColumns, playlistColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Playlist{&b, &c} {
+		err = a.SetPlaylist(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Playlist != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PlaylistFilms[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.PlaylistID != x.ID {
+			t.Error("foreign key was wrong value", a.PlaylistID)
+		}
+
+		if exists, err := PlaylistFilmExists(ctx, tx, a.PlaylistID, a.FilmID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testPlaylistFilmToOneSetOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PlaylistFilm
+	var b, c Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, playlistFilmDBTypes, false, strmangle.SetComplement(playlistFilmPrimaryKeyColumns, playlistFilmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Film{&b, &c} {
+		err = a.SetFilm(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Film != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PlaylistFilms[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.FilmID != x.ID {
+			t.Error("foreign key was wrong value", a.FilmID)
+		}
+
+		if exists, err := PlaylistFilmExists(ctx, tx, a.PlaylistID, a.FilmID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testPlaylistFilmsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistFilm{}
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPlaylistFilmsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistFilm{}
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PlaylistFilmSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPlaylistFilmsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistFilm{}
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PlaylistFilms().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	playlistFilmDBTypes = map[string]string{`PlaylistID`: `integer`, `FilmID`: `integer`, `Position`: `integer`, `AddedAt`: `timestamp with time zone`}
+	_                   = bytes.MinRead
+)
+
+func testPlaylistFilmsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(playlistFilmPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(playlistFilmAllColumns) == len(playlistFilmPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistFilm{}
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaylistFilms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPlaylistFilmsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(playlistFilmAllColumns) == len(playlistFilmPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaylistFilm{}
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaylistFilms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, playlistFilmDBTypes, true, playlistFilmPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(playlistFilmAllColumns, playlistFilmPrimaryKeyColumns) {
+		fields = playlistFilmAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			playlistFilmAllColumns,
+			playlistFilmPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PlaylistFilmSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPlaylistFilmsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(playlistFilmAllColumns) == len(playlistFilmPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PlaylistFilm{}
+	if err = randomize.Struct(seed, &o, playlistFilmDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PlaylistFilm: %s", err)
+	}
+
+	count, err := PlaylistFilms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, playlistFilmDBTypes, false, playlistFilmPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PlaylistFilm struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PlaylistFilm: %s", err)
+	}
+
+	count, err = PlaylistFilms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,837 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPostRevisions(t *testing.T) {
+	t.Parallel()
+
+	query := PostRevisions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPostRevisionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostRevision{}
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PostRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPostRevisionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostRevision{}
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PostRevisions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PostRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPostRevisionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostRevision{}
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PostRevisionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PostRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPostRevisionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostRevision{}
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PostRevisionExists(ctx, tx, o.PostID, o.RevisedAt)
+	if err != nil {
+		t.Errorf("Unable to check if PostRevision exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PostRevisionExists to return true, but got false.")
+	}
+}
+
+func testPostRevisionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostRevision{}
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	postRevisionFound, err := FindPostRevision(ctx, tx, o.PostID, o.RevisedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if postRevisionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPostRevisionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostRevision{}
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PostRevisions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPostRevisionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostRevision{}
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PostRevisions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPostRevisionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	postRevisionOne := &PostRevision{}
+	postRevisionTwo := &PostRevision{}
+	if err = randomize.Struct(seed, postRevisionOne, postRevisionDBTypes, false, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+	if err = randomize.Struct(seed, postRevisionTwo, postRevisionDBTypes, false, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = postRevisionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = postRevisionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PostRevisions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPostRevisionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	postRevisionOne := &PostRevision{}
+	postRevisionTwo := &PostRevision{}
+	if err = randomize.Struct(seed, postRevisionOne, postRevisionDBTypes, false, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+	if err = randomize.Struct(seed, postRevisionTwo, postRevisionDBTypes, false, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = postRevisionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = postRevisionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func postRevisionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PostRevision) error {
+	*o = PostRevision{}
+	return nil
+}
+
+func postRevisionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PostRevision) error {
+	*o = PostRevision{}
+	return nil
+}
+
+func postRevisionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PostRevision) error {
+	*o = PostRevision{}
+	return nil
+}
+
+func postRevisionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PostRevision) error {
+	*o = PostRevision{}
+	return nil
+}
+
+func postRevisionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PostRevision) error {
+	*o = PostRevision{}
+	return nil
+}
+
+func postRevisionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PostRevision) error {
+	*o = PostRevision{}
+	return nil
+}
+
+func postRevisionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PostRevision) error {
+	*o = PostRevision{}
+	return nil
+}
+
+func postRevisionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PostRevision) error {
+	*o = PostRevision{}
+	return nil
+}
+
+func postRevisionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PostRevision) error {
+	*o = PostRevision{}
+	return nil
+}
+
+func testPostRevisionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PostRevision{}
+	o := &PostRevision{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PostRevision object: %s", err)
+	}
+
+	AddPostRevisionHook(boil.BeforeInsertHook, postRevisionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	postRevisionBeforeInsertHooks = []PostRevisionHook{}
+
+	AddPostRevisionHook(boil.AfterInsertHook, postRevisionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	postRevisionAfterInsertHooks = []PostRevisionHook{}
+
+	AddPostRevisionHook(boil.AfterSelectHook, postRevisionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	postRevisionAfterSelectHooks = []PostRevisionHook{}
+
+	AddPostRevisionHook(boil.BeforeUpdateHook, postRevisionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	postRevisionBeforeUpdateHooks = []PostRevisionHook{}
+
+	AddPostRevisionHook(boil.AfterUpdateHook, postRevisionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	postRevisionAfterUpdateHooks = []PostRevisionHook{}
+
+	AddPostRevisionHook(boil.BeforeDeleteHook, postRevisionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	postRevisionBeforeDeleteHooks = []PostRevisionHook{}
+
+	AddPostRevisionHook(boil.AfterDeleteHook, postRevisionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	postRevisionAfterDeleteHooks = []PostRevisionHook{}
+
+	AddPostRevisionHook(boil.BeforeUpsertHook, postRevisionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	postRevisionBeforeUpsertHooks = []PostRevisionHook{}
+
+	AddPostRevisionHook(boil.AfterUpsertHook, postRevisionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	postRevisionAfterUpsertHooks = []PostRevisionHook{}
+}
+
+func testPostRevisionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostRevision{}
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPostRevisionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostRevision{}
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(postRevisionColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPostRevisionToOnePostUsingPost(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PostRevision
+	var foreign Post
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, postRevisionDBTypes, false, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, postDBTypes, false, postColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Post struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.PostID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Post().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PostRevisionSlice{&local}
+	if err = local.L.LoadPost(ctx, tx, false, (*[]*PostRevision)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Post == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Post = nil
+	if err = local.L.LoadPost(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Post == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testPostRevisionToOneSetOpPostUsingPost(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PostRevision
+	var b, c Post
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postRevisionDBTypes, false, strmangle.SetComplement(postRevisionPrimaryKeyColumns, postRevisionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Post{&b, &c} {
+		err = a.SetPost(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Post != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PostRevisions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.PostID != x.ID {
+			t.Error("foreign key was wrong value", a.PostID)
+		}
+
+		if exists, err := PostRevisionExists(ctx, tx, a.PostID, a.RevisedAt); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testPostRevisionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostRevision{}
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPostRevisionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostRevision{}
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PostRevisionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPostRevisionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostRevision{}
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PostRevisions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	postRevisionDBTypes = map[string]string{`PostID`: `integer`, `Body`: `text`, `RevisedAt`: `timestamp with time zone`}
+	_                   = bytes.MinRead
+)
+
+func testPostRevisionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(postRevisionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(postRevisionAllColumns) == len(postRevisionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostRevision{}
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPostRevisionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(postRevisionAllColumns) == len(postRevisionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostRevision{}
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, postRevisionDBTypes, true, postRevisionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(postRevisionAllColumns, postRevisionPrimaryKeyColumns) {
+		fields = postRevisionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			postRevisionAllColumns,
+			postRevisionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PostRevisionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPostRevisionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(postRevisionAllColumns) == len(postRevisionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PostRevision{}
+	if err = randomize.Struct(seed, &o, postRevisionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PostRevision: %s", err)
+	}
+
+	count, err := PostRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, postRevisionDBTypes, false, postRevisionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PostRevision struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PostRevision: %s", err)
+	}
+
+	count, err = PostRevisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1885 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// Proposal is an object representing the database table.
+type Proposal struct {
+	ID                  int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Kind                string      `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	FilmID              null.Int    `boil:"film_id" json:"film_id,omitempty" toml:"film_id" yaml:"film_id,omitempty"`
+	SeriesID            null.Int    `boil:"series_id" json:"series_id,omitempty" toml:"series_id" yaml:"series_id,omitempty"`
+	SeasonNumber        null.Int    `boil:"season_number" json:"season_number,omitempty" toml:"season_number" yaml:"season_number,omitempty"`
+	EpisodeNumber       null.Int    `boil:"episode_number" json:"episode_number,omitempty" toml:"episode_number" yaml:"episode_number,omitempty"`
+	Changes             types.JSON  `boil:"changes" json:"changes" toml:"changes" yaml:"changes"`
+	ProposedBy          int         `boil:"proposed_by" json:"proposed_by" toml:"proposed_by" yaml:"proposed_by"`
+	ProposedAt          time.Time   `boil:"proposed_at" json:"proposed_at" toml:"proposed_at" yaml:"proposed_at"`
+	Status              string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ReviewedBy          null.Int    `boil:"reviewed_by" json:"reviewed_by,omitempty" toml:"reviewed_by" yaml:"reviewed_by,omitempty"`
+	ReviewedAt          null.Time   `boil:"reviewed_at" json:"reviewed_at,omitempty" toml:"reviewed_at" yaml:"reviewed_at,omitempty"`
+	EditSummary         null.String `boil:"edit_summary" json:"edit_summary,omitempty" toml:"edit_summary" yaml:"edit_summary,omitempty"`
+	RequestID           null.String `boil:"request_id" json:"request_id,omitempty" toml:"request_id" yaml:"request_id,omitempty"`
+	ClientIP            null.String `boil:"client_ip" json:"client_ip,omitempty" toml:"client_ip" yaml:"client_ip,omitempty"`
+	UserAgent           null.String `boil:"user_agent" json:"user_agent,omitempty" toml:"user_agent" yaml:"user_agent,omitempty"`
+	BaseContributedAt   null.Time   `boil:"base_contributed_at" json:"base_contributed_at,omitempty" toml:"base_contributed_at" yaml:"base_contributed_at,omitempty"`
+	RevertContributedAt null.Time   `boil:"revert_contributed_at" json:"revert_contributed_at,omitempty" toml:"revert_contributed_at" yaml:"revert_contributed_at,omitempty"`
+
+	R *proposalR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L proposalL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ProposalColumns = struct {
+	ID                  string
+	Kind                string
+	FilmID              string
+	SeriesID            string
+	SeasonNumber        string
+	EpisodeNumber       string
+	Changes             string
+	ProposedBy          string
+	ProposedAt          string
+	Status              string
+	ReviewedBy          string
+	ReviewedAt          string
+	EditSummary         string
+	RequestID           string
+	ClientIP            string
+	UserAgent           string
+	BaseContributedAt   string
+	RevertContributedAt string
+}{
+	ID:                  "id",
+	Kind:                "kind",
+	FilmID:              "film_id",
+	SeriesID:            "series_id",
+	SeasonNumber:        "season_number",
+	EpisodeNumber:       "episode_number",
+	Changes:             "changes",
+	ProposedBy:          "proposed_by",
+	ProposedAt:          "proposed_at",
+	Status:              "status",
+	ReviewedBy:          "reviewed_by",
+	ReviewedAt:          "reviewed_at",
+	EditSummary:         "edit_summary",
+	RequestID:           "request_id",
+	ClientIP:            "client_ip",
+	UserAgent:           "user_agent",
+	BaseContributedAt:   "base_contributed_at",
+	RevertContributedAt: "revert_contributed_at",
+}
+
+var ProposalTableColumns = struct {
+	ID                  string
+	Kind                string
+	FilmID              string
+	SeriesID            string
+	SeasonNumber        string
+	EpisodeNumber       string
+	Changes             string
+	ProposedBy          string
+	ProposedAt          string
+	Status              string
+	ReviewedBy          string
+	ReviewedAt          string
+	EditSummary         string
+	RequestID           string
+	ClientIP            string
+	UserAgent           string
+	BaseContributedAt   string
+	RevertContributedAt string
+}{
+	ID:                  "proposals.id",
+	Kind:                "proposals.kind",
+	FilmID:              "proposals.film_id",
+	SeriesID:            "proposals.series_id",
+	SeasonNumber:        "proposals.season_number",
+	EpisodeNumber:       "proposals.episode_number",
+	Changes:             "proposals.changes",
+	ProposedBy:          "proposals.proposed_by",
+	ProposedAt:          "proposals.proposed_at",
+	Status:              "proposals.status",
+	ReviewedBy:          "proposals.reviewed_by",
+	ReviewedAt:          "proposals.reviewed_at",
+	EditSummary:         "proposals.edit_summary",
+	RequestID:           "proposals.request_id",
+	ClientIP:            "proposals.client_ip",
+	UserAgent:           "proposals.user_agent",
+	BaseContributedAt:   "proposals.base_contributed_at",
+	RevertContributedAt: "proposals.revert_contributed_at",
+}
+
+// Generated where
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var ProposalWhere = struct {
+	ID                  whereHelperint
+	Kind                whereHelperstring
+	FilmID              whereHelpernull_Int
+	SeriesID            whereHelpernull_Int
+	SeasonNumber        whereHelpernull_Int
+	EpisodeNumber       whereHelpernull_Int
+	Changes             whereHelpertypes_JSON
+	ProposedBy          whereHelperint
+	ProposedAt          whereHelpertime_Time
+	Status              whereHelperstring
+	ReviewedBy          whereHelpernull_Int
+	ReviewedAt          whereHelpernull_Time
+	EditSummary         whereHelpernull_String
+	RequestID           whereHelpernull_String
+	ClientIP            whereHelpernull_String
+	UserAgent           whereHelpernull_String
+	BaseContributedAt   whereHelpernull_Time
+	RevertContributedAt whereHelpernull_Time
+}{
+	ID:                  whereHelperint{field: "\"proposals\".\"id\""},
+	Kind:                whereHelperstring{field: "\"proposals\".\"kind\""},
+	FilmID:              whereHelpernull_Int{field: "\"proposals\".\"film_id\""},
+	SeriesID:            whereHelpernull_Int{field: "\"proposals\".\"series_id\""},
+	SeasonNumber:        whereHelpernull_Int{field: "\"proposals\".\"season_number\""},
+	EpisodeNumber:       whereHelpernull_Int{field: "\"proposals\".\"episode_number\""},
+	Changes:             whereHelpertypes_JSON{field: "\"proposals\".\"changes\""},
+	ProposedBy:          whereHelperint{field: "\"proposals\".\"proposed_by\""},
+	ProposedAt:          whereHelpertime_Time{field: "\"proposals\".\"proposed_at\""},
+	Status:              whereHelperstring{field: "\"proposals\".\"status\""},
+	ReviewedBy:          whereHelpernull_Int{field: "\"proposals\".\"reviewed_by\""},
+	ReviewedAt:          whereHelpernull_Time{field: "\"proposals\".\"reviewed_at\""},
+	EditSummary:         whereHelpernull_String{field: "\"proposals\".\"edit_summary\""},
+	RequestID:           whereHelpernull_String{field: "\"proposals\".\"request_id\""},
+	ClientIP:            whereHelpernull_String{field: "\"proposals\".\"client_ip\""},
+	UserAgent:           whereHelpernull_String{field: "\"proposals\".\"user_agent\""},
+	BaseContributedAt:   whereHelpernull_Time{field: "\"proposals\".\"base_contributed_at\""},
+	RevertContributedAt: whereHelpernull_Time{field: "\"proposals\".\"revert_contributed_at\""},
+}
+
+// ProposalRels is where relationship names are stored.
+var ProposalRels = struct {
+	Film           string
+	Series         string
+	ProposedByUser string
+	ReviewedByUser string
+}{
+	Film:           "Film",
+	Series:         "Series",
+	ProposedByUser: "ProposedByUser",
+	ReviewedByUser: "ReviewedByUser",
+}
+
+// proposalR is where relationships are stored.
+type proposalR struct {
+	Film           *Film   `boil:"Film" json:"Film" toml:"Film" yaml:"Film"`
+	Series         *Series `boil:"Series" json:"Series" toml:"Series" yaml:"Series"`
+	ProposedByUser *User   `boil:"ProposedByUser" json:"ProposedByUser" toml:"ProposedByUser" yaml:"ProposedByUser"`
+	ReviewedByUser *User   `boil:"ReviewedByUser" json:"ReviewedByUser" toml:"ReviewedByUser" yaml:"ReviewedByUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*proposalR) NewStruct() *proposalR {
+	return &proposalR{}
+}
+
+func (r *proposalR) GetFilm() *Film {
+	if r == nil {
+		return nil
+	}
+	return r.Film
+}
+
+func (r *proposalR) GetSeries() *Series {
+	if r == nil {
+		return nil
+	}
+	return r.Series
+}
+
+func (r *proposalR) GetProposedByUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.ProposedByUser
+}
+
+func (r *proposalR) GetReviewedByUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.ReviewedByUser
+}
+
+// proposalL is where Load methods for each relationship are stored.
+type proposalL struct{}
+
+var (
+	proposalAllColumns            = []string{"id", "kind", "film_id", "series_id", "season_number", "episode_number", "changes", "proposed_by", "proposed_at", "status", "reviewed_by", "reviewed_at", "edit_summary", "request_id", "client_ip", "user_agent", "base_contributed_at", "revert_contributed_at"}
+	proposalColumnsWithoutDefault = []string{"kind", "changes", "proposed_by"}
+	proposalColumnsWithDefault    = []string{"id", "film_id", "series_id", "season_number", "episode_number", "proposed_at", "status", "reviewed_by", "reviewed_at", "edit_summary", "request_id", "client_ip", "user_agent", "base_contributed_at", "revert_contributed_at"}
+	proposalPrimaryKeyColumns     = []string{"id"}
+	proposalGeneratedColumns      = []string{}
+)
+
+type (
+	// ProposalSlice is an alias for a slice of pointers to Proposal.
+	// This should almost always be used instead of []Proposal.
+	ProposalSlice []*Proposal
+	// ProposalHook is the signature for custom Proposal hook methods
+	ProposalHook func(context.Context, boil.ContextExecutor, *Proposal) error
+
+	proposalQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	proposalType                 = reflect.TypeOf(&Proposal{})
+	proposalMapping              = queries.MakeStructMapping(proposalType)
+	proposalPrimaryKeyMapping, _ = queries.BindMapping(proposalType, proposalMapping, proposalPrimaryKeyColumns)
+	proposalInsertCacheMut       sync.RWMutex
+	proposalInsertCache          = make(map[string]insertCache)
+	proposalUpdateCacheMut       sync.RWMutex
+	proposalUpdateCache          = make(map[string]updateCache)
+	proposalUpsertCacheMut       sync.RWMutex
+	proposalUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var proposalAfterSelectHooks []ProposalHook
+
+var proposalBeforeInsertHooks []ProposalHook
+var proposalAfterInsertHooks []ProposalHook
+
+var proposalBeforeUpdateHooks []ProposalHook
+var proposalAfterUpdateHooks []ProposalHook
+
+var proposalBeforeDeleteHooks []ProposalHook
+var proposalAfterDeleteHooks []ProposalHook
+
+var proposalBeforeUpsertHooks []ProposalHook
+var proposalAfterUpsertHooks []ProposalHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Proposal) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range proposalAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Proposal) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range proposalBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Proposal) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range proposalAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Proposal) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range proposalBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Proposal) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range proposalAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Proposal) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range proposalBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Proposal) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range proposalAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Proposal) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range proposalBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Proposal) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range proposalAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddProposalHook registers your hook function for all future operations.
+func AddProposalHook(hookPoint boil.HookPoint, proposalHook ProposalHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		proposalAfterSelectHooks = append(proposalAfterSelectHooks, proposalHook)
+	case boil.BeforeInsertHook:
+		proposalBeforeInsertHooks = append(proposalBeforeInsertHooks, proposalHook)
+	case boil.AfterInsertHook:
+		proposalAfterInsertHooks = append(proposalAfterInsertHooks, proposalHook)
+	case boil.BeforeUpdateHook:
+		proposalBeforeUpdateHooks = append(proposalBeforeUpdateHooks, proposalHook)
+	case boil.AfterUpdateHook:
+		proposalAfterUpdateHooks = append(proposalAfterUpdateHooks, proposalHook)
+	case boil.BeforeDeleteHook:
+		proposalBeforeDeleteHooks = append(proposalBeforeDeleteHooks, proposalHook)
+	case boil.AfterDeleteHook:
+		proposalAfterDeleteHooks = append(proposalAfterDeleteHooks, proposalHook)
+	case boil.BeforeUpsertHook:
+		proposalBeforeUpsertHooks = append(proposalBeforeUpsertHooks, proposalHook)
+	case boil.AfterUpsertHook:
+		proposalAfterUpsertHooks = append(proposalAfterUpsertHooks, proposalHook)
+	}
+}
+
+// One returns a single proposal record from the query.
+func (q proposalQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Proposal, error) {
+	o := &Proposal{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for proposals")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Proposal records from the query.
+func (q proposalQuery) All(ctx context.Context, exec boil.ContextExecutor) (ProposalSlice, error) {
+	var o []*Proposal
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Proposal slice")
+	}
+
+	if len(proposalAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Proposal records in the query.
+func (q proposalQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count proposals rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q proposalQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if proposals exists")
+	}
+
+	return count > 0, nil
+}
+
+// Film pointed to by the foreign key.
+func (o *Proposal) Film(mods ...qm.QueryMod) filmQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FilmID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Films(queryMods...)
+}
+
+// Series pointed to by the foreign key.
+func (o *Proposal) Series(mods ...qm.QueryMod) seriesQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SeriesID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Serieses(queryMods...)
+}
+
+// ProposedByUser pointed to by the foreign key.
+func (o *Proposal) ProposedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ProposedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// ReviewedByUser pointed to by the foreign key.
+func (o *Proposal) ReviewedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ReviewedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadFilm allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (proposalL) LoadFilm(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProposal interface{}, mods queries.Applicator) error {
+	var slice []*Proposal
+	var object *Proposal
+
+	if singular {
+		var ok bool
+		object, ok = maybeProposal.(*Proposal)
+		if !ok {
+			object = new(Proposal)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProposal))
+			}
+		}
+	} else {
+		s, ok := maybeProposal.(*[]*Proposal)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProposal))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &proposalR{}
+		}
+		if !queries.IsNil(object.FilmID) {
+			args = append(args, object.FilmID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &proposalR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.FilmID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.FilmID) {
+				args = append(args, obj.FilmID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`films`),
+		qm.WhereIn(`films.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Film")
+	}
+
+	var resultSlice []*Film
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Film")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+	}
+
+	if len(proposalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Film = foreign
+		if foreign.R == nil {
+			foreign.R = &filmR{}
+		}
+		foreign.R.Proposals = append(foreign.R.Proposals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.FilmID, foreign.ID) {
+				local.R.Film = foreign
+				if foreign.R == nil {
+					foreign.R = &filmR{}
+				}
+				foreign.R.Proposals = append(foreign.R.Proposals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (proposalL) LoadSeries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProposal interface{}, mods queries.Applicator) error {
+	var slice []*Proposal
+	var object *Proposal
+
+	if singular {
+		var ok bool
+		object, ok = maybeProposal.(*Proposal)
+		if !ok {
+			object = new(Proposal)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProposal))
+			}
+		}
+	} else {
+		s, ok := maybeProposal.(*[]*Proposal)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProposal))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &proposalR{}
+		}
+		if !queries.IsNil(object.SeriesID) {
+			args = append(args, object.SeriesID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &proposalR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.SeriesID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.SeriesID) {
+				args = append(args, obj.SeriesID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`serieses`),
+		qm.WhereIn(`serieses.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Series")
+	}
+
+	var resultSlice []*Series
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Series")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for serieses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for serieses")
+	}
+
+	if len(proposalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Series = foreign
+		if foreign.R == nil {
+			foreign.R = &seriesR{}
+		}
+		foreign.R.SeriesProposals = append(foreign.R.SeriesProposals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.SeriesID, foreign.ID) {
+				local.R.Series = foreign
+				if foreign.R == nil {
+					foreign.R = &seriesR{}
+				}
+				foreign.R.SeriesProposals = append(foreign.R.SeriesProposals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProposedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (proposalL) LoadProposedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProposal interface{}, mods queries.Applicator) error {
+	var slice []*Proposal
+	var object *Proposal
+
+	if singular {
+		var ok bool
+		object, ok = maybeProposal.(*Proposal)
+		if !ok {
+			object = new(Proposal)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProposal))
+			}
+		}
+	} else {
+		s, ok := maybeProposal.(*[]*Proposal)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProposal))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &proposalR{}
+		}
+		args = append(args, object.ProposedBy)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &proposalR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ProposedBy {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ProposedBy)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(proposalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ProposedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ProposedByProposals = append(foreign.R.ProposedByProposals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ProposedBy == foreign.ID {
+				local.R.ProposedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ProposedByProposals = append(foreign.R.ProposedByProposals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReviewedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (proposalL) LoadReviewedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProposal interface{}, mods queries.Applicator) error {
+	var slice []*Proposal
+	var object *Proposal
+
+	if singular {
+		var ok bool
+		object, ok = maybeProposal.(*Proposal)
+		if !ok {
+			object = new(Proposal)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProposal))
+			}
+		}
+	} else {
+		s, ok := maybeProposal.(*[]*Proposal)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProposal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProposal))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &proposalR{}
+		}
+		if !queries.IsNil(object.ReviewedBy) {
+			args = append(args, object.ReviewedBy)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &proposalR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ReviewedBy) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ReviewedBy) {
+				args = append(args, obj.ReviewedBy)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(proposalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ReviewedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ReviewedByProposals = append(foreign.R.ReviewedByProposals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ReviewedBy, foreign.ID) {
+				local.R.ReviewedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ReviewedByProposals = append(foreign.R.ReviewedByProposals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetFilm of the proposal to the related item.
+// Sets o.R.Film to related.
+// Adds o to related.R.Proposals.
+func (o *Proposal) SetFilm(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Film) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"proposals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+		strmangle.WhereClause("\"", "\"", 2, proposalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.FilmID, related.ID)
+	if o.R == nil {
+		o.R = &proposalR{
+			Film: related,
+		}
+	} else {
+		o.R.Film = related
+	}
+
+	if related.R == nil {
+		related.R = &filmR{
+			Proposals: ProposalSlice{o},
+		}
+	} else {
+		related.R.Proposals = append(related.R.Proposals, o)
+	}
+
+	return nil
+}
+
+// RemoveFilm relationship.
+// Sets o.R.Film to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Proposal) RemoveFilm(ctx context.Context, exec boil.ContextExecutor, related *Film) error {
+	var err error
+
+	queries.SetScanner(&o.FilmID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Film = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Proposals {
+		if queries.Equal(o.FilmID, ri.FilmID) {
+			continue
+		}
+
+		ln := len(related.R.Proposals)
+		if ln > 1 && i < ln-1 {
+			related.R.Proposals[i] = related.R.Proposals[ln-1]
+		}
+		related.R.Proposals = related.R.Proposals[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetSeries of the proposal to the related item.
+// Sets o.R.Series to related.
+// Adds o to related.R.SeriesProposals.
+func (o *Proposal) SetSeries(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Series) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"proposals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+		strmangle.WhereClause("\"", "\"", 2, proposalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SeriesID, related.ID)
+	if o.R == nil {
+		o.R = &proposalR{
+			Series: related,
+		}
+	} else {
+		o.R.Series = related
+	}
+
+	if related.R == nil {
+		related.R = &seriesR{
+			SeriesProposals: ProposalSlice{o},
+		}
+	} else {
+		related.R.SeriesProposals = append(related.R.SeriesProposals, o)
+	}
+
+	return nil
+}
+
+// RemoveSeries relationship.
+// Sets o.R.Series to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Proposal) RemoveSeries(ctx context.Context, exec boil.ContextExecutor, related *Series) error {
+	var err error
+
+	queries.SetScanner(&o.SeriesID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Series = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.SeriesProposals {
+		if queries.Equal(o.SeriesID, ri.SeriesID) {
+			continue
+		}
+
+		ln := len(related.R.SeriesProposals)
+		if ln > 1 && i < ln-1 {
+			related.R.SeriesProposals[i] = related.R.SeriesProposals[ln-1]
+		}
+		related.R.SeriesProposals = related.R.SeriesProposals[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetProposedByUser of the proposal to the related item.
+// Sets o.R.ProposedByUser to related.
+// Adds o to related.R.ProposedByProposals.
+func (o *Proposal) SetProposedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"proposals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"proposed_by"}),
+		strmangle.WhereClause("\"", "\"", 2, proposalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ProposedBy = related.ID
+	if o.R == nil {
+		o.R = &proposalR{
+			ProposedByUser: related,
+		}
+	} else {
+		o.R.ProposedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ProposedByProposals: ProposalSlice{o},
+		}
+	} else {
+		related.R.ProposedByProposals = append(related.R.ProposedByProposals, o)
+	}
+
+	return nil
+}
+
+// SetReviewedByUser of the proposal to the related item.
+// Sets o.R.ReviewedByUser to related.
+// Adds o to related.R.ReviewedByProposals.
+func (o *Proposal) SetReviewedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"proposals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"reviewed_by"}),
+		strmangle.WhereClause("\"", "\"", 2, proposalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ReviewedBy, related.ID)
+	if o.R == nil {
+		o.R = &proposalR{
+			ReviewedByUser: related,
+		}
+	} else {
+		o.R.ReviewedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ReviewedByProposals: ProposalSlice{o},
+		}
+	} else {
+		related.R.ReviewedByProposals = append(related.R.ReviewedByProposals, o)
+	}
+
+	return nil
+}
+
+// RemoveReviewedByUser relationship.
+// Sets o.R.ReviewedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Proposal) RemoveReviewedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ReviewedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("reviewed_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ReviewedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ReviewedByProposals {
+		if queries.Equal(o.ReviewedBy, ri.ReviewedBy) {
+			continue
+		}
+
+		ln := len(related.R.ReviewedByProposals)
+		if ln > 1 && i < ln-1 {
+			related.R.ReviewedByProposals[i] = related.R.ReviewedByProposals[ln-1]
+		}
+		related.R.ReviewedByProposals = related.R.ReviewedByProposals[:ln-1]
+		break
+	}
+	return nil
+}
+
+// Proposals retrieves all the records using an executor.
+func Proposals(mods ...qm.QueryMod) proposalQuery {
+	mods = append(mods, qm.From("\"proposals\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"proposals\".*"})
+	}
+
+	return proposalQuery{q}
+}
+
+// FindProposal retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindProposal(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*Proposal, error) {
+	proposalObj := &Proposal{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"proposals\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, proposalObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from proposals")
+	}
+
+	if err = proposalObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return proposalObj, err
+	}
+
+	return proposalObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Proposal) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no proposals provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(proposalColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	proposalInsertCacheMut.RLock()
+	cache, cached := proposalInsertCache[key]
+	proposalInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			proposalAllColumns,
+			proposalColumnsWithDefault,
+			proposalColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(proposalType, proposalMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(proposalType, proposalMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"proposals\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"proposals\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into proposals")
+	}
+
+	if !cached {
+		proposalInsertCacheMut.Lock()
+		proposalInsertCache[key] = cache
+		proposalInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Proposal.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Proposal) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	proposalUpdateCacheMut.RLock()
+	cache, cached := proposalUpdateCache[key]
+	proposalUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			proposalAllColumns,
+			proposalPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update proposals, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"proposals\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, proposalPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(proposalType, proposalMapping, append(wl, proposalPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update proposals row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for proposals")
+	}
+
+	if !cached {
+		proposalUpdateCacheMut.Lock()
+		proposalUpdateCache[key] = cache
+		proposalUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q proposalQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for proposals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for proposals")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ProposalSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), proposalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"proposals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, proposalPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in proposal slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all proposal")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Proposal) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no proposals provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(proposalColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	proposalUpsertCacheMut.RLock()
+	cache, cached := proposalUpsertCache[key]
+	proposalUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			proposalAllColumns,
+			proposalColumnsWithDefault,
+			proposalColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			proposalAllColumns,
+			proposalPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert proposals, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(proposalPrimaryKeyColumns))
+			copy(conflict, proposalPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"proposals\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(proposalType, proposalMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(proposalType, proposalMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert proposals")
+	}
+
+	if !cached {
+		proposalUpsertCacheMut.Lock()
+		proposalUpsertCache[key] = cache
+		proposalUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Proposal record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Proposal) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Proposal provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), proposalPrimaryKeyMapping)
+	sql := "DELETE FROM \"proposals\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from proposals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for proposals")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q proposalQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no proposalQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from proposals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for proposals")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ProposalSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(proposalBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), proposalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"proposals\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, proposalPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from proposal slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for proposals")
+	}
+
+	if len(proposalAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Proposal) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindProposal(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ProposalSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ProposalSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), proposalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"proposals\".* FROM \"proposals\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, proposalPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ProposalSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ProposalExists checks if the Proposal row exists.
+func ProposalExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"proposals\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if proposals exists")
+	}
+
+	return exists, nil
+}
@@ -6,13 +6,48 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("ArtistImageUrls", testArtistImageUrlsUpsert)
+
+	t.Run("ArtistImageUrlsAudits", testArtistImageUrlsAuditsUpsert)
+
+	t.Run("Artists", testArtistsUpsert)
+
+	t.Run("ArtistsAudits", testArtistsAuditsUpsert)
+
+	t.Run("FilmMediaUrls", testFilmMediaUrlsUpsert)
+
+	t.Run("FilmMediaUrlsAudits", testFilmMediaUrlsAuditsUpsert)
+
 	t.Run("Films", testFilmsUpsert)
 
 	t.Run("FilmsAudits", testFilmsAuditsUpsert)
+	t.Run("IdempotencyKeys", testIdempotencyKeysUpsert)
+
+	t.Run("PlaylistFilms", testPlaylistFilmsUpsert)
+
+	t.Run("Playlists", testPlaylistsUpsert)
+
+	t.Run("PostRevisions", testPostRevisionsUpsert)
+
+	t.Run("Posts", testPostsUpsert)
+
+	t.Run("Proposals", testProposalsUpsert)
+
+	t.Run("Seasons", testSeasonsUpsert)
+
+	t.Run("SeasonsAudits", testSeasonsAuditsUpsert)
 
 	t.Run("Serieses", testSeriesesUpsert)
 
 	t.Run("SeriesesAudits", testSeriesesAuditsUpsert)
 
+	t.Run("UserDeletions", testUserDeletionsUpsert)
+
+	t.Run("UserFollowings", testUserFollowingsUpsert)
+
 	t.Run("Users", testUsersUpsert)
+
+	t.Run("UsersAudits", testUsersAuditsUpsert)
+
+	t.Run("Watchlists", testWatchlistsUpsert)
 }
@@ -0,0 +1,1313 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Season is an object representing the database table.
+type Season struct {
+	SeriesID      int         `boil:"series_id" json:"series_id" toml:"series_id" yaml:"series_id"`
+	SeasonNumber  int         `boil:"season_number" json:"season_number" toml:"season_number" yaml:"season_number"`
+	Title         null.String `boil:"title" json:"title,omitempty" toml:"title" yaml:"title,omitempty"`
+	Descriptions  null.String `boil:"descriptions" json:"descriptions,omitempty" toml:"descriptions" yaml:"descriptions,omitempty"`
+	DateStarted   null.Time   `boil:"date_started" json:"date_started,omitempty" toml:"date_started" yaml:"date_started,omitempty"`
+	DateEnded     null.Time   `boil:"date_ended" json:"date_ended,omitempty" toml:"date_ended" yaml:"date_ended,omitempty"`
+	ContributedBy int         `boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt time.Time   `boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation  null.String `boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+
+	R *seasonR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L seasonL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SeasonColumns = struct {
+	SeriesID      string
+	SeasonNumber  string
+	Title         string
+	Descriptions  string
+	DateStarted   string
+	DateEnded     string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	SeriesID:      "series_id",
+	SeasonNumber:  "season_number",
+	Title:         "title",
+	Descriptions:  "descriptions",
+	DateStarted:   "date_started",
+	DateEnded:     "date_ended",
+	ContributedBy: "contributed_by",
+	ContributedAt: "contributed_at",
+	Invalidation:  "invalidation",
+}
+
+var SeasonTableColumns = struct {
+	SeriesID      string
+	SeasonNumber  string
+	Title         string
+	Descriptions  string
+	DateStarted   string
+	DateEnded     string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	SeriesID:      "seasons.series_id",
+	SeasonNumber:  "seasons.season_number",
+	Title:         "seasons.title",
+	Descriptions:  "seasons.descriptions",
+	DateStarted:   "seasons.date_started",
+	DateEnded:     "seasons.date_ended",
+	ContributedBy: "seasons.contributed_by",
+	ContributedAt: "seasons.contributed_at",
+	Invalidation:  "seasons.invalidation",
+}
+
+// Generated where
+
+var SeasonWhere = struct {
+	SeriesID      whereHelperint
+	SeasonNumber  whereHelperint
+	Title         whereHelpernull_String
+	Descriptions  whereHelpernull_String
+	DateStarted   whereHelpernull_Time
+	DateEnded     whereHelpernull_Time
+	ContributedBy whereHelperint
+	ContributedAt whereHelpertime_Time
+	Invalidation  whereHelpernull_String
+}{
+	SeriesID:      whereHelperint{field: "\"seasons\".\"series_id\""},
+	SeasonNumber:  whereHelperint{field: "\"seasons\".\"season_number\""},
+	Title:         whereHelpernull_String{field: "\"seasons\".\"title\""},
+	Descriptions:  whereHelpernull_String{field: "\"seasons\".\"descriptions\""},
+	DateStarted:   whereHelpernull_Time{field: "\"seasons\".\"date_started\""},
+	DateEnded:     whereHelpernull_Time{field: "\"seasons\".\"date_ended\""},
+	ContributedBy: whereHelperint{field: "\"seasons\".\"contributed_by\""},
+	ContributedAt: whereHelpertime_Time{field: "\"seasons\".\"contributed_at\""},
+	Invalidation:  whereHelpernull_String{field: "\"seasons\".\"invalidation\""},
+}
+
+// SeasonRels is where relationship names are stored.
+var SeasonRels = struct {
+	ContributingUser string
+	Series           string
+}{
+	ContributingUser: "ContributingUser",
+	Series:           "Series",
+}
+
+// seasonR is where relationships are stored.
+type seasonR struct {
+	ContributingUser *User   `boil:"ContributingUser" json:"ContributingUser" toml:"ContributingUser" yaml:"ContributingUser"`
+	Series           *Series `boil:"Series" json:"Series" toml:"Series" yaml:"Series"`
+}
+
+// NewStruct creates a new relationship struct
+func (*seasonR) NewStruct() *seasonR {
+	return &seasonR{}
+}
+
+func (r *seasonR) GetContributingUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.ContributingUser
+}
+
+func (r *seasonR) GetSeries() *Series {
+	if r == nil {
+		return nil
+	}
+	return r.Series
+}
+
+// seasonL is where Load methods for each relationship are stored.
+type seasonL struct{}
+
+var (
+	seasonAllColumns            = []string{"series_id", "season_number", "title", "descriptions", "date_started", "date_ended", "contributed_by", "contributed_at", "invalidation"}
+	seasonColumnsWithoutDefault = []string{"series_id", "season_number", "contributed_by"}
+	seasonColumnsWithDefault    = []string{"title", "descriptions", "date_started", "date_ended", "contributed_at", "invalidation"}
+	seasonPrimaryKeyColumns     = []string{"series_id", "season_number"}
+	seasonGeneratedColumns      = []string{}
+)
+
+type (
+	// SeasonSlice is an alias for a slice of pointers to Season.
+	// This should almost always be used instead of []Season.
+	SeasonSlice []*Season
+	// SeasonHook is the signature for custom Season hook methods
+	SeasonHook func(context.Context, boil.ContextExecutor, *Season) error
+
+	seasonQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	seasonType                 = reflect.TypeOf(&Season{})
+	seasonMapping              = queries.MakeStructMapping(seasonType)
+	seasonPrimaryKeyMapping, _ = queries.BindMapping(seasonType, seasonMapping, seasonPrimaryKeyColumns)
+	seasonInsertCacheMut       sync.RWMutex
+	seasonInsertCache          = make(map[string]insertCache)
+	seasonUpdateCacheMut       sync.RWMutex
+	seasonUpdateCache          = make(map[string]updateCache)
+	seasonUpsertCacheMut       sync.RWMutex
+	seasonUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var seasonAfterSelectHooks []SeasonHook
+
+var seasonBeforeInsertHooks []SeasonHook
+var seasonAfterInsertHooks []SeasonHook
+
+var seasonBeforeUpdateHooks []SeasonHook
+var seasonAfterUpdateHooks []SeasonHook
+
+var seasonBeforeDeleteHooks []SeasonHook
+var seasonAfterDeleteHooks []SeasonHook
+
+var seasonBeforeUpsertHooks []SeasonHook
+var seasonAfterUpsertHooks []SeasonHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Season) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Season) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Season) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Season) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Season) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Season) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Season) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Season) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Season) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSeasonHook registers your hook function for all future operations.
+func AddSeasonHook(hookPoint boil.HookPoint, seasonHook SeasonHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		seasonAfterSelectHooks = append(seasonAfterSelectHooks, seasonHook)
+	case boil.BeforeInsertHook:
+		seasonBeforeInsertHooks = append(seasonBeforeInsertHooks, seasonHook)
+	case boil.AfterInsertHook:
+		seasonAfterInsertHooks = append(seasonAfterInsertHooks, seasonHook)
+	case boil.BeforeUpdateHook:
+		seasonBeforeUpdateHooks = append(seasonBeforeUpdateHooks, seasonHook)
+	case boil.AfterUpdateHook:
+		seasonAfterUpdateHooks = append(seasonAfterUpdateHooks, seasonHook)
+	case boil.BeforeDeleteHook:
+		seasonBeforeDeleteHooks = append(seasonBeforeDeleteHooks, seasonHook)
+	case boil.AfterDeleteHook:
+		seasonAfterDeleteHooks = append(seasonAfterDeleteHooks, seasonHook)
+	case boil.BeforeUpsertHook:
+		seasonBeforeUpsertHooks = append(seasonBeforeUpsertHooks, seasonHook)
+	case boil.AfterUpsertHook:
+		seasonAfterUpsertHooks = append(seasonAfterUpsertHooks, seasonHook)
+	}
+}
+
+// One returns a single season record from the query.
+func (q seasonQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Season, error) {
+	o := &Season{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for seasons")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Season records from the query.
+func (q seasonQuery) All(ctx context.Context, exec boil.ContextExecutor) (SeasonSlice, error) {
+	var o []*Season
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Season slice")
+	}
+
+	if len(seasonAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Season records in the query.
+func (q seasonQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count seasons rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q seasonQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if seasons exists")
+	}
+
+	return count > 0, nil
+}
+
+// ContributingUser pointed to by the foreign key.
+func (o *Season) ContributingUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ContributedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Series pointed to by the foreign key.
+func (o *Season) Series(mods ...qm.QueryMod) seriesQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SeriesID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Serieses(queryMods...)
+}
+
+// LoadContributingUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (seasonL) LoadContributingUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeason interface{}, mods queries.Applicator) error {
+	var slice []*Season
+	var object *Season
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeason.(*Season)
+		if !ok {
+			object = new(Season)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeason)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeason))
+			}
+		}
+	} else {
+		s, ok := maybeSeason.(*[]*Season)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeason)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeason))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seasonR{}
+		}
+		args = append(args, object.ContributedBy)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seasonR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ContributedBy {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ContributedBy)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(seasonAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ContributingUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ContributedSeasons = append(foreign.R.ContributedSeasons, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ContributedBy == foreign.ID {
+				local.R.ContributingUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ContributedSeasons = append(foreign.R.ContributedSeasons, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (seasonL) LoadSeries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeason interface{}, mods queries.Applicator) error {
+	var slice []*Season
+	var object *Season
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeason.(*Season)
+		if !ok {
+			object = new(Season)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeason)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeason))
+			}
+		}
+	} else {
+		s, ok := maybeSeason.(*[]*Season)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeason)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeason))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seasonR{}
+		}
+		args = append(args, object.SeriesID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seasonR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SeriesID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SeriesID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`serieses`),
+		qm.WhereIn(`serieses.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Series")
+	}
+
+	var resultSlice []*Series
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Series")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for serieses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for serieses")
+	}
+
+	if len(seasonAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Series = foreign
+		if foreign.R == nil {
+			foreign.R = &seriesR{}
+		}
+		foreign.R.SeriesSeasons = append(foreign.R.SeriesSeasons, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SeriesID == foreign.ID {
+				local.R.Series = foreign
+				if foreign.R == nil {
+					foreign.R = &seriesR{}
+				}
+				foreign.R.SeriesSeasons = append(foreign.R.SeriesSeasons, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetContributingUser of the season to the related item.
+// Sets o.R.ContributingUser to related.
+// Adds o to related.R.ContributedSeasons.
+func (o *Season) SetContributingUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"seasons\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+		strmangle.WhereClause("\"", "\"", 2, seasonPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.SeriesID, o.SeasonNumber}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ContributedBy = related.ID
+	if o.R == nil {
+		o.R = &seasonR{
+			ContributingUser: related,
+		}
+	} else {
+		o.R.ContributingUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ContributedSeasons: SeasonSlice{o},
+		}
+	} else {
+		related.R.ContributedSeasons = append(related.R.ContributedSeasons, o)
+	}
+
+	return nil
+}
+
+// SetSeries of the season to the related item.
+// Sets o.R.Series to related.
+// Adds o to related.R.SeriesSeasons.
+func (o *Season) SetSeries(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Series) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"seasons\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+		strmangle.WhereClause("\"", "\"", 2, seasonPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.SeriesID, o.SeasonNumber}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SeriesID = related.ID
+	if o.R == nil {
+		o.R = &seasonR{
+			Series: related,
+		}
+	} else {
+		o.R.Series = related
+	}
+
+	if related.R == nil {
+		related.R = &seriesR{
+			SeriesSeasons: SeasonSlice{o},
+		}
+	} else {
+		related.R.SeriesSeasons = append(related.R.SeriesSeasons, o)
+	}
+
+	return nil
+}
+
+// Seasons retrieves all the records using an executor.
+func Seasons(mods ...qm.QueryMod) seasonQuery {
+	mods = append(mods, qm.From("\"seasons\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"seasons\".*"})
+	}
+
+	return seasonQuery{q}
+}
+
+// FindSeason retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSeason(ctx context.Context, exec boil.ContextExecutor, seriesID int, seasonNumber int, selectCols ...string) (*Season, error) {
+	seasonObj := &Season{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"seasons\" where \"series_id\"=$1 AND \"season_number\"=$2", sel,
+	)
+
+	q := queries.Raw(query, seriesID, seasonNumber)
+
+	err := q.Bind(ctx, exec, seasonObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from seasons")
+	}
+
+	if err = seasonObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return seasonObj, err
+	}
+
+	return seasonObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Season) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no seasons provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(seasonColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	seasonInsertCacheMut.RLock()
+	cache, cached := seasonInsertCache[key]
+	seasonInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			seasonAllColumns,
+			seasonColumnsWithDefault,
+			seasonColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(seasonType, seasonMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(seasonType, seasonMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"seasons\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"seasons\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into seasons")
+	}
+
+	if !cached {
+		seasonInsertCacheMut.Lock()
+		seasonInsertCache[key] = cache
+		seasonInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Season.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Season) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	seasonUpdateCacheMut.RLock()
+	cache, cached := seasonUpdateCache[key]
+	seasonUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			seasonAllColumns,
+			seasonPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update seasons, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"seasons\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, seasonPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(seasonType, seasonMapping, append(wl, seasonPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update seasons row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for seasons")
+	}
+
+	if !cached {
+		seasonUpdateCacheMut.Lock()
+		seasonUpdateCache[key] = cache
+		seasonUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q seasonQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for seasons")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for seasons")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SeasonSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seasonPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"seasons\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, seasonPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in season slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all season")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Season) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no seasons provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(seasonColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	seasonUpsertCacheMut.RLock()
+	cache, cached := seasonUpsertCache[key]
+	seasonUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			seasonAllColumns,
+			seasonColumnsWithDefault,
+			seasonColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			seasonAllColumns,
+			seasonPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert seasons, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(seasonPrimaryKeyColumns))
+			copy(conflict, seasonPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"seasons\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(seasonType, seasonMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(seasonType, seasonMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert seasons")
+	}
+
+	if !cached {
+		seasonUpsertCacheMut.Lock()
+		seasonUpsertCache[key] = cache
+		seasonUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Season record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Season) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Season provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), seasonPrimaryKeyMapping)
+	sql := "DELETE FROM \"seasons\" WHERE \"series_id\"=$1 AND \"season_number\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from seasons")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for seasons")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q seasonQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no seasonQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from seasons")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for seasons")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SeasonSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(seasonBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seasonPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"seasons\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, seasonPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from season slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for seasons")
+	}
+
+	if len(seasonAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Season) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSeason(ctx, exec, o.SeriesID, o.SeasonNumber)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SeasonSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SeasonSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seasonPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"seasons\".* FROM \"seasons\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, seasonPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SeasonSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SeasonExists checks if the Season row exists.
+func SeasonExists(ctx context.Context, exec boil.ContextExecutor, seriesID int, seasonNumber int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"seasons\" where \"series_id\"=$1 AND \"season_number\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, seriesID, seasonNumber)
+	}
+	row := exec.QueryRowContext(ctx, sql, seriesID, seasonNumber)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if seasons exists")
+	}
+
+	return exists, nil
+}
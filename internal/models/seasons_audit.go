@@ -0,0 +1,936 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SeasonsAudit is an object representing the database table.
+type SeasonsAudit struct {
+	SeriesID      int         `boil:"series_id" json:"series_id" toml:"series_id" yaml:"series_id"`
+	SeasonNumber  int         `boil:"season_number" json:"season_number" toml:"season_number" yaml:"season_number"`
+	Title         null.String `boil:"title" json:"title,omitempty" toml:"title" yaml:"title,omitempty"`
+	Descriptions  null.String `boil:"descriptions" json:"descriptions,omitempty" toml:"descriptions" yaml:"descriptions,omitempty"`
+	DateStarted   null.Time   `boil:"date_started" json:"date_started,omitempty" toml:"date_started" yaml:"date_started,omitempty"`
+	DateEnded     null.Time   `boil:"date_ended" json:"date_ended,omitempty" toml:"date_ended" yaml:"date_ended,omitempty"`
+	ContributedBy int         `boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt time.Time   `boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation  null.String `boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+
+	R *seasonsAuditR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L seasonsAuditL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SeasonsAuditColumns = struct {
+	SeriesID      string
+	SeasonNumber  string
+	Title         string
+	Descriptions  string
+	DateStarted   string
+	DateEnded     string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	SeriesID:      "series_id",
+	SeasonNumber:  "season_number",
+	Title:         "title",
+	Descriptions:  "descriptions",
+	DateStarted:   "date_started",
+	DateEnded:     "date_ended",
+	ContributedBy: "contributed_by",
+	ContributedAt: "contributed_at",
+	Invalidation:  "invalidation",
+}
+
+var SeasonsAuditTableColumns = struct {
+	SeriesID      string
+	SeasonNumber  string
+	Title         string
+	Descriptions  string
+	DateStarted   string
+	DateEnded     string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	SeriesID:      "seasons_audit.series_id",
+	SeasonNumber:  "seasons_audit.season_number",
+	Title:         "seasons_audit.title",
+	Descriptions:  "seasons_audit.descriptions",
+	DateStarted:   "seasons_audit.date_started",
+	DateEnded:     "seasons_audit.date_ended",
+	ContributedBy: "seasons_audit.contributed_by",
+	ContributedAt: "seasons_audit.contributed_at",
+	Invalidation:  "seasons_audit.invalidation",
+}
+
+// Generated where
+
+var SeasonsAuditWhere = struct {
+	SeriesID      whereHelperint
+	SeasonNumber  whereHelperint
+	Title         whereHelpernull_String
+	Descriptions  whereHelpernull_String
+	DateStarted   whereHelpernull_Time
+	DateEnded     whereHelpernull_Time
+	ContributedBy whereHelperint
+	ContributedAt whereHelpertime_Time
+	Invalidation  whereHelpernull_String
+}{
+	SeriesID:      whereHelperint{field: "\"seasons_audit\".\"series_id\""},
+	SeasonNumber:  whereHelperint{field: "\"seasons_audit\".\"season_number\""},
+	Title:         whereHelpernull_String{field: "\"seasons_audit\".\"title\""},
+	Descriptions:  whereHelpernull_String{field: "\"seasons_audit\".\"descriptions\""},
+	DateStarted:   whereHelpernull_Time{field: "\"seasons_audit\".\"date_started\""},
+	DateEnded:     whereHelpernull_Time{field: "\"seasons_audit\".\"date_ended\""},
+	ContributedBy: whereHelperint{field: "\"seasons_audit\".\"contributed_by\""},
+	ContributedAt: whereHelpertime_Time{field: "\"seasons_audit\".\"contributed_at\""},
+	Invalidation:  whereHelpernull_String{field: "\"seasons_audit\".\"invalidation\""},
+}
+
+// SeasonsAuditRels is where relationship names are stored.
+var SeasonsAuditRels = struct {
+}{}
+
+// seasonsAuditR is where relationships are stored.
+type seasonsAuditR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*seasonsAuditR) NewStruct() *seasonsAuditR {
+	return &seasonsAuditR{}
+}
+
+// seasonsAuditL is where Load methods for each relationship are stored.
+type seasonsAuditL struct{}
+
+var (
+	seasonsAuditAllColumns            = []string{"series_id", "season_number", "title", "descriptions", "date_started", "date_ended", "contributed_by", "contributed_at", "invalidation"}
+	seasonsAuditColumnsWithoutDefault = []string{"series_id", "season_number", "contributed_by", "contributed_at"}
+	seasonsAuditColumnsWithDefault    = []string{"title", "descriptions", "date_started", "date_ended", "invalidation"}
+	seasonsAuditPrimaryKeyColumns     = []string{"series_id", "season_number", "contributed_by", "contributed_at"}
+	seasonsAuditGeneratedColumns      = []string{}
+)
+
+type (
+	// SeasonsAuditSlice is an alias for a slice of pointers to SeasonsAudit.
+	// This should almost always be used instead of []SeasonsAudit.
+	SeasonsAuditSlice []*SeasonsAudit
+	// SeasonsAuditHook is the signature for custom SeasonsAudit hook methods
+	SeasonsAuditHook func(context.Context, boil.ContextExecutor, *SeasonsAudit) error
+
+	seasonsAuditQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	seasonsAuditType                 = reflect.TypeOf(&SeasonsAudit{})
+	seasonsAuditMapping              = queries.MakeStructMapping(seasonsAuditType)
+	seasonsAuditPrimaryKeyMapping, _ = queries.BindMapping(seasonsAuditType, seasonsAuditMapping, seasonsAuditPrimaryKeyColumns)
+	seasonsAuditInsertCacheMut       sync.RWMutex
+	seasonsAuditInsertCache          = make(map[string]insertCache)
+	seasonsAuditUpdateCacheMut       sync.RWMutex
+	seasonsAuditUpdateCache          = make(map[string]updateCache)
+	seasonsAuditUpsertCacheMut       sync.RWMutex
+	seasonsAuditUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var seasonsAuditAfterSelectHooks []SeasonsAuditHook
+
+var seasonsAuditBeforeInsertHooks []SeasonsAuditHook
+var seasonsAuditAfterInsertHooks []SeasonsAuditHook
+
+var seasonsAuditBeforeUpdateHooks []SeasonsAuditHook
+var seasonsAuditAfterUpdateHooks []SeasonsAuditHook
+
+var seasonsAuditBeforeDeleteHooks []SeasonsAuditHook
+var seasonsAuditAfterDeleteHooks []SeasonsAuditHook
+
+var seasonsAuditBeforeUpsertHooks []SeasonsAuditHook
+var seasonsAuditAfterUpsertHooks []SeasonsAuditHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SeasonsAudit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonsAuditAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SeasonsAudit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonsAuditBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SeasonsAudit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonsAuditAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SeasonsAudit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonsAuditBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SeasonsAudit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonsAuditAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SeasonsAudit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonsAuditBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SeasonsAudit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonsAuditAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SeasonsAudit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonsAuditBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SeasonsAudit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seasonsAuditAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSeasonsAuditHook registers your hook function for all future operations.
+func AddSeasonsAuditHook(hookPoint boil.HookPoint, seasonsAuditHook SeasonsAuditHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		seasonsAuditAfterSelectHooks = append(seasonsAuditAfterSelectHooks, seasonsAuditHook)
+	case boil.BeforeInsertHook:
+		seasonsAuditBeforeInsertHooks = append(seasonsAuditBeforeInsertHooks, seasonsAuditHook)
+	case boil.AfterInsertHook:
+		seasonsAuditAfterInsertHooks = append(seasonsAuditAfterInsertHooks, seasonsAuditHook)
+	case boil.BeforeUpdateHook:
+		seasonsAuditBeforeUpdateHooks = append(seasonsAuditBeforeUpdateHooks, seasonsAuditHook)
+	case boil.AfterUpdateHook:
+		seasonsAuditAfterUpdateHooks = append(seasonsAuditAfterUpdateHooks, seasonsAuditHook)
+	case boil.BeforeDeleteHook:
+		seasonsAuditBeforeDeleteHooks = append(seasonsAuditBeforeDeleteHooks, seasonsAuditHook)
+	case boil.AfterDeleteHook:
+		seasonsAuditAfterDeleteHooks = append(seasonsAuditAfterDeleteHooks, seasonsAuditHook)
+	case boil.BeforeUpsertHook:
+		seasonsAuditBeforeUpsertHooks = append(seasonsAuditBeforeUpsertHooks, seasonsAuditHook)
+	case boil.AfterUpsertHook:
+		seasonsAuditAfterUpsertHooks = append(seasonsAuditAfterUpsertHooks, seasonsAuditHook)
+	}
+}
+
+// One returns a single seasonsAudit record from the query.
+func (q seasonsAuditQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SeasonsAudit, error) {
+	o := &SeasonsAudit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for seasons_audit")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SeasonsAudit records from the query.
+func (q seasonsAuditQuery) All(ctx context.Context, exec boil.ContextExecutor) (SeasonsAuditSlice, error) {
+	var o []*SeasonsAudit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SeasonsAudit slice")
+	}
+
+	if len(seasonsAuditAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SeasonsAudit records in the query.
+func (q seasonsAuditQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count seasons_audit rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q seasonsAuditQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if seasons_audit exists")
+	}
+
+	return count > 0, nil
+}
+
+// SeasonsAudits retrieves all the records using an executor.
+func SeasonsAudits(mods ...qm.QueryMod) seasonsAuditQuery {
+	mods = append(mods, qm.From("\"seasons_audit\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"seasons_audit\".*"})
+	}
+
+	return seasonsAuditQuery{q}
+}
+
+// FindSeasonsAudit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSeasonsAudit(ctx context.Context, exec boil.ContextExecutor, seriesID int, seasonNumber int, contributedBy int, contributedAt time.Time, selectCols ...string) (*SeasonsAudit, error) {
+	seasonsAuditObj := &SeasonsAudit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"seasons_audit\" where \"series_id\"=$1 AND \"season_number\"=$2 AND \"contributed_by\"=$3 AND \"contributed_at\"=$4", sel,
+	)
+
+	q := queries.Raw(query, seriesID, seasonNumber, contributedBy, contributedAt)
+
+	err := q.Bind(ctx, exec, seasonsAuditObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from seasons_audit")
+	}
+
+	if err = seasonsAuditObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return seasonsAuditObj, err
+	}
+
+	return seasonsAuditObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SeasonsAudit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no seasons_audit provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(seasonsAuditColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	seasonsAuditInsertCacheMut.RLock()
+	cache, cached := seasonsAuditInsertCache[key]
+	seasonsAuditInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			seasonsAuditAllColumns,
+			seasonsAuditColumnsWithDefault,
+			seasonsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(seasonsAuditType, seasonsAuditMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(seasonsAuditType, seasonsAuditMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"seasons_audit\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"seasons_audit\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into seasons_audit")
+	}
+
+	if !cached {
+		seasonsAuditInsertCacheMut.Lock()
+		seasonsAuditInsertCache[key] = cache
+		seasonsAuditInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SeasonsAudit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SeasonsAudit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	seasonsAuditUpdateCacheMut.RLock()
+	cache, cached := seasonsAuditUpdateCache[key]
+	seasonsAuditUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			seasonsAuditAllColumns,
+			seasonsAuditPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update seasons_audit, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"seasons_audit\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, seasonsAuditPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(seasonsAuditType, seasonsAuditMapping, append(wl, seasonsAuditPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update seasons_audit row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for seasons_audit")
+	}
+
+	if !cached {
+		seasonsAuditUpdateCacheMut.Lock()
+		seasonsAuditUpdateCache[key] = cache
+		seasonsAuditUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q seasonsAuditQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for seasons_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for seasons_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SeasonsAuditSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seasonsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"seasons_audit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, seasonsAuditPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in seasonsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all seasonsAudit")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SeasonsAudit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no seasons_audit provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(seasonsAuditColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	seasonsAuditUpsertCacheMut.RLock()
+	cache, cached := seasonsAuditUpsertCache[key]
+	seasonsAuditUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			seasonsAuditAllColumns,
+			seasonsAuditColumnsWithDefault,
+			seasonsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			seasonsAuditAllColumns,
+			seasonsAuditPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert seasons_audit, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(seasonsAuditPrimaryKeyColumns))
+			copy(conflict, seasonsAuditPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"seasons_audit\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(seasonsAuditType, seasonsAuditMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(seasonsAuditType, seasonsAuditMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert seasons_audit")
+	}
+
+	if !cached {
+		seasonsAuditUpsertCacheMut.Lock()
+		seasonsAuditUpsertCache[key] = cache
+		seasonsAuditUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single SeasonsAudit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SeasonsAudit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SeasonsAudit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), seasonsAuditPrimaryKeyMapping)
+	sql := "DELETE FROM \"seasons_audit\" WHERE \"series_id\"=$1 AND \"season_number\"=$2 AND \"contributed_by\"=$3 AND \"contributed_at\"=$4"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from seasons_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for seasons_audit")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q seasonsAuditQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no seasonsAuditQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from seasons_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for seasons_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SeasonsAuditSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(seasonsAuditBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seasonsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"seasons_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, seasonsAuditPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from seasonsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for seasons_audit")
+	}
+
+	if len(seasonsAuditAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SeasonsAudit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSeasonsAudit(ctx, exec, o.SeriesID, o.SeasonNumber, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SeasonsAuditSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SeasonsAuditSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seasonsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"seasons_audit\".* FROM \"seasons_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, seasonsAuditPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SeasonsAuditSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SeasonsAuditExists checks if the SeasonsAudit row exists.
+func SeasonsAuditExists(ctx context.Context, exec boil.ContextExecutor, seriesID int, seasonNumber int, contributedBy int, contributedAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"seasons_audit\" where \"series_id\"=$1 AND \"season_number\"=$2 AND \"contributed_by\"=$3 AND \"contributed_at\"=$4 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, seriesID, seasonNumber, contributedBy, contributedAt)
+	}
+	row := exec.QueryRowContext(ctx, sql, seriesID, seasonNumber, contributedBy, contributedAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if seasons_audit exists")
+	}
+
+	return exists, nil
+}
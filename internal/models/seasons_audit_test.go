@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testSeasonsAudits(t *testing.T) {
+	t.Parallel()
+
+	query := SeasonsAudits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testSeasonsAuditsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeasonsAudit{}
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SeasonsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSeasonsAuditsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeasonsAudit{}
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := SeasonsAudits().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SeasonsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSeasonsAuditsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeasonsAudit{}
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SeasonsAuditSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SeasonsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSeasonsAuditsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeasonsAudit{}
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := SeasonsAuditExists(ctx, tx, o.SeriesID, o.SeasonNumber, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Errorf("Unable to check if SeasonsAudit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected SeasonsAuditExists to return true, but got false.")
+	}
+}
+
+func testSeasonsAuditsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeasonsAudit{}
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	seasonsAuditFound, err := FindSeasonsAudit(ctx, tx, o.SeriesID, o.SeasonNumber, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if seasonsAuditFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testSeasonsAuditsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeasonsAudit{}
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = SeasonsAudits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSeasonsAuditsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeasonsAudit{}
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := SeasonsAudits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testSeasonsAuditsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	seasonsAuditOne := &SeasonsAudit{}
+	seasonsAuditTwo := &SeasonsAudit{}
+	if err = randomize.Struct(seed, seasonsAuditOne, seasonsAuditDBTypes, false, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, seasonsAuditTwo, seasonsAuditDBTypes, false, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = seasonsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = seasonsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SeasonsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testSeasonsAuditsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	seasonsAuditOne := &SeasonsAudit{}
+	seasonsAuditTwo := &SeasonsAudit{}
+	if err = randomize.Struct(seed, seasonsAuditOne, seasonsAuditDBTypes, false, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, seasonsAuditTwo, seasonsAuditDBTypes, false, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = seasonsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = seasonsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeasonsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func seasonsAuditBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *SeasonsAudit) error {
+	*o = SeasonsAudit{}
+	return nil
+}
+
+func seasonsAuditAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *SeasonsAudit) error {
+	*o = SeasonsAudit{}
+	return nil
+}
+
+func seasonsAuditAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *SeasonsAudit) error {
+	*o = SeasonsAudit{}
+	return nil
+}
+
+func seasonsAuditBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SeasonsAudit) error {
+	*o = SeasonsAudit{}
+	return nil
+}
+
+func seasonsAuditAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SeasonsAudit) error {
+	*o = SeasonsAudit{}
+	return nil
+}
+
+func seasonsAuditBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SeasonsAudit) error {
+	*o = SeasonsAudit{}
+	return nil
+}
+
+func seasonsAuditAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SeasonsAudit) error {
+	*o = SeasonsAudit{}
+	return nil
+}
+
+func seasonsAuditBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SeasonsAudit) error {
+	*o = SeasonsAudit{}
+	return nil
+}
+
+func seasonsAuditAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SeasonsAudit) error {
+	*o = SeasonsAudit{}
+	return nil
+}
+
+func testSeasonsAuditsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &SeasonsAudit{}
+	o := &SeasonsAudit{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit object: %s", err)
+	}
+
+	AddSeasonsAuditHook(boil.BeforeInsertHook, seasonsAuditBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	seasonsAuditBeforeInsertHooks = []SeasonsAuditHook{}
+
+	AddSeasonsAuditHook(boil.AfterInsertHook, seasonsAuditAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	seasonsAuditAfterInsertHooks = []SeasonsAuditHook{}
+
+	AddSeasonsAuditHook(boil.AfterSelectHook, seasonsAuditAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	seasonsAuditAfterSelectHooks = []SeasonsAuditHook{}
+
+	AddSeasonsAuditHook(boil.BeforeUpdateHook, seasonsAuditBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	seasonsAuditBeforeUpdateHooks = []SeasonsAuditHook{}
+
+	AddSeasonsAuditHook(boil.AfterUpdateHook, seasonsAuditAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	seasonsAuditAfterUpdateHooks = []SeasonsAuditHook{}
+
+	AddSeasonsAuditHook(boil.BeforeDeleteHook, seasonsAuditBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	seasonsAuditBeforeDeleteHooks = []SeasonsAuditHook{}
+
+	AddSeasonsAuditHook(boil.AfterDeleteHook, seasonsAuditAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	seasonsAuditAfterDeleteHooks = []SeasonsAuditHook{}
+
+	AddSeasonsAuditHook(boil.BeforeUpsertHook, seasonsAuditBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	seasonsAuditBeforeUpsertHooks = []SeasonsAuditHook{}
+
+	AddSeasonsAuditHook(boil.AfterUpsertHook, seasonsAuditAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	seasonsAuditAfterUpsertHooks = []SeasonsAuditHook{}
+}
+
+func testSeasonsAuditsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeasonsAudit{}
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeasonsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSeasonsAuditsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeasonsAudit{}
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(seasonsAuditColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeasonsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSeasonsAuditsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeasonsAudit{}
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSeasonsAuditsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeasonsAudit{}
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SeasonsAuditSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSeasonsAuditsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeasonsAudit{}
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SeasonsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	seasonsAuditDBTypes = map[string]string{`SeriesID`: `integer`, `SeasonNumber`: `integer`, `Title`: `character varying`, `Descriptions`: `character varying`, `DateStarted`: `date`, `DateEnded`: `date`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`}
+	_                   = bytes.MinRead
+)
+
+func testSeasonsAuditsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(seasonsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(seasonsAuditAllColumns) == len(seasonsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeasonsAudit{}
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeasonsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testSeasonsAuditsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(seasonsAuditAllColumns) == len(seasonsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeasonsAudit{}
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeasonsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, seasonsAuditDBTypes, true, seasonsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(seasonsAuditAllColumns, seasonsAuditPrimaryKeyColumns) {
+		fields = seasonsAuditAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			seasonsAuditAllColumns,
+			seasonsAuditPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := SeasonsAuditSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testSeasonsAuditsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(seasonsAuditAllColumns) == len(seasonsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := SeasonsAudit{}
+	if err = randomize.Struct(seed, &o, seasonsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SeasonsAudit: %s", err)
+	}
+
+	count, err := SeasonsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, seasonsAuditDBTypes, false, seasonsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SeasonsAudit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SeasonsAudit: %s", err)
+	}
+
+	count, err = SeasonsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -32,6 +32,10 @@ type Series struct {
 	ContributedBy int         `boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
 	ContributedAt time.Time   `boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
 	Invalidation  null.String `boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	EditSummary   null.String `boil:"edit_summary" json:"edit_summary,omitempty" toml:"edit_summary" yaml:"edit_summary,omitempty"`
+	RequestID     null.String `boil:"request_id" json:"request_id,omitempty" toml:"request_id" yaml:"request_id,omitempty"`
+	ClientIP      null.String `boil:"client_ip" json:"client_ip,omitempty" toml:"client_ip" yaml:"client_ip,omitempty"`
+	UserAgent     null.String `boil:"user_agent" json:"user_agent,omitempty" toml:"user_agent" yaml:"user_agent,omitempty"`
 
 	R *seriesR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L seriesL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -46,6 +50,10 @@ var SeriesColumns = struct {
 	ContributedBy string
 	ContributedAt string
 	Invalidation  string
+	EditSummary   string
+	RequestID     string
+	ClientIP      string
+	UserAgent     string
 }{
 	ID:            "id",
 	Title:         "title",
@@ -55,6 +63,10 @@ var SeriesColumns = struct {
 	ContributedBy: "contributed_by",
 	ContributedAt: "contributed_at",
 	Invalidation:  "invalidation",
+	EditSummary:   "edit_summary",
+	RequestID:     "request_id",
+	ClientIP:      "client_ip",
+	UserAgent:     "user_agent",
 }
 
 var SeriesTableColumns = struct {
@@ -66,6 +78,10 @@ var SeriesTableColumns = struct {
 	ContributedBy string
 	ContributedAt string
 	Invalidation  string
+	EditSummary   string
+	RequestID     string
+	ClientIP      string
+	UserAgent     string
 }{
 	ID:            "serieses.id",
 	Title:         "serieses.title",
@@ -75,34 +91,14 @@ var SeriesTableColumns = struct {
 	ContributedBy: "serieses.contributed_by",
 	ContributedAt: "serieses.contributed_at",
 	Invalidation:  "serieses.invalidation",
+	EditSummary:   "serieses.edit_summary",
+	RequestID:     "serieses.request_id",
+	ClientIP:      "serieses.client_ip",
+	UserAgent:     "serieses.user_agent",
 }
 
 // Generated where
 
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var SeriesWhere = struct {
 	ID            whereHelperint
 	Title         whereHelperstring
@@ -112,6 +108,10 @@ var SeriesWhere = struct {
 	ContributedBy whereHelperint
 	ContributedAt whereHelpertime_Time
 	Invalidation  whereHelpernull_String
+	EditSummary   whereHelpernull_String
+	RequestID     whereHelpernull_String
+	ClientIP      whereHelpernull_String
+	UserAgent     whereHelpernull_String
 }{
 	ID:            whereHelperint{field: "\"serieses\".\"id\""},
 	Title:         whereHelperstring{field: "\"serieses\".\"title\""},
@@ -121,21 +121,34 @@ var SeriesWhere = struct {
 	ContributedBy: whereHelperint{field: "\"serieses\".\"contributed_by\""},
 	ContributedAt: whereHelpertime_Time{field: "\"serieses\".\"contributed_at\""},
 	Invalidation:  whereHelpernull_String{field: "\"serieses\".\"invalidation\""},
+	EditSummary:   whereHelpernull_String{field: "\"serieses\".\"edit_summary\""},
+	RequestID:     whereHelpernull_String{field: "\"serieses\".\"request_id\""},
+	ClientIP:      whereHelpernull_String{field: "\"serieses\".\"client_ip\""},
+	UserAgent:     whereHelpernull_String{field: "\"serieses\".\"user_agent\""},
 }
 
 // SeriesRels is where relationship names are stored.
 var SeriesRels = struct {
 	ContributingUser string
 	SeriesFilms      string
+	Posts            string
+	SeriesProposals  string
+	SeriesSeasons    string
 }{
 	ContributingUser: "ContributingUser",
 	SeriesFilms:      "SeriesFilms",
+	Posts:            "Posts",
+	SeriesProposals:  "SeriesProposals",
+	SeriesSeasons:    "SeriesSeasons",
 }
 
 // seriesR is where relationships are stored.
 type seriesR struct {
-	ContributingUser *User     `boil:"ContributingUser" json:"ContributingUser" toml:"ContributingUser" yaml:"ContributingUser"`
-	SeriesFilms      FilmSlice `boil:"SeriesFilms" json:"SeriesFilms" toml:"SeriesFilms" yaml:"SeriesFilms"`
+	ContributingUser *User         `boil:"ContributingUser" json:"ContributingUser" toml:"ContributingUser" yaml:"ContributingUser"`
+	SeriesFilms      FilmSlice     `boil:"SeriesFilms" json:"SeriesFilms" toml:"SeriesFilms" yaml:"SeriesFilms"`
+	Posts            PostSlice     `boil:"Posts" json:"Posts" toml:"Posts" yaml:"Posts"`
+	SeriesProposals  ProposalSlice `boil:"SeriesProposals" json:"SeriesProposals" toml:"SeriesProposals" yaml:"SeriesProposals"`
+	SeriesSeasons    SeasonSlice   `boil:"SeriesSeasons" json:"SeriesSeasons" toml:"SeriesSeasons" yaml:"SeriesSeasons"`
 }
 
 // NewStruct creates a new relationship struct
@@ -157,13 +170,34 @@ func (r *seriesR) GetSeriesFilms() FilmSlice {
 	return r.SeriesFilms
 }
 
+func (r *seriesR) GetPosts() PostSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Posts
+}
+
+func (r *seriesR) GetSeriesProposals() ProposalSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeriesProposals
+}
+
+func (r *seriesR) GetSeriesSeasons() SeasonSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeriesSeasons
+}
+
 // seriesL is where Load methods for each relationship are stored.
 type seriesL struct{}
 
 var (
-	seriesAllColumns            = []string{"id", "title", "descriptions", "date_started", "date_ended", "contributed_by", "contributed_at", "invalidation"}
+	seriesAllColumns            = []string{"id", "title", "descriptions", "date_started", "date_ended", "contributed_by", "contributed_at", "invalidation", "edit_summary", "request_id", "client_ip", "user_agent"}
 	seriesColumnsWithoutDefault = []string{"title", "date_started", "contributed_by"}
-	seriesColumnsWithDefault    = []string{"id", "descriptions", "date_ended", "contributed_at", "invalidation"}
+	seriesColumnsWithDefault    = []string{"id", "descriptions", "date_ended", "contributed_at", "invalidation", "edit_summary", "request_id", "client_ip", "user_agent"}
 	seriesPrimaryKeyColumns     = []string{"id"}
 	seriesGeneratedColumns      = []string{}
 )
@@ -471,6 +505,49 @@ func (o *Series) SeriesFilms(mods ...qm.QueryMod) filmQuery {
 	return Films(queryMods...)
 }
 
+// Posts retrieves all the post's Posts with an executor.
+func (o *Series) Posts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"post_serieses\" on \"posts\".\"id\" = \"post_serieses\".\"post_id\""),
+		qm.Where("\"post_serieses\".\"series_id\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
+// SeriesProposals retrieves all the proposal's Proposals with an executor via series_id column.
+func (o *Series) SeriesProposals(mods ...qm.QueryMod) proposalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"proposals\".\"series_id\"=?", o.ID),
+	)
+
+	return Proposals(queryMods...)
+}
+
+// SeriesSeasons retrieves all the season's Seasons with an executor via series_id column.
+func (o *Series) SeriesSeasons(mods ...qm.QueryMod) seasonQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"seasons\".\"series_id\"=?", o.ID),
+	)
+
+	return Seasons(queryMods...)
+}
+
 // LoadContributingUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (seriesL) LoadContributingUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
@@ -705,180 +782,864 @@ func (seriesL) LoadSeriesFilms(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// SetContributingUser of the series to the related item.
-// Sets o.R.ContributingUser to related.
-// Adds o to related.R.ContributedSerieses.
-func (o *Series) SetContributingUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"serieses\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
-		strmangle.WhereClause("\"", "\"", 2, seriesPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (seriesL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+	var slice []*Series
+	var object *Series
 
-	o.ContributedBy = related.ID
-	if o.R == nil {
-		o.R = &seriesR{
-			ContributingUser: related,
+	if singular {
+		var ok bool
+		object, ok = maybeSeries.(*Series)
+		if !ok {
+			object = new(Series)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeries))
+			}
 		}
 	} else {
-		o.R.ContributingUser = related
+		s, ok := maybeSeries.(*[]*Series)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeries))
+			}
+		}
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			ContributedSerieses: SeriesSlice{o},
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		related.R.ContributedSerieses = append(related.R.ContributedSerieses, o)
-	}
-
-	return nil
-}
-
-// AddSeriesFilms adds the given related objects to the existing relationships
-// of the seriese, optionally inserting them as new records.
-// Appends related to o.R.SeriesFilms.
-// Sets related.R.Series appropriately.
-func (o *Series) AddSeriesFilms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Film) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			queries.Assign(&rel.SeriesID, o.ID)
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesR{}
 			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"films\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
-				strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
-			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
 			}
 
-			queries.Assign(&rel.SeriesID, o.ID)
+			args = append(args, obj.ID)
 		}
 	}
 
-	if o.R == nil {
-		o.R = &seriesR{
-			SeriesFilms: related,
-		}
-	} else {
-		o.R.SeriesFilms = append(o.R.SeriesFilms, related...)
+	if len(args) == 0 {
+		return nil
 	}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &filmR{
-				Series: o,
-			}
-		} else {
-			rel.R.Series = o
-		}
+	query := NewQuery(
+		qm.Select("\"posts\".\"id\", \"posts\".\"user_id\", \"posts\".\"post_id\", \"posts\".\"body\", \"posts\".\"posted_at\", \"posts\".\"edited_at\", \"a\".\"series_id\""),
+		qm.From("\"posts\""),
+		qm.InnerJoin("\"post_serieses\" as \"a\" on \"posts\".\"id\" = \"a\".\"post_id\""),
+		qm.WhereIn("\"a\".\"series_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
-	return nil
-}
 
-// SetSeriesFilms removes all previously related items of the
-// seriese replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.Series's SeriesFilms accordingly.
-// Replaces o.R.SeriesFilms with related.
-// Sets related.R.Series's SeriesFilms accordingly.
-func (o *Series) SetSeriesFilms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Film) error {
-	query := "update \"films\" set \"series_id\" = null where \"series_id\" = $1"
-	values := []interface{}{o.ID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
+		return errors.Wrap(err, "failed to eager load posts")
 	}
 
-	if o.R != nil {
-		for _, rel := range o.R.SeriesFilms {
-			queries.SetScanner(&rel.SeriesID, nil)
-			if rel.R == nil {
-				continue
-			}
+	var resultSlice []*Post
 
-			rel.R.Series = nil
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Post)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.UserID, &one.PostID, &one.Body, &one.PostedAt, &one.EditedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for posts")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice posts")
 		}
-		o.R.SeriesFilms = nil
-	}
 
-	return o.AddSeriesFilms(ctx, exec, insert, related...)
-}
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
 
-// RemoveSeriesFilms relationships from objects passed in.
-// Removes related items from R.SeriesFilms (uses pointer comparison, removal does not keep order)
-// Sets related.R.Series.
-func (o *Series) RemoveSeriesFilms(ctx context.Context, exec boil.ContextExecutor, related ...*Film) error {
-	if len(related) == 0 {
-		return nil
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
 	}
 
-	var err error
-	for _, rel := range related {
-		queries.SetScanner(&rel.SeriesID, nil)
-		if rel.R != nil {
-			rel.R.Series = nil
-		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
-			return err
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
 	}
-	if o.R == nil {
+	if singular {
+		object.R.Posts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.Serieses = append(foreign.R.Serieses, object)
+		}
 		return nil
 	}
 
-	for _, rel := range related {
-		for i, ri := range o.R.SeriesFilms {
-			if rel != ri {
-				continue
-			}
-
-			ln := len(o.R.SeriesFilms)
-			if ln > 1 && i < ln-1 {
-				o.R.SeriesFilms[i] = o.R.SeriesFilms[ln-1]
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Posts = append(local.R.Posts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.Serieses = append(foreign.R.Serieses, local)
+				break
 			}
-			o.R.SeriesFilms = o.R.SeriesFilms[:ln-1]
-			break
 		}
 	}
 
 	return nil
 }
 
+// LoadSeriesProposals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (seriesL) LoadSeriesProposals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+	var slice []*Series
+	var object *Series
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeries.(*Series)
+		if !ok {
+			object = new(Series)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeries))
+			}
+		}
+	} else {
+		s, ok := maybeSeries.(*[]*Series)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeries))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`proposals`),
+		qm.WhereIn(`proposals.series_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load proposals")
+	}
+
+	var resultSlice []*Proposal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice proposals")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on proposals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for proposals")
+	}
+
+	if len(proposalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SeriesProposals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &proposalR{}
+			}
+			foreign.R.Series = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SeriesID) {
+				local.R.SeriesProposals = append(local.R.SeriesProposals, foreign)
+				if foreign.R == nil {
+					foreign.R = &proposalR{}
+				}
+				foreign.R.Series = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeriesSeasons allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (seriesL) LoadSeriesSeasons(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+	var slice []*Series
+	var object *Series
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeries.(*Series)
+		if !ok {
+			object = new(Series)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeries))
+			}
+		}
+	} else {
+		s, ok := maybeSeries.(*[]*Series)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeries))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`seasons`),
+		qm.WhereIn(`seasons.series_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load seasons")
+	}
+
+	var resultSlice []*Season
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice seasons")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on seasons")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for seasons")
+	}
+
+	if len(seasonAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SeriesSeasons = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &seasonR{}
+			}
+			foreign.R.Series = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SeriesID {
+				local.R.SeriesSeasons = append(local.R.SeriesSeasons, foreign)
+				if foreign.R == nil {
+					foreign.R = &seasonR{}
+				}
+				foreign.R.Series = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetContributingUser of the series to the related item.
+// Sets o.R.ContributingUser to related.
+// Adds o to related.R.ContributedSerieses.
+func (o *Series) SetContributingUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"serieses\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+		strmangle.WhereClause("\"", "\"", 2, seriesPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ContributedBy = related.ID
+	if o.R == nil {
+		o.R = &seriesR{
+			ContributingUser: related,
+		}
+	} else {
+		o.R.ContributingUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ContributedSerieses: SeriesSlice{o},
+		}
+	} else {
+		related.R.ContributedSerieses = append(related.R.ContributedSerieses, o)
+	}
+
+	return nil
+}
+
+// AddSeriesFilms adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.SeriesFilms.
+// Sets related.R.Series appropriately.
+func (o *Series) AddSeriesFilms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Film) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SeriesID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"films\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+				strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SeriesID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &seriesR{
+			SeriesFilms: related,
+		}
+	} else {
+		o.R.SeriesFilms = append(o.R.SeriesFilms, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &filmR{
+				Series: o,
+			}
+		} else {
+			rel.R.Series = o
+		}
+	}
+	return nil
+}
+
+// SetSeriesFilms removes all previously related items of the
+// seriese replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Series's SeriesFilms accordingly.
+// Replaces o.R.SeriesFilms with related.
+// Sets related.R.Series's SeriesFilms accordingly.
+func (o *Series) SetSeriesFilms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Film) error {
+	query := "update \"films\" set \"series_id\" = null where \"series_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SeriesFilms {
+			queries.SetScanner(&rel.SeriesID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Series = nil
+		}
+		o.R.SeriesFilms = nil
+	}
+
+	return o.AddSeriesFilms(ctx, exec, insert, related...)
+}
+
+// RemoveSeriesFilms relationships from objects passed in.
+// Removes related items from R.SeriesFilms (uses pointer comparison, removal does not keep order)
+// Sets related.R.Series.
+func (o *Series) RemoveSeriesFilms(ctx context.Context, exec boil.ContextExecutor, related ...*Film) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SeriesID, nil)
+		if rel.R != nil {
+			rel.R.Series = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SeriesFilms {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SeriesFilms)
+			if ln > 1 && i < ln-1 {
+				o.R.SeriesFilms[i] = o.R.SeriesFilms[ln-1]
+			}
+			o.R.SeriesFilms = o.R.SeriesFilms[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddPosts adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.Posts.
+// Sets related.R.Serieses appropriately.
+func (o *Series) AddPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"post_serieses\" (\"series_id\", \"post_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &seriesR{
+			Posts: related,
+		}
+	} else {
+		o.R.Posts = append(o.R.Posts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				Serieses: SeriesSlice{o},
+			}
+		} else {
+			rel.R.Serieses = append(rel.R.Serieses, o)
+		}
+	}
+	return nil
+}
+
+// SetPosts removes all previously related items of the
+// seriese replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Serieses's Posts accordingly.
+// Replaces o.R.Posts with related.
+// Sets related.R.Serieses's Posts accordingly.
+func (o *Series) SetPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	query := "delete from \"post_serieses\" where \"series_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removePostsFromSeriesesSlice(o, related)
+	if o.R != nil {
+		o.R.Posts = nil
+	}
+
+	return o.AddPosts(ctx, exec, insert, related...)
+}
+
+// RemovePosts relationships from objects passed in.
+// Removes related items from R.Posts (uses pointer comparison, removal does not keep order)
+// Sets related.R.Serieses.
+func (o *Series) RemovePosts(ctx context.Context, exec boil.ContextExecutor, related ...*Post) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"post_serieses\" where \"series_id\" = $1 and \"post_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removePostsFromSeriesesSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Posts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Posts)
+			if ln > 1 && i < ln-1 {
+				o.R.Posts[i] = o.R.Posts[ln-1]
+			}
+			o.R.Posts = o.R.Posts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removePostsFromSeriesesSlice(o *Series, related []*Post) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Serieses {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Serieses)
+			if ln > 1 && i < ln-1 {
+				rel.R.Serieses[i] = rel.R.Serieses[ln-1]
+			}
+			rel.R.Serieses = rel.R.Serieses[:ln-1]
+			break
+		}
+	}
+}
+
+// AddSeriesProposals adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.SeriesProposals.
+// Sets related.R.Series appropriately.
+func (o *Series) AddSeriesProposals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Proposal) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SeriesID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"proposals\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+				strmangle.WhereClause("\"", "\"", 2, proposalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SeriesID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &seriesR{
+			SeriesProposals: related,
+		}
+	} else {
+		o.R.SeriesProposals = append(o.R.SeriesProposals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &proposalR{
+				Series: o,
+			}
+		} else {
+			rel.R.Series = o
+		}
+	}
+	return nil
+}
+
+// SetSeriesProposals removes all previously related items of the
+// seriese replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Series's SeriesProposals accordingly.
+// Replaces o.R.SeriesProposals with related.
+// Sets related.R.Series's SeriesProposals accordingly.
+func (o *Series) SetSeriesProposals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Proposal) error {
+	query := "update \"proposals\" set \"series_id\" = null where \"series_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SeriesProposals {
+			queries.SetScanner(&rel.SeriesID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Series = nil
+		}
+		o.R.SeriesProposals = nil
+	}
+
+	return o.AddSeriesProposals(ctx, exec, insert, related...)
+}
+
+// RemoveSeriesProposals relationships from objects passed in.
+// Removes related items from R.SeriesProposals (uses pointer comparison, removal does not keep order)
+// Sets related.R.Series.
+func (o *Series) RemoveSeriesProposals(ctx context.Context, exec boil.ContextExecutor, related ...*Proposal) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SeriesID, nil)
+		if rel.R != nil {
+			rel.R.Series = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SeriesProposals {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SeriesProposals)
+			if ln > 1 && i < ln-1 {
+				o.R.SeriesProposals[i] = o.R.SeriesProposals[ln-1]
+			}
+			o.R.SeriesProposals = o.R.SeriesProposals[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSeriesSeasons adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.SeriesSeasons.
+// Sets related.R.Series appropriately.
+func (o *Series) AddSeriesSeasons(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Season) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SeriesID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"seasons\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+				strmangle.WhereClause("\"", "\"", 2, seasonPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.SeriesID, rel.SeasonNumber}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SeriesID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &seriesR{
+			SeriesSeasons: related,
+		}
+	} else {
+		o.R.SeriesSeasons = append(o.R.SeriesSeasons, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &seasonR{
+				Series: o,
+			}
+		} else {
+			rel.R.Series = o
+		}
+	}
+	return nil
+}
+
 // Serieses retrieves all the records using an executor.
 func Serieses(mods ...qm.QueryMod) seriesQuery {
 	mods = append(mods, qm.From("\"serieses\""))
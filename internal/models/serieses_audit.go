@@ -32,6 +32,10 @@ type SeriesesAudit struct {
 	ContributedBy int         `boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
 	ContributedAt time.Time   `boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
 	Invalidation  null.String `boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	EditSummary   null.String `boil:"edit_summary" json:"edit_summary,omitempty" toml:"edit_summary" yaml:"edit_summary,omitempty"`
+	RequestID     null.String `boil:"request_id" json:"request_id,omitempty" toml:"request_id" yaml:"request_id,omitempty"`
+	ClientIP      null.String `boil:"client_ip" json:"client_ip,omitempty" toml:"client_ip" yaml:"client_ip,omitempty"`
+	UserAgent     null.String `boil:"user_agent" json:"user_agent,omitempty" toml:"user_agent" yaml:"user_agent,omitempty"`
 
 	R *seriesesAuditR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L seriesesAuditL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -46,6 +50,10 @@ var SeriesesAuditColumns = struct {
 	ContributedBy string
 	ContributedAt string
 	Invalidation  string
+	EditSummary   string
+	RequestID     string
+	ClientIP      string
+	UserAgent     string
 }{
 	ID:            "id",
 	Title:         "title",
@@ -55,6 +63,10 @@ var SeriesesAuditColumns = struct {
 	ContributedBy: "contributed_by",
 	ContributedAt: "contributed_at",
 	Invalidation:  "invalidation",
+	EditSummary:   "edit_summary",
+	RequestID:     "request_id",
+	ClientIP:      "client_ip",
+	UserAgent:     "user_agent",
 }
 
 var SeriesesAuditTableColumns = struct {
@@ -66,6 +78,10 @@ var SeriesesAuditTableColumns = struct {
 	ContributedBy string
 	ContributedAt string
 	Invalidation  string
+	EditSummary   string
+	RequestID     string
+	ClientIP      string
+	UserAgent     string
 }{
 	ID:            "serieses_audit.id",
 	Title:         "serieses_audit.title",
@@ -75,6 +91,10 @@ var SeriesesAuditTableColumns = struct {
 	ContributedBy: "serieses_audit.contributed_by",
 	ContributedAt: "serieses_audit.contributed_at",
 	Invalidation:  "serieses_audit.invalidation",
+	EditSummary:   "serieses_audit.edit_summary",
+	RequestID:     "serieses_audit.request_id",
+	ClientIP:      "serieses_audit.client_ip",
+	UserAgent:     "serieses_audit.user_agent",
 }
 
 // Generated where
@@ -88,6 +108,10 @@ var SeriesesAuditWhere = struct {
 	ContributedBy whereHelperint
 	ContributedAt whereHelpertime_Time
 	Invalidation  whereHelpernull_String
+	EditSummary   whereHelpernull_String
+	RequestID     whereHelpernull_String
+	ClientIP      whereHelpernull_String
+	UserAgent     whereHelpernull_String
 }{
 	ID:            whereHelperint{field: "\"serieses_audit\".\"id\""},
 	Title:         whereHelperstring{field: "\"serieses_audit\".\"title\""},
@@ -97,6 +121,10 @@ var SeriesesAuditWhere = struct {
 	ContributedBy: whereHelperint{field: "\"serieses_audit\".\"contributed_by\""},
 	ContributedAt: whereHelpertime_Time{field: "\"serieses_audit\".\"contributed_at\""},
 	Invalidation:  whereHelpernull_String{field: "\"serieses_audit\".\"invalidation\""},
+	EditSummary:   whereHelpernull_String{field: "\"serieses_audit\".\"edit_summary\""},
+	RequestID:     whereHelpernull_String{field: "\"serieses_audit\".\"request_id\""},
+	ClientIP:      whereHelpernull_String{field: "\"serieses_audit\".\"client_ip\""},
+	UserAgent:     whereHelpernull_String{field: "\"serieses_audit\".\"user_agent\""},
 }
 
 // SeriesesAuditRels is where relationship names are stored.
@@ -116,9 +144,9 @@ func (*seriesesAuditR) NewStruct() *seriesesAuditR {
 type seriesesAuditL struct{}
 
 var (
-	seriesesAuditAllColumns            = []string{"id", "title", "descriptions", "date_started", "date_ended", "contributed_by", "contributed_at", "invalidation"}
+	seriesesAuditAllColumns            = []string{"id", "title", "descriptions", "date_started", "date_ended", "contributed_by", "contributed_at", "invalidation", "edit_summary", "request_id", "client_ip", "user_agent"}
 	seriesesAuditColumnsWithoutDefault = []string{"id", "title", "date_started", "contributed_by", "contributed_at"}
-	seriesesAuditColumnsWithDefault    = []string{"descriptions", "date_ended", "invalidation"}
+	seriesesAuditColumnsWithDefault    = []string{"descriptions", "date_ended", "invalidation", "edit_summary", "request_id", "client_ip", "user_agent"}
 	seriesesAuditPrimaryKeyColumns     = []string{"id", "contributed_by", "contributed_at"}
 	seriesesAuditGeneratedColumns      = []string{}
 )
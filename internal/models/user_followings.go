@@ -0,0 +1,1270 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// UserFollowing is an object representing the database table.
+type UserFollowing struct {
+	FollowerID int       `boil:"follower_id" json:"follower_id" toml:"follower_id" yaml:"follower_id"`
+	FollowedID int       `boil:"followed_id" json:"followed_id" toml:"followed_id" yaml:"followed_id"`
+	FollowedAt time.Time `boil:"followed_at" json:"followed_at" toml:"followed_at" yaml:"followed_at"`
+
+	R *userFollowingR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L userFollowingL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UserFollowingColumns = struct {
+	FollowerID string
+	FollowedID string
+	FollowedAt string
+}{
+	FollowerID: "follower_id",
+	FollowedID: "followed_id",
+	FollowedAt: "followed_at",
+}
+
+var UserFollowingTableColumns = struct {
+	FollowerID string
+	FollowedID string
+	FollowedAt string
+}{
+	FollowerID: "user_followings.follower_id",
+	FollowedID: "user_followings.followed_id",
+	FollowedAt: "user_followings.followed_at",
+}
+
+// Generated where
+
+var UserFollowingWhere = struct {
+	FollowerID whereHelperint
+	FollowedID whereHelperint
+	FollowedAt whereHelpertime_Time
+}{
+	FollowerID: whereHelperint{field: "\"user_followings\".\"follower_id\""},
+	FollowedID: whereHelperint{field: "\"user_followings\".\"followed_id\""},
+	FollowedAt: whereHelpertime_Time{field: "\"user_followings\".\"followed_at\""},
+}
+
+// UserFollowingRels is where relationship names are stored.
+var UserFollowingRels = struct {
+	Follower string
+	Followed string
+}{
+	Follower: "Follower",
+	Followed: "Followed",
+}
+
+// userFollowingR is where relationships are stored.
+type userFollowingR struct {
+	Follower *User `boil:"Follower" json:"Follower" toml:"Follower" yaml:"Follower"`
+	Followed *User `boil:"Followed" json:"Followed" toml:"Followed" yaml:"Followed"`
+}
+
+// NewStruct creates a new relationship struct
+func (*userFollowingR) NewStruct() *userFollowingR {
+	return &userFollowingR{}
+}
+
+func (r *userFollowingR) GetFollower() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Follower
+}
+
+func (r *userFollowingR) GetFollowed() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Followed
+}
+
+// userFollowingL is where Load methods for each relationship are stored.
+type userFollowingL struct{}
+
+var (
+	userFollowingAllColumns            = []string{"follower_id", "followed_id", "followed_at"}
+	userFollowingColumnsWithoutDefault = []string{"follower_id", "followed_id"}
+	userFollowingColumnsWithDefault    = []string{"followed_at"}
+	userFollowingPrimaryKeyColumns     = []string{"follower_id", "followed_id"}
+	userFollowingGeneratedColumns      = []string{}
+)
+
+type (
+	// UserFollowingSlice is an alias for a slice of pointers to UserFollowing.
+	// This should almost always be used instead of []UserFollowing.
+	UserFollowingSlice []*UserFollowing
+	// UserFollowingHook is the signature for custom UserFollowing hook methods
+	UserFollowingHook func(context.Context, boil.ContextExecutor, *UserFollowing) error
+
+	userFollowingQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	userFollowingType                 = reflect.TypeOf(&UserFollowing{})
+	userFollowingMapping              = queries.MakeStructMapping(userFollowingType)
+	userFollowingPrimaryKeyMapping, _ = queries.BindMapping(userFollowingType, userFollowingMapping, userFollowingPrimaryKeyColumns)
+	userFollowingInsertCacheMut       sync.RWMutex
+	userFollowingInsertCache          = make(map[string]insertCache)
+	userFollowingUpdateCacheMut       sync.RWMutex
+	userFollowingUpdateCache          = make(map[string]updateCache)
+	userFollowingUpsertCacheMut       sync.RWMutex
+	userFollowingUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var userFollowingAfterSelectHooks []UserFollowingHook
+
+var userFollowingBeforeInsertHooks []UserFollowingHook
+var userFollowingAfterInsertHooks []UserFollowingHook
+
+var userFollowingBeforeUpdateHooks []UserFollowingHook
+var userFollowingAfterUpdateHooks []UserFollowingHook
+
+var userFollowingBeforeDeleteHooks []UserFollowingHook
+var userFollowingAfterDeleteHooks []UserFollowingHook
+
+var userFollowingBeforeUpsertHooks []UserFollowingHook
+var userFollowingAfterUpsertHooks []UserFollowingHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UserFollowing) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userFollowingAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UserFollowing) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userFollowingBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UserFollowing) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userFollowingAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UserFollowing) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userFollowingBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UserFollowing) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userFollowingAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UserFollowing) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userFollowingBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UserFollowing) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userFollowingAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UserFollowing) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userFollowingBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UserFollowing) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userFollowingAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserFollowingHook registers your hook function for all future operations.
+func AddUserFollowingHook(hookPoint boil.HookPoint, userFollowingHook UserFollowingHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		userFollowingAfterSelectHooks = append(userFollowingAfterSelectHooks, userFollowingHook)
+	case boil.BeforeInsertHook:
+		userFollowingBeforeInsertHooks = append(userFollowingBeforeInsertHooks, userFollowingHook)
+	case boil.AfterInsertHook:
+		userFollowingAfterInsertHooks = append(userFollowingAfterInsertHooks, userFollowingHook)
+	case boil.BeforeUpdateHook:
+		userFollowingBeforeUpdateHooks = append(userFollowingBeforeUpdateHooks, userFollowingHook)
+	case boil.AfterUpdateHook:
+		userFollowingAfterUpdateHooks = append(userFollowingAfterUpdateHooks, userFollowingHook)
+	case boil.BeforeDeleteHook:
+		userFollowingBeforeDeleteHooks = append(userFollowingBeforeDeleteHooks, userFollowingHook)
+	case boil.AfterDeleteHook:
+		userFollowingAfterDeleteHooks = append(userFollowingAfterDeleteHooks, userFollowingHook)
+	case boil.BeforeUpsertHook:
+		userFollowingBeforeUpsertHooks = append(userFollowingBeforeUpsertHooks, userFollowingHook)
+	case boil.AfterUpsertHook:
+		userFollowingAfterUpsertHooks = append(userFollowingAfterUpsertHooks, userFollowingHook)
+	}
+}
+
+// One returns a single userFollowing record from the query.
+func (q userFollowingQuery) One(ctx context.Context, exec boil.ContextExecutor) (*UserFollowing, error) {
+	o := &UserFollowing{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for user_followings")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all UserFollowing records from the query.
+func (q userFollowingQuery) All(ctx context.Context, exec boil.ContextExecutor) (UserFollowingSlice, error) {
+	var o []*UserFollowing
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to UserFollowing slice")
+	}
+
+	if len(userFollowingAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UserFollowing records in the query.
+func (q userFollowingQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count user_followings rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q userFollowingQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if user_followings exists")
+	}
+
+	return count > 0, nil
+}
+
+// Follower pointed to by the foreign key.
+func (o *UserFollowing) Follower(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FollowerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Followed pointed to by the foreign key.
+func (o *UserFollowing) Followed(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FollowedID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadFollower allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userFollowingL) LoadFollower(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserFollowing interface{}, mods queries.Applicator) error {
+	var slice []*UserFollowing
+	var object *UserFollowing
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserFollowing.(*UserFollowing)
+		if !ok {
+			object = new(UserFollowing)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserFollowing)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserFollowing))
+			}
+		}
+	} else {
+		s, ok := maybeUserFollowing.(*[]*UserFollowing)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserFollowing)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserFollowing))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userFollowingR{}
+		}
+		args = append(args, object.FollowerID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userFollowingR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FollowerID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FollowerID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userFollowingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Follower = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.FollowerUserFollowings = append(foreign.R.FollowerUserFollowings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FollowerID == foreign.ID {
+				local.R.Follower = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.FollowerUserFollowings = append(foreign.R.FollowerUserFollowings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFollowed allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userFollowingL) LoadFollowed(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserFollowing interface{}, mods queries.Applicator) error {
+	var slice []*UserFollowing
+	var object *UserFollowing
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserFollowing.(*UserFollowing)
+		if !ok {
+			object = new(UserFollowing)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserFollowing)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserFollowing))
+			}
+		}
+	} else {
+		s, ok := maybeUserFollowing.(*[]*UserFollowing)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserFollowing)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserFollowing))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userFollowingR{}
+		}
+		args = append(args, object.FollowedID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userFollowingR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FollowedID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FollowedID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userFollowingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Followed = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.FollowedUserFollowings = append(foreign.R.FollowedUserFollowings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FollowedID == foreign.ID {
+				local.R.Followed = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.FollowedUserFollowings = append(foreign.R.FollowedUserFollowings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetFollower of the userFollowing to the related item.
+// Sets o.R.Follower to related.
+// Adds o to related.R.FollowerUserFollowings.
+func (o *UserFollowing) SetFollower(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_followings\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"follower_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userFollowingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.FollowerID, o.FollowedID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FollowerID = related.ID
+	if o.R == nil {
+		o.R = &userFollowingR{
+			Follower: related,
+		}
+	} else {
+		o.R.Follower = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			FollowerUserFollowings: UserFollowingSlice{o},
+		}
+	} else {
+		related.R.FollowerUserFollowings = append(related.R.FollowerUserFollowings, o)
+	}
+
+	return nil
+}
+
+// SetFollowed of the userFollowing to the related item.
+// Sets o.R.Followed to related.
+// Adds o to related.R.FollowedUserFollowings.
+func (o *UserFollowing) SetFollowed(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_followings\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"followed_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userFollowingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.FollowerID, o.FollowedID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FollowedID = related.ID
+	if o.R == nil {
+		o.R = &userFollowingR{
+			Followed: related,
+		}
+	} else {
+		o.R.Followed = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			FollowedUserFollowings: UserFollowingSlice{o},
+		}
+	} else {
+		related.R.FollowedUserFollowings = append(related.R.FollowedUserFollowings, o)
+	}
+
+	return nil
+}
+
+// UserFollowings retrieves all the records using an executor.
+func UserFollowings(mods ...qm.QueryMod) userFollowingQuery {
+	mods = append(mods, qm.From("\"user_followings\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"user_followings\".*"})
+	}
+
+	return userFollowingQuery{q}
+}
+
+// FindUserFollowing retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUserFollowing(ctx context.Context, exec boil.ContextExecutor, followerID int, followedID int, selectCols ...string) (*UserFollowing, error) {
+	userFollowingObj := &UserFollowing{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"user_followings\" where \"follower_id\"=$1 AND \"followed_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, followerID, followedID)
+
+	err := q.Bind(ctx, exec, userFollowingObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from user_followings")
+	}
+
+	if err = userFollowingObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return userFollowingObj, err
+	}
+
+	return userFollowingObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UserFollowing) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no user_followings provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userFollowingColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userFollowingInsertCacheMut.RLock()
+	cache, cached := userFollowingInsertCache[key]
+	userFollowingInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userFollowingAllColumns,
+			userFollowingColumnsWithDefault,
+			userFollowingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userFollowingType, userFollowingMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userFollowingType, userFollowingMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"user_followings\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"user_followings\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into user_followings")
+	}
+
+	if !cached {
+		userFollowingInsertCacheMut.Lock()
+		userFollowingInsertCache[key] = cache
+		userFollowingInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the UserFollowing.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UserFollowing) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	userFollowingUpdateCacheMut.RLock()
+	cache, cached := userFollowingUpdateCache[key]
+	userFollowingUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userFollowingAllColumns,
+			userFollowingPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update user_followings, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"user_followings\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, userFollowingPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userFollowingType, userFollowingMapping, append(wl, userFollowingPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update user_followings row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for user_followings")
+	}
+
+	if !cached {
+		userFollowingUpdateCacheMut.Lock()
+		userFollowingUpdateCache[key] = cache
+		userFollowingUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userFollowingQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for user_followings")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for user_followings")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserFollowingSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userFollowingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"user_followings\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, userFollowingPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in userFollowing slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all userFollowing")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UserFollowing) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no user_followings provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userFollowingColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userFollowingUpsertCacheMut.RLock()
+	cache, cached := userFollowingUpsertCache[key]
+	userFollowingUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			userFollowingAllColumns,
+			userFollowingColumnsWithDefault,
+			userFollowingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			userFollowingAllColumns,
+			userFollowingPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert user_followings, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(userFollowingPrimaryKeyColumns))
+			copy(conflict, userFollowingPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"user_followings\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(userFollowingType, userFollowingMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userFollowingType, userFollowingMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert user_followings")
+	}
+
+	if !cached {
+		userFollowingUpsertCacheMut.Lock()
+		userFollowingUpsertCache[key] = cache
+		userFollowingUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single UserFollowing record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UserFollowing) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no UserFollowing provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userFollowingPrimaryKeyMapping)
+	sql := "DELETE FROM \"user_followings\" WHERE \"follower_id\"=$1 AND \"followed_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from user_followings")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for user_followings")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q userFollowingQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no userFollowingQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from user_followings")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for user_followings")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserFollowingSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(userFollowingBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userFollowingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"user_followings\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userFollowingPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from userFollowing slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for user_followings")
+	}
+
+	if len(userFollowingAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UserFollowing) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUserFollowing(ctx, exec, o.FollowerID, o.FollowedID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserFollowingSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserFollowingSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userFollowingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"user_followings\".* FROM \"user_followings\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userFollowingPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in UserFollowingSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserFollowingExists checks if the UserFollowing row exists.
+func UserFollowingExists(ctx context.Context, exec boil.ContextExecutor, followerID int, followedID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"user_followings\" where \"follower_id\"=$1 AND \"followed_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, followerID, followedID)
+	}
+	row := exec.QueryRowContext(ctx, sql, followerID, followedID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if user_followings exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,941 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testUserFollowings(t *testing.T) {
+	t.Parallel()
+
+	query := UserFollowings()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testUserFollowingsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserFollowing{}
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := UserFollowings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testUserFollowingsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserFollowing{}
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := UserFollowings().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := UserFollowings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testUserFollowingsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserFollowing{}
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := UserFollowingSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := UserFollowings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testUserFollowingsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserFollowing{}
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := UserFollowingExists(ctx, tx, o.FollowerID, o.FollowedID)
+	if err != nil {
+		t.Errorf("Unable to check if UserFollowing exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected UserFollowingExists to return true, but got false.")
+	}
+}
+
+func testUserFollowingsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserFollowing{}
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	userFollowingFound, err := FindUserFollowing(ctx, tx, o.FollowerID, o.FollowedID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if userFollowingFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testUserFollowingsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserFollowing{}
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = UserFollowings().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testUserFollowingsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserFollowing{}
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := UserFollowings().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testUserFollowingsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	userFollowingOne := &UserFollowing{}
+	userFollowingTwo := &UserFollowing{}
+	if err = randomize.Struct(seed, userFollowingOne, userFollowingDBTypes, false, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+	if err = randomize.Struct(seed, userFollowingTwo, userFollowingDBTypes, false, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = userFollowingOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = userFollowingTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := UserFollowings().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testUserFollowingsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	userFollowingOne := &UserFollowing{}
+	userFollowingTwo := &UserFollowing{}
+	if err = randomize.Struct(seed, userFollowingOne, userFollowingDBTypes, false, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+	if err = randomize.Struct(seed, userFollowingTwo, userFollowingDBTypes, false, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = userFollowingOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = userFollowingTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserFollowings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func userFollowingBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *UserFollowing) error {
+	*o = UserFollowing{}
+	return nil
+}
+
+func userFollowingAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *UserFollowing) error {
+	*o = UserFollowing{}
+	return nil
+}
+
+func userFollowingAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *UserFollowing) error {
+	*o = UserFollowing{}
+	return nil
+}
+
+func userFollowingBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *UserFollowing) error {
+	*o = UserFollowing{}
+	return nil
+}
+
+func userFollowingAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *UserFollowing) error {
+	*o = UserFollowing{}
+	return nil
+}
+
+func userFollowingBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *UserFollowing) error {
+	*o = UserFollowing{}
+	return nil
+}
+
+func userFollowingAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *UserFollowing) error {
+	*o = UserFollowing{}
+	return nil
+}
+
+func userFollowingBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *UserFollowing) error {
+	*o = UserFollowing{}
+	return nil
+}
+
+func userFollowingAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *UserFollowing) error {
+	*o = UserFollowing{}
+	return nil
+}
+
+func testUserFollowingsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &UserFollowing{}
+	o := &UserFollowing{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize UserFollowing object: %s", err)
+	}
+
+	AddUserFollowingHook(boil.BeforeInsertHook, userFollowingBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	userFollowingBeforeInsertHooks = []UserFollowingHook{}
+
+	AddUserFollowingHook(boil.AfterInsertHook, userFollowingAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	userFollowingAfterInsertHooks = []UserFollowingHook{}
+
+	AddUserFollowingHook(boil.AfterSelectHook, userFollowingAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	userFollowingAfterSelectHooks = []UserFollowingHook{}
+
+	AddUserFollowingHook(boil.BeforeUpdateHook, userFollowingBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	userFollowingBeforeUpdateHooks = []UserFollowingHook{}
+
+	AddUserFollowingHook(boil.AfterUpdateHook, userFollowingAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	userFollowingAfterUpdateHooks = []UserFollowingHook{}
+
+	AddUserFollowingHook(boil.BeforeDeleteHook, userFollowingBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	userFollowingBeforeDeleteHooks = []UserFollowingHook{}
+
+	AddUserFollowingHook(boil.AfterDeleteHook, userFollowingAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	userFollowingAfterDeleteHooks = []UserFollowingHook{}
+
+	AddUserFollowingHook(boil.BeforeUpsertHook, userFollowingBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	userFollowingBeforeUpsertHooks = []UserFollowingHook{}
+
+	AddUserFollowingHook(boil.AfterUpsertHook, userFollowingAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	userFollowingAfterUpsertHooks = []UserFollowingHook{}
+}
+
+func testUserFollowingsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserFollowing{}
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserFollowings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testUserFollowingsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserFollowing{}
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(userFollowingColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserFollowings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testUserFollowingToOneUserUsingFollower(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local UserFollowing
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, userFollowingDBTypes, false, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.FollowerID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Follower().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := UserFollowingSlice{&local}
+	if err = local.L.LoadFollower(ctx, tx, false, (*[]*UserFollowing)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Follower == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Follower = nil
+	if err = local.L.LoadFollower(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Follower == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testUserFollowingToOneUserUsingFollowed(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local UserFollowing
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, userFollowingDBTypes, false, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.FollowedID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Followed().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := UserFollowingSlice{&local}
+	if err = local.L.LoadFollowed(ctx, tx, false, (*[]*UserFollowing)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Followed == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Followed = nil
+	if err = local.L.LoadFollowed(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Followed == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testUserFollowingToOneSetOpUserUsingFollower(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UserFollowing
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userFollowingDBTypes, false, strmangle.SetComplement(userFollowingPrimaryKeyColumns, userFollowingColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetFollower(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Follower != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.FollowerUserFollowings[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.FollowerID != x.ID {
+			t.Error("foreign key was wrong value", a.FollowerID)
+		}
+
+		if exists, err := UserFollowingExists(ctx, tx, a.FollowerID, a.FollowedID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testUserFollowingToOneSetOpUserUsingFollowed(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a UserFollowing
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, userFollowingDBTypes, false, strmangle.SetComplement(userFollowingPrimaryKeyColumns, userFollowingColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetFollowed(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Followed != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.FollowedUserFollowings[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.FollowedID != x.ID {
+			t.Error("foreign key was wrong value", a.FollowedID)
+		}
+
+		if exists, err := UserFollowingExists(ctx, tx, a.FollowerID, a.FollowedID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testUserFollowingsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserFollowing{}
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testUserFollowingsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserFollowing{}
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := UserFollowingSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testUserFollowingsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserFollowing{}
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := UserFollowings().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	userFollowingDBTypes = map[string]string{`FollowerID`: `integer`, `FollowedID`: `integer`, `FollowedAt`: `timestamp with time zone`}
+	_                    = bytes.MinRead
+)
+
+func testUserFollowingsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(userFollowingPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(userFollowingAllColumns) == len(userFollowingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserFollowing{}
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserFollowings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testUserFollowingsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(userFollowingAllColumns) == len(userFollowingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserFollowing{}
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserFollowings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, userFollowingDBTypes, true, userFollowingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(userFollowingAllColumns, userFollowingPrimaryKeyColumns) {
+		fields = userFollowingAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			userFollowingAllColumns,
+			userFollowingPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := UserFollowingSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testUserFollowingsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(userFollowingAllColumns) == len(userFollowingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := UserFollowing{}
+	if err = randomize.Struct(seed, &o, userFollowingDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert UserFollowing: %s", err)
+	}
+
+	count, err := UserFollowings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, userFollowingDBTypes, false, userFollowingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize UserFollowing struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert UserFollowing: %s", err)
+	}
+
+	count, err = UserFollowings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -32,6 +32,8 @@ type User struct {
 	Bio            null.String `boil:"bio" json:"bio,omitempty" toml:"bio" yaml:"bio,omitempty"`
 	Birthdate      null.Time   `boil:"birthdate" json:"birthdate,omitempty" toml:"birthdate" yaml:"birthdate,omitempty"`
 	Joindate       time.Time   `boil:"joindate" json:"joindate" toml:"joindate" yaml:"joindate"`
+	ContributedAt  time.Time   `boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	DeletedAt      null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -46,6 +48,8 @@ var UserColumns = struct {
 	Bio            string
 	Birthdate      string
 	Joindate       string
+	ContributedAt  string
+	DeletedAt      string
 }{
 	ID:             "id",
 	Email:          "email",
@@ -55,6 +59,8 @@ var UserColumns = struct {
 	Bio:            "bio",
 	Birthdate:      "birthdate",
 	Joindate:       "joindate",
+	ContributedAt:  "contributed_at",
+	DeletedAt:      "deleted_at",
 }
 
 var UserTableColumns = struct {
@@ -66,6 +72,8 @@ var UserTableColumns = struct {
 	Bio            string
 	Birthdate      string
 	Joindate       string
+	ContributedAt  string
+	DeletedAt      string
 }{
 	ID:             "users.id",
 	Email:          "users.email",
@@ -75,6 +83,8 @@ var UserTableColumns = struct {
 	Bio:            "users.bio",
 	Birthdate:      "users.birthdate",
 	Joindate:       "users.joindate",
+	ContributedAt:  "users.contributed_at",
+	DeletedAt:      "users.deleted_at",
 }
 
 // Generated where
@@ -88,6 +98,8 @@ var UserWhere = struct {
 	Bio            whereHelpernull_String
 	Birthdate      whereHelpernull_Time
 	Joindate       whereHelpertime_Time
+	ContributedAt  whereHelpertime_Time
+	DeletedAt      whereHelpernull_Time
 }{
 	ID:             whereHelperint{field: "\"users\".\"id\""},
 	Email:          whereHelperstring{field: "\"users\".\"email\""},
@@ -97,21 +109,62 @@ var UserWhere = struct {
 	Bio:            whereHelpernull_String{field: "\"users\".\"bio\""},
 	Birthdate:      whereHelpernull_Time{field: "\"users\".\"birthdate\""},
 	Joindate:       whereHelpertime_Time{field: "\"users\".\"joindate\""},
+	ContributedAt:  whereHelpertime_Time{field: "\"users\".\"contributed_at\""},
+	DeletedAt:      whereHelpernull_Time{field: "\"users\".\"deleted_at\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	ContributedFilms    string
-	ContributedSerieses string
+	ContributedArtistImageUrls string
+	ContributedArtists         string
+	ContributedFilmMediaUrls   string
+	ContributedFilms           string
+	Playlists                  string
+	ReferencingPosts           string
+	Posts                      string
+	ProposedByProposals        string
+	ReviewedByProposals        string
+	ContributedSeasons         string
+	ContributedSerieses        string
+	UserDeletions              string
+	FollowerUserFollowings     string
+	FollowedUserFollowings     string
+	Watchlists                 string
 }{
-	ContributedFilms:    "ContributedFilms",
-	ContributedSerieses: "ContributedSerieses",
+	ContributedArtistImageUrls: "ContributedArtistImageUrls",
+	ContributedArtists:         "ContributedArtists",
+	ContributedFilmMediaUrls:   "ContributedFilmMediaUrls",
+	ContributedFilms:           "ContributedFilms",
+	Playlists:                  "Playlists",
+	ReferencingPosts:           "ReferencingPosts",
+	Posts:                      "Posts",
+	ProposedByProposals:        "ProposedByProposals",
+	ReviewedByProposals:        "ReviewedByProposals",
+	ContributedSeasons:         "ContributedSeasons",
+	ContributedSerieses:        "ContributedSerieses",
+	UserDeletions:              "UserDeletions",
+	FollowerUserFollowings:     "FollowerUserFollowings",
+	FollowedUserFollowings:     "FollowedUserFollowings",
+	Watchlists:                 "Watchlists",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	ContributedFilms    FilmSlice   `boil:"ContributedFilms" json:"ContributedFilms" toml:"ContributedFilms" yaml:"ContributedFilms"`
-	ContributedSerieses SeriesSlice `boil:"ContributedSerieses" json:"ContributedSerieses" toml:"ContributedSerieses" yaml:"ContributedSerieses"`
+	ContributedArtistImageUrls ArtistImageURLSlice `boil:"ContributedArtistImageUrls" json:"ContributedArtistImageUrls" toml:"ContributedArtistImageUrls" yaml:"ContributedArtistImageUrls"`
+	ContributedArtists         ArtistSlice         `boil:"ContributedArtists" json:"ContributedArtists" toml:"ContributedArtists" yaml:"ContributedArtists"`
+	ContributedFilmMediaUrls   FilmMediaURLSlice   `boil:"ContributedFilmMediaUrls" json:"ContributedFilmMediaUrls" toml:"ContributedFilmMediaUrls" yaml:"ContributedFilmMediaUrls"`
+	ContributedFilms           FilmSlice           `boil:"ContributedFilms" json:"ContributedFilms" toml:"ContributedFilms" yaml:"ContributedFilms"`
+	Playlists                  PlaylistSlice       `boil:"Playlists" json:"Playlists" toml:"Playlists" yaml:"Playlists"`
+	ReferencingPosts           PostSlice           `boil:"ReferencingPosts" json:"ReferencingPosts" toml:"ReferencingPosts" yaml:"ReferencingPosts"`
+	Posts                      PostSlice           `boil:"Posts" json:"Posts" toml:"Posts" yaml:"Posts"`
+	ProposedByProposals        ProposalSlice       `boil:"ProposedByProposals" json:"ProposedByProposals" toml:"ProposedByProposals" yaml:"ProposedByProposals"`
+	ReviewedByProposals        ProposalSlice       `boil:"ReviewedByProposals" json:"ReviewedByProposals" toml:"ReviewedByProposals" yaml:"ReviewedByProposals"`
+	ContributedSeasons         SeasonSlice         `boil:"ContributedSeasons" json:"ContributedSeasons" toml:"ContributedSeasons" yaml:"ContributedSeasons"`
+	ContributedSerieses        SeriesSlice         `boil:"ContributedSerieses" json:"ContributedSerieses" toml:"ContributedSerieses" yaml:"ContributedSerieses"`
+	UserDeletions              UserDeletionSlice   `boil:"UserDeletions" json:"UserDeletions" toml:"UserDeletions" yaml:"UserDeletions"`
+	FollowerUserFollowings     UserFollowingSlice  `boil:"FollowerUserFollowings" json:"FollowerUserFollowings" toml:"FollowerUserFollowings" yaml:"FollowerUserFollowings"`
+	FollowedUserFollowings     UserFollowingSlice  `boil:"FollowedUserFollowings" json:"FollowedUserFollowings" toml:"FollowedUserFollowings" yaml:"FollowedUserFollowings"`
+	Watchlists                 WatchlistSlice      `boil:"Watchlists" json:"Watchlists" toml:"Watchlists" yaml:"Watchlists"`
 }
 
 // NewStruct creates a new relationship struct
@@ -119,6 +172,27 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (r *userR) GetContributedArtistImageUrls() ArtistImageURLSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ContributedArtistImageUrls
+}
+
+func (r *userR) GetContributedArtists() ArtistSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ContributedArtists
+}
+
+func (r *userR) GetContributedFilmMediaUrls() FilmMediaURLSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ContributedFilmMediaUrls
+}
+
 func (r *userR) GetContributedFilms() FilmSlice {
 	if r == nil {
 		return nil
@@ -126,6 +200,48 @@ func (r *userR) GetContributedFilms() FilmSlice {
 	return r.ContributedFilms
 }
 
+func (r *userR) GetPlaylists() PlaylistSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Playlists
+}
+
+func (r *userR) GetReferencingPosts() PostSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ReferencingPosts
+}
+
+func (r *userR) GetPosts() PostSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Posts
+}
+
+func (r *userR) GetProposedByProposals() ProposalSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ProposedByProposals
+}
+
+func (r *userR) GetReviewedByProposals() ProposalSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ReviewedByProposals
+}
+
+func (r *userR) GetContributedSeasons() SeasonSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ContributedSeasons
+}
+
 func (r *userR) GetContributedSerieses() SeriesSlice {
 	if r == nil {
 		return nil
@@ -133,13 +249,41 @@ func (r *userR) GetContributedSerieses() SeriesSlice {
 	return r.ContributedSerieses
 }
 
+func (r *userR) GetUserDeletions() UserDeletionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserDeletions
+}
+
+func (r *userR) GetFollowerUserFollowings() UserFollowingSlice {
+	if r == nil {
+		return nil
+	}
+	return r.FollowerUserFollowings
+}
+
+func (r *userR) GetFollowedUserFollowings() UserFollowingSlice {
+	if r == nil {
+		return nil
+	}
+	return r.FollowedUserFollowings
+}
+
+func (r *userR) GetWatchlists() WatchlistSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Watchlists
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "email", "hashed_password", "first_name", "last_name", "bio", "birthdate", "joindate"}
+	userAllColumns            = []string{"id", "email", "hashed_password", "first_name", "last_name", "bio", "birthdate", "joindate", "contributed_at", "deleted_at"}
 	userColumnsWithoutDefault = []string{"email", "hashed_password"}
-	userColumnsWithDefault    = []string{"id", "first_name", "last_name", "bio", "birthdate", "joindate"}
+	userColumnsWithDefault    = []string{"id", "first_name", "last_name", "bio", "birthdate", "joindate", "contributed_at", "deleted_at"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -422,6 +566,48 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// ContributedArtistImageUrls retrieves all the artist_image_url's ArtistImageUrls with an executor via contributed_by column.
+func (o *User) ContributedArtistImageUrls(mods ...qm.QueryMod) artistImageURLQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"artist_image_urls\".\"contributed_by\"=?", o.ID),
+	)
+
+	return ArtistImageUrls(queryMods...)
+}
+
+// ContributedArtists retrieves all the artist's Artists with an executor via contributed_by column.
+func (o *User) ContributedArtists(mods ...qm.QueryMod) artistQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"artists\".\"contributed_by\"=?", o.ID),
+	)
+
+	return Artists(queryMods...)
+}
+
+// ContributedFilmMediaUrls retrieves all the film_media_url's FilmMediaUrls with an executor via contributed_by column.
+func (o *User) ContributedFilmMediaUrls(mods ...qm.QueryMod) filmMediaURLQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"film_media_urls\".\"contributed_by\"=?", o.ID),
+	)
+
+	return FilmMediaUrls(queryMods...)
+}
+
 // ContributedFilms retrieves all the film's Films with an executor via contributed_by column.
 func (o *User) ContributedFilms(mods ...qm.QueryMod) filmQuery {
 	var queryMods []qm.QueryMod
@@ -436,6 +622,91 @@ func (o *User) ContributedFilms(mods ...qm.QueryMod) filmQuery {
 	return Films(queryMods...)
 }
 
+// Playlists retrieves all the playlist's Playlists with an executor.
+func (o *User) Playlists(mods ...qm.QueryMod) playlistQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"playlists\".\"user_id\"=?", o.ID),
+	)
+
+	return Playlists(queryMods...)
+}
+
+// ReferencingPosts retrieves all the post's Posts with an executor via id column.
+func (o *User) ReferencingPosts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"post_users\" on \"posts\".\"id\" = \"post_users\".\"post_id\""),
+		qm.Where("\"post_users\".\"user_id\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
+// Posts retrieves all the post's Posts with an executor.
+func (o *User) Posts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"posts\".\"user_id\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
+// ProposedByProposals retrieves all the proposal's Proposals with an executor via proposed_by column.
+func (o *User) ProposedByProposals(mods ...qm.QueryMod) proposalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"proposals\".\"proposed_by\"=?", o.ID),
+	)
+
+	return Proposals(queryMods...)
+}
+
+// ReviewedByProposals retrieves all the proposal's Proposals with an executor via reviewed_by column.
+func (o *User) ReviewedByProposals(mods ...qm.QueryMod) proposalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"proposals\".\"reviewed_by\"=?", o.ID),
+	)
+
+	return Proposals(queryMods...)
+}
+
+// ContributedSeasons retrieves all the season's Seasons with an executor via contributed_by column.
+func (o *User) ContributedSeasons(mods ...qm.QueryMod) seasonQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"seasons\".\"contributed_by\"=?", o.ID),
+	)
+
+	return Seasons(queryMods...)
+}
+
 // ContributedSerieses retrieves all the seriese's Serieses with an executor via contributed_by column.
 func (o *User) ContributedSerieses(mods ...qm.QueryMod) seriesQuery {
 	var queryMods []qm.QueryMod
@@ -450,9 +721,65 @@ func (o *User) ContributedSerieses(mods ...qm.QueryMod) seriesQuery {
 	return Serieses(queryMods...)
 }
 
-// LoadContributedFilms allows an eager lookup of values, cached into the
+// UserDeletions retrieves all the user_deletion's UserDeletions with an executor.
+func (o *User) UserDeletions(mods ...qm.QueryMod) userDeletionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_deletions\".\"user_id\"=?", o.ID),
+	)
+
+	return UserDeletions(queryMods...)
+}
+
+// FollowerUserFollowings retrieves all the user_following's UserFollowings with an executor via follower_id column.
+func (o *User) FollowerUserFollowings(mods ...qm.QueryMod) userFollowingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_followings\".\"follower_id\"=?", o.ID),
+	)
+
+	return UserFollowings(queryMods...)
+}
+
+// FollowedUserFollowings retrieves all the user_following's UserFollowings with an executor via followed_id column.
+func (o *User) FollowedUserFollowings(mods ...qm.QueryMod) userFollowingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_followings\".\"followed_id\"=?", o.ID),
+	)
+
+	return UserFollowings(queryMods...)
+}
+
+// Watchlists retrieves all the watchlist's Watchlists with an executor.
+func (o *User) Watchlists(mods ...qm.QueryMod) watchlistQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"watchlists\".\"user_id\"=?", o.ID),
+	)
+
+	return Watchlists(queryMods...)
+}
+
+// LoadContributedArtistImageUrls allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadContributedFilms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadContributedArtistImageUrls(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -506,8 +833,8 @@ func (userL) LoadContributedFilms(ctx context.Context, e boil.ContextExecutor, s
 	}
 
 	query := NewQuery(
-		qm.From(`films`),
-		qm.WhereIn(`films.contributed_by in ?`, args...),
+		qm.From(`artist_image_urls`),
+		qm.WhereIn(`artist_image_urls.contributed_by in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -515,22 +842,22 @@ func (userL) LoadContributedFilms(ctx context.Context, e boil.ContextExecutor, s
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load films")
+		return errors.Wrap(err, "failed to eager load artist_image_urls")
 	}
 
-	var resultSlice []*Film
+	var resultSlice []*ArtistImageURL
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice films")
+		return errors.Wrap(err, "failed to bind eager loaded slice artist_image_urls")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on films")
+		return errors.Wrap(err, "failed to close results in eager load on artist_image_urls")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for artist_image_urls")
 	}
 
-	if len(filmAfterSelectHooks) != 0 {
+	if len(artistImageURLAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -538,10 +865,10 @@ func (userL) LoadContributedFilms(ctx context.Context, e boil.ContextExecutor, s
 		}
 	}
 	if singular {
-		object.R.ContributedFilms = resultSlice
+		object.R.ContributedArtistImageUrls = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &filmR{}
+				foreign.R = &artistImageURLR{}
 			}
 			foreign.R.ContributingUser = object
 		}
@@ -551,9 +878,9 @@ func (userL) LoadContributedFilms(ctx context.Context, e boil.ContextExecutor, s
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ContributedBy {
-				local.R.ContributedFilms = append(local.R.ContributedFilms, foreign)
+				local.R.ContributedArtistImageUrls = append(local.R.ContributedArtistImageUrls, foreign)
 				if foreign.R == nil {
-					foreign.R = &filmR{}
+					foreign.R = &artistImageURLR{}
 				}
 				foreign.R.ContributingUser = local
 				break
@@ -564,9 +891,9 @@ func (userL) LoadContributedFilms(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
-// LoadContributedSerieses allows an eager lookup of values, cached into the
+// LoadContributedArtists allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadContributedSerieses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadContributedArtists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -620,8 +947,8 @@ func (userL) LoadContributedSerieses(ctx context.Context, e boil.ContextExecutor
 	}
 
 	query := NewQuery(
-		qm.From(`serieses`),
-		qm.WhereIn(`serieses.contributed_by in ?`, args...),
+		qm.From(`artists`),
+		qm.WhereIn(`artists.contributed_by in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -629,22 +956,22 @@ func (userL) LoadContributedSerieses(ctx context.Context, e boil.ContextExecutor
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load serieses")
+		return errors.Wrap(err, "failed to eager load artists")
 	}
 
-	var resultSlice []*Series
+	var resultSlice []*Artist
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice serieses")
+		return errors.Wrap(err, "failed to bind eager loaded slice artists")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on serieses")
+		return errors.Wrap(err, "failed to close results in eager load on artists")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for serieses")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for artists")
 	}
 
-	if len(seriesAfterSelectHooks) != 0 {
+	if len(artistAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -652,10 +979,10 @@ func (userL) LoadContributedSerieses(ctx context.Context, e boil.ContextExecutor
 		}
 	}
 	if singular {
-		object.R.ContributedSerieses = resultSlice
+		object.R.ContributedArtists = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &seriesR{}
+				foreign.R = &artistR{}
 			}
 			foreign.R.ContributingUser = object
 		}
@@ -665,9 +992,9 @@ func (userL) LoadContributedSerieses(ctx context.Context, e boil.ContextExecutor
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ContributedBy {
-				local.R.ContributedSerieses = append(local.R.ContributedSerieses, foreign)
+				local.R.ContributedArtists = append(local.R.ContributedArtists, foreign)
 				if foreign.R == nil {
-					foreign.R = &seriesR{}
+					foreign.R = &artistR{}
 				}
 				foreign.R.ContributingUser = local
 				break
@@ -678,24 +1005,2039 @@ func (userL) LoadContributedSerieses(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
-// AddContributedFilms adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.ContributedFilms.
-// Sets related.R.ContributingUser appropriately.
-func (o *User) AddContributedFilms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Film) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.ContributedBy = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
+// LoadContributedFilmMediaUrls allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadContributedFilmMediaUrls(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
 			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
 		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"films\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
-				strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
-			)
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`film_media_urls`),
+		qm.WhereIn(`film_media_urls.contributed_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load film_media_urls")
+	}
+
+	var resultSlice []*FilmMediaURL
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice film_media_urls")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on film_media_urls")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for film_media_urls")
+	}
+
+	if len(filmMediaURLAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ContributedFilmMediaUrls = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &filmMediaURLR{}
+			}
+			foreign.R.ContributingUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ContributedBy {
+				local.R.ContributedFilmMediaUrls = append(local.R.ContributedFilmMediaUrls, foreign)
+				if foreign.R == nil {
+					foreign.R = &filmMediaURLR{}
+				}
+				foreign.R.ContributingUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadContributedFilms allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadContributedFilms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`films`),
+		qm.WhereIn(`films.contributed_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load films")
+	}
+
+	var resultSlice []*Film
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice films")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+	}
+
+	if len(filmAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ContributedFilms = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &filmR{}
+			}
+			foreign.R.ContributingUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ContributedBy {
+				local.R.ContributedFilms = append(local.R.ContributedFilms, foreign)
+				if foreign.R == nil {
+					foreign.R = &filmR{}
+				}
+				foreign.R.ContributingUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPlaylists allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPlaylists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`playlists`),
+		qm.WhereIn(`playlists.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load playlists")
+	}
+
+	var resultSlice []*Playlist
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice playlists")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on playlists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for playlists")
+	}
+
+	if len(playlistAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Playlists = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &playlistR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Playlists = append(local.R.Playlists, foreign)
+				if foreign.R == nil {
+					foreign.R = &playlistR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReferencingPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadReferencingPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"posts\".\"id\", \"posts\".\"user_id\", \"posts\".\"post_id\", \"posts\".\"body\", \"posts\".\"posted_at\", \"posts\".\"edited_at\", \"a\".\"user_id\""),
+		qm.From("\"posts\""),
+		qm.InnerJoin("\"post_users\" as \"a\" on \"posts\".\"id\" = \"a\".\"post_id\""),
+		qm.WhereIn("\"a\".\"user_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posts")
+	}
+
+	var resultSlice []*Post
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Post)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.UserID, &one.PostID, &one.Body, &one.PostedAt, &one.EditedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for posts")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice posts")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReferencingPosts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.ReferencedUsers = append(foreign.R.ReferencedUsers, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.ReferencingPosts = append(local.R.ReferencingPosts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.ReferencedUsers = append(foreign.R.ReferencedUsers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posts")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice posts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Posts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Posts = append(local.R.Posts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProposedByProposals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadProposedByProposals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`proposals`),
+		qm.WhereIn(`proposals.proposed_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load proposals")
+	}
+
+	var resultSlice []*Proposal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice proposals")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on proposals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for proposals")
+	}
+
+	if len(proposalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ProposedByProposals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &proposalR{}
+			}
+			foreign.R.ProposedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProposedBy {
+				local.R.ProposedByProposals = append(local.R.ProposedByProposals, foreign)
+				if foreign.R == nil {
+					foreign.R = &proposalR{}
+				}
+				foreign.R.ProposedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReviewedByProposals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadReviewedByProposals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`proposals`),
+		qm.WhereIn(`proposals.reviewed_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load proposals")
+	}
+
+	var resultSlice []*Proposal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice proposals")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on proposals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for proposals")
+	}
+
+	if len(proposalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReviewedByProposals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &proposalR{}
+			}
+			foreign.R.ReviewedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ReviewedBy) {
+				local.R.ReviewedByProposals = append(local.R.ReviewedByProposals, foreign)
+				if foreign.R == nil {
+					foreign.R = &proposalR{}
+				}
+				foreign.R.ReviewedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadContributedSeasons allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadContributedSeasons(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`seasons`),
+		qm.WhereIn(`seasons.contributed_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load seasons")
+	}
+
+	var resultSlice []*Season
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice seasons")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on seasons")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for seasons")
+	}
+
+	if len(seasonAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ContributedSeasons = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &seasonR{}
+			}
+			foreign.R.ContributingUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ContributedBy {
+				local.R.ContributedSeasons = append(local.R.ContributedSeasons, foreign)
+				if foreign.R == nil {
+					foreign.R = &seasonR{}
+				}
+				foreign.R.ContributingUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadContributedSerieses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadContributedSerieses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`serieses`),
+		qm.WhereIn(`serieses.contributed_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load serieses")
+	}
+
+	var resultSlice []*Series
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice serieses")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on serieses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for serieses")
+	}
+
+	if len(seriesAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ContributedSerieses = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &seriesR{}
+			}
+			foreign.R.ContributingUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ContributedBy {
+				local.R.ContributedSerieses = append(local.R.ContributedSerieses, foreign)
+				if foreign.R == nil {
+					foreign.R = &seriesR{}
+				}
+				foreign.R.ContributingUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserDeletions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserDeletions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_deletions`),
+		qm.WhereIn(`user_deletions.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_deletions")
+	}
+
+	var resultSlice []*UserDeletion
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_deletions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_deletions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_deletions")
+	}
+
+	if len(userDeletionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserDeletions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userDeletionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserDeletions = append(local.R.UserDeletions, foreign)
+				if foreign.R == nil {
+					foreign.R = &userDeletionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFollowerUserFollowings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadFollowerUserFollowings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_followings`),
+		qm.WhereIn(`user_followings.follower_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_followings")
+	}
+
+	var resultSlice []*UserFollowing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_followings")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_followings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_followings")
+	}
+
+	if len(userFollowingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FollowerUserFollowings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userFollowingR{}
+			}
+			foreign.R.Follower = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FollowerID {
+				local.R.FollowerUserFollowings = append(local.R.FollowerUserFollowings, foreign)
+				if foreign.R == nil {
+					foreign.R = &userFollowingR{}
+				}
+				foreign.R.Follower = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFollowedUserFollowings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadFollowedUserFollowings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_followings`),
+		qm.WhereIn(`user_followings.followed_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_followings")
+	}
+
+	var resultSlice []*UserFollowing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_followings")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_followings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_followings")
+	}
+
+	if len(userFollowingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FollowedUserFollowings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userFollowingR{}
+			}
+			foreign.R.Followed = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FollowedID {
+				local.R.FollowedUserFollowings = append(local.R.FollowedUserFollowings, foreign)
+				if foreign.R == nil {
+					foreign.R = &userFollowingR{}
+				}
+				foreign.R.Followed = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWatchlists allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWatchlists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`watchlists`),
+		qm.WhereIn(`watchlists.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load watchlists")
+	}
+
+	var resultSlice []*Watchlist
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice watchlists")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on watchlists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for watchlists")
+	}
+
+	if len(watchlistAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Watchlists = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &watchlistR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Watchlists = append(local.R.Watchlists, foreign)
+				if foreign.R == nil {
+					foreign.R = &watchlistR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddContributedArtistImageUrls adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ContributedArtistImageUrls.
+// Sets related.R.ContributingUser appropriately.
+func (o *User) AddContributedArtistImageUrls(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArtistImageURL) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ContributedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"artist_image_urls\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, artistImageURLPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ContributedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ContributedArtistImageUrls: related,
+		}
+	} else {
+		o.R.ContributedArtistImageUrls = append(o.R.ContributedArtistImageUrls, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &artistImageURLR{
+				ContributingUser: o,
+			}
+		} else {
+			rel.R.ContributingUser = o
+		}
+	}
+	return nil
+}
+
+// AddContributedArtists adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ContributedArtists.
+// Sets related.R.ContributingUser appropriately.
+func (o *User) AddContributedArtists(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Artist) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ContributedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"artists\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, artistPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ContributedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ContributedArtists: related,
+		}
+	} else {
+		o.R.ContributedArtists = append(o.R.ContributedArtists, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &artistR{
+				ContributingUser: o,
+			}
+		} else {
+			rel.R.ContributingUser = o
+		}
+	}
+	return nil
+}
+
+// AddContributedFilmMediaUrls adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ContributedFilmMediaUrls.
+// Sets related.R.ContributingUser appropriately.
+func (o *User) AddContributedFilmMediaUrls(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FilmMediaURL) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ContributedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"film_media_urls\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, filmMediaURLPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ContributedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ContributedFilmMediaUrls: related,
+		}
+	} else {
+		o.R.ContributedFilmMediaUrls = append(o.R.ContributedFilmMediaUrls, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &filmMediaURLR{
+				ContributingUser: o,
+			}
+		} else {
+			rel.R.ContributingUser = o
+		}
+	}
+	return nil
+}
+
+// AddContributedFilms adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ContributedFilms.
+// Sets related.R.ContributingUser appropriately.
+func (o *User) AddContributedFilms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Film) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ContributedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"films\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ContributedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ContributedFilms: related,
+		}
+	} else {
+		o.R.ContributedFilms = append(o.R.ContributedFilms, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &filmR{
+				ContributingUser: o,
+			}
+		} else {
+			rel.R.ContributingUser = o
+		}
+	}
+	return nil
+}
+
+// AddPlaylists adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Playlists.
+// Sets related.R.User appropriately.
+func (o *User) AddPlaylists(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Playlist) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"playlists\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, playlistPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Playlists: related,
+		}
+	} else {
+		o.R.Playlists = append(o.R.Playlists, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &playlistR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddReferencingPosts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ReferencingPosts.
+// Sets related.R.ReferencedUsers appropriately.
+func (o *User) AddReferencingPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"post_users\" (\"user_id\", \"post_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &userR{
+			ReferencingPosts: related,
+		}
+	} else {
+		o.R.ReferencingPosts = append(o.R.ReferencingPosts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				ReferencedUsers: UserSlice{o},
+			}
+		} else {
+			rel.R.ReferencedUsers = append(rel.R.ReferencedUsers, o)
+		}
+	}
+	return nil
+}
+
+// SetReferencingPosts removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ReferencedUsers's ReferencingPosts accordingly.
+// Replaces o.R.ReferencingPosts with related.
+// Sets related.R.ReferencedUsers's ReferencingPosts accordingly.
+func (o *User) SetReferencingPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	query := "delete from \"post_users\" where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeReferencingPostsFromReferencedUsersSlice(o, related)
+	if o.R != nil {
+		o.R.ReferencingPosts = nil
+	}
+
+	return o.AddReferencingPosts(ctx, exec, insert, related...)
+}
+
+// RemoveReferencingPosts relationships from objects passed in.
+// Removes related items from R.ReferencingPosts (uses pointer comparison, removal does not keep order)
+// Sets related.R.ReferencedUsers.
+func (o *User) RemoveReferencingPosts(ctx context.Context, exec boil.ContextExecutor, related ...*Post) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"post_users\" where \"user_id\" = $1 and \"post_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeReferencingPostsFromReferencedUsersSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ReferencingPosts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ReferencingPosts)
+			if ln > 1 && i < ln-1 {
+				o.R.ReferencingPosts[i] = o.R.ReferencingPosts[ln-1]
+			}
+			o.R.ReferencingPosts = o.R.ReferencingPosts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeReferencingPostsFromReferencedUsersSlice(o *User, related []*Post) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.ReferencedUsers {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.ReferencedUsers)
+			if ln > 1 && i < ln-1 {
+				rel.R.ReferencedUsers[i] = rel.R.ReferencedUsers[ln-1]
+			}
+			rel.R.ReferencedUsers = rel.R.ReferencedUsers[:ln-1]
+			break
+		}
+	}
+}
+
+// AddPosts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Posts.
+// Sets related.R.User appropriately.
+func (o *User) AddPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"posts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Posts: related,
+		}
+	} else {
+		o.R.Posts = append(o.R.Posts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddProposedByProposals adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ProposedByProposals.
+// Sets related.R.ProposedByUser appropriately.
+func (o *User) AddProposedByProposals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Proposal) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProposedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"proposals\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"proposed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, proposalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProposedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ProposedByProposals: related,
+		}
+	} else {
+		o.R.ProposedByProposals = append(o.R.ProposedByProposals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &proposalR{
+				ProposedByUser: o,
+			}
+		} else {
+			rel.R.ProposedByUser = o
+		}
+	}
+	return nil
+}
+
+// AddReviewedByProposals adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ReviewedByProposals.
+// Sets related.R.ReviewedByUser appropriately.
+func (o *User) AddReviewedByProposals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Proposal) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ReviewedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"proposals\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"reviewed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, proposalPrimaryKeyColumns),
+			)
 			values := []interface{}{o.ID, rel.ID}
 
 			if boil.IsDebug(ctx) {
@@ -707,21 +3049,148 @@ func (o *User) AddContributedFilms(ctx context.Context, exec boil.ContextExecuto
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
+			queries.Assign(&rel.ReviewedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ReviewedByProposals: related,
+		}
+	} else {
+		o.R.ReviewedByProposals = append(o.R.ReviewedByProposals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &proposalR{
+				ReviewedByUser: o,
+			}
+		} else {
+			rel.R.ReviewedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetReviewedByProposals removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ReviewedByUser's ReviewedByProposals accordingly.
+// Replaces o.R.ReviewedByProposals with related.
+// Sets related.R.ReviewedByUser's ReviewedByProposals accordingly.
+func (o *User) SetReviewedByProposals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Proposal) error {
+	query := "update \"proposals\" set \"reviewed_by\" = null where \"reviewed_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ReviewedByProposals {
+			queries.SetScanner(&rel.ReviewedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ReviewedByUser = nil
+		}
+		o.R.ReviewedByProposals = nil
+	}
+
+	return o.AddReviewedByProposals(ctx, exec, insert, related...)
+}
+
+// RemoveReviewedByProposals relationships from objects passed in.
+// Removes related items from R.ReviewedByProposals (uses pointer comparison, removal does not keep order)
+// Sets related.R.ReviewedByUser.
+func (o *User) RemoveReviewedByProposals(ctx context.Context, exec boil.ContextExecutor, related ...*Proposal) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ReviewedBy, nil)
+		if rel.R != nil {
+			rel.R.ReviewedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("reviewed_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ReviewedByProposals {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ReviewedByProposals)
+			if ln > 1 && i < ln-1 {
+				o.R.ReviewedByProposals[i] = o.R.ReviewedByProposals[ln-1]
+			}
+			o.R.ReviewedByProposals = o.R.ReviewedByProposals[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddContributedSeasons adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ContributedSeasons.
+// Sets related.R.ContributingUser appropriately.
+func (o *User) AddContributedSeasons(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Season) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ContributedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"seasons\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, seasonPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.SeriesID, rel.SeasonNumber}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
 			rel.ContributedBy = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			ContributedFilms: related,
+			ContributedSeasons: related,
 		}
 	} else {
-		o.R.ContributedFilms = append(o.R.ContributedFilms, related...)
+		o.R.ContributedSeasons = append(o.R.ContributedSeasons, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &filmR{
+			rel.R = &seasonR{
 				ContributingUser: o,
 			}
 		} else {
@@ -784,6 +3253,218 @@ func (o *User) AddContributedSerieses(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
+// AddUserDeletions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserDeletions.
+// Sets related.R.User appropriately.
+func (o *User) AddUserDeletions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserDeletion) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_deletions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userDeletionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserDeletions: related,
+		}
+	} else {
+		o.R.UserDeletions = append(o.R.UserDeletions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userDeletionR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddFollowerUserFollowings adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.FollowerUserFollowings.
+// Sets related.R.Follower appropriately.
+func (o *User) AddFollowerUserFollowings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFollowing) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FollowerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_followings\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"follower_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userFollowingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.FollowerID, rel.FollowedID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FollowerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			FollowerUserFollowings: related,
+		}
+	} else {
+		o.R.FollowerUserFollowings = append(o.R.FollowerUserFollowings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userFollowingR{
+				Follower: o,
+			}
+		} else {
+			rel.R.Follower = o
+		}
+	}
+	return nil
+}
+
+// AddFollowedUserFollowings adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.FollowedUserFollowings.
+// Sets related.R.Followed appropriately.
+func (o *User) AddFollowedUserFollowings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserFollowing) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FollowedID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_followings\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"followed_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userFollowingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.FollowerID, rel.FollowedID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FollowedID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			FollowedUserFollowings: related,
+		}
+	} else {
+		o.R.FollowedUserFollowings = append(o.R.FollowedUserFollowings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userFollowingR{
+				Followed: o,
+			}
+		} else {
+			rel.R.Followed = o
+		}
+	}
+	return nil
+}
+
+// AddWatchlists adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Watchlists.
+// Sets related.R.User appropriately.
+func (o *User) AddWatchlists(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Watchlist) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"watchlists\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, watchlistPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.FilmID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Watchlists: related,
+		}
+	} else {
+		o.R.Watchlists = append(o.R.Watchlists, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &watchlistR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"users\""))
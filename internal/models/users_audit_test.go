@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testUsersAudits(t *testing.T) {
+	t.Parallel()
+
+	query := UsersAudits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testUsersAuditsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UsersAudit{}
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := UsersAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testUsersAuditsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UsersAudit{}
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := UsersAudits().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := UsersAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testUsersAuditsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UsersAudit{}
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := UsersAuditSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := UsersAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testUsersAuditsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UsersAudit{}
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := UsersAuditExists(ctx, tx, o.ID, o.ContributedAt)
+	if err != nil {
+		t.Errorf("Unable to check if UsersAudit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected UsersAuditExists to return true, but got false.")
+	}
+}
+
+func testUsersAuditsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UsersAudit{}
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	usersAuditFound, err := FindUsersAudit(ctx, tx, o.ID, o.ContributedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if usersAuditFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testUsersAuditsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UsersAudit{}
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = UsersAudits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testUsersAuditsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UsersAudit{}
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := UsersAudits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testUsersAuditsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	usersAuditOne := &UsersAudit{}
+	usersAuditTwo := &UsersAudit{}
+	if err = randomize.Struct(seed, usersAuditOne, usersAuditDBTypes, false, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, usersAuditTwo, usersAuditDBTypes, false, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = usersAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = usersAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := UsersAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testUsersAuditsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	usersAuditOne := &UsersAudit{}
+	usersAuditTwo := &UsersAudit{}
+	if err = randomize.Struct(seed, usersAuditOne, usersAuditDBTypes, false, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, usersAuditTwo, usersAuditDBTypes, false, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = usersAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = usersAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UsersAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func usersAuditBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *UsersAudit) error {
+	*o = UsersAudit{}
+	return nil
+}
+
+func usersAuditAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *UsersAudit) error {
+	*o = UsersAudit{}
+	return nil
+}
+
+func usersAuditAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *UsersAudit) error {
+	*o = UsersAudit{}
+	return nil
+}
+
+func usersAuditBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *UsersAudit) error {
+	*o = UsersAudit{}
+	return nil
+}
+
+func usersAuditAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *UsersAudit) error {
+	*o = UsersAudit{}
+	return nil
+}
+
+func usersAuditBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *UsersAudit) error {
+	*o = UsersAudit{}
+	return nil
+}
+
+func usersAuditAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *UsersAudit) error {
+	*o = UsersAudit{}
+	return nil
+}
+
+func usersAuditBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *UsersAudit) error {
+	*o = UsersAudit{}
+	return nil
+}
+
+func usersAuditAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *UsersAudit) error {
+	*o = UsersAudit{}
+	return nil
+}
+
+func testUsersAuditsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &UsersAudit{}
+	o := &UsersAudit{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize UsersAudit object: %s", err)
+	}
+
+	AddUsersAuditHook(boil.BeforeInsertHook, usersAuditBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	usersAuditBeforeInsertHooks = []UsersAuditHook{}
+
+	AddUsersAuditHook(boil.AfterInsertHook, usersAuditAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	usersAuditAfterInsertHooks = []UsersAuditHook{}
+
+	AddUsersAuditHook(boil.AfterSelectHook, usersAuditAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	usersAuditAfterSelectHooks = []UsersAuditHook{}
+
+	AddUsersAuditHook(boil.BeforeUpdateHook, usersAuditBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	usersAuditBeforeUpdateHooks = []UsersAuditHook{}
+
+	AddUsersAuditHook(boil.AfterUpdateHook, usersAuditAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	usersAuditAfterUpdateHooks = []UsersAuditHook{}
+
+	AddUsersAuditHook(boil.BeforeDeleteHook, usersAuditBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	usersAuditBeforeDeleteHooks = []UsersAuditHook{}
+
+	AddUsersAuditHook(boil.AfterDeleteHook, usersAuditAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	usersAuditAfterDeleteHooks = []UsersAuditHook{}
+
+	AddUsersAuditHook(boil.BeforeUpsertHook, usersAuditBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	usersAuditBeforeUpsertHooks = []UsersAuditHook{}
+
+	AddUsersAuditHook(boil.AfterUpsertHook, usersAuditAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	usersAuditAfterUpsertHooks = []UsersAuditHook{}
+}
+
+func testUsersAuditsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UsersAudit{}
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UsersAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testUsersAuditsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UsersAudit{}
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(usersAuditColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UsersAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testUsersAuditsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UsersAudit{}
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testUsersAuditsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UsersAudit{}
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := UsersAuditSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testUsersAuditsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UsersAudit{}
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := UsersAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	usersAuditDBTypes = map[string]string{`ID`: `integer`, `Email`: `character varying`, `FirstName`: `character varying`, `LastName`: `character varying`, `Bio`: `character varying`, `Birthdate`: `date`, `Joindate`: `date`, `ContributedAt`: `timestamp with time zone`}
+	_                 = bytes.MinRead
+)
+
+func testUsersAuditsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(usersAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(usersAuditAllColumns) == len(usersAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UsersAudit{}
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UsersAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testUsersAuditsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(usersAuditAllColumns) == len(usersAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UsersAudit{}
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UsersAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, usersAuditDBTypes, true, usersAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(usersAuditAllColumns, usersAuditPrimaryKeyColumns) {
+		fields = usersAuditAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			usersAuditAllColumns,
+			usersAuditPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := UsersAuditSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testUsersAuditsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(usersAuditAllColumns) == len(usersAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := UsersAudit{}
+	if err = randomize.Struct(seed, &o, usersAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert UsersAudit: %s", err)
+	}
+
+	count, err := UsersAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, usersAuditDBTypes, false, usersAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize UsersAudit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert UsersAudit: %s", err)
+	}
+
+	count, err = UsersAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
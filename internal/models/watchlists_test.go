@@ -0,0 +1,941 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWatchlists(t *testing.T) {
+	t.Parallel()
+
+	query := Watchlists()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWatchlistsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Watchlist{}
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Watchlists().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchlistsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Watchlist{}
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Watchlists().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Watchlists().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchlistsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Watchlist{}
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WatchlistSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Watchlists().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchlistsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Watchlist{}
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WatchlistExists(ctx, tx, o.UserID, o.FilmID)
+	if err != nil {
+		t.Errorf("Unable to check if Watchlist exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WatchlistExists to return true, but got false.")
+	}
+}
+
+func testWatchlistsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Watchlist{}
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	watchlistFound, err := FindWatchlist(ctx, tx, o.UserID, o.FilmID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if watchlistFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWatchlistsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Watchlist{}
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Watchlists().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchlistsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Watchlist{}
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Watchlists().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWatchlistsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	watchlistOne := &Watchlist{}
+	watchlistTwo := &Watchlist{}
+	if err = randomize.Struct(seed, watchlistOne, watchlistDBTypes, false, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+	if err = randomize.Struct(seed, watchlistTwo, watchlistDBTypes, false, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = watchlistOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = watchlistTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Watchlists().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWatchlistsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	watchlistOne := &Watchlist{}
+	watchlistTwo := &Watchlist{}
+	if err = randomize.Struct(seed, watchlistOne, watchlistDBTypes, false, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+	if err = randomize.Struct(seed, watchlistTwo, watchlistDBTypes, false, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = watchlistOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = watchlistTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Watchlists().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func watchlistBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Watchlist) error {
+	*o = Watchlist{}
+	return nil
+}
+
+func watchlistAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Watchlist) error {
+	*o = Watchlist{}
+	return nil
+}
+
+func watchlistAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Watchlist) error {
+	*o = Watchlist{}
+	return nil
+}
+
+func watchlistBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Watchlist) error {
+	*o = Watchlist{}
+	return nil
+}
+
+func watchlistAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Watchlist) error {
+	*o = Watchlist{}
+	return nil
+}
+
+func watchlistBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Watchlist) error {
+	*o = Watchlist{}
+	return nil
+}
+
+func watchlistAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Watchlist) error {
+	*o = Watchlist{}
+	return nil
+}
+
+func watchlistBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Watchlist) error {
+	*o = Watchlist{}
+	return nil
+}
+
+func watchlistAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Watchlist) error {
+	*o = Watchlist{}
+	return nil
+}
+
+func testWatchlistsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Watchlist{}
+	o := &Watchlist{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, watchlistDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Watchlist object: %s", err)
+	}
+
+	AddWatchlistHook(boil.BeforeInsertHook, watchlistBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	watchlistBeforeInsertHooks = []WatchlistHook{}
+
+	AddWatchlistHook(boil.AfterInsertHook, watchlistAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	watchlistAfterInsertHooks = []WatchlistHook{}
+
+	AddWatchlistHook(boil.AfterSelectHook, watchlistAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	watchlistAfterSelectHooks = []WatchlistHook{}
+
+	AddWatchlistHook(boil.BeforeUpdateHook, watchlistBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	watchlistBeforeUpdateHooks = []WatchlistHook{}
+
+	AddWatchlistHook(boil.AfterUpdateHook, watchlistAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	watchlistAfterUpdateHooks = []WatchlistHook{}
+
+	AddWatchlistHook(boil.BeforeDeleteHook, watchlistBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	watchlistBeforeDeleteHooks = []WatchlistHook{}
+
+	AddWatchlistHook(boil.AfterDeleteHook, watchlistAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	watchlistAfterDeleteHooks = []WatchlistHook{}
+
+	AddWatchlistHook(boil.BeforeUpsertHook, watchlistBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	watchlistBeforeUpsertHooks = []WatchlistHook{}
+
+	AddWatchlistHook(boil.AfterUpsertHook, watchlistAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	watchlistAfterUpsertHooks = []WatchlistHook{}
+}
+
+func testWatchlistsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Watchlist{}
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Watchlists().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWatchlistsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Watchlist{}
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(watchlistColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Watchlists().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWatchlistToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Watchlist
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, watchlistDBTypes, false, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := WatchlistSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*Watchlist)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testWatchlistToOneFilmUsingFilm(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Watchlist
+	var foreign Film
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, watchlistDBTypes, false, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.FilmID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Film().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := WatchlistSlice{&local}
+	if err = local.L.LoadFilm(ctx, tx, false, (*[]*Watchlist)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Film = nil
+	if err = local.L.LoadFilm(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testWatchlistToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Watchlist
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, watchlistDBTypes, false, strmangle.SetComplement(watchlistPrimaryKeyColumns, watchlistColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Watchlists[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := WatchlistExists(ctx, tx, a.UserID, a.FilmID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testWatchlistToOneSetOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Watchlist
+	var b, c Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, watchlistDBTypes, false, strmangle.SetComplement(watchlistPrimaryKeyColumns, watchlistColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Film{&b, &c} {
+		err = a.SetFilm(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Film != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Watchlists[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.FilmID != x.ID {
+			t.Error("foreign key was wrong value", a.FilmID)
+		}
+
+		if exists, err := WatchlistExists(ctx, tx, a.UserID, a.FilmID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testWatchlistsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Watchlist{}
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchlistsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Watchlist{}
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WatchlistSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchlistsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Watchlist{}
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Watchlists().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	watchlistDBTypes = map[string]string{`UserID`: `integer`, `FilmID`: `integer`, `AddedAt`: `timestamp with time zone`, `WatchedAt`: `timestamp with time zone`}
+	_                = bytes.MinRead
+)
+
+func testWatchlistsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(watchlistPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(watchlistAllColumns) == len(watchlistPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Watchlist{}
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Watchlists().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWatchlistsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(watchlistAllColumns) == len(watchlistPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Watchlist{}
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Watchlists().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, watchlistDBTypes, true, watchlistPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(watchlistAllColumns, watchlistPrimaryKeyColumns) {
+		fields = watchlistAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			watchlistAllColumns,
+			watchlistPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WatchlistSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testWatchlistsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(watchlistAllColumns) == len(watchlistPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Watchlist{}
+	if err = randomize.Struct(seed, &o, watchlistDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Watchlist: %s", err)
+	}
+
+	count, err := Watchlists().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, watchlistDBTypes, false, watchlistPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Watchlist struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Watchlist: %s", err)
+	}
+
+	count, err = Watchlists().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,63 @@
+package notifier_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/logging"
+	"github.com/aria3ppp/watch-server/internal/notifier"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLogNotify(t *testing.T) {
+	require := require.New(t)
+
+	core, logs := observer.New(zap.InfoLevel)
+	n := notifier.NewLog(zap.New(core))
+
+	at := time.Date(2022, 10, 19, 12, 0, 0, 0, time.UTC)
+	err := n.Notify(context.Background(), &notifier.Notification{
+		Kind:   notifier.KindPasswordChanged,
+		UserID: 1,
+		Email:  "email",
+		At:     at,
+	})
+	require.NoError(err)
+
+	entries := logs.All()
+	require.Len(entries, 1)
+	require.Equal(
+		map[string]any{
+			"kind":    string(notifier.KindPasswordChanged),
+			"user_id": int64(1),
+			"email":   "email",
+			"at":      at,
+		},
+		entries[0].ContextMap(),
+	)
+}
+
+func TestLogNotify_RequestLogger(t *testing.T) {
+	require := require.New(t)
+
+	core, logs := observer.New(zap.InfoLevel)
+	n := notifier.NewLog(zap.NewNop())
+
+	// notifications sent for a request are logged along with its fields
+	ctx := logging.WithLogger(
+		context.Background(),
+		zap.New(core).With(zap.String("request_id", "id")),
+	)
+	err := n.Notify(ctx, &notifier.Notification{
+		Kind:   notifier.KindPasswordChanged,
+		UserID: 1,
+	})
+	require.NoError(err)
+
+	entries := logs.All()
+	require.Len(entries, 1)
+	require.Equal("id", entries[0].ContextMap()["request_id"])
+}
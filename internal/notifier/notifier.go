@@ -0,0 +1,29 @@
+package notifier
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -destination mock_notifier/mock_service.go . Service
+
+// Kind is the account event a notification tells its recipient about.
+type Kind string
+
+const (
+	KindEmailChanged    Kind = "email_changed"
+	KindPasswordChanged Kind = "password_changed"
+)
+
+// Notification is a security notice addressed to one of a user's emails.
+type Notification struct {
+	Kind   Kind
+	UserID int
+	Email  string
+	At     time.Time
+}
+
+// Service delivers notifications to users.
+type Service interface {
+	Notify(ctx context.Context, notification *Notification) error
+}
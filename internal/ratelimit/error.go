@@ -0,0 +1,5 @@
+package ratelimit
+
+import "errors"
+
+var ErrInvalidLimit = errors.New("invalid rate limit")
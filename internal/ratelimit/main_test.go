@@ -0,0 +1,81 @@
+package ratelimit_test
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/lib/pq"
+)
+
+// To run the postgres tests set TEST_DB_INTEGRATION env
+const ENV_TEST_DB_INTEGRATION = "TEST_DB_INTEGRATION"
+
+var db *sql.DB
+
+// setup test cases
+func setup() (func() error, error) {
+	// run migrations
+	driver, err := postgres.WithInstance(db, &postgres.Config{})
+	if err != nil {
+		log.Fatalf("postgres.WithInstance error: %s", err)
+	}
+	migrator, err := migrate.NewWithDatabaseInstance(
+		"file://../../migrations",
+		"postgres", driver,
+	)
+	if err != nil {
+		log.Fatalf("migrate.NewWithDatabaseInstance error: %s", err)
+	}
+
+	err = migrator.Up()
+	if err != nil && err != migrate.ErrNoChange {
+		return nil, fmt.Errorf("migrate up error: %w", err)
+	}
+
+	// prepare teardown
+	teardownFunc := func() error {
+		// drop migrations
+		return migrator.Drop()
+	}
+
+	return teardownFunc, nil
+}
+
+// skipUnlessDB skips the tests running against the database unless
+// TEST_DB_INTEGRATION env is set
+func skipUnlessDB(t *testing.T) {
+	if db == nil {
+		t.Skipf("integration test skipped: to enable, set %s env!", ENV_TEST_DB_INTEGRATION)
+	}
+}
+
+func TestMain(m *testing.M) {
+	// connect only when TEST_DB_INTEGRATION env is set, the memory tests run
+	// either way
+	if os.Getenv(ENV_TEST_DB_INTEGRATION) != "" {
+		dsn := fmt.Sprintf(
+			"postgres://%s:%s@localhost:%s/%s?sslmode=disable",
+			os.Getenv("POSTGRES_USER"),
+			os.Getenv("POSTGRES_PASSWORD"),
+			os.Getenv("POSTGRES_PORT"),
+			os.Getenv("POSTGRES_DB"),
+		)
+		var err error
+		db, err = sql.Open("postgres", dsn)
+		if err != nil {
+			log.Fatalf("Could not connect to database %q: %s", dsn, err)
+		}
+		err = db.Ping()
+		if err != nil {
+			log.Fatalf("Could not ping database %q: %s", dsn, err)
+		}
+	}
+
+	os.Exit(m.Run())
+}
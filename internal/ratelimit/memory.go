@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Memory keeps the buckets in the memory of the process, so the processes
+// serving the same clients don't share them. It keeps up to maxBuckets of
+// them, a new key taking the place of the bucket to be refilled the soonest
+// once there's no refilled one left to prune.
+type Memory struct {
+	mu         sync.Mutex
+	buckets    map[string]*memoryBucket
+	maxBuckets int
+}
+
+type memoryBucket struct {
+	*bucket
+	fullAt time.Time
+}
+
+var _ Service = (*Memory)(nil)
+
+// NewMemory returns a memory store of up to maxBuckets buckets, 0 leaves it
+// unbounded.
+func NewMemory(maxBuckets int) *Memory {
+	return &Memory{
+		buckets:    make(map[string]*memoryBucket),
+		maxBuckets: maxBuckets,
+	}
+}
+
+func (m *Memory) Take(
+	ctx context.Context,
+	key string,
+	limit Limit,
+) (*Result, error) {
+	if err := limit.validate(); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	b, exists := m.buckets[key]
+	if !exists {
+		if m.maxBuckets > 0 && len(m.buckets) >= m.maxBuckets {
+			m.evict(now)
+		}
+		b = &memoryBucket{bucket: newBucket(limit, now)}
+		m.buckets[key] = b
+	}
+	result := b.take(limit, now)
+	b.fullAt = now.Add(result.ResetAfter)
+	return result, nil
+}
+
+func (m *Memory) Prune(ctx context.Context) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.prune(time.Now()), nil
+}
+
+func (m *Memory) prune(now time.Time) int {
+	pruned := 0
+	for key, b := range m.buckets {
+		if !b.fullAt.After(now) {
+			delete(m.buckets, key)
+			pruned++
+		}
+	}
+	return pruned
+}
+
+// evict makes room for a new bucket, pruning the refilled buckets or else
+// the one to be refilled the soonest, whose client is the least throttled.
+func (m *Memory) evict(now time.Time) {
+	if m.prune(now) > 0 {
+		return
+	}
+	var (
+		soonestKey    string
+		soonestFullAt time.Time
+	)
+	for key, b := range m.buckets {
+		if soonestKey == "" || b.fullAt.Before(soonestFullAt) {
+			soonestKey, soonestFullAt = key, b.fullAt
+		}
+	}
+	delete(m.buckets, soonestKey)
+}
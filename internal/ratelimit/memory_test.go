@@ -0,0 +1,116 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/ratelimit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	memory := ratelimit.NewMemory(0)
+
+	// a token refilled every 10 milliseconds
+	limit := ratelimit.Limit{PerMinute: 6000, Burst: 2}
+
+	// invalid limits
+	_, err := memory.Take(ctx, "key", ratelimit.Limit{Burst: 2})
+	require.Equal(ratelimit.ErrInvalidLimit, err)
+	_, err = memory.Take(ctx, "key", ratelimit.Limit{PerMinute: 6000})
+	require.Equal(ratelimit.ErrInvalidLimit, err)
+
+	// the burst is let through
+	result, err := memory.Take(ctx, "key", limit)
+	require.NoError(err)
+	require.True(result.Allowed)
+	require.Equal(1, result.Remaining)
+	require.Zero(result.RetryAfter)
+	require.LessOrEqual(result.ResetAfter, 10*time.Millisecond)
+
+	result, err = memory.Take(ctx, "key", limit)
+	require.NoError(err)
+	require.True(result.Allowed)
+	require.Equal(0, result.Remaining)
+
+	// then throttled
+	result, err = memory.Take(ctx, "key", limit)
+	require.NoError(err)
+	require.False(result.Allowed)
+	require.Equal(0, result.Remaining)
+	require.Greater(result.RetryAfter, time.Duration(0))
+	require.LessOrEqual(result.RetryAfter, 10*time.Millisecond)
+
+	// while the other keys are not
+	result, err = memory.Take(ctx, "other key", limit)
+	require.NoError(err)
+	require.True(result.Allowed)
+
+	// until refilled
+	time.Sleep(10 * time.Millisecond)
+	result, err = memory.Take(ctx, "key", limit)
+	require.NoError(err)
+	require.True(result.Allowed)
+
+	// the buckets refilled to the full are pruned
+	pruned, err := memory.Prune(ctx)
+	require.NoError(err)
+	require.Equal(1, pruned)
+
+	time.Sleep(20 * time.Millisecond)
+	pruned, err = memory.Prune(ctx)
+	require.NoError(err)
+	require.Equal(1, pruned)
+
+	// and taken from full again
+	result, err = memory.Take(ctx, "key", limit)
+	require.NoError(err)
+	require.True(result.Allowed)
+	require.Equal(1, result.Remaining)
+}
+
+func TestMemory_MaxBuckets(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	memory := ratelimit.NewMemory(2)
+
+	// a token refilled every 10 milliseconds, and every minute
+	fast := ratelimit.Limit{PerMinute: 6000, Burst: 1}
+	slow := ratelimit.Limit{PerMinute: 1, Burst: 1}
+
+	result, err := memory.Take(ctx, "slow", slow)
+	require.NoError(err)
+	require.True(result.Allowed)
+	result, err = memory.Take(ctx, "fast", fast)
+	require.NoError(err)
+	require.True(result.Allowed)
+
+	// a new key past the cap takes the place of the bucket refilled the
+	// soonest, so the slow one is still throttled
+	result, err = memory.Take(ctx, "new", fast)
+	require.NoError(err)
+	require.True(result.Allowed)
+
+	result, err = memory.Take(ctx, "slow", slow)
+	require.NoError(err)
+	require.False(result.Allowed)
+
+	// the evicted fast one is taken from full again, in place of the new one
+	result, err = memory.Take(ctx, "fast", fast)
+	require.NoError(err)
+	require.True(result.Allowed)
+
+	// the refilled buckets give way first
+	time.Sleep(10 * time.Millisecond)
+	result, err = memory.Take(ctx, "other", fast)
+	require.NoError(err)
+	require.True(result.Allowed)
+	result, err = memory.Take(ctx, "slow", slow)
+	require.NoError(err)
+	require.False(result.Allowed)
+}
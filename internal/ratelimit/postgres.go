@@ -0,0 +1,103 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Postgres keeps the buckets in the rate_limit_buckets table, so all the
+// processes serving the same clients share them.
+type Postgres struct {
+	db *sql.DB
+}
+
+var _ Service = (*Postgres)(nil)
+
+func NewPostgres(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+func (p *Postgres) Take(
+	ctx context.Context,
+	key string,
+	limit Limit,
+) (_ *Result, err error) {
+	if err := limit.validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	now := time.Now()
+
+	// a missing bucket is a full one, lock it to take from
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO rate_limit_buckets (key, tokens, updated_at, full_at)
+		VALUES ($1, $2, $3, $3)
+		ON CONFLICT (key) DO NOTHING`,
+		key,
+		float64(limit.Burst),
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var b bucket
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT tokens, updated_at FROM rate_limit_buckets
+		WHERE key = $1
+		FOR UPDATE`,
+		key,
+	).Scan(&b.tokens, &b.updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	result := b.take(limit, now)
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE rate_limit_buckets
+		SET tokens = $2, updated_at = $3, full_at = $4
+		WHERE key = $1`,
+		key,
+		b.tokens,
+		b.updatedAt,
+		now.Add(result.ResetAfter),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (p *Postgres) Prune(ctx context.Context) (int, error) {
+	res, err := p.db.ExecContext(
+		ctx,
+		`DELETE FROM rate_limit_buckets WHERE full_at <= $1`,
+		time.Now(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	pruned, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(pruned), nil
+}
@@ -0,0 +1,163 @@
+package ratelimit_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/ratelimit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgres(t *testing.T) {
+	skipUnlessDB(t)
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	ctx := context.Background()
+	postgres := ratelimit.NewPostgres(db)
+
+	// a token refilled every 100 milliseconds, slow enough for the round
+	// trips to the database not to refill any
+	limit := ratelimit.Limit{PerMinute: 600, Burst: 2}
+
+	// invalid limits
+	_, err = postgres.Take(ctx, "key", ratelimit.Limit{Burst: 2})
+	require.Equal(ratelimit.ErrInvalidLimit, err)
+	_, err = postgres.Take(ctx, "key", ratelimit.Limit{PerMinute: 600})
+	require.Equal(ratelimit.ErrInvalidLimit, err)
+
+	// the burst is let through
+	result, err := postgres.Take(ctx, "key", limit)
+	require.NoError(err)
+	require.True(result.Allowed)
+	require.Equal(1, result.Remaining)
+	require.Zero(result.RetryAfter)
+	require.LessOrEqual(result.ResetAfter, 100*time.Millisecond)
+
+	result, err = postgres.Take(ctx, "key", limit)
+	require.NoError(err)
+	require.True(result.Allowed)
+	require.Equal(0, result.Remaining)
+
+	// then throttled, told when to retry
+	result, err = postgres.Take(ctx, "key", limit)
+	require.NoError(err)
+	require.False(result.Allowed)
+	require.Equal(0, result.Remaining)
+	require.Greater(result.RetryAfter, time.Duration(0))
+	require.LessOrEqual(result.RetryAfter, 100*time.Millisecond)
+	retryAfter := result.RetryAfter
+
+	// while the other keys are not
+	result, err = postgres.Take(ctx, "other key", limit)
+	require.NoError(err)
+	require.True(result.Allowed)
+
+	// until refilled
+	time.Sleep(retryAfter)
+	result, err = postgres.Take(ctx, "key", limit)
+	require.NoError(err)
+	require.True(result.Allowed)
+}
+
+func TestPostgres_ConcurrentTake(t *testing.T) {
+	skipUnlessDB(t)
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	ctx := context.Background()
+	postgres := ratelimit.NewPostgres(db)
+
+	// a single token refilled every minute
+	limit := ratelimit.Limit{PerMinute: 1, Burst: 1}
+
+	// the takes of a key are serialized by the bucket lock, so only one of
+	// two concurrent takes gets the token
+	var (
+		wg      sync.WaitGroup
+		start   = make(chan struct{})
+		results = make([]*ratelimit.Result, 2)
+		errs    = make([]error, 2)
+	)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			results[i], errs[i] = postgres.Take(ctx, "key", limit)
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	allowed := 0
+	for i, result := range results {
+		require.NoError(errs[i])
+		if result.Allowed {
+			allowed++
+		} else {
+			require.Greater(result.RetryAfter, time.Duration(0))
+		}
+	}
+	require.Equal(1, allowed)
+
+	// and the bucket is left empty
+	result, err := postgres.Take(ctx, "key", limit)
+	require.NoError(err)
+	require.False(result.Allowed)
+}
+
+func TestPostgres_Prune(t *testing.T) {
+	skipUnlessDB(t)
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	ctx := context.Background()
+	postgres := ratelimit.NewPostgres(db)
+
+	// a bucket refilled to the full in 100 milliseconds and another one in a
+	// minute
+	_, err = postgres.Take(ctx, "fast", ratelimit.Limit{PerMinute: 600, Burst: 1})
+	require.NoError(err)
+	_, err = postgres.Take(ctx, "slow", ratelimit.Limit{PerMinute: 1, Burst: 1})
+	require.NoError(err)
+
+	// none is full yet
+	pruned, err := postgres.Prune(ctx)
+	require.NoError(err)
+	require.Equal(0, pruned)
+
+	// only the full one is pruned
+	time.Sleep(200 * time.Millisecond)
+	pruned, err = postgres.Prune(ctx)
+	require.NoError(err)
+	require.Equal(1, pruned)
+
+	var keys []string
+	rows, err := db.QueryContext(ctx, "SELECT key FROM rate_limit_buckets")
+	require.NoError(err)
+	defer rows.Close()
+	for rows.Next() {
+		var key string
+		require.NoError(rows.Scan(&key))
+		keys = append(keys, key)
+	}
+	require.NoError(rows.Err())
+	require.Equal([]string{"slow"}, keys)
+
+	// the slow bucket is still empty
+	result, err := postgres.Take(ctx, "slow", ratelimit.Limit{PerMinute: 1, Burst: 1})
+	require.NoError(err)
+	require.False(result.Allowed)
+}
@@ -0,0 +1,80 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+//go:generate mockgen -destination mock_ratelimit/mock_service.go . Service
+
+// Service throttles requests by token buckets. The bucket of a key holds up
+// to Limit.Burst tokens refilled at Limit.PerMinute tokens a minute, and each
+// request let through takes a token out of it.
+type Service interface {
+	Take(ctx context.Context, key string, limit Limit) (*Result, error)
+	// Prune forgets the buckets refilled to the full by now, which are taken
+	// from just like missing ones, and returns how many were.
+	Prune(ctx context.Context) (int, error)
+}
+
+type Limit struct {
+	PerMinute int
+	Burst     int
+}
+
+func (l Limit) validate() error {
+	if l.PerMinute <= 0 || l.Burst <= 0 {
+		return ErrInvalidLimit
+	}
+	return nil
+}
+
+type Result struct {
+	Allowed bool
+	// Remaining is the number of whole tokens left in the bucket
+	Remaining int
+	// RetryAfter is the wait for the next token if the request was not allowed
+	RetryAfter time.Duration
+	// ResetAfter is the wait for the bucket to be refilled to the full
+	ResetAfter time.Duration
+}
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+func newBucket(limit Limit, now time.Time) *bucket {
+	return &bucket{tokens: float64(limit.Burst), updatedAt: now}
+}
+
+// take refills the bucket for the time passed since its last update and takes
+// a token out of it if there's any.
+func (b *bucket) take(limit Limit, now time.Time) *Result {
+	perSecond := float64(limit.PerMinute) / 60
+	// the clocks of the processes sharing a bucket might disagree
+	if elapsed := now.Sub(b.updatedAt); elapsed > 0 {
+		b.tokens = math.Min(
+			float64(limit.Burst),
+			b.tokens+elapsed.Seconds()*perSecond,
+		)
+	}
+	b.updatedAt = now
+
+	result := &Result{Allowed: b.tokens >= 1}
+	if result.Allowed {
+		b.tokens--
+	} else {
+		result.RetryAfter = secondsDuration((1 - b.tokens) / perSecond)
+	}
+	result.Remaining = int(b.tokens)
+	result.ResetAfter = secondsDuration(
+		(float64(limit.Burst) - b.tokens) / perSecond,
+	)
+	return result
+}
+
+func secondsDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,114 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (repo *Repository) ArtistGet(
+	ctx context.Context,
+	id int,
+) (*models.Artist, error) {
+	artist, err := models.Artists(
+		models.ArtistWhere.ID.EQ(id),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return artist, nil
+}
+
+func (repo *Repository) ArtistCreate(
+	ctx context.Context,
+	contributorID int,
+	artist *models.Artist,
+) error {
+	artist.ContributedBy = contributorID
+	return artist.Insert(ctx, repo.exec, boil.Infer())
+}
+
+func (repo *Repository) ArtistImageGet(
+	ctx context.Context,
+	artistID, imageID int,
+) (*models.ArtistImageURL, error) {
+	image, err := models.ArtistImageUrls(
+		models.ArtistImageURLWhere.ID.EQ(imageID),
+		models.ArtistImageURLWhere.ArtistID.EQ(artistID),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return image, nil
+}
+
+func (repo *Repository) ArtistImagesGetAllByArtist(
+	ctx context.Context,
+	artistID int,
+	offset, limit int,
+) ([]*models.ArtistImageURL, error) {
+	images, err := models.ArtistImageUrls(
+		models.ArtistImageURLWhere.ArtistID.EQ(artistID),
+		qm.Offset(offset),
+		qm.Limit(limit),
+		qm.OrderBy(models.ArtistImageURLColumns.ID),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+func (repo *Repository) ArtistImagesCountByArtist(
+	ctx context.Context,
+	artistID int,
+) (int, error) {
+	nImages, err := models.ArtistImageUrls(
+		models.ArtistImageURLWhere.ArtistID.EQ(artistID),
+	).Count(ctx, repo.exec)
+	return int(nImages), err
+}
+
+func (repo *Repository) ArtistImageCreate(
+	ctx context.Context,
+	contributorID int,
+	image *models.ArtistImageURL,
+) error {
+	image.ContributedBy = contributorID
+	return image.Insert(ctx, repo.exec, boil.Infer())
+}
+
+func (repo *Repository) ArtistImageInvalidate(
+	ctx context.Context,
+	artistID, imageID int,
+	contributorID int,
+	invalidation string,
+) error {
+	rowsAff, err := models.ArtistImageUrls(
+		models.ArtistImageURLWhere.ID.EQ(imageID),
+		models.ArtistImageURLWhere.ArtistID.EQ(artistID),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		map[string]any{
+			models.ArtistImageURLColumns.Invalidation:  invalidation,
+			models.ArtistImageURLColumns.ContributedBy: contributorID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
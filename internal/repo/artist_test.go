@@ -0,0 +1,141 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestArtistCreate(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	// first the artist is not found
+
+	_, err = r.ArtistGet(ctx, 1)
+	require.Equal(repo.ErrNoRecord, err)
+
+	artist := &models.Artist{
+		FirstName: "first",
+		LastName:  null.StringFrom("last"),
+	}
+	err = r.ArtistCreate(ctx, user.ID, artist)
+	require.NoError(err)
+
+	fetchedArtist, err := r.ArtistGet(ctx, artist.ID)
+	require.NoError(err)
+	require.Equal(artist.FirstName, fetchedArtist.FirstName)
+	require.Equal(artist.LastName, fetchedArtist.LastName)
+	require.False(fetchedArtist.Bio.Valid)
+	require.Equal(user.ID, fetchedArtist.ContributedBy)
+}
+
+func TestArtistImageCreate(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	artist := &models.Artist{FirstName: "first"}
+	err = r.ArtistCreate(ctx, user.ID, artist)
+	require.NoError(err)
+
+	// first there's no image
+
+	images, err := r.ArtistImagesGetAllByArtist(ctx, artist.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(0, len(images))
+
+	referenced, err := r.MediaBlobReferenced(ctx, "portrait_thumb")
+	require.NoError(err)
+	require.False(referenced)
+
+	// create images
+
+	portrait := &models.ArtistImageURL{
+		ArtistID:     artist.ID,
+		ImageURL:     "portrait",
+		MimeType:     "image/png",
+		SizeInBytes:  100,
+		ThumbnailURL: "portrait_thumb",
+	}
+	err = r.ArtistImageCreate(ctx, user.ID, portrait)
+	require.NoError(err)
+
+	still := &models.ArtistImageURL{
+		ArtistID:     artist.ID,
+		ImageURL:     "still",
+		MimeType:     "image/jpeg",
+		SizeInBytes:  200,
+		ThumbnailURL: "still_thumb",
+	}
+	err = r.ArtistImageCreate(ctx, user.ID, still)
+	require.NoError(err)
+
+	fetchedImage, err := r.ArtistImageGet(ctx, artist.ID, portrait.ID)
+	require.NoError(err)
+	require.Equal(portrait.ImageURL, fetchedImage.ImageURL)
+	require.Equal(portrait.ThumbnailURL, fetchedImage.ThumbnailURL)
+	require.Equal(user.ID, fetchedImage.ContributedBy)
+
+	// images of another artist are not found
+
+	_, err = r.ArtistImageGet(ctx, artist.ID+1, portrait.ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	images, err = r.ArtistImagesGetAllByArtist(ctx, artist.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(2, len(images))
+	require.Equal(portrait.ID, images[0].ID)
+	require.Equal(still.ID, images[1].ID)
+
+	nImages, err := r.ArtistImagesCountByArtist(ctx, artist.ID)
+	require.NoError(err)
+	require.Equal(2, nImages)
+
+	// the images reference their blobs and thumbnails
+
+	for _, key := range []string{"portrait", "portrait_thumb"} {
+		referenced, err = r.MediaBlobReferenced(ctx, key)
+		require.NoError(err)
+		require.True(referenced)
+	}
+
+	// invalidating the image keeps the blob referenced
+
+	err = r.ArtistImageInvalidate(ctx, artist.ID, portrait.ID, user.ID, "invalid")
+	require.NoError(err)
+
+	referenced, err = r.MediaBlobReferenced(ctx, "portrait")
+	require.NoError(err)
+	require.True(referenced)
+
+	fetchedImage, err = r.ArtistImageGet(ctx, artist.ID, portrait.ID)
+	require.NoError(err)
+	require.Equal(null.StringFrom("invalid"), fetchedImage.Invalidation)
+
+	err = r.ArtistImageInvalidate(ctx, artist.ID+1, portrait.ID, user.ID, "invalid")
+	require.Equal(repo.ErrNoRecord, err)
+}
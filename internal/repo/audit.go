@@ -0,0 +1,297 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// AuditTable is the audit table of a table registered with the audit
+// procedures of the migrations, A being the sqlboiler model of its rows.
+type AuditTable[A any] struct {
+	// Name of the audit table
+	Name string
+	// KeyColumns identify the audited record, in the order keys are given
+	KeyColumns []string
+	// Scope restricts the rows to the ones of the audited kind of records,
+	// as in movies sharing the films table with episodes. Its clauses must
+	// not be qualified by a table name.
+	Scope []qm.QueryMod
+	// DiffColumns are the columns compared by diffs
+	DiffColumns []string
+	// RevertColumns are the columns restored by reverts
+	RevertColumns []string
+	// keyset orders the audits of a record uniquely, newest first
+	keyset keyset
+}
+
+var (
+	MovieAudits = &AuditTable[models.FilmsAudit]{
+		Name:       models.TableNames.FilmsAudit,
+		KeyColumns: []string{models.FilmsAuditColumns.ID},
+		Scope: []qm.QueryMod{
+			qm.Where(models.FilmsAuditColumns.SeriesID + " IS NULL"),
+			qm.Where(models.FilmsAuditColumns.SeasonNumber + " IS NULL"),
+			qm.Where(models.FilmsAuditColumns.EpisodeNumber + " IS NULL"),
+		},
+		DiffColumns:   filmsAuditDiffColumns,
+		RevertColumns: filmsAuditRevertColumns,
+		keyset:        contributedAuditKeyset,
+	}
+	EpisodeAudits = &AuditTable[models.FilmsAudit]{
+		Name: models.TableNames.FilmsAudit,
+		KeyColumns: []string{
+			models.FilmsAuditColumns.SeriesID,
+			models.FilmsAuditColumns.SeasonNumber,
+			models.FilmsAuditColumns.EpisodeNumber,
+		},
+		Scope: []qm.QueryMod{
+			qm.Where(models.FilmsAuditColumns.SeriesID + " IS NOT NULL"),
+			qm.Where(models.FilmsAuditColumns.SeasonNumber + " IS NOT NULL"),
+			qm.Where(models.FilmsAuditColumns.EpisodeNumber + " IS NOT NULL"),
+		},
+		DiffColumns:   filmsAuditDiffColumns,
+		RevertColumns: filmsAuditRevertColumns,
+		keyset:        contributedAuditKeyset,
+	}
+	SeriesAudits = &AuditTable[models.SeriesesAudit]{
+		Name:       models.TableNames.SeriesesAudit,
+		KeyColumns: []string{models.SeriesesAuditColumns.ID},
+		DiffColumns: auditDiffColumns(
+			models.SeriesesAuditColumns,
+			models.SeriesesAuditColumns.ID,
+			models.SeriesesAuditColumns.ContributedBy,
+			models.SeriesesAuditColumns.ContributedAt,
+			models.SeriesesAuditColumns.EditSummary,
+			models.SeriesesAuditColumns.RequestID,
+			models.SeriesesAuditColumns.ClientIP,
+			models.SeriesesAuditColumns.UserAgent,
+		),
+		RevertColumns: []string{
+			models.SeriesesAuditColumns.Title,
+			models.SeriesesAuditColumns.Descriptions,
+			models.SeriesesAuditColumns.DateStarted,
+			models.SeriesesAuditColumns.DateEnded,
+			models.SeriesesAuditColumns.Invalidation,
+		},
+		keyset: contributedAuditKeyset,
+	}
+	SeasonAudits = &AuditTable[models.SeasonsAudit]{
+		Name: models.TableNames.SeasonsAudit,
+		KeyColumns: []string{
+			models.SeasonsAuditColumns.SeriesID,
+			models.SeasonsAuditColumns.SeasonNumber,
+		},
+		DiffColumns: auditDiffColumns(
+			models.SeasonsAuditColumns,
+			models.SeasonsAuditColumns.SeriesID,
+			models.SeasonsAuditColumns.SeasonNumber,
+			models.SeasonsAuditColumns.ContributedBy,
+			models.SeasonsAuditColumns.ContributedAt,
+		),
+		RevertColumns: []string{
+			models.SeasonsAuditColumns.Title,
+			models.SeasonsAuditColumns.Descriptions,
+			models.SeasonsAuditColumns.DateStarted,
+			models.SeasonsAuditColumns.DateEnded,
+			models.SeasonsAuditColumns.Invalidation,
+		},
+		keyset: contributedAuditKeyset,
+	}
+	// the profile history of users is not contributed to, so it's neither
+	// diffed nor reverted
+	UserAudits = &AuditTable[models.UsersAudit]{
+		Name:       models.TableNames.UsersAudit,
+		KeyColumns: []string{models.UsersAuditColumns.ID},
+		keyset: keyset{
+			columns: []string{models.UsersAuditColumns.ContributedAt},
+			desc:    true,
+		},
+	}
+)
+
+var (
+	filmsAuditDiffColumns = auditDiffColumns(
+		models.FilmsAuditColumns,
+		models.FilmsAuditColumns.ID,
+		models.FilmsAuditColumns.ContributedBy,
+		models.FilmsAuditColumns.ContributedAt,
+		models.FilmsAuditColumns.EditSummary,
+		models.FilmsAuditColumns.RequestID,
+		models.FilmsAuditColumns.ClientIP,
+		models.FilmsAuditColumns.UserAgent,
+	)
+	// the contributor breaks the ties as the audit tables of contributed
+	// records are keyed by both
+	contributedAuditKeyset = keyset{
+		columns: []string{auditContributedAtColumn, auditContributedByColumn},
+		desc:    true,
+	}
+	// the film position in a series is not part of a revert
+	filmsAuditRevertColumns = []string{
+		models.FilmsAuditColumns.Title,
+		models.FilmsAuditColumns.Descriptions,
+		models.FilmsAuditColumns.DateReleased,
+		models.FilmsAuditColumns.Duration,
+		models.FilmsAuditColumns.Invalidation,
+	}
+)
+
+// GetAll returns the audits of a record, newest first. A key shorter than
+// the key columns returns the audits of all the records sharing it.
+func (t *AuditTable[A]) GetAll(
+	ctx context.Context,
+	exec boil.ContextExecutor,
+	key []any,
+	offset, limit int,
+) ([]*A, error) {
+	var audits []*A
+	err := t.query(
+		key,
+		qm.Offset(offset),
+		qm.Limit(limit),
+		qm.OrderBy(auditContributedAtColumn+" desc"),
+	).Bind(ctx, exec, &audits)
+	if err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+// GetPage is the keyset paginated counterpart of GetAll, keyed by the
+// contribution time of the audits and their contributor if recorded.
+func (t *AuditTable[A]) GetPage(
+	ctx context.Context,
+	exec boil.ContextExecutor,
+	key []any,
+	page *KeysetPage,
+) ([]*A, error) {
+	var audits []*A
+	err := t.query(key, t.keyset.mods(page)...).Bind(ctx, exec, &audits)
+	if err != nil {
+		return nil, err
+	}
+	return keysetRows(audits, page), nil
+}
+
+// Count returns the number of audits of a record or, given a shorter key,
+// of all the records sharing it.
+func (t *AuditTable[A]) Count(
+	ctx context.Context,
+	exec boil.ContextExecutor,
+	key []any,
+) (int, error) {
+	var count int
+	err := t.query(key, qm.Select("COUNT(*)")).
+		QueryRowContext(ctx, exec).
+		Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Get returns the audit of a record contributed at contributedAt, further
+// restricted by mods if given.
+func (t *AuditTable[A]) Get(
+	ctx context.Context,
+	exec boil.ContextExecutor,
+	key []any,
+	contributedAt time.Time,
+	mods ...qm.QueryMod,
+) (*A, error) {
+	audit := new(A)
+	err := t.query(
+		key,
+		append(
+			[]qm.QueryMod{
+				qm.Where(auditContributedAtColumn+" = ?", contributedAt),
+			},
+			mods...,
+		)...,
+	).Bind(ctx, exec, audit)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return audit, nil
+}
+
+// Diff compares the diff columns of two revisions of a record, each one
+// either an audit or the current row.
+func (t *AuditTable[A]) Diff(from, to any) (*AuditDiff, error) {
+	return AuditDiffGet(from, to, t.DiffColumns)
+}
+
+// DiffAll diffs each revision, newest first, against the one following it.
+func (t *AuditTable[A]) DiffAll(revisions []any) ([]*AuditDiff, error) {
+	var diffs []*AuditDiff
+	for i := 0; i+1 < len(revisions); i++ {
+		diff, err := t.Diff(revisions[i+1], revisions[i])
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+// RevertMap returns the revert columns of an audit by name, ready to be
+// updated on the audited table.
+func (t *AuditTable[A]) RevertMap(audit *A) (map[string]any, error) {
+	values, err := auditValues(audit, t.RevertColumns)
+	if err != nil {
+		return nil, err
+	}
+	cols := make(map[string]any, len(t.RevertColumns))
+	for i, column := range t.RevertColumns {
+		cols[column] = values[i]
+	}
+	return cols, nil
+}
+
+func (t *AuditTable[A]) query(key []any, mods ...qm.QueryMod) *queries.Query {
+	if len(key) > len(t.KeyColumns) {
+		panic(fmt.Sprintf(
+			"repo.AuditTable: %d keys given for %d key columns of %s",
+			len(key),
+			len(t.KeyColumns),
+			t.Name,
+		))
+	}
+	queryMods := make([]qm.QueryMod, 0, 1+len(t.Scope)+len(key)+len(mods))
+	queryMods = append(queryMods, qm.From(t.Name))
+	queryMods = append(queryMods, t.Scope...)
+	for i, value := range key {
+		queryMods = append(queryMods, qm.Where(t.KeyColumns[i]+" = ?", value))
+	}
+	queryMods = append(queryMods, mods...)
+	return models.NewQuery(queryMods...)
+}
+
+// auditDiffColumns returns the column names held by a sqlboiler XxxColumns
+// struct, in table order, leaving out the excluded ones.
+func auditDiffColumns(columnsStruct any, excluded ...string) []string {
+	v := reflect.ValueOf(columnsStruct)
+	columns := make([]string, 0, v.NumField())
+outer:
+	for i := 0; i < v.NumField(); i++ {
+		column := v.Field(i).String()
+		for _, e := range excluded {
+			if column == e {
+				continue outer
+			}
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
@@ -0,0 +1,109 @@
+package repo
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// AuditChange is a single column changed between two revisions of a record.
+type AuditChange struct {
+	Column string `json:"column"`
+	Old    any    `json:"old"`
+	New    any    `json:"new"`
+}
+
+// AuditDiff lists the columns changed from one revision of a record to
+// another, attributed to the contributor of the later revision.
+type AuditDiff struct {
+	FromContributedAt time.Time      `json:"from_contributed_at"`
+	ToContributedAt   time.Time      `json:"to_contributed_at"`
+	ContributedBy     int            `json:"contributed_by"`
+	EditSummary       null.String    `json:"edit_summary"`
+	Changes           []*AuditChange `json:"changes"`
+}
+
+const (
+	auditContributedByColumn = "contributed_by"
+	auditContributedAtColumn = "contributed_at"
+	auditEditSummaryColumn   = "edit_summary"
+)
+
+// AuditDiffGet compares the given columns of two revisions of a record.
+// A revision is either a sqlboiler model of the record's table or of its
+// audit table, so the current row can be diffed against its history.
+// Tables without contribution metadata leave the edit summary null.
+func AuditDiffGet(from, to any, columns []string) (*AuditDiff, error) {
+	header := []string{auditContributedByColumn, auditContributedAtColumn}
+	if auditHasColumn(to, auditEditSummaryColumn) {
+		header = append(header, auditEditSummaryColumn)
+	}
+	columns = append(header, columns...)
+	fromValues, err := auditValues(from, columns)
+	if err != nil {
+		return nil, err
+	}
+	toValues, err := auditValues(to, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &AuditDiff{
+		FromContributedAt: fromValues[1].(time.Time),
+		ToContributedAt:   toValues[1].(time.Time),
+		ContributedBy:     toValues[0].(int),
+	}
+	if len(header) > 2 {
+		diff.EditSummary = toValues[2].(null.String)
+	}
+	for i := len(header); i < len(columns); i++ {
+		if auditValuesEqual(fromValues[i], toValues[i]) {
+			continue
+		}
+		diff.Changes = append(diff.Changes, &AuditChange{
+			Column: columns[i],
+			Old:    fromValues[i],
+			New:    toValues[i],
+		})
+	}
+	return diff, nil
+}
+
+func auditValues(revision any, columns []string) ([]any, error) {
+	typ := reflect.TypeOf(revision)
+	mapping, err := queries.BindMapping(
+		typ,
+		queries.MakeStructMapping(typ),
+		columns,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("repo.auditValues: %w", err)
+	}
+	return queries.ValuesFromMapping(
+		reflect.Indirect(reflect.ValueOf(revision)),
+		mapping,
+	), nil
+}
+
+func auditHasColumn(revision any, column string) bool {
+	_, ok := queries.MakeStructMapping(reflect.TypeOf(revision))[column]
+	return ok
+}
+
+func auditValuesEqual(a, b any) bool {
+	if valuer, ok := a.(driver.Valuer); ok {
+		a, _ = valuer.Value()
+	}
+	if valuer, ok := b.(driver.Valuer); ok {
+		b, _ = valuer.Value()
+	}
+	if ta, ok := a.(time.Time); ok {
+		tb, ok := b.(time.Time)
+		return ok && ta.Equal(tb)
+	}
+	return a == b
+}
@@ -0,0 +1,105 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/volatiletech/null/v8"
+)
+
+// AuditRetention decides which audit revisions of a record are pruned.
+type AuditRetention struct {
+	// KeepRevisions most recent revisions are never pruned
+	KeepRevisions int
+	// MaxRevisions is the number of revisions kept, zero keeps them all
+	MaxRevisions int
+	// Before prunes revisions contributed before it, zero keeps them all
+	Before time.Time
+}
+
+// auditTables are the audit tables along with the columns identifying
+// the audited record.
+var auditTables = []struct {
+	name       string
+	keyColumns []string
+}{
+	{
+		name:       models.TableNames.FilmsAudit,
+		keyColumns: []string{models.FilmsAuditColumns.ID},
+	},
+	{
+		name:       models.TableNames.SeriesesAudit,
+		keyColumns: []string{models.SeriesesAuditColumns.ID},
+	},
+	{
+		name: models.TableNames.SeasonsAudit,
+		keyColumns: []string{
+			models.SeasonsAuditColumns.SeriesID,
+			models.SeasonsAuditColumns.SeasonNumber,
+		},
+	},
+	{
+		name:       models.TableNames.FilmMediaUrlsAudit,
+		keyColumns: []string{models.FilmMediaUrlsAuditColumns.ID},
+	},
+	{
+		name:       models.TableNames.ArtistsAudit,
+		keyColumns: []string{models.ArtistsAuditColumns.ID},
+	},
+	{
+		name:       models.TableNames.ArtistImageUrlsAudit,
+		keyColumns: []string{models.ArtistImageUrlsAuditColumns.ID},
+	},
+	{
+		name:       models.TableNames.UsersAudit,
+		keyColumns: []string{models.UsersAuditColumns.ID},
+	},
+}
+
+// AuditsPrune deletes the audit revisions beyond retention and returns the
+// number of deleted rows by audit table.
+func (repo *Repository) AuditsPrune(
+	ctx context.Context,
+	retention AuditRetention,
+) (map[string]int64, error) {
+	before := null.NewTime(retention.Before, !retention.Before.IsZero())
+	pruned := make(map[string]int64, len(auditTables))
+	for _, table := range auditTables {
+		result, err := repo.exec.ExecContext(
+			ctx,
+			fmt.Sprintf(
+				`DELETE FROM %[1]s AS a USING (
+					SELECT
+						ctid,
+						%[3]s,
+						ROW_NUMBER() OVER (PARTITION BY %[2]s ORDER BY %[3]s DESC) AS revision
+					FROM %[1]s
+				) AS r
+				WHERE a.ctid = r.ctid
+					AND r.revision > $1
+					AND (
+						($2 > 0 AND r.revision > $2)
+						OR ($3::TIMESTAMPTZ IS NOT NULL AND r.%[3]s < $3::TIMESTAMPTZ)
+					)`,
+				table.name,
+				strings.Join(table.keyColumns, ", "),
+				auditContributedAtColumn,
+			),
+			retention.KeepRevisions,
+			retention.MaxRevisions,
+			before,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rowsAff, err := result.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		pruned[table.name] = rowsAff
+	}
+	return pruned, nil
+}
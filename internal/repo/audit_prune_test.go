@@ -0,0 +1,99 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditsPrune(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	movies := []*models.Film{
+		{Title: "m1", DateReleased: testutils.Date(2000, 1, 1)},
+		{Title: "m2", DateReleased: testutils.Date(2000, 1, 1)},
+	}
+	for _, m := range movies {
+		err := r.MovieCreate(ctx, user.ID, m)
+		require.NoError(err)
+	}
+
+	// five revisions for the first movie and one for the second
+	for _, title := range []string{"t1", "t2", "t3", "t4", "t5"} {
+		err := r.MovieUpdate(
+			ctx,
+			movies[0].ID,
+			user.ID,
+			map[string]any{models.FilmColumns.Title: title},
+		)
+		require.NoError(err)
+	}
+	err = r.MovieUpdate(
+		ctx,
+		movies[1].ID,
+		user.ID,
+		map[string]any{models.FilmColumns.Title: "t1"},
+	)
+	require.NoError(err)
+
+	auditsCount := func(movieID int) int {
+		count, err := r.MovieAuditsCount(ctx, movieID)
+		require.NoError(err)
+		return count
+	}
+
+	// no limits prune nothing
+
+	pruned, err := r.AuditsPrune(ctx, repo.AuditRetention{})
+	require.NoError(err)
+	require.Equal(int64(0), pruned[models.TableNames.FilmsAudit])
+
+	// keep at most three revisions
+
+	pruned, err = r.AuditsPrune(
+		ctx,
+		repo.AuditRetention{KeepRevisions: 1, MaxRevisions: 3},
+	)
+	require.NoError(err)
+	require.Equal(int64(2), pruned[models.TableNames.FilmsAudit])
+	require.Equal(3, auditsCount(movies[0].ID))
+	require.Equal(1, auditsCount(movies[1].ID))
+
+	// the most recent revisions survive the age limit
+
+	pruned, err = r.AuditsPrune(
+		ctx,
+		repo.AuditRetention{
+			KeepRevisions: 2,
+			Before:        time.Now().Add(time.Hour),
+		},
+	)
+	require.NoError(err)
+	require.Equal(int64(1), pruned[models.TableNames.FilmsAudit])
+	require.Equal(2, auditsCount(movies[0].ID))
+	require.Equal(1, auditsCount(movies[1].ID))
+
+	// the oldest revisions are the pruned ones
+
+	audits, err := r.MovieAuditsGetAll(ctx, movies[0].ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal("t3", audits[0].Title)
+	require.Equal("t2", audits[1].Title)
+}
@@ -0,0 +1,155 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestAuditTable(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{
+		Title:       "series",
+		DateStarted: testutils.Date(2000, 1, 1),
+	}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+	for seasonNumber := 1; seasonNumber <= 2; seasonNumber++ {
+		err = r.SeasonCreateIfNotExists(ctx, series.ID, seasonNumber, user.ID)
+		require.NoError(err)
+	}
+
+	// no audits yet
+
+	audits, err := repo.SeasonAudits.GetAll(
+		ctx,
+		db,
+		[]any{series.ID, 1},
+		0,
+		math.MaxInt,
+	)
+	require.NoError(err)
+	require.Equal(0, len(audits))
+
+	// update the first season twice and the second once
+
+	titles := []string{"first title", "second title"}
+	for _, title := range titles {
+		err = r.SeasonUpdate(
+			ctx,
+			series.ID,
+			1,
+			user.ID,
+			map[string]any{models.SeasonColumns.Title: title},
+		)
+		require.NoError(err)
+	}
+	err = r.SeasonUpdate(
+		ctx,
+		series.ID,
+		2,
+		user.ID,
+		map[string]any{models.SeasonColumns.Title: "title"},
+	)
+	require.NoError(err)
+
+	// audits are listed newest first
+
+	audits, err = repo.SeasonAudits.GetAll(
+		ctx,
+		db,
+		[]any{series.ID, 1},
+		0,
+		math.MaxInt,
+	)
+	require.NoError(err)
+	require.Equal(2, len(audits))
+	require.Equal(null.StringFrom(titles[0]), audits[0].Title)
+	require.False(audits[1].Title.Valid)
+
+	count, err := repo.SeasonAudits.Count(ctx, db, []any{series.ID, 1})
+	require.NoError(err)
+	require.Equal(2, count)
+
+	// a key prefix covers all the records sharing it
+
+	count, err = repo.SeasonAudits.Count(ctx, db, []any{series.ID})
+	require.NoError(err)
+	require.Equal(3, count)
+
+	// get an audit by its contribution time
+
+	audit, err := repo.SeasonAudits.Get(
+		ctx,
+		db,
+		[]any{series.ID, 1},
+		audits[1].ContributedAt,
+	)
+	require.NoError(err)
+	require.Equal(audits[1], audit)
+
+	_, err = repo.SeasonAudits.Get(
+		ctx,
+		db,
+		[]any{series.ID, 2},
+		audits[1].ContributedAt,
+	)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// diff the current season against its first revision
+
+	season, err := r.SeasonGet(ctx, series.ID, 1, true)
+	require.NoError(err)
+
+	diff, err := repo.SeasonAudits.Diff(audit, season)
+	require.NoError(err)
+	require.Equal(
+		&repo.AuditDiff{
+			FromContributedAt: audit.ContributedAt,
+			ToContributedAt:   season.ContributedAt,
+			ContributedBy:     user.ID,
+			Changes: []*repo.AuditChange{
+				{
+					Column: models.SeasonColumns.Title,
+					Old:    audit.Title,
+					New:    season.Title,
+				},
+			},
+		},
+		diff,
+	)
+
+	diffs, err := repo.SeasonAudits.DiffAll(
+		[]any{season, audits[0], audits[1]},
+	)
+	require.NoError(err)
+	require.Equal(2, len(diffs))
+
+	// revert to the first revision
+
+	cols, err := repo.SeasonAudits.RevertMap(audit)
+	require.NoError(err)
+	err = r.SeasonUpdate(ctx, series.ID, 1, user.ID, cols)
+	require.NoError(err)
+
+	season, err = r.SeasonGet(ctx, series.ID, 1, true)
+	require.NoError(err)
+	require.False(season.Title.Valid)
+}
@@ -0,0 +1,289 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// Contributed record types
+const (
+	ContributionRecordMovie   = "movie"
+	ContributionRecordEpisode = "episode"
+	ContributionRecordSeries  = "series"
+)
+
+// Contribution actions
+const (
+	ContributionActionCreate     = "create"
+	ContributionActionUpdate     = "update"
+	ContributionActionInvalidate = "invalidate"
+	ContributionActionRestore    = "restore"
+)
+
+// Contribution is a single revision of a film or series made by a user. The
+// action is told from the revision preceding it, so once older audits are
+// pruned the earliest remaining revision shows up as a create.
+type Contribution struct {
+	Record        string      `boil:"record" json:"record"`
+	ID            int         `boil:"id" json:"id"`
+	SeriesID      null.Int    `boil:"series_id" json:"series_id"`
+	SeasonNumber  null.Int    `boil:"season_number" json:"season_number"`
+	EpisodeNumber null.Int    `boil:"episode_number" json:"episode_number"`
+	Title         string      `boil:"title" json:"title"`
+	Action        string      `boil:"action" json:"action"`
+	Invalidation  null.String `boil:"invalidation" json:"invalidation"`
+	EditSummary   null.String `boil:"edit_summary" json:"edit_summary"`
+	ContributedAt time.Time   `boil:"contributed_at" json:"contributed_at"`
+}
+
+// Contributor is a user ranked by their number of contributions.
+type Contributor struct {
+	Rank          int `boil:"rank" json:"rank"`
+	UserID        int `boil:"user_id" json:"user_id"`
+	Contributions int `boil:"contributions" json:"contributions"`
+}
+
+// ContributionMetadata describes the request a contribution was made through.
+// It's kept along with every revision of films and serieses.
+type ContributionMetadata struct {
+	EditSummary null.String
+	RequestID   null.String
+	ClientIP    null.String
+	UserAgent   null.String
+}
+
+type contributionMetadataKey struct{}
+
+// WithContributionMetadata returns a copy of ctx carrying md, which is then
+// recorded by the films and serieses written with that context.
+func WithContributionMetadata(
+	ctx context.Context,
+	md *ContributionMetadata,
+) context.Context {
+	return context.WithValue(ctx, contributionMetadataKey{}, md)
+}
+
+// ContributionMetadataFromContext returns the metadata carried by ctx, an
+// empty one if it carries none.
+func ContributionMetadataFromContext(ctx context.Context) *ContributionMetadata {
+	if md, ok := ctx.Value(contributionMetadataKey{}).(*ContributionMetadata); ok {
+		return md
+	}
+	return &ContributionMetadata{}
+}
+
+// withContributionMetadata adds the metadata columns to the cols updating a
+// film or series. Missing metadata is set to null rather than left out as
+// the updated row would otherwise keep the metadata of the previous revision.
+func withContributionMetadata(
+	ctx context.Context,
+	cols map[string]any,
+) map[string]any {
+	md := ContributionMetadataFromContext(ctx)
+	// films and serieses share the metadata column names
+	cols[models.FilmColumns.EditSummary] = md.EditSummary
+	cols[models.FilmColumns.RequestID] = md.RequestID
+	cols[models.FilmColumns.ClientIP] = md.ClientIP
+	cols[models.FilmColumns.UserAgent] = md.UserAgent
+	return cols
+}
+
+func setFilmContributionMetadata(ctx context.Context, film *models.Film) {
+	md := ContributionMetadataFromContext(ctx)
+	film.EditSummary = md.EditSummary
+	film.RequestID = md.RequestID
+	film.ClientIP = md.ClientIP
+	film.UserAgent = md.UserAgent
+}
+
+func setSeriesContributionMetadata(ctx context.Context, series *models.Series) {
+	md := ContributionMetadataFromContext(ctx)
+	series.EditSummary = md.EditSummary
+	series.RequestID = md.RequestID
+	series.ClientIP = md.ClientIP
+	series.UserAgent = md.UserAgent
+}
+
+// ContributionsGetAll returns the contributions of userID, newest first.
+func (repo *Repository) ContributionsGetAll(
+	ctx context.Context,
+	userID int,
+	offset, limit int,
+) ([]*Contribution, error) {
+	// the predecessor of a revision might be contributed by anyone so the
+	// whole history of the records the user contributed to is windowed
+	query := fmt.Sprintf(
+		"WITH revisions AS (%[1]s), "+
+			"contributed AS (SELECT DISTINCT source, id FROM revisions WHERE %[2]s = $1), "+
+			"actions AS ("+
+			"SELECT revisions.*, CASE "+
+			"WHEN LAG(%[3]s) OVER w IS NULL THEN '%[4]s' "+
+			"WHEN %[8]s IS NOT NULL AND LAG(%[8]s) OVER w IS NULL THEN '%[5]s' "+
+			"WHEN %[8]s IS NULL AND LAG(%[8]s) OVER w IS NOT NULL THEN '%[6]s' "+
+			"ELSE '%[7]s' END AS action "+
+			"FROM revisions JOIN contributed USING (source, id) "+
+			"WINDOW w AS (PARTITION BY source, id ORDER BY %[3]s)"+
+			") "+
+			"SELECT record, id, series_id, season_number, episode_number, title, action, %[8]s, %[9]s, %[3]s "+
+			"FROM actions WHERE %[2]s = $1 "+
+			"ORDER BY %[3]s DESC, source, id OFFSET $2 LIMIT $3",
+		contributionRevisions(),
+		models.FilmColumns.ContributedBy,
+		models.FilmColumns.ContributedAt,
+		ContributionActionCreate,
+		ContributionActionInvalidate,
+		ContributionActionRestore,
+		ContributionActionUpdate,
+		models.FilmColumns.Invalidation,
+		models.FilmColumns.EditSummary,
+	)
+
+	var contributions []*Contribution
+	err := queries.Raw(query, userID, offset, limit).
+		Bind(ctx, repo.exec, &contributions)
+	if err != nil {
+		return nil, err
+	}
+	return contributions, nil
+}
+
+func (repo *Repository) ContributionsCount(
+	ctx context.Context,
+	userID int,
+) (int, error) {
+	var count struct {
+		Count int `boil:"count"`
+	}
+	err := queries.Raw(
+		fmt.Sprintf(
+			"WITH revisions AS (%s) SELECT COUNT(*) AS count FROM revisions WHERE %s = $1",
+			contributionRevisions(),
+			models.FilmColumns.ContributedBy,
+		),
+		userID,
+	).Bind(ctx, repo.exec, &count)
+	if err != nil {
+		return 0, err
+	}
+	return count.Count, nil
+}
+
+// ContributorsGetAll ranks the contributors by the number of contributions
+// they made from since until until, a zero time leaves that end open.
+// Contributors with the same number of contributions share a rank.
+func (repo *Repository) ContributorsGetAll(
+	ctx context.Context,
+	since, until time.Time,
+	offset, limit int,
+) ([]*Contributor, error) {
+	var contributors []*Contributor
+	err := queries.Raw(
+		fmt.Sprintf(
+			"WITH revisions AS (%[1]s) "+
+				"SELECT RANK() OVER (ORDER BY COUNT(*) DESC) AS rank, "+
+				"%[2]s AS user_id, COUNT(*) AS contributions "+
+				"FROM revisions WHERE %[3]s "+
+				"GROUP BY %[2]s ORDER BY contributions DESC, user_id OFFSET $3 LIMIT $4",
+			contributionRevisions(),
+			models.FilmColumns.ContributedBy,
+			contributionsWindow(),
+		),
+		null.NewTime(since, !since.IsZero()),
+		null.NewTime(until, !until.IsZero()),
+		offset,
+		limit,
+	).Bind(ctx, repo.exec, &contributors)
+	if err != nil {
+		return nil, err
+	}
+	return contributors, nil
+}
+
+func (repo *Repository) ContributorsCount(
+	ctx context.Context,
+	since, until time.Time,
+) (int, error) {
+	var count struct {
+		Count int `boil:"count"`
+	}
+	err := queries.Raw(
+		fmt.Sprintf(
+			"WITH revisions AS (%s) "+
+				"SELECT COUNT(DISTINCT %s) AS count FROM revisions WHERE %s",
+			contributionRevisions(),
+			models.FilmColumns.ContributedBy,
+			contributionsWindow(),
+		),
+		null.NewTime(since, !since.IsZero()),
+		null.NewTime(until, !until.IsZero()),
+	).Bind(ctx, repo.exec, &count)
+	if err != nil {
+		return 0, err
+	}
+	return count.Count, nil
+}
+
+// contributionRevisions selects every revision of films and serieses, current
+// rows and audits alike, tagged with the table they are kept in.
+func contributionRevisions() string {
+	films := func(table string) string {
+		return fmt.Sprintf(
+			"SELECT '%[2]s' AS source, "+
+				"CASE WHEN %[3]s IS NULL THEN '%[8]s' ELSE '%[9]s' END AS record, "+
+				"%[4]s AS id, %[3]s AS series_id, %[5]s AS season_number, %[6]s AS episode_number, "+
+				"%[7]s AS title, %[10]s, %[11]s, %[12]s, %[13]s FROM %[1]s",
+			table,
+			models.TableNames.Films,
+			models.FilmColumns.SeriesID,
+			models.FilmColumns.ID,
+			models.FilmColumns.SeasonNumber,
+			models.FilmColumns.EpisodeNumber,
+			models.FilmColumns.Title,
+			ContributionRecordMovie,
+			ContributionRecordEpisode,
+			models.FilmColumns.ContributedBy,
+			models.FilmColumns.ContributedAt,
+			models.FilmColumns.Invalidation,
+			models.FilmColumns.EditSummary,
+		)
+	}
+	serieses := func(table string) string {
+		return fmt.Sprintf(
+			"SELECT '%[2]s' AS source, '%[3]s' AS record, "+
+				"%[4]s AS id, NULL::INT AS series_id, NULL::INT AS season_number, NULL::INT AS episode_number, "+
+				"%[5]s AS title, %[6]s, %[7]s, %[8]s, %[9]s FROM %[1]s",
+			table,
+			models.TableNames.Serieses,
+			ContributionRecordSeries,
+			models.SeriesColumns.ID,
+			models.SeriesColumns.Title,
+			models.SeriesColumns.ContributedBy,
+			models.SeriesColumns.ContributedAt,
+			models.SeriesColumns.Invalidation,
+			models.SeriesColumns.EditSummary,
+		)
+	}
+	return strings.Join(
+		[]string{
+			films(models.TableNames.Films),
+			films(models.TableNames.FilmsAudit),
+			serieses(models.TableNames.Serieses),
+			serieses(models.TableNames.SeriesesAudit),
+		},
+		" UNION ALL ",
+	)
+}
+
+// contributionsWindow filters revisions by the $1 and $2 nullable bounds.
+func contributionsWindow() string {
+	return fmt.Sprintf(
+		"($1::TIMESTAMPTZ IS NULL OR %[1]s >= $1) AND ($2::TIMESTAMPTZ IS NULL OR %[1]s < $2)",
+		models.FilmColumns.ContributedAt,
+	)
+}
@@ -0,0 +1,259 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestContributionsGetAll(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	users := []*models.User{{Email: "email1"}, {Email: "email2"}}
+	for _, u := range users {
+		err := r.UserCreate(ctx, u)
+		require.NoError(err)
+	}
+
+	// the second user creates a series with an episode, the first one edits
+	// the series after creating a movie and then invalidates and restores it
+
+	series := &models.Series{Title: "series", DateStarted: testutils.Date(2000, 1, 1)}
+	err = r.SeriesCreate(ctx, users[1].ID, series)
+	require.NoError(err)
+	err = r.SeasonCreateIfNotExists(ctx, series.ID, 1, users[1].ID)
+	require.NoError(err)
+	episode := &models.Film{Title: "episode", DateReleased: testutils.Date(2000, 1, 1)}
+	err = r.EpisodePut(ctx, series.ID, 1, 1, users[1].ID, episode)
+	require.NoError(err)
+
+	movie := &models.Film{Title: "movie", DateReleased: testutils.Date(2000, 1, 1)}
+	err = r.MovieCreate(ctx, users[0].ID, movie)
+	require.NoError(err)
+	err = r.SeriesUpdate(
+		ctx,
+		series.ID,
+		users[0].ID,
+		map[string]any{models.SeriesColumns.Title: "new series"},
+	)
+	require.NoError(err)
+	err = r.MovieInvalidate(ctx, movie.ID, users[0].ID, "invalid")
+	require.NoError(err)
+	err = r.MovieRestore(ctx, movie.ID, users[0].ID)
+	require.NoError(err)
+
+	contributions, err := r.ContributionsGetAll(ctx, users[0].ID, 0, 10)
+	require.NoError(err)
+	count, err := r.ContributionsCount(ctx, users[0].ID)
+	require.NoError(err)
+	require.Equal(4, count)
+	require.Len(contributions, 4)
+
+	type contribution struct {
+		record, action, title string
+		id                    int
+		invalidation          null.String
+	}
+	got := make([]contribution, 0, len(contributions))
+	for _, c := range contributions {
+		got = append(got, contribution{
+			record:       c.Record,
+			action:       c.Action,
+			title:        c.Title,
+			id:           c.ID,
+			invalidation: c.Invalidation,
+		})
+	}
+	require.Equal(
+		[]contribution{
+			{repo.ContributionRecordMovie, repo.ContributionActionRestore, "movie", movie.ID, null.String{}},
+			{repo.ContributionRecordMovie, repo.ContributionActionInvalidate, "movie", movie.ID, null.StringFrom("invalid")},
+			{repo.ContributionRecordSeries, repo.ContributionActionUpdate, "new series", series.ID, null.String{}},
+			{repo.ContributionRecordMovie, repo.ContributionActionCreate, "movie", movie.ID, null.String{}},
+		},
+		got,
+	)
+
+	// episodes carry their position in the series
+
+	contributions, err = r.ContributionsGetAll(ctx, users[1].ID, 0, 10)
+	require.NoError(err)
+	require.Len(contributions, 2)
+	require.Equal(repo.ContributionRecordEpisode, contributions[0].Record)
+	require.Equal(repo.ContributionActionCreate, contributions[0].Action)
+	require.Equal(null.IntFrom(series.ID), contributions[0].SeriesID)
+	require.Equal(null.IntFrom(1), contributions[0].SeasonNumber)
+	require.Equal(null.IntFrom(1), contributions[0].EpisodeNumber)
+	require.Equal(repo.ContributionRecordSeries, contributions[1].Record)
+	require.Equal(repo.ContributionActionCreate, contributions[1].Action)
+
+	// pagination
+
+	contributions, err = r.ContributionsGetAll(ctx, users[0].ID, 1, 2)
+	require.NoError(err)
+	require.Len(contributions, 2)
+	require.Equal(repo.ContributionActionInvalidate, contributions[0].Action)
+	require.Equal(repo.ContributionActionUpdate, contributions[1].Action)
+}
+
+func TestContributorsGetAll(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	users := []*models.User{{Email: "email1"}, {Email: "email2"}, {Email: "email3"}}
+	for _, u := range users {
+		err := r.UserCreate(ctx, u)
+		require.NoError(err)
+	}
+
+	// no contributors
+
+	contributors, err := r.ContributorsGetAll(ctx, time.Time{}, time.Time{}, 0, 10)
+	require.NoError(err)
+	require.Len(contributors, 0)
+	count, err := r.ContributorsCount(ctx, time.Time{}, time.Time{})
+	require.NoError(err)
+	require.Equal(0, count)
+
+	// the first user makes one contribution and the others two each
+
+	movie := &models.Film{Title: "movie", DateReleased: testutils.Date(2000, 1, 1)}
+	err = r.MovieCreate(ctx, users[0].ID, movie)
+	require.NoError(err)
+
+	var middle time.Time
+	for i, u := range users[1:] {
+		if i == 1 {
+			middle = time.Now()
+		}
+		series := &models.Series{Title: "series", DateStarted: testutils.Date(2000, 1, 1)}
+		err := r.SeriesCreate(ctx, u.ID, series)
+		require.NoError(err)
+		err = r.SeriesInvalidate(ctx, series.ID, u.ID, "invalid")
+		require.NoError(err)
+	}
+
+	contributors, err = r.ContributorsGetAll(ctx, time.Time{}, time.Time{}, 0, 10)
+	require.NoError(err)
+	require.Equal(
+		[]*repo.Contributor{
+			{Rank: 1, UserID: users[1].ID, Contributions: 2},
+			{Rank: 1, UserID: users[2].ID, Contributions: 2},
+			{Rank: 3, UserID: users[0].ID, Contributions: 1},
+		},
+		contributors,
+	)
+	count, err = r.ContributorsCount(ctx, time.Time{}, time.Time{})
+	require.NoError(err)
+	require.Equal(3, count)
+
+	// time window
+
+	contributors, err = r.ContributorsGetAll(ctx, middle, time.Time{}, 0, 10)
+	require.NoError(err)
+	require.Equal(
+		[]*repo.Contributor{
+			{Rank: 1, UserID: users[2].ID, Contributions: 2},
+		},
+		contributors,
+	)
+	count, err = r.ContributorsCount(ctx, middle, time.Time{})
+	require.NoError(err)
+	require.Equal(1, count)
+
+	contributors, err = r.ContributorsGetAll(ctx, time.Time{}, middle, 0, 10)
+	require.NoError(err)
+	require.Equal(
+		[]*repo.Contributor{
+			{Rank: 1, UserID: users[1].ID, Contributions: 2},
+			{Rank: 2, UserID: users[0].ID, Contributions: 1},
+		},
+		contributors,
+	)
+
+	// pagination
+
+	contributors, err = r.ContributorsGetAll(ctx, time.Time{}, time.Time{}, 2, 1)
+	require.NoError(err)
+	require.Equal(
+		[]*repo.Contributor{
+			{Rank: 3, UserID: users[0].ID, Contributions: 1},
+		},
+		contributors,
+	)
+}
+
+func TestContributionMetadata(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	md := &repo.ContributionMetadata{
+		EditSummary: null.StringFrom("add movie"),
+		RequestID:   null.StringFrom("request"),
+		ClientIP:    null.StringFrom("127.0.0.1"),
+		UserAgent:   null.StringFrom("agent"),
+	}
+	movie := &models.Film{Title: "movie", DateReleased: testutils.Date(2000, 1, 1)}
+	err = r.MovieCreate(repo.WithContributionMetadata(ctx, md), user.ID, movie)
+	require.NoError(err)
+
+	// an update without metadata must not carry on the created one
+	err = r.MovieUpdate(
+		ctx,
+		movie.ID,
+		user.ID,
+		map[string]any{models.FilmColumns.Title: "new title"},
+	)
+	require.NoError(err)
+
+	gotMovie, err := r.MovieGet(ctx, movie.ID, false)
+	require.NoError(err)
+	require.Equal(null.String{}, gotMovie.EditSummary)
+	require.Equal(null.String{}, gotMovie.RequestID)
+	require.Equal(null.String{}, gotMovie.ClientIP)
+	require.Equal(null.String{}, gotMovie.UserAgent)
+
+	audits, err := r.MovieAuditsGetAll(ctx, movie.ID, 0, 10)
+	require.NoError(err)
+	require.Len(audits, 1)
+	require.Equal(md.EditSummary, audits[0].EditSummary)
+	require.Equal(md.RequestID, audits[0].RequestID)
+	require.Equal(md.ClientIP, audits[0].ClientIP)
+	require.Equal(md.UserAgent, audits[0].UserAgent)
+
+	// the summary shows up in the contribution history
+	contributions, err := r.ContributionsGetAll(ctx, user.ID, 0, 10)
+	require.NoError(err)
+	require.Len(contributions, 2)
+	require.Equal(null.String{}, contributions[0].EditSummary)
+	require.Equal(md.EditSummary, contributions[1].EditSummary)
+}
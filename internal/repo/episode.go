@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/aria3ppp/watch-server/internal/models"
 	"github.com/volatiletech/null/v8"
@@ -29,14 +30,22 @@ import (
 // 	return episode, nil
 // }
 
+// EpisodeGet fetches an episode along with the relations to load, like
+// models.FilmRels.Series.
 func (repo *Repository) EpisodeGet(
 	ctx context.Context,
 	seriesID, seasonNumber, episodeNumber int,
+	includeInvalidated bool,
+	loads ...string,
 ) (*models.Film, error) {
-	episode, err := models.Films(
+	mods := []qm.QueryMod{
 		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
 		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
 		models.FilmWhere.EpisodeNumber.EQ(null.IntFrom(episodeNumber)),
+		invalidationFilter(models.FilmColumns.Invalidation, includeInvalidated),
+	}
+	episode, err := models.Films(
+		append(mods, loadMods(loads, includeInvalidated)...)...,
 	).One(ctx, repo.exec)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -51,11 +60,13 @@ func (repo *Repository) EpisodesGetAllBySeries(
 	ctx context.Context,
 	seriesID int,
 	offset, limit int,
+	includeInvalidated bool,
 ) ([]*models.Film, error) {
 	episodes, err := models.Films(
 		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
 		models.FilmWhere.SeasonNumber.IsNotNull(),
 		models.FilmWhere.EpisodeNumber.IsNotNull(),
+		invalidationFilter(models.FilmColumns.Invalidation, includeInvalidated),
 		qm.Offset(offset),
 		qm.Limit(limit),
 		qm.OrderBy(models.FilmColumns.SeasonNumber),
@@ -72,11 +83,13 @@ func (repo *Repository) EpisodesGetAllBySeason(
 	seriesID int,
 	seasonNumber int,
 	offset, limit int,
+	includeInvalidated bool,
 ) ([]*models.Film, error) {
 	episodes, err := models.Films(
 		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
 		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
 		models.FilmWhere.EpisodeNumber.IsNotNull(),
+		invalidationFilter(models.FilmColumns.Invalidation, includeInvalidated),
 		qm.Offset(offset),
 		qm.Limit(limit),
 		qm.OrderBy(models.FilmColumns.SeasonNumber),
@@ -88,16 +101,48 @@ func (repo *Repository) EpisodesGetAllBySeason(
 	return episodes, nil
 }
 
+// EpisodesGetPageBySeries is the keyset paginated counterpart of
+// EpisodesGetAllBySeries, keyed by the season and episode numbers.
+func (repo *Repository) EpisodesGetPageBySeries(
+	ctx context.Context,
+	seriesID int,
+	page *KeysetPage,
+	includeInvalidated bool,
+) ([]*models.Film, error) {
+	mods := []qm.QueryMod{
+		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		models.FilmWhere.SeasonNumber.IsNotNull(),
+		models.FilmWhere.EpisodeNumber.IsNotNull(),
+		invalidationFilter(models.FilmColumns.Invalidation, includeInvalidated),
+	}
+	episodes, err := models.Films(
+		append(mods, episodeKeyset.mods(page)...)...,
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return keysetRows(episodes, page), nil
+}
+
+var episodeKeyset = keyset{
+	columns: []string{
+		models.FilmColumns.SeasonNumber,
+		models.FilmColumns.EpisodeNumber,
+	},
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 func (repo *Repository) EpisodesCountBySeries(
 	ctx context.Context,
 	seriesID int,
+	includeInvalidated bool,
 ) (int, error) {
 	nEpisodes, err := models.Films(
 		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
 		models.FilmWhere.SeasonNumber.IsNotNull(),
 		models.FilmWhere.EpisodeNumber.IsNotNull(),
+		invalidationFilter(models.FilmColumns.Invalidation, includeInvalidated),
 	).Count(ctx, repo.exec)
 	return int(nEpisodes), err
 }
@@ -106,11 +151,13 @@ func (repo *Repository) EpisodesCountBySeason(
 	ctx context.Context,
 	seriesID int,
 	seasonNumber int,
+	includeInvalidated bool,
 ) (int, error) {
 	nEpisodes, err := models.Films(
 		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
 		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
 		models.FilmWhere.EpisodeNumber.IsNotNull(),
+		invalidationFilter(models.FilmColumns.Invalidation, includeInvalidated),
 	).Count(ctx, repo.exec)
 	return int(nEpisodes), err
 }
@@ -123,10 +170,28 @@ func (repo *Repository) EpisodePut(
 	contributorID int,
 	episode *models.Film,
 ) error {
+	// a conditional put replaces an existing episode only
+	if _, isConditional := ifMatchVersions(ctx); isConditional {
+		return repo.EpisodeUpdate(
+			ctx,
+			seriesID,
+			seasonNumber,
+			episodeNumber,
+			contributorID,
+			map[string]any{
+				models.FilmColumns.Title:        episode.Title,
+				models.FilmColumns.Descriptions: episode.Descriptions,
+				models.FilmColumns.DateReleased: episode.DateReleased,
+				models.FilmColumns.Duration:     episode.Duration,
+				models.FilmColumns.Invalidation: episode.Invalidation,
+			},
+		)
+	}
 	episode.SeriesID = null.IntFrom(seriesID)
 	episode.SeasonNumber = null.IntFrom(seasonNumber)
 	episode.EpisodeNumber = null.IntFrom(episodeNumber)
 	episode.ContributedBy = contributorID
+	setFilmContributionMetadata(ctx, episode)
 	return episode.Upsert(
 		ctx,
 		repo.exec,
@@ -149,16 +214,19 @@ func (repo *Repository) EpisodeUpdate(
 	cols map[string]any,
 ) error {
 	cols[models.FilmColumns.ContributedBy] = contributorID
-	rowsAff, err := models.Films(
+	mods := []qm.QueryMod{
 		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
 		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
 		models.FilmWhere.EpisodeNumber.EQ(null.IntFrom(episodeNumber)),
-	).UpdateAll(ctx, repo.exec, cols)
+	}
+	rowsAff, err := models.Films(
+		append(mods, ifMatchMod(ctx, models.FilmColumns.ContributedAt))...,
+	).UpdateAll(ctx, repo.exec, withContributionMetadata(ctx, cols))
 	if err != nil {
 		return err
 	}
 	if rowsAff == 0 {
-		return ErrNoRecord
+		return noRowsUpdated(ctx, repo.exec, models.Films(mods...).Exists)
 	}
 	return nil
 }
@@ -169,23 +237,26 @@ func (repo *Repository) EpisodeInvalidate(
 	contributorID int,
 	invalidation string,
 ) error {
-	rowsAff, err := models.Films(
+	mods := []qm.QueryMod{
 		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
 		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
 		models.FilmWhere.EpisodeNumber.EQ(null.IntFrom(episodeNumber)),
+	}
+	rowsAff, err := models.Films(
+		append(mods, ifMatchMod(ctx, models.FilmColumns.ContributedAt))...,
 	).UpdateAll(
 		ctx,
 		repo.exec,
-		map[string]any{
+		withContributionMetadata(ctx, map[string]any{
 			models.FilmColumns.Invalidation:  invalidation,
 			models.FilmColumns.ContributedBy: contributorID,
-		},
+		}),
 	)
 	if err != nil {
 		return err
 	}
 	if rowsAff == 0 {
-		return ErrNoRecord
+		return noRowsUpdated(ctx, repo.exec, models.Films(mods...).Exists)
 	}
 	return nil
 }
@@ -204,10 +275,10 @@ func (repo *Repository) EpisodesInvalidateAllBySeason(
 	).UpdateAll(
 		ctx,
 		repo.exec,
-		map[string]any{
+		withContributionMetadata(ctx, map[string]any{
 			models.FilmColumns.Invalidation:  invalidation,
 			models.FilmColumns.ContributedBy: contributorID,
-		},
+		}),
 	)
 	if err != nil {
 		return err
@@ -231,10 +302,103 @@ func (repo *Repository) EpisodesInvalidateAllBySeries(
 	).UpdateAll(
 		ctx,
 		repo.exec,
-		map[string]any{
+		withContributionMetadata(ctx, map[string]any{
 			models.FilmColumns.Invalidation:  invalidation,
 			models.FilmColumns.ContributedBy: contributorID,
-		},
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// EpisodeRestore clears the invalidation of an episode.
+// ErrNoRecord is returned if there's no such invalidated record.
+func (repo *Repository) EpisodeRestore(
+	ctx context.Context,
+	seriesID, seasonNumber, episodeNumber int,
+	contributorID int,
+) error {
+	rowsAff, err := models.Films(
+		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
+		models.FilmWhere.EpisodeNumber.EQ(null.IntFrom(episodeNumber)),
+		models.FilmWhere.Invalidation.IsNotNull(),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		withContributionMetadata(ctx, map[string]any{
+			models.FilmColumns.Invalidation:  nil,
+			models.FilmColumns.ContributedBy: contributorID,
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// EpisodesRestoreAllBySeason clears the invalidation of all episodes of a season.
+// ErrNoRecord is returned if there's no such invalidated record.
+func (repo *Repository) EpisodesRestoreAllBySeason(
+	ctx context.Context,
+	seriesID int,
+	seasonNumber int,
+	contributorID int,
+) error {
+	rowsAff, err := models.Films(
+		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
+		models.FilmWhere.EpisodeNumber.IsNotNull(),
+		models.FilmWhere.Invalidation.IsNotNull(),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		withContributionMetadata(ctx, map[string]any{
+			models.FilmColumns.Invalidation:  nil,
+			models.FilmColumns.ContributedBy: contributorID,
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// EpisodesRestoreAllBySeries clears the invalidation of the episodes of a
+// series invalidated at invalidatedAt with the given reason, that is the ones
+// invalidated along with the series itself.
+// ErrNoRecord is returned if there's no such invalidated episode.
+func (repo *Repository) EpisodesRestoreAllBySeries(
+	ctx context.Context,
+	seriesID int,
+	contributorID int,
+	invalidatedAt time.Time,
+	invalidation string,
+) error {
+	rowsAff, err := models.Films(
+		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		models.FilmWhere.SeasonNumber.IsNotNull(),
+		models.FilmWhere.EpisodeNumber.IsNotNull(),
+		models.FilmWhere.Invalidation.EQ(null.StringFrom(invalidation)),
+		models.FilmWhere.ContributedAt.EQ(invalidatedAt),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		withContributionMetadata(ctx, map[string]any{
+			models.FilmColumns.Invalidation:  nil,
+			models.FilmColumns.ContributedBy: contributorID,
+		}),
 	)
 	if err != nil {
 		return err
@@ -272,33 +436,55 @@ func (repo *Repository) EpisodeAuditsGetAll(
 	seriesID, seasonNumber, episodeNumber int,
 	offset, limit int,
 ) ([]*models.FilmsAudit, error) {
-	audits, err := models.FilmsAudits(
-		models.FilmsAuditWhere.SeriesID.EQ(null.IntFrom(seriesID)),
-		models.FilmsAuditWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
-		models.FilmsAuditWhere.EpisodeNumber.EQ(null.IntFrom(episodeNumber)),
-		qm.Offset(offset),
-		qm.Limit(limit),
-		qm.OrderBy(models.FilmsAuditColumns.ContributedAt+" desc"),
-	).All(ctx, repo.exec)
-	if err != nil {
-		return nil, err
-	}
-	return audits, nil
+	return EpisodeAudits.GetAll(
+		ctx,
+		repo.exec,
+		[]any{seriesID, seasonNumber, episodeNumber},
+		offset,
+		limit,
+	)
+}
+
+func (repo *Repository) EpisodeAuditsGetPage(
+	ctx context.Context,
+	seriesID, seasonNumber, episodeNumber int,
+	page *KeysetPage,
+) ([]*models.FilmsAudit, error) {
+	return EpisodeAudits.GetPage(
+		ctx,
+		repo.exec,
+		[]any{seriesID, seasonNumber, episodeNumber},
+		page,
+	)
 }
 
 func (repo *Repository) EpisodeAuditsCount(
 	ctx context.Context,
 	seriesID, seasonNumber, episodeNumber int,
 ) (int, error) {
-	auditsCount, err := models.FilmsAudits(
-		models.FilmsAuditWhere.SeriesID.EQ(null.IntFrom(seriesID)),
-		models.FilmsAuditWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
-		models.FilmsAuditWhere.EpisodeNumber.EQ(null.IntFrom(episodeNumber)),
-	).Count(ctx, repo.exec)
-	if err != nil {
-		return 0, err
-	}
-	return int(auditsCount), nil
+	return EpisodeAudits.Count(
+		ctx,
+		repo.exec,
+		[]any{seriesID, seasonNumber, episodeNumber},
+	)
+}
+
+// EpisodeAuditGet returns the audit of the episode of id contributed at
+// contributedAt. Audits of episodes previously numbered the same are left
+// out.
+func (repo *Repository) EpisodeAuditGet(
+	ctx context.Context,
+	id int,
+	seriesID, seasonNumber, episodeNumber int,
+	contributedAt time.Time,
+) (*models.FilmsAudit, error) {
+	return EpisodeAudits.Get(
+		ctx,
+		repo.exec,
+		[]any{seriesID, seasonNumber, episodeNumber},
+		contributedAt,
+		qm.Where(models.FilmsAuditColumns.ID+" = ?", id),
+	)
 }
 
 func (repo *Repository) EpisodesAuditsGetAllBySeason(
@@ -307,18 +493,13 @@ func (repo *Repository) EpisodesAuditsGetAllBySeason(
 	seasonNumber int,
 	offset, limit int,
 ) ([]*models.FilmsAudit, error) {
-	audits, err := models.FilmsAudits(
-		models.FilmsAuditWhere.SeriesID.EQ(null.IntFrom(seriesID)),
-		models.FilmsAuditWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
-		models.FilmsAuditWhere.EpisodeNumber.IsNotNull(),
-		qm.Offset(offset),
-		qm.Limit(limit),
-		qm.OrderBy(models.FilmsAuditColumns.ContributedAt+" desc"),
-	).All(ctx, repo.exec)
-	if err != nil {
-		return nil, err
-	}
-	return audits, nil
+	return EpisodeAudits.GetAll(
+		ctx,
+		repo.exec,
+		[]any{seriesID, seasonNumber},
+		offset,
+		limit,
+	)
 }
 
 func (repo *Repository) EpisodesAuditsCountBySeason(
@@ -326,15 +507,7 @@ func (repo *Repository) EpisodesAuditsCountBySeason(
 	seriesID int,
 	seasonNumber int,
 ) (int, error) {
-	auditsCount, err := models.FilmsAudits(
-		models.FilmsAuditWhere.SeriesID.EQ(null.IntFrom(seriesID)),
-		models.FilmsAuditWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
-		models.FilmsAuditWhere.EpisodeNumber.IsNotNull(),
-	).Count(ctx, repo.exec)
-	if err != nil {
-		return 0, err
-	}
-	return int(auditsCount), nil
+	return EpisodeAudits.Count(ctx, repo.exec, []any{seriesID, seasonNumber})
 }
 
 func (repo *Repository) EpisodesAuditsGetAllBySeries(
@@ -342,31 +515,12 @@ func (repo *Repository) EpisodesAuditsGetAllBySeries(
 	seriesID int,
 	offset, limit int,
 ) ([]*models.FilmsAudit, error) {
-	audits, err := models.FilmsAudits(
-		models.FilmsAuditWhere.SeriesID.EQ(null.IntFrom(seriesID)),
-		models.FilmsAuditWhere.SeasonNumber.IsNotNull(),
-		models.FilmsAuditWhere.EpisodeNumber.IsNotNull(),
-		qm.Offset(offset),
-		qm.Limit(limit),
-		qm.OrderBy(models.FilmsAuditColumns.ContributedAt+" desc"),
-	).All(ctx, repo.exec)
-	if err != nil {
-		return nil, err
-	}
-	return audits, nil
+	return EpisodeAudits.GetAll(ctx, repo.exec, []any{seriesID}, offset, limit)
 }
 
 func (repo *Repository) EpisodesAuditsCountBySeries(
 	ctx context.Context,
 	seriesID int,
 ) (int, error) {
-	auditsCount, err := models.FilmsAudits(
-		models.FilmsAuditWhere.SeriesID.EQ(null.IntFrom(seriesID)),
-		models.FilmsAuditWhere.SeasonNumber.IsNotNull(),
-		models.FilmsAuditWhere.EpisodeNumber.IsNotNull(),
-	).Count(ctx, repo.exec)
-	if err != nil {
-		return 0, err
-	}
-	return int(auditsCount), nil
+	return EpisodeAudits.Count(ctx, repo.exec, []any{seriesID})
 }
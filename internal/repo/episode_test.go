@@ -72,7 +72,7 @@ func TestEpisodeGet(t *testing.T) {
 
 	// first there's no episode
 
-	fetchedEpisode, err := r.EpisodeGet(ctx, series.ID, 1, 1)
+	fetchedEpisode, err := r.EpisodeGet(ctx, series.ID, 1, 1, true)
 	require.Equal(repo.ErrNoRecord, err)
 	require.Nil(fetchedEpisode)
 
@@ -103,6 +103,7 @@ func TestEpisodeGet(t *testing.T) {
 		series.ID,
 		seasonNumber,
 		episodeNumber,
+		true,
 	)
 	require.NoError(err)
 
@@ -138,6 +139,7 @@ func TestEpisodesGetAllBySeries(t *testing.T) {
 		series.ID,
 		0,
 		math.MaxInt,
+		true,
 	)
 	require.NoError(err)
 	require.Equal(0, len(fetchedEpisode))
@@ -181,6 +183,7 @@ func TestEpisodesGetAllBySeries(t *testing.T) {
 		series.ID,
 		0,
 		math.MaxInt,
+		true,
 	)
 	require.NoError(err)
 	require.Equal(len(episodes), len(fetchedEpisode))
@@ -222,6 +225,7 @@ func TestEpisodesGetAllBySeason(t *testing.T) {
 		seasonNumber,
 		0,
 		math.MaxInt,
+		true,
 	)
 	require.NoError(err)
 	require.Equal(0, len(fetchedEpisodes))
@@ -264,6 +268,7 @@ func TestEpisodesGetAllBySeason(t *testing.T) {
 		seasonNumber,
 		0,
 		math.MaxInt,
+		true,
 	)
 	require.NoError(err)
 	require.Equal(len(episodes), len(fetchedEpisodes))
@@ -299,7 +304,7 @@ func TestEpisodesCountBySeries(t *testing.T) {
 
 	// first there's no episode
 
-	nEpisodes, err := r.EpisodesCountBySeries(ctx, series.ID)
+	nEpisodes, err := r.EpisodesCountBySeries(ctx, series.ID, true)
 	require.NoError(err)
 	require.Equal(0, nEpisodes)
 
@@ -337,7 +342,7 @@ func TestEpisodesCountBySeries(t *testing.T) {
 
 	// count episodes
 
-	nEpisodes, err = r.EpisodesCountBySeries(ctx, series.ID)
+	nEpisodes, err = r.EpisodesCountBySeries(ctx, series.ID, true)
 	require.NoError(err)
 	require.Equal(len(episodes), nEpisodes)
 }
@@ -362,7 +367,7 @@ func TestEpisodesCountBySeason(t *testing.T) {
 
 	// first there's no episode
 
-	nEpisodes, err := r.EpisodesCountBySeason(ctx, series.ID, seasonNumber)
+	nEpisodes, err := r.EpisodesCountBySeason(ctx, series.ID, seasonNumber, true)
 	require.NoError(err)
 	require.Equal(0, nEpisodes)
 
@@ -398,7 +403,7 @@ func TestEpisodesCountBySeason(t *testing.T) {
 
 	// count episodes
 
-	nEpisodes, err = r.EpisodesCountBySeason(ctx, series.ID, seasonNumber)
+	nEpisodes, err = r.EpisodesCountBySeason(ctx, series.ID, seasonNumber, true)
 	require.NoError(err)
 	require.Equal(len(episodes), nEpisodes)
 }
@@ -431,7 +436,7 @@ func TestEpisodePut(t *testing.T) {
 
 	// first there's no episode
 
-	nEpisodes, err := r.EpisodesCountBySeason(ctx, series.ID, seasonNumber)
+	nEpisodes, err := r.EpisodesCountBySeason(ctx, series.ID, seasonNumber, true)
 	require.NoError(err)
 	require.Equal(0, nEpisodes)
 
@@ -455,6 +460,7 @@ func TestEpisodePut(t *testing.T) {
 		seasonNumber,
 		0,
 		math.MaxInt,
+		true,
 	)
 	require.NoError(err)
 	require.Equal(1, len(fetchedEpisodes))
@@ -493,6 +499,7 @@ func TestEpisodePut(t *testing.T) {
 		seasonNumber,
 		0,
 		math.MaxInt,
+		true,
 	)
 	require.NoError(err)
 	require.Equal(1, len(fetchedEpisodes))
@@ -631,6 +638,7 @@ func TestEpisodeUpdate(t *testing.T) {
 		series.ID,
 		seasonNumber,
 		episodeNumber,
+		true,
 	)
 	require.NoError(err)
 
@@ -763,6 +771,7 @@ func TestEpisodeInvalidate(t *testing.T) {
 		series.ID,
 		seasonNumber,
 		episodeNumber,
+		true,
 	)
 	require.NoError(err)
 	require.Equal(
@@ -888,6 +897,7 @@ func TestEpisodesInvalidateAllBySeason(t *testing.T) {
 		seasonNumber,
 		0,
 		math.MaxInt,
+		true,
 	)
 	require.NoError(err)
 	for _, ie := range invalidatedEpisodes {
@@ -1010,6 +1020,7 @@ func TestEpisodesInvalidateAllBySeries(t *testing.T) {
 		series.ID,
 		0,
 		math.MaxInt,
+		true,
 	)
 	require.NoError(err)
 	for _, ie := range invalidatedEpisodes {
@@ -1288,6 +1299,107 @@ func TestEpisodeAuditsCount(t *testing.T) {
 	require.Equal(len(episodeNewVersions), auditsCount)
 }
 
+func TestEpisodesRestoreAllBySeries(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	seasonNumber := 1
+	for i := 1; i <= 3; i++ {
+		err := r.EpisodePut(
+			ctx,
+			series.ID,
+			seasonNumber,
+			i,
+			user.ID,
+			&models.Film{
+				Title:        "episode",
+				DateReleased: testutils.Date(2000, 1, i),
+			},
+		)
+		require.NoError(err)
+	}
+
+	// invalidate series along with its episodes
+
+	invalidation := "invalidation"
+	err = r.Transaction(ctx, func(ctx context.Context, tx repo.Service) error {
+		err := tx.SeriesInvalidate(ctx, series.ID, user.ID, invalidation)
+		if err != nil {
+			return err
+		}
+		return tx.EpisodesInvalidateAllBySeries(
+			ctx,
+			series.ID,
+			user.ID,
+			invalidation,
+		)
+	})
+	require.NoError(err)
+
+	invalidatedSeries, err := r.SeriesGet(ctx, series.ID, true)
+	require.NoError(err)
+
+	// the third episode is invalidated again on its own afterwards
+
+	err = r.EpisodeInvalidate(ctx, series.ID, seasonNumber, 3, user.ID, "own")
+	require.NoError(err)
+
+	// a different reason restores nothing
+
+	err = r.EpisodesRestoreAllBySeries(
+		ctx,
+		series.ID,
+		user.ID,
+		invalidatedSeries.ContributedAt,
+		"other",
+	)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// restore the episodes invalidated along with the series
+
+	err = r.EpisodesRestoreAllBySeries(
+		ctx,
+		series.ID,
+		user.ID,
+		invalidatedSeries.ContributedAt,
+		invalidation,
+	)
+	require.NoError(err)
+
+	episodes, err := r.EpisodesGetAllBySeries(
+		ctx,
+		series.ID,
+		0,
+		math.MaxInt,
+		false,
+	)
+	require.NoError(err)
+	require.Equal(2, len(episodes))
+
+	invalidatedEpisode, err := r.EpisodeGet(
+		ctx,
+		series.ID,
+		seasonNumber,
+		3,
+		true,
+	)
+	require.NoError(err)
+	require.Equal(null.StringFrom("own"), invalidatedEpisode.Invalidation)
+}
+
 func TestEpisodesAuditsGetAllBySeason(t *testing.T) {
 	require := require.New(t)
 
@@ -1737,3 +1849,83 @@ func TestEpisodesAuditsCountBySeries(t *testing.T) {
 	require.NoError(err)
 	require.Equal(len(episodeNewVersions), auditsCount)
 }
+
+func TestEpisodeAuditGet(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	seasonNumber := 1
+	episodeNumber := 1
+
+	episode := &models.Film{
+		Title:        "episode",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.EpisodePut(
+		ctx,
+		series.ID,
+		seasonNumber,
+		episodeNumber,
+		user.ID,
+		episode,
+	)
+	require.NoError(err)
+
+	err = r.EpisodeUpdate(
+		ctx,
+		series.ID,
+		seasonNumber,
+		episodeNumber,
+		user.ID,
+		map[string]any{models.FilmColumns.Title: "new title"},
+	)
+	require.NoError(err)
+
+	audits, err := r.EpisodeAuditsGetAll(
+		ctx,
+		series.ID,
+		seasonNumber,
+		episodeNumber,
+		0,
+		math.MaxInt,
+	)
+	require.NoError(err)
+	require.Equal(1, len(audits))
+
+	audit, err := r.EpisodeAuditGet(
+		ctx,
+		episode.ID,
+		series.ID,
+		seasonNumber,
+		episodeNumber,
+		audits[0].ContributedAt,
+	)
+	require.NoError(err)
+	require.Equal(audits[0], audit)
+	require.Equal("episode", audit.Title)
+
+	// the audit of another episode numbered so is not found
+
+	_, err = r.EpisodeAuditGet(
+		ctx,
+		episode.ID+1,
+		series.ID,
+		seasonNumber,
+		episodeNumber,
+		audits[0].ContributedAt,
+	)
+	require.Equal(repo.ErrNoRecord, err)
+}
@@ -2,4 +2,7 @@ package repo
 
 import "errors"
 
-var ErrNoRecord = errors.New("repo: no record")
+var (
+	ErrNoRecord           = errors.New("repo: no record")
+	ErrPreconditionFailed = errors.New("repo: precondition failed")
+)
@@ -0,0 +1,127 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// Feed activity types
+const (
+	FeedActivitySeries    = "series"
+	FeedActivitySeason    = "season"
+	FeedActivityFilm      = "film"
+	FeedActivityFilmMedia = "film_media"
+	FeedActivityWatched   = "watched"
+)
+
+// FeedActivity is a single entry of a user's feed. Contributions carry the
+// ids of the contributed record and watchlist completions the watched film.
+type FeedActivity struct {
+	Activity     string    `boil:"activity" json:"activity"`
+	UserID       int       `boil:"user_id" json:"user_id"`
+	OccurredAt   time.Time `boil:"occurred_at" json:"occurred_at"`
+	SeriesID     null.Int  `boil:"series_id" json:"series_id"`
+	SeasonNumber null.Int  `boil:"season_number" json:"season_number"`
+	FilmID       null.Int  `boil:"film_id" json:"film_id"`
+	MediaID      null.Int  `boil:"media_id" json:"media_id"`
+	// Key breaks ties between activities occurred at the same time
+	Key string `boil:"activity_key" json:"-"`
+}
+
+// FeedPosition is the position of an activity in the feed, the feed is
+// ordered by OccurredAt and Key both descending.
+type FeedPosition struct {
+	OccurredAt time.Time
+	Key        string
+}
+
+// FeedGetAll returns up to limit activities of the users followed by userID,
+// newest first, starting right after the before position if not nil.
+func (repo *Repository) FeedGetAll(
+	ctx context.Context,
+	userID int,
+	before *FeedPosition,
+	limit int,
+) ([]*FeedActivity, error) {
+	followed := fmt.Sprintf(
+		"SELECT %s FROM %s WHERE %s = $1",
+		models.UserFollowingColumns.FollowedID,
+		models.TableNames.UserFollowings,
+		models.UserFollowingColumns.FollowerID,
+	)
+
+	sources := []string{
+		feedContributions(FeedActivitySeries, models.TableNames.Serieses, models.SeriesColumns.ID, "NULL", "NULL", "NULL"),
+		feedContributions(FeedActivitySeries, models.TableNames.SeriesesAudit, models.SeriesesAuditColumns.ID, "NULL", "NULL", "NULL"),
+		feedContributions(FeedActivitySeason, models.TableNames.Seasons, models.SeasonColumns.SeriesID, models.SeasonColumns.SeasonNumber, "NULL", "NULL"),
+		feedContributions(FeedActivitySeason, models.TableNames.SeasonsAudit, models.SeasonsAuditColumns.SeriesID, models.SeasonsAuditColumns.SeasonNumber, "NULL", "NULL"),
+		feedContributions(FeedActivityFilm, models.TableNames.Films, models.FilmColumns.SeriesID, models.FilmColumns.SeasonNumber, models.FilmColumns.ID, "NULL"),
+		feedContributions(FeedActivityFilm, models.TableNames.FilmsAudit, models.FilmsAuditColumns.SeriesID, models.FilmsAuditColumns.SeasonNumber, models.FilmsAuditColumns.ID, "NULL"),
+		feedContributions(FeedActivityFilmMedia, models.TableNames.FilmMediaUrls, "NULL", "NULL", models.FilmMediaURLColumns.FilmID, models.FilmMediaURLColumns.ID),
+		feedContributions(FeedActivityFilmMedia, models.TableNames.FilmMediaUrlsAudit, "NULL", "NULL", models.FilmMediaUrlsAuditColumns.FilmID, models.FilmMediaUrlsAuditColumns.ID),
+		fmt.Sprintf(
+			"SELECT '%[1]s' AS activity, %[3]s AS user_id, %[4]s AS occurred_at, "+
+				"NULL::INT AS series_id, NULL::INT AS season_number, %[5]s AS film_id, NULL::INT AS media_id "+
+				"FROM %[2]s WHERE %[4]s IS NOT NULL AND %[3]s IN (SELECT * FROM followed)",
+			FeedActivityWatched,
+			models.TableNames.Watchlists,
+			models.WatchlistColumns.UserID,
+			models.WatchlistColumns.WatchedAt,
+			models.WatchlistColumns.FilmID,
+		),
+	}
+
+	args := []any{userID, limit}
+	where := ""
+	if before != nil {
+		where = "WHERE (occurred_at, activity_key) < ($3, $4) "
+		args = append(args, before.OccurredAt, before.Key)
+	}
+
+	var activities []*FeedActivity
+	err := queries.Raw(
+		fmt.Sprintf(
+			"WITH followed AS (%s), activities AS (%s) "+
+				"SELECT * FROM (SELECT *, format('%%s:%%s:%%s:%%s:%%s:%%s', "+
+				"activity, user_id, series_id, season_number, film_id, media_id) AS activity_key "+
+				"FROM activities) feed %s"+
+				"ORDER BY occurred_at DESC, activity_key DESC LIMIT $2",
+			followed,
+			strings.Join(sources, " UNION ALL "),
+			where,
+		),
+		args...,
+	).Bind(ctx, repo.exec, &activities)
+	if err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
+// feedContributions selects the contributions of the followed users recorded
+// on table, NULL is accepted in place of the id columns.
+func feedContributions(
+	activity string,
+	table string,
+	seriesIDColumn, seasonNumberColumn, filmIDColumn, mediaIDColumn string,
+) string {
+	return fmt.Sprintf(
+		"SELECT '%[1]s' AS activity, %[3]s AS user_id, %[4]s AS occurred_at, "+
+			"%[5]s::INT AS series_id, %[6]s::INT AS season_number, %[7]s::INT AS film_id, %[8]s::INT AS media_id "+
+			"FROM %[2]s WHERE %[3]s IN (SELECT * FROM followed)",
+		activity,
+		table,
+		models.SeriesColumns.ContributedBy,
+		models.SeriesColumns.ContributedAt,
+		seriesIDColumn,
+		seasonNumberColumn,
+		filmIDColumn,
+		mediaIDColumn,
+	)
+}
@@ -0,0 +1,126 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestFeedGetAll(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	follower := &models.User{Email: "follower"}
+	err = r.UserCreate(ctx, follower)
+	require.NoError(err)
+	followed := &models.User{Email: "followed"}
+	err = r.UserCreate(ctx, followed)
+	require.NoError(err)
+	stranger := &models.User{Email: "stranger"}
+	err = r.UserCreate(ctx, stranger)
+	require.NoError(err)
+
+	err = r.UserFollow(ctx, follower.ID, followed.ID)
+	require.NoError(err)
+
+	// first there's no activities
+
+	activities, err := r.FeedGetAll(ctx, follower.ID, nil, 10)
+	require.NoError(err)
+	require.Equal(0, len(activities))
+
+	// create and update a series, contribute a movie and watch it
+
+	series := &models.Series{
+		Title:       "series",
+		DateStarted: testutils.Date(2000, 1, 1),
+	}
+	err = r.SeriesCreate(ctx, followed.ID, series)
+	require.NoError(err)
+	err = r.SeriesUpdate(
+		ctx,
+		series.ID,
+		followed.ID,
+		map[string]any{models.SeriesColumns.Title: "new series title"},
+	)
+	require.NoError(err)
+
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, followed.ID, movie)
+	require.NoError(err)
+
+	err = (&models.Watchlist{UserID: followed.ID, FilmID: movie.ID}).
+		Insert(ctx, db, boil.Infer())
+	require.NoError(err)
+	err = r.WatchlistMarkWatched(ctx, followed.ID, movie.ID)
+	require.NoError(err)
+
+	// activities of users not followed are left out
+
+	strangerMovie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, stranger.ID, strangerMovie)
+	require.NoError(err)
+
+	// the feed is ordered newest first
+
+	activities, err = r.FeedGetAll(ctx, follower.ID, nil, 10)
+	require.NoError(err)
+	require.Equal(4, len(activities))
+
+	expActivities := []struct {
+		activity string
+		seriesID null.Int
+		filmID   null.Int
+	}{
+		{repo.FeedActivityWatched, null.Int{}, null.IntFrom(movie.ID)},
+		{repo.FeedActivityFilm, null.Int{}, null.IntFrom(movie.ID)},
+		{repo.FeedActivitySeries, null.IntFrom(series.ID), null.Int{}},
+		{repo.FeedActivitySeries, null.IntFrom(series.ID), null.Int{}},
+	}
+	for i, exp := range expActivities {
+		require.Equal(exp.activity, activities[i].Activity)
+		require.Equal(followed.ID, activities[i].UserID)
+		require.Equal(exp.seriesID, activities[i].SeriesID)
+		require.Equal(exp.filmID, activities[i].FilmID)
+	}
+
+	// page through the feed
+
+	page, err := r.FeedGetAll(ctx, follower.ID, nil, 1)
+	require.NoError(err)
+	for i := 1; i < len(activities); i++ {
+		page, err = r.FeedGetAll(
+			ctx,
+			follower.ID,
+			&repo.FeedPosition{OccurredAt: page[0].OccurredAt, Key: page[0].Key},
+			1,
+		)
+		require.NoError(err)
+		require.Equal(1, len(page))
+		require.Equal(activities[i], page[0])
+	}
+
+	// the followed user's feed is empty
+
+	activities, err = r.FeedGetAll(ctx, followed.ID, nil, 10)
+	require.NoError(err)
+	require.Equal(0, len(activities))
+}
@@ -0,0 +1,148 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// FilmGet fetches a film regardless of it being a movie or an episode.
+func (repo *Repository) FilmGet(
+	ctx context.Context,
+	id int,
+) (*models.Film, error) {
+	film, err := models.Films(
+		models.FilmWhere.ID.EQ(id),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return film, nil
+}
+
+func (repo *Repository) FilmMediaGet(
+	ctx context.Context,
+	filmID, mediaID int,
+) (*models.FilmMediaURL, error) {
+	media, err := models.FilmMediaUrls(
+		models.FilmMediaURLWhere.ID.EQ(mediaID),
+		models.FilmMediaURLWhere.FilmID.EQ(filmID),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return media, nil
+}
+
+func (repo *Repository) FilmMediaGetAllByFilm(
+	ctx context.Context,
+	filmID int,
+	offset, limit int,
+) ([]*models.FilmMediaURL, error) {
+	media, err := models.FilmMediaUrls(
+		models.FilmMediaURLWhere.FilmID.EQ(filmID),
+		qm.Offset(offset),
+		qm.Limit(limit),
+		qm.OrderBy(models.FilmMediaURLColumns.ID),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+func (repo *Repository) FilmMediaCountByFilm(
+	ctx context.Context,
+	filmID int,
+) (int, error) {
+	nMedia, err := models.FilmMediaUrls(
+		models.FilmMediaURLWhere.FilmID.EQ(filmID),
+	).Count(ctx, repo.exec)
+	return int(nMedia), err
+}
+
+func (repo *Repository) FilmMediaCreate(
+	ctx context.Context,
+	contributorID int,
+	media *models.FilmMediaURL,
+) error {
+	media.ContributedBy = contributorID
+	return media.Insert(ctx, repo.exec, boil.Infer())
+}
+
+func (repo *Repository) FilmMediaInvalidate(
+	ctx context.Context,
+	filmID, mediaID int,
+	contributorID int,
+	invalidation string,
+) error {
+	rowsAff, err := models.FilmMediaUrls(
+		models.FilmMediaURLWhere.ID.EQ(mediaID),
+		models.FilmMediaURLWhere.FilmID.EQ(filmID),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		map[string]any{
+			models.FilmMediaURLColumns.Invalidation:  invalidation,
+			models.FilmMediaURLColumns.ContributedBy: contributorID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// MediaBlobReferenced reports whether a blob key is used by any film media or
+// artist image record, current or audited.
+func (repo *Repository) MediaBlobReferenced(
+	ctx context.Context,
+	key string,
+) (bool, error) {
+	queries := []func(context.Context, boil.ContextExecutor) (bool, error){
+		models.FilmMediaUrls(
+			qm.Expr(
+				models.FilmMediaURLWhere.MediaURL.EQ(key),
+				qm.Or2(models.FilmMediaURLWhere.ThumbnailURL.EQ(null.StringFrom(key))),
+			),
+		).Exists,
+		models.FilmMediaUrlsAudits(
+			qm.Expr(
+				models.FilmMediaUrlsAuditWhere.MediaURL.EQ(key),
+				qm.Or2(models.FilmMediaUrlsAuditWhere.ThumbnailURL.EQ(null.StringFrom(key))),
+			),
+		).Exists,
+		models.ArtistImageUrls(
+			qm.Expr(
+				models.ArtistImageURLWhere.ImageURL.EQ(key),
+				qm.Or2(models.ArtistImageURLWhere.ThumbnailURL.EQ(key)),
+			),
+		).Exists,
+		models.ArtistImageUrlsAudits(
+			qm.Expr(
+				models.ArtistImageUrlsAuditWhere.ImageURL.EQ(key),
+				qm.Or2(models.ArtistImageUrlsAuditWhere.ThumbnailURL.EQ(key)),
+			),
+		).Exists,
+	}
+	for _, exists := range queries {
+		referenced, err := exists(ctx, repo.exec)
+		if err != nil || referenced {
+			return referenced, err
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,142 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestFilmMediaCreate(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	// first there's no media
+
+	media, err := r.FilmMediaGetAllByFilm(ctx, movie.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(0, len(media))
+
+	// create media
+
+	image := &models.FilmMediaURL{
+		FilmID:       movie.ID,
+		MediaURL:     "image",
+		MediaType:    "image",
+		MimeType:     "image/png",
+		SizeInBytes:  100,
+		ThumbnailURL: null.StringFrom("image_thumb"),
+	}
+	err = r.FilmMediaCreate(ctx, user.ID, image)
+	require.NoError(err)
+
+	video := &models.FilmMediaURL{
+		FilmID:      movie.ID,
+		MediaURL:    "video",
+		MediaType:   "video",
+		MimeType:    "video/mp4",
+		SizeInBytes: 1000,
+	}
+	err = r.FilmMediaCreate(ctx, user.ID, video)
+	require.NoError(err)
+
+	fetchedMedia, err := r.FilmMediaGet(ctx, movie.ID, image.ID)
+	require.NoError(err)
+	require.Equal(image.MediaURL, fetchedMedia.MediaURL)
+	require.Equal(image.ThumbnailURL, fetchedMedia.ThumbnailURL)
+	require.Equal(user.ID, fetchedMedia.ContributedBy)
+
+	// media of another film is not found
+
+	_, err = r.FilmMediaGet(ctx, movie.ID+1, image.ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	media, err = r.FilmMediaGetAllByFilm(ctx, movie.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(2, len(media))
+	require.Equal(image.ID, media[0].ID)
+	require.Equal(video.ID, media[1].ID)
+
+	nMedia, err := r.FilmMediaCountByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(2, nMedia)
+}
+
+func TestMediaBlobReferenced(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	// first the blob is unreferenced
+
+	referenced, err := r.MediaBlobReferenced(ctx, "image_thumb")
+	require.NoError(err)
+	require.False(referenced)
+
+	// media references the blob and its thumbnail
+
+	image := &models.FilmMediaURL{
+		FilmID:       movie.ID,
+		MediaURL:     "image",
+		MediaType:    "image",
+		MimeType:     "image/png",
+		SizeInBytes:  100,
+		ThumbnailURL: null.StringFrom("image_thumb"),
+	}
+	err = r.FilmMediaCreate(ctx, user.ID, image)
+	require.NoError(err)
+
+	for _, key := range []string{"image", "image_thumb"} {
+		referenced, err = r.MediaBlobReferenced(ctx, key)
+		require.NoError(err)
+		require.True(referenced)
+	}
+
+	// invalidating the media keeps the blob referenced
+
+	err = r.FilmMediaInvalidate(ctx, movie.ID, image.ID, user.ID, "invalid")
+	require.NoError(err)
+
+	referenced, err = r.MediaBlobReferenced(ctx, "image")
+	require.NoError(err)
+	require.True(referenced)
+
+	err = r.FilmMediaInvalidate(ctx, movie.ID, image.ID+1, user.ID, "invalid")
+	require.Equal(repo.ErrNoRecord, err)
+}
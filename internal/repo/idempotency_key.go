@@ -0,0 +1,136 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+func (repo *Repository) IdempotencyKeyGet(
+	ctx context.Context,
+	userID int,
+	key string,
+) (*models.IdempotencyKey, error) {
+	idempotencyKey, err := models.IdempotencyKeys(
+		models.IdempotencyKeyWhere.UserID.EQ(userID),
+		models.IdempotencyKeyWhere.Key.EQ(key),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return idempotencyKey, nil
+}
+
+// IdempotencyKeyReserve records the key of a user for the request hashed to
+// requestHash, with its response pending, and reports whether it did. A key
+// already there is only reserved anew if it was created before expiredBefore,
+// or if it's still in flight for the same request since before
+// leaseExpiredBefore, its request being taken to have died.
+func (repo *Repository) IdempotencyKeyReserve(
+	ctx context.Context,
+	userID int,
+	key string,
+	requestHash string,
+	expiredBefore time.Time,
+	leaseExpiredBefore time.Time,
+) (reserved bool, err error) {
+	result, err := queries.Raw(
+		fmt.Sprintf(
+			"INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s) VALUES ($1, $2, $3) "+
+				"ON CONFLICT (%[2]s, %[3]s) DO UPDATE SET "+
+				"%[4]s = EXCLUDED.%[4]s, %[5]s = NULL, %[6]s = NULL, "+
+				"%[7]s = CURRENT_TIMESTAMP, %[8]s = CURRENT_TIMESTAMP "+
+				"WHERE %[1]s.%[7]s < $4 OR ("+
+				"%[1]s.%[5]s IS NULL AND %[1]s.%[8]s < $5 AND "+
+				"%[1]s.%[4]s = EXCLUDED.%[4]s)",
+			models.TableNames.IdempotencyKeys,
+			models.IdempotencyKeyColumns.UserID,
+			models.IdempotencyKeyColumns.Key,
+			models.IdempotencyKeyColumns.RequestHash,
+			models.IdempotencyKeyColumns.ResponseStatus,
+			models.IdempotencyKeyColumns.ResponseBody,
+			models.IdempotencyKeyColumns.CreatedAt,
+			models.IdempotencyKeyColumns.ReservedAt,
+		),
+		userID,
+		key,
+		requestHash,
+		expiredBefore,
+		leaseExpiredBefore,
+	).ExecContext(ctx, repo.exec)
+	if err != nil {
+		return false, err
+	}
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAff > 0, nil
+}
+
+// IdempotencyKeyComplete stores the response to the request the key of a
+// user is reserved for.
+// ErrNoRecord is returned if there's no such key.
+func (repo *Repository) IdempotencyKeyComplete(
+	ctx context.Context,
+	userID int,
+	key string,
+	responseStatus int,
+	responseBody string,
+) error {
+	rowsAff, err := models.IdempotencyKeys(
+		models.IdempotencyKeyWhere.UserID.EQ(userID),
+		models.IdempotencyKeyWhere.Key.EQ(key),
+	).UpdateAll(ctx, repo.exec, models.M{
+		models.IdempotencyKeyColumns.ResponseStatus: null.IntFrom(responseStatus),
+		models.IdempotencyKeyColumns.ResponseBody:   null.StringFrom(responseBody),
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// IdempotencyKeyDelete releases the key of a user so that it could be
+// reserved again.
+// ErrNoRecord is returned if there's no such key.
+func (repo *Repository) IdempotencyKeyDelete(
+	ctx context.Context,
+	userID int,
+	key string,
+) error {
+	rowsAff, err := models.IdempotencyKeys(
+		models.IdempotencyKeyWhere.UserID.EQ(userID),
+		models.IdempotencyKeyWhere.Key.EQ(key),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// IdempotencyKeysPrune deletes the keys created before expiredBefore and
+// returns how many were.
+func (repo *Repository) IdempotencyKeysPrune(
+	ctx context.Context,
+	expiredBefore time.Time,
+) (int, error) {
+	rowsAff, err := models.IdempotencyKeys(
+		models.IdempotencyKeyWhere.CreatedAt.LT(expiredBefore),
+	).DeleteAll(ctx, repo.exec)
+	return int(rowsAff), err
+}
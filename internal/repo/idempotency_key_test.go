@@ -0,0 +1,176 @@
+package repo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	expiredBefore := time.Now().Add(-time.Hour)
+	leaseExpiredBefore := time.Now().Add(-time.Minute)
+
+	// no key
+
+	_, err = r.IdempotencyKeyGet(ctx, user.ID, "key")
+	require.Equal(repo.ErrNoRecord, err)
+
+	err = r.IdempotencyKeyComplete(ctx, user.ID, "key", http.StatusOK, "{}")
+	require.Equal(repo.ErrNoRecord, err)
+
+	err = r.IdempotencyKeyDelete(ctx, user.ID, "key")
+	require.Equal(repo.ErrNoRecord, err)
+
+	// a key is reserved once
+
+	reserved, err := r.IdempotencyKeyReserve(
+		ctx,
+		user.ID,
+		"key",
+		"hash",
+		expiredBefore,
+		leaseExpiredBefore,
+	)
+	require.NoError(err)
+	require.True(reserved)
+
+	reserved, err = r.IdempotencyKeyReserve(
+		ctx,
+		user.ID,
+		"key",
+		"other hash",
+		expiredBefore,
+		leaseExpiredBefore,
+	)
+	require.NoError(err)
+	require.False(reserved)
+
+	idempotencyKey, err := r.IdempotencyKeyGet(ctx, user.ID, "key")
+	require.NoError(err)
+	require.Equal("hash", idempotencyKey.RequestHash)
+	require.False(idempotencyKey.ResponseStatus.Valid)
+
+	// a key in flight past its lease is taken over by a retry of its request
+	// only
+
+	reserved, err = r.IdempotencyKeyReserve(
+		ctx,
+		user.ID,
+		"key",
+		"other hash",
+		expiredBefore,
+		time.Now().Add(time.Hour),
+	)
+	require.NoError(err)
+	require.False(reserved)
+
+	reserved, err = r.IdempotencyKeyReserve(
+		ctx,
+		user.ID,
+		"key",
+		"hash",
+		expiredBefore,
+		time.Now().Add(time.Hour),
+	)
+	require.NoError(err)
+	require.True(reserved)
+
+	takenOver, err := r.IdempotencyKeyGet(ctx, user.ID, "key")
+	require.NoError(err)
+	require.True(takenOver.ReservedAt.After(idempotencyKey.ReservedAt))
+
+	// store the response
+
+	err = r.IdempotencyKeyComplete(
+		ctx,
+		user.ID,
+		"key",
+		http.StatusOK,
+		`{"status":"OK"}`,
+	)
+	require.NoError(err)
+
+	idempotencyKey, err = r.IdempotencyKeyGet(ctx, user.ID, "key")
+	require.NoError(err)
+	require.Equal(http.StatusOK, idempotencyKey.ResponseStatus.Int)
+	require.Equal(`{"status":"OK"}`, idempotencyKey.ResponseBody.String)
+
+	// an answered key is never taken over
+
+	reserved, err = r.IdempotencyKeyReserve(
+		ctx,
+		user.ID,
+		"key",
+		"hash",
+		expiredBefore,
+		time.Now().Add(time.Hour),
+	)
+	require.NoError(err)
+	require.False(reserved)
+
+	// an expired key is reserved anew, its response cleared
+
+	reserved, err = r.IdempotencyKeyReserve(
+		ctx,
+		user.ID,
+		"key",
+		"other hash",
+		time.Now().Add(time.Hour),
+		leaseExpiredBefore,
+	)
+	require.NoError(err)
+	require.True(reserved)
+
+	idempotencyKey, err = r.IdempotencyKeyGet(ctx, user.ID, "key")
+	require.NoError(err)
+	require.Equal("other hash", idempotencyKey.RequestHash)
+	require.False(idempotencyKey.ResponseStatus.Valid)
+	require.False(idempotencyKey.ResponseBody.Valid)
+
+	// a released key is reserved anew
+
+	err = r.IdempotencyKeyDelete(ctx, user.ID, "key")
+	require.NoError(err)
+
+	reserved, err = r.IdempotencyKeyReserve(
+		ctx,
+		user.ID,
+		"key",
+		"hash",
+		expiredBefore,
+		leaseExpiredBefore,
+	)
+	require.NoError(err)
+	require.True(reserved)
+
+	// prune the expired keys
+
+	pruned, err := r.IdempotencyKeysPrune(ctx, expiredBefore)
+	require.NoError(err)
+	require.Equal(0, pruned)
+
+	pruned, err = r.IdempotencyKeysPrune(ctx, time.Now().Add(time.Hour))
+	require.NoError(err)
+	require.Equal(1, pruned)
+
+	_, err = r.IdempotencyKeyGet(ctx, user.ID, "key")
+	require.Equal(repo.ErrNoRecord, err)
+}
@@ -0,0 +1,170 @@
+package repo
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// KeysetPage selects a page of a list ordered by a set of columns making the
+// order unique, without the cost of skipping the rows before it.
+type KeysetPage struct {
+	// After holds the values of the ordering columns of the row the page is
+	// next to, nil for the first page of the list.
+	After []any
+	// Backward pages toward the head of the list, the page ending right
+	// before After. The rows are still returned in list order.
+	Backward bool
+	Limit    int
+}
+
+// keyset is a set of columns ordering a list in a single direction, or each
+// in its own direction when descs is set
+type keyset struct {
+	columns []string
+	desc    bool
+	descs   []bool
+}
+
+// sortedKeyset is the keyset of a list ordered by sort and then by the
+// unique tiebreaker column, the way ListSort.mods orders it.
+func sortedKeyset(sort ListSort, tiebreaker string) keyset {
+	k := keyset{columns: sort.Columns(tiebreaker)}
+	for _, column := range k.columns {
+		desc := false
+		for _, key := range sort {
+			if key.Column == column {
+				desc = key.Desc
+			}
+		}
+		k.descs = append(k.descs, desc)
+	}
+	return k
+}
+
+// mods returns the query mods selecting page of the list ordered by k
+func (k keyset) mods(page *KeysetPage) []qm.QueryMod {
+	if page.After != nil && len(page.After) != len(k.columns) {
+		panic(fmt.Sprintf(
+			"repo.keyset: %d values given for %d columns %v",
+			len(page.After),
+			len(k.columns),
+			k.columns,
+		))
+	}
+	if k.descs != nil {
+		return k.sortedMods(page)
+	}
+
+	// backward pages run the order in reverse
+	desc := k.desc != page.Backward
+	op, direction := ">", "ASC"
+	if desc {
+		op, direction = "<", "DESC"
+	}
+
+	mods := make([]qm.QueryMod, 0, len(k.columns)+2)
+	if page.After != nil {
+		mods = append(mods, qm.Where(
+			fmt.Sprintf(
+				"(%s) %s (%s)",
+				strings.Join(k.columns, ", "),
+				op,
+				strings.TrimSuffix(strings.Repeat("?, ", len(k.columns)), ", "),
+			),
+			page.After...,
+		))
+	}
+	for _, column := range k.columns {
+		mods = append(mods, qm.OrderBy(column+" "+direction))
+	}
+	mods = append(mods, qm.Limit(page.Limit))
+	return mods
+}
+
+// sortedMods is mods for the keysets ordering each column its own way, with
+// missing values last. The rows can't be compared as a whole then, so a row
+// is past After when the columns before one equal its values and the column
+// itself is past its value.
+func (k keyset) sortedMods(page *KeysetPage) []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(k.columns)+2)
+	if page.After != nil {
+		var (
+			past, equal         []string
+			pastArgs, equalArgs []any
+		)
+		for i, column := range k.columns {
+			value := page.After[i]
+			// forward pages run past the value and backward ones before it
+			op := "<"
+			if k.descs[i] == page.Backward {
+				op = ">"
+			}
+			var cond string
+			var condArgs []any
+			switch {
+			case !isNull(value) && !page.Backward:
+				cond = fmt.Sprintf("(%s %s ? OR %s IS NULL)", column, op, column)
+				condArgs = []any{value}
+			case !isNull(value):
+				cond = fmt.Sprintf("%s %s ?", column, op)
+				condArgs = []any{value}
+			case page.Backward:
+				cond = column + " IS NOT NULL"
+			}
+			// nothing is past a missing value
+			if cond != "" {
+				conds := append(append([]string{}, equal...), cond)
+				past = append(past, "("+strings.Join(conds, " AND ")+")")
+				pastArgs = append(append(pastArgs, equalArgs...), condArgs...)
+			}
+			if isNull(value) {
+				equal = append(equal, column+" IS NULL")
+			} else {
+				equal = append(equal, column+" = ?")
+				equalArgs = append(equalArgs, value)
+			}
+		}
+		if len(past) == 0 {
+			past = []string{"FALSE"}
+		}
+		mods = append(mods, qm.Where(
+			"("+strings.Join(past, " OR ")+")",
+			pastArgs...,
+		))
+	}
+	for i, column := range k.columns {
+		// backward pages run the order in reverse, missing values first
+		direction, nulls := "ASC", "NULLS LAST"
+		if k.descs[i] != page.Backward {
+			direction = "DESC"
+		}
+		if page.Backward {
+			nulls = "NULLS FIRST"
+		}
+		mods = append(mods, qm.OrderBy(column+" "+direction+" "+nulls))
+	}
+	mods = append(mods, qm.Limit(page.Limit))
+	return mods
+}
+
+// isNull tells whether a keyset value is a missing one
+func isNull(value any) bool {
+	if valuer, ok := value.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		return err == nil && v == nil
+	}
+	return value == nil
+}
+
+// keysetRows puts the rows of a backward page back in list order
+func keysetRows[T any](rows []T, page *KeysetPage) []T {
+	if page.Backward {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+	return rows
+}
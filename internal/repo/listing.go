@@ -0,0 +1,93 @@
+package repo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// FilterOperator compares a column to the value of a filter condition
+type FilterOperator string
+
+const (
+	FilterEQ  FilterOperator = "eq"
+	FilterGTE FilterOperator = "gte"
+	FilterLTE FilterOperator = "lte"
+	// FilterContains matches the text columns containing the value, case
+	// insensitively
+	FilterContains FilterOperator = "contains"
+)
+
+type FilterCondition struct {
+	Column   string
+	Operator FilterOperator
+	Value    any
+}
+
+// ListFilter restricts a list to the rows meeting all of its conditions. The
+// columns are trusted to be ones of the listed table.
+type ListFilter []FilterCondition
+
+func (f ListFilter) mods() []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(f))
+	for _, c := range f {
+		switch c.Operator {
+		case FilterEQ:
+			mods = append(mods, qm.Where(c.Column+" = ?", c.Value))
+		case FilterGTE:
+			mods = append(mods, qm.Where(c.Column+" >= ?", c.Value))
+		case FilterLTE:
+			mods = append(mods, qm.Where(c.Column+" <= ?", c.Value))
+		case FilterContains:
+			mods = append(mods, qm.Where(
+				c.Column+" ILIKE ?",
+				"%"+likeEscaper.Replace(fmt.Sprint(c.Value))+"%",
+			))
+		default:
+			panic(fmt.Sprintf("repo.ListFilter: unknown operator %q", c.Operator))
+		}
+	}
+	return mods
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+type SortKey struct {
+	Column string
+	Desc   bool
+}
+
+// ListSort orders a list by its keys in turn. The columns are trusted to be
+// ones of the listed table.
+type ListSort []SortKey
+
+// Columns returns the columns the list is ordered by, the sort keys up to
+// the unique column tiebreaker and then the tiebreaker.
+func (s ListSort) Columns(tiebreaker string) []string {
+	columns := make([]string, 0, len(s)+1)
+	for _, key := range s {
+		columns = append(columns, key.Column)
+		if key.Column == tiebreaker {
+			return columns
+		}
+	}
+	return append(columns, tiebreaker)
+}
+
+// mods orders by the sort keys and then by the unique column tiebreaker so
+// the pages of the list keep still. Missing values come last either way.
+func (s ListSort) mods(tiebreaker string) []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(s)+1)
+	for _, key := range s {
+		direction := "ASC"
+		if key.Desc {
+			direction = "DESC"
+		}
+		mods = append(mods, qm.OrderBy(key.Column+" "+direction+" NULLS LAST"))
+		if key.Column == tiebreaker {
+			return mods
+		}
+	}
+	return append(mods, qm.OrderBy(tiebreaker))
+}
@@ -7,6 +7,7 @@ package mock_repo
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/aria3ppp/watch-server/internal/models"
 	repo "github.com/aria3ppp/watch-server/internal/repo"
@@ -36,6 +37,198 @@ func (m *MockRepositoryTx) EXPECT() *MockRepositoryTxMockRecorder {
 	return m.recorder
 }
 
+// ArtistCreate mocks base method.
+func (m *MockRepositoryTx) ArtistCreate(arg0 context.Context, arg1 int, arg2 *models.Artist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArtistCreate indicates an expected call of ArtistCreate.
+func (mr *MockRepositoryTxMockRecorder) ArtistCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistCreate", reflect.TypeOf((*MockRepositoryTx)(nil).ArtistCreate), arg0, arg1, arg2)
+}
+
+// ArtistGet mocks base method.
+func (m *MockRepositoryTx) ArtistGet(arg0 context.Context, arg1 int) (*models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtistGet indicates an expected call of ArtistGet.
+func (mr *MockRepositoryTxMockRecorder) ArtistGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistGet", reflect.TypeOf((*MockRepositoryTx)(nil).ArtistGet), arg0, arg1)
+}
+
+// ArtistImageCreate mocks base method.
+func (m *MockRepositoryTx) ArtistImageCreate(arg0 context.Context, arg1 int, arg2 *models.ArtistImageURL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistImageCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArtistImageCreate indicates an expected call of ArtistImageCreate.
+func (mr *MockRepositoryTxMockRecorder) ArtistImageCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistImageCreate", reflect.TypeOf((*MockRepositoryTx)(nil).ArtistImageCreate), arg0, arg1, arg2)
+}
+
+// ArtistImageGet mocks base method.
+func (m *MockRepositoryTx) ArtistImageGet(arg0 context.Context, arg1, arg2 int) (*models.ArtistImageURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistImageGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.ArtistImageURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtistImageGet indicates an expected call of ArtistImageGet.
+func (mr *MockRepositoryTxMockRecorder) ArtistImageGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistImageGet", reflect.TypeOf((*MockRepositoryTx)(nil).ArtistImageGet), arg0, arg1, arg2)
+}
+
+// ArtistImageInvalidate mocks base method.
+func (m *MockRepositoryTx) ArtistImageInvalidate(arg0 context.Context, arg1, arg2, arg3 int, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistImageInvalidate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArtistImageInvalidate indicates an expected call of ArtistImageInvalidate.
+func (mr *MockRepositoryTxMockRecorder) ArtistImageInvalidate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistImageInvalidate", reflect.TypeOf((*MockRepositoryTx)(nil).ArtistImageInvalidate), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ArtistImagesCountByArtist mocks base method.
+func (m *MockRepositoryTx) ArtistImagesCountByArtist(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistImagesCountByArtist", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtistImagesCountByArtist indicates an expected call of ArtistImagesCountByArtist.
+func (mr *MockRepositoryTxMockRecorder) ArtistImagesCountByArtist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistImagesCountByArtist", reflect.TypeOf((*MockRepositoryTx)(nil).ArtistImagesCountByArtist), arg0, arg1)
+}
+
+// ArtistImagesGetAllByArtist mocks base method.
+func (m *MockRepositoryTx) ArtistImagesGetAllByArtist(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.ArtistImageURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistImagesGetAllByArtist", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.ArtistImageURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtistImagesGetAllByArtist indicates an expected call of ArtistImagesGetAllByArtist.
+func (mr *MockRepositoryTxMockRecorder) ArtistImagesGetAllByArtist(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistImagesGetAllByArtist", reflect.TypeOf((*MockRepositoryTx)(nil).ArtistImagesGetAllByArtist), arg0, arg1, arg2, arg3)
+}
+
+// AuditsPrune mocks base method.
+func (m *MockRepositoryTx) AuditsPrune(arg0 context.Context, arg1 repo.AuditRetention) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditsPrune", arg0, arg1)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditsPrune indicates an expected call of AuditsPrune.
+func (mr *MockRepositoryTxMockRecorder) AuditsPrune(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditsPrune", reflect.TypeOf((*MockRepositoryTx)(nil).AuditsPrune), arg0, arg1)
+}
+
+// ContributionsCount mocks base method.
+func (m *MockRepositoryTx) ContributionsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContributionsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContributionsCount indicates an expected call of ContributionsCount.
+func (mr *MockRepositoryTxMockRecorder) ContributionsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContributionsCount", reflect.TypeOf((*MockRepositoryTx)(nil).ContributionsCount), arg0, arg1)
+}
+
+// ContributionsGetAll mocks base method.
+func (m *MockRepositoryTx) ContributionsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*repo.Contribution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContributionsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*repo.Contribution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContributionsGetAll indicates an expected call of ContributionsGetAll.
+func (mr *MockRepositoryTxMockRecorder) ContributionsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContributionsGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).ContributionsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// ContributorsCount mocks base method.
+func (m *MockRepositoryTx) ContributorsCount(arg0 context.Context, arg1, arg2 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContributorsCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContributorsCount indicates an expected call of ContributorsCount.
+func (mr *MockRepositoryTxMockRecorder) ContributorsCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContributorsCount", reflect.TypeOf((*MockRepositoryTx)(nil).ContributorsCount), arg0, arg1, arg2)
+}
+
+// ContributorsGetAll mocks base method.
+func (m *MockRepositoryTx) ContributorsGetAll(arg0 context.Context, arg1, arg2 time.Time, arg3, arg4 int) ([]*repo.Contributor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContributorsGetAll", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*repo.Contributor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContributorsGetAll indicates an expected call of ContributorsGetAll.
+func (mr *MockRepositoryTxMockRecorder) ContributorsGetAll(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContributorsGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).ContributorsGetAll), arg0, arg1, arg2, arg3, arg4)
+}
+
+// EpisodeAuditGet mocks base method.
+func (m *MockRepositoryTx) EpisodeAuditGet(arg0 context.Context, arg1, arg2, arg3, arg4 int, arg5 time.Time) (*models.FilmsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodeAuditGet", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*models.FilmsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpisodeAuditGet indicates an expected call of EpisodeAuditGet.
+func (mr *MockRepositoryTxMockRecorder) EpisodeAuditGet(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeAuditGet", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodeAuditGet), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // EpisodeAuditsCount mocks base method.
 func (m *MockRepositoryTx) EpisodeAuditsCount(arg0 context.Context, arg1, arg2, arg3 int) (int, error) {
 	m.ctrl.T.Helper()
@@ -66,19 +259,39 @@ func (mr *MockRepositoryTxMockRecorder) EpisodeAuditsGetAll(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeAuditsGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodeAuditsGetAll), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// EpisodeAuditsGetPage mocks base method.
+func (m *MockRepositoryTx) EpisodeAuditsGetPage(arg0 context.Context, arg1, arg2, arg3 int, arg4 *repo.KeysetPage) ([]*models.FilmsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodeAuditsGetPage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*models.FilmsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpisodeAuditsGetPage indicates an expected call of EpisodeAuditsGetPage.
+func (mr *MockRepositoryTxMockRecorder) EpisodeAuditsGetPage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeAuditsGetPage", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodeAuditsGetPage), arg0, arg1, arg2, arg3, arg4)
+}
+
 // EpisodeGet mocks base method.
-func (m *MockRepositoryTx) EpisodeGet(arg0 context.Context, arg1, arg2, arg3 int) (*models.Film, error) {
+func (m *MockRepositoryTx) EpisodeGet(arg0 context.Context, arg1, arg2, arg3 int, arg4 bool, arg5 ...string) (*models.Film, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodeGet", arg0, arg1, arg2, arg3)
+	varargs := []interface{}{arg0, arg1, arg2, arg3, arg4}
+	for _, a := range arg5 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EpisodeGet", varargs...)
 	ret0, _ := ret[0].(*models.Film)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EpisodeGet indicates an expected call of EpisodeGet.
-func (mr *MockRepositoryTxMockRecorder) EpisodeGet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockRepositoryTxMockRecorder) EpisodeGet(arg0, arg1, arg2, arg3, arg4 interface{}, arg5 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeGet", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodeGet), arg0, arg1, arg2, arg3)
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4}, arg5...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeGet", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodeGet), varargs...)
 }
 
 // EpisodeInvalidate mocks base method.
@@ -109,6 +322,20 @@ func (mr *MockRepositoryTxMockRecorder) EpisodePut(arg0, arg1, arg2, arg3, arg4,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodePut", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodePut), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// EpisodeRestore mocks base method.
+func (m *MockRepositoryTx) EpisodeRestore(arg0 context.Context, arg1, arg2, arg3, arg4 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodeRestore", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EpisodeRestore indicates an expected call of EpisodeRestore.
+func (mr *MockRepositoryTxMockRecorder) EpisodeRestore(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeRestore", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodeRestore), arg0, arg1, arg2, arg3, arg4)
+}
+
 // EpisodeUpdate mocks base method.
 func (m *MockRepositoryTx) EpisodeUpdate(arg0 context.Context, arg1, arg2, arg3, arg4 int, arg5 map[string]interface{}) error {
 	m.ctrl.T.Helper()
@@ -184,63 +411,78 @@ func (mr *MockRepositoryTxMockRecorder) EpisodesAuditsGetAllBySeries(arg0, arg1,
 }
 
 // EpisodesCountBySeason mocks base method.
-func (m *MockRepositoryTx) EpisodesCountBySeason(arg0 context.Context, arg1, arg2 int) (int, error) {
+func (m *MockRepositoryTx) EpisodesCountBySeason(arg0 context.Context, arg1, arg2 int, arg3 bool) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodesCountBySeason", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "EpisodesCountBySeason", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EpisodesCountBySeason indicates an expected call of EpisodesCountBySeason.
-func (mr *MockRepositoryTxMockRecorder) EpisodesCountBySeason(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockRepositoryTxMockRecorder) EpisodesCountBySeason(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesCountBySeason", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodesCountBySeason), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesCountBySeason", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodesCountBySeason), arg0, arg1, arg2, arg3)
 }
 
 // EpisodesCountBySeries mocks base method.
-func (m *MockRepositoryTx) EpisodesCountBySeries(arg0 context.Context, arg1 int) (int, error) {
+func (m *MockRepositoryTx) EpisodesCountBySeries(arg0 context.Context, arg1 int, arg2 bool) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodesCountBySeries", arg0, arg1)
+	ret := m.ctrl.Call(m, "EpisodesCountBySeries", arg0, arg1, arg2)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EpisodesCountBySeries indicates an expected call of EpisodesCountBySeries.
-func (mr *MockRepositoryTxMockRecorder) EpisodesCountBySeries(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockRepositoryTxMockRecorder) EpisodesCountBySeries(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesCountBySeries", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodesCountBySeries), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesCountBySeries", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodesCountBySeries), arg0, arg1, arg2)
 }
 
 // EpisodesGetAllBySeason mocks base method.
-func (m *MockRepositoryTx) EpisodesGetAllBySeason(arg0 context.Context, arg1, arg2, arg3, arg4 int) ([]*models.Film, error) {
+func (m *MockRepositoryTx) EpisodesGetAllBySeason(arg0 context.Context, arg1, arg2, arg3, arg4 int, arg5 bool) ([]*models.Film, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodesGetAllBySeason", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "EpisodesGetAllBySeason", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].([]*models.Film)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EpisodesGetAllBySeason indicates an expected call of EpisodesGetAllBySeason.
-func (mr *MockRepositoryTxMockRecorder) EpisodesGetAllBySeason(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+func (mr *MockRepositoryTxMockRecorder) EpisodesGetAllBySeason(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesGetAllBySeason", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodesGetAllBySeason), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesGetAllBySeason", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodesGetAllBySeason), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
 // EpisodesGetAllBySeries mocks base method.
-func (m *MockRepositoryTx) EpisodesGetAllBySeries(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.Film, error) {
+func (m *MockRepositoryTx) EpisodesGetAllBySeries(arg0 context.Context, arg1, arg2, arg3 int, arg4 bool) ([]*models.Film, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodesGetAllBySeries", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "EpisodesGetAllBySeries", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].([]*models.Film)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EpisodesGetAllBySeries indicates an expected call of EpisodesGetAllBySeries.
-func (mr *MockRepositoryTxMockRecorder) EpisodesGetAllBySeries(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockRepositoryTxMockRecorder) EpisodesGetAllBySeries(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesGetAllBySeries", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodesGetAllBySeries), arg0, arg1, arg2, arg3, arg4)
+}
+
+// EpisodesGetPageBySeries mocks base method.
+func (m *MockRepositoryTx) EpisodesGetPageBySeries(arg0 context.Context, arg1 int, arg2 *repo.KeysetPage, arg3 bool) ([]*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodesGetPageBySeries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpisodesGetPageBySeries indicates an expected call of EpisodesGetPageBySeries.
+func (mr *MockRepositoryTxMockRecorder) EpisodesGetPageBySeries(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesGetAllBySeries", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodesGetAllBySeries), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesGetPageBySeries", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodesGetPageBySeries), arg0, arg1, arg2, arg3)
 }
 
 // EpisodesInvalidateAllBySeason mocks base method.
@@ -271,247 +513,1208 @@ func (mr *MockRepositoryTxMockRecorder) EpisodesInvalidateAllBySeries(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesInvalidateAllBySeries", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodesInvalidateAllBySeries), arg0, arg1, arg2, arg3)
 }
 
-// MovieAuditsCount mocks base method.
-func (m *MockRepositoryTx) MovieAuditsCount(arg0 context.Context, arg1 int) (int, error) {
+// EpisodesRestoreAllBySeason mocks base method.
+func (m *MockRepositoryTx) EpisodesRestoreAllBySeason(arg0 context.Context, arg1, arg2, arg3 int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieAuditsCount", arg0, arg1)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "EpisodesRestoreAllBySeason", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MovieAuditsCount indicates an expected call of MovieAuditsCount.
-func (mr *MockRepositoryTxMockRecorder) MovieAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+// EpisodesRestoreAllBySeason indicates an expected call of EpisodesRestoreAllBySeason.
+func (mr *MockRepositoryTxMockRecorder) EpisodesRestoreAllBySeason(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditsCount", reflect.TypeOf((*MockRepositoryTx)(nil).MovieAuditsCount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesRestoreAllBySeason", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodesRestoreAllBySeason), arg0, arg1, arg2, arg3)
 }
 
-// MovieAuditsGetAll mocks base method.
-func (m *MockRepositoryTx) MovieAuditsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.FilmsAudit, error) {
+// EpisodesRestoreAllBySeries mocks base method.
+func (m *MockRepositoryTx) EpisodesRestoreAllBySeries(arg0 context.Context, arg1, arg2 int, arg3 time.Time, arg4 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieAuditsGetAll", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].([]*models.FilmsAudit)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "EpisodesRestoreAllBySeries", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MovieAuditsGetAll indicates an expected call of MovieAuditsGetAll.
-func (mr *MockRepositoryTxMockRecorder) MovieAuditsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// EpisodesRestoreAllBySeries indicates an expected call of EpisodesRestoreAllBySeries.
+func (mr *MockRepositoryTxMockRecorder) EpisodesRestoreAllBySeries(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditsGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).MovieAuditsGetAll), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesRestoreAllBySeries", reflect.TypeOf((*MockRepositoryTx)(nil).EpisodesRestoreAllBySeries), arg0, arg1, arg2, arg3, arg4)
 }
 
-// MovieCreate mocks base method.
-func (m *MockRepositoryTx) MovieCreate(arg0 context.Context, arg1 int, arg2 *models.Film) error {
+// FeedGetAll mocks base method.
+func (m *MockRepositoryTx) FeedGetAll(arg0 context.Context, arg1 int, arg2 *repo.FeedPosition, arg3 int) ([]*repo.FeedActivity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieCreate", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "FeedGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*repo.FeedActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MovieCreate indicates an expected call of MovieCreate.
-func (mr *MockRepositoryTxMockRecorder) MovieCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+// FeedGetAll indicates an expected call of FeedGetAll.
+func (mr *MockRepositoryTxMockRecorder) FeedGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieCreate", reflect.TypeOf((*MockRepositoryTx)(nil).MovieCreate), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeedGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).FeedGetAll), arg0, arg1, arg2, arg3)
 }
 
-// MovieGet mocks base method.
-func (m *MockRepositoryTx) MovieGet(arg0 context.Context, arg1 int) (*models.Film, error) {
+// FilmGet mocks base method.
+func (m *MockRepositoryTx) FilmGet(arg0 context.Context, arg1 int) (*models.Film, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieGet", arg0, arg1)
+	ret := m.ctrl.Call(m, "FilmGet", arg0, arg1)
 	ret0, _ := ret[0].(*models.Film)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MovieGet indicates an expected call of MovieGet.
-func (mr *MockRepositoryTxMockRecorder) MovieGet(arg0, arg1 interface{}) *gomock.Call {
+// FilmGet indicates an expected call of FilmGet.
+func (mr *MockRepositoryTxMockRecorder) FilmGet(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieGet", reflect.TypeOf((*MockRepositoryTx)(nil).MovieGet), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmGet", reflect.TypeOf((*MockRepositoryTx)(nil).FilmGet), arg0, arg1)
 }
 
-// MovieInvalidate mocks base method.
-func (m *MockRepositoryTx) MovieInvalidate(arg0 context.Context, arg1, arg2 int, arg3 string) error {
+// FilmMediaCountByFilm mocks base method.
+func (m *MockRepositoryTx) FilmMediaCountByFilm(arg0 context.Context, arg1 int) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieInvalidate", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "FilmMediaCountByFilm", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MovieInvalidate indicates an expected call of MovieInvalidate.
-func (mr *MockRepositoryTxMockRecorder) MovieInvalidate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// FilmMediaCountByFilm indicates an expected call of FilmMediaCountByFilm.
+func (mr *MockRepositoryTxMockRecorder) FilmMediaCountByFilm(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieInvalidate", reflect.TypeOf((*MockRepositoryTx)(nil).MovieInvalidate), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmMediaCountByFilm", reflect.TypeOf((*MockRepositoryTx)(nil).FilmMediaCountByFilm), arg0, arg1)
 }
 
-// MovieUpdate mocks base method.
-func (m *MockRepositoryTx) MovieUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+// FilmMediaCreate mocks base method.
+func (m *MockRepositoryTx) FilmMediaCreate(arg0 context.Context, arg1 int, arg2 *models.FilmMediaURL) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieUpdate", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "FilmMediaCreate", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MovieUpdate indicates an expected call of MovieUpdate.
-func (mr *MockRepositoryTxMockRecorder) MovieUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// FilmMediaCreate indicates an expected call of FilmMediaCreate.
+func (mr *MockRepositoryTxMockRecorder) FilmMediaCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieUpdate", reflect.TypeOf((*MockRepositoryTx)(nil).MovieUpdate), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmMediaCreate", reflect.TypeOf((*MockRepositoryTx)(nil).FilmMediaCreate), arg0, arg1, arg2)
 }
 
-// MoviesCount mocks base method.
-func (m *MockRepositoryTx) MoviesCount(arg0 context.Context) (int, error) {
+// FilmMediaGet mocks base method.
+func (m *MockRepositoryTx) FilmMediaGet(arg0 context.Context, arg1, arg2 int) (*models.FilmMediaURL, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MoviesCount", arg0)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "FilmMediaGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.FilmMediaURL)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MoviesCount indicates an expected call of MoviesCount.
-func (mr *MockRepositoryTxMockRecorder) MoviesCount(arg0 interface{}) *gomock.Call {
+// FilmMediaGet indicates an expected call of FilmMediaGet.
+func (mr *MockRepositoryTxMockRecorder) FilmMediaGet(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoviesCount", reflect.TypeOf((*MockRepositoryTx)(nil).MoviesCount), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmMediaGet", reflect.TypeOf((*MockRepositoryTx)(nil).FilmMediaGet), arg0, arg1, arg2)
 }
 
-// MoviesGetAll mocks base method.
-func (m *MockRepositoryTx) MoviesGetAll(arg0 context.Context, arg1, arg2 int) ([]*models.Film, error) {
+// FilmMediaGetAllByFilm mocks base method.
+func (m *MockRepositoryTx) FilmMediaGetAllByFilm(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.FilmMediaURL, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MoviesGetAll", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]*models.Film)
+	ret := m.ctrl.Call(m, "FilmMediaGetAllByFilm", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.FilmMediaURL)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MoviesGetAll indicates an expected call of MoviesGetAll.
-func (mr *MockRepositoryTxMockRecorder) MoviesGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+// FilmMediaGetAllByFilm indicates an expected call of FilmMediaGetAllByFilm.
+func (mr *MockRepositoryTxMockRecorder) FilmMediaGetAllByFilm(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoviesGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).MoviesGetAll), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmMediaGetAllByFilm", reflect.TypeOf((*MockRepositoryTx)(nil).FilmMediaGetAllByFilm), arg0, arg1, arg2, arg3)
 }
 
-// SeriesAuditsCount mocks base method.
-func (m *MockRepositoryTx) SeriesAuditsCount(arg0 context.Context, arg1 int) (int, error) {
+// FilmMediaInvalidate mocks base method.
+func (m *MockRepositoryTx) FilmMediaInvalidate(arg0 context.Context, arg1, arg2, arg3 int, arg4 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesAuditsCount", arg0, arg1)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "FilmMediaInvalidate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// SeriesAuditsCount indicates an expected call of SeriesAuditsCount.
-func (mr *MockRepositoryTxMockRecorder) SeriesAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+// FilmMediaInvalidate indicates an expected call of FilmMediaInvalidate.
+func (mr *MockRepositoryTxMockRecorder) FilmMediaInvalidate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsCount", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesAuditsCount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmMediaInvalidate", reflect.TypeOf((*MockRepositoryTx)(nil).FilmMediaInvalidate), arg0, arg1, arg2, arg3, arg4)
 }
 
-// SeriesAuditsGetAll mocks base method.
-func (m *MockRepositoryTx) SeriesAuditsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.SeriesesAudit, error) {
+// IdempotencyKeyComplete mocks base method.
+func (m *MockRepositoryTx) IdempotencyKeyComplete(arg0 context.Context, arg1 int, arg2 string, arg3 int, arg4 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesAuditsGetAll", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].([]*models.SeriesesAudit)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "IdempotencyKeyComplete", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// SeriesAuditsGetAll indicates an expected call of SeriesAuditsGetAll.
-func (mr *MockRepositoryTxMockRecorder) SeriesAuditsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// IdempotencyKeyComplete indicates an expected call of IdempotencyKeyComplete.
+func (mr *MockRepositoryTxMockRecorder) IdempotencyKeyComplete(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesAuditsGetAll), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdempotencyKeyComplete", reflect.TypeOf((*MockRepositoryTx)(nil).IdempotencyKeyComplete), arg0, arg1, arg2, arg3, arg4)
 }
 
-// SeriesCreate mocks base method.
-func (m *MockRepositoryTx) SeriesCreate(arg0 context.Context, arg1 int, arg2 *models.Series) error {
+// IdempotencyKeyDelete mocks base method.
+func (m *MockRepositoryTx) IdempotencyKeyDelete(arg0 context.Context, arg1 int, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesCreate", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "IdempotencyKeyDelete", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SeriesCreate indicates an expected call of SeriesCreate.
-func (mr *MockRepositoryTxMockRecorder) SeriesCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+// IdempotencyKeyDelete indicates an expected call of IdempotencyKeyDelete.
+func (mr *MockRepositoryTxMockRecorder) IdempotencyKeyDelete(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesCreate", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesCreate), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdempotencyKeyDelete", reflect.TypeOf((*MockRepositoryTx)(nil).IdempotencyKeyDelete), arg0, arg1, arg2)
 }
 
-// SeriesGet mocks base method.
-func (m *MockRepositoryTx) SeriesGet(arg0 context.Context, arg1 int) (*models.Series, error) {
+// IdempotencyKeyGet mocks base method.
+func (m *MockRepositoryTx) IdempotencyKeyGet(arg0 context.Context, arg1 int, arg2 string) (*models.IdempotencyKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesGet", arg0, arg1)
-	ret0, _ := ret[0].(*models.Series)
+	ret := m.ctrl.Call(m, "IdempotencyKeyGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.IdempotencyKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SeriesGet indicates an expected call of SeriesGet.
-func (mr *MockRepositoryTxMockRecorder) SeriesGet(arg0, arg1 interface{}) *gomock.Call {
+// IdempotencyKeyGet indicates an expected call of IdempotencyKeyGet.
+func (mr *MockRepositoryTxMockRecorder) IdempotencyKeyGet(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesGet", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesGet), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdempotencyKeyGet", reflect.TypeOf((*MockRepositoryTx)(nil).IdempotencyKeyGet), arg0, arg1, arg2)
 }
 
-// SeriesInvalidate mocks base method.
-func (m *MockRepositoryTx) SeriesInvalidate(arg0 context.Context, arg1, arg2 int, arg3 string) error {
+// IdempotencyKeyReserve mocks base method.
+func (m *MockRepositoryTx) IdempotencyKeyReserve(arg0 context.Context, arg1 int, arg2, arg3 string, arg4, arg5 time.Time) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesInvalidate", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "IdempotencyKeyReserve", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SeriesInvalidate indicates an expected call of SeriesInvalidate.
-func (mr *MockRepositoryTxMockRecorder) SeriesInvalidate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// IdempotencyKeyReserve indicates an expected call of IdempotencyKeyReserve.
+func (mr *MockRepositoryTxMockRecorder) IdempotencyKeyReserve(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesInvalidate", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesInvalidate), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdempotencyKeyReserve", reflect.TypeOf((*MockRepositoryTx)(nil).IdempotencyKeyReserve), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
-// SeriesUpdate mocks base method.
-func (m *MockRepositoryTx) SeriesUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+// IdempotencyKeysPrune mocks base method.
+func (m *MockRepositoryTx) IdempotencyKeysPrune(arg0 context.Context, arg1 time.Time) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesUpdate", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "IdempotencyKeysPrune", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SeriesUpdate indicates an expected call of SeriesUpdate.
-func (mr *MockRepositoryTxMockRecorder) SeriesUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// IdempotencyKeysPrune indicates an expected call of IdempotencyKeysPrune.
+func (mr *MockRepositoryTxMockRecorder) IdempotencyKeysPrune(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesUpdate", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesUpdate), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdempotencyKeysPrune", reflect.TypeOf((*MockRepositoryTx)(nil).IdempotencyKeysPrune), arg0, arg1)
 }
 
-// SeriesesCount mocks base method.
-func (m *MockRepositoryTx) SeriesesCount(arg0 context.Context) (int, error) {
+// MediaBlobReferenced mocks base method.
+func (m *MockRepositoryTx) MediaBlobReferenced(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesesCount", arg0)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "MediaBlobReferenced", arg0, arg1)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SeriesesCount indicates an expected call of SeriesesCount.
-func (mr *MockRepositoryTxMockRecorder) SeriesesCount(arg0 interface{}) *gomock.Call {
+// MediaBlobReferenced indicates an expected call of MediaBlobReferenced.
+func (mr *MockRepositoryTxMockRecorder) MediaBlobReferenced(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesesCount", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesesCount), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaBlobReferenced", reflect.TypeOf((*MockRepositoryTx)(nil).MediaBlobReferenced), arg0, arg1)
 }
 
-// SeriesesGetAll mocks base method.
-func (m *MockRepositoryTx) SeriesesGetAll(arg0 context.Context, arg1, arg2 int) ([]*models.Series, error) {
+// MovieAuditGet mocks base method.
+func (m *MockRepositoryTx) MovieAuditGet(arg0 context.Context, arg1 int, arg2 time.Time) (*models.FilmsAudit, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesesGetAll", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]*models.Series)
+	ret := m.ctrl.Call(m, "MovieAuditGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.FilmsAudit)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SeriesesGetAll indicates an expected call of SeriesesGetAll.
-func (mr *MockRepositoryTxMockRecorder) SeriesesGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+// MovieAuditGet indicates an expected call of MovieAuditGet.
+func (mr *MockRepositoryTxMockRecorder) MovieAuditGet(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesesGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesesGetAll), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditGet", reflect.TypeOf((*MockRepositoryTx)(nil).MovieAuditGet), arg0, arg1, arg2)
 }
 
-// Transaction mocks base method.
-func (m *MockRepositoryTx) Transaction(arg0 context.Context, arg1 func(context.Context, repo.Service) error) error {
+// MovieAuditsCount mocks base method.
+func (m *MockRepositoryTx) MovieAuditsCount(arg0 context.Context, arg1 int) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Transaction", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
+	ret := m.ctrl.Call(m, "MovieAuditsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovieAuditsCount indicates an expected call of MovieAuditsCount.
+func (mr *MockRepositoryTxMockRecorder) MovieAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditsCount", reflect.TypeOf((*MockRepositoryTx)(nil).MovieAuditsCount), arg0, arg1)
+}
+
+// MovieAuditsGetAll mocks base method.
+func (m *MockRepositoryTx) MovieAuditsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.FilmsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieAuditsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.FilmsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovieAuditsGetAll indicates an expected call of MovieAuditsGetAll.
+func (mr *MockRepositoryTxMockRecorder) MovieAuditsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditsGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).MovieAuditsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// MovieAuditsGetPage mocks base method.
+func (m *MockRepositoryTx) MovieAuditsGetPage(arg0 context.Context, arg1 int, arg2 *repo.KeysetPage) ([]*models.FilmsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieAuditsGetPage", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.FilmsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovieAuditsGetPage indicates an expected call of MovieAuditsGetPage.
+func (mr *MockRepositoryTxMockRecorder) MovieAuditsGetPage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditsGetPage", reflect.TypeOf((*MockRepositoryTx)(nil).MovieAuditsGetPage), arg0, arg1, arg2)
+}
+
+// MovieCreate mocks base method.
+func (m *MockRepositoryTx) MovieCreate(arg0 context.Context, arg1 int, arg2 *models.Film) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovieCreate indicates an expected call of MovieCreate.
+func (mr *MockRepositoryTxMockRecorder) MovieCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieCreate", reflect.TypeOf((*MockRepositoryTx)(nil).MovieCreate), arg0, arg1, arg2)
+}
+
+// MovieGet mocks base method.
+func (m *MockRepositoryTx) MovieGet(arg0 context.Context, arg1 int, arg2 bool) (*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovieGet indicates an expected call of MovieGet.
+func (mr *MockRepositoryTxMockRecorder) MovieGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieGet", reflect.TypeOf((*MockRepositoryTx)(nil).MovieGet), arg0, arg1, arg2)
+}
+
+// MovieInvalidate mocks base method.
+func (m *MockRepositoryTx) MovieInvalidate(arg0 context.Context, arg1, arg2 int, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieInvalidate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovieInvalidate indicates an expected call of MovieInvalidate.
+func (mr *MockRepositoryTxMockRecorder) MovieInvalidate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieInvalidate", reflect.TypeOf((*MockRepositoryTx)(nil).MovieInvalidate), arg0, arg1, arg2, arg3)
+}
+
+// MovieRestore mocks base method.
+func (m *MockRepositoryTx) MovieRestore(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieRestore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovieRestore indicates an expected call of MovieRestore.
+func (mr *MockRepositoryTxMockRecorder) MovieRestore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieRestore", reflect.TypeOf((*MockRepositoryTx)(nil).MovieRestore), arg0, arg1, arg2)
+}
+
+// MovieUpdate mocks base method.
+func (m *MockRepositoryTx) MovieUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovieUpdate indicates an expected call of MovieUpdate.
+func (mr *MockRepositoryTxMockRecorder) MovieUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieUpdate", reflect.TypeOf((*MockRepositoryTx)(nil).MovieUpdate), arg0, arg1, arg2, arg3)
+}
+
+// MoviesCount mocks base method.
+func (m *MockRepositoryTx) MoviesCount(arg0 context.Context, arg1 repo.ListFilter, arg2 bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoviesCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoviesCount indicates an expected call of MoviesCount.
+func (mr *MockRepositoryTxMockRecorder) MoviesCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoviesCount", reflect.TypeOf((*MockRepositoryTx)(nil).MoviesCount), arg0, arg1, arg2)
+}
+
+// MoviesGetAll mocks base method.
+func (m *MockRepositoryTx) MoviesGetAll(arg0 context.Context, arg1 repo.ListFilter, arg2 repo.ListSort, arg3, arg4 int, arg5 bool) ([]*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoviesGetAll", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoviesGetAll indicates an expected call of MoviesGetAll.
+func (mr *MockRepositoryTxMockRecorder) MoviesGetAll(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoviesGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).MoviesGetAll), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// MoviesGetPage mocks base method.
+func (m *MockRepositoryTx) MoviesGetPage(arg0 context.Context, arg1 repo.ListFilter, arg2 repo.ListSort, arg3 *repo.KeysetPage, arg4 bool) ([]*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoviesGetPage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoviesGetPage indicates an expected call of MoviesGetPage.
+func (mr *MockRepositoryTxMockRecorder) MoviesGetPage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoviesGetPage", reflect.TypeOf((*MockRepositoryTx)(nil).MoviesGetPage), arg0, arg1, arg2, arg3, arg4)
+}
+
+// PlaylistCoverThumbnails mocks base method.
+func (m *MockRepositoryTx) PlaylistCoverThumbnails(arg0 context.Context, arg1, arg2 int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistCoverThumbnails", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistCoverThumbnails indicates an expected call of PlaylistCoverThumbnails.
+func (mr *MockRepositoryTxMockRecorder) PlaylistCoverThumbnails(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistCoverThumbnails", reflect.TypeOf((*MockRepositoryTx)(nil).PlaylistCoverThumbnails), arg0, arg1, arg2)
+}
+
+// PlaylistCreate mocks base method.
+func (m *MockRepositoryTx) PlaylistCreate(arg0 context.Context, arg1 *models.Playlist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaylistCreate indicates an expected call of PlaylistCreate.
+func (mr *MockRepositoryTxMockRecorder) PlaylistCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistCreate", reflect.TypeOf((*MockRepositoryTx)(nil).PlaylistCreate), arg0, arg1)
+}
+
+// PlaylistDelete mocks base method.
+func (m *MockRepositoryTx) PlaylistDelete(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaylistDelete indicates an expected call of PlaylistDelete.
+func (mr *MockRepositoryTxMockRecorder) PlaylistDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistDelete", reflect.TypeOf((*MockRepositoryTx)(nil).PlaylistDelete), arg0, arg1)
+}
+
+// PlaylistFilmAdd mocks base method.
+func (m *MockRepositoryTx) PlaylistFilmAdd(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistFilmAdd", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaylistFilmAdd indicates an expected call of PlaylistFilmAdd.
+func (mr *MockRepositoryTxMockRecorder) PlaylistFilmAdd(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistFilmAdd", reflect.TypeOf((*MockRepositoryTx)(nil).PlaylistFilmAdd), arg0, arg1, arg2)
+}
+
+// PlaylistFilmIDs mocks base method.
+func (m *MockRepositoryTx) PlaylistFilmIDs(arg0 context.Context, arg1 int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistFilmIDs", arg0, arg1)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistFilmIDs indicates an expected call of PlaylistFilmIDs.
+func (mr *MockRepositoryTxMockRecorder) PlaylistFilmIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistFilmIDs", reflect.TypeOf((*MockRepositoryTx)(nil).PlaylistFilmIDs), arg0, arg1)
+}
+
+// PlaylistFilmRemove mocks base method.
+func (m *MockRepositoryTx) PlaylistFilmRemove(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistFilmRemove", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaylistFilmRemove indicates an expected call of PlaylistFilmRemove.
+func (mr *MockRepositoryTxMockRecorder) PlaylistFilmRemove(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistFilmRemove", reflect.TypeOf((*MockRepositoryTx)(nil).PlaylistFilmRemove), arg0, arg1, arg2)
+}
+
+// PlaylistFilmsCount mocks base method.
+func (m *MockRepositoryTx) PlaylistFilmsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistFilmsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistFilmsCount indicates an expected call of PlaylistFilmsCount.
+func (mr *MockRepositoryTxMockRecorder) PlaylistFilmsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistFilmsCount", reflect.TypeOf((*MockRepositoryTx)(nil).PlaylistFilmsCount), arg0, arg1)
+}
+
+// PlaylistFilmsGetAll mocks base method.
+func (m *MockRepositoryTx) PlaylistFilmsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistFilmsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistFilmsGetAll indicates an expected call of PlaylistFilmsGetAll.
+func (mr *MockRepositoryTxMockRecorder) PlaylistFilmsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistFilmsGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).PlaylistFilmsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// PlaylistFilmsReorder mocks base method.
+func (m *MockRepositoryTx) PlaylistFilmsReorder(arg0 context.Context, arg1 int, arg2 []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistFilmsReorder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaylistFilmsReorder indicates an expected call of PlaylistFilmsReorder.
+func (mr *MockRepositoryTxMockRecorder) PlaylistFilmsReorder(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistFilmsReorder", reflect.TypeOf((*MockRepositoryTx)(nil).PlaylistFilmsReorder), arg0, arg1, arg2)
+}
+
+// PlaylistGet mocks base method.
+func (m *MockRepositoryTx) PlaylistGet(arg0 context.Context, arg1 int) (*models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistGet indicates an expected call of PlaylistGet.
+func (mr *MockRepositoryTxMockRecorder) PlaylistGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistGet", reflect.TypeOf((*MockRepositoryTx)(nil).PlaylistGet), arg0, arg1)
+}
+
+// PlaylistGetForUpdate mocks base method.
+func (m *MockRepositoryTx) PlaylistGetForUpdate(arg0 context.Context, arg1 int) (*models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistGetForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(*models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistGetForUpdate indicates an expected call of PlaylistGetForUpdate.
+func (mr *MockRepositoryTxMockRecorder) PlaylistGetForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistGetForUpdate", reflect.TypeOf((*MockRepositoryTx)(nil).PlaylistGetForUpdate), arg0, arg1)
+}
+
+// PlaylistUpdate mocks base method.
+func (m *MockRepositoryTx) PlaylistUpdate(arg0 context.Context, arg1 int, arg2 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaylistUpdate indicates an expected call of PlaylistUpdate.
+func (mr *MockRepositoryTxMockRecorder) PlaylistUpdate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistUpdate", reflect.TypeOf((*MockRepositoryTx)(nil).PlaylistUpdate), arg0, arg1, arg2)
+}
+
+// PlaylistsCountByUser mocks base method.
+func (m *MockRepositoryTx) PlaylistsCountByUser(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistsCountByUser", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistsCountByUser indicates an expected call of PlaylistsCountByUser.
+func (mr *MockRepositoryTxMockRecorder) PlaylistsCountByUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistsCountByUser", reflect.TypeOf((*MockRepositoryTx)(nil).PlaylistsCountByUser), arg0, arg1)
+}
+
+// PlaylistsGetAllByUser mocks base method.
+func (m *MockRepositoryTx) PlaylistsGetAllByUser(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistsGetAllByUser", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistsGetAllByUser indicates an expected call of PlaylistsGetAllByUser.
+func (mr *MockRepositoryTxMockRecorder) PlaylistsGetAllByUser(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistsGetAllByUser", reflect.TypeOf((*MockRepositoryTx)(nil).PlaylistsGetAllByUser), arg0, arg1, arg2, arg3)
+}
+
+// PostCreate mocks base method.
+func (m *MockRepositoryTx) PostCreate(arg0 context.Context, arg1 *models.Post, arg2, arg3, arg4, arg5 []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostCreate", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostCreate indicates an expected call of PostCreate.
+func (mr *MockRepositoryTxMockRecorder) PostCreate(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostCreate", reflect.TypeOf((*MockRepositoryTx)(nil).PostCreate), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// PostDelete mocks base method.
+func (m *MockRepositoryTx) PostDelete(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostDelete indicates an expected call of PostDelete.
+func (mr *MockRepositoryTxMockRecorder) PostDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostDelete", reflect.TypeOf((*MockRepositoryTx)(nil).PostDelete), arg0, arg1)
+}
+
+// PostGet mocks base method.
+func (m *MockRepositoryTx) PostGet(arg0 context.Context, arg1 int) (*repo.PostWithReferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostGet", arg0, arg1)
+	ret0, _ := ret[0].(*repo.PostWithReferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostGet indicates an expected call of PostGet.
+func (mr *MockRepositoryTxMockRecorder) PostGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostGet", reflect.TypeOf((*MockRepositoryTx)(nil).PostGet), arg0, arg1)
+}
+
+// PostRepliesCount mocks base method.
+func (m *MockRepositoryTx) PostRepliesCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostRepliesCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostRepliesCount indicates an expected call of PostRepliesCount.
+func (mr *MockRepositoryTxMockRecorder) PostRepliesCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostRepliesCount", reflect.TypeOf((*MockRepositoryTx)(nil).PostRepliesCount), arg0, arg1)
+}
+
+// PostRepliesGetAll mocks base method.
+func (m *MockRepositoryTx) PostRepliesGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*repo.PostWithReferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostRepliesGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*repo.PostWithReferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostRepliesGetAll indicates an expected call of PostRepliesGetAll.
+func (mr *MockRepositoryTxMockRecorder) PostRepliesGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostRepliesGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).PostRepliesGetAll), arg0, arg1, arg2, arg3)
+}
+
+// PostRevisionsCount mocks base method.
+func (m *MockRepositoryTx) PostRevisionsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostRevisionsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostRevisionsCount indicates an expected call of PostRevisionsCount.
+func (mr *MockRepositoryTxMockRecorder) PostRevisionsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostRevisionsCount", reflect.TypeOf((*MockRepositoryTx)(nil).PostRevisionsCount), arg0, arg1)
+}
+
+// PostRevisionsGetAll mocks base method.
+func (m *MockRepositoryTx) PostRevisionsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.PostRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostRevisionsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.PostRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostRevisionsGetAll indicates an expected call of PostRevisionsGetAll.
+func (mr *MockRepositoryTxMockRecorder) PostRevisionsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostRevisionsGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).PostRevisionsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// PostUpdate mocks base method.
+func (m *MockRepositoryTx) PostUpdate(arg0 context.Context, arg1 int, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostUpdate indicates an expected call of PostUpdate.
+func (mr *MockRepositoryTxMockRecorder) PostUpdate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostUpdate", reflect.TypeOf((*MockRepositoryTx)(nil).PostUpdate), arg0, arg1, arg2)
+}
+
+// PostsCountByFilm mocks base method.
+func (m *MockRepositoryTx) PostsCountByFilm(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostsCountByFilm", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostsCountByFilm indicates an expected call of PostsCountByFilm.
+func (mr *MockRepositoryTxMockRecorder) PostsCountByFilm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostsCountByFilm", reflect.TypeOf((*MockRepositoryTx)(nil).PostsCountByFilm), arg0, arg1)
+}
+
+// PostsCountBySeries mocks base method.
+func (m *MockRepositoryTx) PostsCountBySeries(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostsCountBySeries", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostsCountBySeries indicates an expected call of PostsCountBySeries.
+func (mr *MockRepositoryTxMockRecorder) PostsCountBySeries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostsCountBySeries", reflect.TypeOf((*MockRepositoryTx)(nil).PostsCountBySeries), arg0, arg1)
+}
+
+// PostsGetAllByFilm mocks base method.
+func (m *MockRepositoryTx) PostsGetAllByFilm(arg0 context.Context, arg1, arg2, arg3 int) ([]*repo.PostWithReferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostsGetAllByFilm", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*repo.PostWithReferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostsGetAllByFilm indicates an expected call of PostsGetAllByFilm.
+func (mr *MockRepositoryTxMockRecorder) PostsGetAllByFilm(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostsGetAllByFilm", reflect.TypeOf((*MockRepositoryTx)(nil).PostsGetAllByFilm), arg0, arg1, arg2, arg3)
+}
+
+// PostsGetAllBySeries mocks base method.
+func (m *MockRepositoryTx) PostsGetAllBySeries(arg0 context.Context, arg1, arg2, arg3 int) ([]*repo.PostWithReferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostsGetAllBySeries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*repo.PostWithReferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostsGetAllBySeries indicates an expected call of PostsGetAllBySeries.
+func (mr *MockRepositoryTxMockRecorder) PostsGetAllBySeries(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostsGetAllBySeries", reflect.TypeOf((*MockRepositoryTx)(nil).PostsGetAllBySeries), arg0, arg1, arg2, arg3)
+}
+
+// ProposalCreate mocks base method.
+func (m *MockRepositoryTx) ProposalCreate(arg0 context.Context, arg1 *models.Proposal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProposalCreate indicates an expected call of ProposalCreate.
+func (mr *MockRepositoryTxMockRecorder) ProposalCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalCreate", reflect.TypeOf((*MockRepositoryTx)(nil).ProposalCreate), arg0, arg1)
+}
+
+// ProposalGet mocks base method.
+func (m *MockRepositoryTx) ProposalGet(arg0 context.Context, arg1 int) (*models.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposalGet indicates an expected call of ProposalGet.
+func (mr *MockRepositoryTxMockRecorder) ProposalGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalGet", reflect.TypeOf((*MockRepositoryTx)(nil).ProposalGet), arg0, arg1)
+}
+
+// ProposalReview mocks base method.
+func (m *MockRepositoryTx) ProposalReview(arg0 context.Context, arg1, arg2 int, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalReview", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProposalReview indicates an expected call of ProposalReview.
+func (mr *MockRepositoryTxMockRecorder) ProposalReview(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalReview", reflect.TypeOf((*MockRepositoryTx)(nil).ProposalReview), arg0, arg1, arg2, arg3)
+}
+
+// ProposalsApprovedCount mocks base method.
+func (m *MockRepositoryTx) ProposalsApprovedCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalsApprovedCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposalsApprovedCount indicates an expected call of ProposalsApprovedCount.
+func (mr *MockRepositoryTxMockRecorder) ProposalsApprovedCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalsApprovedCount", reflect.TypeOf((*MockRepositoryTx)(nil).ProposalsApprovedCount), arg0, arg1)
+}
+
+// ProposalsCount mocks base method.
+func (m *MockRepositoryTx) ProposalsCount(arg0 context.Context, arg1 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposalsCount indicates an expected call of ProposalsCount.
+func (mr *MockRepositoryTxMockRecorder) ProposalsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalsCount", reflect.TypeOf((*MockRepositoryTx)(nil).ProposalsCount), arg0, arg1)
+}
+
+// ProposalsGetAll mocks base method.
+func (m *MockRepositoryTx) ProposalsGetAll(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*models.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposalsGetAll indicates an expected call of ProposalsGetAll.
+func (mr *MockRepositoryTxMockRecorder) ProposalsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalsGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).ProposalsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// SeasonAuditsCount mocks base method.
+func (m *MockRepositoryTx) SeasonAuditsCount(arg0 context.Context, arg1, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonAuditsCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeasonAuditsCount indicates an expected call of SeasonAuditsCount.
+func (mr *MockRepositoryTxMockRecorder) SeasonAuditsCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonAuditsCount", reflect.TypeOf((*MockRepositoryTx)(nil).SeasonAuditsCount), arg0, arg1, arg2)
+}
+
+// SeasonAuditsGetAll mocks base method.
+func (m *MockRepositoryTx) SeasonAuditsGetAll(arg0 context.Context, arg1, arg2, arg3, arg4 int) ([]*models.SeasonsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonAuditsGetAll", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*models.SeasonsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeasonAuditsGetAll indicates an expected call of SeasonAuditsGetAll.
+func (mr *MockRepositoryTxMockRecorder) SeasonAuditsGetAll(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonAuditsGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).SeasonAuditsGetAll), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SeasonAuditsGetPage mocks base method.
+func (m *MockRepositoryTx) SeasonAuditsGetPage(arg0 context.Context, arg1, arg2 int, arg3 *repo.KeysetPage) ([]*models.SeasonsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonAuditsGetPage", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.SeasonsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeasonAuditsGetPage indicates an expected call of SeasonAuditsGetPage.
+func (mr *MockRepositoryTxMockRecorder) SeasonAuditsGetPage(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonAuditsGetPage", reflect.TypeOf((*MockRepositoryTx)(nil).SeasonAuditsGetPage), arg0, arg1, arg2, arg3)
+}
+
+// SeasonCreateIfNotExists mocks base method.
+func (m *MockRepositoryTx) SeasonCreateIfNotExists(arg0 context.Context, arg1, arg2, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonCreateIfNotExists", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeasonCreateIfNotExists indicates an expected call of SeasonCreateIfNotExists.
+func (mr *MockRepositoryTxMockRecorder) SeasonCreateIfNotExists(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonCreateIfNotExists", reflect.TypeOf((*MockRepositoryTx)(nil).SeasonCreateIfNotExists), arg0, arg1, arg2, arg3)
+}
+
+// SeasonGet mocks base method.
+func (m *MockRepositoryTx) SeasonGet(arg0 context.Context, arg1, arg2 int, arg3 bool, arg4 ...string) (*models.Season, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SeasonGet", varargs...)
+	ret0, _ := ret[0].(*models.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeasonGet indicates an expected call of SeasonGet.
+func (mr *MockRepositoryTxMockRecorder) SeasonGet(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonGet", reflect.TypeOf((*MockRepositoryTx)(nil).SeasonGet), varargs...)
+}
+
+// SeasonInvalidate mocks base method.
+func (m *MockRepositoryTx) SeasonInvalidate(arg0 context.Context, arg1, arg2, arg3 int, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonInvalidate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeasonInvalidate indicates an expected call of SeasonInvalidate.
+func (mr *MockRepositoryTxMockRecorder) SeasonInvalidate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonInvalidate", reflect.TypeOf((*MockRepositoryTx)(nil).SeasonInvalidate), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SeasonPut mocks base method.
+func (m *MockRepositoryTx) SeasonPut(arg0 context.Context, arg1, arg2, arg3 int, arg4 *models.Season) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonPut", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeasonPut indicates an expected call of SeasonPut.
+func (mr *MockRepositoryTxMockRecorder) SeasonPut(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonPut", reflect.TypeOf((*MockRepositoryTx)(nil).SeasonPut), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SeasonRestore mocks base method.
+func (m *MockRepositoryTx) SeasonRestore(arg0 context.Context, arg1, arg2, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonRestore", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeasonRestore indicates an expected call of SeasonRestore.
+func (mr *MockRepositoryTxMockRecorder) SeasonRestore(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonRestore", reflect.TypeOf((*MockRepositoryTx)(nil).SeasonRestore), arg0, arg1, arg2, arg3)
+}
+
+// SeasonUpdate mocks base method.
+func (m *MockRepositoryTx) SeasonUpdate(arg0 context.Context, arg1, arg2, arg3 int, arg4 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonUpdate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeasonUpdate indicates an expected call of SeasonUpdate.
+func (mr *MockRepositoryTxMockRecorder) SeasonUpdate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonUpdate", reflect.TypeOf((*MockRepositoryTx)(nil).SeasonUpdate), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SeasonsCountBySeries mocks base method.
+func (m *MockRepositoryTx) SeasonsCountBySeries(arg0 context.Context, arg1 int, arg2 bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonsCountBySeries", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeasonsCountBySeries indicates an expected call of SeasonsCountBySeries.
+func (mr *MockRepositoryTxMockRecorder) SeasonsCountBySeries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonsCountBySeries", reflect.TypeOf((*MockRepositoryTx)(nil).SeasonsCountBySeries), arg0, arg1, arg2)
+}
+
+// SeasonsGetAllBySeries mocks base method.
+func (m *MockRepositoryTx) SeasonsGetAllBySeries(arg0 context.Context, arg1, arg2, arg3 int, arg4 bool) ([]*repo.SeasonWithEpisodesCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonsGetAllBySeries", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*repo.SeasonWithEpisodesCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeasonsGetAllBySeries indicates an expected call of SeasonsGetAllBySeries.
+func (mr *MockRepositoryTxMockRecorder) SeasonsGetAllBySeries(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonsGetAllBySeries", reflect.TypeOf((*MockRepositoryTx)(nil).SeasonsGetAllBySeries), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SeriesAuditGet mocks base method.
+func (m *MockRepositoryTx) SeriesAuditGet(arg0 context.Context, arg1 int, arg2 time.Time) (*models.SeriesesAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesAuditGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.SeriesesAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesAuditGet indicates an expected call of SeriesAuditGet.
+func (mr *MockRepositoryTxMockRecorder) SeriesAuditGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditGet", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesAuditGet), arg0, arg1, arg2)
+}
+
+// SeriesAuditsCount mocks base method.
+func (m *MockRepositoryTx) SeriesAuditsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesAuditsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesAuditsCount indicates an expected call of SeriesAuditsCount.
+func (mr *MockRepositoryTxMockRecorder) SeriesAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsCount", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesAuditsCount), arg0, arg1)
+}
+
+// SeriesAuditsGetAll mocks base method.
+func (m *MockRepositoryTx) SeriesAuditsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.SeriesesAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesAuditsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.SeriesesAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesAuditsGetAll indicates an expected call of SeriesAuditsGetAll.
+func (mr *MockRepositoryTxMockRecorder) SeriesAuditsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesAuditsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// SeriesAuditsGetPage mocks base method.
+func (m *MockRepositoryTx) SeriesAuditsGetPage(arg0 context.Context, arg1 int, arg2 *repo.KeysetPage) ([]*models.SeriesesAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesAuditsGetPage", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.SeriesesAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesAuditsGetPage indicates an expected call of SeriesAuditsGetPage.
+func (mr *MockRepositoryTxMockRecorder) SeriesAuditsGetPage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsGetPage", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesAuditsGetPage), arg0, arg1, arg2)
+}
+
+// SeriesCreate mocks base method.
+func (m *MockRepositoryTx) SeriesCreate(arg0 context.Context, arg1 int, arg2 *models.Series) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesCreate indicates an expected call of SeriesCreate.
+func (mr *MockRepositoryTxMockRecorder) SeriesCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesCreate", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesCreate), arg0, arg1, arg2)
+}
+
+// SeriesGet mocks base method.
+func (m *MockRepositoryTx) SeriesGet(arg0 context.Context, arg1 int, arg2 bool, arg3 ...string) (*models.Series, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SeriesGet", varargs...)
+	ret0, _ := ret[0].(*models.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesGet indicates an expected call of SeriesGet.
+func (mr *MockRepositoryTxMockRecorder) SeriesGet(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesGet", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesGet), varargs...)
+}
+
+// SeriesInvalidate mocks base method.
+func (m *MockRepositoryTx) SeriesInvalidate(arg0 context.Context, arg1, arg2 int, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesInvalidate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesInvalidate indicates an expected call of SeriesInvalidate.
+func (mr *MockRepositoryTxMockRecorder) SeriesInvalidate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesInvalidate", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesInvalidate), arg0, arg1, arg2, arg3)
+}
+
+// SeriesRestore mocks base method.
+func (m *MockRepositoryTx) SeriesRestore(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesRestore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesRestore indicates an expected call of SeriesRestore.
+func (mr *MockRepositoryTxMockRecorder) SeriesRestore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesRestore", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesRestore), arg0, arg1, arg2)
+}
+
+// SeriesUpdate mocks base method.
+func (m *MockRepositoryTx) SeriesUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesUpdate indicates an expected call of SeriesUpdate.
+func (mr *MockRepositoryTxMockRecorder) SeriesUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesUpdate", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesUpdate), arg0, arg1, arg2, arg3)
+}
+
+// SeriesesCount mocks base method.
+func (m *MockRepositoryTx) SeriesesCount(arg0 context.Context, arg1 repo.ListFilter, arg2 bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesesCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesesCount indicates an expected call of SeriesesCount.
+func (mr *MockRepositoryTxMockRecorder) SeriesesCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesesCount", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesesCount), arg0, arg1, arg2)
+}
+
+// SeriesesGetAll mocks base method.
+func (m *MockRepositoryTx) SeriesesGetAll(arg0 context.Context, arg1 repo.ListFilter, arg2 repo.ListSort, arg3, arg4 int, arg5 bool) ([]*models.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesesGetAll", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]*models.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesesGetAll indicates an expected call of SeriesesGetAll.
+func (mr *MockRepositoryTxMockRecorder) SeriesesGetAll(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesesGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).SeriesesGetAll), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Transaction mocks base method.
+func (m *MockRepositoryTx) Transaction(arg0 context.Context, arg1 func(context.Context, repo.Service) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
 
 // Transaction indicates an expected call of Transaction.
 func (mr *MockRepositoryTxMockRecorder) Transaction(arg0, arg1 interface{}) *gomock.Call {
@@ -519,6 +1722,65 @@ func (mr *MockRepositoryTxMockRecorder) Transaction(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockRepositoryTx)(nil).Transaction), arg0, arg1)
 }
 
+// UserAnonymize mocks base method.
+func (m *MockRepositoryTx) UserAnonymize(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserAnonymize", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserAnonymize indicates an expected call of UserAnonymize.
+func (mr *MockRepositoryTxMockRecorder) UserAnonymize(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserAnonymize", reflect.TypeOf((*MockRepositoryTx)(nil).UserAnonymize), arg0, arg1)
+}
+
+// UserAuditsCount mocks base method.
+func (m *MockRepositoryTx) UserAuditsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserAuditsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserAuditsCount indicates an expected call of UserAuditsCount.
+func (mr *MockRepositoryTxMockRecorder) UserAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserAuditsCount", reflect.TypeOf((*MockRepositoryTx)(nil).UserAuditsCount), arg0, arg1)
+}
+
+// UserAuditsGetAll mocks base method.
+func (m *MockRepositoryTx) UserAuditsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.UsersAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserAuditsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.UsersAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserAuditsGetAll indicates an expected call of UserAuditsGetAll.
+func (mr *MockRepositoryTxMockRecorder) UserAuditsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserAuditsGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).UserAuditsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// UserAuditsGetPage mocks base method.
+func (m *MockRepositoryTx) UserAuditsGetPage(arg0 context.Context, arg1 int, arg2 *repo.KeysetPage) ([]*models.UsersAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserAuditsGetPage", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.UsersAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserAuditsGetPage indicates an expected call of UserAuditsGetPage.
+func (mr *MockRepositoryTxMockRecorder) UserAuditsGetPage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserAuditsGetPage", reflect.TypeOf((*MockRepositoryTx)(nil).UserAuditsGetPage), arg0, arg1, arg2)
+}
+
 // UserCreate mocks base method.
 func (m *MockRepositoryTx) UserCreate(arg0 context.Context, arg1 *models.User) error {
 	m.ctrl.T.Helper()
@@ -547,6 +1809,138 @@ func (mr *MockRepositoryTxMockRecorder) UserDelete(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDelete", reflect.TypeOf((*MockRepositoryTx)(nil).UserDelete), arg0, arg1)
 }
 
+// UserDeletionCreate mocks base method.
+func (m *MockRepositoryTx) UserDeletionCreate(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDeletionCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserDeletionCreate indicates an expected call of UserDeletionCreate.
+func (mr *MockRepositoryTxMockRecorder) UserDeletionCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDeletionCreate", reflect.TypeOf((*MockRepositoryTx)(nil).UserDeletionCreate), arg0, arg1)
+}
+
+// UserDeletionDelete mocks base method.
+func (m *MockRepositoryTx) UserDeletionDelete(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDeletionDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserDeletionDelete indicates an expected call of UserDeletionDelete.
+func (mr *MockRepositoryTxMockRecorder) UserDeletionDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDeletionDelete", reflect.TypeOf((*MockRepositoryTx)(nil).UserDeletionDelete), arg0, arg1)
+}
+
+// UserDeletionGet mocks base method.
+func (m *MockRepositoryTx) UserDeletionGet(arg0 context.Context, arg1 int) (*models.UserDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDeletionGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.UserDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserDeletionGet indicates an expected call of UserDeletionGet.
+func (mr *MockRepositoryTxMockRecorder) UserDeletionGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDeletionGet", reflect.TypeOf((*MockRepositoryTx)(nil).UserDeletionGet), arg0, arg1)
+}
+
+// UserDeletionsGetAllDue mocks base method.
+func (m *MockRepositoryTx) UserDeletionsGetAllDue(arg0 context.Context, arg1 time.Time) ([]*models.UserDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDeletionsGetAllDue", arg0, arg1)
+	ret0, _ := ret[0].([]*models.UserDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserDeletionsGetAllDue indicates an expected call of UserDeletionsGetAllDue.
+func (mr *MockRepositoryTxMockRecorder) UserDeletionsGetAllDue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDeletionsGetAllDue", reflect.TypeOf((*MockRepositoryTx)(nil).UserDeletionsGetAllDue), arg0, arg1)
+}
+
+// UserFollow mocks base method.
+func (m *MockRepositoryTx) UserFollow(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserFollow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserFollow indicates an expected call of UserFollow.
+func (mr *MockRepositoryTxMockRecorder) UserFollow(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserFollow", reflect.TypeOf((*MockRepositoryTx)(nil).UserFollow), arg0, arg1, arg2)
+}
+
+// UserFollowersCount mocks base method.
+func (m *MockRepositoryTx) UserFollowersCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserFollowersCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserFollowersCount indicates an expected call of UserFollowersCount.
+func (mr *MockRepositoryTxMockRecorder) UserFollowersCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserFollowersCount", reflect.TypeOf((*MockRepositoryTx)(nil).UserFollowersCount), arg0, arg1)
+}
+
+// UserFollowersGetAll mocks base method.
+func (m *MockRepositoryTx) UserFollowersGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserFollowersGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserFollowersGetAll indicates an expected call of UserFollowersGetAll.
+func (mr *MockRepositoryTxMockRecorder) UserFollowersGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserFollowersGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).UserFollowersGetAll), arg0, arg1, arg2, arg3)
+}
+
+// UserFollowingsCount mocks base method.
+func (m *MockRepositoryTx) UserFollowingsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserFollowingsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserFollowingsCount indicates an expected call of UserFollowingsCount.
+func (mr *MockRepositoryTxMockRecorder) UserFollowingsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserFollowingsCount", reflect.TypeOf((*MockRepositoryTx)(nil).UserFollowingsCount), arg0, arg1)
+}
+
+// UserFollowingsGetAll mocks base method.
+func (m *MockRepositoryTx) UserFollowingsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserFollowingsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserFollowingsGetAll indicates an expected call of UserFollowingsGetAll.
+func (mr *MockRepositoryTxMockRecorder) UserFollowingsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserFollowingsGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).UserFollowingsGetAll), arg0, arg1, arg2, arg3)
+}
+
 // UserGet mocks base method.
 func (m *MockRepositoryTx) UserGet(arg0 context.Context, arg1 int) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -577,6 +1971,35 @@ func (mr *MockRepositoryTxMockRecorder) UserGetByEmail(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGetByEmail", reflect.TypeOf((*MockRepositoryTx)(nil).UserGetByEmail), arg0, arg1)
 }
 
+// UserStatusGet mocks base method.
+func (m *MockRepositoryTx) UserStatusGet(arg0 context.Context, arg1 int) (*repo.UserStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserStatusGet", arg0, arg1)
+	ret0, _ := ret[0].(*repo.UserStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserStatusGet indicates an expected call of UserStatusGet.
+func (mr *MockRepositoryTxMockRecorder) UserStatusGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserStatusGet", reflect.TypeOf((*MockRepositoryTx)(nil).UserStatusGet), arg0, arg1)
+}
+
+// UserUnfollow mocks base method.
+func (m *MockRepositoryTx) UserUnfollow(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserUnfollow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserUnfollow indicates an expected call of UserUnfollow.
+func (mr *MockRepositoryTxMockRecorder) UserUnfollow(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserUnfollow", reflect.TypeOf((*MockRepositoryTx)(nil).UserUnfollow), arg0, arg1, arg2)
+}
+
 // UserUpdate mocks base method.
 func (m *MockRepositoryTx) UserUpdate(arg0 context.Context, arg1 int, arg2 map[string]interface{}) error {
 	m.ctrl.T.Helper()
@@ -605,3 +2028,62 @@ func (mr *MockRepositoryTxMockRecorder) UsersCount(arg0 interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsersCount", reflect.TypeOf((*MockRepositoryTx)(nil).UsersCount), arg0)
 }
+
+// WatchlistAddPlaylist mocks base method.
+func (m *MockRepositoryTx) WatchlistAddPlaylist(arg0 context.Context, arg1, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchlistAddPlaylist", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchlistAddPlaylist indicates an expected call of WatchlistAddPlaylist.
+func (mr *MockRepositoryTxMockRecorder) WatchlistAddPlaylist(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistAddPlaylist", reflect.TypeOf((*MockRepositoryTx)(nil).WatchlistAddPlaylist), arg0, arg1, arg2)
+}
+
+// WatchlistCount mocks base method.
+func (m *MockRepositoryTx) WatchlistCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchlistCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchlistCount indicates an expected call of WatchlistCount.
+func (mr *MockRepositoryTxMockRecorder) WatchlistCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistCount", reflect.TypeOf((*MockRepositoryTx)(nil).WatchlistCount), arg0, arg1)
+}
+
+// WatchlistGetAll mocks base method.
+func (m *MockRepositoryTx) WatchlistGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.Watchlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchlistGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Watchlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchlistGetAll indicates an expected call of WatchlistGetAll.
+func (mr *MockRepositoryTxMockRecorder) WatchlistGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistGetAll", reflect.TypeOf((*MockRepositoryTx)(nil).WatchlistGetAll), arg0, arg1, arg2, arg3)
+}
+
+// WatchlistMarkWatched mocks base method.
+func (m *MockRepositoryTx) WatchlistMarkWatched(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchlistMarkWatched", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchlistMarkWatched indicates an expected call of WatchlistMarkWatched.
+func (mr *MockRepositoryTxMockRecorder) WatchlistMarkWatched(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistMarkWatched", reflect.TypeOf((*MockRepositoryTx)(nil).WatchlistMarkWatched), arg0, arg1, arg2)
+}
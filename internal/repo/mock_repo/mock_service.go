@@ -7,6 +7,7 @@ package mock_repo
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/aria3ppp/watch-server/internal/models"
 	repo "github.com/aria3ppp/watch-server/internal/repo"
@@ -36,6 +37,198 @@ func (m *MockServiceTx) EXPECT() *MockServiceTxMockRecorder {
 	return m.recorder
 }
 
+// ArtistCreate mocks base method.
+func (m *MockServiceTx) ArtistCreate(arg0 context.Context, arg1 int, arg2 *models.Artist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArtistCreate indicates an expected call of ArtistCreate.
+func (mr *MockServiceTxMockRecorder) ArtistCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistCreate", reflect.TypeOf((*MockServiceTx)(nil).ArtistCreate), arg0, arg1, arg2)
+}
+
+// ArtistGet mocks base method.
+func (m *MockServiceTx) ArtistGet(arg0 context.Context, arg1 int) (*models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtistGet indicates an expected call of ArtistGet.
+func (mr *MockServiceTxMockRecorder) ArtistGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistGet", reflect.TypeOf((*MockServiceTx)(nil).ArtistGet), arg0, arg1)
+}
+
+// ArtistImageCreate mocks base method.
+func (m *MockServiceTx) ArtistImageCreate(arg0 context.Context, arg1 int, arg2 *models.ArtistImageURL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistImageCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArtistImageCreate indicates an expected call of ArtistImageCreate.
+func (mr *MockServiceTxMockRecorder) ArtistImageCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistImageCreate", reflect.TypeOf((*MockServiceTx)(nil).ArtistImageCreate), arg0, arg1, arg2)
+}
+
+// ArtistImageGet mocks base method.
+func (m *MockServiceTx) ArtistImageGet(arg0 context.Context, arg1, arg2 int) (*models.ArtistImageURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistImageGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.ArtistImageURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtistImageGet indicates an expected call of ArtistImageGet.
+func (mr *MockServiceTxMockRecorder) ArtistImageGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistImageGet", reflect.TypeOf((*MockServiceTx)(nil).ArtistImageGet), arg0, arg1, arg2)
+}
+
+// ArtistImageInvalidate mocks base method.
+func (m *MockServiceTx) ArtistImageInvalidate(arg0 context.Context, arg1, arg2, arg3 int, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistImageInvalidate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArtistImageInvalidate indicates an expected call of ArtistImageInvalidate.
+func (mr *MockServiceTxMockRecorder) ArtistImageInvalidate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistImageInvalidate", reflect.TypeOf((*MockServiceTx)(nil).ArtistImageInvalidate), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ArtistImagesCountByArtist mocks base method.
+func (m *MockServiceTx) ArtistImagesCountByArtist(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistImagesCountByArtist", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtistImagesCountByArtist indicates an expected call of ArtistImagesCountByArtist.
+func (mr *MockServiceTxMockRecorder) ArtistImagesCountByArtist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistImagesCountByArtist", reflect.TypeOf((*MockServiceTx)(nil).ArtistImagesCountByArtist), arg0, arg1)
+}
+
+// ArtistImagesGetAllByArtist mocks base method.
+func (m *MockServiceTx) ArtistImagesGetAllByArtist(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.ArtistImageURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistImagesGetAllByArtist", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.ArtistImageURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtistImagesGetAllByArtist indicates an expected call of ArtistImagesGetAllByArtist.
+func (mr *MockServiceTxMockRecorder) ArtistImagesGetAllByArtist(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistImagesGetAllByArtist", reflect.TypeOf((*MockServiceTx)(nil).ArtistImagesGetAllByArtist), arg0, arg1, arg2, arg3)
+}
+
+// AuditsPrune mocks base method.
+func (m *MockServiceTx) AuditsPrune(arg0 context.Context, arg1 repo.AuditRetention) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditsPrune", arg0, arg1)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditsPrune indicates an expected call of AuditsPrune.
+func (mr *MockServiceTxMockRecorder) AuditsPrune(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditsPrune", reflect.TypeOf((*MockServiceTx)(nil).AuditsPrune), arg0, arg1)
+}
+
+// ContributionsCount mocks base method.
+func (m *MockServiceTx) ContributionsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContributionsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContributionsCount indicates an expected call of ContributionsCount.
+func (mr *MockServiceTxMockRecorder) ContributionsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContributionsCount", reflect.TypeOf((*MockServiceTx)(nil).ContributionsCount), arg0, arg1)
+}
+
+// ContributionsGetAll mocks base method.
+func (m *MockServiceTx) ContributionsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*repo.Contribution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContributionsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*repo.Contribution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContributionsGetAll indicates an expected call of ContributionsGetAll.
+func (mr *MockServiceTxMockRecorder) ContributionsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContributionsGetAll", reflect.TypeOf((*MockServiceTx)(nil).ContributionsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// ContributorsCount mocks base method.
+func (m *MockServiceTx) ContributorsCount(arg0 context.Context, arg1, arg2 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContributorsCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContributorsCount indicates an expected call of ContributorsCount.
+func (mr *MockServiceTxMockRecorder) ContributorsCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContributorsCount", reflect.TypeOf((*MockServiceTx)(nil).ContributorsCount), arg0, arg1, arg2)
+}
+
+// ContributorsGetAll mocks base method.
+func (m *MockServiceTx) ContributorsGetAll(arg0 context.Context, arg1, arg2 time.Time, arg3, arg4 int) ([]*repo.Contributor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContributorsGetAll", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*repo.Contributor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContributorsGetAll indicates an expected call of ContributorsGetAll.
+func (mr *MockServiceTxMockRecorder) ContributorsGetAll(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContributorsGetAll", reflect.TypeOf((*MockServiceTx)(nil).ContributorsGetAll), arg0, arg1, arg2, arg3, arg4)
+}
+
+// EpisodeAuditGet mocks base method.
+func (m *MockServiceTx) EpisodeAuditGet(arg0 context.Context, arg1, arg2, arg3, arg4 int, arg5 time.Time) (*models.FilmsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodeAuditGet", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*models.FilmsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpisodeAuditGet indicates an expected call of EpisodeAuditGet.
+func (mr *MockServiceTxMockRecorder) EpisodeAuditGet(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeAuditGet", reflect.TypeOf((*MockServiceTx)(nil).EpisodeAuditGet), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // EpisodeAuditsCount mocks base method.
 func (m *MockServiceTx) EpisodeAuditsCount(arg0 context.Context, arg1, arg2, arg3 int) (int, error) {
 	m.ctrl.T.Helper()
@@ -66,19 +259,39 @@ func (mr *MockServiceTxMockRecorder) EpisodeAuditsGetAll(arg0, arg1, arg2, arg3,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).EpisodeAuditsGetAll), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// EpisodeAuditsGetPage mocks base method.
+func (m *MockServiceTx) EpisodeAuditsGetPage(arg0 context.Context, arg1, arg2, arg3 int, arg4 *repo.KeysetPage) ([]*models.FilmsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodeAuditsGetPage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*models.FilmsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpisodeAuditsGetPage indicates an expected call of EpisodeAuditsGetPage.
+func (mr *MockServiceTxMockRecorder) EpisodeAuditsGetPage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeAuditsGetPage", reflect.TypeOf((*MockServiceTx)(nil).EpisodeAuditsGetPage), arg0, arg1, arg2, arg3, arg4)
+}
+
 // EpisodeGet mocks base method.
-func (m *MockServiceTx) EpisodeGet(arg0 context.Context, arg1, arg2, arg3 int) (*models.Film, error) {
+func (m *MockServiceTx) EpisodeGet(arg0 context.Context, arg1, arg2, arg3 int, arg4 bool, arg5 ...string) (*models.Film, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodeGet", arg0, arg1, arg2, arg3)
+	varargs := []interface{}{arg0, arg1, arg2, arg3, arg4}
+	for _, a := range arg5 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EpisodeGet", varargs...)
 	ret0, _ := ret[0].(*models.Film)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EpisodeGet indicates an expected call of EpisodeGet.
-func (mr *MockServiceTxMockRecorder) EpisodeGet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockServiceTxMockRecorder) EpisodeGet(arg0, arg1, arg2, arg3, arg4 interface{}, arg5 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeGet", reflect.TypeOf((*MockServiceTx)(nil).EpisodeGet), arg0, arg1, arg2, arg3)
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4}, arg5...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeGet", reflect.TypeOf((*MockServiceTx)(nil).EpisodeGet), varargs...)
 }
 
 // EpisodeInvalidate mocks base method.
@@ -109,6 +322,20 @@ func (mr *MockServiceTxMockRecorder) EpisodePut(arg0, arg1, arg2, arg3, arg4, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodePut", reflect.TypeOf((*MockServiceTx)(nil).EpisodePut), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// EpisodeRestore mocks base method.
+func (m *MockServiceTx) EpisodeRestore(arg0 context.Context, arg1, arg2, arg3, arg4 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodeRestore", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EpisodeRestore indicates an expected call of EpisodeRestore.
+func (mr *MockServiceTxMockRecorder) EpisodeRestore(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeRestore", reflect.TypeOf((*MockServiceTx)(nil).EpisodeRestore), arg0, arg1, arg2, arg3, arg4)
+}
+
 // EpisodeUpdate mocks base method.
 func (m *MockServiceTx) EpisodeUpdate(arg0 context.Context, arg1, arg2, arg3, arg4 int, arg5 map[string]interface{}) error {
 	m.ctrl.T.Helper()
@@ -184,63 +411,78 @@ func (mr *MockServiceTxMockRecorder) EpisodesAuditsGetAllBySeries(arg0, arg1, ar
 }
 
 // EpisodesCountBySeason mocks base method.
-func (m *MockServiceTx) EpisodesCountBySeason(arg0 context.Context, arg1, arg2 int) (int, error) {
+func (m *MockServiceTx) EpisodesCountBySeason(arg0 context.Context, arg1, arg2 int, arg3 bool) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodesCountBySeason", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "EpisodesCountBySeason", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EpisodesCountBySeason indicates an expected call of EpisodesCountBySeason.
-func (mr *MockServiceTxMockRecorder) EpisodesCountBySeason(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockServiceTxMockRecorder) EpisodesCountBySeason(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesCountBySeason", reflect.TypeOf((*MockServiceTx)(nil).EpisodesCountBySeason), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesCountBySeason", reflect.TypeOf((*MockServiceTx)(nil).EpisodesCountBySeason), arg0, arg1, arg2, arg3)
 }
 
 // EpisodesCountBySeries mocks base method.
-func (m *MockServiceTx) EpisodesCountBySeries(arg0 context.Context, arg1 int) (int, error) {
+func (m *MockServiceTx) EpisodesCountBySeries(arg0 context.Context, arg1 int, arg2 bool) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodesCountBySeries", arg0, arg1)
+	ret := m.ctrl.Call(m, "EpisodesCountBySeries", arg0, arg1, arg2)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EpisodesCountBySeries indicates an expected call of EpisodesCountBySeries.
-func (mr *MockServiceTxMockRecorder) EpisodesCountBySeries(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockServiceTxMockRecorder) EpisodesCountBySeries(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesCountBySeries", reflect.TypeOf((*MockServiceTx)(nil).EpisodesCountBySeries), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesCountBySeries", reflect.TypeOf((*MockServiceTx)(nil).EpisodesCountBySeries), arg0, arg1, arg2)
 }
 
 // EpisodesGetAllBySeason mocks base method.
-func (m *MockServiceTx) EpisodesGetAllBySeason(arg0 context.Context, arg1, arg2, arg3, arg4 int) ([]*models.Film, error) {
+func (m *MockServiceTx) EpisodesGetAllBySeason(arg0 context.Context, arg1, arg2, arg3, arg4 int, arg5 bool) ([]*models.Film, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodesGetAllBySeason", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "EpisodesGetAllBySeason", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].([]*models.Film)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EpisodesGetAllBySeason indicates an expected call of EpisodesGetAllBySeason.
-func (mr *MockServiceTxMockRecorder) EpisodesGetAllBySeason(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+func (mr *MockServiceTxMockRecorder) EpisodesGetAllBySeason(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesGetAllBySeason", reflect.TypeOf((*MockServiceTx)(nil).EpisodesGetAllBySeason), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesGetAllBySeason", reflect.TypeOf((*MockServiceTx)(nil).EpisodesGetAllBySeason), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
 // EpisodesGetAllBySeries mocks base method.
-func (m *MockServiceTx) EpisodesGetAllBySeries(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.Film, error) {
+func (m *MockServiceTx) EpisodesGetAllBySeries(arg0 context.Context, arg1, arg2, arg3 int, arg4 bool) ([]*models.Film, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodesGetAllBySeries", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "EpisodesGetAllBySeries", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].([]*models.Film)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EpisodesGetAllBySeries indicates an expected call of EpisodesGetAllBySeries.
-func (mr *MockServiceTxMockRecorder) EpisodesGetAllBySeries(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockServiceTxMockRecorder) EpisodesGetAllBySeries(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesGetAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).EpisodesGetAllBySeries), arg0, arg1, arg2, arg3, arg4)
+}
+
+// EpisodesGetPageBySeries mocks base method.
+func (m *MockServiceTx) EpisodesGetPageBySeries(arg0 context.Context, arg1 int, arg2 *repo.KeysetPage, arg3 bool) ([]*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodesGetPageBySeries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpisodesGetPageBySeries indicates an expected call of EpisodesGetPageBySeries.
+func (mr *MockServiceTxMockRecorder) EpisodesGetPageBySeries(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesGetAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).EpisodesGetAllBySeries), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesGetPageBySeries", reflect.TypeOf((*MockServiceTx)(nil).EpisodesGetPageBySeries), arg0, arg1, arg2, arg3)
 }
 
 // EpisodesInvalidateAllBySeason mocks base method.
@@ -271,247 +513,1208 @@ func (mr *MockServiceTxMockRecorder) EpisodesInvalidateAllBySeries(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesInvalidateAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).EpisodesInvalidateAllBySeries), arg0, arg1, arg2, arg3)
 }
 
-// MovieAuditsCount mocks base method.
-func (m *MockServiceTx) MovieAuditsCount(arg0 context.Context, arg1 int) (int, error) {
+// EpisodesRestoreAllBySeason mocks base method.
+func (m *MockServiceTx) EpisodesRestoreAllBySeason(arg0 context.Context, arg1, arg2, arg3 int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieAuditsCount", arg0, arg1)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "EpisodesRestoreAllBySeason", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MovieAuditsCount indicates an expected call of MovieAuditsCount.
-func (mr *MockServiceTxMockRecorder) MovieAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+// EpisodesRestoreAllBySeason indicates an expected call of EpisodesRestoreAllBySeason.
+func (mr *MockServiceTxMockRecorder) EpisodesRestoreAllBySeason(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).MovieAuditsCount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesRestoreAllBySeason", reflect.TypeOf((*MockServiceTx)(nil).EpisodesRestoreAllBySeason), arg0, arg1, arg2, arg3)
 }
 
-// MovieAuditsGetAll mocks base method.
-func (m *MockServiceTx) MovieAuditsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.FilmsAudit, error) {
+// EpisodesRestoreAllBySeries mocks base method.
+func (m *MockServiceTx) EpisodesRestoreAllBySeries(arg0 context.Context, arg1, arg2 int, arg3 time.Time, arg4 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieAuditsGetAll", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].([]*models.FilmsAudit)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "EpisodesRestoreAllBySeries", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MovieAuditsGetAll indicates an expected call of MovieAuditsGetAll.
-func (mr *MockServiceTxMockRecorder) MovieAuditsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// EpisodesRestoreAllBySeries indicates an expected call of EpisodesRestoreAllBySeries.
+func (mr *MockServiceTxMockRecorder) EpisodesRestoreAllBySeries(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).MovieAuditsGetAll), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesRestoreAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).EpisodesRestoreAllBySeries), arg0, arg1, arg2, arg3, arg4)
 }
 
-// MovieCreate mocks base method.
-func (m *MockServiceTx) MovieCreate(arg0 context.Context, arg1 int, arg2 *models.Film) error {
+// FeedGetAll mocks base method.
+func (m *MockServiceTx) FeedGetAll(arg0 context.Context, arg1 int, arg2 *repo.FeedPosition, arg3 int) ([]*repo.FeedActivity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieCreate", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "FeedGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*repo.FeedActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MovieCreate indicates an expected call of MovieCreate.
-func (mr *MockServiceTxMockRecorder) MovieCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+// FeedGetAll indicates an expected call of FeedGetAll.
+func (mr *MockServiceTxMockRecorder) FeedGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieCreate", reflect.TypeOf((*MockServiceTx)(nil).MovieCreate), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeedGetAll", reflect.TypeOf((*MockServiceTx)(nil).FeedGetAll), arg0, arg1, arg2, arg3)
 }
 
-// MovieGet mocks base method.
-func (m *MockServiceTx) MovieGet(arg0 context.Context, arg1 int) (*models.Film, error) {
+// FilmGet mocks base method.
+func (m *MockServiceTx) FilmGet(arg0 context.Context, arg1 int) (*models.Film, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieGet", arg0, arg1)
+	ret := m.ctrl.Call(m, "FilmGet", arg0, arg1)
 	ret0, _ := ret[0].(*models.Film)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MovieGet indicates an expected call of MovieGet.
-func (mr *MockServiceTxMockRecorder) MovieGet(arg0, arg1 interface{}) *gomock.Call {
+// FilmGet indicates an expected call of FilmGet.
+func (mr *MockServiceTxMockRecorder) FilmGet(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieGet", reflect.TypeOf((*MockServiceTx)(nil).MovieGet), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmGet", reflect.TypeOf((*MockServiceTx)(nil).FilmGet), arg0, arg1)
 }
 
-// MovieInvalidate mocks base method.
-func (m *MockServiceTx) MovieInvalidate(arg0 context.Context, arg1, arg2 int, arg3 string) error {
+// FilmMediaCountByFilm mocks base method.
+func (m *MockServiceTx) FilmMediaCountByFilm(arg0 context.Context, arg1 int) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieInvalidate", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "FilmMediaCountByFilm", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MovieInvalidate indicates an expected call of MovieInvalidate.
-func (mr *MockServiceTxMockRecorder) MovieInvalidate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// FilmMediaCountByFilm indicates an expected call of FilmMediaCountByFilm.
+func (mr *MockServiceTxMockRecorder) FilmMediaCountByFilm(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieInvalidate", reflect.TypeOf((*MockServiceTx)(nil).MovieInvalidate), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmMediaCountByFilm", reflect.TypeOf((*MockServiceTx)(nil).FilmMediaCountByFilm), arg0, arg1)
 }
 
-// MovieUpdate mocks base method.
-func (m *MockServiceTx) MovieUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+// FilmMediaCreate mocks base method.
+func (m *MockServiceTx) FilmMediaCreate(arg0 context.Context, arg1 int, arg2 *models.FilmMediaURL) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieUpdate", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "FilmMediaCreate", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MovieUpdate indicates an expected call of MovieUpdate.
-func (mr *MockServiceTxMockRecorder) MovieUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// FilmMediaCreate indicates an expected call of FilmMediaCreate.
+func (mr *MockServiceTxMockRecorder) FilmMediaCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieUpdate", reflect.TypeOf((*MockServiceTx)(nil).MovieUpdate), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmMediaCreate", reflect.TypeOf((*MockServiceTx)(nil).FilmMediaCreate), arg0, arg1, arg2)
 }
 
-// MoviesCount mocks base method.
-func (m *MockServiceTx) MoviesCount(arg0 context.Context) (int, error) {
+// FilmMediaGet mocks base method.
+func (m *MockServiceTx) FilmMediaGet(arg0 context.Context, arg1, arg2 int) (*models.FilmMediaURL, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MoviesCount", arg0)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "FilmMediaGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.FilmMediaURL)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MoviesCount indicates an expected call of MoviesCount.
-func (mr *MockServiceTxMockRecorder) MoviesCount(arg0 interface{}) *gomock.Call {
+// FilmMediaGet indicates an expected call of FilmMediaGet.
+func (mr *MockServiceTxMockRecorder) FilmMediaGet(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoviesCount", reflect.TypeOf((*MockServiceTx)(nil).MoviesCount), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmMediaGet", reflect.TypeOf((*MockServiceTx)(nil).FilmMediaGet), arg0, arg1, arg2)
 }
 
-// MoviesGetAll mocks base method.
-func (m *MockServiceTx) MoviesGetAll(arg0 context.Context, arg1, arg2 int) ([]*models.Film, error) {
+// FilmMediaGetAllByFilm mocks base method.
+func (m *MockServiceTx) FilmMediaGetAllByFilm(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.FilmMediaURL, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MoviesGetAll", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]*models.Film)
+	ret := m.ctrl.Call(m, "FilmMediaGetAllByFilm", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.FilmMediaURL)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MoviesGetAll indicates an expected call of MoviesGetAll.
-func (mr *MockServiceTxMockRecorder) MoviesGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+// FilmMediaGetAllByFilm indicates an expected call of FilmMediaGetAllByFilm.
+func (mr *MockServiceTxMockRecorder) FilmMediaGetAllByFilm(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoviesGetAll", reflect.TypeOf((*MockServiceTx)(nil).MoviesGetAll), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmMediaGetAllByFilm", reflect.TypeOf((*MockServiceTx)(nil).FilmMediaGetAllByFilm), arg0, arg1, arg2, arg3)
 }
 
-// SeriesAuditsCount mocks base method.
-func (m *MockServiceTx) SeriesAuditsCount(arg0 context.Context, arg1 int) (int, error) {
+// FilmMediaInvalidate mocks base method.
+func (m *MockServiceTx) FilmMediaInvalidate(arg0 context.Context, arg1, arg2, arg3 int, arg4 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesAuditsCount", arg0, arg1)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "FilmMediaInvalidate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// SeriesAuditsCount indicates an expected call of SeriesAuditsCount.
-func (mr *MockServiceTxMockRecorder) SeriesAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+// FilmMediaInvalidate indicates an expected call of FilmMediaInvalidate.
+func (mr *MockServiceTxMockRecorder) FilmMediaInvalidate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).SeriesAuditsCount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmMediaInvalidate", reflect.TypeOf((*MockServiceTx)(nil).FilmMediaInvalidate), arg0, arg1, arg2, arg3, arg4)
 }
 
-// SeriesAuditsGetAll mocks base method.
-func (m *MockServiceTx) SeriesAuditsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.SeriesesAudit, error) {
+// IdempotencyKeyComplete mocks base method.
+func (m *MockServiceTx) IdempotencyKeyComplete(arg0 context.Context, arg1 int, arg2 string, arg3 int, arg4 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesAuditsGetAll", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].([]*models.SeriesesAudit)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "IdempotencyKeyComplete", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// SeriesAuditsGetAll indicates an expected call of SeriesAuditsGetAll.
-func (mr *MockServiceTxMockRecorder) SeriesAuditsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// IdempotencyKeyComplete indicates an expected call of IdempotencyKeyComplete.
+func (mr *MockServiceTxMockRecorder) IdempotencyKeyComplete(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).SeriesAuditsGetAll), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdempotencyKeyComplete", reflect.TypeOf((*MockServiceTx)(nil).IdempotencyKeyComplete), arg0, arg1, arg2, arg3, arg4)
 }
 
-// SeriesCreate mocks base method.
-func (m *MockServiceTx) SeriesCreate(arg0 context.Context, arg1 int, arg2 *models.Series) error {
+// IdempotencyKeyDelete mocks base method.
+func (m *MockServiceTx) IdempotencyKeyDelete(arg0 context.Context, arg1 int, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesCreate", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "IdempotencyKeyDelete", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SeriesCreate indicates an expected call of SeriesCreate.
-func (mr *MockServiceTxMockRecorder) SeriesCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+// IdempotencyKeyDelete indicates an expected call of IdempotencyKeyDelete.
+func (mr *MockServiceTxMockRecorder) IdempotencyKeyDelete(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesCreate", reflect.TypeOf((*MockServiceTx)(nil).SeriesCreate), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdempotencyKeyDelete", reflect.TypeOf((*MockServiceTx)(nil).IdempotencyKeyDelete), arg0, arg1, arg2)
 }
 
-// SeriesGet mocks base method.
-func (m *MockServiceTx) SeriesGet(arg0 context.Context, arg1 int) (*models.Series, error) {
+// IdempotencyKeyGet mocks base method.
+func (m *MockServiceTx) IdempotencyKeyGet(arg0 context.Context, arg1 int, arg2 string) (*models.IdempotencyKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesGet", arg0, arg1)
-	ret0, _ := ret[0].(*models.Series)
+	ret := m.ctrl.Call(m, "IdempotencyKeyGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.IdempotencyKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SeriesGet indicates an expected call of SeriesGet.
-func (mr *MockServiceTxMockRecorder) SeriesGet(arg0, arg1 interface{}) *gomock.Call {
+// IdempotencyKeyGet indicates an expected call of IdempotencyKeyGet.
+func (mr *MockServiceTxMockRecorder) IdempotencyKeyGet(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesGet", reflect.TypeOf((*MockServiceTx)(nil).SeriesGet), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdempotencyKeyGet", reflect.TypeOf((*MockServiceTx)(nil).IdempotencyKeyGet), arg0, arg1, arg2)
 }
 
-// SeriesInvalidate mocks base method.
-func (m *MockServiceTx) SeriesInvalidate(arg0 context.Context, arg1, arg2 int, arg3 string) error {
+// IdempotencyKeyReserve mocks base method.
+func (m *MockServiceTx) IdempotencyKeyReserve(arg0 context.Context, arg1 int, arg2, arg3 string, arg4, arg5 time.Time) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesInvalidate", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "IdempotencyKeyReserve", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SeriesInvalidate indicates an expected call of SeriesInvalidate.
-func (mr *MockServiceTxMockRecorder) SeriesInvalidate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// IdempotencyKeyReserve indicates an expected call of IdempotencyKeyReserve.
+func (mr *MockServiceTxMockRecorder) IdempotencyKeyReserve(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesInvalidate", reflect.TypeOf((*MockServiceTx)(nil).SeriesInvalidate), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdempotencyKeyReserve", reflect.TypeOf((*MockServiceTx)(nil).IdempotencyKeyReserve), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
-// SeriesUpdate mocks base method.
-func (m *MockServiceTx) SeriesUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+// IdempotencyKeysPrune mocks base method.
+func (m *MockServiceTx) IdempotencyKeysPrune(arg0 context.Context, arg1 time.Time) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesUpdate", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "IdempotencyKeysPrune", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SeriesUpdate indicates an expected call of SeriesUpdate.
-func (mr *MockServiceTxMockRecorder) SeriesUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// IdempotencyKeysPrune indicates an expected call of IdempotencyKeysPrune.
+func (mr *MockServiceTxMockRecorder) IdempotencyKeysPrune(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesUpdate", reflect.TypeOf((*MockServiceTx)(nil).SeriesUpdate), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdempotencyKeysPrune", reflect.TypeOf((*MockServiceTx)(nil).IdempotencyKeysPrune), arg0, arg1)
 }
 
-// SeriesesCount mocks base method.
-func (m *MockServiceTx) SeriesesCount(arg0 context.Context) (int, error) {
+// MediaBlobReferenced mocks base method.
+func (m *MockServiceTx) MediaBlobReferenced(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesesCount", arg0)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "MediaBlobReferenced", arg0, arg1)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SeriesesCount indicates an expected call of SeriesesCount.
-func (mr *MockServiceTxMockRecorder) SeriesesCount(arg0 interface{}) *gomock.Call {
+// MediaBlobReferenced indicates an expected call of MediaBlobReferenced.
+func (mr *MockServiceTxMockRecorder) MediaBlobReferenced(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesesCount", reflect.TypeOf((*MockServiceTx)(nil).SeriesesCount), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaBlobReferenced", reflect.TypeOf((*MockServiceTx)(nil).MediaBlobReferenced), arg0, arg1)
 }
 
-// SeriesesGetAll mocks base method.
-func (m *MockServiceTx) SeriesesGetAll(arg0 context.Context, arg1, arg2 int) ([]*models.Series, error) {
+// MovieAuditGet mocks base method.
+func (m *MockServiceTx) MovieAuditGet(arg0 context.Context, arg1 int, arg2 time.Time) (*models.FilmsAudit, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesesGetAll", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]*models.Series)
+	ret := m.ctrl.Call(m, "MovieAuditGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.FilmsAudit)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SeriesesGetAll indicates an expected call of SeriesesGetAll.
-func (mr *MockServiceTxMockRecorder) SeriesesGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+// MovieAuditGet indicates an expected call of MovieAuditGet.
+func (mr *MockServiceTxMockRecorder) MovieAuditGet(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesesGetAll", reflect.TypeOf((*MockServiceTx)(nil).SeriesesGetAll), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditGet", reflect.TypeOf((*MockServiceTx)(nil).MovieAuditGet), arg0, arg1, arg2)
 }
 
-// Transaction mocks base method.
-func (m *MockServiceTx) Transaction(arg0 context.Context, arg1 func(context.Context, repo.Service) error) error {
+// MovieAuditsCount mocks base method.
+func (m *MockServiceTx) MovieAuditsCount(arg0 context.Context, arg1 int) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Transaction", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
+	ret := m.ctrl.Call(m, "MovieAuditsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovieAuditsCount indicates an expected call of MovieAuditsCount.
+func (mr *MockServiceTxMockRecorder) MovieAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).MovieAuditsCount), arg0, arg1)
+}
+
+// MovieAuditsGetAll mocks base method.
+func (m *MockServiceTx) MovieAuditsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.FilmsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieAuditsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.FilmsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovieAuditsGetAll indicates an expected call of MovieAuditsGetAll.
+func (mr *MockServiceTxMockRecorder) MovieAuditsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).MovieAuditsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// MovieAuditsGetPage mocks base method.
+func (m *MockServiceTx) MovieAuditsGetPage(arg0 context.Context, arg1 int, arg2 *repo.KeysetPage) ([]*models.FilmsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieAuditsGetPage", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.FilmsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovieAuditsGetPage indicates an expected call of MovieAuditsGetPage.
+func (mr *MockServiceTxMockRecorder) MovieAuditsGetPage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditsGetPage", reflect.TypeOf((*MockServiceTx)(nil).MovieAuditsGetPage), arg0, arg1, arg2)
+}
+
+// MovieCreate mocks base method.
+func (m *MockServiceTx) MovieCreate(arg0 context.Context, arg1 int, arg2 *models.Film) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovieCreate indicates an expected call of MovieCreate.
+func (mr *MockServiceTxMockRecorder) MovieCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieCreate", reflect.TypeOf((*MockServiceTx)(nil).MovieCreate), arg0, arg1, arg2)
+}
+
+// MovieGet mocks base method.
+func (m *MockServiceTx) MovieGet(arg0 context.Context, arg1 int, arg2 bool) (*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovieGet indicates an expected call of MovieGet.
+func (mr *MockServiceTxMockRecorder) MovieGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieGet", reflect.TypeOf((*MockServiceTx)(nil).MovieGet), arg0, arg1, arg2)
+}
+
+// MovieInvalidate mocks base method.
+func (m *MockServiceTx) MovieInvalidate(arg0 context.Context, arg1, arg2 int, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieInvalidate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovieInvalidate indicates an expected call of MovieInvalidate.
+func (mr *MockServiceTxMockRecorder) MovieInvalidate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieInvalidate", reflect.TypeOf((*MockServiceTx)(nil).MovieInvalidate), arg0, arg1, arg2, arg3)
+}
+
+// MovieRestore mocks base method.
+func (m *MockServiceTx) MovieRestore(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieRestore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovieRestore indicates an expected call of MovieRestore.
+func (mr *MockServiceTxMockRecorder) MovieRestore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieRestore", reflect.TypeOf((*MockServiceTx)(nil).MovieRestore), arg0, arg1, arg2)
+}
+
+// MovieUpdate mocks base method.
+func (m *MockServiceTx) MovieUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovieUpdate indicates an expected call of MovieUpdate.
+func (mr *MockServiceTxMockRecorder) MovieUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieUpdate", reflect.TypeOf((*MockServiceTx)(nil).MovieUpdate), arg0, arg1, arg2, arg3)
+}
+
+// MoviesCount mocks base method.
+func (m *MockServiceTx) MoviesCount(arg0 context.Context, arg1 repo.ListFilter, arg2 bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoviesCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoviesCount indicates an expected call of MoviesCount.
+func (mr *MockServiceTxMockRecorder) MoviesCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoviesCount", reflect.TypeOf((*MockServiceTx)(nil).MoviesCount), arg0, arg1, arg2)
+}
+
+// MoviesGetAll mocks base method.
+func (m *MockServiceTx) MoviesGetAll(arg0 context.Context, arg1 repo.ListFilter, arg2 repo.ListSort, arg3, arg4 int, arg5 bool) ([]*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoviesGetAll", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoviesGetAll indicates an expected call of MoviesGetAll.
+func (mr *MockServiceTxMockRecorder) MoviesGetAll(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoviesGetAll", reflect.TypeOf((*MockServiceTx)(nil).MoviesGetAll), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// MoviesGetPage mocks base method.
+func (m *MockServiceTx) MoviesGetPage(arg0 context.Context, arg1 repo.ListFilter, arg2 repo.ListSort, arg3 *repo.KeysetPage, arg4 bool) ([]*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoviesGetPage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoviesGetPage indicates an expected call of MoviesGetPage.
+func (mr *MockServiceTxMockRecorder) MoviesGetPage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoviesGetPage", reflect.TypeOf((*MockServiceTx)(nil).MoviesGetPage), arg0, arg1, arg2, arg3, arg4)
+}
+
+// PlaylistCoverThumbnails mocks base method.
+func (m *MockServiceTx) PlaylistCoverThumbnails(arg0 context.Context, arg1, arg2 int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistCoverThumbnails", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistCoverThumbnails indicates an expected call of PlaylistCoverThumbnails.
+func (mr *MockServiceTxMockRecorder) PlaylistCoverThumbnails(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistCoverThumbnails", reflect.TypeOf((*MockServiceTx)(nil).PlaylistCoverThumbnails), arg0, arg1, arg2)
+}
+
+// PlaylistCreate mocks base method.
+func (m *MockServiceTx) PlaylistCreate(arg0 context.Context, arg1 *models.Playlist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaylistCreate indicates an expected call of PlaylistCreate.
+func (mr *MockServiceTxMockRecorder) PlaylistCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistCreate", reflect.TypeOf((*MockServiceTx)(nil).PlaylistCreate), arg0, arg1)
+}
+
+// PlaylistDelete mocks base method.
+func (m *MockServiceTx) PlaylistDelete(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaylistDelete indicates an expected call of PlaylistDelete.
+func (mr *MockServiceTxMockRecorder) PlaylistDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistDelete", reflect.TypeOf((*MockServiceTx)(nil).PlaylistDelete), arg0, arg1)
+}
+
+// PlaylistFilmAdd mocks base method.
+func (m *MockServiceTx) PlaylistFilmAdd(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistFilmAdd", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaylistFilmAdd indicates an expected call of PlaylistFilmAdd.
+func (mr *MockServiceTxMockRecorder) PlaylistFilmAdd(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistFilmAdd", reflect.TypeOf((*MockServiceTx)(nil).PlaylistFilmAdd), arg0, arg1, arg2)
+}
+
+// PlaylistFilmIDs mocks base method.
+func (m *MockServiceTx) PlaylistFilmIDs(arg0 context.Context, arg1 int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistFilmIDs", arg0, arg1)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistFilmIDs indicates an expected call of PlaylistFilmIDs.
+func (mr *MockServiceTxMockRecorder) PlaylistFilmIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistFilmIDs", reflect.TypeOf((*MockServiceTx)(nil).PlaylistFilmIDs), arg0, arg1)
+}
+
+// PlaylistFilmRemove mocks base method.
+func (m *MockServiceTx) PlaylistFilmRemove(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistFilmRemove", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaylistFilmRemove indicates an expected call of PlaylistFilmRemove.
+func (mr *MockServiceTxMockRecorder) PlaylistFilmRemove(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistFilmRemove", reflect.TypeOf((*MockServiceTx)(nil).PlaylistFilmRemove), arg0, arg1, arg2)
+}
+
+// PlaylistFilmsCount mocks base method.
+func (m *MockServiceTx) PlaylistFilmsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistFilmsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistFilmsCount indicates an expected call of PlaylistFilmsCount.
+func (mr *MockServiceTxMockRecorder) PlaylistFilmsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistFilmsCount", reflect.TypeOf((*MockServiceTx)(nil).PlaylistFilmsCount), arg0, arg1)
+}
+
+// PlaylistFilmsGetAll mocks base method.
+func (m *MockServiceTx) PlaylistFilmsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistFilmsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistFilmsGetAll indicates an expected call of PlaylistFilmsGetAll.
+func (mr *MockServiceTxMockRecorder) PlaylistFilmsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistFilmsGetAll", reflect.TypeOf((*MockServiceTx)(nil).PlaylistFilmsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// PlaylistFilmsReorder mocks base method.
+func (m *MockServiceTx) PlaylistFilmsReorder(arg0 context.Context, arg1 int, arg2 []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistFilmsReorder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaylistFilmsReorder indicates an expected call of PlaylistFilmsReorder.
+func (mr *MockServiceTxMockRecorder) PlaylistFilmsReorder(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistFilmsReorder", reflect.TypeOf((*MockServiceTx)(nil).PlaylistFilmsReorder), arg0, arg1, arg2)
+}
+
+// PlaylistGet mocks base method.
+func (m *MockServiceTx) PlaylistGet(arg0 context.Context, arg1 int) (*models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistGet indicates an expected call of PlaylistGet.
+func (mr *MockServiceTxMockRecorder) PlaylistGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistGet", reflect.TypeOf((*MockServiceTx)(nil).PlaylistGet), arg0, arg1)
+}
+
+// PlaylistGetForUpdate mocks base method.
+func (m *MockServiceTx) PlaylistGetForUpdate(arg0 context.Context, arg1 int) (*models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistGetForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(*models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistGetForUpdate indicates an expected call of PlaylistGetForUpdate.
+func (mr *MockServiceTxMockRecorder) PlaylistGetForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistGetForUpdate", reflect.TypeOf((*MockServiceTx)(nil).PlaylistGetForUpdate), arg0, arg1)
+}
+
+// PlaylistUpdate mocks base method.
+func (m *MockServiceTx) PlaylistUpdate(arg0 context.Context, arg1 int, arg2 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaylistUpdate indicates an expected call of PlaylistUpdate.
+func (mr *MockServiceTxMockRecorder) PlaylistUpdate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistUpdate", reflect.TypeOf((*MockServiceTx)(nil).PlaylistUpdate), arg0, arg1, arg2)
+}
+
+// PlaylistsCountByUser mocks base method.
+func (m *MockServiceTx) PlaylistsCountByUser(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistsCountByUser", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistsCountByUser indicates an expected call of PlaylistsCountByUser.
+func (mr *MockServiceTxMockRecorder) PlaylistsCountByUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistsCountByUser", reflect.TypeOf((*MockServiceTx)(nil).PlaylistsCountByUser), arg0, arg1)
+}
+
+// PlaylistsGetAllByUser mocks base method.
+func (m *MockServiceTx) PlaylistsGetAllByUser(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistsGetAllByUser", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaylistsGetAllByUser indicates an expected call of PlaylistsGetAllByUser.
+func (mr *MockServiceTxMockRecorder) PlaylistsGetAllByUser(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistsGetAllByUser", reflect.TypeOf((*MockServiceTx)(nil).PlaylistsGetAllByUser), arg0, arg1, arg2, arg3)
+}
+
+// PostCreate mocks base method.
+func (m *MockServiceTx) PostCreate(arg0 context.Context, arg1 *models.Post, arg2, arg3, arg4, arg5 []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostCreate", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostCreate indicates an expected call of PostCreate.
+func (mr *MockServiceTxMockRecorder) PostCreate(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostCreate", reflect.TypeOf((*MockServiceTx)(nil).PostCreate), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// PostDelete mocks base method.
+func (m *MockServiceTx) PostDelete(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostDelete indicates an expected call of PostDelete.
+func (mr *MockServiceTxMockRecorder) PostDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostDelete", reflect.TypeOf((*MockServiceTx)(nil).PostDelete), arg0, arg1)
+}
+
+// PostGet mocks base method.
+func (m *MockServiceTx) PostGet(arg0 context.Context, arg1 int) (*repo.PostWithReferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostGet", arg0, arg1)
+	ret0, _ := ret[0].(*repo.PostWithReferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostGet indicates an expected call of PostGet.
+func (mr *MockServiceTxMockRecorder) PostGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostGet", reflect.TypeOf((*MockServiceTx)(nil).PostGet), arg0, arg1)
+}
+
+// PostRepliesCount mocks base method.
+func (m *MockServiceTx) PostRepliesCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostRepliesCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostRepliesCount indicates an expected call of PostRepliesCount.
+func (mr *MockServiceTxMockRecorder) PostRepliesCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostRepliesCount", reflect.TypeOf((*MockServiceTx)(nil).PostRepliesCount), arg0, arg1)
+}
+
+// PostRepliesGetAll mocks base method.
+func (m *MockServiceTx) PostRepliesGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*repo.PostWithReferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostRepliesGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*repo.PostWithReferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostRepliesGetAll indicates an expected call of PostRepliesGetAll.
+func (mr *MockServiceTxMockRecorder) PostRepliesGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostRepliesGetAll", reflect.TypeOf((*MockServiceTx)(nil).PostRepliesGetAll), arg0, arg1, arg2, arg3)
+}
+
+// PostRevisionsCount mocks base method.
+func (m *MockServiceTx) PostRevisionsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostRevisionsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostRevisionsCount indicates an expected call of PostRevisionsCount.
+func (mr *MockServiceTxMockRecorder) PostRevisionsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostRevisionsCount", reflect.TypeOf((*MockServiceTx)(nil).PostRevisionsCount), arg0, arg1)
+}
+
+// PostRevisionsGetAll mocks base method.
+func (m *MockServiceTx) PostRevisionsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.PostRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostRevisionsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.PostRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostRevisionsGetAll indicates an expected call of PostRevisionsGetAll.
+func (mr *MockServiceTxMockRecorder) PostRevisionsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostRevisionsGetAll", reflect.TypeOf((*MockServiceTx)(nil).PostRevisionsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// PostUpdate mocks base method.
+func (m *MockServiceTx) PostUpdate(arg0 context.Context, arg1 int, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostUpdate indicates an expected call of PostUpdate.
+func (mr *MockServiceTxMockRecorder) PostUpdate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostUpdate", reflect.TypeOf((*MockServiceTx)(nil).PostUpdate), arg0, arg1, arg2)
+}
+
+// PostsCountByFilm mocks base method.
+func (m *MockServiceTx) PostsCountByFilm(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostsCountByFilm", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostsCountByFilm indicates an expected call of PostsCountByFilm.
+func (mr *MockServiceTxMockRecorder) PostsCountByFilm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostsCountByFilm", reflect.TypeOf((*MockServiceTx)(nil).PostsCountByFilm), arg0, arg1)
+}
+
+// PostsCountBySeries mocks base method.
+func (m *MockServiceTx) PostsCountBySeries(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostsCountBySeries", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostsCountBySeries indicates an expected call of PostsCountBySeries.
+func (mr *MockServiceTxMockRecorder) PostsCountBySeries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostsCountBySeries", reflect.TypeOf((*MockServiceTx)(nil).PostsCountBySeries), arg0, arg1)
+}
+
+// PostsGetAllByFilm mocks base method.
+func (m *MockServiceTx) PostsGetAllByFilm(arg0 context.Context, arg1, arg2, arg3 int) ([]*repo.PostWithReferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostsGetAllByFilm", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*repo.PostWithReferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostsGetAllByFilm indicates an expected call of PostsGetAllByFilm.
+func (mr *MockServiceTxMockRecorder) PostsGetAllByFilm(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostsGetAllByFilm", reflect.TypeOf((*MockServiceTx)(nil).PostsGetAllByFilm), arg0, arg1, arg2, arg3)
+}
+
+// PostsGetAllBySeries mocks base method.
+func (m *MockServiceTx) PostsGetAllBySeries(arg0 context.Context, arg1, arg2, arg3 int) ([]*repo.PostWithReferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostsGetAllBySeries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*repo.PostWithReferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostsGetAllBySeries indicates an expected call of PostsGetAllBySeries.
+func (mr *MockServiceTxMockRecorder) PostsGetAllBySeries(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostsGetAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).PostsGetAllBySeries), arg0, arg1, arg2, arg3)
+}
+
+// ProposalCreate mocks base method.
+func (m *MockServiceTx) ProposalCreate(arg0 context.Context, arg1 *models.Proposal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProposalCreate indicates an expected call of ProposalCreate.
+func (mr *MockServiceTxMockRecorder) ProposalCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalCreate", reflect.TypeOf((*MockServiceTx)(nil).ProposalCreate), arg0, arg1)
+}
+
+// ProposalGet mocks base method.
+func (m *MockServiceTx) ProposalGet(arg0 context.Context, arg1 int) (*models.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposalGet indicates an expected call of ProposalGet.
+func (mr *MockServiceTxMockRecorder) ProposalGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalGet", reflect.TypeOf((*MockServiceTx)(nil).ProposalGet), arg0, arg1)
+}
+
+// ProposalReview mocks base method.
+func (m *MockServiceTx) ProposalReview(arg0 context.Context, arg1, arg2 int, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalReview", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProposalReview indicates an expected call of ProposalReview.
+func (mr *MockServiceTxMockRecorder) ProposalReview(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalReview", reflect.TypeOf((*MockServiceTx)(nil).ProposalReview), arg0, arg1, arg2, arg3)
+}
+
+// ProposalsApprovedCount mocks base method.
+func (m *MockServiceTx) ProposalsApprovedCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalsApprovedCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposalsApprovedCount indicates an expected call of ProposalsApprovedCount.
+func (mr *MockServiceTxMockRecorder) ProposalsApprovedCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalsApprovedCount", reflect.TypeOf((*MockServiceTx)(nil).ProposalsApprovedCount), arg0, arg1)
+}
+
+// ProposalsCount mocks base method.
+func (m *MockServiceTx) ProposalsCount(arg0 context.Context, arg1 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposalsCount indicates an expected call of ProposalsCount.
+func (mr *MockServiceTxMockRecorder) ProposalsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalsCount", reflect.TypeOf((*MockServiceTx)(nil).ProposalsCount), arg0, arg1)
+}
+
+// ProposalsGetAll mocks base method.
+func (m *MockServiceTx) ProposalsGetAll(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*models.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposalsGetAll indicates an expected call of ProposalsGetAll.
+func (mr *MockServiceTxMockRecorder) ProposalsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalsGetAll", reflect.TypeOf((*MockServiceTx)(nil).ProposalsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// SeasonAuditsCount mocks base method.
+func (m *MockServiceTx) SeasonAuditsCount(arg0 context.Context, arg1, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonAuditsCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeasonAuditsCount indicates an expected call of SeasonAuditsCount.
+func (mr *MockServiceTxMockRecorder) SeasonAuditsCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).SeasonAuditsCount), arg0, arg1, arg2)
+}
+
+// SeasonAuditsGetAll mocks base method.
+func (m *MockServiceTx) SeasonAuditsGetAll(arg0 context.Context, arg1, arg2, arg3, arg4 int) ([]*models.SeasonsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonAuditsGetAll", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*models.SeasonsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeasonAuditsGetAll indicates an expected call of SeasonAuditsGetAll.
+func (mr *MockServiceTxMockRecorder) SeasonAuditsGetAll(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).SeasonAuditsGetAll), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SeasonAuditsGetPage mocks base method.
+func (m *MockServiceTx) SeasonAuditsGetPage(arg0 context.Context, arg1, arg2 int, arg3 *repo.KeysetPage) ([]*models.SeasonsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonAuditsGetPage", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.SeasonsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeasonAuditsGetPage indicates an expected call of SeasonAuditsGetPage.
+func (mr *MockServiceTxMockRecorder) SeasonAuditsGetPage(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonAuditsGetPage", reflect.TypeOf((*MockServiceTx)(nil).SeasonAuditsGetPage), arg0, arg1, arg2, arg3)
+}
+
+// SeasonCreateIfNotExists mocks base method.
+func (m *MockServiceTx) SeasonCreateIfNotExists(arg0 context.Context, arg1, arg2, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonCreateIfNotExists", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeasonCreateIfNotExists indicates an expected call of SeasonCreateIfNotExists.
+func (mr *MockServiceTxMockRecorder) SeasonCreateIfNotExists(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonCreateIfNotExists", reflect.TypeOf((*MockServiceTx)(nil).SeasonCreateIfNotExists), arg0, arg1, arg2, arg3)
+}
+
+// SeasonGet mocks base method.
+func (m *MockServiceTx) SeasonGet(arg0 context.Context, arg1, arg2 int, arg3 bool, arg4 ...string) (*models.Season, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SeasonGet", varargs...)
+	ret0, _ := ret[0].(*models.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeasonGet indicates an expected call of SeasonGet.
+func (mr *MockServiceTxMockRecorder) SeasonGet(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonGet", reflect.TypeOf((*MockServiceTx)(nil).SeasonGet), varargs...)
+}
+
+// SeasonInvalidate mocks base method.
+func (m *MockServiceTx) SeasonInvalidate(arg0 context.Context, arg1, arg2, arg3 int, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonInvalidate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeasonInvalidate indicates an expected call of SeasonInvalidate.
+func (mr *MockServiceTxMockRecorder) SeasonInvalidate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonInvalidate", reflect.TypeOf((*MockServiceTx)(nil).SeasonInvalidate), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SeasonPut mocks base method.
+func (m *MockServiceTx) SeasonPut(arg0 context.Context, arg1, arg2, arg3 int, arg4 *models.Season) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonPut", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeasonPut indicates an expected call of SeasonPut.
+func (mr *MockServiceTxMockRecorder) SeasonPut(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonPut", reflect.TypeOf((*MockServiceTx)(nil).SeasonPut), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SeasonRestore mocks base method.
+func (m *MockServiceTx) SeasonRestore(arg0 context.Context, arg1, arg2, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonRestore", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeasonRestore indicates an expected call of SeasonRestore.
+func (mr *MockServiceTxMockRecorder) SeasonRestore(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonRestore", reflect.TypeOf((*MockServiceTx)(nil).SeasonRestore), arg0, arg1, arg2, arg3)
+}
+
+// SeasonUpdate mocks base method.
+func (m *MockServiceTx) SeasonUpdate(arg0 context.Context, arg1, arg2, arg3 int, arg4 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonUpdate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeasonUpdate indicates an expected call of SeasonUpdate.
+func (mr *MockServiceTxMockRecorder) SeasonUpdate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonUpdate", reflect.TypeOf((*MockServiceTx)(nil).SeasonUpdate), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SeasonsCountBySeries mocks base method.
+func (m *MockServiceTx) SeasonsCountBySeries(arg0 context.Context, arg1 int, arg2 bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonsCountBySeries", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeasonsCountBySeries indicates an expected call of SeasonsCountBySeries.
+func (mr *MockServiceTxMockRecorder) SeasonsCountBySeries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonsCountBySeries", reflect.TypeOf((*MockServiceTx)(nil).SeasonsCountBySeries), arg0, arg1, arg2)
+}
+
+// SeasonsGetAllBySeries mocks base method.
+func (m *MockServiceTx) SeasonsGetAllBySeries(arg0 context.Context, arg1, arg2, arg3 int, arg4 bool) ([]*repo.SeasonWithEpisodesCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeasonsGetAllBySeries", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*repo.SeasonWithEpisodesCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeasonsGetAllBySeries indicates an expected call of SeasonsGetAllBySeries.
+func (mr *MockServiceTxMockRecorder) SeasonsGetAllBySeries(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeasonsGetAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).SeasonsGetAllBySeries), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SeriesAuditGet mocks base method.
+func (m *MockServiceTx) SeriesAuditGet(arg0 context.Context, arg1 int, arg2 time.Time) (*models.SeriesesAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesAuditGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.SeriesesAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesAuditGet indicates an expected call of SeriesAuditGet.
+func (mr *MockServiceTxMockRecorder) SeriesAuditGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditGet", reflect.TypeOf((*MockServiceTx)(nil).SeriesAuditGet), arg0, arg1, arg2)
+}
+
+// SeriesAuditsCount mocks base method.
+func (m *MockServiceTx) SeriesAuditsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesAuditsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesAuditsCount indicates an expected call of SeriesAuditsCount.
+func (mr *MockServiceTxMockRecorder) SeriesAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).SeriesAuditsCount), arg0, arg1)
+}
+
+// SeriesAuditsGetAll mocks base method.
+func (m *MockServiceTx) SeriesAuditsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.SeriesesAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesAuditsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.SeriesesAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesAuditsGetAll indicates an expected call of SeriesAuditsGetAll.
+func (mr *MockServiceTxMockRecorder) SeriesAuditsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).SeriesAuditsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// SeriesAuditsGetPage mocks base method.
+func (m *MockServiceTx) SeriesAuditsGetPage(arg0 context.Context, arg1 int, arg2 *repo.KeysetPage) ([]*models.SeriesesAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesAuditsGetPage", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.SeriesesAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesAuditsGetPage indicates an expected call of SeriesAuditsGetPage.
+func (mr *MockServiceTxMockRecorder) SeriesAuditsGetPage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsGetPage", reflect.TypeOf((*MockServiceTx)(nil).SeriesAuditsGetPage), arg0, arg1, arg2)
+}
+
+// SeriesCreate mocks base method.
+func (m *MockServiceTx) SeriesCreate(arg0 context.Context, arg1 int, arg2 *models.Series) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesCreate indicates an expected call of SeriesCreate.
+func (mr *MockServiceTxMockRecorder) SeriesCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesCreate", reflect.TypeOf((*MockServiceTx)(nil).SeriesCreate), arg0, arg1, arg2)
+}
+
+// SeriesGet mocks base method.
+func (m *MockServiceTx) SeriesGet(arg0 context.Context, arg1 int, arg2 bool, arg3 ...string) (*models.Series, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SeriesGet", varargs...)
+	ret0, _ := ret[0].(*models.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesGet indicates an expected call of SeriesGet.
+func (mr *MockServiceTxMockRecorder) SeriesGet(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesGet", reflect.TypeOf((*MockServiceTx)(nil).SeriesGet), varargs...)
+}
+
+// SeriesInvalidate mocks base method.
+func (m *MockServiceTx) SeriesInvalidate(arg0 context.Context, arg1, arg2 int, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesInvalidate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesInvalidate indicates an expected call of SeriesInvalidate.
+func (mr *MockServiceTxMockRecorder) SeriesInvalidate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesInvalidate", reflect.TypeOf((*MockServiceTx)(nil).SeriesInvalidate), arg0, arg1, arg2, arg3)
+}
+
+// SeriesRestore mocks base method.
+func (m *MockServiceTx) SeriesRestore(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesRestore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesRestore indicates an expected call of SeriesRestore.
+func (mr *MockServiceTxMockRecorder) SeriesRestore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesRestore", reflect.TypeOf((*MockServiceTx)(nil).SeriesRestore), arg0, arg1, arg2)
+}
+
+// SeriesUpdate mocks base method.
+func (m *MockServiceTx) SeriesUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesUpdate indicates an expected call of SeriesUpdate.
+func (mr *MockServiceTxMockRecorder) SeriesUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesUpdate", reflect.TypeOf((*MockServiceTx)(nil).SeriesUpdate), arg0, arg1, arg2, arg3)
+}
+
+// SeriesesCount mocks base method.
+func (m *MockServiceTx) SeriesesCount(arg0 context.Context, arg1 repo.ListFilter, arg2 bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesesCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesesCount indicates an expected call of SeriesesCount.
+func (mr *MockServiceTxMockRecorder) SeriesesCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesesCount", reflect.TypeOf((*MockServiceTx)(nil).SeriesesCount), arg0, arg1, arg2)
+}
+
+// SeriesesGetAll mocks base method.
+func (m *MockServiceTx) SeriesesGetAll(arg0 context.Context, arg1 repo.ListFilter, arg2 repo.ListSort, arg3, arg4 int, arg5 bool) ([]*models.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesesGetAll", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]*models.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesesGetAll indicates an expected call of SeriesesGetAll.
+func (mr *MockServiceTxMockRecorder) SeriesesGetAll(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesesGetAll", reflect.TypeOf((*MockServiceTx)(nil).SeriesesGetAll), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Transaction mocks base method.
+func (m *MockServiceTx) Transaction(arg0 context.Context, arg1 func(context.Context, repo.Service) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
 
 // Transaction indicates an expected call of Transaction.
 func (mr *MockServiceTxMockRecorder) Transaction(arg0, arg1 interface{}) *gomock.Call {
@@ -519,6 +1722,65 @@ func (mr *MockServiceTxMockRecorder) Transaction(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockServiceTx)(nil).Transaction), arg0, arg1)
 }
 
+// UserAnonymize mocks base method.
+func (m *MockServiceTx) UserAnonymize(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserAnonymize", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserAnonymize indicates an expected call of UserAnonymize.
+func (mr *MockServiceTxMockRecorder) UserAnonymize(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserAnonymize", reflect.TypeOf((*MockServiceTx)(nil).UserAnonymize), arg0, arg1)
+}
+
+// UserAuditsCount mocks base method.
+func (m *MockServiceTx) UserAuditsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserAuditsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserAuditsCount indicates an expected call of UserAuditsCount.
+func (mr *MockServiceTxMockRecorder) UserAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).UserAuditsCount), arg0, arg1)
+}
+
+// UserAuditsGetAll mocks base method.
+func (m *MockServiceTx) UserAuditsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.UsersAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserAuditsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.UsersAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserAuditsGetAll indicates an expected call of UserAuditsGetAll.
+func (mr *MockServiceTxMockRecorder) UserAuditsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).UserAuditsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// UserAuditsGetPage mocks base method.
+func (m *MockServiceTx) UserAuditsGetPage(arg0 context.Context, arg1 int, arg2 *repo.KeysetPage) ([]*models.UsersAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserAuditsGetPage", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.UsersAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserAuditsGetPage indicates an expected call of UserAuditsGetPage.
+func (mr *MockServiceTxMockRecorder) UserAuditsGetPage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserAuditsGetPage", reflect.TypeOf((*MockServiceTx)(nil).UserAuditsGetPage), arg0, arg1, arg2)
+}
+
 // UserCreate mocks base method.
 func (m *MockServiceTx) UserCreate(arg0 context.Context, arg1 *models.User) error {
 	m.ctrl.T.Helper()
@@ -547,6 +1809,138 @@ func (mr *MockServiceTxMockRecorder) UserDelete(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDelete", reflect.TypeOf((*MockServiceTx)(nil).UserDelete), arg0, arg1)
 }
 
+// UserDeletionCreate mocks base method.
+func (m *MockServiceTx) UserDeletionCreate(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDeletionCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserDeletionCreate indicates an expected call of UserDeletionCreate.
+func (mr *MockServiceTxMockRecorder) UserDeletionCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDeletionCreate", reflect.TypeOf((*MockServiceTx)(nil).UserDeletionCreate), arg0, arg1)
+}
+
+// UserDeletionDelete mocks base method.
+func (m *MockServiceTx) UserDeletionDelete(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDeletionDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserDeletionDelete indicates an expected call of UserDeletionDelete.
+func (mr *MockServiceTxMockRecorder) UserDeletionDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDeletionDelete", reflect.TypeOf((*MockServiceTx)(nil).UserDeletionDelete), arg0, arg1)
+}
+
+// UserDeletionGet mocks base method.
+func (m *MockServiceTx) UserDeletionGet(arg0 context.Context, arg1 int) (*models.UserDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDeletionGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.UserDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserDeletionGet indicates an expected call of UserDeletionGet.
+func (mr *MockServiceTxMockRecorder) UserDeletionGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDeletionGet", reflect.TypeOf((*MockServiceTx)(nil).UserDeletionGet), arg0, arg1)
+}
+
+// UserDeletionsGetAllDue mocks base method.
+func (m *MockServiceTx) UserDeletionsGetAllDue(arg0 context.Context, arg1 time.Time) ([]*models.UserDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDeletionsGetAllDue", arg0, arg1)
+	ret0, _ := ret[0].([]*models.UserDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserDeletionsGetAllDue indicates an expected call of UserDeletionsGetAllDue.
+func (mr *MockServiceTxMockRecorder) UserDeletionsGetAllDue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDeletionsGetAllDue", reflect.TypeOf((*MockServiceTx)(nil).UserDeletionsGetAllDue), arg0, arg1)
+}
+
+// UserFollow mocks base method.
+func (m *MockServiceTx) UserFollow(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserFollow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserFollow indicates an expected call of UserFollow.
+func (mr *MockServiceTxMockRecorder) UserFollow(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserFollow", reflect.TypeOf((*MockServiceTx)(nil).UserFollow), arg0, arg1, arg2)
+}
+
+// UserFollowersCount mocks base method.
+func (m *MockServiceTx) UserFollowersCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserFollowersCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserFollowersCount indicates an expected call of UserFollowersCount.
+func (mr *MockServiceTxMockRecorder) UserFollowersCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserFollowersCount", reflect.TypeOf((*MockServiceTx)(nil).UserFollowersCount), arg0, arg1)
+}
+
+// UserFollowersGetAll mocks base method.
+func (m *MockServiceTx) UserFollowersGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserFollowersGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserFollowersGetAll indicates an expected call of UserFollowersGetAll.
+func (mr *MockServiceTxMockRecorder) UserFollowersGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserFollowersGetAll", reflect.TypeOf((*MockServiceTx)(nil).UserFollowersGetAll), arg0, arg1, arg2, arg3)
+}
+
+// UserFollowingsCount mocks base method.
+func (m *MockServiceTx) UserFollowingsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserFollowingsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserFollowingsCount indicates an expected call of UserFollowingsCount.
+func (mr *MockServiceTxMockRecorder) UserFollowingsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserFollowingsCount", reflect.TypeOf((*MockServiceTx)(nil).UserFollowingsCount), arg0, arg1)
+}
+
+// UserFollowingsGetAll mocks base method.
+func (m *MockServiceTx) UserFollowingsGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserFollowingsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserFollowingsGetAll indicates an expected call of UserFollowingsGetAll.
+func (mr *MockServiceTxMockRecorder) UserFollowingsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserFollowingsGetAll", reflect.TypeOf((*MockServiceTx)(nil).UserFollowingsGetAll), arg0, arg1, arg2, arg3)
+}
+
 // UserGet mocks base method.
 func (m *MockServiceTx) UserGet(arg0 context.Context, arg1 int) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -577,6 +1971,35 @@ func (mr *MockServiceTxMockRecorder) UserGetByEmail(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGetByEmail", reflect.TypeOf((*MockServiceTx)(nil).UserGetByEmail), arg0, arg1)
 }
 
+// UserStatusGet mocks base method.
+func (m *MockServiceTx) UserStatusGet(arg0 context.Context, arg1 int) (*repo.UserStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserStatusGet", arg0, arg1)
+	ret0, _ := ret[0].(*repo.UserStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserStatusGet indicates an expected call of UserStatusGet.
+func (mr *MockServiceTxMockRecorder) UserStatusGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserStatusGet", reflect.TypeOf((*MockServiceTx)(nil).UserStatusGet), arg0, arg1)
+}
+
+// UserUnfollow mocks base method.
+func (m *MockServiceTx) UserUnfollow(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserUnfollow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserUnfollow indicates an expected call of UserUnfollow.
+func (mr *MockServiceTxMockRecorder) UserUnfollow(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserUnfollow", reflect.TypeOf((*MockServiceTx)(nil).UserUnfollow), arg0, arg1, arg2)
+}
+
 // UserUpdate mocks base method.
 func (m *MockServiceTx) UserUpdate(arg0 context.Context, arg1 int, arg2 map[string]interface{}) error {
 	m.ctrl.T.Helper()
@@ -605,3 +2028,62 @@ func (mr *MockServiceTxMockRecorder) UsersCount(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsersCount", reflect.TypeOf((*MockServiceTx)(nil).UsersCount), arg0)
 }
+
+// WatchlistAddPlaylist mocks base method.
+func (m *MockServiceTx) WatchlistAddPlaylist(arg0 context.Context, arg1, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchlistAddPlaylist", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchlistAddPlaylist indicates an expected call of WatchlistAddPlaylist.
+func (mr *MockServiceTxMockRecorder) WatchlistAddPlaylist(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistAddPlaylist", reflect.TypeOf((*MockServiceTx)(nil).WatchlistAddPlaylist), arg0, arg1, arg2)
+}
+
+// WatchlistCount mocks base method.
+func (m *MockServiceTx) WatchlistCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchlistCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchlistCount indicates an expected call of WatchlistCount.
+func (mr *MockServiceTxMockRecorder) WatchlistCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistCount", reflect.TypeOf((*MockServiceTx)(nil).WatchlistCount), arg0, arg1)
+}
+
+// WatchlistGetAll mocks base method.
+func (m *MockServiceTx) WatchlistGetAll(arg0 context.Context, arg1, arg2, arg3 int) ([]*models.Watchlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchlistGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Watchlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchlistGetAll indicates an expected call of WatchlistGetAll.
+func (mr *MockServiceTxMockRecorder) WatchlistGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistGetAll", reflect.TypeOf((*MockServiceTx)(nil).WatchlistGetAll), arg0, arg1, arg2, arg3)
+}
+
+// WatchlistMarkWatched mocks base method.
+func (m *MockServiceTx) WatchlistMarkWatched(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchlistMarkWatched", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchlistMarkWatched indicates an expected call of WatchlistMarkWatched.
+func (mr *MockServiceTxMockRecorder) WatchlistMarkWatched(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistMarkWatched", reflect.TypeOf((*MockServiceTx)(nil).WatchlistMarkWatched), arg0, arg1, arg2)
+}
@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/aria3ppp/watch-server/internal/models"
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -12,12 +13,14 @@ import (
 func (repo *Repository) MovieGet(
 	ctx context.Context,
 	id int,
+	includeInvalidated bool,
 ) (*models.Film, error) {
 	movie, err := models.Films(
 		models.FilmWhere.ID.EQ(id),
 		models.FilmWhere.SeriesID.IsNull(),
 		models.FilmWhere.SeasonNumber.IsNull(),
 		models.FilmWhere.EpisodeNumber.IsNull(),
+		invalidationFilter(models.FilmColumns.Invalidation, includeInvalidated),
 	).One(ctx, repo.exec)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -30,27 +33,66 @@ func (repo *Repository) MovieGet(
 
 func (repo *Repository) MoviesGetAll(
 	ctx context.Context,
+	filter ListFilter,
+	sort ListSort,
 	offset, limit int,
+	includeInvalidated bool,
 ) ([]*models.Film, error) {
-	movies, err := models.Films(
+	mods := []qm.QueryMod{
 		models.FilmWhere.SeriesID.IsNull(),
 		models.FilmWhere.SeasonNumber.IsNull(),
 		models.FilmWhere.EpisodeNumber.IsNull(),
+		invalidationFilter(models.FilmColumns.Invalidation, includeInvalidated),
 		qm.Offset(offset),
 		qm.Limit(limit),
-		qm.OrderBy(models.FilmColumns.ID),
-	).All(ctx, repo.exec)
+	}
+	mods = append(mods, filter.mods()...)
+	mods = append(mods, sort.mods(models.FilmColumns.ID)...)
+	movies, err := models.Films(mods...).All(ctx, repo.exec)
 	if err != nil {
 		return nil, err
 	}
 	return movies, nil
 }
 
-func (repo *Repository) MoviesCount(ctx context.Context) (int, error) {
-	nMovies, err := models.Films(
+// MoviesGetPage is the keyset paginated counterpart of MoviesGetAll, keyed
+// by the sort columns and then the movie id.
+func (repo *Repository) MoviesGetPage(
+	ctx context.Context,
+	filter ListFilter,
+	sort ListSort,
+	page *KeysetPage,
+	includeInvalidated bool,
+) ([]*models.Film, error) {
+	mods := []qm.QueryMod{
+		models.FilmWhere.SeriesID.IsNull(),
+		models.FilmWhere.SeasonNumber.IsNull(),
+		models.FilmWhere.EpisodeNumber.IsNull(),
+		invalidationFilter(models.FilmColumns.Invalidation, includeInvalidated),
+	}
+	mods = append(mods, filter.mods()...)
+	movies, err := models.Films(
+		append(mods, sortedKeyset(sort, models.FilmColumns.ID).mods(page)...)...,
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return keysetRows(movies, page), nil
+}
+
+func (repo *Repository) MoviesCount(
+	ctx context.Context,
+	filter ListFilter,
+	includeInvalidated bool,
+) (int, error) {
+	mods := []qm.QueryMod{
 		models.FilmWhere.SeriesID.IsNull(),
 		models.FilmWhere.SeasonNumber.IsNull(),
 		models.FilmWhere.EpisodeNumber.IsNull(),
+		invalidationFilter(models.FilmColumns.Invalidation, includeInvalidated),
+	}
+	nMovies, err := models.Films(
+		append(mods, filter.mods()...)...,
 	).Count(ctx, repo.exec)
 	return int(nMovies), err
 }
@@ -61,6 +103,7 @@ func (repo *Repository) MovieCreate(
 	movie *models.Film,
 ) error {
 	movie.ContributedBy = contributorID
+	setFilmContributionMetadata(ctx, movie)
 	return movie.Insert(ctx, repo.exec, boil.Infer())
 }
 
@@ -71,17 +114,20 @@ func (repo *Repository) MovieUpdate(
 	cols map[string]any,
 ) error {
 	cols[models.FilmColumns.ContributedBy] = contributorID
-	rowsAff, err := models.Films(
+	mods := []qm.QueryMod{
 		models.FilmWhere.ID.EQ(movieID),
 		models.FilmWhere.SeriesID.IsNull(),
 		models.FilmWhere.SeasonNumber.IsNull(),
 		models.FilmWhere.EpisodeNumber.IsNull(),
-	).UpdateAll(ctx, repo.exec, cols)
+	}
+	rowsAff, err := models.Films(
+		append(mods, ifMatchMod(ctx, models.FilmColumns.ContributedAt))...,
+	).UpdateAll(ctx, repo.exec, withContributionMetadata(ctx, cols))
 	if err != nil {
 		return err
 	}
 	if rowsAff == 0 {
-		return ErrNoRecord
+		return noRowsUpdated(ctx, repo.exec, models.Films(mods...).Exists)
 	}
 	return nil
 }
@@ -92,18 +138,51 @@ func (repo *Repository) MovieInvalidate(
 	contributorID int,
 	invalidation string,
 ) error {
-	rowsAff, err := models.Films(
+	mods := []qm.QueryMod{
 		models.FilmWhere.ID.EQ(movieID),
 		models.FilmWhere.SeriesID.IsNull(),
 		models.FilmWhere.SeasonNumber.IsNull(),
 		models.FilmWhere.EpisodeNumber.IsNull(),
+	}
+	rowsAff, err := models.Films(
+		append(mods, ifMatchMod(ctx, models.FilmColumns.ContributedAt))...,
 	).UpdateAll(
 		ctx,
 		repo.exec,
-		map[string]any{
+		withContributionMetadata(ctx, map[string]any{
 			models.FilmColumns.Invalidation:  invalidation,
 			models.FilmColumns.ContributedBy: contributorID,
-		},
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return noRowsUpdated(ctx, repo.exec, models.Films(mods...).Exists)
+	}
+	return nil
+}
+
+// MovieRestore clears the invalidation of a movie.
+// ErrNoRecord is returned if there's no such invalidated record.
+func (repo *Repository) MovieRestore(
+	ctx context.Context,
+	movieID int,
+	contributorID int,
+) error {
+	rowsAff, err := models.Films(
+		models.FilmWhere.ID.EQ(movieID),
+		models.FilmWhere.SeriesID.IsNull(),
+		models.FilmWhere.SeasonNumber.IsNull(),
+		models.FilmWhere.EpisodeNumber.IsNull(),
+		models.FilmWhere.Invalidation.IsNotNull(),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		withContributionMetadata(ctx, map[string]any{
+			models.FilmColumns.Invalidation:  nil,
+			models.FilmColumns.ContributedBy: contributorID,
+		}),
 	)
 	if err != nil {
 		return err
@@ -121,33 +200,29 @@ func (repo *Repository) MovieAuditsGetAll(
 	id int,
 	offset, limit int,
 ) ([]*models.FilmsAudit, error) {
-	audits, err := models.FilmsAudits(
-		models.FilmsAuditWhere.ID.EQ(id),
-		models.FilmsAuditWhere.SeriesID.IsNull(),
-		models.FilmsAuditWhere.SeasonNumber.IsNull(),
-		models.FilmsAuditWhere.EpisodeNumber.IsNull(),
-		qm.Offset(offset),
-		qm.Limit(limit),
-		qm.OrderBy(models.FilmsAuditColumns.ContributedAt+" desc"),
-	).All(ctx, repo.exec)
-	if err != nil {
-		return nil, err
-	}
-	return audits, nil
+	return MovieAudits.GetAll(ctx, repo.exec, []any{id}, offset, limit)
+}
+
+func (repo *Repository) MovieAuditsGetPage(
+	ctx context.Context,
+	id int,
+	page *KeysetPage,
+) ([]*models.FilmsAudit, error) {
+	return MovieAudits.GetPage(ctx, repo.exec, []any{id}, page)
 }
 
 func (repo *Repository) MovieAuditsCount(
 	ctx context.Context,
 	id int,
 ) (int, error) {
-	auditsCount, err := models.FilmsAudits(
-		models.FilmsAuditWhere.ID.EQ(id),
-		models.FilmsAuditWhere.SeriesID.IsNull(),
-		models.FilmsAuditWhere.SeasonNumber.IsNull(),
-		models.FilmsAuditWhere.EpisodeNumber.IsNull(),
-	).Count(ctx, repo.exec)
-	if err != nil {
-		return 0, err
-	}
-	return int(auditsCount), nil
+	return MovieAudits.Count(ctx, repo.exec, []any{id})
+}
+
+// MovieAuditGet returns the movie audit contributed at contributedAt.
+func (repo *Repository) MovieAuditGet(
+	ctx context.Context,
+	id int,
+	contributedAt time.Time,
+) (*models.FilmsAudit, error) {
+	return MovieAudits.Get(ctx, repo.exec, []any{id}, contributedAt)
 }
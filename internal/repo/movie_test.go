@@ -33,7 +33,7 @@ func TestMovieGet(t *testing.T) {
 
 	// first there's no movie
 
-	fetchedMovie, err := r.MovieGet(ctx, 1)
+	fetchedMovie, err := r.MovieGet(ctx, 1, true)
 	require.Equal(repo.ErrNoRecord, err)
 	require.Nil(fetchedMovie)
 
@@ -44,7 +44,7 @@ func TestMovieGet(t *testing.T) {
 
 	// fetch the movie
 
-	fetchedMovie, err = r.MovieGet(ctx, movie.ID)
+	fetchedMovie, err = r.MovieGet(ctx, movie.ID, true)
 	require.NoError(err)
 
 	testutils.SetTimeLocation(
@@ -73,8 +73,11 @@ func TestMoviesGetAll(t *testing.T) {
 
 	fetchedMovies, err := r.MoviesGetAll(
 		ctx,
+		nil,
+		nil,
 		0,
 		math.MaxInt,
+		true,
 	)
 	require.NoError(err)
 	require.Equal(0, len(fetchedMovies))
@@ -113,8 +116,11 @@ func TestMoviesGetAll(t *testing.T) {
 
 	fetchedMovies, err = r.MoviesGetAll(
 		ctx,
+		nil,
+		nil,
 		0,
 		math.MaxInt,
+		true,
 	)
 	require.NoError(err)
 	require.Equal(len(movies), len(fetchedMovies))
@@ -129,6 +135,268 @@ func TestMoviesGetAll(t *testing.T) {
 	}
 }
 
+func TestMoviesGetAllFilteredSorted(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	users := []*models.User{{Email: "email1"}, {Email: "email2"}}
+	for _, user := range users {
+		err = r.UserCreate(ctx, user)
+		require.NoError(err)
+	}
+	movies := []*models.Film{
+		{
+			Title:        "The Movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+			Duration:     null.IntFrom(90),
+		},
+		{
+			Title:        "another movie",
+			DateReleased: testutils.Date(2001, 1, 1),
+			Duration:     null.IntFrom(150),
+		},
+		{
+			Title:        "100% movie",
+			DateReleased: testutils.Date(2001, 1, 1),
+		},
+		{
+			Title:        "show",
+			DateReleased: testutils.Date(2002, 1, 1),
+			Duration:     null.IntFrom(120),
+		},
+	}
+	for i, m := range movies {
+		err = r.MovieCreate(ctx, users[i%2].ID, m)
+		require.NoError(err)
+	}
+
+	titles := func(movies []*models.Film) []string {
+		var titles []string
+		for _, m := range movies {
+			titles = append(titles, m.Title)
+		}
+		return titles
+	}
+
+	testCases := []struct {
+		name   string
+		filter repo.ListFilter
+		sort   repo.ListSort
+		exp    []string
+	}{
+		{
+			name: "by id by default",
+			exp:  []string{"The Movie", "another movie", "100% movie", "show"},
+		},
+		{
+			name: "title contains case insensitively",
+			filter: repo.ListFilter{
+				{Column: models.FilmColumns.Title, Operator: repo.FilterContains, Value: "MOVIE"},
+			},
+			exp: []string{"The Movie", "another movie", "100% movie"},
+		},
+		{
+			name: "title contains literally",
+			filter: repo.ListFilter{
+				{Column: models.FilmColumns.Title, Operator: repo.FilterContains, Value: "0%"},
+			},
+			exp: []string{"100% movie"},
+		},
+		{
+			name: "duration range",
+			filter: repo.ListFilter{
+				{Column: models.FilmColumns.Duration, Operator: repo.FilterGTE, Value: 90},
+				{Column: models.FilmColumns.Duration, Operator: repo.FilterLTE, Value: 120},
+			},
+			exp: []string{"The Movie", "show"},
+		},
+		{
+			name: "contributor",
+			filter: repo.ListFilter{
+				{Column: models.FilmColumns.ContributedBy, Operator: repo.FilterEQ, Value: users[1].ID},
+			},
+			exp: []string{"another movie", "show"},
+		},
+		{
+			name: "ties broken by id",
+			sort: repo.ListSort{
+				{Column: models.FilmColumns.DateReleased, Desc: true},
+			},
+			exp: []string{"show", "another movie", "100% movie", "The Movie"},
+		},
+		{
+			name: "missing values last",
+			sort: repo.ListSort{
+				{Column: models.FilmColumns.Duration, Desc: true},
+			},
+			exp: []string{"another movie", "show", "The Movie", "100% movie"},
+		},
+		{
+			name: "filtered and sorted",
+			filter: repo.ListFilter{
+				{Column: models.FilmColumns.DateReleased, Operator: repo.FilterGTE, Value: testutils.Date(2001, 1, 1)},
+			},
+			sort: repo.ListSort{
+				{Column: models.FilmColumns.DateReleased},
+				{Column: models.FilmColumns.Title, Desc: true},
+			},
+			exp: []string{"another movie", "100% movie", "show"},
+		},
+	}
+
+	for _, tc := range testCases {
+		fetchedMovies, err := r.MoviesGetAll(
+			ctx,
+			tc.filter,
+			tc.sort,
+			0,
+			math.MaxInt,
+			true,
+		)
+		require.NoError(err, tc.name)
+		require.Equal(tc.exp, titles(fetchedMovies), tc.name)
+
+		count, err := r.MoviesCount(ctx, tc.filter, true)
+		require.NoError(err, tc.name)
+		require.Equal(len(tc.exp), count, tc.name)
+	}
+}
+
+func TestMoviesGetPage(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	durations := []null.Int{
+		{},
+		null.IntFrom(100),
+		{},
+		null.IntFrom(100),
+		null.IntFrom(90),
+	}
+	for _, duration := range durations {
+		err = r.MovieCreate(ctx, user.ID, &models.Film{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+			Duration:     duration,
+		})
+		require.NoError(err)
+	}
+
+	ids := func(movies []*models.Film) []int {
+		var ids []int
+		for _, m := range movies {
+			ids = append(ids, m.ID)
+		}
+		return ids
+	}
+
+	// first page
+
+	movies, err := r.MoviesGetPage(ctx, nil, nil, &repo.KeysetPage{Limit: 2}, true)
+	require.NoError(err)
+	require.Equal([]int{1, 2}, ids(movies))
+
+	// the page after the second movie
+
+	movies, err = r.MoviesGetPage(
+		ctx,
+		nil,
+		nil,
+		&repo.KeysetPage{After: []any{2}, Limit: 2},
+		true,
+	)
+	require.NoError(err)
+	require.Equal([]int{3, 4}, ids(movies))
+
+	// the page before the fifth movie is still in list order
+
+	movies, err = r.MoviesGetPage(
+		ctx,
+		nil,
+		nil,
+		&repo.KeysetPage{After: []any{5}, Backward: true, Limit: 3},
+		true,
+	)
+	require.NoError(err)
+	require.Equal([]int{2, 3, 4}, ids(movies))
+
+	// nothing after the last movie
+
+	movies, err = r.MoviesGetPage(
+		ctx,
+		nil,
+		nil,
+		&repo.KeysetPage{After: []any{5}, Limit: 2},
+		true,
+	)
+	require.NoError(err)
+	require.Equal(0, len(movies))
+
+	// sorted pages are keyed by the sort columns and then the id, missing
+	// values coming last
+
+	sort := repo.ListSort{{Column: models.FilmColumns.Duration, Desc: true}}
+
+	movies, err = r.MoviesGetPage(ctx, nil, sort, &repo.KeysetPage{Limit: 2}, true)
+	require.NoError(err)
+	require.Equal([]int{2, 4}, ids(movies))
+
+	movies, err = r.MoviesGetPage(
+		ctx,
+		nil,
+		sort,
+		&repo.KeysetPage{After: []any{null.IntFrom(100), 4}, Limit: 2},
+		true,
+	)
+	require.NoError(err)
+	require.Equal([]int{5, 1}, ids(movies))
+
+	movies, err = r.MoviesGetPage(
+		ctx,
+		nil,
+		sort,
+		&repo.KeysetPage{After: []any{null.Int{}, 1}, Limit: 2},
+		true,
+	)
+	require.NoError(err)
+	require.Equal([]int{3}, ids(movies))
+
+	movies, err = r.MoviesGetPage(
+		ctx,
+		nil,
+		sort,
+		&repo.KeysetPage{After: []any{null.Int{}, 1}, Backward: true, Limit: 2},
+		true,
+	)
+	require.NoError(err)
+	require.Equal([]int{4, 5}, ids(movies))
+
+	movies, err = r.MoviesGetPage(
+		ctx,
+		nil,
+		sort,
+		&repo.KeysetPage{After: []any{null.IntFrom(90), 5}, Backward: true, Limit: 3},
+		true,
+	)
+	require.NoError(err)
+	require.Equal([]int{2, 4}, ids(movies))
+}
+
 func TestMoviesCount(t *testing.T) {
 	require := require.New(t)
 
@@ -145,7 +413,7 @@ func TestMoviesCount(t *testing.T) {
 
 	// first there's no movie
 
-	nMovies, err := r.MoviesCount(ctx)
+	nMovies, err := r.MoviesCount(ctx, nil, true)
 	require.NoError(err)
 	require.Equal(0, nMovies)
 
@@ -166,7 +434,7 @@ func TestMoviesCount(t *testing.T) {
 
 	// count movies
 
-	nMovies, err = r.MoviesCount(ctx)
+	nMovies, err = r.MoviesCount(ctx, nil, true)
 	require.NoError(err)
 	require.Equal(len(movies), nMovies)
 }
@@ -191,7 +459,7 @@ func TestMovieCreate(t *testing.T) {
 
 	// first there's no movie
 
-	nMovies, err := r.MoviesCount(ctx)
+	nMovies, err := r.MoviesCount(ctx, nil, true)
 	require.NoError(err)
 	require.Equal(0, nMovies)
 
@@ -206,7 +474,7 @@ func TestMovieCreate(t *testing.T) {
 
 	// fetch the movie
 
-	fetchedMovie, err := r.MovieGet(ctx, movie.ID)
+	fetchedMovie, err := r.MovieGet(ctx, movie.ID, true)
 	require.NoError(err)
 
 	testutils.SetTimeLocation(
@@ -266,7 +534,7 @@ func TestMovieUpdate(t *testing.T) {
 
 	// fetch the updated movie
 
-	fetchedUpdatedMovie, err := r.MovieGet(ctx, movie.ID)
+	fetchedUpdatedMovie, err := r.MovieGet(ctx, movie.ID, true)
 	require.NoError(err)
 
 	testutils.SetTimeLocation(
@@ -320,6 +588,84 @@ func TestMovieUpdate(t *testing.T) {
 	)
 }
 
+func TestMovieUpdateIfMatch(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+
+	// a conditional update of no movie misses it still
+
+	err = r.MovieUpdate(
+		repo.WithIfMatch(ctx, time.Now()),
+		movie.ID,
+		user.ID,
+		map[string]any{models.FilmColumns.Title: "title"},
+	)
+	require.Equal(repo.ErrNoRecord, err)
+
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+	fetchedMovie, err := r.MovieGet(ctx, movie.ID, true)
+	require.NoError(err)
+	version := fetchedMovie.ContributedAt
+
+	// update the movie at its version
+
+	err = r.MovieUpdate(
+		repo.WithIfMatch(ctx, version.Add(-time.Hour), version),
+		movie.ID,
+		user.ID,
+		map[string]any{models.FilmColumns.Title: "new title"},
+	)
+	require.NoError(err)
+
+	// the movie isn't at that version anymore
+
+	err = r.MovieUpdate(
+		repo.WithIfMatch(ctx, version),
+		movie.ID,
+		user.ID,
+		map[string]any{models.FilmColumns.Title: "lost update"},
+	)
+	require.Equal(repo.ErrPreconditionFailed, err)
+	err = r.MovieInvalidate(
+		repo.WithIfMatch(ctx, version),
+		movie.ID,
+		user.ID,
+		"invalidation",
+	)
+	require.Equal(repo.ErrPreconditionFailed, err)
+
+	// no version matches no tag
+
+	err = r.MovieUpdate(
+		repo.WithIfMatch(ctx),
+		movie.ID,
+		user.ID,
+		map[string]any{models.FilmColumns.Title: "lost update"},
+	)
+	require.Equal(repo.ErrPreconditionFailed, err)
+
+	fetchedMovie, err = r.MovieGet(ctx, movie.ID, true)
+	require.NoError(err)
+	require.Equal("new title", fetchedMovie.Title)
+	require.False(fetchedMovie.Invalidation.Valid)
+	require.True(fetchedMovie.ContributedAt.After(version))
+}
+
 func TestMovieInvalidate(t *testing.T) {
 	require := require.New(t)
 
@@ -358,7 +704,7 @@ func TestMovieInvalidate(t *testing.T) {
 
 	// check invalidated
 
-	invalidatedMovie, err := r.MovieGet(ctx, movie.ID)
+	invalidatedMovie, err := r.MovieGet(ctx, movie.ID, true)
 	require.NoError(err)
 	require.Equal(null.StringFrom(invalidation), invalidatedMovie.Invalidation)
 
@@ -393,6 +739,69 @@ func TestMovieInvalidate(t *testing.T) {
 	)
 }
 
+func TestMovieRestore(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	// a valid movie is not restorable
+
+	err = r.MovieRestore(ctx, movie.ID, user.ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// invalidated movie is hidden by default
+
+	err = r.MovieInvalidate(ctx, movie.ID, user.ID, "invalidation")
+	require.NoError(err)
+
+	_, err = r.MovieGet(ctx, movie.ID, false)
+	require.Equal(repo.ErrNoRecord, err)
+	movies, err := r.MoviesGetAll(ctx, nil, nil, 0, math.MaxInt, false)
+	require.NoError(err)
+	require.Equal(0, len(movies))
+	count, err := r.MoviesCount(ctx, nil, false)
+	require.NoError(err)
+	require.Equal(0, count)
+
+	movies, err = r.MoviesGetAll(ctx, nil, nil, 0, math.MaxInt, true)
+	require.NoError(err)
+	require.Equal(1, len(movies))
+	count, err = r.MoviesCount(ctx, nil, true)
+	require.NoError(err)
+	require.Equal(1, count)
+
+	// restore movie
+
+	err = r.MovieRestore(ctx, movie.ID, user.ID)
+	require.NoError(err)
+
+	restoredMovie, err := r.MovieGet(ctx, movie.ID, false)
+	require.NoError(err)
+	require.Equal(null.String{}, restoredMovie.Invalidation)
+
+	// both the invalidation and the restore are audited
+
+	audits, err := r.MovieAuditsGetAll(ctx, movie.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(2, len(audits))
+	require.Equal(null.StringFrom("invalidation"), audits[0].Invalidation)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 func TestMovieAuditsGetAll(t *testing.T) {
@@ -605,3 +1014,56 @@ func TestMovieAuditsCount(t *testing.T) {
 	require.NoError(err)
 	require.Equal(len(movieNewVersions), auditsCount)
 }
+
+func TestMovieAuditGet(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	// no audit exists yet
+
+	_, err = r.MovieAuditGet(ctx, movie.ID, movie.ContributedAt)
+	require.Equal(repo.ErrNoRecord, err)
+
+	err = r.MovieUpdate(
+		ctx,
+		movie.ID,
+		user.ID,
+		map[string]any{models.FilmColumns.Title: "new title"},
+	)
+	require.NoError(err)
+
+	audits, err := r.MovieAuditsGetAll(ctx, movie.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(1, len(audits))
+
+	audit, err := r.MovieAuditGet(ctx, movie.ID, audits[0].ContributedAt)
+	require.NoError(err)
+	require.Equal(audits[0], audit)
+	require.Equal("movie", audit.Title)
+
+	// an unknown timestamp is not found
+
+	_, err = r.MovieAuditGet(
+		ctx,
+		movie.ID,
+		audits[0].ContributedAt.Add(time.Second),
+	)
+	require.Equal(repo.ErrNoRecord, err)
+}
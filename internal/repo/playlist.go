@@ -0,0 +1,295 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (repo *Repository) PlaylistGet(
+	ctx context.Context,
+	id int,
+) (*models.Playlist, error) {
+	playlist, err := models.Playlists(
+		models.PlaylistWhere.ID.EQ(id),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return playlist, nil
+}
+
+// PlaylistGetForUpdate fetches a playlist locking it until the transaction
+// ends, so the modifications of the playlist and its films are serialized.
+func (repo *Repository) PlaylistGetForUpdate(
+	ctx context.Context,
+	id int,
+) (*models.Playlist, error) {
+	playlist, err := models.Playlists(
+		models.PlaylistWhere.ID.EQ(id),
+		qm.For("UPDATE"),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return playlist, nil
+}
+
+func (repo *Repository) PlaylistsGetAllByUser(
+	ctx context.Context,
+	userID int,
+	offset, limit int,
+) ([]*models.Playlist, error) {
+	playlists, err := models.Playlists(
+		models.PlaylistWhere.UserID.EQ(userID),
+		qm.Offset(offset),
+		qm.Limit(limit),
+		qm.OrderBy(models.PlaylistColumns.ID),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return playlists, nil
+}
+
+func (repo *Repository) PlaylistsCountByUser(
+	ctx context.Context,
+	userID int,
+) (int, error) {
+	nPlaylists, err := models.Playlists(
+		models.PlaylistWhere.UserID.EQ(userID),
+	).Count(ctx, repo.exec)
+	return int(nPlaylists), err
+}
+
+func (repo *Repository) PlaylistCreate(
+	ctx context.Context,
+	playlist *models.Playlist,
+) error {
+	return playlist.Insert(ctx, repo.exec, boil.Infer())
+}
+
+func (repo *Repository) PlaylistUpdate(
+	ctx context.Context,
+	id int,
+	cols map[string]any,
+) error {
+	rowsAff, err := models.Playlists(
+		models.PlaylistWhere.ID.EQ(id),
+	).UpdateAll(ctx, repo.exec, cols)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+func (repo *Repository) PlaylistDelete(ctx context.Context, id int) error {
+	rowsAff, err := models.Playlists(
+		models.PlaylistWhere.ID.EQ(id),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (repo *Repository) PlaylistFilmsGetAll(
+	ctx context.Context,
+	playlistID int,
+	offset, limit int,
+) ([]*models.Film, error) {
+	films, err := models.Films(
+		qm.Select(models.TableNames.Films+".*"),
+		qm.InnerJoin(
+			fmt.Sprintf(
+				"%[1]s ON %[1]s.%[2]s = %[3]s.%[4]s",
+				models.TableNames.PlaylistFilms,
+				models.PlaylistFilmColumns.FilmID,
+				models.TableNames.Films,
+				models.FilmColumns.ID,
+			),
+		),
+		qm.Where(
+			models.TableNames.PlaylistFilms+"."+models.PlaylistFilmColumns.PlaylistID+" = ?",
+			playlistID,
+		),
+		qm.Offset(offset),
+		qm.Limit(limit),
+		qm.OrderBy(
+			models.TableNames.PlaylistFilms+"."+models.PlaylistFilmColumns.Position,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return films, nil
+}
+
+func (repo *Repository) PlaylistFilmsCount(
+	ctx context.Context,
+	playlistID int,
+) (int, error) {
+	nFilms, err := models.PlaylistFilms(
+		models.PlaylistFilmWhere.PlaylistID.EQ(playlistID),
+	).Count(ctx, repo.exec)
+	return int(nFilms), err
+}
+
+// PlaylistFilmIDs returns the ids of all the playlist films in order.
+func (repo *Repository) PlaylistFilmIDs(
+	ctx context.Context,
+	playlistID int,
+) ([]int, error) {
+	playlistFilms, err := models.PlaylistFilms(
+		qm.Select(models.PlaylistFilmColumns.FilmID),
+		models.PlaylistFilmWhere.PlaylistID.EQ(playlistID),
+		qm.OrderBy(models.PlaylistFilmColumns.Position),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	filmIDs := make([]int, len(playlistFilms))
+	for i, pf := range playlistFilms {
+		filmIDs[i] = pf.FilmID
+	}
+	return filmIDs, nil
+}
+
+// PlaylistFilmAdd appends a film to the end of the playlist. Adding a film
+// already on the playlist is a no-op.
+func (repo *Repository) PlaylistFilmAdd(
+	ctx context.Context,
+	playlistID, filmID int,
+) error {
+	_, err := queries.Raw(
+		fmt.Sprintf(
+			"INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s) "+
+				"SELECT $1, $2, COALESCE(MAX(%[4]s), 0) + 1 FROM %[1]s WHERE %[2]s = $1 "+
+				"ON CONFLICT DO NOTHING",
+			models.TableNames.PlaylistFilms,
+			models.PlaylistFilmColumns.PlaylistID,
+			models.PlaylistFilmColumns.FilmID,
+			models.PlaylistFilmColumns.Position,
+		),
+		playlistID,
+		filmID,
+	).ExecContext(ctx, repo.exec)
+	return err
+}
+
+func (repo *Repository) PlaylistFilmRemove(
+	ctx context.Context,
+	playlistID, filmID int,
+) error {
+	rowsAff, err := models.PlaylistFilms(
+		models.PlaylistFilmWhere.PlaylistID.EQ(playlistID),
+		models.PlaylistFilmWhere.FilmID.EQ(filmID),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// PlaylistFilmsReorder positions the playlist films in the order of filmIDs
+// in a single statement, failing with ErrNoRecord if any of filmIDs is not a
+// playlist film.
+func (repo *Repository) PlaylistFilmsReorder(
+	ctx context.Context,
+	playlistID int,
+	filmIDs []int,
+) error {
+	ids := make(pq.Int64Array, len(filmIDs))
+	for i, id := range filmIDs {
+		ids[i] = int64(id)
+	}
+	result, err := repo.exec.ExecContext(
+		ctx,
+		fmt.Sprintf(
+			"UPDATE %[1]s SET %[2]s = ordered.position "+
+				"FROM unnest($2::INT[]) WITH ORDINALITY AS ordered(film_id, position) "+
+				"WHERE %[1]s.%[3]s = $1 AND %[1]s.%[4]s = ordered.film_id",
+			models.TableNames.PlaylistFilms,
+			models.PlaylistFilmColumns.Position,
+			models.PlaylistFilmColumns.PlaylistID,
+			models.PlaylistFilmColumns.FilmID,
+		),
+		playlistID,
+		ids,
+	)
+	if err != nil {
+		return err
+	}
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAff != int64(len(filmIDs)) {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// PlaylistCoverThumbnails returns up to limit thumbnail keys, the first valid
+// image of each playlist film in playlist order.
+func (repo *Repository) PlaylistCoverThumbnails(
+	ctx context.Context,
+	playlistID int,
+	limit int,
+) ([]string, error) {
+	var rows []struct {
+		ThumbnailURL string `boil:"thumbnail_url"`
+	}
+	err := queries.Raw(
+		fmt.Sprintf(
+			"SELECT m.%[5]s FROM %[1]s pf "+
+				"JOIN LATERAL (SELECT %[5]s FROM %[2]s "+
+				"WHERE %[6]s = pf.%[3]s AND %[7]s = 'image' AND %[5]s IS NOT NULL AND %[8]s IS NULL "+
+				"ORDER BY %[9]s LIMIT 1) m ON TRUE "+
+				"WHERE pf.%[4]s = $1 ORDER BY pf.%[10]s LIMIT $2",
+			models.TableNames.PlaylistFilms,
+			models.TableNames.FilmMediaUrls,
+			models.PlaylistFilmColumns.FilmID,
+			models.PlaylistFilmColumns.PlaylistID,
+			models.FilmMediaURLColumns.ThumbnailURL,
+			models.FilmMediaURLColumns.FilmID,
+			models.FilmMediaURLColumns.MediaType,
+			models.FilmMediaURLColumns.Invalidation,
+			models.FilmMediaURLColumns.ID,
+			models.PlaylistFilmColumns.Position,
+		),
+		playlistID,
+		limit,
+	).Bind(ctx, repo.exec, &rows)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, len(rows))
+	for i, row := range rows {
+		keys[i] = row.ThumbnailURL
+	}
+	return keys, nil
+}
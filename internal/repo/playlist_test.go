@@ -0,0 +1,195 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestPlaylistFilms(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	playlist := &models.Playlist{UserID: user.ID, Title: "playlist"}
+	err = r.PlaylistCreate(ctx, playlist)
+	require.NoError(err)
+
+	movies := make([]*models.Film, 3)
+	for i := range movies {
+		movies[i] = &models.Film{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		}
+		err = r.MovieCreate(ctx, user.ID, movies[i])
+		require.NoError(err)
+	}
+
+	// first there's no films
+
+	films, err := r.PlaylistFilmsGetAll(ctx, playlist.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(0, len(films))
+
+	// films are appended in order and adding twice is a no-op
+
+	for _, movie := range movies {
+		err = r.PlaylistFilmAdd(ctx, playlist.ID, movie.ID)
+		require.NoError(err)
+	}
+	err = r.PlaylistFilmAdd(ctx, playlist.ID, movies[0].ID)
+	require.NoError(err)
+
+	filmIDs, err := r.PlaylistFilmIDs(ctx, playlist.ID)
+	require.NoError(err)
+	require.Equal([]int{movies[0].ID, movies[1].ID, movies[2].ID}, filmIDs)
+
+	nFilms, err := r.PlaylistFilmsCount(ctx, playlist.ID)
+	require.NoError(err)
+	require.Equal(len(movies), nFilms)
+
+	// reorder films
+
+	reordered := []int{movies[2].ID, movies[0].ID, movies[1].ID}
+	err = r.PlaylistFilmsReorder(ctx, playlist.ID, reordered)
+	require.NoError(err)
+
+	films, err = r.PlaylistFilmsGetAll(ctx, playlist.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(len(reordered), len(films))
+	for i, film := range films {
+		require.Equal(reordered[i], film.ID)
+	}
+
+	// reordering a film not in the playlist is rolled back with the others
+
+	err = r.Transaction(ctx, func(ctx context.Context, tx repo.Service) error {
+		locked, err := tx.PlaylistGetForUpdate(ctx, playlist.ID)
+		require.NoError(err)
+		require.Equal(playlist.ID, locked.ID)
+		return tx.PlaylistFilmsReorder(
+			ctx,
+			playlist.ID,
+			[]int{movies[0].ID, movies[1].ID, movies[2].ID + 100},
+		)
+	})
+	require.Equal(repo.ErrNoRecord, err)
+
+	filmIDs, err = r.PlaylistFilmIDs(ctx, playlist.ID)
+	require.NoError(err)
+	require.Equal(reordered, filmIDs)
+
+	// remove a film
+
+	err = r.PlaylistFilmRemove(ctx, playlist.ID, movies[0].ID)
+	require.NoError(err)
+	err = r.PlaylistFilmRemove(ctx, playlist.ID, movies[0].ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	filmIDs, err = r.PlaylistFilmIDs(ctx, playlist.ID)
+	require.NoError(err)
+	require.Equal([]int{movies[2].ID, movies[1].ID}, filmIDs)
+
+	// add the playlist to the watchlist
+
+	nAdded, err := r.WatchlistAddPlaylist(ctx, user.ID, playlist.ID)
+	require.NoError(err)
+	require.Equal(2, nAdded)
+	nAdded, err = r.WatchlistAddPlaylist(ctx, user.ID, playlist.ID)
+	require.NoError(err)
+	require.Equal(0, nAdded)
+
+	watchlist, err := r.WatchlistGetAll(ctx, user.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(2, len(watchlist))
+
+	nWatchlist, err := r.WatchlistCount(ctx, user.ID)
+	require.NoError(err)
+	require.Equal(2, nWatchlist)
+
+	// deleting the playlist removes its films
+
+	err = r.PlaylistDelete(ctx, playlist.ID)
+	require.NoError(err)
+	err = r.PlaylistDelete(ctx, playlist.ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	nFilms, err = r.PlaylistFilmsCount(ctx, playlist.ID)
+	require.NoError(err)
+	require.Equal(0, nFilms)
+}
+
+func TestPlaylistCoverThumbnails(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	playlist := &models.Playlist{UserID: user.ID, Title: "playlist"}
+	err = r.PlaylistCreate(ctx, playlist)
+	require.NoError(err)
+
+	// three films: with two images, with a video only and with one image
+	thumbnails := [][]string{{"a_thumb", "b_thumb"}, nil, {"c_thumb"}}
+	for _, filmThumbnails := range thumbnails {
+		movie := &models.Film{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		}
+		err = r.MovieCreate(ctx, user.ID, movie)
+		require.NoError(err)
+		err = r.PlaylistFilmAdd(ctx, playlist.ID, movie.ID)
+		require.NoError(err)
+
+		if filmThumbnails == nil {
+			err = r.FilmMediaCreate(ctx, user.ID, &models.FilmMediaURL{
+				FilmID:    movie.ID,
+				MediaURL:  "video",
+				MediaType: "video",
+				MimeType:  "video/mp4",
+			})
+			require.NoError(err)
+		}
+		for _, thumbnail := range filmThumbnails {
+			err = r.FilmMediaCreate(ctx, user.ID, &models.FilmMediaURL{
+				FilmID:       movie.ID,
+				MediaURL:     "image",
+				MediaType:    "image",
+				MimeType:     "image/png",
+				ThumbnailURL: null.StringFrom(thumbnail),
+			})
+			require.NoError(err)
+		}
+	}
+
+	keys, err := r.PlaylistCoverThumbnails(ctx, playlist.ID, 4)
+	require.NoError(err)
+	require.Equal([]string{"a_thumb", "c_thumb"}, keys)
+
+	keys, err = r.PlaylistCoverThumbnails(ctx, playlist.ID, 1)
+	require.NoError(err)
+	require.Equal([]string{"a_thumb"}, keys)
+}
@@ -0,0 +1,314 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/lib/pq"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// PostWithReferences is a post along with the ids of the films, serieses,
+// users and artists it references.
+type PostWithReferences struct {
+	models.Post `boil:",bind"`
+	FilmIDs     pq.Int64Array `boil:"film_ids" json:"film_ids"`
+	SeriesIDs   pq.Int64Array `boil:"series_ids" json:"series_ids"`
+	UserIDs     pq.Int64Array `boil:"user_ids" json:"user_ids"`
+	ArtistIDs   pq.Int64Array `boil:"artist_ids" json:"artist_ids"`
+}
+
+// postsWithReferences selects the posts filtered by mods along with their
+// references, newest first.
+func (repo *Repository) postsWithReferences(
+	ctx context.Context,
+	mods ...qm.QueryMod,
+) ([]*PostWithReferences, error) {
+	references := func(table, postIDColumn, idColumn, alias string) string {
+		return fmt.Sprintf(
+			"ARRAY(SELECT %[3]s FROM %[1]s WHERE %[1]s.%[2]s = %[4]s.%[5]s ORDER BY %[3]s) AS %[6]s",
+			table,
+			postIDColumn,
+			idColumn,
+			models.TableNames.Posts,
+			models.PostColumns.ID,
+			alias,
+		)
+	}
+	mods = append(
+		[]qm.QueryMod{
+			qm.Select(
+				models.TableNames.Posts+".*",
+				references(
+					models.TableNames.PostFilms,
+					"post_id",
+					"film_id",
+					"film_ids",
+				),
+				references(
+					models.TableNames.PostSerieses,
+					"post_id",
+					"series_id",
+					"series_ids",
+				),
+				references(
+					models.TableNames.PostUsers,
+					"post_id",
+					"user_id",
+					"user_ids",
+				),
+				references(
+					models.TableNames.PostArtists,
+					"post_id",
+					"artist_id",
+					"artist_ids",
+				),
+			),
+			qm.OrderBy(
+				models.TableNames.Posts + "." + models.PostColumns.PostedAt + " DESC, " +
+					models.TableNames.Posts + "." + models.PostColumns.ID + " DESC",
+			),
+		},
+		mods...,
+	)
+	var posts []*PostWithReferences
+	err := models.Posts(mods...).Bind(ctx, repo.exec, &posts)
+	if err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// postsReferencing filters posts referencing id on the references table.
+func postsReferencing(table, idColumn string, id int) qm.QueryMod {
+	return qm.Where(
+		fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %[1]s WHERE %[1]s.post_id = %[2]s.%[3]s AND %[1]s.%[4]s = ?)",
+			table,
+			models.TableNames.Posts,
+			models.PostColumns.ID,
+			idColumn,
+		),
+		id,
+	)
+}
+
+func (repo *Repository) PostGet(
+	ctx context.Context,
+	id int,
+) (*PostWithReferences, error) {
+	posts, err := repo.postsWithReferences(
+		ctx,
+		models.PostWhere.ID.EQ(id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(posts) == 0 {
+		return nil, ErrNoRecord
+	}
+	return posts[0], nil
+}
+
+func (repo *Repository) PostsGetAllByFilm(
+	ctx context.Context,
+	filmID int,
+	offset, limit int,
+) ([]*PostWithReferences, error) {
+	return repo.postsWithReferences(
+		ctx,
+		postsReferencing(models.TableNames.PostFilms, "film_id", filmID),
+		qm.Offset(offset),
+		qm.Limit(limit),
+	)
+}
+
+func (repo *Repository) PostsCountByFilm(
+	ctx context.Context,
+	filmID int,
+) (int, error) {
+	nPosts, err := models.Posts(
+		postsReferencing(models.TableNames.PostFilms, "film_id", filmID),
+	).Count(ctx, repo.exec)
+	return int(nPosts), err
+}
+
+func (repo *Repository) PostsGetAllBySeries(
+	ctx context.Context,
+	seriesID int,
+	offset, limit int,
+) ([]*PostWithReferences, error) {
+	return repo.postsWithReferences(
+		ctx,
+		postsReferencing(models.TableNames.PostSerieses, "series_id", seriesID),
+		qm.Offset(offset),
+		qm.Limit(limit),
+	)
+}
+
+func (repo *Repository) PostsCountBySeries(
+	ctx context.Context,
+	seriesID int,
+) (int, error) {
+	nPosts, err := models.Posts(
+		postsReferencing(models.TableNames.PostSerieses, "series_id", seriesID),
+	).Count(ctx, repo.exec)
+	return int(nPosts), err
+}
+
+func (repo *Repository) PostRepliesGetAll(
+	ctx context.Context,
+	postID int,
+	offset, limit int,
+) ([]*PostWithReferences, error) {
+	return repo.postsWithReferences(
+		ctx,
+		models.PostWhere.PostID.EQ(null.IntFrom(postID)),
+		qm.Offset(offset),
+		qm.Limit(limit),
+	)
+}
+
+func (repo *Repository) PostRepliesCount(
+	ctx context.Context,
+	postID int,
+) (int, error) {
+	nReplies, err := models.Posts(
+		models.PostWhere.PostID.EQ(null.IntFrom(postID)),
+	).Count(ctx, repo.exec)
+	return int(nReplies), err
+}
+
+// PostCreate inserts the post and its references, duplicate references are
+// ignored.
+func (repo *Repository) PostCreate(
+	ctx context.Context,
+	post *models.Post,
+	filmIDs, seriesIDs, userIDs, artistIDs []int,
+) error {
+	err := post.Insert(ctx, repo.exec, boil.Infer())
+	if err != nil {
+		return err
+	}
+	for _, refs := range []struct {
+		table    string
+		idColumn string
+		ids      []int
+	}{
+		{models.TableNames.PostFilms, "film_id", filmIDs},
+		{models.TableNames.PostSerieses, "series_id", seriesIDs},
+		{models.TableNames.PostUsers, "user_id", userIDs},
+		{models.TableNames.PostArtists, "artist_id", artistIDs},
+	} {
+		if len(refs.ids) == 0 {
+			continue
+		}
+		ids := make(pq.Int64Array, len(refs.ids))
+		for i, id := range refs.ids {
+			ids[i] = int64(id)
+		}
+		_, err = queries.Raw(
+			fmt.Sprintf(
+				"INSERT INTO %s (post_id, %s) SELECT $1, UNNEST($2::INT[]) "+
+					"ON CONFLICT DO NOTHING",
+				refs.table,
+				refs.idColumn,
+			),
+			post.ID,
+			ids,
+		).ExecContext(ctx, repo.exec)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostUpdate replaces the post body. The previous body is kept as a revision.
+func (repo *Repository) PostUpdate(
+	ctx context.Context,
+	id int,
+	body string,
+) error {
+	rowsAff, err := models.Posts(
+		models.PostWhere.ID.EQ(id),
+	).UpdateAll(ctx, repo.exec, models.M{models.PostColumns.Body: body})
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// PostDelete leaves a tombstone of the post: its body is cleared along with
+// its revisions and references, and the row is kept for its replies.
+func (repo *Repository) PostDelete(ctx context.Context, id int) error {
+	rowsAff, err := models.Posts(
+		models.PostWhere.ID.EQ(id),
+		models.PostWhere.DeletedAt.IsNull(),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		models.M{
+			models.PostColumns.Body:      "",
+			models.PostColumns.DeletedAt: null.TimeFrom(time.Now()),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	for _, table := range []string{
+		models.TableNames.PostRevisions,
+		models.TableNames.PostFilms,
+		models.TableNames.PostSerieses,
+		models.TableNames.PostUsers,
+		models.TableNames.PostArtists,
+	} {
+		_, err = queries.Raw(
+			fmt.Sprintf("DELETE FROM %s WHERE post_id = $1", table),
+			id,
+		).ExecContext(ctx, repo.exec)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostRevisionsGetAll returns the previous bodies of the post, latest first.
+func (repo *Repository) PostRevisionsGetAll(
+	ctx context.Context,
+	postID int,
+	offset, limit int,
+) ([]*models.PostRevision, error) {
+	revisions, err := models.PostRevisions(
+		models.PostRevisionWhere.PostID.EQ(postID),
+		qm.Offset(offset),
+		qm.Limit(limit),
+		qm.OrderBy(models.PostRevisionColumns.RevisedAt+" DESC"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+func (repo *Repository) PostRevisionsCount(
+	ctx context.Context,
+	postID int,
+) (int, error) {
+	nRevisions, err := models.PostRevisions(
+		models.PostRevisionWhere.PostID.EQ(postID),
+	).Count(ctx, repo.exec)
+	return int(nRevisions), err
+}
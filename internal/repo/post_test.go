@@ -0,0 +1,168 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestPosts(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	mentioned := &models.User{Email: "mentioned"}
+	err = r.UserCreate(ctx, mentioned)
+	require.NoError(err)
+
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+	series := &models.Series{
+		Title:       "series",
+		DateStarted: testutils.Date(2000, 1, 1),
+	}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+	artist := &models.Artist{FirstName: "artist"}
+	err = r.ArtistCreate(ctx, user.ID, artist)
+	require.NoError(err)
+
+	// first there's no post
+
+	_, err = r.PostGet(ctx, 1)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// create a post, duplicate references are ignored
+
+	post := &models.Post{UserID: user.ID, Body: "body"}
+	err = r.PostCreate(
+		ctx,
+		post,
+		[]int{movie.ID, movie.ID},
+		[]int{series.ID},
+		[]int{mentioned.ID},
+		[]int{artist.ID, artist.ID},
+	)
+	require.NoError(err)
+
+	gotPost, err := r.PostGet(ctx, post.ID)
+	require.NoError(err)
+	require.Equal(post.Body, gotPost.Body)
+	require.False(gotPost.EditedAt.Valid)
+	require.Equal(pq.Int64Array{int64(movie.ID)}, gotPost.FilmIDs)
+	require.Equal(pq.Int64Array{int64(series.ID)}, gotPost.SeriesIDs)
+	require.Equal(pq.Int64Array{int64(mentioned.ID)}, gotPost.UserIDs)
+	require.Equal(pq.Int64Array{int64(artist.ID)}, gotPost.ArtistIDs)
+
+	// reply to the post
+
+	reply := &models.Post{
+		UserID: mentioned.ID,
+		PostID: null.IntFrom(post.ID),
+		Body:   "reply",
+	}
+	err = r.PostCreate(ctx, reply, []int{movie.ID}, nil, nil, nil)
+	require.NoError(err)
+
+	replies, err := r.PostRepliesGetAll(ctx, post.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(1, len(replies))
+	require.Equal(reply.ID, replies[0].ID)
+	require.Equal(pq.Int64Array{}, replies[0].SeriesIDs)
+	require.Equal(pq.Int64Array{}, replies[0].ArtistIDs)
+
+	nReplies, err := r.PostRepliesCount(ctx, post.ID)
+	require.NoError(err)
+	require.Equal(1, nReplies)
+
+	// list posts referencing the movie and the series, newest first
+
+	moviePosts, err := r.PostsGetAllByFilm(ctx, movie.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(2, len(moviePosts))
+	require.Equal(reply.ID, moviePosts[0].ID)
+	require.Equal(post.ID, moviePosts[1].ID)
+
+	nMoviePosts, err := r.PostsCountByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(2, nMoviePosts)
+
+	seriesPosts, err := r.PostsGetAllBySeries(ctx, series.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(1, len(seriesPosts))
+	require.Equal(post.ID, seriesPosts[0].ID)
+
+	nSeriesPosts, err := r.PostsCountBySeries(ctx, series.ID)
+	require.NoError(err)
+	require.Equal(1, nSeriesPosts)
+
+	// editing the post keeps the previous bodies
+
+	err = r.PostUpdate(ctx, post.ID, "edited body")
+	require.NoError(err)
+	err = r.PostUpdate(ctx, post.ID, "edited body again")
+	require.NoError(err)
+
+	gotPost, err = r.PostGet(ctx, post.ID)
+	require.NoError(err)
+	require.Equal("edited body again", gotPost.Body)
+	require.True(gotPost.EditedAt.Valid)
+
+	revisions, err := r.PostRevisionsGetAll(ctx, post.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(2, len(revisions))
+	require.Equal("edited body", revisions[0].Body)
+	require.Equal("body", revisions[1].Body)
+
+	nRevisions, err := r.PostRevisionsCount(ctx, post.ID)
+	require.NoError(err)
+	require.Equal(2, nRevisions)
+
+	// deleting the post leaves a tombstone keeping its replies
+
+	err = r.PostDelete(ctx, post.ID)
+	require.NoError(err)
+	err = r.PostDelete(ctx, post.ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	gotPost, err = r.PostGet(ctx, post.ID)
+	require.NoError(err)
+	require.Empty(gotPost.Body)
+	require.True(gotPost.DeletedAt.Valid)
+	require.Equal(pq.Int64Array{}, gotPost.FilmIDs)
+	require.Equal(pq.Int64Array{}, gotPost.SeriesIDs)
+	require.Equal(pq.Int64Array{}, gotPost.UserIDs)
+	require.Equal(pq.Int64Array{}, gotPost.ArtistIDs)
+
+	nRevisions, err = r.PostRevisionsCount(ctx, post.ID)
+	require.NoError(err)
+	require.Equal(0, nRevisions)
+
+	replies, err = r.PostRepliesGetAll(ctx, post.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(1, len(replies))
+	require.Equal(reply.ID, replies[0].ID)
+
+	nSeriesPosts, err = r.PostsCountBySeries(ctx, series.ID)
+	require.NoError(err)
+	require.Equal(0, nSeriesPosts)
+}
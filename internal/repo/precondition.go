@@ -0,0 +1,85 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ifMatchKey struct{}
+
+// WithIfMatch makes the updates of films and serieses done through the
+// returned context conditional on the updated record still being at one of
+// versions, told by its contribution time. The updates of records found at
+// other versions fail with ErrPreconditionFailed.
+func WithIfMatch(ctx context.Context, versions ...time.Time) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, versions)
+}
+
+func ifMatchVersions(ctx context.Context) (versions []time.Time, isConditional bool) {
+	versions, isConditional = ctx.Value(ifMatchKey{}).([]time.Time)
+	return versions, isConditional
+}
+
+// Conditional reports whether ctx carries a precondition.
+func Conditional(ctx context.Context) bool {
+	_, isConditional := ifMatchVersions(ctx)
+	return isConditional
+}
+
+// IfMatch reports whether a record at version meets the precondition of
+// ctx, true if ctx carries none.
+func IfMatch(ctx context.Context, version time.Time) bool {
+	versions, isConditional := ifMatchVersions(ctx)
+	if !isConditional {
+		return true
+	}
+	for _, v := range versions {
+		if v.Equal(version) {
+			return true
+		}
+	}
+	return false
+}
+
+// ifMatchMod restricts an update to the records at the versions ctx is
+// conditional on, told by their column of contribution time.
+func ifMatchMod(ctx context.Context, column string) qm.QueryMod {
+	versions, isConditional := ifMatchVersions(ctx)
+	if !isConditional {
+		return qm.QueryModFunc(func(*queries.Query) {})
+	}
+	args := make([]any, len(versions))
+	for i, version := range versions {
+		args[i] = version
+	}
+	// no version matches an empty list
+	if len(args) == 0 {
+		return qm.Where("FALSE")
+	}
+	return qm.WhereIn(column+" IN ?", args...)
+}
+
+// noRowsUpdated tells why an update found no row to update: ErrNoRecord if
+// there's no such record per exists, ErrPreconditionFailed if the update was
+// conditional and the record isn't at the versions asked for.
+func noRowsUpdated(
+	ctx context.Context,
+	exec boil.ContextExecutor,
+	exists func(context.Context, boil.ContextExecutor) (bool, error),
+) error {
+	if _, isConditional := ifMatchVersions(ctx); !isConditional {
+		return ErrNoRecord
+	}
+	found, err := exists(ctx, exec)
+	if err != nil {
+		return err
+	}
+	if found {
+		return ErrPreconditionFailed
+	}
+	return ErrNoRecord
+}
@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Proposal kinds
+const (
+	ProposalKindMovieUpdate       = "movie_update"
+	ProposalKindEpisodePut        = "episode_put"
+	ProposalKindEpisodeUpdate     = "episode_update"
+	ProposalKindSeasonEpisodesPut = "season_episodes_put"
+	ProposalKindMovieRevert       = "movie_revert"
+	ProposalKindEpisodeRevert     = "episode_revert"
+)
+
+// Proposal statuses
+const (
+	ProposalStatusPending  = "pending"
+	ProposalStatusApproved = "approved"
+	ProposalStatusRejected = "rejected"
+)
+
+func (repo *Repository) ProposalGet(
+	ctx context.Context,
+	id int,
+) (*models.Proposal, error) {
+	proposal, err := models.Proposals(
+		models.ProposalWhere.ID.EQ(id),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return proposal, nil
+}
+
+// ProposalsGetAll returns the proposals of status, oldest first.
+func (repo *Repository) ProposalsGetAll(
+	ctx context.Context,
+	status string,
+	offset, limit int,
+) ([]*models.Proposal, error) {
+	proposals, err := models.Proposals(
+		models.ProposalWhere.Status.EQ(status),
+		qm.Offset(offset),
+		qm.Limit(limit),
+		qm.OrderBy(
+			models.ProposalColumns.ProposedAt+", "+models.ProposalColumns.ID,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return proposals, nil
+}
+
+func (repo *Repository) ProposalsCount(
+	ctx context.Context,
+	status string,
+) (int, error) {
+	count, err := models.Proposals(
+		models.ProposalWhere.Status.EQ(status),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// ProposalsApprovedCount returns the number of proposals of userID approved
+// by the moderators.
+func (repo *Repository) ProposalsApprovedCount(
+	ctx context.Context,
+	userID int,
+) (int, error) {
+	count, err := models.Proposals(
+		models.ProposalWhere.ProposedBy.EQ(userID),
+		models.ProposalWhere.Status.EQ(ProposalStatusApproved),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (repo *Repository) ProposalCreate(
+	ctx context.Context,
+	proposal *models.Proposal,
+) error {
+	return proposal.Insert(ctx, repo.exec, boil.Infer())
+}
+
+// ProposalReview sets the status of a pending proposal as decided by
+// reviewerID.
+func (repo *Repository) ProposalReview(
+	ctx context.Context,
+	id int,
+	reviewerID int,
+	status string,
+) error {
+	rowsAff, err := models.Proposals(
+		models.ProposalWhere.ID.EQ(id),
+		models.ProposalWhere.Status.EQ(ProposalStatusPending),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		map[string]any{
+			models.ProposalColumns.Status:     status,
+			models.ProposalColumns.ReviewedBy: null.IntFrom(reviewerID),
+			models.ProposalColumns.ReviewedAt: null.TimeFrom(time.Now()),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestProposal(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	users := []*models.User{{Email: "email1"}, {Email: "email2"}}
+	for _, u := range users {
+		err := r.UserCreate(ctx, u)
+		require.NoError(err)
+	}
+	movie := &models.Film{Title: "movie", DateReleased: testutils.Date(2000, 1, 1)}
+	err = r.MovieCreate(ctx, users[0].ID, movie)
+	require.NoError(err)
+
+	// propose two edits by the second user
+
+	proposals := []*models.Proposal{
+		{
+			Kind:       repo.ProposalKindMovieUpdate,
+			FilmID:     null.IntFrom(movie.ID),
+			Changes:    []byte(`{"title":"first"}`),
+			ProposedBy: users[1].ID,
+		},
+		{
+			Kind:       repo.ProposalKindMovieUpdate,
+			FilmID:     null.IntFrom(movie.ID),
+			Changes:    []byte(`{"title":"second"}`),
+			ProposedBy: users[1].ID,
+		},
+	}
+	for _, p := range proposals {
+		err := r.ProposalCreate(ctx, p)
+		require.NoError(err)
+		require.Equal(repo.ProposalStatusPending, p.Status)
+	}
+
+	// both are pending
+
+	pending, err := r.ProposalsGetAll(ctx, repo.ProposalStatusPending, 0, 10)
+	require.NoError(err)
+	require.Len(pending, 2)
+	require.Equal(proposals[0].ID, pending[0].ID)
+	require.Equal(proposals[1].ID, pending[1].ID)
+	count, err := r.ProposalsCount(ctx, repo.ProposalStatusPending)
+	require.NoError(err)
+	require.Equal(2, count)
+
+	// review the first one
+
+	err = r.ProposalReview(
+		ctx,
+		proposals[0].ID,
+		users[0].ID,
+		repo.ProposalStatusApproved,
+	)
+	require.NoError(err)
+
+	approved, err := r.ProposalGet(ctx, proposals[0].ID)
+	require.NoError(err)
+	require.Equal(repo.ProposalStatusApproved, approved.Status)
+	require.Equal(null.IntFrom(users[0].ID), approved.ReviewedBy)
+	require.True(approved.ReviewedAt.Valid)
+
+	count, err = r.ProposalsCount(ctx, repo.ProposalStatusPending)
+	require.NoError(err)
+	require.Equal(1, count)
+
+	// only the approved proposals count to the trust of their proposer
+
+	count, err = r.ProposalsApprovedCount(ctx, users[1].ID)
+	require.NoError(err)
+	require.Equal(1, count)
+	count, err = r.ProposalsApprovedCount(ctx, users[0].ID)
+	require.NoError(err)
+	require.Equal(0, count)
+
+	// a reviewed proposal could not be reviewed again
+
+	err = r.ProposalReview(
+		ctx,
+		proposals[0].ID,
+		users[0].ID,
+		repo.ProposalStatusRejected,
+	)
+	require.Equal(repo.ErrNoRecord, err)
+
+	_, err = r.ProposalGet(ctx, proposals[1].ID+1)
+	require.Equal(repo.ErrNoRecord, err)
+}
@@ -3,8 +3,11 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 //go:generate mockgen -destination mock_repo/mock_service.go . ServiceTx
@@ -25,14 +28,133 @@ type Service interface {
 	UserCreate(ctx context.Context, user *models.User) error
 	UserUpdate(ctx context.Context, id int, columns map[string]any) error
 	UserDelete(ctx context.Context, id int) error
+	UserAuditsGetAll(
+		ctx context.Context,
+		id int,
+		offset, limit int,
+	) ([]*models.UsersAudit, error)
+	UserAuditsGetPage(
+		ctx context.Context,
+		id int,
+		page *KeysetPage,
+	) ([]*models.UsersAudit, error)
+	UserAuditsCount(ctx context.Context, id int) (int, error)
+
+	// User deletion
+	UserStatusGet(ctx context.Context, id int) (*UserStatus, error)
+	UserDeletionGet(
+		ctx context.Context,
+		userID int,
+	) (*models.UserDeletion, error)
+	UserDeletionCreate(ctx context.Context, userID int) error
+	UserDeletionDelete(ctx context.Context, userID int) error
+	UserDeletionsGetAllDue(
+		ctx context.Context,
+		requestedBefore time.Time,
+	) ([]*models.UserDeletion, error)
+	UserAnonymize(ctx context.Context, id int) error
+
+	// Idempotency key
+	IdempotencyKeyGet(
+		ctx context.Context,
+		userID int,
+		key string,
+	) (*models.IdempotencyKey, error)
+	IdempotencyKeyReserve(
+		ctx context.Context,
+		userID int,
+		key string,
+		requestHash string,
+		expiredBefore time.Time,
+		leaseExpiredBefore time.Time,
+	) (bool, error)
+	IdempotencyKeyComplete(
+		ctx context.Context,
+		userID int,
+		key string,
+		responseStatus int,
+		responseBody string,
+	) error
+	IdempotencyKeyDelete(ctx context.Context, userID int, key string) error
+	IdempotencyKeysPrune(
+		ctx context.Context,
+		expiredBefore time.Time,
+	) (int, error)
+
+	// User following
+	UserFollow(ctx context.Context, followerID, followedID int) error
+	UserUnfollow(ctx context.Context, followerID, followedID int) error
+	UserFollowersGetAll(
+		ctx context.Context,
+		userID int,
+		offset, limit int,
+	) ([]*models.User, error)
+	UserFollowersCount(ctx context.Context, userID int) (int, error)
+	UserFollowingsGetAll(
+		ctx context.Context,
+		userID int,
+		offset, limit int,
+	) ([]*models.User, error)
+	UserFollowingsCount(ctx context.Context, userID int) (int, error)
+
+	// Feed
+	FeedGetAll(
+		ctx context.Context,
+		userID int,
+		before *FeedPosition,
+		limit int,
+	) ([]*FeedActivity, error)
+
+	// Contribution
+	ContributionsGetAll(
+		ctx context.Context,
+		userID int,
+		offset, limit int,
+	) ([]*Contribution, error)
+	ContributionsCount(ctx context.Context, userID int) (int, error)
+	ContributorsGetAll(
+		ctx context.Context,
+		since, until time.Time,
+		offset, limit int,
+	) ([]*Contributor, error)
+	ContributorsCount(ctx context.Context, since, until time.Time) (int, error)
+
+	// Proposal
+	ProposalGet(ctx context.Context, id int) (*models.Proposal, error)
+	ProposalsGetAll(
+		ctx context.Context,
+		status string,
+		offset, limit int,
+	) ([]*models.Proposal, error)
+	ProposalsCount(ctx context.Context, status string) (int, error)
+	ProposalsApprovedCount(ctx context.Context, userID int) (int, error)
+	ProposalCreate(ctx context.Context, proposal *models.Proposal) error
+	ProposalReview(
+		ctx context.Context,
+		id int,
+		reviewerID int,
+		status string,
+	) error
 
 	// Series
-	SeriesGet(ctx context.Context, id int) (*models.Series, error)
+	SeriesGet(
+		ctx context.Context,
+		id int,
+		includeInvalidated bool,
+		loads ...string,
+	) (*models.Series, error)
 	SeriesesGetAll(
 		ctx context.Context,
+		filter ListFilter,
+		sort ListSort,
 		offset, limit int,
+		includeInvalidated bool,
 	) ([]*models.Series, error)
-	SeriesesCount(ctx context.Context) (int, error)
+	SeriesesCount(
+		ctx context.Context,
+		filter ListFilter,
+		includeInvalidated bool,
+	) (int, error)
 	SeriesCreate(
 		ctx context.Context,
 		contributorID int,
@@ -50,15 +172,91 @@ type Service interface {
 		contributorID int,
 		invalidation string,
 	) error
+	SeriesRestore(
+		ctx context.Context,
+		seriesID int,
+		contributorID int,
+	) error
 	SeriesAuditsGetAll(
 		ctx context.Context,
 		id int,
 		offset, limit int,
 	) ([]*models.SeriesesAudit, error)
+	SeriesAuditsGetPage(
+		ctx context.Context,
+		id int,
+		page *KeysetPage,
+	) ([]*models.SeriesesAudit, error)
 	SeriesAuditsCount(
 		ctx context.Context,
 		id int,
 	) (int, error)
+	SeriesAuditGet(
+		ctx context.Context,
+		id int,
+		contributedAt time.Time,
+	) (*models.SeriesesAudit, error)
+
+	// Season
+	SeasonGet(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		includeInvalidated bool,
+		loads ...string,
+	) (*models.Season, error)
+	SeasonsGetAllBySeries(
+		ctx context.Context,
+		seriesID int,
+		offset, limit int,
+		includeInvalidated bool,
+	) ([]*SeasonWithEpisodesCount, error)
+	SeasonsCountBySeries(
+		ctx context.Context,
+		seriesID int,
+		includeInvalidated bool,
+	) (int, error)
+	SeasonPut(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		contributorID int,
+		season *models.Season,
+	) error
+	SeasonCreateIfNotExists(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		contributorID int,
+	) error
+	SeasonUpdate(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		contributorID int,
+		cols map[string]any,
+	) error
+	SeasonInvalidate(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		contributorID int,
+		invalidation string,
+	) error
+	SeasonRestore(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		contributorID int,
+	) error
+	SeasonAuditsGetAll(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		offset, limit int,
+	) ([]*models.SeasonsAudit, error)
+	SeasonAuditsGetPage(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+		page *KeysetPage,
+	) ([]*models.SeasonsAudit, error)
+	SeasonAuditsCount(
+		ctx context.Context,
+		seriesID, seasonNumber int,
+	) (int, error)
 
 	// Episode
 	// EpisodeGetByID(
@@ -68,26 +266,38 @@ type Service interface {
 	EpisodeGet(
 		ctx context.Context,
 		seriesID, seasonNumber, episodeNumber int,
+		includeInvalidated bool,
+		loads ...string,
 	) (*models.Film, error)
 	EpisodesGetAllBySeries(
 		ctx context.Context,
 		seriesID int,
 		offset, limit int,
+		includeInvalidated bool,
+	) ([]*models.Film, error)
+	EpisodesGetPageBySeries(
+		ctx context.Context,
+		seriesID int,
+		page *KeysetPage,
+		includeInvalidated bool,
 	) ([]*models.Film, error)
 	EpisodesGetAllBySeason(
 		ctx context.Context,
 		seriesID int,
 		seasonNumber int,
 		offset, limit int,
+		includeInvalidated bool,
 	) ([]*models.Film, error)
 	EpisodesCountBySeries(
 		ctx context.Context,
 		seriesID int,
+		includeInvalidated bool,
 	) (int, error)
 	EpisodesCountBySeason(
 		ctx context.Context,
 		seriesID int,
 		seasonNumber int,
+		includeInvalidated bool,
 	) (int, error)
 	EpisodePut(
 		ctx context.Context,
@@ -120,6 +330,24 @@ type Service interface {
 		contributorID int,
 		invalidation string,
 	) error
+	EpisodeRestore(
+		ctx context.Context,
+		seriesID, seasonNumber, episodeNumber int,
+		contributorID int,
+	) error
+	EpisodesRestoreAllBySeason(
+		ctx context.Context,
+		seriesID int,
+		seasonNumber int,
+		contributorID int,
+	) error
+	EpisodesRestoreAllBySeries(
+		ctx context.Context,
+		seriesID int,
+		contributorID int,
+		invalidatedAt time.Time,
+		invalidation string,
+	) error
 	// EpisodeAuditsGetAllByID(
 	// 	ctx context.Context,
 	// 	id int,
@@ -130,10 +358,21 @@ type Service interface {
 		seriesID, seasonNumber, episodeNumber int,
 		offset, limit int,
 	) ([]*models.FilmsAudit, error)
+	EpisodeAuditsGetPage(
+		ctx context.Context,
+		seriesID, seasonNumber, episodeNumber int,
+		page *KeysetPage,
+	) ([]*models.FilmsAudit, error)
 	EpisodeAuditsCount(
 		ctx context.Context,
 		seriesID, seasonNumber, episodeNumber int,
 	) (int, error)
+	EpisodeAuditGet(
+		ctx context.Context,
+		id int,
+		seriesID, seasonNumber, episodeNumber int,
+		contributedAt time.Time,
+	) (*models.FilmsAudit, error)
 	EpisodesAuditsGetAllBySeason(
 		ctx context.Context,
 		seriesID int,
@@ -155,16 +394,181 @@ type Service interface {
 		seriesID int,
 	) (int, error)
 
+	// Film media
+	FilmGet(ctx context.Context, id int) (*models.Film, error)
+	FilmMediaGet(
+		ctx context.Context,
+		filmID, mediaID int,
+	) (*models.FilmMediaURL, error)
+	FilmMediaGetAllByFilm(
+		ctx context.Context,
+		filmID int,
+		offset, limit int,
+	) ([]*models.FilmMediaURL, error)
+	FilmMediaCountByFilm(
+		ctx context.Context,
+		filmID int,
+	) (int, error)
+	FilmMediaCreate(
+		ctx context.Context,
+		contributorID int,
+		media *models.FilmMediaURL,
+	) error
+	FilmMediaInvalidate(
+		ctx context.Context,
+		filmID, mediaID int,
+		contributorID int,
+		invalidation string,
+	) error
+	MediaBlobReferenced(ctx context.Context, key string) (bool, error)
+
+	// Artist
+	ArtistGet(ctx context.Context, id int) (*models.Artist, error)
+	ArtistCreate(
+		ctx context.Context,
+		contributorID int,
+		artist *models.Artist,
+	) error
+	ArtistImageGet(
+		ctx context.Context,
+		artistID, imageID int,
+	) (*models.ArtistImageURL, error)
+	ArtistImagesGetAllByArtist(
+		ctx context.Context,
+		artistID int,
+		offset, limit int,
+	) ([]*models.ArtistImageURL, error)
+	ArtistImagesCountByArtist(
+		ctx context.Context,
+		artistID int,
+	) (int, error)
+	ArtistImageCreate(
+		ctx context.Context,
+		contributorID int,
+		image *models.ArtistImageURL,
+	) error
+	ArtistImageInvalidate(
+		ctx context.Context,
+		artistID, imageID int,
+		contributorID int,
+		invalidation string,
+	) error
+
+	// Playlist
+	PlaylistGet(ctx context.Context, id int) (*models.Playlist, error)
+	PlaylistGetForUpdate(ctx context.Context, id int) (*models.Playlist, error)
+	PlaylistsGetAllByUser(
+		ctx context.Context,
+		userID int,
+		offset, limit int,
+	) ([]*models.Playlist, error)
+	PlaylistsCountByUser(ctx context.Context, userID int) (int, error)
+	PlaylistCreate(ctx context.Context, playlist *models.Playlist) error
+	PlaylistUpdate(
+		ctx context.Context,
+		id int,
+		cols map[string]any,
+	) error
+	PlaylistDelete(ctx context.Context, id int) error
+	PlaylistFilmsGetAll(
+		ctx context.Context,
+		playlistID int,
+		offset, limit int,
+	) ([]*models.Film, error)
+	PlaylistFilmsCount(ctx context.Context, playlistID int) (int, error)
+	PlaylistFilmIDs(ctx context.Context, playlistID int) ([]int, error)
+	PlaylistFilmAdd(ctx context.Context, playlistID, filmID int) error
+	PlaylistFilmRemove(ctx context.Context, playlistID, filmID int) error
+	PlaylistFilmsReorder(
+		ctx context.Context,
+		playlistID int,
+		filmIDs []int,
+	) error
+	PlaylistCoverThumbnails(
+		ctx context.Context,
+		playlistID int,
+		limit int,
+	) ([]string, error)
+
+	// Watchlist
+	WatchlistGetAll(
+		ctx context.Context,
+		userID int,
+		offset, limit int,
+	) ([]*models.Watchlist, error)
+	WatchlistCount(ctx context.Context, userID int) (int, error)
+	WatchlistAddPlaylist(
+		ctx context.Context,
+		userID int,
+		playlistID int,
+	) (int, error)
+	WatchlistMarkWatched(ctx context.Context, userID int, filmID int) error
+
+	// Post
+	PostGet(ctx context.Context, id int) (*PostWithReferences, error)
+	PostsGetAllByFilm(
+		ctx context.Context,
+		filmID int,
+		offset, limit int,
+	) ([]*PostWithReferences, error)
+	PostsCountByFilm(ctx context.Context, filmID int) (int, error)
+	PostsGetAllBySeries(
+		ctx context.Context,
+		seriesID int,
+		offset, limit int,
+	) ([]*PostWithReferences, error)
+	PostsCountBySeries(ctx context.Context, seriesID int) (int, error)
+	PostRepliesGetAll(
+		ctx context.Context,
+		postID int,
+		offset, limit int,
+	) ([]*PostWithReferences, error)
+	PostRepliesCount(ctx context.Context, postID int) (int, error)
+	PostCreate(
+		ctx context.Context,
+		post *models.Post,
+		filmIDs, seriesIDs, userIDs, artistIDs []int,
+	) error
+	PostUpdate(ctx context.Context, id int, body string) error
+	PostDelete(ctx context.Context, id int) error
+	PostRevisionsGetAll(
+		ctx context.Context,
+		postID int,
+		offset, limit int,
+	) ([]*models.PostRevision, error)
+	PostRevisionsCount(ctx context.Context, postID int) (int, error)
+
+	// Audit
+	AuditsPrune(
+		ctx context.Context,
+		retention AuditRetention,
+	) (map[string]int64, error)
+
 	// Movie
 	MovieGet(
 		ctx context.Context,
 		id int,
+		includeInvalidated bool,
 	) (*models.Film, error)
 	MoviesGetAll(
 		ctx context.Context,
+		filter ListFilter,
+		sort ListSort,
 		offset, limit int,
+		includeInvalidated bool,
 	) ([]*models.Film, error)
-	MoviesCount(ctx context.Context) (int, error)
+	MoviesGetPage(
+		ctx context.Context,
+		filter ListFilter,
+		sort ListSort,
+		page *KeysetPage,
+		includeInvalidated bool,
+	) ([]*models.Film, error)
+	MoviesCount(
+		ctx context.Context,
+		filter ListFilter,
+		includeInvalidated bool,
+	) (int, error)
 	MovieCreate(
 		ctx context.Context,
 		contributorID int,
@@ -182,15 +586,30 @@ type Service interface {
 		contributorID int,
 		invalidation string,
 	) error
+	MovieRestore(
+		ctx context.Context,
+		movieID int,
+		contributorID int,
+	) error
 	MovieAuditsGetAll(
 		ctx context.Context,
 		id int,
 		offset, limit int,
 	) ([]*models.FilmsAudit, error)
+	MovieAuditsGetPage(
+		ctx context.Context,
+		id int,
+		page *KeysetPage,
+	) ([]*models.FilmsAudit, error)
 	MovieAuditsCount(
 		ctx context.Context,
 		id int,
 	) (int, error)
+	MovieAuditGet(
+		ctx context.Context,
+		id int,
+		contributedAt time.Time,
+	) (*models.FilmsAudit, error)
 }
 
 type Repository struct {
@@ -212,3 +631,35 @@ type Executor interface {
 func NewRepository(exec Executor) *Repository {
 	return &Repository{exec}
 }
+
+// invalidationFilter hides the rows invalidated through column unless
+// includeInvalidated is set.
+func invalidationFilter(column string, includeInvalidated bool) qm.QueryMod {
+	if includeInvalidated {
+		return qm.QueryModFunc(func(*queries.Query) {})
+	}
+	return qm.Where(column + " IS NULL")
+}
+
+// relationOrders orders the to-many relations eager loaded by loadMods
+var relationOrders = map[string]string{
+	models.SeriesRels.SeriesSeasons: models.SeasonColumns.SeasonNumber,
+}
+
+// loadMods eager loads relations, named by the models' *Rels, of the fetched
+// records. The related records are ones carrying an invalidation column like
+// films, serieses and seasons, the invalidated ones are left out unless
+// includeInvalidated is set.
+func loadMods(relations []string, includeInvalidated bool) []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(relations))
+	for _, relation := range relations {
+		relationMods := []qm.QueryMod{
+			invalidationFilter(models.FilmColumns.Invalidation, includeInvalidated),
+		}
+		if order, isOrdered := relationOrders[relation]; isOrdered {
+			relationMods = append(relationMods, qm.OrderBy(order))
+		}
+		mods = append(mods, qm.Load(relation, relationMods...))
+	}
+	return mods
+}
@@ -0,0 +1,260 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// SeasonWithEpisodesCount is a season along with the number of episodes put
+// under it.
+type SeasonWithEpisodesCount struct {
+	models.Season `boil:",bind"`
+	EpisodesCount int `boil:"episodes_count" json:"episodes_count"`
+}
+
+// SeasonGet fetches a season along with the relations to load, like
+// models.SeasonRels.Series.
+func (repo *Repository) SeasonGet(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	includeInvalidated bool,
+	loads ...string,
+) (*models.Season, error) {
+	mods := []qm.QueryMod{
+		models.SeasonWhere.SeriesID.EQ(seriesID),
+		models.SeasonWhere.SeasonNumber.EQ(seasonNumber),
+		invalidationFilter(models.SeasonColumns.Invalidation, includeInvalidated),
+	}
+	season, err := models.Seasons(
+		append(mods, loadMods(loads, includeInvalidated)...)...,
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return season, nil
+}
+
+func (repo *Repository) SeasonsGetAllBySeries(
+	ctx context.Context,
+	seriesID int,
+	offset, limit int,
+	includeInvalidated bool,
+) ([]*SeasonWithEpisodesCount, error) {
+	// invalidated episodes are only counted along with invalidated seasons
+	episodesFilter := ""
+	if !includeInvalidated {
+		episodesFilter = fmt.Sprintf(
+			" AND %s.%s IS NULL",
+			models.TableNames.Films,
+			models.FilmColumns.Invalidation,
+		)
+	}
+	var seasons []*SeasonWithEpisodesCount
+	err := models.Seasons(
+		qm.Select(
+			models.TableNames.Seasons+".*",
+			fmt.Sprintf(
+				"(SELECT COUNT(*) FROM %[1]s WHERE %[1]s.%[3]s = %[2]s.%[5]s AND %[1]s.%[4]s = %[2]s.%[6]s AND %[1]s.%[7]s IS NOT NULL%[8]s) AS episodes_count",
+				models.TableNames.Films,
+				models.TableNames.Seasons,
+				models.FilmColumns.SeriesID,
+				models.FilmColumns.SeasonNumber,
+				models.SeasonColumns.SeriesID,
+				models.SeasonColumns.SeasonNumber,
+				models.FilmColumns.EpisodeNumber,
+				episodesFilter,
+			),
+		),
+		models.SeasonWhere.SeriesID.EQ(seriesID),
+		invalidationFilter(
+			models.TableNames.Seasons+"."+models.SeasonColumns.Invalidation,
+			includeInvalidated,
+		),
+		qm.Offset(offset),
+		qm.Limit(limit),
+		qm.OrderBy(models.SeasonColumns.SeasonNumber),
+	).Bind(ctx, repo.exec, &seasons)
+	if err != nil {
+		return nil, err
+	}
+	return seasons, nil
+}
+
+func (repo *Repository) SeasonsCountBySeries(
+	ctx context.Context,
+	seriesID int,
+	includeInvalidated bool,
+) (int, error) {
+	nSeasons, err := models.Seasons(
+		models.SeasonWhere.SeriesID.EQ(seriesID),
+		invalidationFilter(models.SeasonColumns.Invalidation, includeInvalidated),
+	).Count(ctx, repo.exec)
+	return int(nSeasons), err
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (repo *Repository) SeasonPut(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	contributorID int,
+	season *models.Season,
+) error {
+	season.SeriesID = seriesID
+	season.SeasonNumber = seasonNumber
+	season.ContributedBy = contributorID
+	return season.Upsert(
+		ctx,
+		repo.exec,
+		true, // update on conflict
+		[]string{
+			models.SeasonColumns.SeriesID,
+			models.SeasonColumns.SeasonNumber,
+		},
+		boil.Infer(),
+		boil.Infer(),
+	)
+}
+
+// SeasonCreateIfNotExists inserts a bare season and leaves an existing one
+// untouched.
+func (repo *Repository) SeasonCreateIfNotExists(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	contributorID int,
+) error {
+	season := &models.Season{
+		SeriesID:      seriesID,
+		SeasonNumber:  seasonNumber,
+		ContributedBy: contributorID,
+	}
+	return season.Upsert(
+		ctx,
+		repo.exec,
+		false, // do nothing on conflict
+		[]string{
+			models.SeasonColumns.SeriesID,
+			models.SeasonColumns.SeasonNumber,
+		},
+		boil.None(),
+		boil.Infer(),
+	)
+}
+
+func (repo *Repository) SeasonUpdate(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	contributorID int,
+	cols map[string]any,
+) error {
+	cols[models.SeasonColumns.ContributedBy] = contributorID
+	rowsAff, err := models.Seasons(
+		models.SeasonWhere.SeriesID.EQ(seriesID),
+		models.SeasonWhere.SeasonNumber.EQ(seasonNumber),
+	).UpdateAll(ctx, repo.exec, cols)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+func (repo *Repository) SeasonInvalidate(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	contributorID int,
+	invalidation string,
+) error {
+	rowsAff, err := models.Seasons(
+		models.SeasonWhere.SeriesID.EQ(seriesID),
+		models.SeasonWhere.SeasonNumber.EQ(seasonNumber),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		map[string]any{
+			models.SeasonColumns.Invalidation:  invalidation,
+			models.SeasonColumns.ContributedBy: contributorID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// SeasonRestore clears the invalidation of a season.
+// ErrNoRecord is returned if there's no such invalidated record.
+func (repo *Repository) SeasonRestore(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	contributorID int,
+) error {
+	rowsAff, err := models.Seasons(
+		models.SeasonWhere.SeriesID.EQ(seriesID),
+		models.SeasonWhere.SeasonNumber.EQ(seasonNumber),
+		models.SeasonWhere.Invalidation.IsNotNull(),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		map[string]any{
+			models.SeasonColumns.Invalidation:  nil,
+			models.SeasonColumns.ContributedBy: contributorID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (repo *Repository) SeasonAuditsGetAll(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	offset, limit int,
+) ([]*models.SeasonsAudit, error) {
+	return SeasonAudits.GetAll(
+		ctx,
+		repo.exec,
+		[]any{seriesID, seasonNumber},
+		offset,
+		limit,
+	)
+}
+
+func (repo *Repository) SeasonAuditsGetPage(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+	page *KeysetPage,
+) ([]*models.SeasonsAudit, error) {
+	return SeasonAudits.GetPage(
+		ctx,
+		repo.exec,
+		[]any{seriesID, seasonNumber},
+		page,
+	)
+}
+
+func (repo *Repository) SeasonAuditsCount(
+	ctx context.Context,
+	seriesID, seasonNumber int,
+) (int, error) {
+	return SeasonAudits.Count(ctx, repo.exec, []any{seriesID, seasonNumber})
+}
@@ -0,0 +1,232 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestSeasonPut(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{
+		Title:       "series",
+		DateStarted: testutils.Date(2000, 1, 1),
+	}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	// first there's no season
+
+	fetchedSeason, err := r.SeasonGet(ctx, series.ID, 1, true)
+	require.Equal(repo.ErrNoRecord, err)
+	require.Nil(fetchedSeason)
+
+	// put season
+
+	season := &models.Season{Title: null.StringFrom("season")}
+	err = r.SeasonPut(ctx, series.ID, 1, user.ID, season)
+	require.NoError(err)
+
+	fetchedSeason, err = r.SeasonGet(ctx, series.ID, 1, true)
+	require.NoError(err)
+	require.Equal(series.ID, fetchedSeason.SeriesID)
+	require.Equal(1, fetchedSeason.SeasonNumber)
+	require.Equal(null.StringFrom("season"), fetchedSeason.Title)
+	require.Equal(user.ID, fetchedSeason.ContributedBy)
+
+	// put again replaces the season and audits the old one
+
+	err = r.SeasonPut(
+		ctx,
+		series.ID,
+		1,
+		user.ID,
+		&models.Season{Title: null.StringFrom("new season")},
+	)
+	require.NoError(err)
+
+	fetchedSeason, err = r.SeasonGet(ctx, series.ID, 1, true)
+	require.NoError(err)
+	require.Equal(null.StringFrom("new season"), fetchedSeason.Title)
+
+	audits, err := r.SeasonAuditsGetAll(ctx, series.ID, 1, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(1, len(audits))
+	require.Equal(null.StringFrom("season"), audits[0].Title)
+
+	nAudits, err := r.SeasonAuditsCount(ctx, series.ID, 1)
+	require.NoError(err)
+	require.Equal(1, nAudits)
+}
+
+func TestSeasonCreateIfNotExists(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{
+		Title:       "series",
+		DateStarted: testutils.Date(2000, 1, 1),
+	}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	// create a bare season
+
+	err = r.SeasonCreateIfNotExists(ctx, series.ID, 1, user.ID)
+	require.NoError(err)
+
+	fetchedSeason, err := r.SeasonGet(ctx, series.ID, 1, true)
+	require.NoError(err)
+	require.False(fetchedSeason.Title.Valid)
+
+	// existing season is left untouched
+
+	err = r.SeasonUpdate(
+		ctx,
+		series.ID,
+		1,
+		user.ID,
+		map[string]any{models.SeasonColumns.Title: "season"},
+	)
+	require.NoError(err)
+
+	err = r.SeasonCreateIfNotExists(ctx, series.ID, 1, user.ID)
+	require.NoError(err)
+
+	fetchedSeason, err = r.SeasonGet(ctx, series.ID, 1, true)
+	require.NoError(err)
+	require.Equal(null.StringFrom("season"), fetchedSeason.Title)
+}
+
+func TestSeasonsGetAllBySeries(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{
+		Title:       "series",
+		DateStarted: testutils.Date(2000, 1, 1),
+	}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	// first there's no seasons
+
+	seasons, err := r.SeasonsGetAllBySeries(ctx, series.ID, 0, math.MaxInt, true)
+	require.NoError(err)
+	require.Equal(0, len(seasons))
+
+	// put seasons with 3, 0 and 1 episodes
+
+	episodesPerSeason := []int{3, 0, 1}
+	for i, nEpisodes := range episodesPerSeason {
+		seasonNumber := i + 1
+		err = r.SeasonCreateIfNotExists(ctx, series.ID, seasonNumber, user.ID)
+		require.NoError(err)
+		for episodeNumber := 1; episodeNumber <= nEpisodes; episodeNumber++ {
+			err = r.EpisodePut(
+				ctx,
+				series.ID,
+				seasonNumber,
+				episodeNumber,
+				user.ID,
+				&models.Film{
+					Title:        "episode",
+					DateReleased: testutils.Date(2000, 1, 1),
+				},
+			)
+			require.NoError(err)
+		}
+	}
+
+	// fetch seasons ordered by season number
+
+	seasons, err = r.SeasonsGetAllBySeries(ctx, series.ID, 0, math.MaxInt, true)
+	require.NoError(err)
+	require.Equal(len(episodesPerSeason), len(seasons))
+	for i, s := range seasons {
+		require.Equal(series.ID, s.SeriesID)
+		require.Equal(i+1, s.SeasonNumber)
+		require.Equal(episodesPerSeason[i], s.EpisodesCount)
+	}
+
+	nSeasons, err := r.SeasonsCountBySeries(ctx, series.ID, true)
+	require.NoError(err)
+	require.Equal(len(episodesPerSeason), nSeasons)
+}
+
+func TestSeasonInvalidate(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{
+		Title:       "series",
+		DateStarted: testutils.Date(2000, 1, 1),
+	}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	invalidation := "season invalidation"
+
+	// first there's no season
+
+	err = r.SeasonInvalidate(ctx, series.ID, 1, user.ID, invalidation)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// add season
+
+	err = r.SeasonCreateIfNotExists(ctx, series.ID, 1, user.ID)
+	require.NoError(err)
+
+	// invalidate season
+
+	err = r.SeasonInvalidate(ctx, series.ID, 1, user.ID, invalidation)
+	require.NoError(err)
+
+	fetchedSeason, err := r.SeasonGet(ctx, series.ID, 1, true)
+	require.NoError(err)
+	require.Equal(null.StringFrom(invalidation), fetchedSeason.Invalidation)
+}
@@ -3,18 +3,27 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/aria3ppp/watch-server/internal/models"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
+// SeriesGet fetches a series along with the relations to load, like
+// models.SeriesRels.SeriesSeasons.
 func (repo *Repository) SeriesGet(
 	ctx context.Context,
 	id int,
+	includeInvalidated bool,
+	loads ...string,
 ) (*models.Series, error) {
-	serie, err := models.Serieses(
+	mods := []qm.QueryMod{
 		models.SeriesWhere.ID.EQ(id),
+		invalidationFilter(models.SeriesColumns.Invalidation, includeInvalidated),
+	}
+	serie, err := models.Serieses(
+		append(mods, loadMods(loads, includeInvalidated)...)...,
 	).One(ctx, repo.exec)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -27,21 +36,36 @@ func (repo *Repository) SeriesGet(
 
 func (repo *Repository) SeriesesGetAll(
 	ctx context.Context,
+	filter ListFilter,
+	sort ListSort,
 	offset, limit int,
+	includeInvalidated bool,
 ) ([]*models.Series, error) {
-	series, err := models.Serieses(
+	mods := []qm.QueryMod{
+		invalidationFilter(models.SeriesColumns.Invalidation, includeInvalidated),
 		qm.Offset(offset),
 		qm.Limit(limit),
-		qm.OrderBy(models.SeriesColumns.ID),
-	).All(ctx, repo.exec)
+	}
+	mods = append(mods, filter.mods()...)
+	mods = append(mods, sort.mods(models.SeriesColumns.ID)...)
+	series, err := models.Serieses(mods...).All(ctx, repo.exec)
 	if err != nil {
 		return nil, err
 	}
 	return series, nil
 }
 
-func (repo *Repository) SeriesesCount(ctx context.Context) (int, error) {
-	nSerie, err := models.Serieses().Count(ctx, repo.exec)
+func (repo *Repository) SeriesesCount(
+	ctx context.Context,
+	filter ListFilter,
+	includeInvalidated bool,
+) (int, error) {
+	mods := []qm.QueryMod{
+		invalidationFilter(models.SeriesColumns.Invalidation, includeInvalidated),
+	}
+	nSerie, err := models.Serieses(
+		append(mods, filter.mods()...)...,
+	).Count(ctx, repo.exec)
 	return int(nSerie), err
 }
 
@@ -51,6 +75,7 @@ func (repo *Repository) SeriesCreate(
 	series *models.Series,
 ) error {
 	series.ContributedBy = contributorID
+	setSeriesContributionMetadata(ctx, series)
 	return series.Insert(ctx, repo.exec, boil.Infer())
 }
 
@@ -61,14 +86,15 @@ func (repo *Repository) SeriesUpdate(
 	cols map[string]any,
 ) error {
 	cols[models.SeriesColumns.ContributedBy] = contributorID
+	mods := []qm.QueryMod{models.SeriesWhere.ID.EQ(serieID)}
 	rowsAff, err := models.Serieses(
-		models.SeriesWhere.ID.EQ(serieID),
-	).UpdateAll(ctx, repo.exec, cols)
+		append(mods, ifMatchMod(ctx, models.SeriesColumns.ContributedAt))...,
+	).UpdateAll(ctx, repo.exec, withContributionMetadata(ctx, cols))
 	if err != nil {
 		return err
 	}
 	if rowsAff == 0 {
-		return ErrNoRecord
+		return noRowsUpdated(ctx, repo.exec, models.Serieses(mods...).Exists)
 	}
 	return nil
 }
@@ -79,15 +105,43 @@ func (repo *Repository) SeriesInvalidate(
 	contributorID int,
 	invalidation string,
 ) error {
+	mods := []qm.QueryMod{models.SeriesWhere.ID.EQ(serieID)}
 	rowsAff, err := models.Serieses(
-		models.SeriesWhere.ID.EQ(serieID),
+		append(mods, ifMatchMod(ctx, models.SeriesColumns.ContributedAt))...,
 	).UpdateAll(
 		ctx,
 		repo.exec,
-		map[string]any{
+		withContributionMetadata(ctx, map[string]any{
 			models.SeriesColumns.Invalidation:  invalidation,
 			models.SeriesColumns.ContributedBy: contributorID,
-		},
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return noRowsUpdated(ctx, repo.exec, models.Serieses(mods...).Exists)
+	}
+	return nil
+}
+
+// SeriesRestore clears the invalidation of a series.
+// ErrNoRecord is returned if there's no such invalidated record.
+func (repo *Repository) SeriesRestore(
+	ctx context.Context,
+	seriesID int,
+	contributorID int,
+) error {
+	rowsAff, err := models.Serieses(
+		models.SeriesWhere.ID.EQ(seriesID),
+		models.SeriesWhere.Invalidation.IsNotNull(),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		withContributionMetadata(ctx, map[string]any{
+			models.SeriesColumns.Invalidation:  nil,
+			models.SeriesColumns.ContributedBy: contributorID,
+		}),
 	)
 	if err != nil {
 		return err
@@ -103,27 +157,29 @@ func (repo *Repository) SeriesAuditsGetAll(
 	id int,
 	offset, limit int,
 ) ([]*models.SeriesesAudit, error) {
-	audits, err := models.SeriesesAudits(
-		models.SeriesesAuditWhere.ID.EQ(id),
-		qm.Offset(offset),
-		qm.Limit(limit),
-		qm.OrderBy(models.SeriesesAuditColumns.ContributedAt+" desc"),
-	).All(ctx, repo.exec)
-	if err != nil {
-		return nil, err
-	}
-	return audits, nil
+	return SeriesAudits.GetAll(ctx, repo.exec, []any{id}, offset, limit)
+}
+
+func (repo *Repository) SeriesAuditsGetPage(
+	ctx context.Context,
+	id int,
+	page *KeysetPage,
+) ([]*models.SeriesesAudit, error) {
+	return SeriesAudits.GetPage(ctx, repo.exec, []any{id}, page)
 }
 
 func (repo *Repository) SeriesAuditsCount(
 	ctx context.Context,
 	id int,
 ) (int, error) {
-	auditsCount, err := models.SeriesesAudits(
-		models.SeriesesAuditWhere.ID.EQ(id),
-	).Count(ctx, repo.exec)
-	if err != nil {
-		return 0, err
-	}
-	return int(auditsCount), nil
+	return SeriesAudits.Count(ctx, repo.exec, []any{id})
+}
+
+// SeriesAuditGet returns the series audit contributed at contributedAt.
+func (repo *Repository) SeriesAuditGet(
+	ctx context.Context,
+	id int,
+	contributedAt time.Time,
+) (*models.SeriesesAudit, error) {
+	return SeriesAudits.Get(ctx, repo.exec, []any{id}, contributedAt)
 }
@@ -33,7 +33,7 @@ func TestSeriesGet(t *testing.T) {
 
 	// first there's no series
 
-	fetchedSeries, err := r.SeriesGet(ctx, 1)
+	fetchedSeries, err := r.SeriesGet(ctx, 1, true)
 	require.Equal(repo.ErrNoRecord, err)
 	require.Nil(fetchedSeries)
 
@@ -44,7 +44,7 @@ func TestSeriesGet(t *testing.T) {
 
 	// fetch the series
 
-	fetchedSeries, err = r.SeriesGet(ctx, series.ID)
+	fetchedSeries, err = r.SeriesGet(ctx, series.ID, true)
 	require.NoError(err)
 
 	testutils.SetTimeLocation(
@@ -59,6 +59,73 @@ func TestSeriesGet(t *testing.T) {
 	require.Equal(series, fetchedSeries)
 }
 
+func TestSeriesGetLoads(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{
+		Title:       "series",
+		DateStarted: testutils.Date(2000, 1, 1),
+	}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+	for _, seasonNumber := range []int{3, 1, 2} {
+		err = r.SeasonCreateIfNotExists(ctx, series.ID, seasonNumber, user.ID)
+		require.NoError(err)
+	}
+	err = r.SeasonInvalidate(ctx, series.ID, 2, user.ID, "invalidation")
+	require.NoError(err)
+
+	seasonNumbers := func(seasons models.SeasonSlice) []int {
+		var numbers []int
+		for _, season := range seasons {
+			numbers = append(numbers, season.SeasonNumber)
+		}
+		return numbers
+	}
+
+	// relations aren't loaded unless asked for
+
+	fetchedSeries, err := r.SeriesGet(ctx, series.ID, false)
+	require.NoError(err)
+	require.Nil(fetchedSeries.R)
+
+	// seasons are loaded in order, invalidated ones only if asked for
+
+	fetchedSeries, err = r.SeriesGet(
+		ctx,
+		series.ID,
+		false,
+		models.SeriesRels.SeriesSeasons,
+	)
+	require.NoError(err)
+	require.Equal([]int{1, 3}, seasonNumbers(fetchedSeries.R.SeriesSeasons))
+
+	fetchedSeries, err = r.SeriesGet(
+		ctx,
+		series.ID,
+		true,
+		models.SeriesRels.SeriesSeasons,
+	)
+	require.NoError(err)
+	require.Equal([]int{1, 2, 3}, seasonNumbers(fetchedSeries.R.SeriesSeasons))
+
+	// the parent series is loaded along with a season
+
+	season, err := r.SeasonGet(ctx, series.ID, 1, false, models.SeasonRels.Series)
+	require.NoError(err)
+	require.Equal(series.ID, season.R.Series.ID)
+}
+
 func TestSeriesesGetAll(t *testing.T) {
 	require := require.New(t)
 
@@ -77,8 +144,11 @@ func TestSeriesesGetAll(t *testing.T) {
 
 	fetchedSerieses, err := r.SeriesesGetAll(
 		ctx,
+		nil,
+		nil,
 		0,
 		math.MaxInt,
+		true,
 	)
 	require.NoError(err)
 	require.Equal(0, len(fetchedSerieses))
@@ -117,8 +187,11 @@ func TestSeriesesGetAll(t *testing.T) {
 
 	fetchedSerieses, err = r.SeriesesGetAll(
 		ctx,
+		nil,
+		nil,
 		0,
 		math.MaxInt,
+		true,
 	)
 	require.NoError(err)
 	require.Equal(len(serieses), len(fetchedSerieses))
@@ -153,7 +226,7 @@ func TestSeriesesCount(t *testing.T) {
 
 	// first there's no serieses
 
-	nSerieses, err := r.SeriesesCount(ctx)
+	nSerieses, err := r.SeriesesCount(ctx, nil, true)
 	require.NoError(err)
 	require.Equal(0, nSerieses)
 
@@ -189,7 +262,7 @@ func TestSeriesesCount(t *testing.T) {
 
 	// count serieses
 
-	nSerieses, err = r.SeriesesCount(ctx)
+	nSerieses, err = r.SeriesesCount(ctx, nil, true)
 	require.NoError(err)
 	require.Equal(len(serieses), nSerieses)
 }
@@ -214,7 +287,7 @@ func TestSeriesCreate(t *testing.T) {
 
 	// first there's no series
 
-	nSerieses, err := r.SeriesesCount(ctx)
+	nSerieses, err := r.SeriesesCount(ctx, nil, true)
 	require.NoError(err)
 	require.Equal(0, nSerieses)
 
@@ -225,7 +298,7 @@ func TestSeriesCreate(t *testing.T) {
 
 	// fetch the series
 
-	fetchedSeries, err := r.SeriesGet(ctx, series.ID)
+	fetchedSeries, err := r.SeriesGet(ctx, series.ID, true)
 	require.NoError(err)
 
 	testutils.SetTimeLocation(
@@ -289,7 +362,7 @@ func TestSeriesUpdate(t *testing.T) {
 
 	// fetch the updated series
 
-	fetchedUpdatedSeries, err := r.SeriesGet(ctx, series.ID)
+	fetchedUpdatedSeries, err := r.SeriesGet(ctx, series.ID, true)
 	require.NoError(err)
 
 	testutils.SetTimeLocation(
@@ -389,7 +462,7 @@ func TestSeriesInvalidate(t *testing.T) {
 
 	// check invalidated
 
-	invalidatedSeries, err := r.SeriesGet(ctx, series.ID)
+	invalidatedSeries, err := r.SeriesGet(ctx, series.ID, true)
 	require.NoError(err)
 	require.Equal(null.StringFrom(invalidation), invalidatedSeries.Invalidation)
 
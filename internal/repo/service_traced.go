@@ -0,0 +1,847 @@
+// Code generated by tracegen. DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// tracedService records a span for each call to the methods of next
+type tracedService struct {
+	next Service
+}
+
+var _ Service = &tracedService{}
+
+func (s *tracedService) UserGet(ctx context.Context, id int) (_ *models.User, err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserGet(ctx, id)
+}
+
+func (s *tracedService) UserGetByEmail(ctx context.Context, email string) (_ *models.User, err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserGetByEmail")
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserGetByEmail(ctx, email)
+}
+
+func (s *tracedService) UsersCount(ctx context.Context) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.UsersCount")
+	defer func() { tracing.End(span, err) }()
+	return s.next.UsersCount(ctx)
+}
+
+func (s *tracedService) UserCreate(ctx context.Context, user *models.User) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserCreate")
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserCreate(ctx, user)
+}
+
+func (s *tracedService) UserUpdate(ctx context.Context, id int, columns map[string]any) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserUpdate", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserUpdate(ctx, id, columns)
+}
+
+func (s *tracedService) UserDelete(ctx context.Context, id int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserDelete", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserDelete(ctx, id)
+}
+
+func (s *tracedService) UserAuditsGetAll(ctx context.Context, id int, offset int, limit int) (_ []*models.UsersAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserAuditsGetAll", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserAuditsGetAll(ctx, id, offset, limit)
+}
+
+func (s *tracedService) UserAuditsGetPage(ctx context.Context, id int, page *KeysetPage) (_ []*models.UsersAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserAuditsGetPage", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserAuditsGetPage(ctx, id, page)
+}
+
+func (s *tracedService) UserAuditsCount(ctx context.Context, id int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserAuditsCount", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserAuditsCount(ctx, id)
+}
+
+func (s *tracedService) UserStatusGet(ctx context.Context, id int) (_ *UserStatus, err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserStatusGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserStatusGet(ctx, id)
+}
+
+func (s *tracedService) UserDeletionGet(ctx context.Context, userID int) (_ *models.UserDeletion, err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserDeletionGet", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserDeletionGet(ctx, userID)
+}
+
+func (s *tracedService) UserDeletionCreate(ctx context.Context, userID int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserDeletionCreate", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserDeletionCreate(ctx, userID)
+}
+
+func (s *tracedService) UserDeletionDelete(ctx context.Context, userID int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserDeletionDelete", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserDeletionDelete(ctx, userID)
+}
+
+func (s *tracedService) UserDeletionsGetAllDue(ctx context.Context, requestedBefore time.Time) (_ []*models.UserDeletion, err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserDeletionsGetAllDue")
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserDeletionsGetAllDue(ctx, requestedBefore)
+}
+
+func (s *tracedService) UserAnonymize(ctx context.Context, id int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserAnonymize", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserAnonymize(ctx, id)
+}
+
+func (s *tracedService) IdempotencyKeyGet(ctx context.Context, userID int, key string) (_ *models.IdempotencyKey, err error) {
+	ctx, span := tracing.Start(ctx, "repo.IdempotencyKeyGet", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.IdempotencyKeyGet(ctx, userID, key)
+}
+
+func (s *tracedService) IdempotencyKeyReserve(ctx context.Context, userID int, key string, requestHash string, expiredBefore time.Time, leaseExpiredBefore time.Time) (_ bool, err error) {
+	ctx, span := tracing.Start(ctx, "repo.IdempotencyKeyReserve", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.IdempotencyKeyReserve(ctx, userID, key, requestHash, expiredBefore, leaseExpiredBefore)
+}
+
+func (s *tracedService) IdempotencyKeyComplete(ctx context.Context, userID int, key string, responseStatus int, responseBody string) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.IdempotencyKeyComplete", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.IdempotencyKeyComplete(ctx, userID, key, responseStatus, responseBody)
+}
+
+func (s *tracedService) IdempotencyKeyDelete(ctx context.Context, userID int, key string) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.IdempotencyKeyDelete", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.IdempotencyKeyDelete(ctx, userID, key)
+}
+
+func (s *tracedService) IdempotencyKeysPrune(ctx context.Context, expiredBefore time.Time) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.IdempotencyKeysPrune")
+	defer func() { tracing.End(span, err) }()
+	return s.next.IdempotencyKeysPrune(ctx, expiredBefore)
+}
+
+func (s *tracedService) UserFollow(ctx context.Context, followerID int, followedID int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserFollow", attribute.Int("follower_id", followerID), attribute.Int("followed_id", followedID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserFollow(ctx, followerID, followedID)
+}
+
+func (s *tracedService) UserUnfollow(ctx context.Context, followerID int, followedID int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserUnfollow", attribute.Int("follower_id", followerID), attribute.Int("followed_id", followedID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserUnfollow(ctx, followerID, followedID)
+}
+
+func (s *tracedService) UserFollowersGetAll(ctx context.Context, userID int, offset int, limit int) (_ []*models.User, err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserFollowersGetAll", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserFollowersGetAll(ctx, userID, offset, limit)
+}
+
+func (s *tracedService) UserFollowersCount(ctx context.Context, userID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserFollowersCount", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserFollowersCount(ctx, userID)
+}
+
+func (s *tracedService) UserFollowingsGetAll(ctx context.Context, userID int, offset int, limit int) (_ []*models.User, err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserFollowingsGetAll", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserFollowingsGetAll(ctx, userID, offset, limit)
+}
+
+func (s *tracedService) UserFollowingsCount(ctx context.Context, userID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.UserFollowingsCount", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UserFollowingsCount(ctx, userID)
+}
+
+func (s *tracedService) FeedGetAll(ctx context.Context, userID int, before *FeedPosition, limit int) (_ []*FeedActivity, err error) {
+	ctx, span := tracing.Start(ctx, "repo.FeedGetAll", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.FeedGetAll(ctx, userID, before, limit)
+}
+
+func (s *tracedService) ContributionsGetAll(ctx context.Context, userID int, offset int, limit int) (_ []*Contribution, err error) {
+	ctx, span := tracing.Start(ctx, "repo.ContributionsGetAll", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ContributionsGetAll(ctx, userID, offset, limit)
+}
+
+func (s *tracedService) ContributionsCount(ctx context.Context, userID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.ContributionsCount", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ContributionsCount(ctx, userID)
+}
+
+func (s *tracedService) ContributorsGetAll(ctx context.Context, since time.Time, until time.Time, offset int, limit int) (_ []*Contributor, err error) {
+	ctx, span := tracing.Start(ctx, "repo.ContributorsGetAll")
+	defer func() { tracing.End(span, err) }()
+	return s.next.ContributorsGetAll(ctx, since, until, offset, limit)
+}
+
+func (s *tracedService) ContributorsCount(ctx context.Context, since time.Time, until time.Time) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.ContributorsCount")
+	defer func() { tracing.End(span, err) }()
+	return s.next.ContributorsCount(ctx, since, until)
+}
+
+func (s *tracedService) ProposalGet(ctx context.Context, id int) (_ *models.Proposal, err error) {
+	ctx, span := tracing.Start(ctx, "repo.ProposalGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ProposalGet(ctx, id)
+}
+
+func (s *tracedService) ProposalsGetAll(ctx context.Context, status string, offset int, limit int) (_ []*models.Proposal, err error) {
+	ctx, span := tracing.Start(ctx, "repo.ProposalsGetAll")
+	defer func() { tracing.End(span, err) }()
+	return s.next.ProposalsGetAll(ctx, status, offset, limit)
+}
+
+func (s *tracedService) ProposalsCount(ctx context.Context, status string) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.ProposalsCount")
+	defer func() { tracing.End(span, err) }()
+	return s.next.ProposalsCount(ctx, status)
+}
+
+func (s *tracedService) ProposalsApprovedCount(ctx context.Context, userID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.ProposalsApprovedCount", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ProposalsApprovedCount(ctx, userID)
+}
+
+func (s *tracedService) ProposalCreate(ctx context.Context, proposal *models.Proposal) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.ProposalCreate")
+	defer func() { tracing.End(span, err) }()
+	return s.next.ProposalCreate(ctx, proposal)
+}
+
+func (s *tracedService) ProposalReview(ctx context.Context, id int, reviewerID int, status string) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.ProposalReview", attribute.Int("id", id), attribute.Int("reviewer_id", reviewerID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ProposalReview(ctx, id, reviewerID, status)
+}
+
+func (s *tracedService) SeriesGet(ctx context.Context, id int, includeInvalidated bool, loads ...string) (_ *models.Series, err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeriesGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesGet(ctx, id, includeInvalidated, loads...)
+}
+
+func (s *tracedService) SeriesesGetAll(ctx context.Context, filter ListFilter, sort ListSort, offset int, limit int, includeInvalidated bool) (_ []*models.Series, err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeriesesGetAll")
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesesGetAll(ctx, filter, sort, offset, limit, includeInvalidated)
+}
+
+func (s *tracedService) SeriesesCount(ctx context.Context, filter ListFilter, includeInvalidated bool) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeriesesCount")
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesesCount(ctx, filter, includeInvalidated)
+}
+
+func (s *tracedService) SeriesCreate(ctx context.Context, contributorID int, series *models.Series) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeriesCreate", attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesCreate(ctx, contributorID, series)
+}
+
+func (s *tracedService) SeriesUpdate(ctx context.Context, seriesID int, contributorID int, cols map[string]any) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeriesUpdate", attribute.Int("series_id", seriesID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesUpdate(ctx, seriesID, contributorID, cols)
+}
+
+func (s *tracedService) SeriesInvalidate(ctx context.Context, seriesID int, contributorID int, invalidation string) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeriesInvalidate", attribute.Int("series_id", seriesID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesInvalidate(ctx, seriesID, contributorID, invalidation)
+}
+
+func (s *tracedService) SeriesRestore(ctx context.Context, seriesID int, contributorID int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeriesRestore", attribute.Int("series_id", seriesID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesRestore(ctx, seriesID, contributorID)
+}
+
+func (s *tracedService) SeriesAuditsGetAll(ctx context.Context, id int, offset int, limit int) (_ []*models.SeriesesAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeriesAuditsGetAll", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesAuditsGetAll(ctx, id, offset, limit)
+}
+
+func (s *tracedService) SeriesAuditsGetPage(ctx context.Context, id int, page *KeysetPage) (_ []*models.SeriesesAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeriesAuditsGetPage", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesAuditsGetPage(ctx, id, page)
+}
+
+func (s *tracedService) SeriesAuditsCount(ctx context.Context, id int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeriesAuditsCount", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesAuditsCount(ctx, id)
+}
+
+func (s *tracedService) SeriesAuditGet(ctx context.Context, id int, contributedAt time.Time) (_ *models.SeriesesAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeriesAuditGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeriesAuditGet(ctx, id, contributedAt)
+}
+
+func (s *tracedService) SeasonGet(ctx context.Context, seriesID int, seasonNumber int, includeInvalidated bool, loads ...string) (_ *models.Season, err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeasonGet", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonGet(ctx, seriesID, seasonNumber, includeInvalidated, loads...)
+}
+
+func (s *tracedService) SeasonsGetAllBySeries(ctx context.Context, seriesID int, offset int, limit int, includeInvalidated bool) (_ []*SeasonWithEpisodesCount, err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeasonsGetAllBySeries", attribute.Int("series_id", seriesID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonsGetAllBySeries(ctx, seriesID, offset, limit, includeInvalidated)
+}
+
+func (s *tracedService) SeasonsCountBySeries(ctx context.Context, seriesID int, includeInvalidated bool) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeasonsCountBySeries", attribute.Int("series_id", seriesID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonsCountBySeries(ctx, seriesID, includeInvalidated)
+}
+
+func (s *tracedService) SeasonPut(ctx context.Context, seriesID int, seasonNumber int, contributorID int, season *models.Season) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeasonPut", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonPut(ctx, seriesID, seasonNumber, contributorID, season)
+}
+
+func (s *tracedService) SeasonCreateIfNotExists(ctx context.Context, seriesID int, seasonNumber int, contributorID int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeasonCreateIfNotExists", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonCreateIfNotExists(ctx, seriesID, seasonNumber, contributorID)
+}
+
+func (s *tracedService) SeasonUpdate(ctx context.Context, seriesID int, seasonNumber int, contributorID int, cols map[string]any) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeasonUpdate", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonUpdate(ctx, seriesID, seasonNumber, contributorID, cols)
+}
+
+func (s *tracedService) SeasonInvalidate(ctx context.Context, seriesID int, seasonNumber int, contributorID int, invalidation string) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeasonInvalidate", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonInvalidate(ctx, seriesID, seasonNumber, contributorID, invalidation)
+}
+
+func (s *tracedService) SeasonRestore(ctx context.Context, seriesID int, seasonNumber int, contributorID int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeasonRestore", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonRestore(ctx, seriesID, seasonNumber, contributorID)
+}
+
+func (s *tracedService) SeasonAuditsGetAll(ctx context.Context, seriesID int, seasonNumber int, offset int, limit int) (_ []*models.SeasonsAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeasonAuditsGetAll", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonAuditsGetAll(ctx, seriesID, seasonNumber, offset, limit)
+}
+
+func (s *tracedService) SeasonAuditsGetPage(ctx context.Context, seriesID int, seasonNumber int, page *KeysetPage) (_ []*models.SeasonsAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeasonAuditsGetPage", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonAuditsGetPage(ctx, seriesID, seasonNumber, page)
+}
+
+func (s *tracedService) SeasonAuditsCount(ctx context.Context, seriesID int, seasonNumber int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.SeasonAuditsCount", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SeasonAuditsCount(ctx, seriesID, seasonNumber)
+}
+
+func (s *tracedService) EpisodeGet(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, includeInvalidated bool, loads ...string) (_ *models.Film, err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodeGet", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeGet(ctx, seriesID, seasonNumber, episodeNumber, includeInvalidated, loads...)
+}
+
+func (s *tracedService) EpisodesGetAllBySeries(ctx context.Context, seriesID int, offset int, limit int, includeInvalidated bool) (_ []*models.Film, err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodesGetAllBySeries", attribute.Int("series_id", seriesID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesGetAllBySeries(ctx, seriesID, offset, limit, includeInvalidated)
+}
+
+func (s *tracedService) EpisodesGetPageBySeries(ctx context.Context, seriesID int, page *KeysetPage, includeInvalidated bool) (_ []*models.Film, err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodesGetPageBySeries", attribute.Int("series_id", seriesID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesGetPageBySeries(ctx, seriesID, page, includeInvalidated)
+}
+
+func (s *tracedService) EpisodesGetAllBySeason(ctx context.Context, seriesID int, seasonNumber int, offset int, limit int, includeInvalidated bool) (_ []*models.Film, err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodesGetAllBySeason", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesGetAllBySeason(ctx, seriesID, seasonNumber, offset, limit, includeInvalidated)
+}
+
+func (s *tracedService) EpisodesCountBySeries(ctx context.Context, seriesID int, includeInvalidated bool) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodesCountBySeries", attribute.Int("series_id", seriesID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesCountBySeries(ctx, seriesID, includeInvalidated)
+}
+
+func (s *tracedService) EpisodesCountBySeason(ctx context.Context, seriesID int, seasonNumber int, includeInvalidated bool) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodesCountBySeason", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesCountBySeason(ctx, seriesID, seasonNumber, includeInvalidated)
+}
+
+func (s *tracedService) EpisodePut(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, contributorID int, episode *models.Film) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodePut", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodePut(ctx, seriesID, seasonNumber, episodeNumber, contributorID, episode)
+}
+
+func (s *tracedService) EpisodeUpdate(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, contributorID int, cols map[string]any) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodeUpdate", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeUpdate(ctx, seriesID, seasonNumber, episodeNumber, contributorID, cols)
+}
+
+func (s *tracedService) EpisodeInvalidate(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, contributorID int, invalidation string) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodeInvalidate", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeInvalidate(ctx, seriesID, seasonNumber, episodeNumber, contributorID, invalidation)
+}
+
+func (s *tracedService) EpisodesInvalidateAllBySeason(ctx context.Context, seriesID int, seasonNumber int, contributorID int, invalidation string) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodesInvalidateAllBySeason", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesInvalidateAllBySeason(ctx, seriesID, seasonNumber, contributorID, invalidation)
+}
+
+func (s *tracedService) EpisodesInvalidateAllBySeries(ctx context.Context, seriesID int, contributorID int, invalidation string) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodesInvalidateAllBySeries", attribute.Int("series_id", seriesID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesInvalidateAllBySeries(ctx, seriesID, contributorID, invalidation)
+}
+
+func (s *tracedService) EpisodeRestore(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, contributorID int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodeRestore", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeRestore(ctx, seriesID, seasonNumber, episodeNumber, contributorID)
+}
+
+func (s *tracedService) EpisodesRestoreAllBySeason(ctx context.Context, seriesID int, seasonNumber int, contributorID int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodesRestoreAllBySeason", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesRestoreAllBySeason(ctx, seriesID, seasonNumber, contributorID)
+}
+
+func (s *tracedService) EpisodesRestoreAllBySeries(ctx context.Context, seriesID int, contributorID int, invalidatedAt time.Time, invalidation string) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodesRestoreAllBySeries", attribute.Int("series_id", seriesID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesRestoreAllBySeries(ctx, seriesID, contributorID, invalidatedAt, invalidation)
+}
+
+func (s *tracedService) EpisodeAuditsGetAll(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, offset int, limit int) (_ []*models.FilmsAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodeAuditsGetAll", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeAuditsGetAll(ctx, seriesID, seasonNumber, episodeNumber, offset, limit)
+}
+
+func (s *tracedService) EpisodeAuditsGetPage(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int, page *KeysetPage) (_ []*models.FilmsAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodeAuditsGetPage", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeAuditsGetPage(ctx, seriesID, seasonNumber, episodeNumber, page)
+}
+
+func (s *tracedService) EpisodeAuditsCount(ctx context.Context, seriesID int, seasonNumber int, episodeNumber int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodeAuditsCount", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeAuditsCount(ctx, seriesID, seasonNumber, episodeNumber)
+}
+
+func (s *tracedService) EpisodeAuditGet(ctx context.Context, id int, seriesID int, seasonNumber int, episodeNumber int, contributedAt time.Time) (_ *models.FilmsAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodeAuditGet", attribute.Int("id", id), attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber), attribute.Int("episode_number", episodeNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodeAuditGet(ctx, id, seriesID, seasonNumber, episodeNumber, contributedAt)
+}
+
+func (s *tracedService) EpisodesAuditsGetAllBySeason(ctx context.Context, seriesID int, seasonNumber int, offset int, limit int) (_ []*models.FilmsAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodesAuditsGetAllBySeason", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesAuditsGetAllBySeason(ctx, seriesID, seasonNumber, offset, limit)
+}
+
+func (s *tracedService) EpisodesAuditsCountBySeason(ctx context.Context, seriesID int, seasonNumber int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodesAuditsCountBySeason", attribute.Int("series_id", seriesID), attribute.Int("season_number", seasonNumber))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesAuditsCountBySeason(ctx, seriesID, seasonNumber)
+}
+
+func (s *tracedService) EpisodesAuditsGetAllBySeries(ctx context.Context, seriesID int, offset int, limit int) (_ []*models.FilmsAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodesAuditsGetAllBySeries", attribute.Int("series_id", seriesID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesAuditsGetAllBySeries(ctx, seriesID, offset, limit)
+}
+
+func (s *tracedService) EpisodesAuditsCountBySeries(ctx context.Context, seriesID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.EpisodesAuditsCountBySeries", attribute.Int("series_id", seriesID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.EpisodesAuditsCountBySeries(ctx, seriesID)
+}
+
+func (s *tracedService) FilmGet(ctx context.Context, id int) (_ *models.Film, err error) {
+	ctx, span := tracing.Start(ctx, "repo.FilmGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.FilmGet(ctx, id)
+}
+
+func (s *tracedService) FilmMediaGet(ctx context.Context, filmID int, mediaID int) (_ *models.FilmMediaURL, err error) {
+	ctx, span := tracing.Start(ctx, "repo.FilmMediaGet", attribute.Int("film_id", filmID), attribute.Int("media_id", mediaID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.FilmMediaGet(ctx, filmID, mediaID)
+}
+
+func (s *tracedService) FilmMediaGetAllByFilm(ctx context.Context, filmID int, offset int, limit int) (_ []*models.FilmMediaURL, err error) {
+	ctx, span := tracing.Start(ctx, "repo.FilmMediaGetAllByFilm", attribute.Int("film_id", filmID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.FilmMediaGetAllByFilm(ctx, filmID, offset, limit)
+}
+
+func (s *tracedService) FilmMediaCountByFilm(ctx context.Context, filmID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.FilmMediaCountByFilm", attribute.Int("film_id", filmID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.FilmMediaCountByFilm(ctx, filmID)
+}
+
+func (s *tracedService) FilmMediaCreate(ctx context.Context, contributorID int, media *models.FilmMediaURL) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.FilmMediaCreate", attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.FilmMediaCreate(ctx, contributorID, media)
+}
+
+func (s *tracedService) FilmMediaInvalidate(ctx context.Context, filmID int, mediaID int, contributorID int, invalidation string) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.FilmMediaInvalidate", attribute.Int("film_id", filmID), attribute.Int("media_id", mediaID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.FilmMediaInvalidate(ctx, filmID, mediaID, contributorID, invalidation)
+}
+
+func (s *tracedService) MediaBlobReferenced(ctx context.Context, key string) (_ bool, err error) {
+	ctx, span := tracing.Start(ctx, "repo.MediaBlobReferenced")
+	defer func() { tracing.End(span, err) }()
+	return s.next.MediaBlobReferenced(ctx, key)
+}
+
+func (s *tracedService) ArtistGet(ctx context.Context, id int) (_ *models.Artist, err error) {
+	ctx, span := tracing.Start(ctx, "repo.ArtistGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ArtistGet(ctx, id)
+}
+
+func (s *tracedService) ArtistCreate(ctx context.Context, contributorID int, artist *models.Artist) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.ArtistCreate", attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ArtistCreate(ctx, contributorID, artist)
+}
+
+func (s *tracedService) ArtistImageGet(ctx context.Context, artistID int, imageID int) (_ *models.ArtistImageURL, err error) {
+	ctx, span := tracing.Start(ctx, "repo.ArtistImageGet", attribute.Int("artist_id", artistID), attribute.Int("image_id", imageID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ArtistImageGet(ctx, artistID, imageID)
+}
+
+func (s *tracedService) ArtistImagesGetAllByArtist(ctx context.Context, artistID int, offset int, limit int) (_ []*models.ArtistImageURL, err error) {
+	ctx, span := tracing.Start(ctx, "repo.ArtistImagesGetAllByArtist", attribute.Int("artist_id", artistID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ArtistImagesGetAllByArtist(ctx, artistID, offset, limit)
+}
+
+func (s *tracedService) ArtistImagesCountByArtist(ctx context.Context, artistID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.ArtistImagesCountByArtist", attribute.Int("artist_id", artistID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ArtistImagesCountByArtist(ctx, artistID)
+}
+
+func (s *tracedService) ArtistImageCreate(ctx context.Context, contributorID int, image *models.ArtistImageURL) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.ArtistImageCreate", attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ArtistImageCreate(ctx, contributorID, image)
+}
+
+func (s *tracedService) ArtistImageInvalidate(ctx context.Context, artistID int, imageID int, contributorID int, invalidation string) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.ArtistImageInvalidate", attribute.Int("artist_id", artistID), attribute.Int("image_id", imageID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.ArtistImageInvalidate(ctx, artistID, imageID, contributorID, invalidation)
+}
+
+func (s *tracedService) PlaylistGet(ctx context.Context, id int) (_ *models.Playlist, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PlaylistGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistGet(ctx, id)
+}
+
+func (s *tracedService) PlaylistGetForUpdate(ctx context.Context, id int) (_ *models.Playlist, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PlaylistGetForUpdate", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistGetForUpdate(ctx, id)
+}
+
+func (s *tracedService) PlaylistsGetAllByUser(ctx context.Context, userID int, offset int, limit int) (_ []*models.Playlist, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PlaylistsGetAllByUser", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistsGetAllByUser(ctx, userID, offset, limit)
+}
+
+func (s *tracedService) PlaylistsCountByUser(ctx context.Context, userID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PlaylistsCountByUser", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistsCountByUser(ctx, userID)
+}
+
+func (s *tracedService) PlaylistCreate(ctx context.Context, playlist *models.Playlist) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.PlaylistCreate")
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistCreate(ctx, playlist)
+}
+
+func (s *tracedService) PlaylistUpdate(ctx context.Context, id int, cols map[string]any) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.PlaylistUpdate", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistUpdate(ctx, id, cols)
+}
+
+func (s *tracedService) PlaylistDelete(ctx context.Context, id int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.PlaylistDelete", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistDelete(ctx, id)
+}
+
+func (s *tracedService) PlaylistFilmsGetAll(ctx context.Context, playlistID int, offset int, limit int) (_ []*models.Film, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PlaylistFilmsGetAll", attribute.Int("playlist_id", playlistID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistFilmsGetAll(ctx, playlistID, offset, limit)
+}
+
+func (s *tracedService) PlaylistFilmsCount(ctx context.Context, playlistID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PlaylistFilmsCount", attribute.Int("playlist_id", playlistID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistFilmsCount(ctx, playlistID)
+}
+
+func (s *tracedService) PlaylistFilmIDs(ctx context.Context, playlistID int) (_ []int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PlaylistFilmIDs", attribute.Int("playlist_id", playlistID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistFilmIDs(ctx, playlistID)
+}
+
+func (s *tracedService) PlaylistFilmAdd(ctx context.Context, playlistID int, filmID int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.PlaylistFilmAdd", attribute.Int("playlist_id", playlistID), attribute.Int("film_id", filmID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistFilmAdd(ctx, playlistID, filmID)
+}
+
+func (s *tracedService) PlaylistFilmRemove(ctx context.Context, playlistID int, filmID int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.PlaylistFilmRemove", attribute.Int("playlist_id", playlistID), attribute.Int("film_id", filmID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistFilmRemove(ctx, playlistID, filmID)
+}
+
+func (s *tracedService) PlaylistFilmsReorder(ctx context.Context, playlistID int, filmIDs []int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.PlaylistFilmsReorder", attribute.Int("playlist_id", playlistID), attribute.IntSlice("film_ids", filmIDs))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistFilmsReorder(ctx, playlistID, filmIDs)
+}
+
+func (s *tracedService) PlaylistCoverThumbnails(ctx context.Context, playlistID int, limit int) (_ []string, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PlaylistCoverThumbnails", attribute.Int("playlist_id", playlistID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PlaylistCoverThumbnails(ctx, playlistID, limit)
+}
+
+func (s *tracedService) WatchlistGetAll(ctx context.Context, userID int, offset int, limit int) (_ []*models.Watchlist, err error) {
+	ctx, span := tracing.Start(ctx, "repo.WatchlistGetAll", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.WatchlistGetAll(ctx, userID, offset, limit)
+}
+
+func (s *tracedService) WatchlistCount(ctx context.Context, userID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.WatchlistCount", attribute.Int("user_id", userID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.WatchlistCount(ctx, userID)
+}
+
+func (s *tracedService) WatchlistAddPlaylist(ctx context.Context, userID int, playlistID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.WatchlistAddPlaylist", attribute.Int("user_id", userID), attribute.Int("playlist_id", playlistID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.WatchlistAddPlaylist(ctx, userID, playlistID)
+}
+
+func (s *tracedService) WatchlistMarkWatched(ctx context.Context, userID int, filmID int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.WatchlistMarkWatched", attribute.Int("user_id", userID), attribute.Int("film_id", filmID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.WatchlistMarkWatched(ctx, userID, filmID)
+}
+
+func (s *tracedService) PostGet(ctx context.Context, id int) (_ *PostWithReferences, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PostGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostGet(ctx, id)
+}
+
+func (s *tracedService) PostsGetAllByFilm(ctx context.Context, filmID int, offset int, limit int) (_ []*PostWithReferences, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PostsGetAllByFilm", attribute.Int("film_id", filmID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostsGetAllByFilm(ctx, filmID, offset, limit)
+}
+
+func (s *tracedService) PostsCountByFilm(ctx context.Context, filmID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PostsCountByFilm", attribute.Int("film_id", filmID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostsCountByFilm(ctx, filmID)
+}
+
+func (s *tracedService) PostsGetAllBySeries(ctx context.Context, seriesID int, offset int, limit int) (_ []*PostWithReferences, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PostsGetAllBySeries", attribute.Int("series_id", seriesID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostsGetAllBySeries(ctx, seriesID, offset, limit)
+}
+
+func (s *tracedService) PostsCountBySeries(ctx context.Context, seriesID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PostsCountBySeries", attribute.Int("series_id", seriesID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostsCountBySeries(ctx, seriesID)
+}
+
+func (s *tracedService) PostRepliesGetAll(ctx context.Context, postID int, offset int, limit int) (_ []*PostWithReferences, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PostRepliesGetAll", attribute.Int("post_id", postID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostRepliesGetAll(ctx, postID, offset, limit)
+}
+
+func (s *tracedService) PostRepliesCount(ctx context.Context, postID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PostRepliesCount", attribute.Int("post_id", postID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostRepliesCount(ctx, postID)
+}
+
+func (s *tracedService) PostCreate(ctx context.Context, post *models.Post, filmIDs []int, seriesIDs []int, userIDs []int, artistIDs []int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.PostCreate", attribute.IntSlice("film_ids", filmIDs), attribute.IntSlice("series_ids", seriesIDs), attribute.IntSlice("user_ids", userIDs), attribute.IntSlice("artist_ids", artistIDs))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostCreate(ctx, post, filmIDs, seriesIDs, userIDs, artistIDs)
+}
+
+func (s *tracedService) PostUpdate(ctx context.Context, id int, body string) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.PostUpdate", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostUpdate(ctx, id, body)
+}
+
+func (s *tracedService) PostDelete(ctx context.Context, id int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.PostDelete", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostDelete(ctx, id)
+}
+
+func (s *tracedService) PostRevisionsGetAll(ctx context.Context, postID int, offset int, limit int) (_ []*models.PostRevision, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PostRevisionsGetAll", attribute.Int("post_id", postID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostRevisionsGetAll(ctx, postID, offset, limit)
+}
+
+func (s *tracedService) PostRevisionsCount(ctx context.Context, postID int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.PostRevisionsCount", attribute.Int("post_id", postID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PostRevisionsCount(ctx, postID)
+}
+
+func (s *tracedService) AuditsPrune(ctx context.Context, retention AuditRetention) (_ map[string]int64, err error) {
+	ctx, span := tracing.Start(ctx, "repo.AuditsPrune")
+	defer func() { tracing.End(span, err) }()
+	return s.next.AuditsPrune(ctx, retention)
+}
+
+func (s *tracedService) MovieGet(ctx context.Context, id int, includeInvalidated bool) (_ *models.Film, err error) {
+	ctx, span := tracing.Start(ctx, "repo.MovieGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieGet(ctx, id, includeInvalidated)
+}
+
+func (s *tracedService) MoviesGetAll(ctx context.Context, filter ListFilter, sort ListSort, offset int, limit int, includeInvalidated bool) (_ []*models.Film, err error) {
+	ctx, span := tracing.Start(ctx, "repo.MoviesGetAll")
+	defer func() { tracing.End(span, err) }()
+	return s.next.MoviesGetAll(ctx, filter, sort, offset, limit, includeInvalidated)
+}
+
+func (s *tracedService) MoviesGetPage(ctx context.Context, filter ListFilter, sort ListSort, page *KeysetPage, includeInvalidated bool) (_ []*models.Film, err error) {
+	ctx, span := tracing.Start(ctx, "repo.MoviesGetPage")
+	defer func() { tracing.End(span, err) }()
+	return s.next.MoviesGetPage(ctx, filter, sort, page, includeInvalidated)
+}
+
+func (s *tracedService) MoviesCount(ctx context.Context, filter ListFilter, includeInvalidated bool) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.MoviesCount")
+	defer func() { tracing.End(span, err) }()
+	return s.next.MoviesCount(ctx, filter, includeInvalidated)
+}
+
+func (s *tracedService) MovieCreate(ctx context.Context, contributorID int, movie *models.Film) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.MovieCreate", attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieCreate(ctx, contributorID, movie)
+}
+
+func (s *tracedService) MovieUpdate(ctx context.Context, movieID int, contributorID int, cols map[string]any) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.MovieUpdate", attribute.Int("movie_id", movieID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieUpdate(ctx, movieID, contributorID, cols)
+}
+
+func (s *tracedService) MovieInvalidate(ctx context.Context, movieID int, contributorID int, invalidation string) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.MovieInvalidate", attribute.Int("movie_id", movieID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieInvalidate(ctx, movieID, contributorID, invalidation)
+}
+
+func (s *tracedService) MovieRestore(ctx context.Context, movieID int, contributorID int) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.MovieRestore", attribute.Int("movie_id", movieID), attribute.Int("contributor_id", contributorID))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieRestore(ctx, movieID, contributorID)
+}
+
+func (s *tracedService) MovieAuditsGetAll(ctx context.Context, id int, offset int, limit int) (_ []*models.FilmsAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.MovieAuditsGetAll", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieAuditsGetAll(ctx, id, offset, limit)
+}
+
+func (s *tracedService) MovieAuditsGetPage(ctx context.Context, id int, page *KeysetPage) (_ []*models.FilmsAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.MovieAuditsGetPage", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieAuditsGetPage(ctx, id, page)
+}
+
+func (s *tracedService) MovieAuditsCount(ctx context.Context, id int) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "repo.MovieAuditsCount", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieAuditsCount(ctx, id)
+}
+
+func (s *tracedService) MovieAuditGet(ctx context.Context, id int, contributedAt time.Time) (_ *models.FilmsAudit, err error) {
+	ctx, span := tracing.Start(ctx, "repo.MovieAuditGet", attribute.Int("id", id))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MovieAuditGet(ctx, id, contributedAt)
+}
@@ -0,0 +1,41 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watch-server/internal/tracing"
+)
+
+//go:generate go run ../tracing/tracegen -source repo.go -type Service -prefix repo -destination service_traced.go
+
+// tracedServiceTx records a span for each transaction of next, the queries
+// made within included.
+type tracedServiceTx struct {
+	*tracedService
+	next ServiceTx
+}
+
+var _ ServiceTx = &tracedServiceTx{}
+
+// NewTracedServiceTx returns next recording a span for each of its queries and
+// transactions.
+func NewTracedServiceTx(next ServiceTx) ServiceTx {
+	return &tracedServiceTx{
+		tracedService: &tracedService{next: next},
+		next:          next,
+	}
+}
+
+func (s *tracedServiceTx) Transaction(
+	ctx context.Context,
+	fn func(context.Context, Service) error,
+) (err error) {
+	ctx, span := tracing.Start(ctx, "repo.Transaction")
+	defer func() { tracing.End(span, err) }()
+	return s.next.Transaction(
+		ctx,
+		func(ctx context.Context, r Service) error {
+			return fn(ctx, &tracedService{next: r})
+		},
+	)
+}
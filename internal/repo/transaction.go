@@ -3,6 +3,11 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/logging"
+	"github.com/aria3ppp/watch-server/internal/metrics"
+	"go.uber.org/zap"
 )
 
 type noOpBeginTx struct {
@@ -31,16 +36,40 @@ func (repo *Repository) Transaction(
 		return err
 	}
 	r := NewRepository(noOpBeginTx{tx})
+	start := time.Now()
 	defer func() {
 		if p := recover(); p != nil {
-			tx.Rollback()
+			rollback(ctx, tx)
+			observeTransaction(start, metrics.OutcomeRollback)
 			panic(p)
 		} else if err != nil {
-			tx.Rollback()
+			rollback(ctx, tx)
+			observeTransaction(start, metrics.OutcomeRollback)
+		} else if err = tx.Commit(); err != nil {
+			observeTransaction(start, metrics.OutcomeRollback)
 		} else {
-			tx.Commit()
+			observeTransaction(start, metrics.OutcomeCommit)
 		}
 	}()
 	err = fn(ctx, r)
 	return
 }
+
+// rollback rolls tx back logging a failure, as the error that made the
+// transaction roll back is the one returned. A transaction whose context is
+// done is rolled back already.
+func rollback(ctx context.Context, tx *sql.Tx) {
+	if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		logging.FromContext(ctx).Error(
+			"repo.Transaction: rollback failed",
+			zap.Error(err),
+		)
+	}
+}
+
+// observeTransaction records the duration of a transaction started at start
+func observeTransaction(start time.Time, outcome string) {
+	metrics.DBTransactionDuration.
+		WithLabelValues(outcome).
+		Observe(time.Since(start).Seconds())
+}
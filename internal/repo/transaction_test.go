@@ -7,10 +7,10 @@ import (
 
 	"github.com/aria3ppp/watch-server/internal/models"
 	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 
 	_ "github.com/golang-migrate/migrate/v4/source/file"
-	_ "github.com/lib/pq"
 )
 
 func TestTransactionOK(t *testing.T) {
@@ -173,3 +173,33 @@ func TestTransactionPanic(t *testing.T) {
 	require.NoError(err)
 	require.Equal(0, nUsers)
 }
+
+func TestTransactionCommitFailed(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	// a failed statement whose error is dropped leaves the transaction
+	// aborted, so it fails to commit
+	err = r.Transaction(
+		ctx,
+		func(ctx context.Context, repo repo.Service) error {
+			err := repo.UserCreate(ctx, &models.User{Email: "email"})
+			require.NoError(err)
+			err = repo.UserCreate(ctx, &models.User{Email: "email"})
+			require.Error(err)
+			return nil
+		},
+	)
+
+	require.Equal(pq.ErrInFailedTransaction, err)
+
+	nUsers, err := r.UsersCount(ctx)
+	require.NoError(err)
+	require.Equal(0, nUsers)
+}
@@ -50,6 +50,7 @@ func (repo *Repository) UserCreate(
 	return user.Insert(ctx, repo.exec, boil.Infer())
 }
 
+// UserUpdate updates a user, anonymized users are left as is.
 func (repo *Repository) UserUpdate(
 	ctx context.Context,
 	id int,
@@ -57,6 +58,7 @@ func (repo *Repository) UserUpdate(
 ) error {
 	rowsAff, err := models.Users(
 		models.UserWhere.ID.EQ(id),
+		models.UserWhere.DeletedAt.IsNull(),
 	).UpdateAll(
 		ctx,
 		repo.exec,
@@ -83,3 +85,26 @@ func (repo *Repository) UserDelete(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+func (repo *Repository) UserAuditsGetAll(
+	ctx context.Context,
+	id int,
+	offset, limit int,
+) ([]*models.UsersAudit, error) {
+	return UserAudits.GetAll(ctx, repo.exec, []any{id}, offset, limit)
+}
+
+func (repo *Repository) UserAuditsGetPage(
+	ctx context.Context,
+	id int,
+	page *KeysetPage,
+) ([]*models.UsersAudit, error) {
+	return UserAudits.GetPage(ctx, repo.exec, []any{id}, page)
+}
+
+func (repo *Repository) UserAuditsCount(
+	ctx context.Context,
+	id int,
+) (int, error) {
+	return UserAudits.Count(ctx, repo.exec, []any{id})
+}
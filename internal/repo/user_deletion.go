@@ -0,0 +1,171 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// UserStatus tells whether a user is anonymized or about to be.
+type UserStatus struct {
+	DeletedAt           null.Time `boil:"deleted_at"`
+	DeletionRequestedAt null.Time `boil:"requested_at"`
+}
+
+// UserStatusGet returns the deletion status of a user in a single query, as
+// it's checked on every authorized request.
+// ErrNoRecord is returned if there's no such user.
+func (repo *Repository) UserStatusGet(
+	ctx context.Context,
+	id int,
+) (*UserStatus, error) {
+	status := new(UserStatus)
+	err := models.NewQuery(
+		qm.Select(
+			models.UserTableColumns.DeletedAt,
+			models.UserDeletionTableColumns.RequestedAt,
+		),
+		qm.From(models.TableNames.Users),
+		qm.LeftOuterJoin(fmt.Sprintf(
+			"%s ON %s = %s",
+			models.TableNames.UserDeletions,
+			models.UserDeletionTableColumns.UserID,
+			models.UserTableColumns.ID,
+		)),
+		qm.Where(models.UserTableColumns.ID+" = ?", id),
+	).Bind(ctx, repo.exec, status)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return status, nil
+}
+
+func (repo *Repository) UserDeletionGet(
+	ctx context.Context,
+	userID int,
+) (*models.UserDeletion, error) {
+	deletion, err := models.UserDeletions(
+		models.UserDeletionWhere.UserID.EQ(userID),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return deletion, nil
+}
+
+// UserDeletionCreate schedules the deletion of a user, a deletion already
+// pending keeps the time it was first requested at.
+func (repo *Repository) UserDeletionCreate(
+	ctx context.Context,
+	userID int,
+) error {
+	deletion := &models.UserDeletion{UserID: userID}
+	return deletion.Upsert(
+		ctx,
+		repo.exec,
+		false, // do nothing on conflict
+		[]string{models.UserDeletionColumns.UserID},
+		boil.None(),
+		boil.Infer(),
+	)
+}
+
+// UserDeletionDelete cancels the pending deletion of a user.
+// ErrNoRecord is returned if there's no such pending deletion.
+func (repo *Repository) UserDeletionDelete(
+	ctx context.Context,
+	userID int,
+) error {
+	rowsAff, err := models.UserDeletions(
+		models.UserDeletionWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// UserDeletionsGetAllDue returns the deletions requested before
+// requestedBefore, oldest first.
+func (repo *Repository) UserDeletionsGetAllDue(
+	ctx context.Context,
+	requestedBefore time.Time,
+) ([]*models.UserDeletion, error) {
+	deletions, err := models.UserDeletions(
+		models.UserDeletionWhere.RequestedAt.LT(requestedBefore),
+		qm.OrderBy(models.UserDeletionColumns.RequestedAt),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return deletions, nil
+}
+
+// UserAnonymize turns a user into a tombstone: the profile is scrubbed, the
+// email is freed and the profile history and followings are dropped, while
+// the contributions of the user are left referencing the tombstone. Its
+// pending deletion, if any, is done with.
+// ErrNoRecord is returned if there's no such user not anonymized yet.
+func (repo *Repository) UserAnonymize(ctx context.Context, id int) error {
+	rowsAff, err := models.Users(
+		models.UserWhere.ID.EQ(id),
+		models.UserWhere.DeletedAt.IsNull(),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		map[string]any{
+			// no valid email looks like this one
+			models.UserColumns.Email:          fmt.Sprintf("deleted-%d", id),
+			models.UserColumns.HashedPassword: "",
+			models.UserColumns.FirstName:      nil,
+			models.UserColumns.LastName:       nil,
+			models.UserColumns.Bio:            nil,
+			models.UserColumns.Birthdate:      nil,
+			models.UserColumns.DeletedAt:      null.TimeFrom(time.Now()),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+
+	// the update above is audited as well so the history goes after it
+	_, err = models.UsersAudits(
+		models.UsersAuditWhere.ID.EQ(id),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+
+	_, err = models.UserFollowings(
+		qm.Expr(
+			models.UserFollowingWhere.FollowerID.EQ(id),
+			qm.Or2(models.UserFollowingWhere.FollowedID.EQ(id)),
+		),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+
+	_, err = models.UserDeletions(
+		models.UserDeletionWhere.UserID.EQ(id),
+	).DeleteAll(ctx, repo.exec)
+	return err
+}
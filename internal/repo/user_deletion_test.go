@@ -0,0 +1,163 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestUserDeletion(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	users := make([]*models.User, 2)
+	for i := range users {
+		users[i] = &models.User{
+			Email:          "email" + string(rune('a'+i)),
+			HashedPassword: "hash",
+			FirstName:      null.StringFrom("first name"),
+			Bio:            null.StringFrom("bio"),
+			Birthdate:      null.TimeFrom(testutils.Date(2000, 1, 1)),
+		}
+		err = r.UserCreate(ctx, users[i])
+		require.NoError(err)
+	}
+
+	// no pending deletion
+
+	_, err = r.UserStatusGet(ctx, math.MaxInt32)
+	require.Equal(repo.ErrNoRecord, err)
+
+	status, err := r.UserStatusGet(ctx, users[0].ID)
+	require.NoError(err)
+	require.False(status.DeletedAt.Valid)
+	require.False(status.DeletionRequestedAt.Valid)
+
+	_, err = r.UserDeletionGet(ctx, users[0].ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	err = r.UserDeletionDelete(ctx, users[0].ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// requesting twice keeps the first request time
+
+	err = r.UserDeletionCreate(ctx, users[0].ID)
+	require.NoError(err)
+	deletion, err := r.UserDeletionGet(ctx, users[0].ID)
+	require.NoError(err)
+	err = r.UserDeletionCreate(ctx, users[0].ID)
+	require.NoError(err)
+	again, err := r.UserDeletionGet(ctx, users[0].ID)
+	require.NoError(err)
+	require.Equal(deletion.RequestedAt, again.RequestedAt)
+
+	status, err = r.UserStatusGet(ctx, users[0].ID)
+	require.NoError(err)
+	require.False(status.DeletedAt.Valid)
+	require.True(status.DeletionRequestedAt.Valid)
+	require.True(deletion.RequestedAt.Equal(status.DeletionRequestedAt.Time))
+
+	// cancel and request again
+
+	err = r.UserDeletionDelete(ctx, users[0].ID)
+	require.NoError(err)
+	err = r.UserDeletionCreate(ctx, users[0].ID)
+	require.NoError(err)
+
+	// due deletions
+
+	due, err := r.UserDeletionsGetAllDue(ctx, time.Now().Add(-time.Hour))
+	require.NoError(err)
+	require.Equal(0, len(due))
+
+	due, err = r.UserDeletionsGetAllDue(ctx, time.Now().Add(time.Hour))
+	require.NoError(err)
+	require.Equal(1, len(due))
+	require.Equal(users[0].ID, due[0].UserID)
+
+	// anonymize user
+
+	err = r.UserFollow(ctx, users[0].ID, users[1].ID)
+	require.NoError(err)
+	err = r.UserFollow(ctx, users[1].ID, users[0].ID)
+	require.NoError(err)
+	err = r.UserUpdate(
+		ctx,
+		users[0].ID,
+		map[string]any{models.UserColumns.LastName: "last name"},
+	)
+	require.NoError(err)
+
+	movie := &models.Film{Title: "movie", DateReleased: testutils.Date(2000, 1, 1)}
+	err = r.MovieCreate(ctx, users[0].ID, movie)
+	require.NoError(err)
+
+	err = r.UserAnonymize(ctx, users[0].ID)
+	require.NoError(err)
+
+	user, err := r.UserGet(ctx, users[0].ID)
+	require.NoError(err)
+	require.True(user.DeletedAt.Valid)
+	require.NotEqual(users[0].Email, user.Email)
+	require.Empty(user.HashedPassword)
+	require.False(user.FirstName.Valid)
+	require.False(user.LastName.Valid)
+	require.False(user.Bio.Valid)
+	require.False(user.Birthdate.Valid)
+
+	status, err = r.UserStatusGet(ctx, users[0].ID)
+	require.NoError(err)
+	require.True(status.DeletedAt.Valid)
+	require.False(status.DeletionRequestedAt.Valid)
+
+	// the pending deletion, profile history and followings are gone
+
+	_, err = r.UserDeletionGet(ctx, users[0].ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	nAudits, err := r.UserAuditsCount(ctx, users[0].ID)
+	require.NoError(err)
+	require.Equal(0, nAudits)
+
+	followers, err := r.UserFollowersGetAll(ctx, users[1].ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(0, len(followers))
+	followings, err := r.UserFollowingsGetAll(ctx, users[1].ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(0, len(followings))
+
+	// the contributions stay attributed to the tombstone
+
+	fetchedMovie, err := r.MovieGet(ctx, movie.ID, false)
+	require.NoError(err)
+	require.Equal(users[0].ID, fetchedMovie.ContributedBy)
+
+	// tombstones are neither anonymized nor updated again
+
+	err = r.UserAnonymize(ctx, users[0].ID)
+	require.Equal(repo.ErrNoRecord, err)
+	err = r.UserUpdate(
+		ctx,
+		users[0].ID,
+		map[string]any{models.UserColumns.Bio: "bio"},
+	)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// the email is freed
+
+	err = r.UserCreate(ctx, &models.User{Email: users[0].Email})
+	require.NoError(err)
+}
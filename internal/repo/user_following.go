@@ -0,0 +1,139 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// UserFollow makes followerID follow followedID. Following a user twice is a
+// no-op.
+func (repo *Repository) UserFollow(
+	ctx context.Context,
+	followerID, followedID int,
+) error {
+	following := &models.UserFollowing{
+		FollowerID: followerID,
+		FollowedID: followedID,
+	}
+	return following.Upsert(
+		ctx,
+		repo.exec,
+		false,
+		[]string{
+			models.UserFollowingColumns.FollowerID,
+			models.UserFollowingColumns.FollowedID,
+		},
+		boil.None(),
+		boil.Infer(),
+	)
+}
+
+func (repo *Repository) UserUnfollow(
+	ctx context.Context,
+	followerID, followedID int,
+) error {
+	rowsAff, err := models.UserFollowings(
+		models.UserFollowingWhere.FollowerID.EQ(followerID),
+		models.UserFollowingWhere.FollowedID.EQ(followedID),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// UserFollowersGetAll returns the users following userID, earliest first.
+func (repo *Repository) UserFollowersGetAll(
+	ctx context.Context,
+	userID int,
+	offset, limit int,
+) ([]*models.User, error) {
+	return repo.userFollowingsJoin(
+		ctx,
+		models.UserFollowingColumns.FollowerID,
+		models.UserFollowingColumns.FollowedID,
+		userID,
+		offset,
+		limit,
+	)
+}
+
+func (repo *Repository) UserFollowersCount(
+	ctx context.Context,
+	userID int,
+) (int, error) {
+	nFollowers, err := models.UserFollowings(
+		models.UserFollowingWhere.FollowedID.EQ(userID),
+	).Count(ctx, repo.exec)
+	return int(nFollowers), err
+}
+
+// UserFollowingsGetAll returns the users followed by userID, earliest first.
+func (repo *Repository) UserFollowingsGetAll(
+	ctx context.Context,
+	userID int,
+	offset, limit int,
+) ([]*models.User, error) {
+	return repo.userFollowingsJoin(
+		ctx,
+		models.UserFollowingColumns.FollowedID,
+		models.UserFollowingColumns.FollowerID,
+		userID,
+		offset,
+		limit,
+	)
+}
+
+func (repo *Repository) UserFollowingsCount(
+	ctx context.Context,
+	userID int,
+) (int, error) {
+	nFollowings, err := models.UserFollowings(
+		models.UserFollowingWhere.FollowerID.EQ(userID),
+	).Count(ctx, repo.exec)
+	return int(nFollowings), err
+}
+
+// userFollowingsJoin selects the users on the joinColumn side of the
+// followings whose whereColumn equals userID.
+func (repo *Repository) userFollowingsJoin(
+	ctx context.Context,
+	joinColumn string,
+	whereColumn string,
+	userID int,
+	offset, limit int,
+) ([]*models.User, error) {
+	users, err := models.Users(
+		qm.Select(models.TableNames.Users+".*"),
+		qm.InnerJoin(
+			fmt.Sprintf(
+				"%[1]s ON %[1]s.%[2]s = %[3]s.%[4]s",
+				models.TableNames.UserFollowings,
+				joinColumn,
+				models.TableNames.Users,
+				models.UserColumns.ID,
+			),
+		),
+		qm.Where(
+			models.TableNames.UserFollowings+"."+whereColumn+" = ?",
+			userID,
+		),
+		qm.Offset(offset),
+		qm.Limit(limit),
+		qm.OrderBy(
+			models.TableNames.UserFollowings+"."+models.UserFollowingColumns.FollowedAt+", "+
+				models.TableNames.Users+"."+models.UserColumns.ID,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
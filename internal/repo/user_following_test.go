@@ -0,0 +1,91 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserFollowings(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	users := make([]*models.User, 3)
+	for i := range users {
+		users[i] = &models.User{Email: "email" + string(rune('a'+i))}
+		err = r.UserCreate(ctx, users[i])
+		require.NoError(err)
+	}
+
+	// first there's no followings
+
+	err = r.UserUnfollow(ctx, users[0].ID, users[1].ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	followers, err := r.UserFollowersGetAll(ctx, users[1].ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(0, len(followers))
+
+	// follow users and following twice is a no-op
+
+	err = r.UserFollow(ctx, users[0].ID, users[1].ID)
+	require.NoError(err)
+	err = r.UserFollow(ctx, users[0].ID, users[1].ID)
+	require.NoError(err)
+	err = r.UserFollow(ctx, users[2].ID, users[1].ID)
+	require.NoError(err)
+	err = r.UserFollow(ctx, users[0].ID, users[2].ID)
+	require.NoError(err)
+
+	followers, err = r.UserFollowersGetAll(ctx, users[1].ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(2, len(followers))
+	require.Equal(users[0].ID, followers[0].ID)
+	require.Equal(users[2].ID, followers[1].ID)
+
+	nFollowers, err := r.UserFollowersCount(ctx, users[1].ID)
+	require.NoError(err)
+	require.Equal(2, nFollowers)
+
+	followings, err := r.UserFollowingsGetAll(ctx, users[0].ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(2, len(followings))
+	require.Equal(users[1].ID, followings[0].ID)
+	require.Equal(users[2].ID, followings[1].ID)
+
+	nFollowings, err := r.UserFollowingsCount(ctx, users[0].ID)
+	require.NoError(err)
+	require.Equal(2, nFollowings)
+
+	// unfollow a user
+
+	err = r.UserUnfollow(ctx, users[0].ID, users[1].ID)
+	require.NoError(err)
+
+	nFollowers, err = r.UserFollowersCount(ctx, users[1].ID)
+	require.NoError(err)
+	require.Equal(1, nFollowers)
+
+	// deleting a user removes their followings
+
+	err = r.UserDelete(ctx, users[2].ID)
+	require.NoError(err)
+
+	nFollowers, err = r.UserFollowersCount(ctx, users[1].ID)
+	require.NoError(err)
+	require.Equal(0, nFollowers)
+
+	nFollowings, err = r.UserFollowingsCount(ctx, users[0].ID)
+	require.NoError(err)
+	require.Equal(0, nFollowings)
+}
@@ -595,3 +595,116 @@ func TestUserUpdate_UpdateFields(t *testing.T) {
 		})
 	}
 }
+
+func TestUserAudits(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{
+		Email:          "username@example.com",
+		HashedPassword: "jfdjsfks",
+	}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	// no audits yet
+
+	audits, err := r.UserAuditsGetAll(ctx, user.ID, 0, 10)
+	require.NoError(err)
+	require.Len(audits, 0)
+	count, err := r.UserAuditsCount(ctx, user.ID)
+	require.NoError(err)
+	require.Equal(0, count)
+
+	// every update audits the previous profile
+
+	updates := []map[string]any{
+		{models.UserColumns.FirstName: "first name"},
+		{models.UserColumns.Email: "new@example.com"},
+		{models.UserColumns.HashedPassword: "new hash"},
+	}
+	for _, cols := range updates {
+		err := r.UserUpdate(ctx, user.ID, cols)
+		require.NoError(err)
+	}
+
+	count, err = r.UserAuditsCount(ctx, user.ID)
+	require.NoError(err)
+	require.Equal(len(updates), count)
+
+	audits, err = r.UserAuditsGetAll(ctx, user.ID, 0, 10)
+	require.NoError(err)
+	require.Len(audits, len(updates))
+
+	// newest first
+	require.Equal("new@example.com", audits[0].Email)
+	require.Equal(null.StringFrom("first name"), audits[0].FirstName)
+	require.Equal(user.Email, audits[1].Email)
+	require.Equal(null.StringFrom("first name"), audits[1].FirstName)
+	require.Equal(user.Email, audits[2].Email)
+	require.False(audits[2].FirstName.Valid)
+	require.True(audits[2].ContributedAt.Equal(user.ContributedAt))
+
+	// pagination
+
+	audits, err = r.UserAuditsGetAll(ctx, user.ID, 1, 1)
+	require.NoError(err)
+	require.Len(audits, 1)
+	require.Equal(user.Email, audits[0].Email)
+	require.Equal(null.StringFrom("first name"), audits[0].FirstName)
+}
+
+func TestUserAudits_SameTransaction(t *testing.T) {
+	require := require.New(t)
+
+	teardown, err := setup()
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{
+		Email:          "username@example.com",
+		HashedPassword: "jfdjsfks",
+	}
+
+	// the audits of updates made in one transaction are told apart
+	err = r.Transaction(ctx, func(ctx context.Context, tx repo.Service) error {
+		if err := tx.UserCreate(ctx, user); err != nil {
+			return err
+		}
+		err := tx.UserUpdate(
+			ctx,
+			user.ID,
+			map[string]any{models.UserColumns.FirstName: "first name"},
+		)
+		if err != nil {
+			return err
+		}
+		return tx.UserUpdate(
+			ctx,
+			user.ID,
+			map[string]any{models.UserColumns.LastName: "last name"},
+		)
+	})
+	require.NoError(err)
+
+	audits, err := r.UserAuditsGetAll(ctx, user.ID, 0, 10)
+	require.NoError(err)
+	require.Len(audits, 2)
+	require.True(audits[0].ContributedAt.After(audits[1].ContributedAt))
+	require.Equal(null.StringFrom("first name"), audits[0].FirstName)
+	require.False(audits[0].LastName.Valid)
+	require.False(audits[1].FirstName.Valid)
+
+	gotUser, err := r.UserGet(ctx, user.ID)
+	require.NoError(err)
+	require.True(gotUser.ContributedAt.After(audits[0].ContributedAt))
+}
@@ -0,0 +1,100 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (repo *Repository) WatchlistGetAll(
+	ctx context.Context,
+	userID int,
+	offset, limit int,
+) ([]*models.Watchlist, error) {
+	watchlist, err := models.Watchlists(
+		models.WatchlistWhere.UserID.EQ(userID),
+		qm.Offset(offset),
+		qm.Limit(limit),
+		qm.OrderBy(
+			models.WatchlistColumns.AddedAt+", "+models.WatchlistColumns.FilmID,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return watchlist, nil
+}
+
+func (repo *Repository) WatchlistCount(
+	ctx context.Context,
+	userID int,
+) (int, error) {
+	nFilms, err := models.Watchlists(
+		models.WatchlistWhere.UserID.EQ(userID),
+	).Count(ctx, repo.exec)
+	return int(nFilms), err
+}
+
+// WatchlistAddPlaylist adds all the playlist films not already on the user's
+// watchlist and returns the number of films added.
+func (repo *Repository) WatchlistAddPlaylist(
+	ctx context.Context,
+	userID int,
+	playlistID int,
+) (int, error) {
+	result, err := queries.Raw(
+		fmt.Sprintf(
+			"INSERT INTO %[1]s (%[2]s, %[3]s) "+
+				"SELECT $1, %[5]s FROM %[4]s WHERE %[6]s = $2 ORDER BY %[7]s "+
+				"ON CONFLICT DO NOTHING",
+			models.TableNames.Watchlists,
+			models.WatchlistColumns.UserID,
+			models.WatchlistColumns.FilmID,
+			models.TableNames.PlaylistFilms,
+			models.PlaylistFilmColumns.FilmID,
+			models.PlaylistFilmColumns.PlaylistID,
+			models.PlaylistFilmColumns.Position,
+		),
+		userID,
+		playlistID,
+	).ExecContext(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	nAdded, err := result.RowsAffected()
+	return int(nAdded), err
+}
+
+// WatchlistMarkWatched records the time the user watched a film on their
+// watchlist.
+func (repo *Repository) WatchlistMarkWatched(
+	ctx context.Context,
+	userID int,
+	filmID int,
+) error {
+	result, err := queries.Raw(
+		fmt.Sprintf(
+			"UPDATE %[1]s SET %[2]s = CURRENT_TIMESTAMP WHERE %[3]s = $1 AND %[4]s = $2",
+			models.TableNames.Watchlists,
+			models.WatchlistColumns.WatchedAt,
+			models.WatchlistColumns.UserID,
+			models.WatchlistColumns.FilmID,
+		),
+		userID,
+		filmID,
+	).ExecContext(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/aria3ppp/watch-server/internal/search (interfaces: Service)
+
+// Package mock_search is a generated GoMock package.
+package mock_search
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/aria3ppp/watch-server/internal/models"
+	repo "github.com/aria3ppp/watch-server/internal/repo"
+	search "github.com/aria3ppp/watch-server/internal/search"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// SearchMovies mocks base method.
+func (m *MockService) SearchMovies(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*models.Film, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMovies", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Film)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchMovies indicates an expected call of SearchMovies.
+func (mr *MockServiceMockRecorder) SearchMovies(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMovies", reflect.TypeOf((*MockService)(nil).SearchMovies), arg0, arg1, arg2, arg3)
+}
+
+// SearchMoviesPage mocks base method.
+func (m *MockService) SearchMoviesPage(arg0 context.Context, arg1 string, arg2 *repo.KeysetPage) ([]*search.MovieHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMoviesPage", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*search.MovieHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMoviesPage indicates an expected call of SearchMoviesPage.
+func (mr *MockServiceMockRecorder) SearchMoviesPage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMoviesPage", reflect.TypeOf((*MockService)(nil).SearchMoviesPage), arg0, arg1, arg2)
+}
+
+// SearchSerieses mocks base method.
+func (m *MockService) SearchSerieses(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*models.Series, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSerieses", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Series)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchSerieses indicates an expected call of SearchSerieses.
+func (mr *MockServiceMockRecorder) SearchSerieses(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSerieses", reflect.TypeOf((*MockService)(nil).SearchSerieses), arg0, arg1, arg2, arg3)
+}
+
+// SearchSeriesesPage mocks base method.
+func (m *MockService) SearchSeriesesPage(arg0 context.Context, arg1 string, arg2 *repo.KeysetPage) ([]*search.SeriesHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSeriesesPage", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*search.SeriesHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSeriesesPage indicates an expected call of SearchSeriesesPage.
+func (mr *MockServiceMockRecorder) SearchSeriesesPage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSeriesesPage", reflect.TypeOf((*MockService)(nil).SearchSeriesesPage), arg0, arg1, arg2)
+}
@@ -1,6 +1,7 @@
 package search
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -9,9 +10,13 @@ import (
 	"time"
 
 	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
 	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
 )
 
+//go:generate mockgen -destination mock_search/mock_service.go . Service
+
 type Service interface {
 	SearchSerieses(
 		ctx context.Context,
@@ -23,6 +28,39 @@ type Service interface {
 		query string,
 		from, size int,
 	) (hits []*models.Film, totalHits int, err error)
+	// SearchSeriesesPage is the keyset paginated counterpart of
+	// SearchSerieses, keyed by the score and then the id of the hits. The
+	// hits aren't counted.
+	SearchSeriesesPage(
+		ctx context.Context,
+		query string,
+		page *repo.KeysetPage,
+	) (hits []*SeriesHit, err error)
+	// SearchMoviesPage is the keyset paginated counterpart of SearchMovies,
+	// keyed by the score and then the id of the hits. The hits aren't
+	// counted.
+	SearchMoviesPage(
+		ctx context.Context,
+		query string,
+		page *repo.KeysetPage,
+	) (hits []*MovieHit, err error)
+}
+
+// Rank is where a hit stands among the others: the most relevant first and
+// then by id.
+type Rank struct {
+	Score float64
+	ID    string
+}
+
+type SeriesHit struct {
+	Series *models.Series
+	Rank
+}
+
+type MovieHit struct {
+	Movie *models.Film
+	Rank
 }
 
 type ElasticSearch struct {
@@ -83,15 +121,15 @@ func (e *ElasticSearch) SearchSerieses(
 	from, size int,
 ) (hits []*models.Series, totalHits int, err error) {
 	// prepare search query
-	searchQuery := fmt.Sprintf(
-		`{"query": {"multi_match": {"query": "%s", "fields": ["title", "descriptions"], "fuzziness": "AUTO"}}}`,
-		query,
-	)
+	searchQuery, err := json.Marshal(map[string]any{"query": matchQuery(query)})
+	if err != nil {
+		return nil, 0, err
+	}
 	// search query
 	resp, err := e.client.Search(
 		e.client.Search.WithContext(ctx),
 		e.client.Search.WithIndex("series"),
-		e.client.Search.WithBody(strings.NewReader(searchQuery)),
+		e.client.Search.WithBody(bytes.NewReader(searchQuery)),
 		e.client.Search.WithTrackTotalHits(true),
 		e.client.Search.WithFrom(from),
 		e.client.Search.WithSize(size),
@@ -100,16 +138,7 @@ func (e *ElasticSearch) SearchSerieses(
 		return nil, 0, err
 	}
 	if resp.IsError() {
-		var em map[string]interface{}
-		if err := json.NewDecoder(resp.Body).Decode(&em); err != nil {
-			return nil, 0, err
-		}
-		return nil, 0, fmt.Errorf(
-			"[%s] %s: %s",
-			resp.Status(),
-			em["error"].(map[string]interface{})["type"],
-			em["error"].(map[string]interface{})["reason"],
-		)
+		return nil, 0, responseError(resp)
 	}
 	// decode response body
 	type R struct {
@@ -126,7 +155,7 @@ func (e *ElasticSearch) SearchSerieses(
 	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
 		return nil, 0, err
 	}
-	hits = make([]*models.Series, min(size, r.Hits.Total.Value))
+	hits = make([]*models.Series, len(r.Hits.Hits))
 	for i, h := range r.Hits.Hits {
 		hits[i] = h.Source
 	}
@@ -139,15 +168,15 @@ func (e *ElasticSearch) SearchMovies(
 	from, size int,
 ) (hits []*models.Film, totalHits int, err error) {
 	// prepare search query
-	searchQuery := fmt.Sprintf(
-		`{"query": {"multi_match": {"query": "%s", "fields": ["title", "descriptions"], "fuzziness": "AUTO"}}}`,
-		query,
-	)
+	searchQuery, err := json.Marshal(map[string]any{"query": matchQuery(query)})
+	if err != nil {
+		return nil, 0, err
+	}
 	// search query
 	resp, err := e.client.Search(
 		e.client.Search.WithContext(ctx),
 		e.client.Search.WithIndex("movie"),
-		e.client.Search.WithBody(strings.NewReader(searchQuery)),
+		e.client.Search.WithBody(bytes.NewReader(searchQuery)),
 		e.client.Search.WithTrackTotalHits(true),
 		e.client.Search.WithFrom(from),
 		e.client.Search.WithSize(size),
@@ -156,16 +185,7 @@ func (e *ElasticSearch) SearchMovies(
 		return nil, 0, err
 	}
 	if resp.IsError() {
-		var em map[string]interface{}
-		if err := json.NewDecoder(resp.Body).Decode(&em); err != nil {
-			return nil, 0, err
-		}
-		return nil, 0, fmt.Errorf(
-			"[%s] %s: %s",
-			resp.Status(),
-			em["error"].(map[string]interface{})["type"],
-			em["error"].(map[string]interface{})["reason"],
-		)
+		return nil, 0, responseError(resp)
 	}
 	// decode response body
 	type R struct {
@@ -182,18 +202,154 @@ func (e *ElasticSearch) SearchMovies(
 	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
 		return nil, 0, err
 	}
-	hits = make([]*models.Film, min(size, r.Hits.Total.Value))
+	hits = make([]*models.Film, len(r.Hits.Hits))
 	for i, h := range r.Hits.Hits {
 		hits[i] = h.Source
 	}
 	return hits, r.Hits.Total.Value, nil
 }
 
-func min(a, b int) int {
-	if a < b {
-		return a
+func (e *ElasticSearch) SearchSeriesesPage(
+	ctx context.Context,
+	query string,
+	page *repo.KeysetPage,
+) (hits []*SeriesHit, err error) {
+	serieses, ranks, err := searchPage[*models.Series](
+		ctx,
+		e.client,
+		"series",
+		query,
+		page,
+	)
+	if err != nil {
+		return nil, err
+	}
+	hits = make([]*SeriesHit, len(serieses))
+	for i := range serieses {
+		hits[i] = &SeriesHit{Series: serieses[i], Rank: ranks[i]}
+	}
+	return hits, nil
+}
+
+func (e *ElasticSearch) SearchMoviesPage(
+	ctx context.Context,
+	query string,
+	page *repo.KeysetPage,
+) (hits []*MovieHit, err error) {
+	movies, ranks, err := searchPage[*models.Film](
+		ctx,
+		e.client,
+		"movie",
+		query,
+		page,
+	)
+	if err != nil {
+		return nil, err
+	}
+	hits = make([]*MovieHit, len(movies))
+	for i := range movies {
+		hits[i] = &MovieHit{Movie: movies[i], Rank: ranks[i]}
+	}
+	return hits, nil
+}
+
+// searchPage searches a page of the hits of query in index past the score
+// and id of page.After, by the search_after of elasticsearch. It returns the
+// sources of the hits along with their ranks.
+func searchPage[T any](
+	ctx context.Context,
+	client *elasticsearch.Client,
+	index string,
+	query string,
+	page *repo.KeysetPage,
+) (sources []T, ranks []Rank, err error) {
+	// backward pages run the order in reverse
+	scoreOrder, idOrder := "desc", "asc"
+	if page.Backward {
+		scoreOrder, idOrder = "asc", "desc"
+	}
+	body := map[string]any{
+		"query": matchQuery(query),
+		"sort": []map[string]string{
+			{"_score": scoreOrder},
+			{"id": idOrder},
+		},
+		"size":             page.Limit,
+		"track_total_hits": false,
+	}
+	if page.After != nil {
+		body["search_after"] = page.After
+	}
+	searchQuery, err := json.Marshal(body)
+	if err != nil {
+		return nil, nil, err
+	}
+	// search query
+	resp, err := client.Search(
+		client.Search.WithContext(ctx),
+		client.Search.WithIndex(index),
+		client.Search.WithBody(bytes.NewReader(searchQuery)),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.IsError() {
+		return nil, nil, responseError(resp)
+	}
+	// decode response body, the sort values of a hit being its score and id
+	var r struct {
+		Hits struct {
+			Hits []struct {
+				Source T                  `json:"_source"`
+				Sort   [2]json.RawMessage `json:"sort"`
+			}
+		}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return nil, nil, err
+	}
+	sources = make([]T, len(r.Hits.Hits))
+	ranks = make([]Rank, len(r.Hits.Hits))
+	for i, h := range r.Hits.Hits {
+		// put the hits of a backward page back in order
+		if page.Backward {
+			i = len(r.Hits.Hits) - 1 - i
+		}
+		sources[i] = h.Source
+		if err := json.Unmarshal(h.Sort[0], &ranks[i].Score); err != nil {
+			return nil, nil, err
+		}
+		if err := json.Unmarshal(h.Sort[1], &ranks[i].ID); err != nil {
+			return nil, nil, err
+		}
+	}
+	return sources, ranks, nil
+}
+
+// matchQuery matches query against the titles and descriptions
+func matchQuery(query string) map[string]any {
+	return map[string]any{
+		"multi_match": map[string]any{
+			"query":     query,
+			"fields":    []string{"title", "descriptions"},
+			"fuzziness": "AUTO",
+		},
 	}
-	return b
+}
+
+// responseError is the error of an elasticsearch error response
+func responseError(resp *esapi.Response) error {
+	var em map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&em); err != nil {
+		return err
+	}
+	return fmt.Errorf(
+		"[%s] %s: %s",
+		resp.Status(),
+		em["error"].(map[string]interface{})["type"],
+		em["error"].(map[string]interface{})["reason"],
+	)
 }
 
 // /*
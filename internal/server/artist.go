@@ -0,0 +1,405 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/server/request"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/artist/:id/
+func (s *Server) HandleArtistGet(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleArtistGet: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// fetch artist
+	artist, err := s.app.ArtistGet(c.Request().Context(), params.ID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleArtistGet: artist not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleArtistGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(artist))
+}
+
+// POST /v1/authorized/artist/
+func (s *Server) HandleArtistCreate(c echo.Context) error {
+	// bind & validate request
+	var req dto.ArtistCreateRequest
+	err := (&echo.DefaultBinder{}).BindBody(c, &req)
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleArtistCreate: request binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidRequest, err.Error()),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleArtistCreate: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// create artist
+	artistID, err := s.app.ArtistCreate(
+		c.Request().Context(),
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		s.loggerOf(c).Error(
+			"server.HandleArtistCreate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(artistID))
+}
+
+// GET /v1/authorized/artist/:id/image/:image_id/
+func (s *Server) HandleArtistImageGet(c echo.Context) error {
+	// bind & validate params
+	var params request.ArtistImageIDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleArtistImageGet: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// fetch image
+	image, err := s.app.ArtistImageGet(
+		c.Request().Context(),
+		params.ArtistID,
+		params.ImageID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleArtistImageGet: image not found",
+				zap.Int("artist id", params.ArtistID),
+				zap.Int("image id", params.ImageID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleArtistImageGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(image))
+}
+
+// GET /v1/authorized/artist/:id/image/?page=1&per_page=100
+func (s *Server) HandleArtistImagesGetAll(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleArtistImagesGetAll: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+
+	// fetch images
+	images, total, err := s.app.ArtistImagesGetAll(
+		c.Request().Context(),
+		params.ID,
+		offset,
+		perPage,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleArtistImagesGetAll: artist not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleArtistImagesGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(page, perPage, images, total),
+	)
+}
+
+// POST /v1/authorized/artist/:id/image/
+func (s *Server) HandleArtistImageUpload(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleArtistImageUpload: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// fetch uploaded file
+	fileHeader, err := c.FormFile(MediaFormField)
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleArtistImageUpload: request binding failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidRequest, err.Error()),
+		)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		s.loggerOf(c).Error(
+			"server.HandleArtistImageUpload: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+	defer file.Close()
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleArtistImageUpload: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// upload image
+	imageID, err := s.app.ArtistImageUpload(
+		c.Request().Context(),
+		params.ID,
+		payload.UserID,
+		file,
+	)
+	if err != nil {
+		switch err {
+		case app.ErrNotFound:
+			s.loggerOf(c).Info(
+				"server.HandleArtistImageUpload: artist not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		case app.ErrMediaTooLarge:
+			s.loggerOf(c).Info(
+				"server.HandleArtistImageUpload: image too large",
+				zap.Int64("size", fileHeader.Size),
+			)
+			return echo.NewHTTPError(
+				http.StatusRequestEntityTooLarge,
+				response.Error(response.StatusMediaTooLarge),
+			)
+		case app.ErrUnsupportedMediaType:
+			s.loggerOf(c).Info(
+				"server.HandleArtistImageUpload: unsupported media type",
+				zap.String("filename", fileHeader.Filename),
+			)
+			return echo.NewHTTPError(
+				http.StatusUnsupportedMediaType,
+				response.Error(response.StatusUnsupportedMediaType),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleArtistImageUpload: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(imageID))
+}
+
+// DELETE /v1/authorized/artist/:id/image/:image_id/
+func (s *Server) HandleArtistImageInvalidate(c echo.Context) error {
+	// bind & validate params
+	var params request.ArtistImageIDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleArtistImageInvalidate: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// bind & validate request
+	var req dto.InvalidationRequest
+	err = (&echo.DefaultBinder{}).BindBody(c, &req)
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleArtistImageInvalidate: request binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidRequest, err.Error()),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleArtistImageInvalidate: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// invalidate image
+	err = s.app.ArtistImageInvalidate(
+		c.Request().Context(),
+		params.ArtistID,
+		params.ImageID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleArtistImageInvalidate: image not found",
+				zap.Int("artist id", params.ArtistID),
+				zap.Int("image id", params.ImageID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleArtistImageInvalidate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
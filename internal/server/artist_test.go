@@ -0,0 +1,231 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	appServer "github.com/aria3ppp/watch-server/internal/server"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestHandleArtistCreate(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(
+		OptEnableDefaultUser,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/artist/"
+	method := http.MethodPost
+
+	// invalid request
+	e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ArtistCreateRequest{}).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		ValueEqual("status", response.StatusInvalidRequest.String())
+
+	// create artist
+	req := &dto.ArtistCreateRequest{
+		FirstName: "first",
+		Bio:       null.StringFrom("bio"),
+	}
+	artistID := int(e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("payload").
+		Number().
+		Raw())
+
+	gotArtist, err := appInstance.ArtistGet(ctx, artistID)
+	require.NoError(err)
+	require.Equal(req.FirstName, gotArtist.FirstName)
+	require.Equal(req.Bio, gotArtist.Bio)
+	require.False(gotArtist.LastName.Valid)
+	require.Equal(defaults.user.id, gotArtist.ContributedBy)
+
+	// get artist
+	getPath := "/v1/authorized/artist/{id}/"
+	e.Request(http.MethodGet, getPath).
+		WithPath("id", artistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(gotArtist))
+
+	// artist not found
+	e.Request(http.MethodGet, getPath).
+		WithPath("id", artistID+1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+}
+
+func TestHandleArtistImageUpload(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(
+		OptEnableDefaultUser,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/artist/{id}/image/"
+	method := http.MethodPost
+
+	var content bytes.Buffer
+	err = png.Encode(&content, image.NewRGBA(image.Rect(0, 0, 640, 480)))
+	require.NoError(err)
+
+	// artist not found
+	e.Request(method, path).
+		WithPath("id", 999).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFileBytes(appServer.MediaFormField, "image.png", content.Bytes()).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	artistID, err := appInstance.ArtistCreate(
+		ctx,
+		defaults.user.id,
+		&dto.ArtistCreateRequest{FirstName: "first"},
+	)
+	require.NoError(err)
+
+	// videos are not accepted
+	mp4Content := []byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom")
+	e.Request(method, path).
+		WithPath("id", artistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFileBytes(appServer.MediaFormField, "video.mp4", mp4Content).
+		Expect().
+		Status(http.StatusUnsupportedMediaType).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusUnsupportedMediaType))
+
+	// request body too large, bounded by the image size limit
+	e.Request(method, path).
+		WithPath("id", artistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(echo.HeaderContentType, "multipart/form-data; boundary=boundary").
+		WithChunked(io.MultiReader(
+			strings.NewReader(
+				"--boundary\r\n"+
+					`Content-Disposition: form-data; name="`+appServer.MediaFormField+`"; filename="image.png"`+"\r\n\r\n",
+			),
+			io.LimitReader(
+				zeroReader{},
+				config.Config.Validation.Media.Image.MaxSizeInBytes+2<<10,
+			),
+		)).
+		Expect().
+		Status(http.StatusRequestEntityTooLarge).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusMediaTooLarge))
+
+	// upload image
+	imageID := int(e.Request(method, path).
+		WithPath("id", artistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFileBytes(appServer.MediaFormField, "image.png", content.Bytes()).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("payload").
+		Number().
+		Raw())
+
+	gotImage, err := appInstance.ArtistImageGet(ctx, artistID, imageID)
+	require.NoError(err)
+	require.Equal("image/png", gotImage.MimeType)
+	require.Equal(int64(content.Len()), gotImage.SizeInBytes)
+
+	// fetch the uploaded blobs
+	blobPath := "/v1/authorized/media/{key}/"
+	e.Request(http.MethodGet, blobPath).
+		WithPath("key", gotImage.ImageURL).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		ContentType("image/png").
+		Body().
+		Equal(content.String())
+	e.Request(http.MethodGet, blobPath).
+		WithPath("key", gotImage.ThumbnailURL).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		ContentType("image/jpeg")
+
+	// list images
+	e.Request(http.MethodGet, path).
+		WithPath("id", artistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.Paginated(config.Config.Pagination.Page.MinValue, config.Config.Pagination.PageSize.DefaultValue, []*models.ArtistImageURL{gotImage}, 1))
+
+	// invalidate image
+	req := &dto.InvalidationRequest{Invalidation: "invalidation"}
+	e.Request(http.MethodDelete, path+"{image_id}/").
+		WithPath("id", artistID).
+		WithPath("image_id", imageID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	gotImage, err = appInstance.ArtistImageGet(ctx, artistID, imageID)
+	require.NoError(err)
+	require.Equal(req.Invalidation, gotImage.Invalidation.String)
+
+	// the blob is kept for the audit trail
+	blob, err := appInstance.MediaBlobGet(ctx, gotImage.ImageURL)
+	require.NoError(err)
+	blob.Close()
+}
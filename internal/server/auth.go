@@ -2,10 +2,16 @@ package server
 
 import (
 	"net/http"
+	"strconv"
 
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/logging"
+	"github.com/aria3ppp/watch-server/internal/metrics"
 	"github.com/aria3ppp/watch-server/internal/server/response"
 	token_service "github.com/aria3ppp/watch-server/internal/token"
 	"github.com/labstack/echo/v4"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -20,6 +26,10 @@ var (
 		http.StatusUnauthorized,
 		response.Error(response.StatusTokenInvalid),
 	)
+	ErrUserDeletionPending error = echo.NewHTTPError(
+		http.StatusForbidden,
+		response.Error(response.StatusUserDeletionPending),
+	)
 )
 
 func FetchUserPayload(c echo.Context) *token_service.Payload {
@@ -31,40 +41,100 @@ func FetchUserPayload(c echo.Context) *token_service.Payload {
 }
 
 func (s *Server) AuthMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
-	return func(c echo.Context) error {
-		// extract token
-		auth := c.Request().Header.Get(echo.HeaderAuthorization)
-		token := token_service.ExtractTokenFromAuth(auth)
-		if token == "" {
-			s.logger.Info(
-				"server.AuthMiddleware: token missing/malformed",
-				zap.String(echo.HeaderAuthorization, auth),
-			)
-			return ErrTokenMissingOrMalformed
-		}
+	return s.authMiddleware(false)(next)
+}
+
+// AuthPendingDeletionMiddleware is AuthMiddleware letting through the users
+// of a pending deletion, for the routes they restore their account by.
+func (s *Server) AuthPendingDeletionMiddleware(
+	next echo.HandlerFunc,
+) echo.HandlerFunc {
+	return s.authMiddleware(true)(next)
+}
 
-		// validate token
-		payload, err := s.tokenService.ValidateToken(token)
-		if err != nil {
-			if err == token_service.ErrInvalidToken {
-				s.logger.Info(
-					"server.AuthMiddleware: invalid token",
-					zap.String("token", token),
+// authMiddleware validates the bearer token and rejects the tokens of users
+// anonymized since, or about to be unless pendingDeletionAllowed.
+func (s *Server) authMiddleware(pendingDeletionAllowed bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			// extract token
+			auth := c.Request().Header.Get(echo.HeaderAuthorization)
+			token := token_service.ExtractTokenFromAuth(auth)
+			if token == "" {
+				s.loggerOf(c).Info(
+					"server.AuthMiddleware: token missing/malformed",
+					zap.String(echo.HeaderAuthorization, auth),
 				)
-				return ErrTokenInvalid
+				metrics.TokenValidationFailuresTotal.
+					WithLabelValues(metrics.ReasonTokenMissing).
+					Inc()
+				return ErrTokenMissingOrMalformed
 			}
 
-			s.logger.Error(
-				"server.JWTMiddleware: internal server error", zap.Error(err),
+			// validate token
+			payload, err := s.tokenService.ValidateToken(token)
+			if err != nil {
+				if err == token_service.ErrInvalidToken {
+					s.loggerOf(c).Info(
+						"server.AuthMiddleware: invalid token",
+						zap.String("token", token),
+					)
+					metrics.TokenValidationFailuresTotal.
+						WithLabelValues(metrics.ReasonTokenInvalid).
+						Inc()
+					return ErrTokenInvalid
+				}
+
+				s.loggerOf(c).Error(
+					"server.JWTMiddleware: internal server error", zap.Error(err),
+				)
+				return echo.NewHTTPError(
+					http.StatusInternalServerError,
+					response.Error(response.StatusInternalServerError),
+				)
+			}
+
+			// reject the users anonymized since the token was issued, and the ones
+			// about to be unless let through
+			err = s.app.UserAuthorize(
+				c.Request().Context(),
+				payload.UserID,
+				pendingDeletionAllowed,
 			)
-			return echo.NewHTTPError(
-				http.StatusInternalServerError,
-				response.Error(response.StatusInternalServerError),
+			switch err {
+			case nil:
+			case app.ErrTokenInvalid:
+				s.loggerOf(c).Info(
+					"server.AuthMiddleware: user deleted",
+					zap.Int("user_id", payload.UserID),
+				)
+				metrics.TokenValidationFailuresTotal.
+					WithLabelValues(metrics.ReasonTokenInvalid).
+					Inc()
+				return ErrTokenInvalid
+			case app.ErrUserDeletionPending:
+				return ErrUserDeletionPending
+			default:
+				s.loggerOf(c).Error(
+					"server.AuthMiddleware: internal server error", zap.Error(err),
+				)
+				return echo.NewHTTPError(
+					http.StatusInternalServerError,
+					response.Error(response.StatusInternalServerError),
+				)
+			}
+
+			// set payload in context, and the user on the request logger
+			c.Set(PayloadKey, payload)
+			req := c.Request()
+			trace.SpanFromContext(req.Context()).
+				SetAttributes(semconv.EnduserIDKey.String(strconv.Itoa(payload.UserID)))
+			c.SetRequest(
+				req.WithContext(
+					logging.With(req.Context(), zap.Int("user_id", payload.UserID)),
+				),
 			)
+			return next(c)
 		}
-
-		// set payload in context
-		c.Set(PayloadKey, payload)
-		return next(c)
 	}
 }
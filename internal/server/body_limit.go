@@ -0,0 +1,65 @@
+package server
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// BodyLimitMiddleware rejects the requests of bodies of more than maxSize
+// bytes, by their content length if given and by failing the reads past
+// maxSize otherwise. It bounds the multipart forms, which are spooled to disk
+// as a whole once parsed, before the handler parses them.
+func (s *Server) BodyLimitMiddleware(maxSize int64) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			if req.ContentLength > maxSize {
+				s.loggerOf(c).Info(
+					"server.BodyLimitMiddleware: request body too large",
+					zap.Int64("content length", req.ContentLength),
+				)
+				return echo.NewHTTPError(
+					http.StatusRequestEntityTooLarge,
+					response.Error(response.StatusMediaTooLarge),
+				)
+			}
+
+			body := &countingReadCloser{
+				ReadCloser: http.MaxBytesReader(c.Response(), req.Body, maxSize),
+			}
+			req.Body = body
+
+			err := next(c)
+			// the reads of the handler failed once the body reached maxSize,
+			// whatever it responded with
+			if err != nil && body.read >= maxSize {
+				s.loggerOf(c).Info(
+					"server.BodyLimitMiddleware: request body too large",
+					zap.Error(err),
+				)
+				return echo.NewHTTPError(
+					http.StatusRequestEntityTooLarge,
+					response.Error(response.StatusMediaTooLarge),
+				)
+			}
+			return err
+		}
+	}
+}
+
+// countingReadCloser counts the bytes read from the underlying reader
+type countingReadCloser struct {
+	io.ReadCloser
+	read int64
+}
+
+func (r *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.read += int64(n)
+	return n, err
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/server/request"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/user/:id/contributions/
+func (s *Server) HandleUserContributionsGetAll(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleUserContributionsGetAll: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+
+	// fetch contributions
+	contributions, total, err := s.app.ContributionsGetAll(
+		c.Request().Context(),
+		params.ID,
+		offset,
+		perPage,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleUserContributionsGetAll: user not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleUserContributionsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(page, perPage, contributions, total),
+	)
+}
+
+// GET /v1/authorized/leaderboard/?since=&until=
+func (s *Server) HandleLeaderboardGet(c echo.Context) error {
+	// bind & validate query
+	var query request.LeaderboardQueryParam
+	err := (&echo.DefaultBinder{}).BindQueryParams(c, &query)
+	if err == nil {
+		err = query.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleLeaderboardGet: query binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+
+	// rank contributors
+	contributors, total, err := s.app.ContributorsGetAll(
+		c.Request().Context(),
+		query.Since,
+		query.Until,
+		offset,
+		perPage,
+	)
+	if err != nil {
+		s.loggerOf(c).Error(
+			"server.HandleLeaderboardGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(page, perPage, contributors, total),
+	)
+}
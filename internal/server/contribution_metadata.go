@@ -0,0 +1,127 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"go.uber.org/zap"
+)
+
+// HeaderEditSummary carries the optional summary of a write, much like a
+// commit message.
+const HeaderEditSummary = "X-Edit-Summary"
+
+// FieldEditSummary carries the summary in the json body of a write instead,
+// taking precedence over HeaderEditSummary.
+const FieldEditSummary = "edit_summary"
+
+// the sizes of the metadata columns, longer values are cut to fit
+const (
+	maxRequestIDLength = 100
+	maxClientIPLength  = 45
+	maxUserAgentLength = 300
+)
+
+// ContributionMetadataMiddleware puts the metadata of the request on its
+// context so that the contributions made through it record them.
+func (s *Server) ContributionMetadataMiddleware(
+	next echo.HandlerFunc,
+) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+
+		// validate edit summary
+		summary, source, err := editSummaryOf(req)
+		if err != nil {
+			s.loggerOf(c).Info(
+				"server.ContributionMetadataMiddleware: reading request body failed",
+				zap.Error(err),
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusInvalidRequest),
+			)
+		}
+		summary = strings.TrimSpace(summary)
+		maxLength := config.Config.Validation.Request.EditSummary.MaxLength
+		err = validation.Validate(summary, validation.RuneLength(0, maxLength))
+		if err != nil {
+			s.loggerOf(c).Info(
+				"server.ContributionMetadataMiddleware: edit summary validation failed",
+				zap.Error(err),
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(
+					response.StatusInvalidRequest,
+					source+": "+err.Error(),
+				),
+			)
+		}
+
+		requestID := req.Header.Get(echo.HeaderXRequestID)
+		if requestID == "" {
+			requestID = c.Response().Header().Get(echo.HeaderXRequestID)
+		}
+
+		// the client ip is told by the IPExtractor of the router, just as
+		// the rate limits tell clients apart
+		md := &repo.ContributionMetadata{
+			EditSummary: nullStringOf(summary, maxLength),
+			RequestID:   nullStringOf(requestID, maxRequestIDLength),
+			ClientIP:    nullStringOf(c.RealIP(), maxClientIPLength),
+			UserAgent:   nullStringOf(req.UserAgent(), maxUserAgentLength),
+		}
+		c.SetRequest(
+			req.WithContext(repo.WithContributionMetadata(req.Context(), md)),
+		)
+		return next(c)
+	}
+}
+
+// editSummaryOf returns the edit summary of req along with where it's read
+// from: the edit_summary field of its json body if there's one, its
+// X-Edit-Summary header otherwise. The body is left for the handler to bind,
+// which reports a malformed one.
+func editSummaryOf(req *http.Request) (summary string, source string, err error) {
+	if req.Body != nil &&
+		strings.HasPrefix(
+			req.Header.Get(echo.HeaderContentType),
+			echo.MIMEApplicationJSON,
+		) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return "", "", err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		var fields struct {
+			EditSummary *string `json:"edit_summary"`
+		}
+		if json.Unmarshal(body, &fields) == nil && fields.EditSummary != nil {
+			return *fields.EditSummary, FieldEditSummary, nil
+		}
+	}
+	return req.Header.Get(HeaderEditSummary), HeaderEditSummary, nil
+}
+
+// nullStringOf returns s cut to maxLength characters, null if it's empty.
+func nullStringOf(s string, maxLength int) null.String {
+	if s == "" {
+		return null.String{}
+	}
+	if utf8.RuneCountInString(s) > maxLength {
+		s = string([]rune(s)[:maxLength])
+	}
+	return null.StringFrom(s)
+}
@@ -0,0 +1,289 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	appServer "github.com/aria3ppp/watch-server/internal/server"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestHandleUserContributionsGetAll(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(OptEnableDefaultUser)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/user/{id}/contributions/"
+
+	// invalid id
+	e.GET(path).
+		WithPath("id", -1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	// user not found
+	e.GET(path).
+		WithPath("id", 999).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	// create and invalidate a movie
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+	err = appInstance.MovieInvalidate(
+		ctx,
+		movieID,
+		defaults.user.id,
+		&dto.InvalidationRequest{Invalidation: "invalid"},
+	)
+	require.NoError(err)
+
+	expContributions, total, err := appInstance.ContributionsGetAll(
+		ctx,
+		defaults.user.id,
+		0,
+		config.Config.Pagination.PageSize.DefaultValue,
+	)
+	require.NoError(err)
+	require.Equal(2, total)
+	require.Equal(repo.ContributionActionInvalidate, expContributions[0].Action)
+	require.Equal(repo.ContributionActionCreate, expContributions[1].Action)
+
+	e.GET(path).
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(
+			response.Paginated(
+				config.Config.Pagination.Page.MinValue,
+				config.Config.Pagination.PageSize.DefaultValue,
+				expContributions,
+				total,
+			),
+		)
+}
+
+func TestHandleLeaderboardGet(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(OptEnableDefaultUser)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/leaderboard/"
+
+	// until before since
+	e.GET(path).
+		WithQuery("since", "2022-10-02T00:00:00Z").
+		WithQuery("until", "2022-10-01T00:00:00Z").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	_, err = appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	expContributors := []*repo.Contributor{
+		{Rank: 1, UserID: defaults.user.id, Contributions: 1},
+	}
+
+	// all time
+	e.GET(path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(
+			response.Paginated(
+				config.Config.Pagination.Page.MinValue,
+				config.Config.Pagination.PageSize.DefaultValue,
+				expContributors,
+				len(expContributors),
+			),
+		)
+
+	// window ending before the contribution
+	e.GET(path).
+		WithQuery("until", time.Now().Add(-time.Hour).Format(time.RFC3339)).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(
+			response.Paginated(
+				config.Config.Pagination.Page.MinValue,
+				config.Config.Pagination.PageSize.DefaultValue,
+				nil,
+				0,
+			),
+		)
+}
+
+func TestContributionMetadataMiddleware(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(OptEnableDefaultUser)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+
+	// edit summary too long
+	e.POST("/v1/authorized/movie/").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(
+			appServer.HeaderEditSummary,
+			strings.Repeat(
+				"a",
+				config.Config.Validation.Request.EditSummary.MaxLength+1,
+			),
+		).
+		WithJSON(&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		}).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		ValueEqual("status", response.StatusInvalidRequest.String())
+
+	// edit summary of the body too long
+	e.POST("/v1/authorized/movie/").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(map[string]any{
+			"title":         "movie",
+			"date_released": testutils.Date(2000, 1, 1),
+			"edit_summary": strings.Repeat(
+				"a",
+				config.Config.Validation.Request.EditSummary.MaxLength+1,
+			),
+		}).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		ValueEqual("status", response.StatusInvalidRequest.String())
+
+	// the edit summary of the body takes precedence over the header
+	otherMovieID := int(
+		e.POST("/v1/authorized/movie/").
+			WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+			WithHeader(appServer.HeaderEditSummary, "ignored").
+			WithJSON(map[string]any{
+				"title":         "other movie",
+				"date_released": testutils.Date(2000, 1, 1),
+				"edit_summary":  " add other movie ",
+			}).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("payload").Number().Raw(),
+	)
+
+	gotMovie, err := appInstance.MovieGet(ctx, otherMovieID, false)
+	require.NoError(err)
+	require.Equal("other movie", gotMovie.Title)
+	require.Equal(null.StringFrom("add other movie"), gotMovie.EditSummary)
+
+	// create and update a movie with a summary each
+	movieID := int(
+		e.POST("/v1/authorized/movie/").
+			WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+			WithHeader(appServer.HeaderEditSummary, "add movie").
+			WithHeader(echo.HeaderXRequestID, "request-1").
+			WithJSON(&dto.MovieCreateRequest{
+				Title:        "movie",
+				DateReleased: testutils.Date(2000, 1, 1),
+			}).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("payload").Number().Raw(),
+	)
+
+	e.PATCH("/v1/authorized/movie/{id}/").
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader("User-Agent", "agent").
+		WithHeader(echo.HeaderXForwardedFor, "203.0.113.1").
+		WithJSON(&dto.MovieUpdateRequest{Title: null.StringFrom("new title")}).
+		Expect().
+		Status(http.StatusOK)
+
+	// the update carries no summary nor request id of its own, and its
+	// client ip is the peer address rather than the one it claims
+	gotMovie, err = appInstance.MovieGet(ctx, movieID, false)
+	require.NoError(err)
+	require.Equal(null.String{}, gotMovie.EditSummary)
+	require.Equal(null.String{}, gotMovie.RequestID)
+	require.Equal(null.StringFrom(testClientIP), gotMovie.ClientIP)
+	require.Equal(null.StringFrom("agent"), gotMovie.UserAgent)
+
+	// the created revision is audited along with its metadata
+	audit := e.GET("/v1/authorized/movie/{id}/audits/").
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("payload").
+		Array().
+		Element(0).
+		Object()
+	audit.ValueEqual("edit_summary", "add movie")
+	audit.ValueEqual("request_id", "request-1")
+	audit.ValueEqual("client_ip", testClientIP)
+	audit.ValueEqual("user_agent", testUserAgent)
+}
@@ -5,13 +5,14 @@ import (
 
 	"github.com/aria3ppp/watch-server/internal/app"
 	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
 	"github.com/aria3ppp/watch-server/internal/server/request"
 	"github.com/aria3ppp/watch-server/internal/server/response"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 )
 
-// GET /v1/authorized/series/:id/season/:season_number/episode/:episode_number/
+// GET /v1/authorized/series/:id/season/:season_number/episode/:episode_number/?include_invalidated=true&include=series
 func (s *Server) HandleEpisodeGet(c echo.Context) error {
 	// bind & validate params
 	var params request.SeriesSeasonEpisodeNumberPathParam
@@ -20,7 +21,7 @@ func (s *Server) HandleEpisodeGet(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleEpisodeGet: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -30,16 +31,38 @@ func (s *Server) HandleEpisodeGet(c echo.Context) error {
 		)
 	}
 
+	// parse the relations to include
+	include, err := FetchIncludeQueryParam(
+		c.Request(),
+		includeSeries,
+	)
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleEpisodeGet: query validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+	var loads []string
+	if include[includeSeries] {
+		loads = append(loads, models.FilmRels.Series)
+	}
+
 	// fetch episode
 	episode, err := s.app.EpisodeGet(
 		c.Request().Context(),
 		params.SeriesID,
 		params.SeasonNumber,
 		params.EpisodeNumber,
+		FetchIncludeInvalidatedQueryParam(c.Request()),
+		loads...,
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleEpisodeGet: episdoe not found",
 				zap.Int("series id", params.SeriesID),
 				zap.Int("season number", params.SeriesID),
@@ -51,7 +74,7 @@ func (s *Server) HandleEpisodeGet(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleEpisodeGet: internal server error", zap.Error(err),
 		)
 		return echo.NewHTTPError(
@@ -60,10 +83,26 @@ func (s *Server) HandleEpisodeGet(c echo.Context) error {
 		)
 	}
 
-	return c.JSON(http.StatusOK, response.OK(episode))
+	// the included series changes apart from the episode, so only the
+	// episode alone is tagged
+	if len(include) == 0 && notModified(c, episode.ContributedAt) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	// embed the included relations
+	relations := make(map[string]any)
+	if include[includeSeries] {
+		relations[includeSeries] = episode.R.GetSeries()
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.OK(response.Embed(episode, relations)),
+	)
 }
 
-// GET /v1/authorized/series/:id/episode/?page=1&per_page=100
+// GET /v1/authorized/series/:id/episode/?page=1&per_page=100&include_invalidated=true
+// GET /v1/authorized/series/:id/episode/?cursor=&per_page=100&include_invalidated=true
 func (s *Server) HandleEpisodesGetAllBySeries(c echo.Context) error {
 	// bind & validate params
 	var params request.IDPathParam
@@ -72,7 +111,7 @@ func (s *Server) HandleEpisodesGetAllBySeries(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleEpisodesGetAllBySeries: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -82,18 +121,49 @@ func (s *Server) HandleEpisodesGetAllBySeries(c echo.Context) error {
 		)
 	}
 
-	// parse pagination params
-	page, perPage, offset := FetchPaginationQueryParams(c.Request())
-
-	// fetch episodes
-	episodes, total, err := s.app.EpisodesGetAllBySeries(
-		c.Request().Context(),
-		params.ID,
-		offset,
-		perPage,
-	)
+	// fetch episodes, by cursor if asked for
+	var res *response.ResponseValue
+	if CursorPaginationRequested(c.Request()) {
+		cursor, perPage := FetchCursorPaginationQueryParams(c.Request())
+		var (
+			episodes               []*models.Film
+			prevCursor, nextCursor string
+		)
+		episodes, prevCursor, nextCursor, err = s.app.EpisodesGetPageBySeries(
+			c.Request().Context(),
+			params.ID,
+			cursor,
+			perPage,
+			FetchIncludeInvalidatedQueryParam(c.Request()),
+		)
+		res = response.Cursored(perPage, episodes, prevCursor, nextCursor)
+	} else {
+		page, perPage, offset := FetchPaginationQueryParams(c.Request())
+		var (
+			episodes []*models.Film
+			total    int
+		)
+		episodes, total, err = s.app.EpisodesGetAllBySeries(
+			c.Request().Context(),
+			params.ID,
+			offset,
+			perPage,
+			FetchIncludeInvalidatedQueryParam(c.Request()),
+		)
+		res = response.Paginated(page, perPage, episodes, total)
+	}
 	if err != nil {
-		s.logger.Error(
+		if err == app.ErrCursorInvalid {
+			s.loggerOf(c).Info(
+				"server.HandleEpisodesGetAllBySeries: invalid cursor",
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusInvalidURLParameter),
+			)
+		}
+
+		s.loggerOf(c).Error(
 			"server.HandleEpisodesGetAllBySeries: internal server error",
 			zap.Error(err),
 		)
@@ -103,13 +173,10 @@ func (s *Server) HandleEpisodesGetAllBySeries(c echo.Context) error {
 		)
 	}
 
-	return c.JSON(
-		http.StatusOK,
-		response.Paginated(page, perPage, episodes, total),
-	)
+	return c.JSON(http.StatusOK, res)
 }
 
-// GET /v1/authorized/series/:id/season/:season_number/episode/?page=1&per_page=100
+// GET /v1/authorized/series/:id/season/:season_number/episode/?page=1&per_page=100&include_invalidated=true
 func (s *Server) HandleEpisodesGetAllBySeason(c echo.Context) error {
 	// bind & validate params
 	var params request.SeriesSeasonNumberPathParam
@@ -118,7 +185,7 @@ func (s *Server) HandleEpisodesGetAllBySeason(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleEpisodesGetAllBySeason: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -138,9 +205,10 @@ func (s *Server) HandleEpisodesGetAllBySeason(c echo.Context) error {
 		params.SeasonNumber,
 		offset,
 		perPage,
+		FetchIncludeInvalidatedQueryParam(c.Request()),
 	)
 	if err != nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleEpisodesGetAllBySeason: internal server error",
 			zap.Error(err),
 		)
@@ -165,7 +233,7 @@ func (s *Server) HandleEpisodePut(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleEpisodePut: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -182,7 +250,7 @@ func (s *Server) HandleEpisodePut(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleEpisodePut: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -194,7 +262,7 @@ func (s *Server) HandleEpisodePut(c echo.Context) error {
 
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleEpisodePut: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -205,7 +273,7 @@ func (s *Server) HandleEpisodePut(c echo.Context) error {
 	}
 
 	// put episode
-	err = s.app.EpisodePut(
+	proposal, err := s.app.EpisodePut(
 		c.Request().Context(),
 		params.SeriesID,
 		params.SeasonNumber,
@@ -215,7 +283,7 @@ func (s *Server) HandleEpisodePut(c echo.Context) error {
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleEpisodePut: series not found",
 				zap.Int("series id", params.SeriesID),
 			)
@@ -225,7 +293,20 @@ func (s *Server) HandleEpisodePut(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		if err == app.ErrPreconditionFailed {
+			s.loggerOf(c).Info(
+				"server.HandleEpisodePut: precondition failed",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+				zap.Int("episode number", params.EpisodeNumber),
+			)
+			return echo.NewHTTPError(
+				http.StatusPreconditionFailed,
+				response.Error(response.StatusPreconditionFailed),
+			)
+		}
+
+		s.loggerOf(c).Error(
 			"server.HandleEpisodePut: internal server error",
 			zap.Error(err),
 		)
@@ -235,6 +316,11 @@ func (s *Server) HandleEpisodePut(c echo.Context) error {
 		)
 	}
 
+	// edit queued for moderation
+	if proposal != nil {
+		return c.JSON(http.StatusAccepted, response.OK(proposal))
+	}
+
 	return c.JSON(http.StatusOK, response.OK(nil))
 }
 
@@ -247,7 +333,7 @@ func (s *Server) HandleEpisodesPutAllBySeason(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleEpisodesPutAllBySeason: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -264,7 +350,7 @@ func (s *Server) HandleEpisodesPutAllBySeason(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleEpisodesPutAllBySeason: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -276,7 +362,7 @@ func (s *Server) HandleEpisodesPutAllBySeason(c echo.Context) error {
 
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleEpisodesPutAllBySeason: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -287,7 +373,7 @@ func (s *Server) HandleEpisodesPutAllBySeason(c echo.Context) error {
 	}
 
 	// put all episodes by season
-	err = s.app.EpisodesPutAllBySeason(
+	proposal, err := s.app.EpisodesPutAllBySeason(
 		c.Request().Context(),
 		params.SeriesID,
 		params.SeasonNumber,
@@ -296,7 +382,7 @@ func (s *Server) HandleEpisodesPutAllBySeason(c echo.Context) error {
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleEpisodesPutAllBySeason: series not found",
 				zap.Int("series id", params.SeriesID),
 			)
@@ -306,7 +392,7 @@ func (s *Server) HandleEpisodesPutAllBySeason(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleEpisodesPutAllBySeason: internal server error",
 			zap.Error(err),
 		)
@@ -316,6 +402,11 @@ func (s *Server) HandleEpisodesPutAllBySeason(c echo.Context) error {
 		)
 	}
 
+	// edit queued for moderation
+	if proposal != nil {
+		return c.JSON(http.StatusAccepted, response.OK(proposal))
+	}
+
 	return c.JSON(http.StatusOK, response.OK(nil))
 }
 
@@ -328,7 +419,7 @@ func (s *Server) HandleEpisodeUpdate(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleEpisodeUpdate: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -345,7 +436,7 @@ func (s *Server) HandleEpisodeUpdate(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleEpisodeUpdate: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -357,7 +448,7 @@ func (s *Server) HandleEpisodeUpdate(c echo.Context) error {
 
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleEpisodeUpdate: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -368,7 +459,7 @@ func (s *Server) HandleEpisodeUpdate(c echo.Context) error {
 	}
 
 	// update episode
-	err = s.app.EpisodeUpdate(
+	proposal, err := s.app.EpisodeUpdate(
 		c.Request().Context(),
 		params.SeriesID,
 		params.SeasonNumber,
@@ -378,7 +469,7 @@ func (s *Server) HandleEpisodeUpdate(c echo.Context) error {
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleEpisodeUpdate: episode not found",
 				zap.Int("series id", params.SeriesID),
 				zap.Int("season number", params.SeasonNumber),
@@ -390,7 +481,20 @@ func (s *Server) HandleEpisodeUpdate(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		if err == app.ErrPreconditionFailed {
+			s.loggerOf(c).Info(
+				"server.HandleEpisodeUpdate: precondition failed",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+				zap.Int("episode number", params.EpisodeNumber),
+			)
+			return echo.NewHTTPError(
+				http.StatusPreconditionFailed,
+				response.Error(response.StatusPreconditionFailed),
+			)
+		}
+
+		s.loggerOf(c).Error(
 			"server.HandleEpisodeUpdate: internal server error",
 			zap.Error(err),
 		)
@@ -400,6 +504,11 @@ func (s *Server) HandleEpisodeUpdate(c echo.Context) error {
 		)
 	}
 
+	// edit queued for moderation
+	if proposal != nil {
+		return c.JSON(http.StatusAccepted, response.OK(proposal))
+	}
+
 	return c.JSON(http.StatusOK, response.OK(nil))
 }
 
@@ -412,7 +521,7 @@ func (s *Server) HandleEpisodeInvalidate(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleEpisodeInvalidate: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -429,7 +538,7 @@ func (s *Server) HandleEpisodeInvalidate(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleEpisodeInvalidate: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -441,7 +550,7 @@ func (s *Server) HandleEpisodeInvalidate(c echo.Context) error {
 
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleEpisodeInvalidate: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -462,7 +571,7 @@ func (s *Server) HandleEpisodeInvalidate(c echo.Context) error {
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleEpisodeInvalidate: episode not found",
 				zap.Int("series id", params.SeriesID),
 				zap.Int("season number", params.SeasonNumber),
@@ -474,7 +583,20 @@ func (s *Server) HandleEpisodeInvalidate(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		if err == app.ErrPreconditionFailed {
+			s.loggerOf(c).Info(
+				"server.HandleEpisodeInvalidate: precondition failed",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+				zap.Int("episode number", params.EpisodeNumber),
+			)
+			return echo.NewHTTPError(
+				http.StatusPreconditionFailed,
+				response.Error(response.StatusPreconditionFailed),
+			)
+		}
+
+		s.loggerOf(c).Error(
 			"server.HandleEpisodeInvalidate: internal server error",
 			zap.Error(err),
 		)
@@ -487,6 +609,72 @@ func (s *Server) HandleEpisodeInvalidate(c echo.Context) error {
 	return c.JSON(http.StatusOK, response.OK(nil))
 }
 
+// POST /v1/authorized/series/:id/season/:season_number/episode/:episode_number/restore/
+func (s *Server) HandleEpisodeRestore(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonEpisodeNumberPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleEpisodeRestore: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleEpisodeRestore: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// restore episode
+	err = s.app.EpisodeRestore(
+		c.Request().Context(),
+		params.SeriesID,
+		params.SeasonNumber,
+		params.EpisodeNumber,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleEpisodeRestore: episode not found",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+				zap.Int("episode number", params.EpisodeNumber),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleEpisodeRestore: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
 // DELETE /v1/authorized/series/:id/season/:season_number/episode/
 func (s *Server) HandleEpisodesInvalidateAllBySeason(c echo.Context) error {
 	// bind & validate params
@@ -496,7 +684,7 @@ func (s *Server) HandleEpisodesInvalidateAllBySeason(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleEpisodesInvalidateAllBySeason: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -513,7 +701,7 @@ func (s *Server) HandleEpisodesInvalidateAllBySeason(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleEpisodesInvalidateAllBySeason: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -525,7 +713,7 @@ func (s *Server) HandleEpisodesInvalidateAllBySeason(c echo.Context) error {
 
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleEpisodesInvalidateAllBySeason: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -545,7 +733,7 @@ func (s *Server) HandleEpisodesInvalidateAllBySeason(c echo.Context) error {
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleEpisodesInvalidateAllBySeason: season not found",
 				zap.Int("series id", params.SeriesID),
 				zap.Int("season number", params.SeasonNumber),
@@ -556,7 +744,7 @@ func (s *Server) HandleEpisodesInvalidateAllBySeason(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleEpisodesInvalidateAllBySeason: internal server error",
 			zap.Error(err),
 		)
@@ -569,7 +757,72 @@ func (s *Server) HandleEpisodesInvalidateAllBySeason(c echo.Context) error {
 	return c.JSON(http.StatusOK, response.OK(nil))
 }
 
+// POST /v1/authorized/series/:id/season/:season_number/episode/restore/
+func (s *Server) HandleEpisodesRestoreAllBySeason(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonNumberPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleEpisodesRestoreAllBySeason: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleEpisodesRestoreAllBySeason: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// restore episodes
+	err = s.app.EpisodesRestoreAllBySeason(
+		c.Request().Context(),
+		params.SeriesID,
+		params.SeasonNumber,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleEpisodesRestoreAllBySeason: episodes not found",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleEpisodesRestoreAllBySeason: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
 // GET /v1/authorized/series/:id/season/:season_number/episode/:episode_number/audits/?page=1&per_page=100
+// GET /v1/authorized/series/:id/season/:season_number/episode/:episode_number/audits/?cursor=&per_page=100
 func (s *Server) HandleEpisodeAuditsGetAll(c echo.Context) error {
 	// bind & validate params
 	var params request.SeriesSeasonEpisodeNumberPathParam
@@ -578,7 +831,7 @@ func (s *Server) HandleEpisodeAuditsGetAll(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleEpisodeAuditsGetAll: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -588,24 +841,124 @@ func (s *Server) HandleEpisodeAuditsGetAll(c echo.Context) error {
 		)
 	}
 
-	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+	// fetch audits, by cursor if asked for
+	var res *response.ResponseValue
+	if CursorPaginationRequested(c.Request()) {
+		cursor, perPage := FetchCursorPaginationQueryParams(c.Request())
+		var (
+			audits                 []*models.FilmsAudit
+			prevCursor, nextCursor string
+		)
+		audits, prevCursor, nextCursor, err = s.app.EpisodeAuditsGetPage(
+			c.Request().Context(),
+			params.SeriesID,
+			params.SeasonNumber,
+			params.EpisodeNumber,
+			cursor,
+			perPage,
+		)
+		res = response.Cursored(perPage, audits, prevCursor, nextCursor)
+	} else {
+		page, perPage, offset := FetchPaginationQueryParams(c.Request())
+		var (
+			audits []*models.FilmsAudit
+			total  int
+		)
+		audits, total, err = s.app.EpisodeAuditsGetAll(
+			c.Request().Context(),
+			params.SeriesID,
+			params.SeasonNumber,
+			params.EpisodeNumber,
+			offset,
+			perPage,
+		)
+		res = response.Paginated(page, perPage, audits, total)
+	}
+	if err != nil {
+		if err == app.ErrCursorInvalid {
+			s.loggerOf(c).Info(
+				"server.HandleEpisodeAuditsGetAll: invalid cursor",
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusInvalidURLParameter),
+			)
+		}
+
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleEpisodeAuditsGetAll: episode not found",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+				zap.Int("episode number", params.EpisodeNumber),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleEpisodeAuditsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// POST /v1/authorized/series/:id/season/:season_number/episode/:episode_number/audits/:contributed_at/revert/
+func (s *Server) HandleEpisodeAuditRevert(c echo.Context) error {
+	// bind & validate params
+	var params request.EpisodeAuditPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleEpisodeAuditRevert: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleEpisodeAuditRevert: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
 
-	// fetch audits
-	audits, total, err := s.app.EpisodeAuditsGetAll(
+	// revert episode
+	proposal, err := s.app.EpisodeAuditRevert(
 		c.Request().Context(),
 		params.SeriesID,
 		params.SeasonNumber,
 		params.EpisodeNumber,
-		offset,
-		perPage,
+		params.ContributedAt,
+		payload.UserID,
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
-				"server.HandleEpisodeAuditsGetAll: episode not found",
+			s.loggerOf(c).Info(
+				"server.HandleEpisodeAuditRevert: episode audit not found",
 				zap.Int("series id", params.SeriesID),
 				zap.Int("season number", params.SeasonNumber),
 				zap.Int("episode number", params.EpisodeNumber),
+				zap.Time("contributed at", params.ContributedAt),
 			)
 			return echo.NewHTTPError(
 				http.StatusNotFound,
@@ -613,8 +966,8 @@ func (s *Server) HandleEpisodeAuditsGetAll(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
-			"server.HandleEpisodeAuditsGetAll: internal server error",
+		s.loggerOf(c).Error(
+			"server.HandleEpisodeAuditRevert: internal server error",
 			zap.Error(err),
 		)
 		return echo.NewHTTPError(
@@ -623,8 +976,10 @@ func (s *Server) HandleEpisodeAuditsGetAll(c echo.Context) error {
 		)
 	}
 
-	return c.JSON(
-		http.StatusOK,
-		response.Paginated(page, perPage, audits, total),
-	)
+	// edit queued for moderation
+	if proposal != nil {
+		return c.JSON(http.StatusAccepted, response.OK(proposal))
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
 }
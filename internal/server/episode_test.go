@@ -72,7 +72,7 @@ func TestHandleEpisodeGet(t *testing.T) {
 		Title:        "episode",
 		DateReleased: testutils.Date(2000, 1, 1),
 	}
-	err = appInstance.EpisodePut(
+	_, err = appInstance.EpisodePut(
 		ctx,
 		defaults.series.id, seasonNumber, episodeNumber,
 		defaults.user.id,
@@ -85,6 +85,7 @@ func TestHandleEpisodeGet(t *testing.T) {
 		defaults.series.id,
 		seasonNumber,
 		episodeNumber,
+		true,
 	)
 	require.NoError(err)
 
@@ -217,7 +218,7 @@ func TestHandleEpisodesGetAllBySeries(t *testing.T) {
 		for e, ereq := range sreq {
 			se := s + 1
 			ep := e + 1
-			err = appInstance.EpisodePut(
+			_, err = appInstance.EpisodePut(
 				ctx,
 				defaults.series.id,
 				se,
@@ -236,6 +237,7 @@ func TestHandleEpisodesGetAllBySeries(t *testing.T) {
 		defaults.series.id,
 		0,
 		config.Config.Pagination.PageSize.MaxValue,
+		true,
 	)
 	require.NoError(err)
 
@@ -348,7 +350,7 @@ func TestHandleEpisodesGetAllBySeason(t *testing.T) {
 
 	for i, req := range episodePutReqs {
 		ep := i + 1
-		err = appInstance.EpisodePut(
+		_, err = appInstance.EpisodePut(
 			ctx,
 			defaults.series.id,
 			seasonNumber,
@@ -366,6 +368,7 @@ func TestHandleEpisodesGetAllBySeason(t *testing.T) {
 		seasonNumber,
 		0,
 		config.Config.Pagination.PageSize.MaxValue,
+		true,
 	)
 	require.NoError(err)
 
@@ -472,6 +475,7 @@ func TestHandleEpisodePut(t *testing.T) {
 		defaults.series.id,
 		seasonNumber,
 		episodeNumber,
+		true,
 	)
 	require.NoError(err)
 
@@ -495,6 +499,8 @@ func TestHandleEpisodePut(t *testing.T) {
 			Invalidation:  null.String{},
 			ContributedBy: defaults.user.id,
 			ContributedAt: gotEpisode.ContributedAt,
+			ClientIP:      null.StringFrom(testClientIP),
+			UserAgent:     null.StringFrom(testUserAgent),
 		},
 		gotEpisode,
 	)
@@ -803,6 +809,7 @@ func TestHandleEpisodesPutAllBySeason(t *testing.T) {
 		seasonNumber,
 		0,
 		math.MaxInt,
+		true,
 	)
 	require.NoError(err)
 	require.Equal(len(episodePutAllReq.Episodes), total)
@@ -830,6 +837,8 @@ func TestHandleEpisodesPutAllBySeason(t *testing.T) {
 				Invalidation:  null.String{},
 				ContributedBy: defaults.user.id,
 				ContributedAt: gotEpisodes[i].ContributedAt,
+				ClientIP:      null.StringFrom(testClientIP),
+				UserAgent:     null.StringFrom(testUserAgent),
 			},
 			gotEpisodes[i],
 		)
@@ -1130,7 +1139,7 @@ func TestHandleEpisodeUpdate(t *testing.T) {
 			require := prequire.New(t)
 
 			// insert episode
-			err := appInstance.EpisodePut(
+			_, err := appInstance.EpisodePut(
 				ctx,
 				defaults.series.id, seasonNumber, episodeNumber,
 				defaults.user.id,
@@ -1146,6 +1155,7 @@ func TestHandleEpisodeUpdate(t *testing.T) {
 				defaults.series.id,
 				seasonNumber,
 				episodeNumber,
+				true,
 			)
 			require.NoError(err)
 
@@ -1170,6 +1180,7 @@ func TestHandleEpisodeUpdate(t *testing.T) {
 				defaults.series.id,
 				seasonNumber,
 				episodeNumber,
+				true,
 			)
 			require.NoError(err)
 
@@ -1206,6 +1217,8 @@ func TestHandleEpisodeUpdate(t *testing.T) {
 			updatedEpisode.Invalidation = null.String{}
 			updatedEpisode.ContributedBy = defaults.user.id
 			updatedEpisode.ContributedAt = gotEpisodeAfterUpdate.ContributedAt
+			updatedEpisode.ClientIP = null.StringFrom(testClientIP)
+			updatedEpisode.UserAgent = null.StringFrom(testUserAgent)
 
 			testutils.SetTimeLocation(
 				&updatedEpisode.DateReleased,
@@ -1487,7 +1500,7 @@ func TestHandleEpisodeInvalidate(t *testing.T) {
 		Title:        "episode",
 		DateReleased: testutils.Date(1900, 3, 14),
 	}
-	err = appInstance.EpisodePut(
+	_, err = appInstance.EpisodePut(
 		ctx,
 		defaults.series.id, seasonNumber, episodeNumber,
 		defaults.user.id,
@@ -1513,6 +1526,7 @@ func TestHandleEpisodeInvalidate(t *testing.T) {
 		defaults.series.id,
 		seasonNumber,
 		episodeNumber,
+		true,
 	)
 	require.NoError(err)
 
@@ -1534,6 +1548,8 @@ func TestHandleEpisodeInvalidate(t *testing.T) {
 			Invalidation:  null.StringFrom(invalidationRequest.Invalidation),
 			ContributedBy: defaults.user.id,
 			ContributedAt: gotInvalidatedEpisode.ContributedAt,
+			ClientIP:      null.StringFrom(testClientIP),
+			UserAgent:     null.StringFrom(testUserAgent),
 		},
 		gotInvalidatedEpisode,
 	)
@@ -1694,7 +1710,7 @@ func TestHandleEpisodesInvalidateAllBySeason(t *testing.T) {
 			},
 		},
 	}
-	err = appInstance.EpisodesPutAllBySeason(
+	_, err = appInstance.EpisodesPutAllBySeason(
 		ctx,
 		defaults.series.id, seasonNumber,
 		defaults.user.id,
@@ -1722,6 +1738,7 @@ func TestHandleEpisodesInvalidateAllBySeason(t *testing.T) {
 		seasonNumber,
 		0,
 		math.MaxInt,
+		true,
 	)
 	require.NoError(err)
 	require.Equal(len(episodePutAllReq.Episodes), total)
@@ -1754,6 +1771,8 @@ func TestHandleEpisodesInvalidateAllBySeason(t *testing.T) {
 				),
 				ContributedBy: defaults.user.id,
 				ContributedAt: gotInvalidatedEpisodes[i].ContributedAt,
+				ClientIP:      null.StringFrom(testClientIP),
+				UserAgent:     null.StringFrom(testUserAgent),
 			},
 			gotInvalidatedEpisodes[i],
 		)
@@ -1892,7 +1911,7 @@ func TestHandleEpisodeAuditsGetAll(t *testing.T) {
 		Title:        "episode",
 		DateReleased: testutils.Date(2000, 1, 1),
 	}
-	err = appInstance.EpisodePut(
+	_, err = appInstance.EpisodePut(
 		ctx,
 		defaults.series.id, seasonNumber, episodeNumber,
 		defaults.user.id,
@@ -1905,6 +1924,7 @@ func TestHandleEpisodeAuditsGetAll(t *testing.T) {
 		defaults.series.id,
 		seasonNumber,
 		episodeNumber,
+		true,
 	)
 	require.NoError(err)
 
@@ -1947,7 +1967,7 @@ func TestHandleEpisodeAuditsGetAll(t *testing.T) {
 		),
 		Duration: null.IntFrom(10 * 60),
 	}
-	err = appInstance.EpisodeUpdate(
+	_, err = appInstance.EpisodeUpdate(
 		ctx,
 		defaults.series.id, seasonNumber, episodeNumber,
 		defaults.user.id,
@@ -1960,6 +1980,7 @@ func TestHandleEpisodeAuditsGetAll(t *testing.T) {
 		defaults.series.id,
 		seasonNumber,
 		episodeNumber,
+		true,
 	)
 	require.NoError(err)
 
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderETag        = "ETag"
+	HeaderIfMatch     = "If-Match"
+	HeaderIfNoneMatch = "If-None-Match"
+)
+
+// entityTag returns the strong entity tag of a record at version, told by
+// its contribution time.
+func entityTag(version time.Time) string {
+	return `"` + strconv.FormatInt(version.UnixMicro(), 36) + `"`
+}
+
+// entityTagVersion returns the version a strong entity tag stands for
+func entityTagVersion(tag string) (version time.Time, isValid bool) {
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return time.Time{}, false
+	}
+	micros, err := strconv.ParseInt(tag[1:len(tag)-1], 36, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.UnixMicro(micros), true
+}
+
+// entityTags returns the entity tags listed by the header of req
+func entityTags(req *http.Request, header string) []string {
+	return splitList(strings.Join(req.Header.Values(header), ","))
+}
+
+// notModified sets the ETag header of a record at version and reports
+// whether the If-None-Match header of the request matches it, which is
+// answered http.StatusNotModified with no body.
+func notModified(c echo.Context, version time.Time) bool {
+	tag := entityTag(version)
+	c.Response().Header().Set(HeaderETag, tag)
+	for _, t := range entityTags(c.Request(), HeaderIfNoneMatch) {
+		// weak comparison
+		if t == "*" || strings.TrimPrefix(t, "W/") == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// PreconditionMiddleware makes the edits made through the request
+// conditional on the If-Match header if any, so that they fail with
+// http.StatusPreconditionFailed unless the record is still at a version
+// listed. Weak and malformed tags never match.
+func (s *Server) PreconditionMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+
+		tags := entityTags(req, HeaderIfMatch)
+		if len(tags) == 0 {
+			return next(c)
+		}
+		versions := make([]time.Time, 0, len(tags))
+		for _, tag := range tags {
+			// any version matches
+			if tag == "*" {
+				return next(c)
+			}
+			if version, isValid := entityTagVersion(tag); isValid {
+				versions = append(versions, version)
+			}
+		}
+
+		c.SetRequest(
+			req.WithContext(repo.WithIfMatch(req.Context(), versions...)),
+		)
+		return next(c)
+	}
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/feed/?cursor=&per_page=100
+func (s *Server) HandleFeedGet(c echo.Context) error {
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleFeedGet: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	cursor, perPage := FetchCursorPaginationQueryParams(c.Request())
+
+	// fetch feed
+	activities, nextCursor, err := s.app.FeedGet(
+		c.Request().Context(),
+		payload.UserID,
+		cursor,
+		perPage,
+	)
+	if err != nil {
+		if err == app.ErrCursorInvalid {
+			s.loggerOf(c).Info(
+				"server.HandleFeedGet: invalid cursor",
+				zap.String("cursor", cursor),
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusInvalidURLParameter),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleFeedGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Cursored(perPage, activities, "", nextCursor),
+	)
+}
@@ -0,0 +1,384 @@
+package server
+
+import (
+	"bufio"
+	"net/http"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/server/request"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// multipart form field holding the uploaded file
+const MediaFormField = "file"
+
+// the room left in the upload requests for the multipart boundaries and
+// headers around the file
+const multipartOverhead = 1 << 10
+
+// maxMediaUploadSize returns the max size of the bodies of the upload
+// requests, sized after the largest media configured
+func maxMediaUploadSize() int64 {
+	maxSize := config.Config.Validation.Media.Image.MaxSizeInBytes
+	if videoMaxSize := config.Config.Validation.Media.Video.MaxSizeInBytes; videoMaxSize > maxSize {
+		maxSize = videoMaxSize
+	}
+	return maxSize + multipartOverhead
+}
+
+// maxImageUploadSize returns the max size of the bodies of the image only
+// upload requests
+func maxImageUploadSize() int64 {
+	return config.Config.Validation.Media.Image.MaxSizeInBytes + multipartOverhead
+}
+
+// GET /v1/authorized/film/:id/media/:media_id/
+func (s *Server) HandleFilmMediaGet(c echo.Context) error {
+	// bind & validate params
+	var params request.FilmMediaIDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleFilmMediaGet: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// fetch media
+	media, err := s.app.FilmMediaGet(
+		c.Request().Context(),
+		params.FilmID,
+		params.MediaID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleFilmMediaGet: media not found",
+				zap.Int("film id", params.FilmID),
+				zap.Int("media id", params.MediaID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleFilmMediaGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(media))
+}
+
+// GET /v1/authorized/film/:id/media/?page=1&per_page=100
+func (s *Server) HandleFilmMediaGetAll(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleFilmMediaGetAll: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+
+	// fetch media
+	media, total, err := s.app.FilmMediaGetAll(
+		c.Request().Context(),
+		params.ID,
+		offset,
+		perPage,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleFilmMediaGetAll: film not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleFilmMediaGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(page, perPage, media, total),
+	)
+}
+
+// POST /v1/authorized/film/:id/media/
+func (s *Server) HandleFilmMediaUpload(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleFilmMediaUpload: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// fetch uploaded file
+	fileHeader, err := c.FormFile(MediaFormField)
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleFilmMediaUpload: request binding failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidRequest, err.Error()),
+		)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		s.loggerOf(c).Error(
+			"server.HandleFilmMediaUpload: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+	defer file.Close()
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleFilmMediaUpload: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// upload media
+	mediaID, err := s.app.FilmMediaUpload(
+		c.Request().Context(),
+		params.ID,
+		payload.UserID,
+		file,
+	)
+	if err != nil {
+		switch err {
+		case app.ErrNotFound:
+			s.loggerOf(c).Info(
+				"server.HandleFilmMediaUpload: film not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		case app.ErrMediaTooLarge:
+			s.loggerOf(c).Info(
+				"server.HandleFilmMediaUpload: media too large",
+				zap.Int64("size", fileHeader.Size),
+			)
+			return echo.NewHTTPError(
+				http.StatusRequestEntityTooLarge,
+				response.Error(response.StatusMediaTooLarge),
+			)
+		case app.ErrUnsupportedMediaType:
+			s.loggerOf(c).Info(
+				"server.HandleFilmMediaUpload: unsupported media type",
+				zap.String("filename", fileHeader.Filename),
+			)
+			return echo.NewHTTPError(
+				http.StatusUnsupportedMediaType,
+				response.Error(response.StatusUnsupportedMediaType),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleFilmMediaUpload: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(mediaID))
+}
+
+// DELETE /v1/authorized/film/:id/media/:media_id/
+func (s *Server) HandleFilmMediaInvalidate(c echo.Context) error {
+	// bind & validate params
+	var params request.FilmMediaIDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleFilmMediaInvalidate: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// bind & validate request
+	var req dto.InvalidationRequest
+	err = (&echo.DefaultBinder{}).BindBody(c, &req)
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleFilmMediaInvalidate: request binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidRequest, err.Error()),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleFilmMediaInvalidate: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// invalidate media
+	err = s.app.FilmMediaInvalidate(
+		c.Request().Context(),
+		params.FilmID,
+		params.MediaID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleFilmMediaInvalidate: media not found",
+				zap.Int("film id", params.FilmID),
+				zap.Int("media id", params.MediaID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleFilmMediaInvalidate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// GET /v1/authorized/media/:key/
+func (s *Server) HandleMediaBlobGet(c echo.Context) error {
+	// bind & validate params
+	var params request.BlobKeyPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleMediaBlobGet: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// fetch blob
+	blob, err := s.app.MediaBlobGet(c.Request().Context(), params.Key)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleMediaBlobGet: blob not found",
+				zap.String("key", params.Key),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleMediaBlobGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+	defer blob.Close()
+
+	// blobs are stored without a content type so sniff it again
+	buffered := bufio.NewReaderSize(blob, 512)
+	head, _ := buffered.Peek(512)
+
+	return c.Stream(http.StatusOK, http.DetectContentType(head), buffered)
+}
@@ -0,0 +1,260 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	appServer "github.com/aria3ppp/watch-server/internal/server"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleFilmMediaUpload(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(
+		OptEnableDefaultUser,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/film/{id}/media/"
+	method := http.MethodPost
+
+	var content bytes.Buffer
+	err = png.Encode(&content, image.NewRGBA(image.Rect(0, 0, 640, 480)))
+	require.NoError(err)
+
+	// invalid id
+	e.Request(method, path).
+		WithPath("id", -1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFileBytes(appServer.MediaFormField, "image.png", content.Bytes()).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	// film not found
+	e.Request(method, path).
+		WithPath("id", 999).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFileBytes(appServer.MediaFormField, "image.png", content.Bytes()).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	// unsupported media type
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFileBytes(appServer.MediaFormField, "text.txt", []byte("text")).
+		Expect().
+		Status(http.StatusUnsupportedMediaType).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusUnsupportedMediaType))
+
+	// request body too large, streamed for it to have no content length
+	maxSize := config.Config.Validation.Media.Video.MaxSizeInBytes
+	if imageMaxSize := config.Config.Validation.Media.Image.MaxSizeInBytes; imageMaxSize > maxSize {
+		maxSize = imageMaxSize
+	}
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(echo.HeaderContentType, "multipart/form-data; boundary=boundary").
+		WithChunked(io.MultiReader(
+			strings.NewReader(
+				"--boundary\r\n"+
+					`Content-Disposition: form-data; name="`+appServer.MediaFormField+`"; filename="video.mp4"`+"\r\n\r\n",
+			),
+			io.LimitReader(zeroReader{}, maxSize+2<<10),
+		)).
+		Expect().
+		Status(http.StatusRequestEntityTooLarge).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusMediaTooLarge))
+
+	// upload image
+	mediaID := int(e.Request(method, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFileBytes(appServer.MediaFormField, "image.png", content.Bytes()).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("payload").
+		Number().
+		Raw())
+
+	gotMedia, err := appInstance.FilmMediaGet(ctx, movieID, mediaID)
+	require.NoError(err)
+	require.Equal(app.MediaTypeImage, gotMedia.MediaType)
+	require.Equal("image/png", gotMedia.MimeType)
+	require.Equal(int64(content.Len()), gotMedia.SizeInBytes)
+	require.True(gotMedia.ThumbnailURL.Valid)
+
+	// fetch the uploaded blobs
+	blobPath := "/v1/authorized/media/{key}/"
+	e.Request(http.MethodGet, blobPath).
+		WithPath("key", gotMedia.MediaURL).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		ContentType("image/png").
+		Body().
+		Equal(content.String())
+
+	thumbnail := e.Request(http.MethodGet, blobPath).
+		WithPath("key", gotMedia.ThumbnailURL.String).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		ContentType("image/jpeg").
+		Body().
+		Raw()
+	thumbnailConfig, _, err := image.DecodeConfig(
+		bytes.NewReader([]byte(thumbnail)),
+	)
+	require.NoError(err)
+	require.Equal(
+		config.Config.Validation.Media.Thumbnail.MaxDimension,
+		thumbnailConfig.Width,
+	)
+
+	// blob not found
+	e.Request(http.MethodGet, blobPath).
+		WithPath("key", "abc").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	// list media
+	e.Request(http.MethodGet, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.Paginated(config.Config.Pagination.Page.MinValue, config.Config.Pagination.PageSize.DefaultValue, []*models.FilmMediaURL{gotMedia}, 1))
+}
+
+// zeroReader reads endless zeros
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+func TestHandleFilmMediaInvalidate(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(
+		OptEnableDefaultUser,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/film/{id}/media/{media_id}/"
+	method := http.MethodDelete
+
+	req := &dto.InvalidationRequest{Invalidation: "invalidation"}
+
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	// media not found
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithPath("media_id", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	var content bytes.Buffer
+	err = png.Encode(&content, image.NewRGBA(image.Rect(0, 0, 8, 8)))
+	require.NoError(err)
+	mediaID, err := appInstance.FilmMediaUpload(
+		ctx,
+		movieID,
+		defaults.user.id,
+		bytes.NewReader(content.Bytes()),
+	)
+	require.NoError(err)
+
+	// invalidate media
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithPath("media_id", mediaID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	gotMedia, err := appInstance.FilmMediaGet(ctx, movieID, mediaID)
+	require.NoError(err)
+	require.Equal(req.Invalidation, gotMedia.Invalidation.String)
+
+	// the blob is kept for the audit trail
+	blob, err := appInstance.MediaBlobGet(ctx, gotMedia.MediaURL)
+	require.NoError(err)
+	blob.Close()
+}
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// HeaderIdempotentReplayed marks the responses replayed to retries
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+)
+
+// IdempotencyMiddleware replays the response to the first request made with
+// an Idempotency-Key header to its retries. Reusing a key for another request
+// is answered http.StatusUnprocessableEntity, and retrying a request not
+// answered yet http.StatusConflict.
+func (s *Server) IdempotencyMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+
+		key := req.Header.Get(HeaderIdempotencyKey)
+		if key == "" {
+			return next(c)
+		}
+
+		// validate idempotency key
+		maxLength := config.Config.Validation.Request.IdempotencyKey.MaxLength
+		err := validation.Validate(key, validation.RuneLength(0, maxLength))
+		if err != nil {
+			s.loggerOf(c).Info(
+				"server.IdempotencyMiddleware: idempotency key validation failed",
+				zap.Error(err),
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(
+					response.StatusInvalidRequest,
+					HeaderIdempotencyKey+": "+err.Error(),
+				),
+			)
+		}
+
+		payload := FetchUserPayload(c)
+		if payload == nil {
+			s.loggerOf(c).Error(
+				"server.IdempotencyMiddleware: payload key not set on router context",
+				zap.String("payload key", PayloadKey),
+			)
+			return echo.NewHTTPError(
+				http.StatusInternalServerError,
+				response.Error(response.StatusInternalServerError),
+			)
+		}
+
+		// hash the request, putting back the body for the handler
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			s.loggerOf(c).Info(
+				"server.IdempotencyMiddleware: reading request body failed",
+				zap.Error(err),
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusInvalidRequest),
+			)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		hash := sha256.New()
+		io.WriteString(hash, req.Method+" "+req.URL.Path+"\n")
+		hash.Write(body)
+		requestHash := hex.EncodeToString(hash.Sum(nil))
+
+		stored, err := s.app.IdempotencyKeyBegin(
+			req.Context(),
+			payload.UserID,
+			key,
+			requestHash,
+		)
+		if err != nil {
+			if err == app.ErrIdempotencyKeyReused {
+				s.loggerOf(c).Info(
+					"server.IdempotencyMiddleware: idempotency key reused",
+					zap.Int("user id", payload.UserID),
+				)
+				return echo.NewHTTPError(
+					http.StatusUnprocessableEntity,
+					response.Error(response.StatusIdempotencyKeyReused),
+				)
+			}
+
+			if err == app.ErrIdempotencyKeyInFlight {
+				s.loggerOf(c).Info(
+					"server.IdempotencyMiddleware: idempotency key in flight",
+					zap.Int("user id", payload.UserID),
+				)
+				return echo.NewHTTPError(
+					http.StatusConflict,
+					response.Error(response.StatusIdempotencyKeyInFlight),
+				)
+			}
+
+			s.loggerOf(c).Error(
+				"server.IdempotencyMiddleware: internal server error",
+				zap.Error(err),
+			)
+			return echo.NewHTTPError(
+				http.StatusInternalServerError,
+				response.Error(response.StatusInternalServerError),
+			)
+		}
+
+		// replay the stored response
+		if stored != nil {
+			c.Response().Header().Set(HeaderIdempotentReplayed, "true")
+			return c.Blob(
+				stored.ResponseStatus.Int,
+				echo.MIMEApplicationJSONCharsetUTF8,
+				[]byte(stored.ResponseBody.String),
+			)
+		}
+
+		// answer the request recording its response, errors included
+		recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+		c.Response().Writer = recorder
+		if err := next(c); err != nil {
+			c.Error(err)
+		}
+
+		// the request context might be done by now, e.g. by the timeout
+		// middleware, while a key left reserved would answer its retries
+		// http.StatusConflict until it expires
+		ctx := context.Background()
+		status := c.Response().Status
+		if status >= http.StatusInternalServerError {
+			// failed requests are answered anew
+			err = s.app.IdempotencyKeyRelease(ctx, payload.UserID, key)
+		} else {
+			err = s.app.IdempotencyKeyEnd(
+				ctx,
+				payload.UserID,
+				key,
+				status,
+				recorder.body.String(),
+			)
+		}
+		if err != nil {
+			s.loggerOf(c).Error(
+				"server.IdempotencyMiddleware: storing the response failed",
+				zap.Error(err),
+			)
+		}
+		return nil
+	}
+}
+
+// responseRecorder keeps a copy of the body written through it
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ipExtractor tells the client ip of a request: its peer address, or if the
+// peer is one of trustedProxies, given as CIDRs, the X-Forwarded-For entry
+// appended by the nearest hop not trusted. A client can then forge any
+// entries it sends but not the one its address is recorded by.
+func ipExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+	// only the configured proxies are trusted, not every private address
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range trustedProxies {
+		_, ipRange, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("server: trusted proxy %q: %w", proxy, err)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
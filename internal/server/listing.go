@@ -0,0 +1,187 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+)
+
+// listFieldType is the type of the filter values of a list field
+type listFieldType int
+
+const (
+	listFieldInt listFieldType = iota
+	// times are either dates or RFC 3339 timestamps
+	listFieldTime
+	listFieldText
+)
+
+func (t listFieldType) parse(value string) (any, error) {
+	switch t {
+	case listFieldInt:
+		return strconv.Atoi(value)
+	case listFieldTime:
+		if date, err := time.Parse("2006-01-02", value); err == nil {
+			return date, nil
+		}
+		return time.Parse(time.RFC3339, value)
+	default:
+		return value, nil
+	}
+}
+
+// listField is a column a list could be sorted or filtered by. It's filtered
+// for equality by the query param named after the column and by the other
+// operators by the ones suffixed by them, like duration_lte.
+type listField struct {
+	typ       listFieldType
+	sortable  bool
+	operators []repo.FilterOperator
+}
+
+// listFields are the columns of a list open to clients by column name
+type listFields map[string]listField
+
+var (
+	rangeOperators = []repo.FilterOperator{repo.FilterGTE, repo.FilterLTE}
+	textOperators  = []repo.FilterOperator{repo.FilterContains}
+	idOperators    = []repo.FilterOperator{repo.FilterEQ}
+)
+
+var movieListFields = listFields{
+	models.FilmColumns.ID: {
+		typ:      listFieldInt,
+		sortable: true,
+	},
+	models.FilmColumns.Title: {
+		typ:       listFieldText,
+		sortable:  true,
+		operators: textOperators,
+	},
+	models.FilmColumns.DateReleased: {
+		typ:       listFieldTime,
+		sortable:  true,
+		operators: rangeOperators,
+	},
+	models.FilmColumns.Duration: {
+		typ:       listFieldInt,
+		sortable:  true,
+		operators: rangeOperators,
+	},
+	models.FilmColumns.ContributedBy: {
+		typ:       listFieldInt,
+		operators: idOperators,
+	},
+	models.FilmColumns.ContributedAt: {
+		typ:       listFieldTime,
+		sortable:  true,
+		operators: rangeOperators,
+	},
+}
+
+var seriesListFields = listFields{
+	models.SeriesColumns.ID: {
+		typ:      listFieldInt,
+		sortable: true,
+	},
+	models.SeriesColumns.Title: {
+		typ:       listFieldText,
+		sortable:  true,
+		operators: textOperators,
+	},
+	models.SeriesColumns.DateStarted: {
+		typ:       listFieldTime,
+		sortable:  true,
+		operators: rangeOperators,
+	},
+	models.SeriesColumns.DateEnded: {
+		typ:       listFieldTime,
+		sortable:  true,
+		operators: rangeOperators,
+	},
+	models.SeriesColumns.ContributedBy: {
+		typ:       listFieldInt,
+		operators: idOperators,
+	},
+	models.SeriesColumns.ContributedAt: {
+		typ:       listFieldTime,
+		sortable:  true,
+		operators: rangeOperators,
+	},
+}
+
+// filterParamName is the query param filtering column by operator
+func filterParamName(column string, operator repo.FilterOperator) string {
+	if operator == repo.FilterEQ {
+		return column
+	}
+	return column + "_" + string(operator)
+}
+
+// columns returns the column names of fields in order
+func (fields listFields) columns() []string {
+	columns := make([]string, 0, len(fields))
+	for column := range fields {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// FetchListQueryParams parses the sort and filter query params of a list of
+// fields. The sort param lists the columns to order by, descending when
+// prefixed by a minus, like sort=-date_released,title.
+func FetchListQueryParams(
+	req *http.Request,
+	fields listFields,
+) (filter repo.ListFilter, order repo.ListSort, err error) {
+	query := req.URL.Query()
+
+	if param := query.Get(config.Config.Listing.Sort.VarName); param != "" {
+		sorted := make(map[string]bool)
+		for _, column := range strings.Split(param, ",") {
+			key := repo.SortKey{Column: strings.TrimPrefix(column, "-")}
+			key.Desc = key.Column != column
+			if !fields[key.Column].sortable || sorted[key.Column] {
+				return nil, nil, fmt.Errorf(
+					"server: %q not sortable or sorted twice",
+					key.Column,
+				)
+			}
+			sorted[key.Column] = true
+			order = append(order, key)
+		}
+	}
+
+	for _, column := range fields.columns() {
+		field := fields[column]
+		for _, operator := range field.operators {
+			name := filterParamName(column, operator)
+			if !query.Has(name) {
+				continue
+			}
+			value, err := field.typ.parse(query.Get(name))
+			if err != nil || value == "" {
+				return nil, nil, fmt.Errorf(
+					"server: invalid %s filter %q",
+					name,
+					query.Get(name),
+				)
+			}
+			filter = append(filter, repo.FilterCondition{
+				Column:   column,
+				Operator: operator,
+				Value:    value,
+			})
+		}
+	}
+
+	return filter, order, nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
@@ -15,10 +16,14 @@ import (
 	"github.com/aria3ppp/watch-server/internal/config"
 	"github.com/aria3ppp/watch-server/internal/dto"
 	"github.com/aria3ppp/watch-server/internal/hasher"
+	"github.com/aria3ppp/watch-server/internal/notifier"
+	"github.com/aria3ppp/watch-server/internal/ratelimit"
 	"github.com/aria3ppp/watch-server/internal/repo"
 	"github.com/aria3ppp/watch-server/internal/search"
 	appServer "github.com/aria3ppp/watch-server/internal/server"
+	"github.com/aria3ppp/watch-server/internal/storage"
 	"github.com/aria3ppp/watch-server/internal/token"
+	"github.com/aria3ppp/watch-server/internal/tracing"
 	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/labstack/echo/v4"
@@ -32,12 +37,19 @@ import (
 // To run this test suite set TEST_E2E env
 const ENV_TEST_E2E = "TEST_E2E"
 
+// the metadata contributions made through the test server record
+const (
+	testClientIP  = "127.0.0.1"
+	testUserAgent = "Go-http-client/1.1"
+)
+
 type SetupOpt int
 
 const (
 	OptEnableLogger SetupOpt = 1 << iota
 	OptEnableDefaultUser
 	OptEnableDefaultSeries
+	OptEnableRateLimit
 )
 
 type Defaults struct {
@@ -92,8 +104,18 @@ func setup(
 	// set server to run in production mode
 	config.Config.Servic.Server.Production = true
 
+	// the tests make more requests than the rate limits let through, so the
+	// limits are lifted unless enabled
+	config.Config.RateLimit = rateLimitConfig
+	if OptEnableRateLimit&opts == 0 {
+		config.Config.RateLimit.Read.Burst = 0
+		config.Config.RateLimit.Write.Burst = 0
+		config.Config.RateLimit.Login.Burst = 0
+		config.Config.RateLimit.UserCreate.Burst = 0
+	}
+
 	// initialize server
-	repo := repo.NewRepository(db)
+	repo := repo.NewTracedServiceTx(repo.NewRepository(db))
 	hasher := hasher.NewBcrypt()
 	tokenService := token.NewJWT(
 		token.JWTConfig{
@@ -109,6 +131,7 @@ func setup(
 	)
 	esClient, err := elasticsearch.NewClient(elasticsearch.Config{
 		Addresses: []string{"http://localhost:9200"},
+		Transport: tracing.InstrumentElasticsearch(http.DefaultTransport),
 	})
 	if err != nil {
 		return nil, nil, nil, nil, fmt.Errorf(
@@ -123,7 +146,25 @@ func setup(
 			err,
 		)
 	}
-	appInstance = app.NewApplication(repo, tokenService, searchService, hasher)
+	storageRoot, err := os.MkdirTemp("", "watch-server-storage-")
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("os.MkdirTemp error: %w", err)
+	}
+	storageService, err := storage.NewLocal(storageRoot)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf(
+			"storage.NewLocal error: %w",
+			err,
+		)
+	}
+	appInstance = app.NewApplication(
+		repo,
+		tokenService,
+		searchService,
+		hasher,
+		storageService,
+		notifier.NewLog(zap.NewNop()),
+	)
 	echo := echo.New()
 	logger := zap.NewNop()
 	if OptEnableLogger&opts != 0 {
@@ -135,7 +176,13 @@ func setup(
 			)
 		}
 	}
-	server := appServer.NewServer(appInstance, echo, tokenService, logger)
+	server := appServer.NewServer(
+		app.NewTracedService(appInstance),
+		echo,
+		tokenService,
+		ratelimit.NewMemory(0),
+		logger,
+	)
 	testServer = httptest.NewServer(server.GetHandler())
 
 	var defaultUser *DefaultUser
@@ -198,7 +245,8 @@ func setup(
 		}
 		// close server
 		testServer.Close()
-		return nil
+		// remove stored blobs
+		return os.RemoveAll(storageRoot)
 	}
 
 	defaults = &Defaults{
@@ -210,6 +258,9 @@ func setup(
 
 var db *sql.DB
 
+// the rate limits as configured, before setup lifts them
+var rateLimitConfig = config.Config.RateLimit
+
 func TestMain(m *testing.M) {
 	// run only when TEST_E2E env is set
 	if os.Getenv(ENV_TEST_E2E) == "" {
@@ -225,6 +276,10 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		log.Fatalf("Failed loading configs: %s", err)
 	}
+	rateLimitConfig = config.Config.RateLimit
+	// the requests are traced, the spans only exported by the tests
+	// installing an exporter of their own
+	tracing.Setup(nil, config.Config.Servic.Tracing.SampleRatio)
 	os.Setenv("DSN", fmt.Sprintf(
 		"postgres://%s:%s@localhost:%s/%s?sslmode=disable",
 		os.Getenv("POSTGRES_USER"),
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/metrics"
+	"github.com/labstack/echo/v4"
+)
+
+// MetricsMiddleware counts and times the requests by route, method and status.
+// The route is the path the request was routed by, so that the requests for
+// different records add up.
+func (s *Server) MetricsMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+
+		if err := next(c); err != nil {
+			c.Error(err)
+		}
+
+		labels := []string{
+			c.Path(),
+			c.Request().Method,
+			strconv.Itoa(c.Response().Status),
+		}
+		metrics.HTTPRequestsTotal.WithLabelValues(labels...).Inc()
+		metrics.HTTPRequestDuration.
+			WithLabelValues(labels...).
+			Observe(time.Since(start).Seconds())
+		return nil
+	}
+}
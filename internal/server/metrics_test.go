@@ -0,0 +1,53 @@
+package server_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	require := require.New(t)
+
+	server, _, defaults, teardown, err := setup(OptEnableDefaultUser)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+
+	e.GET("/v1/authorized/user/{id}").
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK)
+	e.GET("/v1/authorized/user/{id}").
+		WithPath("id", defaults.user.id).
+		Expect().
+		Status(http.StatusUnauthorized)
+	e.POST("/v1/user/login").
+		WithJSON(dto.UserLoginRequest{
+			Email:    defaults.user.email,
+			Password: "1nc0RR3ct_pa$$",
+		}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	body := e.GET("/metrics").
+		Expect().
+		Status(http.StatusOK).
+		Body()
+
+	// requests by route, not by path
+	body.Contains(
+		`watch_http_requests_total{method="GET",route="/v1/authorized/user/:id/",status="200"}`,
+	)
+	body.Contains(
+		`watch_http_request_duration_seconds_count{method="GET",route="/v1/authorized/user/:id/",status="401"}`,
+	)
+	body.Contains(`watch_token_validation_failures_total{reason="missing_or_malformed"}`)
+	body.Contains(`watch_login_failures_total{reason="incorrect_password"}`)
+}
@@ -5,13 +5,14 @@ import (
 
 	"github.com/aria3ppp/watch-server/internal/app"
 	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
 	"github.com/aria3ppp/watch-server/internal/server/request"
 	"github.com/aria3ppp/watch-server/internal/server/response"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 )
 
-// GET /v1/authorized/movie/:id/
+// GET /v1/authorized/movie/:id/?include_invalidated=true
 func (s *Server) HandleMovieGet(c echo.Context) error {
 	// bind & validate params
 	var params request.IDPathParam
@@ -20,7 +21,7 @@ func (s *Server) HandleMovieGet(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleMovieGet: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -31,10 +32,14 @@ func (s *Server) HandleMovieGet(c echo.Context) error {
 	}
 
 	// fetch movie
-	movie, err := s.app.MovieGet(c.Request().Context(), params.ID)
+	movie, err := s.app.MovieGet(
+		c.Request().Context(),
+		params.ID,
+		FetchIncludeInvalidatedQueryParam(c.Request()),
+	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleMovieGet: movie not found",
 				zap.Int("id", params.ID),
 			)
@@ -44,7 +49,7 @@ func (s *Server) HandleMovieGet(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleMovieGet: internal server error", zap.Error(err),
 		)
 		return echo.NewHTTPError(
@@ -53,22 +58,74 @@ func (s *Server) HandleMovieGet(c echo.Context) error {
 		)
 	}
 
+	if notModified(c, movie.ContributedAt) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
 	return c.JSON(http.StatusOK, response.OK(movie))
 }
 
-// GET /v1/authorized/movie/?page=1&per_page=100
+// GET /v1/authorized/movie/?page=1&per_page=100&include_invalidated=true&sort=-date_released,title&duration_lte=120
+// GET /v1/authorized/movie/?cursor=&per_page=100&include_invalidated=true&sort=-date_released,title&duration_lte=120
 func (s *Server) HandleMoviesGetAll(c echo.Context) error {
-	// parse pagination params
-	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+	// parse sort and filter params
+	filter, sort, err := FetchListQueryParams(c.Request(), movieListFields)
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleMoviesGetAll: query validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
 
-	// fetch movies
-	movies, total, err := s.app.MoviesGetAll(
-		c.Request().Context(),
-		offset,
-		perPage,
-	)
+	// fetch movies, by cursor if asked for
+	var res *response.ResponseValue
+	if CursorPaginationRequested(c.Request()) {
+		cursor, perPage := FetchCursorPaginationQueryParams(c.Request())
+		var (
+			movies                 []*models.Film
+			prevCursor, nextCursor string
+		)
+		movies, prevCursor, nextCursor, err = s.app.MoviesGetPage(
+			c.Request().Context(),
+			filter,
+			sort,
+			cursor,
+			perPage,
+			FetchIncludeInvalidatedQueryParam(c.Request()),
+		)
+		res = response.Cursored(perPage, movies, prevCursor, nextCursor)
+	} else {
+		page, perPage, offset := FetchPaginationQueryParams(c.Request())
+		var (
+			movies []*models.Film
+			total  int
+		)
+		movies, total, err = s.app.MoviesGetAll(
+			c.Request().Context(),
+			filter,
+			sort,
+			offset,
+			perPage,
+			FetchIncludeInvalidatedQueryParam(c.Request()),
+		)
+		res = response.Paginated(page, perPage, movies, total)
+	}
 	if err != nil {
-		s.logger.Error(
+		if err == app.ErrCursorInvalid {
+			s.loggerOf(c).Info(
+				"server.HandleMoviesGetAll: invalid cursor",
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusInvalidURLParameter),
+			)
+		}
+
+		s.loggerOf(c).Error(
 			"server.HandleMoviesGetAll: internal server error",
 			zap.Error(err),
 		)
@@ -78,10 +135,80 @@ func (s *Server) HandleMoviesGetAll(c echo.Context) error {
 		)
 	}
 
-	return c.JSON(
-		http.StatusOK,
-		response.Paginated(page, perPage, movies, total),
-	)
+	return c.JSON(http.StatusOK, res)
+}
+
+// GET /v1/authorized/movie/search/?query=title&page=1&per_page=100
+// GET /v1/authorized/movie/search/?query=title&cursor=&per_page=100
+func (s *Server) HandleMoviesSearch(c echo.Context) error {
+	// bind & validate query
+	var req dto.SearchRequest
+	err := (&echo.DefaultBinder{}).BindQueryParams(c, &req)
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleMoviesSearch: query binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// search movies, by cursor if asked for
+	var res *response.ResponseValue
+	if CursorPaginationRequested(c.Request()) {
+		cursor, perPage := FetchCursorPaginationQueryParams(c.Request())
+		var (
+			movies                 []*models.Film
+			prevCursor, nextCursor string
+		)
+		movies, prevCursor, nextCursor, err = s.app.MoviesSearchPage(
+			c.Request().Context(),
+			&req,
+			cursor,
+			perPage,
+		)
+		res = response.Cursored(perPage, movies, prevCursor, nextCursor)
+	} else {
+		page, perPage, offset := FetchPaginationQueryParams(c.Request())
+		var (
+			movies []*models.Film
+			total  int
+		)
+		movies, total, err = s.app.MoviesSearch(
+			c.Request().Context(),
+			&req,
+			offset,
+			perPage,
+		)
+		res = response.Paginated(page, perPage, movies, total)
+	}
+	if err != nil {
+		if err == app.ErrCursorInvalid {
+			s.loggerOf(c).Info(
+				"server.HandleMoviesSearch: invalid cursor",
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusInvalidURLParameter),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleMoviesSearch: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, res)
 }
 
 // POST /v1/authorized/movie/
@@ -93,7 +220,7 @@ func (s *Server) HandleMovieCreate(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleMovieCreate: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -105,7 +232,7 @@ func (s *Server) HandleMovieCreate(c echo.Context) error {
 
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleMovieCreate: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -122,7 +249,7 @@ func (s *Server) HandleMovieCreate(c echo.Context) error {
 		&req,
 	)
 	if err != nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleMovieCreate: internal server error",
 			zap.Error(err),
 		)
@@ -144,7 +271,7 @@ func (s *Server) HandleMovieUpdate(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleMovieUpdate: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -161,7 +288,7 @@ func (s *Server) HandleMovieUpdate(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleMovieUpdate: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -173,7 +300,7 @@ func (s *Server) HandleMovieUpdate(c echo.Context) error {
 
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleMovieUpdate: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -184,7 +311,7 @@ func (s *Server) HandleMovieUpdate(c echo.Context) error {
 	}
 
 	// update movie
-	err = s.app.MovieUpdate(
+	proposal, err := s.app.MovieUpdate(
 		c.Request().Context(),
 		params.ID,
 		payload.UserID,
@@ -192,7 +319,7 @@ func (s *Server) HandleMovieUpdate(c echo.Context) error {
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleMovieUpdate: movie not found",
 				zap.Int("id", params.ID),
 			)
@@ -202,7 +329,18 @@ func (s *Server) HandleMovieUpdate(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		if err == app.ErrPreconditionFailed {
+			s.loggerOf(c).Info(
+				"server.HandleMovieUpdate: precondition failed",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusPreconditionFailed,
+				response.Error(response.StatusPreconditionFailed),
+			)
+		}
+
+		s.loggerOf(c).Error(
 			"server.HandleMovieUpdate: internal server error",
 			zap.Error(err),
 		)
@@ -212,6 +350,11 @@ func (s *Server) HandleMovieUpdate(c echo.Context) error {
 		)
 	}
 
+	// edit queued for moderation
+	if proposal != nil {
+		return c.JSON(http.StatusAccepted, response.OK(proposal))
+	}
+
 	return c.JSON(http.StatusOK, response.OK(nil))
 }
 
@@ -224,7 +367,7 @@ func (s *Server) HandleMovieInvalidate(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleMovieInvalidate: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -241,7 +384,7 @@ func (s *Server) HandleMovieInvalidate(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleMovieInvalidate: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -253,7 +396,7 @@ func (s *Server) HandleMovieInvalidate(c echo.Context) error {
 
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleMovieInvalidate: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -272,7 +415,7 @@ func (s *Server) HandleMovieInvalidate(c echo.Context) error {
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleMovieInvalidate: movie not found",
 				zap.Int("id", params.ID),
 			)
@@ -282,7 +425,18 @@ func (s *Server) HandleMovieInvalidate(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		if err == app.ErrPreconditionFailed {
+			s.loggerOf(c).Info(
+				"server.HandleMovieInvalidate: precondition failed",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusPreconditionFailed,
+				response.Error(response.StatusPreconditionFailed),
+			)
+		}
+
+		s.loggerOf(c).Error(
 			"server.HandleMovieInvalidate: internal server error",
 			zap.Error(err),
 		)
@@ -295,7 +449,70 @@ func (s *Server) HandleMovieInvalidate(c echo.Context) error {
 	return c.JSON(http.StatusOK, response.OK(nil))
 }
 
+// POST /v1/authorized/movie/:id/restore/
+func (s *Server) HandleMovieRestore(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleMovieRestore: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleMovieRestore: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// restore movie
+	err = s.app.MovieRestore(
+		c.Request().Context(),
+		params.ID,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleMovieRestore: movie not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleMovieRestore: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
 // GET /v1/authorized/movie/:id/?page=1&per_page=100
+// GET /v1/authorized/movie/:id/?cursor=&per_page=100
 func (s *Server) HandleMovieAuditsGetAll(c echo.Context) error {
 	// bind & validate params
 	var params request.IDPathParam
@@ -304,7 +521,7 @@ func (s *Server) HandleMovieAuditsGetAll(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleMovieAuditsGetAll: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -314,10 +531,232 @@ func (s *Server) HandleMovieAuditsGetAll(c echo.Context) error {
 		)
 	}
 
+	// fetch audits, by cursor if asked for
+	var res *response.ResponseValue
+	if CursorPaginationRequested(c.Request()) {
+		cursor, perPage := FetchCursorPaginationQueryParams(c.Request())
+		var (
+			audits                 []*models.FilmsAudit
+			prevCursor, nextCursor string
+		)
+		audits, prevCursor, nextCursor, err = s.app.MovieAuditsGetPage(
+			c.Request().Context(),
+			params.ID,
+			cursor,
+			perPage,
+		)
+		res = response.Cursored(perPage, audits, prevCursor, nextCursor)
+	} else {
+		page, perPage, offset := FetchPaginationQueryParams(c.Request())
+		var (
+			audits []*models.FilmsAudit
+			total  int
+		)
+		audits, total, err = s.app.MovieAuditsGetAll(
+			c.Request().Context(),
+			params.ID,
+			offset,
+			perPage,
+		)
+		res = response.Paginated(page, perPage, audits, total)
+	}
+	if err != nil {
+		if err == app.ErrCursorInvalid {
+			s.loggerOf(c).Info(
+				"server.HandleMovieAuditsGetAll: invalid cursor",
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusInvalidURLParameter),
+			)
+		}
+
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleMovieAuditsGetAll: movie not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleMovieAuditsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// POST /v1/authorized/movie/:id/audits/:contributed_at/revert/
+func (s *Server) HandleMovieAuditRevert(c echo.Context) error {
+	// bind & validate params
+	var params request.AuditPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleMovieAuditRevert: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleMovieAuditRevert: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// revert movie
+	proposal, err := s.app.MovieAuditRevert(
+		c.Request().Context(),
+		params.ID,
+		params.ContributedAt,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleMovieAuditRevert: movie audit not found",
+				zap.Int("id", params.ID),
+				zap.Time("contributed at", params.ContributedAt),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleMovieAuditRevert: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// edit queued for moderation
+	if proposal != nil {
+		return c.JSON(http.StatusAccepted, response.OK(proposal))
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// GET /v1/authorized/movie/:id/audits/diff/?from=2006-01-02T15:04:05Z&to=2006-01-02T15:04:05Z
+func (s *Server) HandleMovieAuditDiff(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleMovieAuditDiff: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// bind & validate query
+	var query request.AuditDiffQueryParam
+	err = (&echo.DefaultBinder{}).BindQueryParams(c, &query)
+	if err == nil {
+		err = query.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleMovieAuditDiff: query binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// diff revisions
+	diff, err := s.app.MovieAuditDiff(
+		c.Request().Context(),
+		params.ID,
+		query.From,
+		query.To,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleMovieAuditDiff: movie revision not found",
+				zap.Int("id", params.ID),
+				zap.Time("from", query.From),
+				zap.Time("to", query.To),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleMovieAuditDiff: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(diff))
+}
+
+// GET /v1/authorized/movie/:id/audits/diffs/?page=1&per_page=100
+func (s *Server) HandleMovieAuditDiffsGetAll(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleMovieAuditDiffsGetAll: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
 	page, perPage, offset := FetchPaginationQueryParams(c.Request())
 
-	// fetch audits
-	audits, total, err := s.app.MovieAuditsGetAll(
+	// fetch diffs
+	diffs, total, err := s.app.MovieAuditDiffsGetAll(
 		c.Request().Context(),
 		params.ID,
 		offset,
@@ -325,8 +764,8 @@ func (s *Server) HandleMovieAuditsGetAll(c echo.Context) error {
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
-				"server.HandleMovieAuditsGetAll: movie not found",
+			s.loggerOf(c).Info(
+				"server.HandleMovieAuditDiffsGetAll: movie not found",
 				zap.Int("id", params.ID),
 			)
 			return echo.NewHTTPError(
@@ -335,8 +774,8 @@ func (s *Server) HandleMovieAuditsGetAll(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
-			"server.HandleMovieAuditsGetAll: internal server error",
+		s.loggerOf(c).Error(
+			"server.HandleMovieAuditDiffsGetAll: internal server error",
 			zap.Error(err),
 		)
 		return echo.NewHTTPError(
@@ -347,6 +786,6 @@ func (s *Server) HandleMovieAuditsGetAll(c echo.Context) error {
 
 	return c.JSON(
 		http.StatusOK,
-		response.Paginated(page, perPage, audits, total),
+		response.Paginated(page, perPage, diffs, total),
 	)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/aria3ppp/watch-server/internal/config"
 	"github.com/aria3ppp/watch-server/internal/dto"
 	"github.com/aria3ppp/watch-server/internal/models"
+	appServer "github.com/aria3ppp/watch-server/internal/server"
 	"github.com/aria3ppp/watch-server/internal/server/response"
 	"github.com/aria3ppp/watch-server/internal/testutils"
 	"github.com/gavv/httpexpect/v2"
@@ -68,7 +69,7 @@ func TestHandleMovieGet(t *testing.T) {
 	)
 	require.NoError(err)
 
-	gotMovie, err := appInstance.MovieGet(ctx, movieID)
+	gotMovie, err := appInstance.MovieGet(ctx, movieID, true)
 	require.NoError(err)
 
 	require.GreaterOrEqual(gotMovie.ContributedAt, createTime)
@@ -159,8 +160,11 @@ func TestHandleMoviesGetAll(t *testing.T) {
 
 	gotMovies, total, err := appInstance.MoviesGetAll(
 		ctx,
+		nil,
+		nil,
 		0,
 		config.Config.Pagination.PageSize.MaxValue,
+		true,
 	)
 	require.NoError(err)
 
@@ -192,6 +196,124 @@ func TestHandleMoviesGetAll(t *testing.T) {
 		JSON().
 		Object().
 		Equal(response.Paginated(config.Config.Pagination.Page.MinValue, config.Config.Pagination.PageSize.DefaultValue, items, total))
+
+	// sort and filter movies
+	e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithQuery(config.Config.Listing.Sort.VarName, "-date_released").
+		WithQuery("date_released_gte", "2001-01-01").
+		WithQuery("title_contains", "M").
+		WithQuery("contributed_by", defaults.user.id).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.Paginated(
+			config.Config.Pagination.Page.MinValue,
+			config.Config.Pagination.PageSize.DefaultValue,
+			[]*models.Film{items[4], items[3], items[2], items[1]},
+			4,
+		))
+
+	// sorted cursor pages
+	firstPage := e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithQuery(config.Config.Listing.Sort.VarName, "-date_released").
+		WithQuery(config.Config.Pagination.Cursor.VarName, "").
+		WithQuery(config.Config.Pagination.PageSize.VarName, 3).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	firstPage.Value("payload").Equal([]*models.Film{items[4], items[3], items[2]})
+	nextCursor := firstPage.Value("next_cursor").String().NotEmpty().Raw()
+
+	lastPage := e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithQuery(config.Config.Listing.Sort.VarName, "-date_released").
+		WithQuery(config.Config.Pagination.Cursor.VarName, nextCursor).
+		WithQuery(config.Config.Pagination.PageSize.VarName, 3).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	lastPage.Value("payload").Equal([]*models.Film{items[1], items[0]})
+	lastPage.NotContainsKey("next_cursor")
+
+	// invalid sort and filter params
+	for _, query := range []map[string]string{
+		{config.Config.Listing.Sort.VarName: "contributed_by"},
+		{config.Config.Listing.Sort.VarName: "title,-title"},
+		{"duration_lte": "long"},
+		{"date_released_gte": "2001"},
+		// a cursor of another sort
+		{config.Config.Listing.Sort.VarName: "title", config.Config.Pagination.Cursor.VarName: nextCursor},
+	} {
+		request := e.Request(method, path).
+			WithHeader(echo.HeaderAuthorization, defaults.user.auth)
+		for name, value := range query {
+			request = request.WithQuery(name, value)
+		}
+		request.Expect().
+			Status(http.StatusBadRequest).
+			JSON().
+			Object().
+			Equal(response.Error(response.StatusInvalidURLParameter))
+	}
+}
+
+func TestHandleMoviesSearch(t *testing.T) {
+	require := require.New(t)
+
+	server, _, defaults, teardown, err := setup(
+		OptEnableDefaultUser,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/search/"
+	method := http.MethodGet
+
+	// query is required
+	e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	// no hit
+	e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithQuery("query", "title").
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.Paginated(config.Config.Pagination.Page.MinValue, config.Config.Pagination.PageSize.DefaultValue, []*models.Film{}, 0))
+
+	e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithQuery("query", "title").
+		WithQuery(config.Config.Pagination.Cursor.VarName, "").
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.Cursored(config.Config.Pagination.PageSize.DefaultValue, []*models.Film{}, "", ""))
+
+	// invalid cursor
+	e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithQuery("query", "title").
+		WithQuery(config.Config.Pagination.Cursor.VarName, "!").
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
 }
 
 func TestHandleMovieCreate(t *testing.T) {
@@ -230,7 +352,7 @@ func TestHandleMovieCreate(t *testing.T) {
 
 	// check movie created
 
-	gotMovie, err := appInstance.MovieGet(ctx, movieID)
+	gotMovie, err := appInstance.MovieGet(ctx, movieID, true)
 	require.NoError(err)
 
 	testutils.SetTimeLocation(
@@ -253,11 +375,79 @@ func TestHandleMovieCreate(t *testing.T) {
 			Invalidation:  null.String{},
 			ContributedBy: defaults.user.id,
 			ContributedAt: gotMovie.ContributedAt,
+			ClientIP:      null.StringFrom(testClientIP),
+			UserAgent:     null.StringFrom(testUserAgent),
 		},
 		gotMovie,
 	)
 }
 
+func TestHandleMovieCreate_IdempotencyKey(t *testing.T) {
+	require := require.New(t)
+
+	server, _, defaults, teardown, err := setup(OptEnableDefaultUser)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/"
+
+	movieCreateReq := &dto.MovieCreateRequest{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+
+	// create the movie
+	resp := e.POST(path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(appServer.HeaderIdempotencyKey, "key").
+		WithJSON(movieCreateReq).
+		Expect().
+		Status(http.StatusOK)
+	resp.Header(appServer.HeaderIdempotentReplayed).Empty()
+	movieID := resp.JSON().
+		Object().
+		ValueEqual("status", response.StatusOK.String()).
+		Value("payload").Number().Ge(0).Raw()
+
+	// the retry is answered the same, creating no other movie
+	resp = e.POST(path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(appServer.HeaderIdempotencyKey, "key").
+		WithJSON(movieCreateReq).
+		Expect().
+		Status(http.StatusOK)
+	resp.Header(appServer.HeaderIdempotentReplayed).Equal("true")
+	resp.JSON().
+		Object().
+		Equal(response.OK(int(movieID)))
+
+	// the key is not reused for another request
+	e.POST(path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(appServer.HeaderIdempotencyKey, "key").
+		WithJSON(&dto.MovieCreateRequest{
+			Title:        "other movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		}).
+		Expect().
+		Status(http.StatusUnprocessableEntity).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusIdempotencyKeyReused))
+
+	// while another key creates another movie
+	e.POST(path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(appServer.HeaderIdempotencyKey, "other key").
+		WithJSON(movieCreateReq).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("payload").Number().NotEqual(movieID)
+}
+
 func TestHandleMovieCreate_ValidateRequest(t *testing.T) {
 	require := require.New(t)
 
@@ -561,7 +751,7 @@ func TestHandleMovieUpdate(t *testing.T) {
 			)
 			require.NoError(err)
 
-			gotMovieBeforeUpdate, err := appInstance.MovieGet(ctx, movieID)
+			gotMovieBeforeUpdate, err := appInstance.MovieGet(ctx, movieID, true)
 			require.NoError(err)
 
 			updateTime := time.Now()
@@ -578,7 +768,7 @@ func TestHandleMovieUpdate(t *testing.T) {
 				Equal(response.OK(nil))
 
 			// check updated fields
-			gotMovieAfterUpdate, err := appInstance.MovieGet(ctx, movieID)
+			gotMovieAfterUpdate, err := appInstance.MovieGet(ctx, movieID, true)
 			require.NoError(err)
 
 			require.GreaterOrEqual(
@@ -611,6 +801,8 @@ func TestHandleMovieUpdate(t *testing.T) {
 			updatedMovie.Invalidation = null.String{}
 			updatedMovie.ContributedBy = defaults.user.id
 			updatedMovie.ContributedAt = gotMovieAfterUpdate.ContributedAt
+			updatedMovie.ClientIP = null.StringFrom(testClientIP)
+			updatedMovie.UserAgent = null.StringFrom(testUserAgent)
 
 			testutils.SetTimeLocation(
 				&updatedMovie.DateReleased,
@@ -622,6 +814,97 @@ func TestHandleMovieUpdate(t *testing.T) {
 	}
 }
 
+func TestHandleMovieUpdate_IfMatch(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(OptEnableDefaultUser)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/{id}"
+
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(1900, 3, 14),
+		},
+	)
+	require.NoError(err)
+
+	// get the movie tagged by its version
+	etag := e.GET(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		Header(appServer.HeaderETag).
+		NotEmpty().
+		Raw()
+
+	// the cached movie is still current
+	e.GET(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(appServer.HeaderIfNoneMatch, etag).
+		Expect().
+		Status(http.StatusNotModified).
+		Body().
+		Empty()
+
+	// update the movie at its version
+	e.PATCH(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(appServer.HeaderIfMatch, etag).
+		WithJSON(&dto.MovieUpdateRequest{Title: null.StringFrom("title")}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	// the edits made against the outdated version fail
+	e.PATCH(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(appServer.HeaderIfMatch, etag).
+		WithJSON(&dto.MovieUpdateRequest{Title: null.StringFrom("lost")}).
+		Expect().
+		Status(http.StatusPreconditionFailed).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusPreconditionFailed))
+	e.DELETE(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(appServer.HeaderIfMatch, etag).
+		WithJSON(&dto.InvalidationRequest{Invalidation: "invalidation"}).
+		Expect().
+		Status(http.StatusPreconditionFailed).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusPreconditionFailed))
+
+	// and the cached movie is stale
+	e.GET(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(appServer.HeaderIfNoneMatch, etag).
+		Expect().
+		Status(http.StatusOK).
+		Header(appServer.HeaderETag).
+		NotEqual(etag)
+
+	gotMovie, err := appInstance.MovieGet(ctx, movieID, true)
+	require.NoError(err)
+	require.Equal("title", gotMovie.Title)
+	require.False(gotMovie.Invalidation.Valid)
+}
+
 func TestHandleMovieUpdate_ValidateRequest(t *testing.T) {
 	require := require.New(t)
 
@@ -890,7 +1173,7 @@ func TestHandleMovieInvalidate(t *testing.T) {
 		Equal(response.OK(nil))
 
 	// check movie invalidated
-	gotInvalidatedMovie, err := appInstance.MovieGet(ctx, movieID)
+	gotInvalidatedMovie, err := appInstance.MovieGet(ctx, movieID, true)
 	require.NoError(err)
 
 	testutils.SetTimeLocation(
@@ -911,11 +1194,110 @@ func TestHandleMovieInvalidate(t *testing.T) {
 			Invalidation:  null.StringFrom(invalidationRequest.Invalidation),
 			ContributedBy: defaults.user.id,
 			ContributedAt: gotInvalidatedMovie.ContributedAt,
+			ClientIP:      null.StringFrom(testClientIP),
+			UserAgent:     null.StringFrom(testUserAgent),
 		},
 		gotInvalidatedMovie,
 	)
 }
 
+func TestHandleMovieRestore(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(OptEnableDefaultUser)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/{id}/restore"
+	method := http.MethodPost
+
+	// invalid id
+	e.Request(method, path).
+		WithPath("id", -1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	// movie not found
+	e.Request(method, path).
+		WithPath("id", 999).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	// invalidated movie is hidden unless requested
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(1900, 3, 14),
+		},
+	)
+	require.NoError(err)
+	err = appInstance.MovieInvalidate(
+		ctx,
+		movieID,
+		defaults.user.id,
+		&dto.InvalidationRequest{Invalidation: "invalidation"},
+	)
+	require.NoError(err)
+
+	e.GET("/v1/authorized/movie/{id}", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+	e.GET("/v1/authorized/movie/{id}", movieID).
+		WithQuery(config.Config.Invalidation.Include.VarName, true).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("payload").
+		Object().
+		ValueEqual("invalidation", "invalidation")
+	e.GET("/v1/authorized/movie").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 0)
+
+	// restore movie
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	gotMovie, err := appInstance.MovieGet(ctx, movieID, false)
+	require.NoError(err)
+	require.Equal(null.String{}, gotMovie.Invalidation)
+
+	// restoring a valid movie is a no-op
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+}
+
 func TestHandleMovieInvalidate_ValidateRequest(t *testing.T) {
 	require := require.New(t)
 
@@ -1039,7 +1421,7 @@ func TestHandleMovieAuditsGetAll(t *testing.T) {
 	)
 	require.NoError(err)
 
-	gotMovie, err := appInstance.MovieGet(ctx, movieID)
+	gotMovie, err := appInstance.MovieGet(ctx, movieID, true)
 	require.NoError(err)
 
 	require.GreaterOrEqual(gotMovie.ContributedAt, createTime)
@@ -1079,7 +1461,7 @@ func TestHandleMovieAuditsGetAll(t *testing.T) {
 		),
 		Duration: null.IntFrom(10 * 60),
 	}
-	err = appInstance.MovieUpdate(
+	_, err = appInstance.MovieUpdate(
 		ctx,
 		movieID,
 		defaults.user.id,
@@ -1087,7 +1469,7 @@ func TestHandleMovieAuditsGetAll(t *testing.T) {
 	)
 	require.NoError(err)
 
-	gotMovie, err = appInstance.MovieGet(ctx, movieID)
+	gotMovie, err = appInstance.MovieGet(ctx, movieID, true)
 	require.NoError(err)
 
 	require.GreaterOrEqual(gotMovie.ContributedAt, updateTime)
@@ -1135,3 +1517,194 @@ func TestHandleMovieAuditsGetAll(t *testing.T) {
 		Object().
 		Equal(response.Paginated(config.Config.Pagination.Page.MinValue, config.Config.Pagination.PageSize.DefaultValue, []*models.FilmsAudit{expMovieInvalidationAudit, expMovieUpdateAudit}, 2))
 }
+
+func TestHandleMovieAuditRevert(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(OptEnableDefaultUser)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/{id}/audits/{contributed_at}/revert"
+	method := http.MethodPost
+
+	// invalid id
+	e.Request(method, path).
+		WithPath("id", -1).
+		WithPath("contributed_at", time.Now().UTC().Format(time.RFC3339Nano)).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	// invalid contributed_at
+	e.Request(method, path).
+		WithPath("id", 1).
+		WithPath("contributed_at", "yesterday").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	// movie audit not found
+	e.Request(method, path).
+		WithPath("id", 999).
+		WithPath("contributed_at", time.Now().UTC().Format(time.RFC3339Nano)).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	// revert movie
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(1900, 3, 14),
+		},
+	)
+	require.NoError(err)
+
+	_, err = appInstance.MovieUpdate(
+		ctx,
+		movieID,
+		defaults.user.id,
+		&dto.MovieUpdateRequest{Title: null.StringFrom("new title")},
+	)
+	require.NoError(err)
+
+	audits, total, err := appInstance.MovieAuditsGetAll(ctx, movieID, 0, 10)
+	require.NoError(err)
+	require.Equal(1, total)
+
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithPath(
+			"contributed_at",
+			audits[0].ContributedAt.UTC().Format(time.RFC3339Nano),
+		).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	// check movie reverted and the revert audited
+	gotMovie, err := appInstance.MovieGet(ctx, movieID, true)
+	require.NoError(err)
+	require.Equal("movie", gotMovie.Title)
+
+	audits, total, err = appInstance.MovieAuditsGetAll(ctx, movieID, 0, 10)
+	require.NoError(err)
+	require.Equal(2, total)
+	require.Equal("new title", audits[0].Title)
+}
+
+func TestHandleMovieAuditDiff(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(OptEnableDefaultUser)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/{id}/audits/diff"
+	method := http.MethodGet
+
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(1900, 3, 14),
+		},
+	)
+	require.NoError(err)
+
+	_, err = appInstance.MovieUpdate(
+		ctx,
+		movieID,
+		defaults.user.id,
+		&dto.MovieUpdateRequest{Title: null.StringFrom("new title")},
+	)
+	require.NoError(err)
+
+	movie, err := appInstance.MovieGet(ctx, movieID, true)
+	require.NoError(err)
+	audits, _, err := appInstance.MovieAuditsGetAll(ctx, movieID, 0, 10)
+	require.NoError(err)
+
+	from := audits[0].ContributedAt.UTC().Format(time.RFC3339Nano)
+	to := movie.ContributedAt.UTC().Format(time.RFC3339Nano)
+
+	// missing query
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	// revision not found
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithQuery("from", time.Now().UTC().Format(time.RFC3339Nano)).
+		WithQuery("to", to).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	// diff audit against the current movie
+	changes := e.Request(method, path).
+		WithPath("id", movieID).
+		WithQuery("from", from).
+		WithQuery("to", to).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("payload").
+		Object().
+		ContainsKey("from_contributed_at").
+		ContainsKey("to_contributed_at").
+		ValueEqual("contributed_by", defaults.user.id).
+		Value("changes").
+		Array()
+	changes.Length().Equal(1)
+	changes.First().Object().Equal(map[string]any{
+		"column": models.FilmColumns.Title,
+		"old":    "movie",
+		"new":    "new title",
+	})
+
+	// each revision against its predecessor
+	e.Request(method, "/v1/authorized/movie/{id}/audits/diffs").
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 1).
+		Value("payload").
+		Array().
+		Length().
+		Equal(1)
+}
@@ -0,0 +1,794 @@
+package server
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/server/openapi"
+	"github.com/aria3ppp/watch-server/internal/server/request"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+//go:generate go run ./openapi/opgen -destination openapi_operations.go
+
+// operationSpec describes what a handler takes and answers. The specs are
+// generated out of the code of the handlers into operationSpecs, but for
+// their summaries.
+type operationSpec struct {
+	summary string
+	// struct the path params are bound to
+	params any
+	// struct the query params are bound to
+	query any
+	// query params read by the Fetch*QueryParam helpers, cursorable lists
+	// are paginated unless asked for cursor pagination
+	paginated          bool
+	cursorable         bool
+	cursored           bool
+	includeInvalidated bool
+	cascade            bool
+	// columns the list could be sorted and filtered by
+	list listFields
+	// relations the payload could embed
+	include []string
+	// the payload is tagged by its version for conditional gets
+	etag bool
+	// the edit honors If-Match
+	conditional bool
+	// the create replays its response to the retries of an Idempotency-Key
+	idempotent bool
+	// takes a bearer token outside the authorized group
+	bearer bool
+	// json request body
+	body any
+	// multipart form uploading a file at MediaFormField
+	upload bool
+	// response payload, nil for a null payload. It's the items of paginated
+	// and cursored responses.
+	payload any
+	// the update may be proposed for review instead, answering
+	// http.StatusAccepted with the proposal
+	proposable bool
+	// content type of a response written as is, not enveloped
+	raw string
+}
+
+// operationSummaries summarizes the operations by the handlers of their
+// routes, every routed handler must have one.
+var operationSummaries = map[string]string{
+	// user
+	"HandleUserCreate":              "Sign up",
+	"HandleUserLogin":               "Log in",
+	"HandleUserRefreshToken":        "Get an access token for the refresh token",
+	"HandleUserGet":                 "Get a user",
+	"HandleUserUpdate":              "Update the profile",
+	"HandleUserEmailUpdate":         "Change the email",
+	"HandleUserPasswordUpdate":      "Change the password",
+	"HandleUserAuditsGetAll":        "List the profile history",
+	"HandleUserDelete":              "Request the account deletion",
+	"HandleUserRestore":             "Cancel the pending account deletion",
+	"HandleUserFollow":              "Follow a user",
+	"HandleUserUnfollow":            "Unfollow a user",
+	"HandleUserFollowersGetAll":     "List the followers of a user",
+	"HandleUserFollowingsGetAll":    "List the users a user follows",
+	"HandleUserContributionsGetAll": "List the contributions of a user",
+	"HandleFeedGet":                 "Get the activities of the followed users",
+	"HandleLeaderboardGet":          "Rank the contributors",
+
+	// proposal
+	"HandleProposalsGetAll": "List the proposals",
+	"HandleProposalGet":     "Get a proposal",
+	"HandleProposalApprove": "Approve a proposal",
+	"HandleProposalReject":  "Reject a proposal",
+
+	// movie
+	"HandleMoviesGetAll":          "List the movies",
+	"HandleMoviesSearch":          "Search the movies by title and descriptions",
+	"HandleMovieCreate":           "Create a movie",
+	"HandleMovieGet":              "Get a movie",
+	"HandleMovieUpdate":           "Update a movie",
+	"HandleMovieInvalidate":       "Invalidate a movie",
+	"HandleMovieRestore":          "Restore an invalidated movie",
+	"HandleMovieAuditsGetAll":     "List the revisions of a movie",
+	"HandleMovieAuditDiff":        "Diff two revisions of a movie",
+	"HandleMovieAuditDiffsGetAll": "List the diffs between consecutive revisions of a movie",
+	"HandleMovieAuditRevert":      "Revert a movie to a revision",
+	"HandleMoviePostsGetAll":      "List the posts about a movie",
+
+	// series
+	"HandleSeriesesGetAll":         "List the serieses",
+	"HandleSeriesesSearch":         "Search the serieses by title and descriptions",
+	"HandleSeriesCreate":           "Create a series",
+	"HandleSeriesGet":              "Get a series",
+	"HandleSeriesUpdate":           "Update a series",
+	"HandleSeriesInvalidate":       "Invalidate a series along with its seasons and episodes",
+	"HandleSeriesRestore":          "Restore an invalidated series",
+	"HandleSeriesAuditsGetAll":     "List the revisions of a series",
+	"HandleSeriesAuditDiff":        "Diff two revisions of a series",
+	"HandleSeriesAuditDiffsGetAll": "List the diffs between consecutive revisions of a series",
+	"HandleSeriesAuditRevert":      "Revert a series to a revision",
+	"HandleSeriesPostsGetAll":      "List the posts about a series",
+	"HandleEpisodesGetAllBySeries": "List the episodes of a series",
+	"HandleSeasonsGetAllBySeries":  "List the seasons of a series",
+
+	// season
+	"HandleSeasonGet":          "Get a season",
+	"HandleSeasonPut":          "Create or replace a season",
+	"HandleSeasonUpdate":       "Update a season",
+	"HandleSeasonInvalidate":   "Invalidate a season along with its episodes",
+	"HandleSeasonRestore":      "Restore an invalidated season",
+	"HandleSeasonAuditsGetAll": "List the revisions of a season",
+
+	// episode
+	"HandleEpisodesGetAllBySeason":        "List the episodes of a season",
+	"HandleEpisodesPutAllBySeason":        "Create or replace the episodes of a season",
+	"HandleEpisodesInvalidateAllBySeason": "Invalidate the episodes of a season",
+	"HandleEpisodesRestoreAllBySeason":    "Restore the invalidated episodes of a season",
+	"HandleEpisodeGet":                    "Get an episode",
+	"HandleEpisodePut":                    "Create or replace an episode",
+	"HandleEpisodeUpdate":                 "Update an episode",
+	"HandleEpisodeInvalidate":             "Invalidate an episode",
+	"HandleEpisodeRestore":                "Restore an invalidated episode",
+	"HandleEpisodeAuditsGetAll":           "List the revisions of an episode",
+	"HandleEpisodeAuditRevert":            "Revert an episode to a revision",
+
+	// media
+	"HandleFilmMediaGetAll":     "List the media of a film",
+	"HandleFilmMediaUpload":     "Upload a media of a film",
+	"HandleFilmMediaGet":        "Get a media of a film",
+	"HandleFilmMediaInvalidate": "Invalidate a media of a film",
+	"HandleMediaBlobGet":        "Download a media",
+
+	// artist
+	"HandleArtistCreate":          "Create an artist",
+	"HandleArtistGet":             "Get an artist",
+	"HandleArtistImagesGetAll":    "List the images of an artist",
+	"HandleArtistImageUpload":     "Upload an image of an artist",
+	"HandleArtistImageGet":        "Get an image of an artist",
+	"HandleArtistImageInvalidate": "Invalidate an image of an artist",
+
+	// playlist
+	"HandlePlaylistsGetAll":        "List the own playlists",
+	"HandlePlaylistCreate":         "Create a playlist",
+	"HandlePlaylistGet":            "Get a playlist",
+	"HandlePlaylistUpdate":         "Update a playlist",
+	"HandlePlaylistDelete":         "Delete a playlist",
+	"HandlePlaylistCover":          "Get the cover of a playlist",
+	"HandlePlaylistAddToWatchlist": "Add the films of a playlist to the watchlist",
+	"HandlePlaylistFilmsGetAll":    "List the films of a playlist",
+	"HandlePlaylistFilmsReorder":   "Reorder the films of a playlist",
+	"HandlePlaylistFilmAdd":        "Add a film to a playlist",
+	"HandlePlaylistFilmRemove":     "Remove a film from a playlist",
+
+	// watchlist
+	"HandleWatchlistGetAll":      "List the watchlist",
+	"HandleWatchlistMarkWatched": "Mark a film of the watchlist watched",
+
+	// post
+	"HandlePostCreate":          "Create a post or a reply",
+	"HandlePostGet":             "Get a post",
+	"HandlePostUpdate":          "Edit a post",
+	"HandlePostDelete":          "Delete a post",
+	"HandlePostRepliesGetAll":   "List the replies to a post",
+	"HandlePostRevisionsGetAll": "List the revisions of a post",
+
+	// docs
+	"HandleOpenAPIGet":   "Get this document",
+	"HandleDocsGet":      "Browse this document",
+	"HandleDocsAssetGet": "Get a file of the document browser",
+}
+
+const bearerSecurityScheme = "bearer"
+
+// OpenAPI returns the OpenAPI document of the routes of the server.
+func (s *Server) OpenAPI() (*openapi.Document, error) {
+	s.openapiOnce.Do(func() {
+		s.openapiDoc, s.openapiErr = s.buildOpenAPI()
+	})
+	return s.openapiDoc, s.openapiErr
+}
+
+func (s *Server) buildOpenAPI() (*openapi.Document, error) {
+	g := openapi.NewGenerator()
+	envelope := g.Schema(reflect.TypeOf(response.ResponseValue{}))
+
+	doc := &openapi.Document{
+		OpenAPI: openapi.Version,
+		Info:    openapi.Info{Title: "watch-server", Version: "v1"},
+		Paths:   make(map[string]openapi.PathItem),
+	}
+	documented := make(map[string]bool, len(operationSummaries))
+	for _, route := range s.router.Routes() {
+		name, isHandler := handlerName(route)
+		if !isHandler {
+			// e.g. the not found handlers of groups with middlewares
+			continue
+		}
+		spec, exists := operationSpecs[name]
+		if !exists {
+			return nil, fmt.Errorf(
+				"server.OpenAPI: no operation spec for %s %s handled by %s, "+
+					"run go generate",
+				route.Method,
+				route.Path,
+				name,
+			)
+		}
+		spec.summary, exists = operationSummaries[name]
+		if !exists {
+			return nil, fmt.Errorf(
+				"server.OpenAPI: no summary for %s %s handled by %s",
+				route.Method,
+				route.Path,
+				name,
+			)
+		}
+		documented[name] = true
+
+		path := openapiPath(route.Path)
+		operation, err := spec.operation(g, name, path, envelope)
+		if err != nil {
+			return nil, err
+		}
+		item, exists := doc.Paths[path]
+		if !exists {
+			item = make(openapi.PathItem)
+			doc.Paths[path] = item
+		}
+		item[strings.ToLower(route.Method)] = operation
+	}
+	for name := range operationSummaries {
+		if !documented[name] {
+			return nil, fmt.Errorf(
+				"server.OpenAPI: summary for %s not routed",
+				name,
+			)
+		}
+	}
+
+	doc.Components.Schemas = g.Schemas()
+	doc.Components.SecuritySchemes = map[string]*openapi.SecurityScheme{
+		bearerSecurityScheme: {
+			Type:         "http",
+			Scheme:       "bearer",
+			BearerFormat: "JWT",
+		},
+	}
+	return doc, nil
+}
+
+func (spec *operationSpec) operation(
+	g *openapi.Generator,
+	name string,
+	path string,
+	envelope *openapi.Schema,
+) (*openapi.Operation, error) {
+	operation := &openapi.Operation{
+		OperationID: strings.TrimPrefix(name, "Handle"),
+		Summary:     spec.summary,
+		Tags:        []string{openapiTag(path)},
+		Responses:   make(map[string]*openapi.Response),
+	}
+
+	// parameters
+
+	var pathParams []*openapi.Parameter
+	if spec.params != nil {
+		pathParams = g.Parameters(reflect.TypeOf(spec.params), "path")
+	}
+	if err := checkPathParams(name, path, pathParams); err != nil {
+		return nil, err
+	}
+	operation.Parameters = append(operation.Parameters, pathParams...)
+	if spec.query != nil {
+		operation.Parameters = append(
+			operation.Parameters,
+			g.Parameters(reflect.TypeOf(spec.query), "query")...,
+		)
+	}
+	if spec.paginated {
+		operation.Parameters = append(
+			operation.Parameters,
+			&openapi.Parameter{
+				Name: config.Config.Pagination.Page.VarName,
+				In:   "query",
+				Schema: &openapi.Schema{
+					Type:    "integer",
+					Minimum: float(config.Config.Pagination.Page.MinValue),
+					Default: config.Config.Pagination.Page.MinValue,
+				},
+			},
+		)
+	}
+	if spec.paginated || spec.cursored {
+		operation.Parameters = append(
+			operation.Parameters,
+			&openapi.Parameter{
+				Name: config.Config.Pagination.PageSize.VarName,
+				In:   "query",
+				Schema: &openapi.Schema{
+					Type:    "integer",
+					Minimum: float(config.Config.Pagination.PageSize.MinValue),
+					Maximum: float(config.Config.Pagination.PageSize.MaxValue),
+					Default: config.Config.Pagination.PageSize.DefaultValue,
+				},
+			},
+		)
+	}
+	if spec.cursored {
+		operation.Parameters = append(
+			operation.Parameters,
+			&openapi.Parameter{
+				Name:        config.Config.Pagination.Cursor.VarName,
+				In:          "query",
+				Description: "next_cursor of the previous page, left out for the first page",
+				Schema:      &openapi.Schema{Type: "string"},
+			},
+		)
+	}
+	if spec.cursorable {
+		operation.Parameters = append(
+			operation.Parameters,
+			&openapi.Parameter{
+				Name:        config.Config.Pagination.Cursor.VarName,
+				In:          "query",
+				Description: "switches to cursor pagination leaving out the totals, empty for the first page or the next_cursor or prev_cursor of another",
+				Schema:      &openapi.Schema{Type: "string"},
+			},
+		)
+	}
+	if spec.list != nil {
+		operation.Parameters = append(
+			operation.Parameters,
+			listParameters(spec.list)...,
+		)
+	}
+	if spec.includeInvalidated {
+		operation.Parameters = append(
+			operation.Parameters,
+			&openapi.Parameter{
+				Name:        config.Config.Invalidation.Include.VarName,
+				In:          "query",
+				Description: "include the invalidated records",
+				Schema:      &openapi.Schema{Type: "boolean", Default: false},
+			},
+		)
+	}
+	if spec.include != nil {
+		operation.Parameters = append(
+			operation.Parameters,
+			&openapi.Parameter{
+				Name: config.Config.Shaping.Include.VarName,
+				In:   "query",
+				Description: "comma separated relations to embed in the " +
+					"payload out of " + strings.Join(spec.include, ", "),
+				Schema: &openapi.Schema{Type: "string"},
+			},
+		)
+	}
+	if shapeable(spec.payload) {
+		operation.Parameters = append(
+			operation.Parameters,
+			&openapi.Parameter{
+				Name:        config.Config.Shaping.Fields.VarName,
+				In:          "query",
+				Description: "comma separated fields to narrow the payload objects down to",
+				Schema:      &openapi.Schema{Type: "string"},
+			},
+		)
+	}
+	if spec.etag {
+		operation.Parameters = append(
+			operation.Parameters,
+			&openapi.Parameter{
+				Name:        HeaderIfNoneMatch,
+				In:          "header",
+				Description: "ETag of a cached payload to answer 304 if it's still current",
+				Schema:      &openapi.Schema{Type: "string"},
+			},
+		)
+	}
+	if spec.conditional {
+		operation.Parameters = append(
+			operation.Parameters,
+			&openapi.Parameter{
+				Name:        HeaderIfMatch,
+				In:          "header",
+				Description: "ETags of the versions the edit is made against, failing with 412 if the record changed since",
+				Schema:      &openapi.Schema{Type: "string"},
+			},
+		)
+	}
+	if spec.idempotent {
+		maxLength := config.Config.Validation.Request.IdempotencyKey.MaxLength
+		operation.Parameters = append(
+			operation.Parameters,
+			&openapi.Parameter{
+				Name:        HeaderIdempotencyKey,
+				In:          "header",
+				Description: "unique key of the request to replay its response to its retries",
+				Schema:      &openapi.Schema{Type: "string", MaxLength: &maxLength},
+			},
+		)
+	}
+	if spec.cascade {
+		operation.Parameters = append(
+			operation.Parameters,
+			&openapi.Parameter{
+				Name:        config.Config.Invalidation.Cascade.VarName,
+				In:          "query",
+				Description: "restore the related records as well",
+				Schema:      &openapi.Schema{Type: "boolean", Default: false},
+			},
+		)
+	}
+
+	// request body
+
+	if spec.body != nil {
+		schema := g.Schema(reflect.TypeOf(spec.body))
+		// the authorized writes take an edit summary along with the object
+		// they're made with
+		authorized := strings.HasPrefix(path, "/v1/authorized/")
+		if authorized && reflect.Indirect(reflect.ValueOf(spec.body)).Kind() == reflect.Struct {
+			maxLength := config.Config.Validation.Request.EditSummary.MaxLength
+			schema = &openapi.Schema{
+				AllOf: []*openapi.Schema{
+					schema,
+					{
+						Type: "object",
+						Properties: map[string]*openapi.Schema{
+							FieldEditSummary: {Type: "string", MaxLength: &maxLength},
+						},
+					},
+				},
+			}
+			operation.Parameters = append(
+				operation.Parameters,
+				&openapi.Parameter{
+					Name:        HeaderEditSummary,
+					In:          "header",
+					Description: "summary of the edit, unless told by the " + FieldEditSummary + " field of the body",
+					Schema:      &openapi.Schema{Type: "string", MaxLength: &maxLength},
+				},
+			)
+		}
+		operation.RequestBody = &openapi.RequestBody{
+			Required: true,
+			Content: map[string]*openapi.MediaType{
+				echo.MIMEApplicationJSON: {Schema: schema},
+			},
+		}
+	}
+	if spec.upload {
+		operation.RequestBody = &openapi.RequestBody{
+			Required: true,
+			Content: map[string]*openapi.MediaType{
+				echo.MIMEMultipartForm: {
+					Schema: &openapi.Schema{
+						Type: "object",
+						Properties: map[string]*openapi.Schema{
+							MediaFormField: {Type: "string", Format: "binary"},
+						},
+						Required: []string{MediaFormField},
+					},
+				},
+			},
+		}
+	}
+
+	// security
+
+	if spec.bearer || strings.HasPrefix(path, "/v1/authorized/") {
+		operation.Security = []map[string][]string{
+			{bearerSecurityScheme: {}},
+		}
+	}
+
+	// responses
+
+	if spec.raw != "" {
+		schema := &openapi.Schema{Type: "string", Format: "binary"}
+		if spec.raw == echo.MIMEApplicationJSON {
+			schema = &openapi.Schema{Type: "object"}
+		} else if strings.HasPrefix(spec.raw, "text/") {
+			schema = &openapi.Schema{Type: "string"}
+		}
+		operation.Responses[fmt.Sprint(http.StatusOK)] = &openapi.Response{
+			Description: http.StatusText(http.StatusOK),
+			Content: map[string]*openapi.MediaType{
+				spec.raw: {Schema: schema},
+			},
+		}
+	} else {
+		operation.Responses[fmt.Sprint(http.StatusOK)] = enveloped(
+			g,
+			envelope,
+			http.StatusText(http.StatusOK),
+			spec.payload,
+		)
+	}
+	if spec.etag {
+		ok := operation.Responses[fmt.Sprint(http.StatusOK)]
+		ok.Headers = map[string]*openapi.Header{
+			HeaderETag: {
+				Description: "version of the payload, left out when relations are included",
+				Schema:      &openapi.Schema{Type: "string"},
+			},
+		}
+		operation.Responses[fmt.Sprint(http.StatusNotModified)] = &openapi.Response{
+			Description: http.StatusText(http.StatusNotModified),
+		}
+	}
+	if spec.conditional {
+		operation.Responses[fmt.Sprint(http.StatusPreconditionFailed)] = enveloped(
+			g,
+			envelope,
+			"Changed since the versions of If-Match",
+			nil,
+		)
+	}
+	if spec.idempotent {
+		operation.Responses[fmt.Sprint(http.StatusConflict)] = enveloped(
+			g,
+			envelope,
+			"A request made with the Idempotency-Key isn't answered yet",
+			nil,
+		)
+		operation.Responses[fmt.Sprint(http.StatusUnprocessableEntity)] = enveloped(
+			g,
+			envelope,
+			"The Idempotency-Key was used for another request",
+			nil,
+		)
+	}
+	if spec.proposable {
+		operation.Responses[fmt.Sprint(http.StatusAccepted)] = enveloped(
+			g,
+			envelope,
+			"Proposed for review by a moderator",
+			models.Proposal{},
+		)
+	}
+	// all routes are rate limited, the responses to the requests counted bear
+	// the X-RateLimit headers
+	tooManyRequests := enveloped(g, envelope, "Rate limited", nil)
+	tooManyRequests.Headers = map[string]*openapi.Header{
+		echo.HeaderRetryAfter: {
+			Description: "seconds until a request is let through",
+			Schema:      &openapi.Schema{Type: "integer"},
+		},
+		HeaderXRateLimitLimit: {
+			Description: "requests let through in a burst",
+			Schema:      &openapi.Schema{Type: "integer"},
+		},
+		HeaderXRateLimitRemaining: {
+			Description: "requests left to let through in the burst",
+			Schema:      &openapi.Schema{Type: "integer"},
+		},
+		HeaderXRateLimitReset: {
+			Description: "seconds until the burst is refilled",
+			Schema:      &openapi.Schema{Type: "integer"},
+		},
+	}
+	operation.Responses[fmt.Sprint(http.StatusTooManyRequests)] = tooManyRequests
+	operation.Responses["default"] = enveloped(g, envelope, "Error", nil)
+
+	return operation, nil
+}
+
+// enveloped returns a response enveloping payload in response.ResponseValue
+func enveloped(
+	g *openapi.Generator,
+	envelope *openapi.Schema,
+	description string,
+	payload any,
+) *openapi.Response {
+	schema := envelope
+	if payload != nil {
+		schema = &openapi.Schema{
+			AllOf: []*openapi.Schema{
+				envelope,
+				{
+					Type: "object",
+					Properties: map[string]*openapi.Schema{
+						"payload": g.Schema(reflect.TypeOf(payload)),
+					},
+				},
+			},
+		}
+	}
+	return &openapi.Response{
+		Description: description,
+		Content: map[string]*openapi.MediaType{
+			echo.MIMEApplicationJSON: {Schema: schema},
+		},
+	}
+}
+
+// checkPathParams ensures the path params bound by a handler are the ones
+// of its path.
+func checkPathParams(name, path string, params []*openapi.Parameter) error {
+	bound := make(map[string]bool, len(params))
+	for _, param := range params {
+		bound[param.Name] = true
+	}
+	var inPath int
+	for _, segment := range strings.Split(path, "/") {
+		if !strings.HasPrefix(segment, "{") {
+			continue
+		}
+		inPath++
+		param := strings.Trim(segment, "{}")
+		if !bound[param] {
+			return fmt.Errorf(
+				"server.OpenAPI: path param %s of %s not bound by %s",
+				param,
+				path,
+				name,
+			)
+		}
+	}
+	if inPath != len(params) {
+		return fmt.Errorf(
+			"server.OpenAPI: %s binds path params missing from %s",
+			name,
+			path,
+		)
+	}
+	return nil
+}
+
+// listParameters documents the sort and filter query params of a list
+func listParameters(fields listFields) []*openapi.Parameter {
+	var sortable []string
+	for _, column := range fields.columns() {
+		if fields[column].sortable {
+			sortable = append(sortable, column)
+		}
+	}
+	params := []*openapi.Parameter{{
+		Name: config.Config.Listing.Sort.VarName,
+		In:   "query",
+		Description: "comma separated columns to sort by, descending when " +
+			"prefixed by a minus, out of " + strings.Join(sortable, ", ") +
+			". Cursors page the list in the sort they were made with",
+		Schema: &openapi.Schema{Type: "string"},
+	}}
+	for _, column := range fields.columns() {
+		field := fields[column]
+		for _, operator := range field.operators {
+			param := &openapi.Parameter{
+				Name:        filterParamName(column, operator),
+				In:          "query",
+				Description: fmt.Sprintf("filter by %s %s", column, operator),
+				Schema:      &openapi.Schema{Type: "string"},
+			}
+			switch field.typ {
+			case listFieldInt:
+				param.Schema.Type = "integer"
+			case listFieldTime:
+				param.Schema.Description = "a date or an RFC 3339 time"
+			}
+			params = append(params, param)
+		}
+	}
+	return params
+}
+
+// shapeable reports whether payload is an object or a list of them, which
+// the fields query param narrows down.
+func shapeable(payload any) bool {
+	if payload == nil {
+		return false
+	}
+	t := reflect.TypeOf(payload)
+	if t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
+// handlerName returns the name of the server method handling route
+func handlerName(route *echo.Route) (name string, isHandler bool) {
+	// route names look like "<pkg>.(*Server).HandleX-fm"
+	_, name, isHandler = strings.Cut(route.Name, ".(*Server).")
+	return strings.TrimSuffix(name, "-fm"), isHandler
+}
+
+// openapiPath turns the ":param" segments of an echo path into "{param}"
+func openapiPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// openapiTag groups operations by the first segment of their path after the
+// version and authorized groups.
+func openapiTag(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, segment := range segments {
+		if segment != "v1" && segment != "authorized" {
+			return segment
+		}
+	}
+	return ""
+}
+
+func float(n int) *float64 {
+	f := float64(n)
+	return &f
+}
+
+// the Swagger UI browsing the document, swagger-ui-dist 5.18.2 vendored
+// along with its license
+//
+//go:embed docs
+var docsFS embed.FS
+
+// docs holds the files of the Swagger UI at its root
+var docs = echo.MustSubFS(docsFS, "docs")
+
+// GET /v1/openapi.json/
+func (s *Server) HandleOpenAPIGet(c echo.Context) error {
+	doc, err := s.OpenAPI()
+	if err != nil {
+		s.loggerOf(c).Error(
+			"server.HandleOpenAPIGet: document generation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+	return c.JSON(http.StatusOK, doc)
+}
+
+// GET /v1/docs/
+func (s *Server) HandleDocsGet(c echo.Context) error {
+	return echo.StaticFileHandler("index.html", docs)(c)
+}
+
+// GET /v1/docs/:name/
+func (s *Server) HandleDocsAssetGet(c echo.Context) error {
+	// bind & validate params
+	var params request.DocsAssetPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleDocsAssetGet: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	if _, err := fs.Stat(docs, params.Name); err != nil {
+		return echo.NewHTTPError(
+			http.StatusNotFound,
+			response.Error(response.StatusNotFound),
+		)
+	}
+	return echo.StaticFileHandler(params.Name, docs)(c)
+}
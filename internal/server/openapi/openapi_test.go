@@ -0,0 +1,261 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/ratelimit"
+	"github.com/aria3ppp/watch-server/internal/server"
+	"github.com/aria3ppp/watch-server/internal/server/openapi"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestMain(m *testing.M) {
+	// the document depends on no service, so the envs they're configured
+	// by are only set to get the configs loaded
+	for env, value := range map[string]string{
+		"DSN":                "",
+		"SERVER_LOGFILE":     "",
+		"SERVER_PORT":        "0",
+		"SERVER_SECRET_KEY":  "",
+		"ELASTICSEARCH_URL":  "",
+		"STORAGE_LOCAL_ROOT": "",
+	} {
+		if _, isSet := os.LookupEnv(env); !isSet {
+			os.Setenv(env, value)
+		}
+	}
+	err := config.Load(filepath.Join("..", "..", "..", "config.yaml"))
+	if err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+func newDocument(t *testing.T) (*echo.Echo, *openapi.Document) {
+	router := echo.New()
+	s := server.NewServer(nil, router, nil, nil, zap.NewNop())
+	doc, err := s.OpenAPI()
+	require.NoError(t, err)
+	return router, doc
+}
+
+func TestDocumentCoversRoutes(t *testing.T) {
+	require := require.New(t)
+
+	router, doc := newDocument(t)
+
+	routes := make(map[string]bool)
+	for _, route := range router.Routes() {
+		if !strings.Contains(route.Name, ".(*Server).Handle") {
+			continue
+		}
+		path := route.Path
+		for _, segment := range strings.Split(path, "/") {
+			if strings.HasPrefix(segment, ":") {
+				path = strings.Replace(path, segment, "{"+segment[1:]+"}", 1)
+			}
+		}
+		routes[strings.ToLower(route.Method)+" "+path] = true
+	}
+
+	operations := make(map[string]bool)
+	operationIDs := make(map[string]bool)
+	for path, item := range doc.Paths {
+		for method, operation := range item {
+			operations[method+" "+path] = true
+
+			require.NotEmpty(operation.Summary, "%s %s", method, path)
+			require.False(
+				operationIDs[operation.OperationID],
+				"duplicate operation id %s",
+				operation.OperationID,
+			)
+			operationIDs[operation.OperationID] = true
+		}
+	}
+
+	require.Equal(routes, operations)
+}
+
+func TestDocumentSchemas(t *testing.T) {
+	require := require.New(t)
+
+	_, doc := newDocument(t)
+
+	// the document is valid json
+	_, err := json.Marshal(doc)
+	require.NoError(err)
+
+	// request limits come from the validation configs
+	userCreate := doc.Components.Schemas["dto.UserCreateRequest"]
+	require.NotNil(userCreate)
+	require.Equal([]string{"email", "password"}, userCreate.Required)
+	email := userCreate.Properties["email"]
+	require.Equal("string", email.Type)
+	require.Equal(config.Config.Validation.User.Email.MinLength, *email.MinLength)
+	require.Equal(config.Config.Validation.User.Email.MaxLength, *email.MaxLength)
+	bio := userCreate.Properties["bio"]
+	require.True(bio.Nullable)
+	require.Equal(config.Config.Validation.User.Bio.MaxLength, *bio.MaxLength)
+	require.Equal("date-time", userCreate.Properties["birthdate"].Format)
+
+	reorder := doc.Components.Schemas["dto.PlaylistFilmsReorderRequest"]
+	require.NotNil(reorder)
+	filmIDs := reorder.Properties["film_ids"]
+	require.Equal("array", filmIDs.Type)
+	require.Equal(1, *filmIDs.MinItems)
+	require.Equal(config.Config.Validation.Request.Array.MaxLength, *filmIDs.MaxItems)
+	require.Equal(1.0, *filmIDs.Items.Minimum)
+
+	// the envelope statuses are enumerated
+	envelope := doc.Components.Schemas["response.ResponseValue"]
+	require.NotNil(envelope)
+	require.Contains(envelope.Properties["status"].Enum, "OK")
+	require.Contains(envelope.Properties["status"].Enum, "NotFound")
+
+	// path params bear their limits
+	episodeGet := doc.Paths["/v1/authorized/series/{id}/season/{season_number}/episode/{episode_number}/"]["get"]
+	require.NotNil(episodeGet)
+	var seasonNumber *openapi.Parameter
+	for _, param := range episodeGet.Parameters {
+		if param.Name == "season_number" {
+			seasonNumber = param
+		}
+	}
+	require.NotNil(seasonNumber)
+	require.Equal("path", seasonNumber.In)
+	require.True(seasonNumber.Required)
+	require.Equal("integer", seasonNumber.Schema.Type)
+
+	// lists document their sort and filter params
+	moviesGetAll := doc.Paths["/v1/authorized/movie/"]["get"]
+	require.NotNil(moviesGetAll)
+	params := make(map[string]*openapi.Parameter)
+	for _, param := range moviesGetAll.Parameters {
+		params[param.Name] = param
+	}
+	require.Contains(params, config.Config.Listing.Sort.VarName)
+	require.Contains(params, "title_contains")
+	require.Equal("integer", params["duration_lte"].Schema.Type)
+	require.Equal("integer", params["contributed_by"].Schema.Type)
+	require.NotContains(params, "id")
+	require.Contains(params, config.Config.Shaping.Fields.VarName)
+
+	// gets document the relations they could embed
+	var include *openapi.Parameter
+	for _, param := range episodeGet.Parameters {
+		if param.Name == config.Config.Shaping.Include.VarName {
+			include = param
+		}
+	}
+	require.NotNil(include)
+	require.Contains(include.Description, "series")
+
+	// gets are tagged and edits conditional
+	require.Contains(episodeGet.Responses, "304")
+	require.Contains(episodeGet.Responses["200"].Headers, server.HeaderETag)
+	movieUpdate := doc.Paths["/v1/authorized/movie/{id}/"]["patch"]
+	require.NotNil(movieUpdate)
+	require.Contains(movieUpdate.Responses, "412")
+	var ifMatch *openapi.Parameter
+	for _, param := range movieUpdate.Parameters {
+		if param.Name == server.HeaderIfMatch {
+			ifMatch = param
+		}
+	}
+	require.NotNil(ifMatch)
+	require.Equal("header", ifMatch.In)
+
+	// creates take an idempotency key
+	movieCreate := doc.Paths["/v1/authorized/movie/"]["post"]
+	require.NotNil(movieCreate)
+	require.Contains(movieCreate.Responses, "409")
+	require.Contains(movieCreate.Responses, "422")
+	var idempotencyKey *openapi.Parameter
+	for _, param := range movieCreate.Parameters {
+		if param.Name == server.HeaderIdempotencyKey {
+			idempotencyKey = param
+		}
+	}
+	require.NotNil(idempotencyKey)
+	require.Equal("header", idempotencyKey.In)
+	require.Equal(
+		config.Config.Validation.Request.IdempotencyKey.MaxLength,
+		*idempotencyKey.Schema.MaxLength,
+	)
+
+	// authorized writes take an edit summary in the body or the header
+	var editSummary *openapi.Parameter
+	for _, param := range movieCreate.Parameters {
+		if param.Name == server.HeaderEditSummary {
+			editSummary = param
+		}
+	}
+	require.NotNil(editSummary)
+	require.Equal("header", editSummary.In)
+	movieCreateBody := movieCreate.RequestBody.Content[echo.MIMEApplicationJSON].Schema
+	require.Len(movieCreateBody.AllOf, 2)
+	require.Equal(
+		config.Config.Validation.Request.EditSummary.MaxLength,
+		*movieCreateBody.AllOf[1].Properties[server.FieldEditSummary].MaxLength,
+	)
+
+	// all operations are rate limited
+	login := doc.Paths["/v1/user/login/"]["post"]
+	require.NotNil(login)
+	require.Contains(login.Responses, "429")
+	require.Contains(login.Responses["429"].Headers, echo.HeaderRetryAfter)
+	require.Contains(movieCreate.Responses, "429")
+
+	// authorized operations require a bearer token
+	require.NotEmpty(episodeGet.Security)
+	require.Empty(doc.Paths["/v1/user/login/"]["post"].Security)
+}
+
+func TestDocsServed(t *testing.T) {
+	router := echo.New()
+	server.NewServer(nil, router, nil, ratelimit.NewMemory(0), zap.NewNop())
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	// the browser loads nothing but the files served along
+	rec := get("/v1/docs/")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Header().Get(echo.HeaderContentType), "text/html")
+	require.Contains(t, rec.Body.String(), "./swagger-ui-bundle.js")
+	require.NotContains(t, rec.Body.String(), "https://")
+
+	for _, asset := range []string{
+		"swagger-ui-bundle.js",
+		"swagger-ui.css",
+		"favicon-32x32.png",
+		"favicon-16x16.png",
+	} {
+		rec := get("/v1/docs/" + asset)
+		require.Equal(t, http.StatusOK, rec.Code, asset)
+		require.NotZero(t, rec.Body.Len(), asset)
+	}
+	require.Contains(
+		t,
+		get("/v1/docs/swagger-ui-bundle.js").Header().Get(echo.HeaderContentType),
+		"javascript",
+	)
+
+	// nothing else is served
+	require.Equal(t, http.StatusNotFound, get("/v1/docs/missing.js").Code)
+	require.Equal(t, http.StatusNotFound, get("/v1/docs/..%2Fopenapi.go").Code)
+	require.Equal(t, http.StatusBadRequest, get("/v1/docs/.hidden").Code)
+}
@@ -0,0 +1,586 @@
+// opgen generates the operation specs of the handlers of the server out of
+// their code: the params, query and body they bind, the query params they
+// fetch, the payloads they answer with and the middlewares they're routed
+// through. Only the summaries of the operations are left to be written.
+//
+//	opgen -destination openapi_operations.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	responseImportPath = "github.com/aria3ppp/watch-server/internal/server/response"
+	echoImportPath     = "github.com/labstack/echo/v4"
+)
+
+func main() {
+	destination := flag.String("destination", "", "file to write to")
+	flag.Parse()
+	if *destination == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	code, err := Generate(".", *destination)
+	if err != nil {
+		log.Fatalf("opgen: %s", err)
+	}
+	if err := os.WriteFile(*destination, code, 0o644); err != nil {
+		log.Fatalf("opgen: %s", err)
+	}
+}
+
+// Generate returns the operation specs of the handlers of the package in
+// dir, leaving out the destination file it's written to.
+func Generate(dir, destination string) ([]byte, error) {
+	fset := token.NewFileSet()
+	packages, err := parser.ParseDir(
+		fset,
+		dir,
+		func(info os.FileInfo) bool {
+			name := info.Name()
+			return !strings.HasSuffix(name, "_test.go") &&
+				name != filepath.Base(destination)
+		},
+		0,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(packages) != 1 {
+		return nil, fmt.Errorf("found %d packages in %s", len(packages), dir)
+	}
+	var (
+		pkgName string
+		files   []*ast.File
+	)
+	for name, pkg := range packages {
+		pkgName = name
+		for _, file := range pkg.Files {
+			files = append(files, file)
+		}
+	}
+	// the files are walked in order so the specs are grouped as the handlers
+	sort.Slice(files, func(i, j int) bool {
+		return fset.File(files[i].Pos()).Name() < fset.File(files[j].Pos()).Name()
+	})
+
+	importer, err := exportImporter(fset, dir)
+	if err != nil {
+		return nil, err
+	}
+	info := &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Uses:  make(map[*ast.Ident]types.Object),
+		Defs:  make(map[*ast.Ident]types.Object),
+	}
+	conf := types.Config{
+		Importer: importer,
+		// the package refers to the specs being generated, so it's checked
+		// as far as it goes without them
+		Error: func(error) {},
+	}
+	pkg, _ := conf.Check(pkgName, fset, files, info)
+
+	g := &generator{
+		fset:    fset,
+		info:    info,
+		pkg:     pkg,
+		imports: make(map[string]string),
+		routes:  make(map[string]*route),
+	}
+	for _, file := range files {
+		g.findRoutes(file)
+	}
+	var specs []*spec
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || !isHandler(funcDecl) {
+				continue
+			}
+			s, err := g.spec(funcDecl)
+			if err != nil {
+				return nil, err
+			}
+			specs = append(specs, s)
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by opgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", pkgName)
+	importPaths := make([]string, 0, len(g.imports))
+	for importPath := range g.imports {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+	fmt.Fprintf(&out, "import (\n")
+	for _, importPath := range importPaths {
+		line := strconv.Quote(importPath)
+		if g.imports[importPath] != defaultImportName(importPath) {
+			line = g.imports[importPath] + " " + line
+		}
+		fmt.Fprintf(&out, "\t%s\n", line)
+	}
+	fmt.Fprintf(&out, ")\n\n")
+	fmt.Fprintf(&out, "var operationSpecs = map[string]operationSpec{\n")
+	for _, s := range specs {
+		fmt.Fprintf(&out, "%q: {\n", s.name)
+		for _, field := range s.fields {
+			fmt.Fprintf(&out, "%s: %s,\n", field.name, field.value)
+		}
+		fmt.Fprintf(&out, "},\n")
+	}
+	fmt.Fprintf(&out, "}\n")
+
+	return format.Source(out.Bytes())
+}
+
+// exportImporter imports the dependencies of the package in dir from their
+// export data, as built by the go command.
+func exportImporter(fset *token.FileSet, dir string) (types.Importer, error) {
+	cmd := exec.Command(
+		"go", "list", "-e", "-export", "-deps",
+		"-f", "{{.ImportPath}}={{.Export}}",
+		".",
+	)
+	cmd.Dir = dir
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list: %w", err)
+	}
+	exports := make(map[string]string)
+	for _, line := range strings.Split(string(out), "\n") {
+		if importPath, export, ok := strings.Cut(line, "="); ok && export != "" {
+			exports[importPath] = export
+		}
+	}
+	return importer.ForCompiler(
+		fset,
+		"gc",
+		func(importPath string) (io.ReadCloser, error) {
+			export, exists := exports[importPath]
+			if !exists {
+				return nil, fmt.Errorf("no export data for %s", importPath)
+			}
+			return os.Open(export)
+		},
+	), nil
+}
+
+type generator struct {
+	fset *token.FileSet
+	info *types.Info
+	pkg  *types.Package
+	// the names the generated code refers to the imports by
+	imports map[string]string
+	// the middlewares the handlers are routed through
+	routes map[string]*route
+}
+
+type route struct {
+	conditional bool
+	idempotent  bool
+}
+
+type spec struct {
+	name   string
+	fields []field
+}
+
+type field struct {
+	name, value string
+}
+
+// findRoutes notes the middlewares of the routes registered in file, as in
+// group.GET(path, s.HandleX, middlewares...)
+func (g *generator) findRoutes(file *ast.File) {
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) < 2 {
+			return true
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !isMethod(selector.Sel.Name) {
+			return true
+		}
+		handler := methodName(call.Args[1])
+		if !strings.HasPrefix(handler, "Handle") {
+			return true
+		}
+		r := g.routes[handler]
+		if r == nil {
+			r = &route{}
+			g.routes[handler] = r
+		}
+		for _, arg := range call.Args[2:] {
+			switch methodName(arg) {
+			case "PreconditionMiddleware":
+				r.conditional = true
+			case "IdempotencyMiddleware":
+				r.idempotent = true
+			}
+		}
+		return true
+	})
+}
+
+// spec derives the spec of the handler out of its body
+func (g *generator) spec(funcDecl *ast.FuncDecl) (*spec, error) {
+	name := funcDecl.Name.Name
+	var (
+		params, query, body, list string
+		include                   []string
+		payload, raw              string
+		payloadFound              bool
+		paginated, cursor         bool
+		includeInvalidated        bool
+		cascade, etag, upload     bool
+		bearer, proposable        bool
+		err                       error
+	)
+	setPayload := func(value string) {
+		if payloadFound && payload != value {
+			err = fmt.Errorf(
+				"%s answers with payloads of types %s and %s",
+				name,
+				payload,
+				value,
+			)
+		}
+		payload, payloadFound = value, true
+	}
+
+	ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		switch calleeName(call.Fun) {
+		case "BindPathParams":
+			params = g.boundType(call)
+		case "BindQueryParams":
+			query = g.boundType(call)
+		case "BindBody":
+			body = g.boundType(call)
+		case "FetchPaginationQueryParams":
+			paginated = true
+		case "FetchCursorPaginationQueryParams":
+			cursor = true
+		case "FetchIncludeInvalidatedQueryParam":
+			includeInvalidated = true
+		case "FetchCascadeQueryParam":
+			cascade = true
+		case "FetchListQueryParams":
+			list = g.expr(call.Args[1])
+		case "FetchIncludeQueryParam":
+			for _, arg := range call.Args[1:] {
+				include = append(include, g.expr(arg))
+			}
+		case "notModified":
+			etag = true
+		case "FormFile":
+			upload = true
+		case "ExtractTokenFromAuth":
+			bearer = true
+		case "Paginated":
+			if g.isResponseFunc(call) {
+				setPayload(g.zeroValue(g.info.TypeOf(call.Args[2])))
+			}
+		case "Cursored":
+			if g.isResponseFunc(call) {
+				setPayload(g.zeroValue(g.info.TypeOf(call.Args[1])))
+			}
+		case "JSON":
+			status := g.status(call.Args[0])
+			ok, isOK := call.Args[1].(*ast.CallExpr)
+			if isOK && calleeName(ok.Fun) == "OK" && g.isResponseFunc(ok) {
+				switch status {
+				case http.StatusOK:
+					setPayload(g.zeroValue(g.info.TypeOf(g.embedded(ok.Args[0]))))
+				case http.StatusAccepted:
+					proposable = true
+				}
+			} else if status == http.StatusOK && !g.isResponseValue(call.Args[1]) {
+				raw = g.echo("MIMEApplicationJSON")
+			}
+		case "HTMLBlob":
+			raw = g.echo("MIMETextHTML")
+		case "Blob", "Stream":
+			raw = g.contentType(call.Args[1])
+		case "StaticFileHandler":
+			raw = g.fileContentType(call.Args[0])
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s := &spec{name: name}
+	add := func(name, value string) {
+		s.fields = append(s.fields, field{name, value})
+	}
+	if params != "" {
+		add("params", params)
+	}
+	if query != "" {
+		add("query", query)
+	}
+	if paginated {
+		add("paginated", "true")
+	}
+	// the lists paginated by page as well switch to cursors on demand
+	if cursor && paginated {
+		add("cursorable", "true")
+	}
+	if cursor && !paginated {
+		add("cursored", "true")
+	}
+	if includeInvalidated {
+		add("includeInvalidated", "true")
+	}
+	if cascade {
+		add("cascade", "true")
+	}
+	if list != "" {
+		add("list", list)
+	}
+	if include != nil {
+		add("include", "[]string{"+strings.Join(include, ", ")+"}")
+	}
+	if etag {
+		add("etag", "true")
+	}
+	if r := g.routes[name]; r != nil && r.conditional {
+		add("conditional", "true")
+	}
+	if r := g.routes[name]; r != nil && r.idempotent {
+		add("idempotent", "true")
+	}
+	if bearer {
+		add("bearer", "true")
+	}
+	if body != "" {
+		add("body", body)
+	}
+	if upload {
+		add("upload", "true")
+	}
+	if payloadFound && payload != "nil" {
+		add("payload", payload)
+	}
+	if proposable {
+		add("proposable", "true")
+	}
+	if raw != "" {
+		add("raw", raw)
+	}
+	return s, nil
+}
+
+// embedded returns the payload the relations are embedded in by
+// response.Embed, the expression itself if it's not embedding any
+func (g *generator) embedded(expr ast.Expr) ast.Expr {
+	call, ok := expr.(*ast.CallExpr)
+	if ok && calleeName(call.Fun) == "Embed" && g.isResponseFunc(call) {
+		return call.Args[0]
+	}
+	return expr
+}
+
+// boundType returns the zero value of the type the call binds its second
+// argument to
+func (g *generator) boundType(call *ast.CallExpr) string {
+	arg := call.Args[1]
+	if unary, ok := arg.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		return g.zeroValue(g.info.TypeOf(unary.X))
+	}
+	return g.zeroValue(g.info.TypeOf(arg).(*types.Pointer).Elem())
+}
+
+// zeroValue returns an expression of the zero value of typ, of the element
+// type of pointers
+func (g *generator) zeroValue(typ types.Type) string {
+	if typ == nil {
+		return "nil"
+	}
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	switch t := typ.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case t.Kind() == types.UntypedNil:
+			return "nil"
+		case t.Info()&types.IsNumeric != 0:
+			return "0"
+		case t.Info()&types.IsString != 0:
+			return `""`
+		case t.Info()&types.IsBoolean != 0:
+			return "false"
+		}
+	}
+	return types.TypeString(typ, g.qualifier) + "{}"
+}
+
+// qualifier names the packages of the types the generated code refers to
+func (g *generator) qualifier(pkg *types.Package) string {
+	if pkg == g.pkg || pkg.Path() == g.pkg.Path() {
+		return ""
+	}
+	g.imports[pkg.Path()] = pkg.Name()
+	return pkg.Name()
+}
+
+// status returns the http status the expression evaluates to
+func (g *generator) status(expr ast.Expr) int {
+	value := g.info.Types[expr].Value
+	if value == nil {
+		return 0
+	}
+	status, _ := constant.Int64Val(value)
+	return int(status)
+}
+
+// contentType returns the content type the expression evaluates to, the one
+// of binary data if it's told at run time
+func (g *generator) contentType(expr ast.Expr) string {
+	if value := g.info.Types[expr].Value; value != nil {
+		return strconv.Quote(constant.StringVal(value))
+	}
+	return g.echo("MIMEOctetStream")
+}
+
+// fileContentType returns the content type of the file the expression
+// names, the one of binary data if it's told at run time
+func (g *generator) fileContentType(expr ast.Expr) string {
+	if value := g.info.Types[expr].Value; value != nil {
+		contentType := mime.TypeByExtension(path.Ext(constant.StringVal(value)))
+		if contentType != "" {
+			return strconv.Quote(contentType)
+		}
+	}
+	return g.echo("MIMEOctetStream")
+}
+
+// echo refers to the exported name of the echo package
+func (g *generator) echo(name string) string {
+	g.imports[echoImportPath] = "echo"
+	return "echo." + name
+}
+
+// isResponseFunc reports whether call calls a function of the response
+// package
+func (g *generator) isResponseFunc(call *ast.CallExpr) bool {
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	fn, ok := g.info.Uses[selector.Sel].(*types.Func)
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == responseImportPath
+}
+
+// isResponseValue reports whether expr is an envelope of the response
+// package
+func (g *generator) isResponseValue(expr ast.Expr) bool {
+	typ := g.info.TypeOf(expr)
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	return ok && named.Obj().Pkg() != nil &&
+		named.Obj().Pkg().Path() == responseImportPath
+}
+
+// expr prints expr as in the source
+func (g *generator) expr(expr ast.Expr) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, g.fset, expr)
+	return buf.String()
+}
+
+// isHandler reports whether funcDecl is a handler method of the server
+func isHandler(funcDecl *ast.FuncDecl) bool {
+	if funcDecl.Recv == nil || !strings.HasPrefix(funcDecl.Name.Name, "Handle") {
+		return false
+	}
+	star, ok := funcDecl.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == "Server"
+}
+
+// calleeName returns the name of the function or method called
+func calleeName(fun ast.Expr) string {
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return f.Name
+	case *ast.SelectorExpr:
+		return f.Sel.Name
+	}
+	return ""
+}
+
+// methodName returns the name of the method value, as in s.HandleX
+func methodName(expr ast.Expr) string {
+	if selector, ok := expr.(*ast.SelectorExpr); ok {
+		return selector.Sel.Name
+	}
+	return ""
+}
+
+func isMethod(name string) bool {
+	switch name {
+	case http.MethodGet, http.MethodPost, http.MethodPut,
+		http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// defaultImportName returns the name of the package of importPath, assuming
+// it's named after its path less the major version suffix.
+func defaultImportName(importPath string) string {
+	name := path.Base(importPath)
+	if len(name) > 1 && name[0] == 'v' && isDigits(name[1:]) {
+		name = path.Base(path.Dir(importPath))
+	}
+	return name
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedUpToDate(t *testing.T) {
+	dir := filepath.Join("..", "..")
+	destination := filepath.Join(dir, "openapi_operations.go")
+
+	code, err := Generate(dir, destination)
+	require.NoError(t, err)
+
+	generated, err := os.ReadFile(destination)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		string(code),
+		string(generated),
+		"the operation specs fell behind the handlers, run go generate",
+	)
+}
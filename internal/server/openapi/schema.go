@@ -0,0 +1,231 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// package of the nullable types, each one holding its value in the first
+// field along with a Valid field
+const nullPkgPath = "github.com/volatiletech/null/v8"
+
+// Generator builds the schemas of go types, registering the named structs
+// among the component schemas.
+type Generator struct {
+	schemas map[string]*Schema
+}
+
+func NewGenerator() *Generator {
+	return &Generator{schemas: make(map[string]*Schema)}
+}
+
+// Schemas returns the component schemas registered so far by name
+func (g *Generator) Schemas() map[string]*Schema {
+	return g.schemas
+}
+
+// Schema returns the schema of values of type t as encoded by encoding/json.
+// Named structs are referred to by their package qualified name.
+func (g *Generator) Schema(t reflect.Type) *Schema {
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.Kind() == reflect.Struct && t.PkgPath() == nullPkgPath:
+		s := g.Schema(t.Field(0).Type)
+		s.Nullable = true
+		return s
+	case t.Kind() == reflect.Pointer:
+		return g.Schema(t.Elem())
+	case t.Implements(textMarshalerType):
+		return &Schema{Type: "string", Enum: enumValues(t)}
+	case t.Implements(jsonMarshalerType):
+		// encoded by itself, e.g. raw json
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16,
+		reflect.Uint, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer"}
+	case reflect.Int32, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: g.Schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{
+			Type:                 "object",
+			AdditionalProperties: g.Schema(t.Elem()),
+		}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.object(t)
+		}
+		name := t.String()
+		if _, exists := g.schemas[name]; !exists {
+			// registered ahead so self references end up here
+			g.schemas[name] = &Schema{}
+			*g.schemas[name] = *g.object(t)
+		}
+		return Ref(name)
+	default:
+		// interfaces hold anything
+		return &Schema{}
+	}
+}
+
+// Parameters returns the path or query parameters bound to the fields of the
+// struct t by echo, in being either "path" or "query".
+func (g *Generator) Parameters(t reflect.Type, in string) []*Parameter {
+	tag := "query"
+	if in == "path" {
+		tag = "param"
+	}
+	var params []*Parameter
+	for _, f := range structFields(t, tag) {
+		param := &Parameter{
+			Name:   f.name,
+			In:     in,
+			Schema: g.Schema(f.typ),
+			// path parameters are always required
+			Required: in == "path",
+		}
+		if isValidatable(t) && !f.viaPointer {
+			param.Required = param.Required || isRequired(t, f)
+			constrain(param.Schema, t, f)
+		}
+		params = append(params, param)
+	}
+	return params
+}
+
+func (g *Generator) object(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for _, f := range structFields(t, "json") {
+		fieldSchema := g.Schema(f.typ)
+		if isValidatable(t) && !f.viaPointer {
+			if isRequired(t, f) {
+				s.Required = append(s.Required, f.name)
+			}
+			constrain(fieldSchema, t, f)
+		}
+		s.Properties[f.name] = fieldSchema
+	}
+	return s
+}
+
+type field struct {
+	// name the field is encoded or bound by
+	name string
+	// key of the field among validation errors
+	key   string
+	index []int
+	typ   reflect.Type
+	// reached through an embedded pointer
+	viaPointer bool
+}
+
+// structFields returns the fields of t named by tag, embedded structs
+// without a name are flattened as encoding/json and echo do.
+func structFields(t reflect.Type, tag string) []*field {
+	var fields []*field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" && !sf.Anonymous {
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get(tag), ",")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" && sf.Anonymous {
+			embedded := sf.Type
+			viaPointer := false
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+				viaPointer = true
+			}
+			if embedded.Kind() == reflect.Struct {
+				for _, f := range structFields(embedded, tag) {
+					f.index = append([]int{i}, f.index...)
+					f.viaPointer = f.viaPointer || viaPointer
+					fields = append(fields, f)
+				}
+				continue
+			}
+		}
+
+		if name == "" {
+			// only json falls back to the field name
+			if tag != "json" {
+				continue
+			}
+			name = sf.Name
+		}
+
+		// validation errors are keyed by json names as well
+		key, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if key == "" {
+			key = sf.Name
+		}
+
+		fields = append(fields, &field{
+			name:  name,
+			key:   key,
+			index: []int{i},
+			typ:   sf.Type,
+		})
+	}
+	return fields
+}
+
+// enumValues returns the text of the values of an integer enum t, counting
+// from zero as long as they round trip through their text.
+func enumValues(t reflect.Type) []string {
+	if !reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+	default:
+		return nil
+	}
+	var values []string
+	for i := int64(0); ; i++ {
+		v := reflect.New(t).Elem()
+		v.SetInt(i)
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return values
+		}
+		parsed := reflect.New(t)
+		err = parsed.Interface().(encoding.TextUnmarshaler).UnmarshalText(text)
+		if err != nil || parsed.Elem().Int() != i {
+			return values
+		}
+		values = append(values, string(text))
+	}
+}
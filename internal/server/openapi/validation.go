@@ -0,0 +1,170 @@
+package openapi
+
+import (
+	"math"
+	"reflect"
+	"strings"
+)
+
+// The limits of a field are found by validating values of the struct with
+// the field alone set to a probe value and reading the error it gets. Only
+// ozzo-validation v4 errors carry the codes and params needed to do so.
+
+type validatable interface {
+	Validate() error
+}
+
+type validationError interface {
+	error
+	Code() string
+	Params() map[string]any
+}
+
+var validatableType = reflect.TypeOf((*validatable)(nil)).Elem()
+
+const (
+	// long enough to exceed any length limit
+	probeLength = 1 << 20
+	// far enough to exceed any value limit while fitting any integer
+	probeMax = math.MaxInt32
+	probeMin = math.MinInt32
+)
+
+func isValidatable(t reflect.Type) bool {
+	return t.Implements(validatableType)
+}
+
+// isRequired reports whether the zero value of the field gets rejected
+func isRequired(t reflect.Type, f *field) bool {
+	return probe(t, f, nil) != nil
+}
+
+// constrain sets the limits the validation rules of t put on the field f to
+// its schema s.
+func constrain(s *Schema, t reflect.Type, f *field) {
+	if s.Ref != "" {
+		return
+	}
+
+	valueType := f.typ
+	set := func(v, x reflect.Value) { v.Set(x) }
+	if valueType.Kind() == reflect.Struct && valueType.PkgPath() == nullPkgPath {
+		valueType = valueType.Field(0).Type
+		set = func(v, x reflect.Value) {
+			v.Field(0).Set(x)
+			v.FieldByName("Valid").SetBool(true)
+		}
+	}
+	probeWith := func(x reflect.Value) error {
+		return probe(t, f, func(v reflect.Value) { set(v, x) })
+	}
+
+	switch valueType.Kind() {
+	case reflect.String:
+		x := reflect.New(valueType).Elem()
+		x.SetString(strings.Repeat("x", probeLength))
+		lengthLimits(probeWith(x), &s.MinLength, &s.MaxLength)
+
+	case reflect.Int, reflect.Int16, reflect.Int32, reflect.Int64:
+		x := reflect.New(valueType).Elem()
+		x.SetInt(probeMax)
+		s.Maximum = threshold(probeWith(x), "validation_max_less_equal_than_required")
+		x.SetInt(probeMin)
+		s.Minimum = threshold(probeWith(x), "validation_min_greater_equal_than_required")
+
+	case reflect.Slice:
+		x := reflect.MakeSlice(valueType, probeLength, probeLength)
+		lengthLimits(probeWith(x), &s.MinItems, &s.MaxItems)
+
+		// the limits on the items of integer slices
+		if s.Items == nil || s.Items.Ref != "" {
+			return
+		}
+		switch valueType.Elem().Kind() {
+		case reflect.Int, reflect.Int16, reflect.Int32, reflect.Int64:
+		default:
+			return
+		}
+		x = reflect.MakeSlice(valueType, 1, 1)
+		x.Index(0).SetInt(probeMax)
+		s.Items.Maximum = threshold(
+			errorOf(probeWith(x), "0"),
+			"validation_max_less_equal_than_required",
+		)
+		x.Index(0).SetInt(probeMin)
+		s.Items.Minimum = threshold(
+			errorOf(probeWith(x), "0"),
+			"validation_min_greater_equal_than_required",
+		)
+	}
+}
+
+// probe validates a value of t with its field f set by set and returns the
+// error of the field.
+func probe(t reflect.Type, f *field, set func(reflect.Value)) error {
+	v := reflect.New(t).Elem()
+	if set != nil {
+		set(v.FieldByIndex(f.index))
+	}
+	return errorOf(v.Interface().(validatable).Validate(), f.key)
+}
+
+// errorOf returns the error keyed by key among validation errors, they're
+// maps of error by field name or item index.
+func errorOf(err error, key string) error {
+	v := reflect.ValueOf(err)
+	if err == nil ||
+		v.Kind() != reflect.Map ||
+		v.Type().Key().Kind() != reflect.String {
+		return nil
+	}
+	e := v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key()))
+	if !e.IsValid() || e.IsNil() {
+		return nil
+	}
+	err, _ = e.Interface().(error)
+	return err
+}
+
+func lengthLimits(err error, min, max **int) {
+	ve, ok := err.(validationError)
+	if !ok {
+		return
+	}
+	switch ve.Code() {
+	case "validation_length_out_of_range":
+		*min = intParam(ve, "min")
+		*max = intParam(ve, "max")
+	case "validation_length_too_long":
+		*max = intParam(ve, "max")
+	}
+}
+
+func threshold(err error, code string) *float64 {
+	ve, ok := err.(validationError)
+	if !ok || ve.Code() != code {
+		return nil
+	}
+	v := reflect.ValueOf(ve.Params()["threshold"])
+	var f float64
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		f = float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		f = float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		f = v.Float()
+	default:
+		// e.g. dates
+		return nil
+	}
+	return &f
+}
+
+func intParam(ve validationError, name string) *int {
+	n, ok := ve.Params()[name].(int)
+	if !ok {
+		return nil
+	}
+	return &n
+}
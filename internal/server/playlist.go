@@ -0,0 +1,869 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/server/request"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/playlist/?page=1&per_page=100
+func (s *Server) HandlePlaylistsGetAll(c echo.Context) error {
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistsGetAll: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+
+	// fetch playlists
+	playlists, total, err := s.app.PlaylistsGetAllByUser(
+		c.Request().Context(),
+		payload.UserID,
+		offset,
+		perPage,
+	)
+	if err != nil {
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(page, perPage, playlists, total),
+	)
+}
+
+// POST /v1/authorized/playlist/
+func (s *Server) HandlePlaylistCreate(c echo.Context) error {
+	// bind & validate request
+	var req dto.PlaylistCreateRequest
+	err := (&echo.DefaultBinder{}).BindBody(c, &req)
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePlaylistCreate: request binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidRequest, err.Error()),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistCreate: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// create playlist
+	playlistID, err := s.app.PlaylistCreate(
+		c.Request().Context(),
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistCreate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(playlistID))
+}
+
+// GET /v1/authorized/playlist/:id/
+func (s *Server) HandlePlaylistGet(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePlaylistGet: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistGet: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// fetch playlist
+	playlist, err := s.app.PlaylistGet(
+		c.Request().Context(),
+		params.ID,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistGet: playlist not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(playlist))
+}
+
+// PATCH /v1/authorized/playlist/:id/
+func (s *Server) HandlePlaylistUpdate(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePlaylistUpdate: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// bind & validate request
+	var req dto.PlaylistUpdateRequest
+	err = (&echo.DefaultBinder{}).BindBody(c, &req)
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePlaylistUpdate: request binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidRequest, err.Error()),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistUpdate: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// update playlist
+	err = s.app.PlaylistUpdate(
+		c.Request().Context(),
+		params.ID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		switch err {
+		case app.ErrNotFound:
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistUpdate: playlist not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		case app.ErrForbidden:
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistUpdate: playlist owned by another user",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusForbidden,
+				response.Error(response.StatusForbidden),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistUpdate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// DELETE /v1/authorized/playlist/:id/
+func (s *Server) HandlePlaylistDelete(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePlaylistDelete: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistDelete: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// delete playlist
+	err = s.app.PlaylistDelete(
+		c.Request().Context(),
+		params.ID,
+		payload.UserID,
+	)
+	if err != nil {
+		switch err {
+		case app.ErrNotFound:
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistDelete: playlist not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		case app.ErrForbidden:
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistDelete: playlist owned by another user",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusForbidden,
+				response.Error(response.StatusForbidden),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistDelete: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// GET /v1/authorized/playlist/:id/film/?page=1&per_page=100
+func (s *Server) HandlePlaylistFilmsGetAll(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePlaylistFilmsGetAll: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistFilmsGetAll: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+
+	// fetch playlist films
+	films, total, err := s.app.PlaylistFilmsGetAll(
+		c.Request().Context(),
+		params.ID,
+		payload.UserID,
+		offset,
+		perPage,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistFilmsGetAll: playlist not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistFilmsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(page, perPage, films, total),
+	)
+}
+
+// PUT /v1/authorized/playlist/:id/film/
+func (s *Server) HandlePlaylistFilmsReorder(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePlaylistFilmsReorder: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// bind & validate request
+	var req dto.PlaylistFilmsReorderRequest
+	err = (&echo.DefaultBinder{}).BindBody(c, &req)
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePlaylistFilmsReorder: request binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidRequest, err.Error()),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistFilmsReorder: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// reorder playlist films
+	err = s.app.PlaylistFilmsReorder(
+		c.Request().Context(),
+		params.ID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		switch err {
+		case app.ErrNotFound:
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistFilmsReorder: playlist not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		case app.ErrForbidden:
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistFilmsReorder: playlist owned by another user",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusForbidden,
+				response.Error(response.StatusForbidden),
+			)
+		case app.ErrPlaylistOrderMismatch:
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistFilmsReorder: film ids mismatch playlist films",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusInvalidRequest, err.Error()),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistFilmsReorder: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// PUT /v1/authorized/playlist/:id/film/:film_id/
+func (s *Server) HandlePlaylistFilmAdd(c echo.Context) error {
+	// bind & validate params
+	var params request.PlaylistFilmIDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePlaylistFilmAdd: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistFilmAdd: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// add film to playlist
+	err = s.app.PlaylistFilmAdd(
+		c.Request().Context(),
+		params.ID,
+		params.FilmID,
+		payload.UserID,
+	)
+	if err != nil {
+		switch err {
+		case app.ErrNotFound:
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistFilmAdd: playlist or film not found",
+				zap.Int("id", params.ID),
+				zap.Int("film id", params.FilmID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		case app.ErrForbidden:
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistFilmAdd: playlist owned by another user",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusForbidden,
+				response.Error(response.StatusForbidden),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistFilmAdd: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// DELETE /v1/authorized/playlist/:id/film/:film_id/
+func (s *Server) HandlePlaylistFilmRemove(c echo.Context) error {
+	// bind & validate params
+	var params request.PlaylistFilmIDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePlaylistFilmRemove: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistFilmRemove: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// remove film from playlist
+	err = s.app.PlaylistFilmRemove(
+		c.Request().Context(),
+		params.ID,
+		params.FilmID,
+		payload.UserID,
+	)
+	if err != nil {
+		switch err {
+		case app.ErrNotFound:
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistFilmRemove: playlist or film not found",
+				zap.Int("id", params.ID),
+				zap.Int("film id", params.FilmID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		case app.ErrForbidden:
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistFilmRemove: playlist owned by another user",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusForbidden,
+				response.Error(response.StatusForbidden),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistFilmRemove: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// GET /v1/authorized/playlist/:id/cover/
+func (s *Server) HandlePlaylistCover(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePlaylistCover: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistCover: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// generate cover
+	cover, err := s.app.PlaylistCover(
+		c.Request().Context(),
+		params.ID,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistCover: playlist or cover images not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistCover: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.Blob(http.StatusOK, "image/jpeg", cover)
+}
+
+// POST /v1/authorized/playlist/:id/watchlist/
+func (s *Server) HandlePlaylistAddToWatchlist(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePlaylistAddToWatchlist: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistAddToWatchlist: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// add playlist films to watchlist
+	added, err := s.app.PlaylistAddToWatchlist(
+		c.Request().Context(),
+		params.ID,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandlePlaylistAddToWatchlist: playlist not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePlaylistAddToWatchlist: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(added))
+}
+
+// GET /v1/authorized/watchlist/?page=1&per_page=100
+func (s *Server) HandleWatchlistGetAll(c echo.Context) error {
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleWatchlistGetAll: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+
+	// fetch watchlist
+	watchlist, total, err := s.app.WatchlistGetAll(
+		c.Request().Context(),
+		payload.UserID,
+		offset,
+		perPage,
+	)
+	if err != nil {
+		s.loggerOf(c).Error(
+			"server.HandleWatchlistGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(page, perPage, watchlist, total),
+	)
+}
+
+// PUT /v1/authorized/watchlist/:id/watched/
+func (s *Server) HandleWatchlistMarkWatched(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleWatchlistMarkWatched: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleWatchlistMarkWatched: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// mark film watched
+	err = s.app.WatchlistMarkWatched(
+		c.Request().Context(),
+		payload.UserID,
+		params.ID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleWatchlistMarkWatched: film not on watchlist",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleWatchlistMarkWatched: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
@@ -0,0 +1,399 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestHandlePlaylistCreate(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(
+		OptEnableDefaultUser,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/playlist/"
+	method := http.MethodPost
+
+	// invalid request
+	e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PlaylistCreateRequest{}).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		ValueEqual("status", response.StatusInvalidRequest.String())
+
+	// create playlist
+	req := &dto.PlaylistCreateRequest{
+		Title:        "playlist",
+		Descriptions: null.StringFrom("descriptions"),
+	}
+	playlistID := int(e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("payload").
+		Number().
+		Raw())
+
+	gotPlaylist, err := appInstance.PlaylistGet(
+		ctx,
+		playlistID,
+		defaults.user.id,
+	)
+	require.NoError(err)
+	require.Equal(req.Title, gotPlaylist.Title)
+	require.Equal(req.Descriptions, gotPlaylist.Descriptions)
+	require.False(gotPlaylist.IsPublic)
+
+	// list playlists
+	e.Request(http.MethodGet, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.Paginated(config.Config.Pagination.Page.MinValue, config.Config.Pagination.PageSize.DefaultValue, []*models.Playlist{gotPlaylist}, 1))
+}
+
+func TestHandlePlaylistGet(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(
+		OptEnableDefaultUser,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/playlist/{id}/"
+	method := http.MethodGet
+
+	// another user's playlist
+	otherUserID, err := appInstance.UserCreate(
+		ctx,
+		&dto.UserCreateRequest{Email: "other@prog.net", Password: "pa$$W0RD1"},
+	)
+	require.NoError(err)
+	playlistID, err := appInstance.PlaylistCreate(
+		ctx,
+		otherUserID,
+		&dto.PlaylistCreateRequest{Title: "playlist"},
+	)
+	require.NoError(err)
+
+	// invalid id
+	e.Request(method, path).
+		WithPath("id", -1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	// private playlists are hidden from other users
+	e.Request(method, path).
+		WithPath("id", playlistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	err = appInstance.PlaylistUpdate(
+		ctx,
+		playlistID,
+		otherUserID,
+		&dto.PlaylistUpdateRequest{IsPublic: null.BoolFrom(true)},
+	)
+	require.NoError(err)
+
+	gotPlaylist, err := appInstance.PlaylistGet(ctx, playlistID, otherUserID)
+	require.NoError(err)
+
+	// public playlists are visible
+	e.Request(method, path).
+		WithPath("id", playlistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(gotPlaylist))
+
+	// but only the owner modifies them
+	e.Request(http.MethodPatch, path).
+		WithPath("id", playlistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PlaylistUpdateRequest{Title: null.StringFrom("mine")}).
+		Expect().
+		Status(http.StatusForbidden).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusForbidden))
+
+	e.Request(http.MethodDelete, path).
+		WithPath("id", playlistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusForbidden).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusForbidden))
+}
+
+func TestHandlePlaylistFilms(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(
+		OptEnableDefaultUser,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/playlist/{id}/film/"
+	filmPath := "/v1/authorized/playlist/{id}/film/{film_id}/"
+
+	playlistID, err := appInstance.PlaylistCreate(
+		ctx,
+		defaults.user.id,
+		&dto.PlaylistCreateRequest{Title: "playlist"},
+	)
+	require.NoError(err)
+
+	// film not found
+	e.Request(http.MethodPut, filmPath).
+		WithPath("id", playlistID).
+		WithPath("film_id", 999).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	// add movies and an episode
+	filmIDs := make([]int, 0, 3)
+	for i := 0; i < 2; i++ {
+		movieID, err := appInstance.MovieCreate(
+			ctx,
+			defaults.user.id,
+			&dto.MovieCreateRequest{
+				Title:        "movie",
+				DateReleased: testutils.Date(2000, 1, 1),
+			},
+		)
+		require.NoError(err)
+		filmIDs = append(filmIDs, movieID)
+	}
+	seriesID, err := appInstance.SeriesCreate(
+		ctx,
+		defaults.user.id,
+		&dto.SeriesCreateRequest{Title: "series"},
+	)
+	require.NoError(err)
+	_, err = appInstance.EpisodePut(
+		ctx,
+		seriesID, 1, 1,
+		defaults.user.id,
+		&dto.EpisodePutRequest{
+			Title:        "episode",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+	episode, err := appInstance.EpisodeGet(ctx, seriesID, 1, 1, true)
+	require.NoError(err)
+	filmIDs = append(filmIDs, episode.ID)
+
+	for _, filmID := range filmIDs {
+		e.Request(http.MethodPut, filmPath).
+			WithPath("id", playlistID).
+			WithPath("film_id", filmID).
+			WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Equal(response.OK(nil))
+	}
+
+	// reorder mismatch
+	e.Request(http.MethodPut, path).
+		WithPath("id", playlistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PlaylistFilmsReorderRequest{FilmIDs: filmIDs[:2]}).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		ValueEqual("status", response.StatusInvalidRequest.String())
+
+	// reorder
+	reordered := []int{filmIDs[2], filmIDs[0], filmIDs[1]}
+	e.Request(http.MethodPut, path).
+		WithPath("id", playlistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PlaylistFilmsReorderRequest{FilmIDs: reordered}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	films, total, err := appInstance.PlaylistFilmsGetAll(
+		ctx,
+		playlistID,
+		defaults.user.id,
+		0,
+		config.Config.Pagination.PageSize.MaxValue,
+	)
+	require.NoError(err)
+	require.Equal(len(reordered), total)
+	for i, film := range films {
+		require.Equal(reordered[i], film.ID)
+	}
+
+	e.Request(http.MethodGet, path).
+		WithPath("id", playlistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.Paginated(config.Config.Pagination.Page.MinValue, config.Config.Pagination.PageSize.DefaultValue, films, total))
+
+	// remove a film
+	e.Request(http.MethodDelete, filmPath).
+		WithPath("id", playlistID).
+		WithPath("film_id", filmIDs[0]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	// add the playlist to the watchlist
+	e.Request(http.MethodPost, "/v1/authorized/playlist/{id}/watchlist/").
+		WithPath("id", playlistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(2))
+
+	e.Request(http.MethodGet, "/v1/authorized/watchlist/").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 2)
+}
+
+func TestHandlePlaylistCover(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(
+		OptEnableDefaultUser,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/playlist/{id}/cover/"
+	method := http.MethodGet
+
+	playlistID, err := appInstance.PlaylistCreate(
+		ctx,
+		defaults.user.id,
+		&dto.PlaylistCreateRequest{Title: "playlist"},
+	)
+	require.NoError(err)
+
+	// no member images
+	e.Request(method, path).
+		WithPath("id", playlistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	var content bytes.Buffer
+	err = png.Encode(&content, image.NewRGBA(image.Rect(0, 0, 64, 64)))
+	require.NoError(err)
+
+	for i := 0; i < 4; i++ {
+		movieID, err := appInstance.MovieCreate(
+			ctx,
+			defaults.user.id,
+			&dto.MovieCreateRequest{
+				Title:        "movie",
+				DateReleased: testutils.Date(2000, 1, 1),
+			},
+		)
+		require.NoError(err)
+		_, err = appInstance.FilmMediaUpload(
+			ctx,
+			movieID,
+			defaults.user.id,
+			bytes.NewReader(content.Bytes()),
+		)
+		require.NoError(err)
+		err = appInstance.PlaylistFilmAdd(
+			ctx,
+			playlistID,
+			movieID,
+			defaults.user.id,
+		)
+		require.NoError(err)
+	}
+
+	cover := e.Request(method, path).
+		WithPath("id", playlistID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		ContentType("image/jpeg").
+		Body().
+		Raw()
+
+	coverConfig, err := jpeg.DecodeConfig(bytes.NewReader([]byte(cover)))
+	require.NoError(err)
+	require.Equal(config.Config.Validation.Media.Cover.Size, coverConfig.Width)
+	require.Equal(config.Config.Validation.Media.Cover.Size, coverConfig.Height)
+}
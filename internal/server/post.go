@@ -0,0 +1,506 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/server/request"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// POST /v1/authorized/post/
+func (s *Server) HandlePostCreate(c echo.Context) error {
+	// bind & validate request
+	var req dto.PostCreateRequest
+	err := (&echo.DefaultBinder{}).BindBody(c, &req)
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePostCreate: request binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidRequest, err.Error()),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandlePostCreate: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// create post
+	postID, err := s.app.PostCreate(
+		c.Request().Context(),
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandlePostCreate: replied post or referenced record not found",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePostCreate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(postID))
+}
+
+// GET /v1/authorized/post/:id/
+func (s *Server) HandlePostGet(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePostGet: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// fetch post
+	post, err := s.app.PostGet(c.Request().Context(), params.ID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandlePostGet: post not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePostGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(post))
+}
+
+// PATCH /v1/authorized/post/:id/
+func (s *Server) HandlePostUpdate(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePostUpdate: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// bind & validate request
+	var req dto.PostUpdateRequest
+	err = (&echo.DefaultBinder{}).BindBody(c, &req)
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePostUpdate: request binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidRequest, err.Error()),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandlePostUpdate: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// update post
+	err = s.app.PostUpdate(
+		c.Request().Context(),
+		params.ID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		switch err {
+		case app.ErrNotFound:
+			s.loggerOf(c).Info(
+				"server.HandlePostUpdate: post not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		case app.ErrForbidden:
+			s.loggerOf(c).Info(
+				"server.HandlePostUpdate: post owned by another user",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusForbidden,
+				response.Error(response.StatusForbidden),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePostUpdate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// DELETE /v1/authorized/post/:id/
+func (s *Server) HandlePostDelete(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePostDelete: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandlePostDelete: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// delete post
+	err = s.app.PostDelete(
+		c.Request().Context(),
+		params.ID,
+		payload.UserID,
+	)
+	if err != nil {
+		switch err {
+		case app.ErrNotFound:
+			s.loggerOf(c).Info(
+				"server.HandlePostDelete: post not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		case app.ErrForbidden:
+			s.loggerOf(c).Info(
+				"server.HandlePostDelete: post owned by another user",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusForbidden,
+				response.Error(response.StatusForbidden),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePostDelete: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// GET /v1/authorized/post/:id/reply/?page=1&per_page=100
+func (s *Server) HandlePostRepliesGetAll(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePostRepliesGetAll: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+
+	// fetch replies of post
+	replies, total, err := s.app.PostRepliesGetAll(
+		c.Request().Context(),
+		params.ID,
+		offset,
+		perPage,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandlePostRepliesGetAll: post not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePostRepliesGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(page, perPage, replies, total),
+	)
+}
+
+// GET /v1/authorized/post/:id/revision/?page=1&per_page=100
+func (s *Server) HandlePostRevisionsGetAll(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandlePostRevisionsGetAll: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+
+	// fetch revisions of post
+	revisions, total, err := s.app.PostRevisionsGetAll(
+		c.Request().Context(),
+		params.ID,
+		offset,
+		perPage,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandlePostRevisionsGetAll: post not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandlePostRevisionsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(page, perPage, revisions, total),
+	)
+}
+
+// GET /v1/authorized/movie/:id/post/?page=1&per_page=100
+func (s *Server) HandleMoviePostsGetAll(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleMoviePostsGetAll: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+
+	// fetch posts of movie
+	posts, total, err := s.app.PostsGetAllByMovie(
+		c.Request().Context(),
+		params.ID,
+		offset,
+		perPage,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleMoviePostsGetAll: movie not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleMoviePostsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(page, perPage, posts, total),
+	)
+}
+
+// GET /v1/authorized/series/:id/post/?page=1&per_page=100
+func (s *Server) HandleSeriesPostsGetAll(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeriesPostsGetAll: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+
+	// fetch posts of series
+	posts, total, err := s.app.PostsGetAllBySeries(
+		c.Request().Context(),
+		params.ID,
+		offset,
+		perPage,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleSeriesPostsGetAll: series not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleSeriesPostsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(page, perPage, posts, total),
+	)
+}
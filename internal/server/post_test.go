@@ -0,0 +1,227 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestHandlePost(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(
+		OptEnableDefaultUser | OptEnableDefaultSeries,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+	artistID, err := appInstance.ArtistCreate(
+		ctx,
+		defaults.user.id,
+		&dto.ArtistCreateRequest{FirstName: "artist"},
+	)
+	require.NoError(err)
+
+	// invalid request
+	e.POST("/v1/authorized/post/").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PostCreateRequest{}).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		ValueEqual("status", response.StatusInvalidRequest.String())
+
+	// referenced movie not found
+	e.POST("/v1/authorized/post/").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PostCreateRequest{Body: "body", FilmIDs: []int{999}}).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	// create a post referencing the movie, the series and the artist
+	postID := int(e.POST("/v1/authorized/post/").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PostCreateRequest{
+			Body:      "# review\n\n<b>great</b> movie",
+			FilmIDs:   []int{movieID},
+			SeriesIDs: []int{defaults.series.id},
+			ArtistIDs: []int{artistID},
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("payload").
+		Number().
+		Raw())
+
+	// the body is rendered to sanitized html
+	post := e.GET("/v1/authorized/post/{id}/").
+		WithPath("id", postID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("payload").
+		Object()
+	post.ValueEqual("body_html", "<h1>review</h1>\n<p><!-- raw HTML omitted -->great<!-- raw HTML omitted --> movie</p>\n")
+	post.ValueEqual("film_ids", []int{movieID})
+	post.ValueEqual("series_ids", []int{defaults.series.id})
+	post.ValueEqual("artist_ids", []int{artistID})
+
+	// list the movie and the series posts
+	for _, path := range []string{
+		"/v1/authorized/movie/{id}/post/",
+		"/v1/authorized/series/{id}/post/",
+	} {
+		id := movieID
+		if path == "/v1/authorized/series/{id}/post/" {
+			id = defaults.series.id
+		}
+		posts := e.GET(path).
+			WithPath("id", id).
+			WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+		posts.ValueEqual("total_items", 1)
+		posts.Value("payload").Array().First().Object().ValueEqual("id", postID)
+	}
+
+	// reply to the post
+	replyID := int(e.POST("/v1/authorized/post/").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PostCreateRequest{
+			Body:   "reply",
+			PostID: null.IntFrom(postID),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("payload").
+		Number().
+		Raw())
+
+	replies := e.GET("/v1/authorized/post/{id}/reply/").
+		WithPath("id", postID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	replies.ValueEqual("total_items", 1)
+	replies.Value("payload").Array().First().Object().ValueEqual("id", replyID)
+
+	// another user can't edit the post
+	otherID, err := appInstance.UserCreate(
+		ctx,
+		&dto.UserCreateRequest{Email: "other@prog.net", Password: "pa$$W0RD1"},
+	)
+	require.NoError(err)
+	err = appInstance.PostUpdate(
+		ctx,
+		postID,
+		otherID,
+		&dto.PostUpdateRequest{Body: "edited"},
+	)
+	require.Equal(app.ErrForbidden, err)
+
+	// edit the post
+	e.PATCH("/v1/authorized/post/{id}/").
+		WithPath("id", postID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PostUpdateRequest{Body: "edited"}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	revisions := e.GET("/v1/authorized/post/{id}/revision/").
+		WithPath("id", postID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	revisions.ValueEqual("total_items", 1)
+	revisions.Value("payload").Array().First().Object().ValueEqual("body", "# review\n\n<b>great</b> movie")
+
+	// delete the post
+	e.DELETE("/v1/authorized/post/{id}/").
+		WithPath("id", postID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	// the post is kept as a tombstone along with its replies
+	deleted := e.GET("/v1/authorized/post/{id}/").
+		WithPath("id", postID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("payload").
+		Object()
+	deleted.ValueEqual("body", "")
+	deleted.ValueEqual("body_html", "")
+	deleted.Value("deleted_at").String().NotEmpty()
+
+	e.GET("/v1/authorized/post/{id}/").
+		WithPath("id", replyID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK)
+
+	// a deleted post can't be edited or deleted again
+	e.PATCH("/v1/authorized/post/{id}/").
+		WithPath("id", postID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PostUpdateRequest{Body: "edited"}).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	e.DELETE("/v1/authorized/post/{id}/").
+		WithPath("id", postID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+}
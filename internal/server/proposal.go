@@ -0,0 +1,319 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/server/request"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/proposal/?status=
+func (s *Server) HandleProposalsGetAll(c echo.Context) error {
+	// bind & validate query
+	var query request.ProposalsQueryParam
+	err := (&echo.DefaultBinder{}).BindQueryParams(c, &query)
+	if err == nil {
+		err = query.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleProposalsGetAll: query binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+	if query.Status == "" {
+		query.Status = repo.ProposalStatusPending
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleProposalsGetAll: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+
+	// fetch proposals
+	proposals, total, err := s.app.ProposalsGetAll(
+		c.Request().Context(),
+		payload.UserID,
+		query.Status,
+		offset,
+		perPage,
+	)
+	if err != nil {
+		if err == app.ErrForbidden {
+			s.loggerOf(c).Info(
+				"server.HandleProposalsGetAll: user is not a moderator",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(
+				http.StatusForbidden,
+				response.Error(response.StatusForbidden),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleProposalsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(page, perPage, proposals, total),
+	)
+}
+
+// GET /v1/authorized/proposal/:id/
+func (s *Server) HandleProposalGet(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleProposalGet: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleProposalGet: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// fetch proposal
+	proposal, err := s.app.ProposalGet(
+		c.Request().Context(),
+		params.ID,
+		payload.UserID,
+	)
+	if err != nil {
+		switch err {
+		case app.ErrNotFound:
+			s.loggerOf(c).Info(
+				"server.HandleProposalGet: proposal not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		case app.ErrForbidden:
+			s.loggerOf(c).Info(
+				"server.HandleProposalGet: proposal of another user",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(
+				http.StatusForbidden,
+				response.Error(response.StatusForbidden),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleProposalGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(proposal))
+}
+
+// POST /v1/authorized/proposal/:id/approve/
+func (s *Server) HandleProposalApprove(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleProposalApprove: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleProposalApprove: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// approve proposal
+	err = s.app.ProposalApprove(c.Request().Context(), params.ID, payload.UserID)
+	if err != nil {
+		switch err {
+		case app.ErrNotFound:
+			s.loggerOf(c).Info(
+				"server.HandleProposalApprove: proposal not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		case app.ErrForbidden:
+			s.loggerOf(c).Info(
+				"server.HandleProposalApprove: user is not a moderator",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(
+				http.StatusForbidden,
+				response.Error(response.StatusForbidden),
+			)
+		case app.ErrProposalReviewed:
+			s.loggerOf(c).Info(
+				"server.HandleProposalApprove: proposal already reviewed",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusProposalReviewed),
+			)
+		case app.ErrProposalOutdated:
+			s.loggerOf(c).Info(
+				"server.HandleProposalApprove: proposed on record changed since",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusConflict,
+				response.Error(response.StatusProposalOutdated),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleProposalApprove: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// POST /v1/authorized/proposal/:id/reject/
+func (s *Server) HandleProposalReject(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleProposalReject: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleProposalReject: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// reject proposal
+	err = s.app.ProposalReject(c.Request().Context(), params.ID, payload.UserID)
+	if err != nil {
+		switch err {
+		case app.ErrNotFound:
+			s.loggerOf(c).Info(
+				"server.HandleProposalReject: proposal not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		case app.ErrForbidden:
+			s.loggerOf(c).Info(
+				"server.HandleProposalReject: user is not a moderator",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(
+				http.StatusForbidden,
+				response.Error(response.StatusForbidden),
+			)
+		case app.ErrProposalReviewed:
+			s.loggerOf(c).Info(
+				"server.HandleProposalReject: proposal already reviewed",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusProposalReviewed),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleProposalReject: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
@@ -0,0 +1,217 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestHandleProposal(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(OptEnableDefaultUser)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+
+	// create a moderator
+	moderatorReq := &dto.UserCreateRequest{
+		Email:    "moderator@prog.net",
+		Password: "pa$$W0RD1",
+	}
+	moderatorID, err := appInstance.UserCreate(ctx, moderatorReq)
+	require.NoError(err)
+	moderatorAuth, _, err := appInstance.UserLogin(
+		ctx,
+		&dto.UserLoginRequest{
+			Email:    moderatorReq.Email,
+			Password: moderatorReq.Password,
+		},
+	)
+	require.NoError(err)
+	moderatorAuth = "Bearer " + moderatorAuth
+
+	// the movie is created before turning moderation on
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	moderation := config.Config.Moderation
+	config.Config.Moderation.TrustThreshold = 10
+	config.Config.Moderation.Moderators = []int{moderatorID}
+	t.Cleanup(func() { config.Config.Moderation = moderation })
+
+	// the default user edit is queued
+	proposal := e.PATCH("/v1/authorized/movie/{id}/").
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.MovieUpdateRequest{Title: null.StringFrom("new title")}).
+		Expect().
+		Status(http.StatusAccepted).
+		JSON().
+		Object().
+		Value("payload").
+		Object()
+	proposal.Value("kind").Equal(repo.ProposalKindMovieUpdate)
+	proposal.Value("status").Equal(repo.ProposalStatusPending)
+	proposalID := int(proposal.Value("id").Number().Raw())
+
+	movie, err := appInstance.MovieGet(ctx, movieID, false)
+	require.NoError(err)
+	require.Equal("movie", movie.Title)
+
+	// only moderators list the queue
+	e.GET("/v1/authorized/proposal/").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusForbidden).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusForbidden))
+
+	e.GET("/v1/authorized/proposal/").
+		WithQuery("status", "unknown").
+		WithHeader(echo.HeaderAuthorization, moderatorAuth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	expProposals, total, err := appInstance.ProposalsGetAll(
+		ctx,
+		moderatorID,
+		repo.ProposalStatusPending,
+		0,
+		config.Config.Pagination.PageSize.DefaultValue,
+	)
+	require.NoError(err)
+	require.Equal(1, total)
+	require.Equal(proposalID, expProposals[0].ID)
+
+	e.GET("/v1/authorized/proposal/").
+		WithHeader(echo.HeaderAuthorization, moderatorAuth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(
+			response.Paginated(
+				config.Config.Pagination.Page.MinValue,
+				config.Config.Pagination.PageSize.DefaultValue,
+				expProposals,
+				total,
+			),
+		)
+
+	// the proposer follows their proposal
+	e.GET("/v1/authorized/proposal/{id}/").
+		WithPath("id", proposalID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(expProposals[0]))
+
+	// the proposer can't approve it
+	e.POST("/v1/authorized/proposal/{id}/approve/").
+		WithPath("id", proposalID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusForbidden).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusForbidden))
+
+	e.POST("/v1/authorized/proposal/{id}/approve/").
+		WithPath("id", proposalID+1).
+		WithHeader(echo.HeaderAuthorization, moderatorAuth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	e.POST("/v1/authorized/proposal/{id}/approve/").
+		WithPath("id", proposalID).
+		WithHeader(echo.HeaderAuthorization, moderatorAuth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	movie, err = appInstance.MovieGet(ctx, movieID, false)
+	require.NoError(err)
+	require.Equal("new title", movie.Title)
+	require.Equal(defaults.user.id, movie.ContributedBy)
+
+	// reviewed once only
+	e.POST("/v1/authorized/proposal/{id}/reject/").
+		WithPath("id", proposalID).
+		WithHeader(echo.HeaderAuthorization, moderatorAuth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusProposalReviewed))
+
+	// a proposal made against a movie changed since is not applied
+	proposalID = int(e.PATCH("/v1/authorized/movie/{id}/").
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.MovieUpdateRequest{Title: null.StringFrom("newer title")}).
+		Expect().
+		Status(http.StatusAccepted).
+		JSON().
+		Object().
+		Value("payload").
+		Object().
+		Value("id").
+		Number().
+		Raw())
+
+	_, err = appInstance.MovieUpdate(
+		ctx,
+		movieID,
+		moderatorID,
+		&dto.MovieUpdateRequest{Title: null.StringFrom("moderated title")},
+	)
+	require.NoError(err)
+
+	e.POST("/v1/authorized/proposal/{id}/approve/").
+		WithPath("id", proposalID).
+		WithHeader(echo.HeaderAuthorization, moderatorAuth).
+		Expect().
+		Status(http.StatusConflict).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusProposalOutdated))
+
+	movie, err = appInstance.MovieGet(ctx, movieID, false)
+	require.NoError(err)
+	require.Equal("moderated title", movie.Title)
+
+	outdated, err := appInstance.ProposalGet(ctx, proposalID, moderatorID)
+	require.NoError(err)
+	require.Equal(repo.ProposalStatusPending, outdated.Status)
+}
@@ -1,8 +1,10 @@
 package server
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/aria3ppp/watch-server/internal/config"
 )
@@ -31,6 +33,60 @@ func FetchPaginationQueryParams(req *http.Request) (page, perPage, offset int) {
 	return page, perPage, (page - 1) * perPage
 }
 
+// FetchCursorPaginationQueryParams is the cursor-based counterpart of
+// FetchPaginationQueryParams, an empty cursor refers to the first page.
+func FetchCursorPaginationQueryParams(
+	req *http.Request,
+) (cursor string, perPage int) {
+	_, perPage, _ = FetchPaginationQueryParams(req)
+	return req.URL.Query().Get(config.Config.Pagination.Cursor.VarName), perPage
+}
+
+// CursorPaginationRequested reports whether the cursor query param is given,
+// even empty, asking a list that's page-paginated by default for its
+// cursor-paginated pages instead.
+func CursorPaginationRequested(req *http.Request) bool {
+	return req.URL.Query().Has(config.Config.Pagination.Cursor.VarName)
+}
+
+// FetchFieldsQueryParam returns the json fields the response payload is
+// narrowed down to, nil for all of them.
+func FetchFieldsQueryParam(req *http.Request) []string {
+	return splitList(req.URL.Query().Get(config.Config.Shaping.Fields.VarName))
+}
+
+// FetchIncludeQueryParam returns the relations asked to be embedded in the
+// response payload, out of the ones a handler supports.
+func FetchIncludeQueryParam(
+	req *http.Request,
+	relations ...string,
+) (map[string]bool, error) {
+	supported := make(map[string]bool, len(relations))
+	for _, relation := range relations {
+		supported[relation] = true
+	}
+	included := make(map[string]bool)
+	param := req.URL.Query().Get(config.Config.Shaping.Include.VarName)
+	for _, relation := range splitList(param) {
+		if !supported[relation] {
+			return nil, fmt.Errorf("server: %q can't be included", relation)
+		}
+		included[relation] = true
+	}
+	return included, nil
+}
+
+// splitList splits a comma separated list, leaving out the empty items
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func parseIntDefault(s string, defaultValue int) int {
 	if s == "" {
 		return defaultValue
@@ -40,3 +96,28 @@ func parseIntDefault(s string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+// FetchIncludeInvalidatedQueryParam reports whether invalidated records are
+// requested along with the valid ones.
+func FetchIncludeInvalidatedQueryParam(req *http.Request) bool {
+	return parseBoolDefault(
+		req.URL.Query().Get(config.Config.Invalidation.Include.VarName),
+		false,
+	)
+}
+
+// FetchCascadeQueryParam reports whether an operation should cascade to the
+// related records.
+func FetchCascadeQueryParam(req *http.Request) bool {
+	return parseBoolDefault(
+		req.URL.Query().Get(config.Config.Invalidation.Cascade.VarName),
+		false,
+	)
+}
+
+func parseBoolDefault(s string, defaultValue bool) bool {
+	if b, err := strconv.ParseBool(s); err == nil {
+		return b
+	}
+	return defaultValue
+}
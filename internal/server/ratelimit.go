@@ -0,0 +1,109 @@
+package server
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/ratelimit"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	HeaderXRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderXRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderXRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// the rate limited route groups, named after their config
+const (
+	rateLimitRead       = "read"
+	rateLimitWrite      = "write"
+	rateLimitLogin      = "login"
+	rateLimitUserCreate = "user_create"
+)
+
+// RateLimitMiddleware throttles the requests of the route group by the limit
+// configured for it, by user once authorized and by client ip until then. The
+// read group counts the requests not safe by method against the write group.
+func (s *Server) RateLimitMiddleware(group string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			name := group
+			if name == rateLimitRead && !isSafeMethod(req.Method) {
+				name = rateLimitWrite
+			}
+			limit := rateLimitOf(name)
+			if limit.Burst == 0 {
+				return next(c)
+			}
+
+			key := name + ":ip:" + c.RealIP()
+			if payload := FetchUserPayload(c); payload != nil {
+				key = name + ":user:" + strconv.Itoa(payload.UserID)
+			}
+
+			result, err := s.rateLimiter.Take(req.Context(), key, limit)
+			if err != nil {
+				// an unavailable store lets the requests through rather than
+				// failing them all
+				s.loggerOf(c).Error(
+					"server.RateLimitMiddleware: internal server error",
+					zap.Error(err),
+				)
+				return next(c)
+			}
+
+			header := c.Response().Header()
+			header.Set(HeaderXRateLimitLimit, strconv.Itoa(limit.Burst))
+			header.Set(HeaderXRateLimitRemaining, strconv.Itoa(result.Remaining))
+			header.Set(HeaderXRateLimitReset, secondsOf(result.ResetAfter))
+
+			if !result.Allowed {
+				header.Set(echo.HeaderRetryAfter, secondsOf(result.RetryAfter))
+				s.loggerOf(c).Info(
+					"server.RateLimitMiddleware: too many requests",
+					zap.String("key", key),
+				)
+				return echo.NewHTTPError(
+					http.StatusTooManyRequests,
+					response.Error(response.StatusTooManyRequests),
+				)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// rateLimitOf returns the limit configured for the route group
+func rateLimitOf(group string) ratelimit.Limit {
+	switch group {
+	case rateLimitWrite:
+		return ratelimit.Limit(config.Config.RateLimit.Write)
+	case rateLimitLogin:
+		return ratelimit.Limit(config.Config.RateLimit.Login)
+	case rateLimitUserCreate:
+		return ratelimit.Limit(config.Config.RateLimit.UserCreate)
+	default:
+		return ratelimit.Limit(config.Config.RateLimit.Read)
+	}
+}
+
+// isSafeMethod tells whether requests made by method are read-only
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet ||
+		method == http.MethodHead ||
+		method == http.MethodOptions
+}
+
+// secondsOf returns d in whole seconds rounded up, as the headers take them
+func secondsOf(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
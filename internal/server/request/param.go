@@ -1,7 +1,11 @@
 package request
 
 import (
+	"regexp"
+	"time"
+
 	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/repo"
 	validation "github.com/go-ozzo/ozzo-validation"
 )
 
@@ -78,3 +82,215 @@ func (p SeriesSeasonEpisodeNumberPathParam) Validate() error {
 		),
 	)
 }
+
+type FilmMediaIDPathParam struct {
+	FilmID  int `param:"id"`
+	MediaID int `param:"media_id"`
+}
+
+var _ validation.Validatable = FilmMediaIDPathParam{}
+
+func (p FilmMediaIDPathParam) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.FilmID,
+			validation.Required,
+			validation.Min(1),
+		),
+		validation.Field(
+			&p.MediaID,
+			validation.Required,
+			validation.Min(1),
+		),
+	)
+}
+
+type ArtistImageIDPathParam struct {
+	ArtistID int `param:"id"`
+	ImageID  int `param:"image_id"`
+}
+
+var _ validation.Validatable = ArtistImageIDPathParam{}
+
+func (p ArtistImageIDPathParam) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.ArtistID,
+			validation.Required,
+			validation.Min(1),
+		),
+		validation.Field(
+			&p.ImageID,
+			validation.Required,
+			validation.Min(1),
+		),
+	)
+}
+
+type BlobKeyPathParam struct {
+	Key string `param:"key"`
+}
+
+var _ validation.Validatable = BlobKeyPathParam{}
+
+var blobKeyRegexp = regexp.MustCompile(`^[0-9a-f]+(_thumb)?$`)
+
+func (p BlobKeyPathParam) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.Key,
+			validation.Required,
+			validation.Match(blobKeyRegexp),
+		),
+	)
+}
+
+type DocsAssetPathParam struct {
+	Name string `param:"name"`
+}
+
+var _ validation.Validatable = DocsAssetPathParam{}
+
+var docsAssetNameRegexp = regexp.MustCompile(`^[0-9A-Za-z-]+(\.[a-z]+)?$`)
+
+func (p DocsAssetPathParam) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.Name,
+			validation.Required,
+			validation.Match(docsAssetNameRegexp),
+		),
+	)
+}
+
+type PlaylistFilmIDPathParam struct {
+	ID     int `param:"id"`
+	FilmID int `param:"film_id"`
+}
+
+var _ validation.Validatable = PlaylistFilmIDPathParam{}
+
+func (p PlaylistFilmIDPathParam) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.ID,
+			validation.Required,
+			validation.Min(1),
+		),
+		validation.Field(
+			&p.FilmID,
+			validation.Required,
+			validation.Min(1),
+		),
+	)
+}
+
+type AuditPathParam struct {
+	ID            int       `param:"id"`
+	ContributedAt time.Time `param:"contributed_at"`
+}
+
+var _ validation.Validatable = AuditPathParam{}
+
+func (p AuditPathParam) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.ID,
+			validation.Required,
+			validation.Min(1),
+		),
+		validation.Field(
+			&p.ContributedAt,
+			validation.Required,
+		),
+	)
+}
+
+type EpisodeAuditPathParam struct {
+	SeriesSeasonEpisodeNumberPathParam
+	ContributedAt time.Time `param:"contributed_at"`
+}
+
+var _ validation.Validatable = EpisodeAuditPathParam{}
+
+func (p EpisodeAuditPathParam) Validate() error {
+	err := p.SeriesSeasonEpisodeNumberPathParam.Validate()
+	if err != nil {
+		return err
+	}
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.ContributedAt,
+			validation.Required,
+		),
+	)
+}
+
+type AuditDiffQueryParam struct {
+	From time.Time `query:"from"`
+	To   time.Time `query:"to"`
+}
+
+var _ validation.Validatable = AuditDiffQueryParam{}
+
+func (p AuditDiffQueryParam) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.From,
+			validation.Required,
+		),
+		validation.Field(
+			&p.To,
+			validation.Required,
+		),
+	)
+}
+
+// LeaderboardQueryParam is the time window contributions are counted in,
+// either end might be left out.
+type LeaderboardQueryParam struct {
+	Since time.Time `query:"since"`
+	Until time.Time `query:"until"`
+}
+
+var _ validation.Validatable = LeaderboardQueryParam{}
+
+func (p LeaderboardQueryParam) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.Until,
+			validation.Min(p.Since),
+		),
+	)
+}
+
+// ProposalsQueryParam filters the proposals by status, pending ones are
+// listed if left out.
+type ProposalsQueryParam struct {
+	Status string `query:"status"`
+}
+
+var _ validation.Validatable = ProposalsQueryParam{}
+
+func (p ProposalsQueryParam) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.Status,
+			validation.In(
+				repo.ProposalStatusPending,
+				repo.ProposalStatusApproved,
+				repo.ProposalStatusRejected,
+			),
+		),
+	)
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/logging"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// RequestLoggerMiddleware identifies the request by the X-Request-ID header it
+// came with, assigning a new one if missing, and echoes it back. The handlers,
+// app and repository log through the logger put on the request context, which
+// is tagged with the identifiers of the request, its span and the route, and
+// once authorized, the user. The request is logged along its latency once
+// served.
+func (s *Server) RequestLoggerMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+		req := c.Request()
+		header := c.Response().Header()
+
+		requestID := req.Header.Get(echo.HeaderXRequestID)
+		if !isValidRequestID(requestID) {
+			requestID = randomHex(16)
+			// so that the contributions record the assigned id
+			req.Header.Set(echo.HeaderXRequestID, requestID)
+		}
+		header.Set(echo.HeaderXRequestID, requestID)
+
+		fields := []zap.Field{
+			zap.String("request_id", requestID),
+			zap.String("method", req.Method),
+			zap.String("route", c.Path()),
+		}
+		// the span started by the tracing middleware
+		spanContext := trace.SpanContextFromContext(req.Context())
+		if spanContext.IsValid() {
+			fields = append(
+				fields,
+				zap.String("trace_id", spanContext.TraceID().String()),
+				zap.String("span_id", spanContext.SpanID().String()),
+			)
+		}
+
+		ctx := logging.WithLogger(req.Context(), s.logger.With(fields...))
+		c.SetRequest(req.WithContext(ctx))
+
+		if err := next(c); err != nil {
+			c.Error(err)
+		}
+
+		// the logger put on the context by the later middlewares, if any,
+		// knows the user too
+		s.loggerOf(c).Info(
+			"server.RequestLoggerMiddleware: request served",
+			zap.Int("status", c.Response().Status),
+			zap.Duration("latency", time.Since(start)),
+		)
+		return nil
+	}
+}
+
+// loggerOf returns the logger of the request c serves
+func (s *Server) loggerOf(c echo.Context) *zap.Logger {
+	return logging.FromContext(c.Request().Context())
+}
+
+// isValidRequestID tells whether id is fit to be recorded and logged
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// randomHex returns n random bytes hex encoded
+func randomHex(n int) string {
+	b := make([]byte, n)
+	// crypto/rand reads never fail on the supported platforms
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
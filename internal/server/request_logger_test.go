@@ -0,0 +1,47 @@
+package server_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLoggerMiddleware(t *testing.T) {
+	require := require.New(t)
+
+	server, _, defaults, teardown, err := setup(OptEnableDefaultUser)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/user/{id}"
+
+	// the requests are assigned ids
+	e.GET(path).
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		Header(echo.HeaderXRequestID).NotEmpty()
+
+	// or keep theirs
+	e.GET(path).
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(echo.HeaderXRequestID, "request-id").
+		Expect().
+		Status(http.StatusOK).
+		Header(echo.HeaderXRequestID).Equal("request-id")
+
+	// while the malformed ones are replaced
+	e.GET(path).
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(echo.HeaderXRequestID, "request id").
+		Expect().
+		Status(http.StatusOK).
+		Header(echo.HeaderXRequestID).NotEqual("request id")
+}
@@ -13,6 +13,12 @@ type ResponseValue struct {
 	PageCount *int `json:"page_count,omitempty"`
 	// TotalItems stands for the total number of items. If total is less than 0, it means total is unknown.
 	TotalItems *int `json:"total_items,omitempty"`
+	// NextCursor refers to the position of the next page on cursor-paginated
+	// responses. It's empty on the last page.
+	NextCursor string `json:"next_cursor,omitempty"`
+	// PrevCursor refers to the position of the previous page on
+	// cursor-paginated responses. It's empty on the first page.
+	PrevCursor string `json:"prev_cursor,omitempty"`
 }
 
 // // http StatusCreated 201
@@ -62,3 +68,24 @@ func Paginated(
 		TotalItems: &totalItems,
 	}
 }
+
+func Cursored(
+	perPage int,
+	items any,
+	prevCursor string,
+	nextCursor string,
+	message ...string,
+) *ResponseValue {
+	var msg string
+	if len(message) > 0 {
+		msg = message[0]
+	}
+	return &ResponseValue{
+		Status:     StatusOK,
+		Message:    msg,
+		PerPage:    perPage,
+		Payload:    &items,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+	}
+}
@@ -0,0 +1,73 @@
+package response
+
+import "encoding/json"
+
+// Embed returns item with the relations embedded in its json object under
+// their names, item itself if there's none.
+func Embed(item any, relations map[string]any) any {
+	if len(relations) == 0 {
+		return item
+	}
+	return embedded{item: item, relations: relations}
+}
+
+type embedded struct {
+	item      any
+	relations map[string]any
+}
+
+func (e embedded) MarshalJSON() ([]byte, error) {
+	encoded, err := json.Marshal(e.item)
+	if err != nil {
+		return nil, err
+	}
+	var object map[string]json.RawMessage
+	err = json.Unmarshal(encoded, &object)
+	if err != nil {
+		return nil, err
+	}
+	for name, relation := range e.relations {
+		object[name], err = json.Marshal(relation)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Shape narrows the json object of payload, or those of its items, down to
+// fields. Payloads other than objects and lists of them are left as they are.
+func Shape(payload any, fields []string) (any, error) {
+	keep := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		keep[field] = true
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	var items []json.RawMessage
+	if json.Unmarshal(encoded, &items) == nil && items != nil {
+		for i := range items {
+			items[i] = shapeObject(items[i], keep)
+		}
+		return items, nil
+	}
+	return shapeObject(encoded, keep), nil
+}
+
+func shapeObject(encoded json.RawMessage, keep map[string]bool) json.RawMessage {
+	var object map[string]json.RawMessage
+	if json.Unmarshal(encoded, &object) != nil || object == nil {
+		return encoded
+	}
+	for name := range object {
+		if !keep[name] {
+			delete(object, name)
+		}
+	}
+	// re-encoding a decoded object can't fail
+	shaped, _ := json.Marshal(object)
+	return shaped
+}
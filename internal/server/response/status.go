@@ -15,6 +15,17 @@ SameNewPassword
 TokenInvalid
 TokenMissingOrMalformed
 InternalServerError
+MediaTooLarge
+UnsupportedMediaType
+Forbidden
+FollowSelf
+ProposalReviewed
+PreconditionFailed
+IdempotencyKeyReused
+IdempotencyKeyInFlight
+TooManyRequests
+ProposalOutdated
+UserDeletionPending
 )
 */
 type Status int
@@ -7,6 +7,7 @@
 package response
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -33,9 +34,33 @@ const (
 	StatusTokenMissingOrMalformed
 	// StatusInternalServerError is a Status of type InternalServerError.
 	StatusInternalServerError
+	// StatusMediaTooLarge is a Status of type MediaTooLarge.
+	StatusMediaTooLarge
+	// StatusUnsupportedMediaType is a Status of type UnsupportedMediaType.
+	StatusUnsupportedMediaType
+	// StatusForbidden is a Status of type Forbidden.
+	StatusForbidden
+	// StatusFollowSelf is a Status of type FollowSelf.
+	StatusFollowSelf
+	// StatusProposalReviewed is a Status of type ProposalReviewed.
+	StatusProposalReviewed
+	// StatusPreconditionFailed is a Status of type PreconditionFailed.
+	StatusPreconditionFailed
+	// StatusIdempotencyKeyReused is a Status of type IdempotencyKeyReused.
+	StatusIdempotencyKeyReused
+	// StatusIdempotencyKeyInFlight is a Status of type IdempotencyKeyInFlight.
+	StatusIdempotencyKeyInFlight
+	// StatusTooManyRequests is a Status of type TooManyRequests.
+	StatusTooManyRequests
+	// StatusProposalOutdated is a Status of type ProposalOutdated.
+	StatusProposalOutdated
+	// StatusUserDeletionPending is a Status of type UserDeletionPending.
+	StatusUserDeletionPending
 )
 
-const _StatusName = "OKNotFoundInvalidURLParameterInvalidRequestEmailAlreadyUsedEmailNotFoundIncorrectPasswordSameNewPasswordTokenInvalidTokenMissingOrMalformedInternalServerError"
+var ErrInvalidStatus = errors.New("not a valid Status")
+
+const _StatusName = "OKNotFoundInvalidURLParameterInvalidRequestEmailAlreadyUsedEmailNotFoundIncorrectPasswordSameNewPasswordTokenInvalidTokenMissingOrMalformedInternalServerErrorMediaTooLargeUnsupportedMediaTypeForbiddenFollowSelfProposalReviewedPreconditionFailedIdempotencyKeyReusedIdempotencyKeyInFlightTooManyRequestsProposalOutdatedUserDeletionPending"
 
 var _StatusMap = map[Status]string{
 	StatusOK:                      _StatusName[0:2],
@@ -49,6 +74,17 @@ var _StatusMap = map[Status]string{
 	StatusTokenInvalid:            _StatusName[104:116],
 	StatusTokenMissingOrMalformed: _StatusName[116:139],
 	StatusInternalServerError:     _StatusName[139:158],
+	StatusMediaTooLarge:           _StatusName[158:171],
+	StatusUnsupportedMediaType:    _StatusName[171:191],
+	StatusForbidden:               _StatusName[191:200],
+	StatusFollowSelf:              _StatusName[200:210],
+	StatusProposalReviewed:        _StatusName[210:226],
+	StatusPreconditionFailed:      _StatusName[226:244],
+	StatusIdempotencyKeyReused:    _StatusName[244:264],
+	StatusIdempotencyKeyInFlight:  _StatusName[264:286],
+	StatusTooManyRequests:         _StatusName[286:301],
+	StatusProposalOutdated:        _StatusName[301:317],
+	StatusUserDeletionPending:     _StatusName[317:336],
 }
 
 // String implements the Stringer interface.
@@ -71,6 +107,17 @@ var _StatusValue = map[string]Status{
 	_StatusName[104:116]: StatusTokenInvalid,
 	_StatusName[116:139]: StatusTokenMissingOrMalformed,
 	_StatusName[139:158]: StatusInternalServerError,
+	_StatusName[158:171]: StatusMediaTooLarge,
+	_StatusName[171:191]: StatusUnsupportedMediaType,
+	_StatusName[191:200]: StatusForbidden,
+	_StatusName[200:210]: StatusFollowSelf,
+	_StatusName[210:226]: StatusProposalReviewed,
+	_StatusName[226:244]: StatusPreconditionFailed,
+	_StatusName[244:264]: StatusIdempotencyKeyReused,
+	_StatusName[264:286]: StatusIdempotencyKeyInFlight,
+	_StatusName[286:301]: StatusTooManyRequests,
+	_StatusName[301:317]: StatusProposalOutdated,
+	_StatusName[317:336]: StatusUserDeletionPending,
 }
 
 // ParseStatus attempts to convert a string to a Status.
@@ -78,7 +125,7 @@ func ParseStatus(name string) (Status, error) {
 	if x, ok := _StatusValue[name]; ok {
 		return x, nil
 	}
-	return Status(0), fmt.Errorf("%s is not a valid Status", name)
+	return Status(0), fmt.Errorf("%s is %w", name, ErrInvalidStatus)
 }
 
 // MarshalText implements the text marshaller method.
@@ -0,0 +1,556 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/server/request"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/series/:id/season/:season_number/?include_invalidated=true&include=series,episodes_count
+func (s *Server) HandleSeasonGet(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonNumberPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeasonGet: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// parse the relations to include
+	include, err := FetchIncludeQueryParam(
+		c.Request(),
+		includeSeries,
+		includeEpisodesCount,
+	)
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeasonGet: query validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+	var loads []string
+	if include[includeSeries] {
+		loads = append(loads, models.SeasonRels.Series)
+	}
+
+	// fetch season
+	season, err := s.app.SeasonGet(
+		c.Request().Context(),
+		params.SeriesID,
+		params.SeasonNumber,
+		FetchIncludeInvalidatedQueryParam(c.Request()),
+		loads...,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleSeasonGet: season not found",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleSeasonGet: internal server error", zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// embed the included relations
+	relations := make(map[string]any)
+	if include[includeSeries] {
+		relations[includeSeries] = season.R.GetSeries()
+	}
+	if include[includeEpisodesCount] {
+		relations[includeEpisodesCount], err = s.app.EpisodesCountBySeason(
+			c.Request().Context(),
+			params.SeriesID,
+			params.SeasonNumber,
+			FetchIncludeInvalidatedQueryParam(c.Request()),
+		)
+		if err != nil {
+			s.loggerOf(c).Error(
+				"server.HandleSeasonGet: internal server error",
+				zap.Error(err),
+			)
+			return echo.NewHTTPError(
+				http.StatusInternalServerError,
+				response.Error(response.StatusInternalServerError),
+			)
+		}
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.OK(response.Embed(season, relations)),
+	)
+}
+
+// GET /v1/authorized/series/:id/season/?page=1&per_page=100&include_invalidated=true
+func (s *Server) HandleSeasonsGetAllBySeries(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeasonsGetAllBySeries: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// parse pagination params
+	page, perPage, offset := FetchPaginationQueryParams(c.Request())
+
+	// fetch seasons
+	seasons, total, err := s.app.SeasonsGetAllBySeries(
+		c.Request().Context(),
+		params.ID,
+		offset,
+		perPage,
+		FetchIncludeInvalidatedQueryParam(c.Request()),
+	)
+	if err != nil {
+		s.loggerOf(c).Error(
+			"server.HandleSeasonsGetAllBySeries: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(page, perPage, seasons, total),
+	)
+}
+
+// PUT /v1/authorized/series/:id/season/:season_number/
+func (s *Server) HandleSeasonPut(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonNumberPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeasonPut: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// bind & validate request
+	var req dto.SeasonPutRequest
+	err = (&echo.DefaultBinder{}).BindBody(c, &req)
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeasonPut: request binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidRequest, err.Error()),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleSeasonPut: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// put season
+	err = s.app.SeasonPut(
+		c.Request().Context(),
+		params.SeriesID,
+		params.SeasonNumber,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleSeasonPut: series not found",
+				zap.Int("series id", params.SeriesID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleSeasonPut: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// PATCH /v1/authorized/series/:id/season/:season_number/
+func (s *Server) HandleSeasonUpdate(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonNumberPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeasonUpdate: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// bind & validate request
+	var req dto.SeasonUpdateRequest
+	err = (&echo.DefaultBinder{}).BindBody(c, &req)
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeasonUpdate: request binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidRequest, err.Error()),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleSeasonUpdate: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// update season
+	err = s.app.SeasonUpdate(
+		c.Request().Context(),
+		params.SeriesID,
+		params.SeasonNumber,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleSeasonUpdate: season not found",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleSeasonUpdate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// DELETE /v1/authorized/series/:id/season/:season_number/
+func (s *Server) HandleSeasonInvalidate(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonNumberPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeasonInvalidate: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// bind & validate request
+	var req dto.InvalidationRequest
+	err = (&echo.DefaultBinder{}).BindBody(c, &req)
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeasonInvalidate: request binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidRequest, err.Error()),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleSeasonInvalidate: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// invalidate season
+	err = s.app.SeasonInvalidate(
+		c.Request().Context(),
+		params.SeriesID,
+		params.SeasonNumber,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleSeasonInvalidate: season not found",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleSeasonInvalidate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// POST /v1/authorized/series/:id/season/:season_number/restore/
+func (s *Server) HandleSeasonRestore(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonNumberPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeasonRestore: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleSeasonRestore: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// restore season
+	err = s.app.SeasonRestore(
+		c.Request().Context(),
+		params.SeriesID,
+		params.SeasonNumber,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleSeasonRestore: season not found",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleSeasonRestore: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// GET /v1/authorized/series/:id/season/:season_number/audits/?page=1&per_page=60
+// GET /v1/authorized/series/:id/season/:season_number/audits/?cursor=&per_page=60
+func (s *Server) HandleSeasonAuditsGetAll(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonNumberPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeasonAuditsGetAll: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// fetch audits, by cursor if asked for
+	var res *response.ResponseValue
+	if CursorPaginationRequested(c.Request()) {
+		cursor, perPage := FetchCursorPaginationQueryParams(c.Request())
+		var (
+			audits                 []*models.SeasonsAudit
+			prevCursor, nextCursor string
+		)
+		audits, prevCursor, nextCursor, err = s.app.SeasonAuditsGetPage(
+			c.Request().Context(),
+			params.SeriesID,
+			params.SeasonNumber,
+			cursor,
+			perPage,
+		)
+		res = response.Cursored(perPage, audits, prevCursor, nextCursor)
+	} else {
+		page, perPage, offset := FetchPaginationQueryParams(c.Request())
+		var (
+			audits []*models.SeasonsAudit
+			total  int
+		)
+		audits, total, err = s.app.SeasonAuditsGetAll(
+			c.Request().Context(),
+			params.SeriesID,
+			params.SeasonNumber,
+			offset,
+			perPage,
+		)
+		res = response.Paginated(page, perPage, audits, total)
+	}
+	if err != nil {
+		if err == app.ErrCursorInvalid {
+			s.loggerOf(c).Info(
+				"server.HandleSeasonAuditsGetAll: invalid cursor",
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusInvalidURLParameter),
+			)
+		}
+
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleSeasonAuditsGetAll: season not found",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleSeasonAuditsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
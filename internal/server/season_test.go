@@ -0,0 +1,284 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestHandleSeasonGet(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(
+		OptEnableDefaultSeries,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/series/{id}/season/{se}"
+	method := http.MethodGet
+
+	seasonNumber := 1
+
+	// invalid id/number
+	e.Request(method, path).
+		WithPath("id", -1).
+		WithPath("se", -1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	// season not found
+	e.Request(method, path).
+		WithPath("id", defaults.series.id).
+		WithPath("se", seasonNumber).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	// putting an episode creates the season
+	_, err = appInstance.EpisodePut(
+		ctx,
+		defaults.series.id, seasonNumber, 1,
+		defaults.user.id,
+		&dto.EpisodePutRequest{
+			Title:        "episode",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	gotSeason, err := appInstance.SeasonGet(
+		ctx,
+		defaults.series.id,
+		seasonNumber,
+		true,
+	)
+	require.NoError(err)
+
+	payload := &models.Season{
+		SeriesID:      defaults.series.id,
+		SeasonNumber:  seasonNumber,
+		ContributedBy: defaults.user.id,
+		ContributedAt: gotSeason.ContributedAt,
+	}
+
+	// get season
+	e.Request(method, path).
+		WithPath("id", defaults.series.id).
+		WithPath("se", seasonNumber).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(payload))
+}
+
+func TestHandleSeasonsGetAllBySeries(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(
+		OptEnableDefaultSeries,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/series/{id}/season/"
+	method := http.MethodGet
+
+	// invalid id
+	e.Request(method, path).
+		WithPath("id", -1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	// no seasons
+	e.Request(method, path).
+		WithPath("id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.Paginated(config.Config.Pagination.Page.MinValue, config.Config.Pagination.PageSize.DefaultValue, nil, 0))
+
+	// put a titled season and then episodes into two seasons
+	err = appInstance.SeasonPut(
+		ctx,
+		defaults.series.id, 1,
+		defaults.user.id,
+		&dto.SeasonPutRequest{Title: null.StringFrom("season one")},
+	)
+	require.NoError(err)
+
+	episodesPerSeason := []int{3, 2}
+	for s, nEpisodes := range episodesPerSeason {
+		for ep := 1; ep <= nEpisodes; ep++ {
+			_, err = appInstance.EpisodePut(
+				ctx,
+				defaults.series.id, s+1, ep,
+				defaults.user.id,
+				&dto.EpisodePutRequest{
+					Title:        "episode",
+					DateReleased: testutils.Date(2000, 1, 1),
+				},
+			)
+			require.NoError(err)
+		}
+	}
+
+	gotSeasons, total, err := appInstance.SeasonsGetAllBySeries(
+		ctx,
+		defaults.series.id,
+		0,
+		config.Config.Pagination.PageSize.MaxValue,
+		true,
+	)
+	require.NoError(err)
+	require.Equal(len(episodesPerSeason), total)
+
+	items := make([]*repo.SeasonWithEpisodesCount, len(gotSeasons))
+	for i, s := range gotSeasons {
+		require.Equal(episodesPerSeason[i], s.EpisodesCount)
+		items[i] = s
+	}
+	require.Equal(null.StringFrom("season one"), items[0].Title)
+
+	e.Request(method, path).
+		WithPath("id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.Paginated(config.Config.Pagination.Page.MinValue, config.Config.Pagination.PageSize.DefaultValue, items, total))
+}
+
+func TestHandleSeasonPut(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(
+		OptEnableDefaultSeries,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/series/{id}/season/{se}"
+	method := http.MethodPut
+
+	seasonNumber := 1
+	req := &dto.SeasonPutRequest{
+		Title:       null.StringFrom("season"),
+		DateStarted: null.TimeFrom(testutils.Date(2000, 1, 1)),
+	}
+
+	// invalid id/number
+	e.Request(method, path).
+		WithPath("id", -1).
+		WithPath("se", -1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	// series not found
+	e.Request(method, path).
+		WithPath("id", defaults.series.id+1).
+		WithPath("se", seasonNumber).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	// put season
+	e.Request(method, path).
+		WithPath("id", defaults.series.id).
+		WithPath("se", seasonNumber).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	gotSeason, err := appInstance.SeasonGet(
+		ctx,
+		defaults.series.id,
+		seasonNumber,
+		true,
+	)
+	require.NoError(err)
+	require.Equal(req.Title, gotSeason.Title)
+	require.Equal(defaults.user.id, gotSeason.ContributedBy)
+
+	// patch season
+	e.Request(http.MethodPatch, path).
+		WithPath("id", defaults.series.id).
+		WithPath("se", seasonNumber).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.SeasonUpdateRequest{
+			Descriptions: null.StringFrom("descriptions"),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	gotSeason, err = appInstance.SeasonGet(
+		ctx,
+		defaults.series.id,
+		seasonNumber,
+		true,
+	)
+	require.NoError(err)
+	require.Equal(req.Title, gotSeason.Title)
+	require.Equal(null.StringFrom("descriptions"), gotSeason.Descriptions)
+
+	// the replaced season is audited
+	audits, total, err := appInstance.SeasonAuditsGetAll(
+		ctx,
+		defaults.series.id,
+		seasonNumber,
+		0,
+		config.Config.Pagination.PageSize.MaxValue,
+	)
+	require.NoError(err)
+	require.Equal(1, total)
+	require.False(audits[0].Descriptions.Valid)
+}
@@ -5,13 +5,14 @@ import (
 
 	"github.com/aria3ppp/watch-server/internal/app"
 	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
 	"github.com/aria3ppp/watch-server/internal/server/request"
 	"github.com/aria3ppp/watch-server/internal/server/response"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 )
 
-// GET /v1/authorized/series/:id/
+// GET /v1/authorized/series/:id/?include_invalidated=true&include=seasons,episodes_count
 func (s *Server) HandleSeriesGet(c echo.Context) error {
 	// bind & validate params
 	var params request.IDPathParam
@@ -20,7 +21,7 @@ func (s *Server) HandleSeriesGet(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleSeriesGet: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -30,11 +31,37 @@ func (s *Server) HandleSeriesGet(c echo.Context) error {
 		)
 	}
 
+	// parse the relations to include
+	include, err := FetchIncludeQueryParam(
+		c.Request(),
+		includeSeasons,
+		includeEpisodesCount,
+	)
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeriesGet: query validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+	var loads []string
+	if include[includeSeasons] {
+		loads = append(loads, models.SeriesRels.SeriesSeasons)
+	}
+
 	// fetch series
-	series, err := s.app.SeriesGet(c.Request().Context(), params.ID)
+	series, err := s.app.SeriesGet(
+		c.Request().Context(),
+		params.ID,
+		FetchIncludeInvalidatedQueryParam(c.Request()),
+		loads...,
+	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleSeriesGet: series not found",
 				zap.Int("id", params.ID),
 			)
@@ -44,7 +71,7 @@ func (s *Server) HandleSeriesGet(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleSeriesGet: internal server error", zap.Error(err),
 		)
 		return echo.NewHTTPError(
@@ -53,22 +80,74 @@ func (s *Server) HandleSeriesGet(c echo.Context) error {
 		)
 	}
 
-	return c.JSON(http.StatusOK, response.OK(series))
+	// the included relations change apart from the series, so only the
+	// series alone is tagged
+	if len(include) == 0 && notModified(c, series.ContributedAt) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	// embed the included relations
+	relations := make(map[string]any)
+	if include[includeSeasons] {
+		seasons := series.R.GetSeriesSeasons()
+		if seasons == nil {
+			seasons = models.SeasonSlice{}
+		}
+		relations[includeSeasons] = seasons
+	}
+	if include[includeEpisodesCount] {
+		relations[includeEpisodesCount], err = s.app.EpisodesCountBySeries(
+			c.Request().Context(),
+			params.ID,
+			FetchIncludeInvalidatedQueryParam(c.Request()),
+		)
+		if err != nil {
+			s.loggerOf(c).Error(
+				"server.HandleSeriesGet: internal server error",
+				zap.Error(err),
+			)
+			return echo.NewHTTPError(
+				http.StatusInternalServerError,
+				response.Error(response.StatusInternalServerError),
+			)
+		}
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.OK(response.Embed(series, relations)),
+	)
 }
 
-// GET /v1/authorized/series/?page=1&per_page=60
+// GET /v1/authorized/series/?page=1&per_page=60&include_invalidated=true&sort=-date_started&title_contains=
 func (s *Server) HandleSeriesesGetAll(c echo.Context) error {
+	// parse sort and filter params
+	filter, sort, err := FetchListQueryParams(c.Request(), seriesListFields)
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeriesesGetAll: query validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
 	// parse pagination params
 	page, perPage, offset := FetchPaginationQueryParams(c.Request())
 
 	// fetch serieses
 	serieses, total, err := s.app.SeriesesGetAll(
 		c.Request().Context(),
+		filter,
+		sort,
 		offset,
 		perPage,
+		FetchIncludeInvalidatedQueryParam(c.Request()),
 	)
 	if err != nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleSeriesesGetAll: internal server error",
 			zap.Error(err),
 		)
@@ -84,6 +163,79 @@ func (s *Server) HandleSeriesesGetAll(c echo.Context) error {
 	)
 }
 
+// GET /v1/authorized/series/search/?query=title&page=1&per_page=100
+// GET /v1/authorized/series/search/?query=title&cursor=&per_page=100
+func (s *Server) HandleSeriesesSearch(c echo.Context) error {
+	// bind & validate query
+	var req dto.SearchRequest
+	err := (&echo.DefaultBinder{}).BindQueryParams(c, &req)
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeriesesSearch: query binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// search serieses, by cursor if asked for
+	var res *response.ResponseValue
+	if CursorPaginationRequested(c.Request()) {
+		cursor, perPage := FetchCursorPaginationQueryParams(c.Request())
+		var (
+			serieses               []*models.Series
+			prevCursor, nextCursor string
+		)
+		serieses, prevCursor, nextCursor, err = s.app.SeriesesSearchPage(
+			c.Request().Context(),
+			&req,
+			cursor,
+			perPage,
+		)
+		res = response.Cursored(perPage, serieses, prevCursor, nextCursor)
+	} else {
+		page, perPage, offset := FetchPaginationQueryParams(c.Request())
+		var (
+			serieses []*models.Series
+			total    int
+		)
+		serieses, total, err = s.app.SeriesesSearch(
+			c.Request().Context(),
+			&req,
+			offset,
+			perPage,
+		)
+		res = response.Paginated(page, perPage, serieses, total)
+	}
+	if err != nil {
+		if err == app.ErrCursorInvalid {
+			s.loggerOf(c).Info(
+				"server.HandleSeriesesSearch: invalid cursor",
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusInvalidURLParameter),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleSeriesesSearch: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
 // POST /v1/authorized/series/
 func (s *Server) HandleSeriesCreate(c echo.Context) error {
 	// bind & validate request
@@ -93,7 +245,7 @@ func (s *Server) HandleSeriesCreate(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleSeriesCreate: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -105,7 +257,7 @@ func (s *Server) HandleSeriesCreate(c echo.Context) error {
 
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleSeriesCreate: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -122,7 +274,7 @@ func (s *Server) HandleSeriesCreate(c echo.Context) error {
 		&req,
 	)
 	if err != nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleSeriesCreate: internal server error",
 			zap.Error(err),
 		)
@@ -144,7 +296,7 @@ func (s *Server) HandleSeriesUpdate(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleSeriesUpdate: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -161,7 +313,7 @@ func (s *Server) HandleSeriesUpdate(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleSeriesUpdate: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -173,7 +325,7 @@ func (s *Server) HandleSeriesUpdate(c echo.Context) error {
 
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleSeriesUpdate: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -192,7 +344,7 @@ func (s *Server) HandleSeriesUpdate(c echo.Context) error {
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleSeriesUpdate: series not found",
 				zap.Int("id", params.ID),
 			)
@@ -202,7 +354,18 @@ func (s *Server) HandleSeriesUpdate(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		if err == app.ErrPreconditionFailed {
+			s.loggerOf(c).Info(
+				"server.HandleSeriesUpdate: precondition failed",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusPreconditionFailed,
+				response.Error(response.StatusPreconditionFailed),
+			)
+		}
+
+		s.loggerOf(c).Error(
 			"server.HandleSeriesUpdate: internal server error",
 			zap.Error(err),
 		)
@@ -224,7 +387,7 @@ func (s *Server) HandleSeriesInvalidate(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleSeriesInvalidate: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -241,7 +404,7 @@ func (s *Server) HandleSeriesInvalidate(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleSeriesInvalidate: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -253,7 +416,7 @@ func (s *Server) HandleSeriesInvalidate(c echo.Context) error {
 
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleSeriesInvalidate: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -272,7 +435,7 @@ func (s *Server) HandleSeriesInvalidate(c echo.Context) error {
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleSeriesInvalidate: series not found",
 				zap.Int("id", params.ID),
 			)
@@ -282,7 +445,18 @@ func (s *Server) HandleSeriesInvalidate(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		if err == app.ErrPreconditionFailed {
+			s.loggerOf(c).Info(
+				"server.HandleSeriesInvalidate: precondition failed",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusPreconditionFailed,
+				response.Error(response.StatusPreconditionFailed),
+			)
+		}
+
+		s.loggerOf(c).Error(
 			"server.HandleSeriesInvalidate: internal server error",
 			zap.Error(err),
 		)
@@ -295,7 +469,71 @@ func (s *Server) HandleSeriesInvalidate(c echo.Context) error {
 	return c.JSON(http.StatusOK, response.OK(nil))
 }
 
+// POST /v1/authorized/series/:id/restore/?cascade=true
+func (s *Server) HandleSeriesRestore(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeriesRestore: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleSeriesRestore: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// restore series
+	err = s.app.SeriesRestore(
+		c.Request().Context(),
+		params.ID,
+		payload.UserID,
+		FetchCascadeQueryParam(c.Request()),
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleSeriesRestore: series not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleSeriesRestore: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
 // GET /v1/authorized/series/:id/audits/?page=1&per_page=60
+// GET /v1/authorized/series/:id/audits/?cursor=&per_page=60
 func (s *Server) HandleSeriesAuditsGetAll(c echo.Context) error {
 	// bind & validate params
 	var params request.IDPathParam
@@ -304,7 +542,7 @@ func (s *Server) HandleSeriesAuditsGetAll(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleSeriesAuditsGetAll: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -314,10 +552,227 @@ func (s *Server) HandleSeriesAuditsGetAll(c echo.Context) error {
 		)
 	}
 
+	// fetch audits, by cursor if asked for
+	var res *response.ResponseValue
+	if CursorPaginationRequested(c.Request()) {
+		cursor, perPage := FetchCursorPaginationQueryParams(c.Request())
+		var (
+			audits                 []*models.SeriesesAudit
+			prevCursor, nextCursor string
+		)
+		audits, prevCursor, nextCursor, err = s.app.SeriesAuditsGetPage(
+			c.Request().Context(),
+			params.ID,
+			cursor,
+			perPage,
+		)
+		res = response.Cursored(perPage, audits, prevCursor, nextCursor)
+	} else {
+		page, perPage, offset := FetchPaginationQueryParams(c.Request())
+		var (
+			audits []*models.SeriesesAudit
+			total  int
+		)
+		audits, total, err = s.app.SeriesAuditsGetAll(
+			c.Request().Context(),
+			params.ID,
+			offset,
+			perPage,
+		)
+		res = response.Paginated(page, perPage, audits, total)
+	}
+	if err != nil {
+		if err == app.ErrCursorInvalid {
+			s.loggerOf(c).Info(
+				"server.HandleSeriesAuditsGetAll: invalid cursor",
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusInvalidURLParameter),
+			)
+		}
+
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleSeriesAuditsGetAll: series not found",
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleSeriesAuditsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// POST /v1/authorized/series/:id/audits/:contributed_at/revert/
+func (s *Server) HandleSeriesAuditRevert(c echo.Context) error {
+	// bind & validate params
+	var params request.AuditPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeriesAuditRevert: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleSeriesAuditRevert: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// revert series
+	err = s.app.SeriesAuditRevert(
+		c.Request().Context(),
+		params.ID,
+		params.ContributedAt,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleSeriesAuditRevert: series audit not found",
+				zap.Int("id", params.ID),
+				zap.Time("contributed at", params.ContributedAt),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleSeriesAuditRevert: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+// GET /v1/authorized/series/:id/audits/diff/?from=2006-01-02T15:04:05Z&to=2006-01-02T15:04:05Z
+func (s *Server) HandleSeriesAuditDiff(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeriesAuditDiff: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// bind & validate query
+	var query request.AuditDiffQueryParam
+	err = (&echo.DefaultBinder{}).BindQueryParams(c, &query)
+	if err == nil {
+		err = query.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeriesAuditDiff: query binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
+	// diff revisions
+	diff, err := s.app.SeriesAuditDiff(
+		c.Request().Context(),
+		params.ID,
+		query.From,
+		query.To,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleSeriesAuditDiff: series revision not found",
+				zap.Int("id", params.ID),
+				zap.Time("from", query.From),
+				zap.Time("to", query.To),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleSeriesAuditDiff: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(diff))
+}
+
+// GET /v1/authorized/series/:id/audits/diffs/?page=1&per_page=100
+func (s *Server) HandleSeriesAuditDiffsGetAll(c echo.Context) error {
+	// bind & validate params
+	var params request.IDPathParam
+	err := (&echo.DefaultBinder{}).BindPathParams(c, &params)
+	if err == nil {
+		err = params.Validate()
+	}
+	if err != nil {
+		s.loggerOf(c).Info(
+			"server.HandleSeriesAuditDiffsGetAll: parameter binding/validation failed",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			response.Error(response.StatusInvalidURLParameter),
+		)
+	}
+
 	page, perPage, offset := FetchPaginationQueryParams(c.Request())
 
-	// fetch audits
-	audits, total, err := s.app.SeriesAuditsGetAll(
+	// fetch diffs
+	diffs, total, err := s.app.SeriesAuditDiffsGetAll(
 		c.Request().Context(),
 		params.ID,
 		offset,
@@ -325,8 +780,8 @@ func (s *Server) HandleSeriesAuditsGetAll(c echo.Context) error {
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
-				"server.HandleSeriesAuditsGetAll: series not found",
+			s.loggerOf(c).Info(
+				"server.HandleSeriesAuditDiffsGetAll: series not found",
 				zap.Int("id", params.ID),
 			)
 			return echo.NewHTTPError(
@@ -335,8 +790,8 @@ func (s *Server) HandleSeriesAuditsGetAll(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
-			"server.HandleSeriesAuditsGetAll: internal server error",
+		s.loggerOf(c).Error(
+			"server.HandleSeriesAuditDiffsGetAll: internal server error",
 			zap.Error(err),
 		)
 		return echo.NewHTTPError(
@@ -347,6 +802,6 @@ func (s *Server) HandleSeriesAuditsGetAll(c echo.Context) error {
 
 	return c.JSON(
 		http.StatusOK,
-		response.Paginated(page, perPage, audits, total),
+		response.Paginated(page, perPage, diffs, total),
 	)
 }
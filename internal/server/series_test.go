@@ -66,7 +66,7 @@ func TestHandleSeriesGet(t *testing.T) {
 	)
 	require.NoError(err)
 
-	gotSeries, err := appInstance.SeriesGet(ctx, seriesID)
+	gotSeries, err := appInstance.SeriesGet(ctx, seriesID, true)
 	require.NoError(err)
 
 	require.GreaterOrEqual(gotSeries.ContributedAt, upsertTime)
@@ -91,6 +91,47 @@ func TestHandleSeriesGet(t *testing.T) {
 		JSON().
 		Object().
 		Equal(response.OK(payload))
+
+	// add a season to embed
+	err = appInstance.SeasonPut(
+		ctx,
+		seriesID,
+		1,
+		defaults.user.id,
+		&dto.SeasonPutRequest{Title: null.StringFrom("season")},
+	)
+	require.NoError(err)
+
+	// unsupported relation
+	e.Request(method, path).
+		WithPath("id", seriesID).
+		WithQuery(config.Config.Shaping.Include.VarName, "series").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	// get series narrowed down to some fields along with its relations
+	shaped := e.Request(method, path).
+		WithPath("id", seriesID).
+		WithQuery(config.Config.Shaping.Fields.VarName, "id,title").
+		WithQuery(config.Config.Shaping.Include.VarName, "seasons,episodes_count").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("status", response.StatusOK.String()).
+		Value("payload").
+		Object()
+	shaped.Keys().ContainsOnly("id", "title", "seasons", "episodes_count")
+	shaped.ValueEqual("id", seriesID)
+	shaped.ValueEqual("title", seriesUpsertReq.Title)
+	shaped.ValueEqual("episodes_count", 0)
+	shaped.Value("seasons").Array().Length().Equal(1)
+	shaped.Value("seasons").Array().First().Object().ValueEqual("title", "season")
 }
 
 func TestHandleSeriesesGetAll(t *testing.T) {
@@ -152,8 +193,11 @@ func TestHandleSeriesesGetAll(t *testing.T) {
 
 	gotSerieses, total, err := appInstance.SeriesesGetAll(
 		ctx,
+		nil,
+		nil,
 		0,
 		config.Config.Pagination.PageSize.MaxValue,
+		true,
 	)
 	require.NoError(err)
 
@@ -218,7 +262,7 @@ func TestHandleSeriesCreate(t *testing.T) {
 
 	// check series created
 
-	gotSeries, err := appInstance.SeriesGet(ctx, seriesID)
+	gotSeries, err := appInstance.SeriesGet(ctx, seriesID, true)
 	require.NoError(err)
 
 	testutils.SetTimeLocation(
@@ -242,6 +286,8 @@ func TestHandleSeriesCreate(t *testing.T) {
 			Invalidation:  null.String{},
 			ContributedBy: defaults.user.id,
 			ContributedAt: gotSeries.ContributedAt,
+			ClientIP:      null.StringFrom(testClientIP),
+			UserAgent:     null.StringFrom(testUserAgent),
 		},
 		gotSeries,
 	)
@@ -568,7 +614,7 @@ func TestHandleSeriesUpdate(t *testing.T) {
 			)
 			require.NoError(err)
 
-			gotSeriesBeforeUpdate, err := appInstance.SeriesGet(ctx, seriesID)
+			gotSeriesBeforeUpdate, err := appInstance.SeriesGet(ctx, seriesID, true)
 			require.NoError(err)
 
 			updateTime := time.Now()
@@ -585,7 +631,7 @@ func TestHandleSeriesUpdate(t *testing.T) {
 				Equal(response.OK(nil))
 
 			// check updated fields
-			gotSeriesAfterUpdate, err := appInstance.SeriesGet(ctx, seriesID)
+			gotSeriesAfterUpdate, err := appInstance.SeriesGet(ctx, seriesID, true)
 			require.NoError(err)
 
 			require.GreaterOrEqual(
@@ -618,6 +664,8 @@ func TestHandleSeriesUpdate(t *testing.T) {
 			updatedSeries.Invalidation = null.String{}
 			updatedSeries.ContributedBy = defaults.user.id
 			updatedSeries.ContributedAt = gotSeriesAfterUpdate.ContributedAt
+			updatedSeries.ClientIP = null.StringFrom(testClientIP)
+			updatedSeries.UserAgent = null.StringFrom(testUserAgent)
 
 			testutils.SetTimeLocation(
 				&updatedSeries.DateStarted,
@@ -919,7 +967,7 @@ func TestHandleSeriesInvalidate(t *testing.T) {
 		Equal(response.OK(nil))
 
 	// check series invalidated
-	gotInvalidatedSeries, err := appInstance.SeriesGet(ctx, seriesID)
+	gotInvalidatedSeries, err := appInstance.SeriesGet(ctx, seriesID, true)
 	require.NoError(err)
 
 	testutils.SetTimeLocation(
@@ -941,6 +989,8 @@ func TestHandleSeriesInvalidate(t *testing.T) {
 			Invalidation:  null.StringFrom(invalidationRequest.Invalidation),
 			ContributedBy: defaults.user.id,
 			ContributedAt: gotInvalidatedSeries.ContributedAt,
+			ClientIP:      null.StringFrom(testClientIP),
+			UserAgent:     null.StringFrom(testUserAgent),
 		},
 		gotInvalidatedSeries,
 	)
@@ -1069,7 +1119,7 @@ func TestHandleSeriesAuditsGetAll(t *testing.T) {
 	)
 	require.NoError(err)
 
-	gotSeries, err := appInstance.SeriesGet(ctx, seriesID)
+	gotSeries, err := appInstance.SeriesGet(ctx, seriesID, true)
 	require.NoError(err)
 
 	require.GreaterOrEqual(gotSeries.ContributedAt, createTime)
@@ -1116,7 +1166,7 @@ func TestHandleSeriesAuditsGetAll(t *testing.T) {
 	)
 	require.NoError(err)
 
-	gotSeries, err = appInstance.SeriesGet(ctx, seriesID)
+	gotSeries, err = appInstance.SeriesGet(ctx, seriesID, true)
 	require.NoError(err)
 
 	require.GreaterOrEqual(gotSeries.ContributedAt, updateTime)
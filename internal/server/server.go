@@ -5,11 +5,15 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/aria3ppp/watch-server/internal/app"
 	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/metrics"
+	"github.com/aria3ppp/watch-server/internal/ratelimit"
+	"github.com/aria3ppp/watch-server/internal/server/openapi"
 	"github.com/aria3ppp/watch-server/internal/token"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -20,22 +24,36 @@ type Server struct {
 	app          app.Service
 	router       *echo.Echo
 	tokenService token.Service
+	rateLimiter  ratelimit.Service
 	logger       *zap.Logger
+
+	// the OpenAPI document is generated once on first request
+	openapiOnce sync.Once
+	openapiDoc  *openapi.Document
+	openapiErr  error
 }
 
 func NewServer(
 	app app.Service,
 	router *echo.Echo,
 	tokenService token.Service,
+	rateLimiter ratelimit.Service,
 	logger *zap.Logger,
 ) *Server {
 	if !config.Config.Servic.Server.Production {
 		router.Debug = true
 	}
+	router.JSONSerializer = shapingJSONSerializer{}
+	extractor, err := ipExtractor(config.Config.Servic.Server.TrustedProxies)
+	if err != nil {
+		logger.Panic("server.NewServer: invalid trusted proxies", zap.Error(err))
+	}
+	router.IPExtractor = extractor
 	server := &Server{
 		app:          app,
 		router:       router,
 		tokenService: tokenService,
+		rateLimiter:  rateLimiter,
 		logger:       logger,
 	}
 	server.setHandlers()
@@ -47,6 +65,14 @@ func (s *Server) setHandlers() {
 	// so all pathes must end with a slash
 	s.router.Pre(middleware.AddTrailingSlash())
 
+	// trace, identify, log and measure the requests, ahead of the timeout so
+	// that the timed out ones are too
+	s.router.Use(
+		s.TracingMiddleware,
+		s.RequestLoggerMiddleware,
+		s.MetricsMiddleware,
+	)
+
 	// set timeout middleware for all paths
 	s.router.Use(
 		middleware.TimeoutWithConfig(
@@ -58,22 +84,72 @@ func (s *Server) setHandlers() {
 		),
 	)
 
+	// the metrics are scraped by prometheus
+	s.router.GET("/metrics/", echo.WrapHandler(metrics.Handler()))
+
+	// the public paths are rate limited by client ip
+	readRateLimit := s.RateLimitMiddleware(rateLimitRead)
+
 	v1 := s.router.Group("/v1")
+	v1.GET("/openapi.json/", s.HandleOpenAPIGet, readRateLimit)
+	v1.GET("/docs/", s.HandleDocsGet, readRateLimit)
+	v1.GET("/docs/:name/", s.HandleDocsAssetGet, readRateLimit)
 
 	user := v1.Group("/user")
-	user.POST("/", s.HandleUserCreate)
-	user.POST("/login/", s.HandleUserLogin)
-	user.GET("/refresh/", s.HandleUserRefreshToken)
+	user.POST(
+		"/",
+		s.HandleUserCreate,
+		s.RateLimitMiddleware(rateLimitUserCreate),
+	)
+	user.POST(
+		"/login/",
+		s.HandleUserLogin,
+		s.RateLimitMiddleware(rateLimitLogin),
+	)
+	user.GET("/refresh/", s.HandleUserRefreshToken, readRateLimit)
 
-	// set jwt middleware for authorized paths
-	authorized := v1.Group("/authorized", s.AuthMiddleware)
+	// set jwt middleware for authorized paths, rate limited by user, the
+	// contributions made through them record the request metadata
+	authorized := v1.Group(
+		"/authorized",
+		s.AuthMiddleware,
+		s.RateLimitMiddleware(rateLimitRead),
+		s.ContributionMetadataMiddleware,
+	)
+
+	// the users of a pending deletion are let through only to restore their
+	// account
+	v1.POST(
+		"/authorized/user/restore/",
+		s.HandleUserRestore,
+		s.AuthPendingDeletionMiddleware,
+		s.RateLimitMiddleware(rateLimitRead),
+		s.ContributionMetadataMiddleware,
+	)
 
 	authorizedUser := authorized.Group("/user")
 	authorizedUser.GET("/:id/", s.HandleUserGet)
 	authorizedUser.PATCH("/", s.HandleUserUpdate)
 	authorizedUser.PUT("/email/", s.HandleUserEmailUpdate)
 	authorizedUser.PUT("/password/", s.HandleUserPasswordUpdate)
+	authorizedUser.GET("/audits/", s.HandleUserAuditsGetAll)
 	authorizedUser.DELETE("/", s.HandleUserDelete)
+	authorizedUser.PUT("/:id/follow/", s.HandleUserFollow)
+	authorizedUser.DELETE("/:id/follow/", s.HandleUserUnfollow)
+	authorizedUser.GET("/:id/followers/", s.HandleUserFollowersGetAll)
+	authorizedUser.GET("/:id/following/", s.HandleUserFollowingsGetAll)
+	authorizedUser.GET("/:id/contributions/", s.HandleUserContributionsGetAll)
+
+	authorized.GET("/feed/", s.HandleFeedGet)
+	authorized.GET("/leaderboard/", s.HandleLeaderboardGet)
+
+	proposals := authorized.Group("/proposal")
+	proposals.GET("/", s.HandleProposalsGetAll)
+
+	proposal := proposals.Group("/:id")
+	proposal.GET("/", s.HandleProposalGet)
+	proposal.POST("/approve/", s.HandleProposalApprove)
+	proposal.POST("/reject/", s.HandleProposalReject)
 
 	// TODO: Implement access-based modifications:
 	// Only allowed users could change or delete a specific resource ==> admin? list of permited users per resource?
@@ -94,39 +170,117 @@ func (s *Server) setHandlers() {
 
 	Movies := authorized.Group("/movie")
 	Movies.GET("/", s.HandleMoviesGetAll)
-	Movies.POST("/", s.HandleMovieCreate)
-	// Movies.GET("/search/", s.HandleMoviesSearch)
+	Movies.POST("/", s.HandleMovieCreate, s.IdempotencyMiddleware)
+	Movies.GET("/search/", s.HandleMoviesSearch)
 
 	Movie := Movies.Group("/:id")
 	Movie.GET("/", s.HandleMovieGet)
-	Movie.PATCH("/", s.HandleMovieUpdate)
-	Movie.DELETE("/", s.HandleMovieInvalidate)
+	Movie.PATCH("/", s.HandleMovieUpdate, s.PreconditionMiddleware)
+	Movie.DELETE("/", s.HandleMovieInvalidate, s.PreconditionMiddleware)
+	Movie.POST("/restore/", s.HandleMovieRestore)
 	Movie.GET("/audits/", s.HandleMovieAuditsGetAll)
+	Movie.GET("/audits/diff/", s.HandleMovieAuditDiff)
+	Movie.GET("/audits/diffs/", s.HandleMovieAuditDiffsGetAll)
+	Movie.POST("/audits/:contributed_at/revert/", s.HandleMovieAuditRevert)
+	Movie.GET("/post/", s.HandleMoviePostsGetAll)
 
 	serieses := authorized.Group("/series")
 	serieses.GET("/", s.HandleSeriesesGetAll)
-	serieses.POST("/", s.HandleSeriesCreate)
-	// serieses.GET("/search", s.HandleSeriesesSearch)
+	serieses.POST("/", s.HandleSeriesCreate, s.IdempotencyMiddleware)
+	serieses.GET("/search/", s.HandleSeriesesSearch)
 
 	series := serieses.Group("/:id")
 	series.GET("/", s.HandleSeriesGet)
-	series.PATCH("/", s.HandleSeriesUpdate)
-	series.DELETE("/", s.HandleSeriesInvalidate)
+	series.PATCH("/", s.HandleSeriesUpdate, s.PreconditionMiddleware)
+	series.DELETE("/", s.HandleSeriesInvalidate, s.PreconditionMiddleware)
+	series.POST("/restore/", s.HandleSeriesRestore)
 	series.GET("/audits/", s.HandleSeriesAuditsGetAll)
+	series.GET("/audits/diff/", s.HandleSeriesAuditDiff)
+	series.GET("/audits/diffs/", s.HandleSeriesAuditDiffsGetAll)
+	series.POST("/audits/:contributed_at/revert/", s.HandleSeriesAuditRevert)
+	series.GET("/post/", s.HandleSeriesPostsGetAll)
 
 	series.GET("/episode/", s.HandleEpisodesGetAllBySeries)
+	series.GET("/season/", s.HandleSeasonsGetAllBySeries)
+
+	season := series.Group("/season/:season_number")
+	season.GET("/", s.HandleSeasonGet)
+	season.PUT("/", s.HandleSeasonPut)
+	season.PATCH("/", s.HandleSeasonUpdate)
+	season.DELETE("/", s.HandleSeasonInvalidate)
+	season.POST("/restore/", s.HandleSeasonRestore)
+	season.GET("/audits/", s.HandleSeasonAuditsGetAll)
 
-	episodes := series.Group("/season/:season_number/episode")
+	episodes := season.Group("/episode")
 	episodes.GET("/", s.HandleEpisodesGetAllBySeason)
 	episodes.PUT("/", s.HandleEpisodesPutAllBySeason)
 	episodes.DELETE("/", s.HandleEpisodesInvalidateAllBySeason)
+	episodes.POST("/restore/", s.HandleEpisodesRestoreAllBySeason)
 
 	episode := episodes.Group("/:episode_number")
 	episode.GET("/", s.HandleEpisodeGet)
-	episode.PUT("/", s.HandleEpisodePut)
-	episode.PATCH("/", s.HandleEpisodeUpdate)
-	episode.DELETE("/", s.HandleEpisodeInvalidate)
+	episode.PUT("/", s.HandleEpisodePut, s.PreconditionMiddleware)
+	episode.PATCH("/", s.HandleEpisodeUpdate, s.PreconditionMiddleware)
+	episode.DELETE("/", s.HandleEpisodeInvalidate, s.PreconditionMiddleware)
+	episode.POST("/restore/", s.HandleEpisodeRestore)
 	episode.GET("/audits/", s.HandleEpisodeAuditsGetAll)
+	episode.POST("/audits/:contributed_at/revert/", s.HandleEpisodeAuditRevert)
+
+	filmMedia := authorized.Group("/film/:id/media")
+	filmMedia.GET("/", s.HandleFilmMediaGetAll)
+	filmMedia.POST(
+		"/",
+		s.HandleFilmMediaUpload,
+		s.BodyLimitMiddleware(maxMediaUploadSize()),
+	)
+	filmMedia.GET("/:media_id/", s.HandleFilmMediaGet)
+	filmMedia.DELETE("/:media_id/", s.HandleFilmMediaInvalidate)
+
+	authorized.GET("/media/:key/", s.HandleMediaBlobGet)
+
+	artists := authorized.Group("/artist")
+	artists.POST("/", s.HandleArtistCreate, s.IdempotencyMiddleware)
+	artists.GET("/:id/", s.HandleArtistGet)
+
+	artistImages := artists.Group("/:id/image")
+	artistImages.GET("/", s.HandleArtistImagesGetAll)
+	artistImages.POST(
+		"/",
+		s.HandleArtistImageUpload,
+		s.BodyLimitMiddleware(maxImageUploadSize()),
+	)
+	artistImages.GET("/:image_id/", s.HandleArtistImageGet)
+	artistImages.DELETE("/:image_id/", s.HandleArtistImageInvalidate)
+
+	playlists := authorized.Group("/playlist")
+	playlists.GET("/", s.HandlePlaylistsGetAll)
+	playlists.POST("/", s.HandlePlaylistCreate)
+
+	playlist := playlists.Group("/:id")
+	playlist.GET("/", s.HandlePlaylistGet)
+	playlist.PATCH("/", s.HandlePlaylistUpdate)
+	playlist.DELETE("/", s.HandlePlaylistDelete)
+	playlist.GET("/cover/", s.HandlePlaylistCover)
+	playlist.POST("/watchlist/", s.HandlePlaylistAddToWatchlist)
+
+	playlistFilms := playlist.Group("/film")
+	playlistFilms.GET("/", s.HandlePlaylistFilmsGetAll)
+	playlistFilms.PUT("/", s.HandlePlaylistFilmsReorder)
+	playlistFilms.PUT("/:film_id/", s.HandlePlaylistFilmAdd)
+	playlistFilms.DELETE("/:film_id/", s.HandlePlaylistFilmRemove)
+
+	authorized.GET("/watchlist/", s.HandleWatchlistGetAll)
+	authorized.PUT("/watchlist/:id/watched/", s.HandleWatchlistMarkWatched)
+
+	posts := authorized.Group("/post")
+	posts.POST("/", s.HandlePostCreate)
+
+	post := posts.Group("/:id")
+	post.GET("/", s.HandlePostGet)
+	post.PATCH("/", s.HandlePostUpdate)
+	post.DELETE("/", s.HandlePostDelete)
+	post.GET("/reply/", s.HandlePostRepliesGetAll)
+	post.GET("/revision/", s.HandlePostRevisionsGetAll)
 }
 
 func (s *Server) GetHandler() http.Handler {
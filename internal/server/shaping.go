@@ -0,0 +1,42 @@
+package server
+
+import (
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+)
+
+// relations the response payloads of some handlers could embed
+const (
+	includeSeries        = "series"
+	includeSeasons       = "seasons"
+	includeEpisodesCount = "episodes_count"
+)
+
+// shapingJSONSerializer narrows the payloads of the responses down to the
+// fields asked for by the fields query param, along with the relations asked
+// to be included.
+type shapingJSONSerializer struct {
+	echo.DefaultJSONSerializer
+}
+
+func (s shapingJSONSerializer) Serialize(
+	c echo.Context,
+	i any,
+	indent string,
+) error {
+	res, isResponse := i.(*response.ResponseValue)
+	fields := FetchFieldsQueryParam(c.Request())
+	if !isResponse || res.Payload == nil || fields == nil {
+		return s.DefaultJSONSerializer.Serialize(c, i, indent)
+	}
+
+	included := splitList(c.QueryParam(config.Config.Shaping.Include.VarName))
+	payload, err := response.Shape(*res.Payload, append(fields, included...))
+	if err != nil {
+		return err
+	}
+	shaped := *res
+	shaped.Payload = &payload
+	return s.DefaultJSONSerializer.Serialize(c, &shaped, indent)
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watch-server/internal/tracing"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HeaderTraceparent carries the W3C trace context of a request
+const HeaderTraceparent = "traceparent"
+
+// TracingMiddleware records a span for each request, continuing the trace of
+// the caller if the request came with a traceparent header, and echoes the
+// trace context of the span back. The spans of the app, the repository and
+// Elasticsearch are its children.
+func (s *Server) TracingMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		propagator := otel.GetTextMapPropagator()
+
+		ctx := propagator.Extract(
+			req.Context(),
+			propagation.HeaderCarrier(req.Header),
+		)
+		ctx, span := tracing.Tracer().Start(
+			ctx,
+			req.Method+" "+c.Path(),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPMethodKey.String(req.Method),
+				semconv.HTTPRouteKey.String(c.Path()),
+				semconv.HTTPTargetKey.String(req.URL.Path),
+			),
+		)
+		defer span.End()
+		propagator.Inject(ctx, propagation.HeaderCarrier(c.Response().Header()))
+		c.SetRequest(req.WithContext(ctx))
+
+		if err := next(c); err != nil {
+			c.Error(err)
+		}
+
+		status := c.Response().Status
+		span.SetAttributes(semconv.HTTPStatusCodeKey.Int(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+		return nil
+	}
+}
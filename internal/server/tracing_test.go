@@ -0,0 +1,104 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	appServer "github.com/aria3ppp/watch-server/internal/server"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/aria3ppp/watch-server/internal/tracing"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingMiddleware(t *testing.T) {
+	require := require.New(t)
+
+	server, _, defaults, teardown, err := setup(OptEnableDefaultUser)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	// export the spans to memory
+	exporter := testutils.NewMemoryExporter()
+	shutdown := tracing.Setup(exporter, 1)
+	t.Cleanup(func() {
+		tracing.Setup(nil, config.Config.Servic.Tracing.SampleRatio)
+	})
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/user/{id}"
+
+	// the requests start traces of their own
+	e.GET(path).
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		Header(appServer.HeaderTraceparent).
+		Match("^00-[0-9a-f]{32}-[0-9a-f]{16}-01$")
+
+	// or continue the trace of the caller
+	traceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+	parentID := "00f067aa0ba902b7"
+	traceparent := e.GET(path).
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(
+			appServer.HeaderTraceparent,
+			"00-"+traceID+"-"+parentID+"-01",
+		).
+		Expect().
+		Status(http.StatusOK).
+		Header(appServer.HeaderTraceparent).
+		Match("^00-([0-9a-f]{32})-([0-9a-f]{16})-01$")
+	traceparent.Index(1).Equal(traceID)
+	traceparent.Index(2).NotEqual(parentID)
+	spanID := traceparent.Raw()[2]
+
+	// while the malformed ones are ignored
+	e.GET(path).
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader(appServer.HeaderTraceparent, "00-"+traceID+"-01").
+		Expect().
+		Status(http.StatusOK).
+		Header(appServer.HeaderTraceparent).
+		NotContains(traceID)
+
+	require.NoError(shutdown(context.Background()))
+
+	// the spans of the continued trace by their names
+	traced := make(map[string]tracetest.SpanStub)
+	for _, span := range exporter.GetSpans() {
+		if span.SpanContext.TraceID().String() == traceID {
+			traced[span.Name] = span
+		}
+	}
+
+	request := traced["GET /v1/authorized/user/:id/"]
+	require.Equal(spanID, request.SpanContext.SpanID().String())
+	require.Equal(parentID, request.Parent.SpanID().String())
+	require.Equal(
+		attribute.StringValue(strconv.Itoa(defaults.user.id)),
+		testutils.AttributeOf(request, "enduser.id"),
+	)
+	require.Equal(
+		attribute.Int64Value(200),
+		testutils.AttributeOf(request, "http.status_code"),
+	)
+
+	app := traced["app.UserGet"]
+	require.Equal(request.SpanContext.SpanID(), app.Parent.SpanID())
+	require.Equal(
+		attribute.Int64Value(int64(defaults.user.id)),
+		testutils.AttributeOf(app, "id"),
+	)
+
+	require.Equal(app.SpanContext.SpanID(), traced["repo.UserGet"].Parent.SpanID())
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/aria3ppp/watch-server/internal/app"
 	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/metrics"
+	"github.com/aria3ppp/watch-server/internal/models"
 	"github.com/aria3ppp/watch-server/internal/server/request"
 	"github.com/aria3ppp/watch-server/internal/server/response"
 	"github.com/aria3ppp/watch-server/internal/token"
@@ -21,7 +23,7 @@ func (s *Server) HandleUserGet(c echo.Context) error {
 		err = params.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleUserGet: parameter binding/validation failed",
 			zap.Error(err),
 		)
@@ -35,7 +37,7 @@ func (s *Server) HandleUserGet(c echo.Context) error {
 	user, err := s.app.UserGet(c.Request().Context(), params.ID)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleUserGet: user not found",
 				zap.Int("id", params.ID),
 			)
@@ -45,7 +47,7 @@ func (s *Server) HandleUserGet(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleUserGet: internal server error", zap.Error(err),
 		)
 		return echo.NewHTTPError(
@@ -68,7 +70,7 @@ func (s *Server) HandleUserCreate(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleUserCreate: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -82,7 +84,7 @@ func (s *Server) HandleUserCreate(c echo.Context) error {
 	userID, err := s.app.UserCreate(c.Request().Context(), &req)
 	if err != nil {
 		if err == app.ErrEmailAlreadyUsed {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleUserCreate: request email already used",
 			)
 			return echo.NewHTTPError(
@@ -91,7 +93,7 @@ func (s *Server) HandleUserCreate(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleUserCreate: internal server error", zap.Error(err),
 		)
 		return echo.NewHTTPError(
@@ -115,7 +117,7 @@ func (s *Server) HandleUserLogin(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleLoginUser: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -132,10 +134,13 @@ func (s *Server) HandleUserLogin(c echo.Context) error {
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleLoginUser: request email not found",
 				zap.String("email", req.Email),
 			)
+			metrics.LoginFailuresTotal.
+				WithLabelValues(metrics.ReasonEmailNotFound).
+				Inc()
 			return echo.NewHTTPError(
 				http.StatusBadRequest,
 				response.Error(response.StatusEmailNotFound),
@@ -143,16 +148,19 @@ func (s *Server) HandleUserLogin(c echo.Context) error {
 		}
 
 		if err == app.ErrIncorrectPassword {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleLoginUser: request password not matched",
 			)
+			metrics.LoginFailuresTotal.
+				WithLabelValues(metrics.ReasonIncorrectPassword).
+				Inc()
 			return echo.NewHTTPError(
 				http.StatusBadRequest,
 				response.Error(response.StatusIncorrectPassword),
 			)
 		}
 
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleLoginUser: internal server error",
 			zap.Error(err),
 		)
@@ -183,7 +191,10 @@ func (s *Server) HandleUserRefreshToken(c echo.Context) error {
 	auth := c.Request().Header.Get(echo.HeaderAuthorization)
 	refreshToken := token.ExtractTokenFromAuth(auth)
 	if refreshToken == "" {
-		s.logger.Info("server.HandleRefreshToken: token malformed or missing")
+		s.loggerOf(c).Info("server.HandleRefreshToken: token malformed or missing")
+		metrics.TokenValidationFailuresTotal.
+			WithLabelValues(metrics.ReasonTokenMissing).
+			Inc()
 		return echo.NewHTTPError(
 			http.StatusBadRequest,
 			response.Error(response.StatusTokenMissingOrMalformed),
@@ -197,17 +208,23 @@ func (s *Server) HandleUserRefreshToken(c echo.Context) error {
 	)
 	if err != nil {
 		if err == app.ErrTokenInvalid {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleRefreshToken: request refresh token not valid",
 				zap.String("token", refreshToken),
 			)
+			metrics.TokenValidationFailuresTotal.
+				WithLabelValues(metrics.ReasonTokenInvalid).
+				Inc()
 			return echo.NewHTTPError(
 				http.StatusBadRequest,
 				response.Error(response.StatusTokenInvalid),
 			)
 		}
+		if err == app.ErrUserDeletionPending {
+			return ErrUserDeletionPending
+		}
 
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleRefreshToken: internal server error", zap.Error(err),
 		)
 		return echo.NewHTTPError(
@@ -232,7 +249,7 @@ func (s *Server) HandleUserUpdate(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleUserUpdate: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -245,7 +262,7 @@ func (s *Server) HandleUserUpdate(c echo.Context) error {
 	// payload must exists
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleUserUpdate: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -259,7 +276,7 @@ func (s *Server) HandleUserUpdate(c echo.Context) error {
 	err = s.app.UserUpdate(c.Request().Context(), payload.UserID, &req)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleUserUpdate: user not found",
 				zap.Int("id", payload.UserID),
 			)
@@ -269,7 +286,7 @@ func (s *Server) HandleUserUpdate(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleUserUpdate: internal server error", zap.Error(err),
 		)
 		return echo.NewHTTPError(
@@ -293,7 +310,7 @@ func (s *Server) HandleUserEmailUpdate(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleUserEmailUpdate: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -306,7 +323,7 @@ func (s *Server) HandleUserEmailUpdate(c echo.Context) error {
 	// payload must exists
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleUserEmailUpdate: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -320,7 +337,7 @@ func (s *Server) HandleUserEmailUpdate(c echo.Context) error {
 	err = s.app.UserEmailUpdate(c.Request().Context(), payload.UserID, &req)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleUserEmailUpdate: user not found",
 				zap.Int("id", payload.UserID),
 			)
@@ -330,7 +347,7 @@ func (s *Server) HandleUserEmailUpdate(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleUserEmailUpdate: internal server error",
 			zap.Error(err),
 		)
@@ -355,7 +372,7 @@ func (s *Server) HandleUserPasswordUpdate(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleUserPasswordUpdate: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -368,7 +385,7 @@ func (s *Server) HandleUserPasswordUpdate(c echo.Context) error {
 	// payload must exists
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleUserPasswordUpdate: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -386,7 +403,7 @@ func (s *Server) HandleUserPasswordUpdate(c echo.Context) error {
 	)
 	if err != nil {
 		if err == app.ErrSameNewPassword {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleUserPasswordUpdate: same new password",
 			)
 			return echo.NewHTTPError(
@@ -396,7 +413,7 @@ func (s *Server) HandleUserPasswordUpdate(c echo.Context) error {
 		}
 
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleUserPasswordUpdate: user not found",
 				zap.Int("id", payload.UserID),
 			)
@@ -407,7 +424,7 @@ func (s *Server) HandleUserPasswordUpdate(c echo.Context) error {
 		}
 
 		if err == app.ErrIncorrectPassword {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleUserPasswordUpdate: request password not matched",
 			)
 			return echo.NewHTTPError(
@@ -416,7 +433,7 @@ func (s *Server) HandleUserPasswordUpdate(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleUserPasswordUpdate: internal server error",
 			zap.Error(err),
 		)
@@ -441,7 +458,7 @@ func (s *Server) HandleUserDelete(c echo.Context) error {
 		err = req.Validate()
 	}
 	if err != nil {
-		s.logger.Info(
+		s.loggerOf(c).Info(
 			"server.HandleUserDelete: request binding/validation failed",
 			zap.Error(err),
 		)
@@ -454,7 +471,7 @@ func (s *Server) HandleUserDelete(c echo.Context) error {
 	// payload must exists
 	payload := FetchUserPayload(c)
 	if payload == nil {
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleUserDelete: payload key not set on router context",
 			zap.String("payload key", PayloadKey),
 		)
@@ -468,7 +485,7 @@ func (s *Server) HandleUserDelete(c echo.Context) error {
 	err = s.app.UserDelete(c.Request().Context(), payload.UserID, &req)
 	if err != nil {
 		if err == app.ErrNotFound {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleUserDelete: user not found",
 				zap.Int("id", payload.UserID),
 			)
@@ -479,7 +496,7 @@ func (s *Server) HandleUserDelete(c echo.Context) error {
 		}
 
 		if err == app.ErrIncorrectPassword {
-			s.logger.Info(
+			s.loggerOf(c).Info(
 				"server.HandleUserDelete: request password not matched",
 			)
 			return echo.NewHTTPError(
@@ -488,7 +505,7 @@ func (s *Server) HandleUserDelete(c echo.Context) error {
 			)
 		}
 
-		s.logger.Error(
+		s.loggerOf(c).Error(
 			"server.HandleUserDelete: internal server error", zap.Error(err),
 		)
 		return echo.NewHTTPError(
@@ -500,3 +517,129 @@ func (s *Server) HandleUserDelete(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, response.OK(nil))
 }
+
+//------------------------------------------------------------------------------
+
+// POST /v1/authorized/user/restore/
+func (s *Server) HandleUserRestore(c echo.Context) error {
+	// payload must exists
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleUserRestore: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	// cancel pending deletion
+	err := s.app.UserRestore(c.Request().Context(), payload.UserID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleUserRestore: no pending deletion",
+				zap.Int("id", payload.UserID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleUserRestore: internal server error", zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, response.OK(nil))
+}
+
+//------------------------------------------------------------------------------
+
+// GET /v1/authorized/user/audits/
+func (s *Server) HandleUserAuditsGetAll(c echo.Context) error {
+	// payload must exists
+	payload := FetchUserPayload(c)
+	if payload == nil {
+		s.loggerOf(c).Error(
+			"server.HandleUserAuditsGetAll: payload key not set on router context",
+			zap.String("payload key", PayloadKey),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	var err error
+
+	// fetch audits, by cursor if asked for
+	var res *response.ResponseValue
+	if CursorPaginationRequested(c.Request()) {
+		cursor, perPage := FetchCursorPaginationQueryParams(c.Request())
+		var (
+			audits                 []*models.UsersAudit
+			prevCursor, nextCursor string
+		)
+		audits, prevCursor, nextCursor, err = s.app.UserAuditsGetPage(
+			c.Request().Context(),
+			payload.UserID,
+			cursor,
+			perPage,
+		)
+		res = response.Cursored(perPage, audits, prevCursor, nextCursor)
+	} else {
+		page, perPage, offset := FetchPaginationQueryParams(c.Request())
+		var (
+			audits []*models.UsersAudit
+			total  int
+		)
+		audits, total, err = s.app.UserAuditsGetAll(
+			c.Request().Context(),
+			payload.UserID,
+			offset,
+			perPage,
+		)
+		res = response.Paginated(page, perPage, audits, total)
+	}
+	if err != nil {
+		if err == app.ErrCursorInvalid {
+			s.loggerOf(c).Info(
+				"server.HandleUserAuditsGetAll: invalid cursor",
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				response.Error(response.StatusInvalidURLParameter),
+			)
+		}
+
+		if err == app.ErrNotFound {
+			s.loggerOf(c).Info(
+				"server.HandleUserAuditsGetAll: user not found",
+				zap.Int("id", payload.UserID),
+			)
+			return echo.NewHTTPError(
+				http.StatusNotFound,
+				response.Error(response.StatusNotFound),
+			)
+		}
+
+		s.loggerOf(c).Error(
+			"server.HandleUserAuditsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(
+			http.StatusInternalServerError,
+			response.Error(response.StatusInternalServerError),
+		)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,204 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/config"
+	"github.com/aria3ppp/watch-server/internal/dto"
+	"github.com/aria3ppp/watch-server/internal/models"
+	"github.com/aria3ppp/watch-server/internal/repo"
+	"github.com/aria3ppp/watch-server/internal/server/response"
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleUserFollow(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(
+		OptEnableDefaultUser,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/user/{id}/follow/"
+
+	// invalid id
+	e.PUT(path).
+		WithPath("id", -1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	// follow self
+	e.PUT(path).
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusFollowSelf))
+
+	// user not found
+	e.PUT(path).
+		WithPath("id", 999).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	followedID, err := appInstance.UserCreate(
+		ctx,
+		&dto.UserCreateRequest{Email: "followed@prog.net", Password: "pa$$W0RD1"},
+	)
+	require.NoError(err)
+
+	// not followed yet
+	e.DELETE(path).
+		WithPath("id", followedID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	// follow user
+	e.PUT(path).
+		WithPath("id", followedID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	follower, err := appInstance.UserGet(ctx, defaults.user.id)
+	require.NoError(err)
+	followed, err := appInstance.UserGet(ctx, followedID)
+	require.NoError(err)
+
+	e.GET("/v1/authorized/user/{id}/followers/").
+		WithPath("id", followedID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.Paginated(config.Config.Pagination.Page.MinValue, config.Config.Pagination.PageSize.DefaultValue, []*models.User{follower}, 1))
+
+	e.GET("/v1/authorized/user/{id}/following/").
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.Paginated(config.Config.Pagination.Page.MinValue, config.Config.Pagination.PageSize.DefaultValue, []*models.User{followed}, 1))
+
+	// unfollow user
+	e.DELETE(path).
+		WithPath("id", followedID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	e.GET("/v1/authorized/user/{id}/followers/").
+		WithPath("id", followedID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.Paginated(config.Config.Pagination.Page.MinValue, config.Config.Pagination.PageSize.DefaultValue, nil, 0))
+}
+
+func TestHandleFeedGet(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(
+		OptEnableDefaultUser,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/feed/"
+
+	// invalid cursor
+	e.GET(path).
+		WithQuery(config.Config.Pagination.Cursor.VarName, "!").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusInvalidURLParameter))
+
+	followedID, err := appInstance.UserCreate(
+		ctx,
+		&dto.UserCreateRequest{Email: "followed@prog.net", Password: "pa$$W0RD1"},
+	)
+	require.NoError(err)
+	err = appInstance.UserFollow(ctx, defaults.user.id, followedID)
+	require.NoError(err)
+
+	// the followed user contributes two movies
+	movieIDs := make([]int, 2)
+	for i := range movieIDs {
+		movieIDs[i], err = appInstance.MovieCreate(
+			ctx,
+			followedID,
+			&dto.MovieCreateRequest{
+				Title:        "movie",
+				DateReleased: testutils.Date(2000, 1, 1),
+			},
+		)
+		require.NoError(err)
+	}
+
+	// first page
+	firstPage := e.GET(path).
+		WithQuery(config.Config.Pagination.PageSize.VarName, 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	firstPage.ValueEqual("status", response.StatusOK.String())
+	firstPage.Value("payload").Array().Length().Equal(1)
+	activity := firstPage.Value("payload").Array().First().Object()
+	activity.ValueEqual("activity", repo.FeedActivityFilm)
+	activity.ValueEqual("user_id", followedID)
+	activity.ValueEqual("film_id", movieIDs[1])
+	nextCursor := firstPage.Value("next_cursor").String().NotEmpty().Raw()
+
+	// last page
+	lastPage := e.GET(path).
+		WithQuery(config.Config.Pagination.PageSize.VarName, 1).
+		WithQuery(config.Config.Pagination.Cursor.VarName, nextCursor).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	lastPage.NotContainsKey("next_cursor")
+	activity = lastPage.Value("payload").Array().First().Object()
+	activity.ValueEqual("film_id", movieIDs[0])
+}
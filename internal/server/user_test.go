@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strconv"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/aria3ppp/watch-server/internal/config"
 	"github.com/aria3ppp/watch-server/internal/dto"
 	"github.com/aria3ppp/watch-server/internal/models"
+	appServer "github.com/aria3ppp/watch-server/internal/server"
 	"github.com/aria3ppp/watch-server/internal/server/response"
 	"github.com/aria3ppp/watch-server/internal/testutils"
 	"github.com/aria3ppp/watch-server/internal/validator"
@@ -66,13 +68,14 @@ func TestHandleUserGet(t *testing.T) {
 	require.NoError(err)
 
 	payload := &models.User{
-		ID:        userID,
-		Email:     userCreateReq.Email,
-		FirstName: userCreateReq.FirstName,
-		LastName:  userCreateReq.LastName,
-		Bio:       userCreateReq.Bio,
-		Birthdate: userCreateReq.Birthdate,
-		Joindate:  gotUser.Joindate,
+		ID:            userID,
+		Email:         userCreateReq.Email,
+		FirstName:     userCreateReq.FirstName,
+		LastName:      userCreateReq.LastName,
+		Bio:           userCreateReq.Bio,
+		Birthdate:     userCreateReq.Birthdate,
+		Joindate:      gotUser.Joindate,
+		ContributedAt: gotUser.ContributedAt,
 	}
 
 	// get user
@@ -133,6 +136,7 @@ func TestHandleUserCreate(t *testing.T) {
 		Bio:            userCreateReq.Bio,
 		Birthdate:      userCreateReq.Birthdate,
 		Joindate:       gotUser.Joindate,
+		ContributedAt:  gotUser.ContributedAt,
 	}, gotUser)
 
 	// email address already taken
@@ -530,24 +534,17 @@ func TestHandleUserUpdate(t *testing.T) {
 		require.Equal(userUpdateReq.Birthdate, updatedUser.Birthdate)
 	}
 
-	// user not found
-	err = appInstance.UserDelete(
-		context.Background(),
-		defaults.user.id,
-		&dto.UserDeleteRequest{
-			Password: defaults.user.password,
-		},
-	)
-	require.NoError(err)
+	// the tokens of the anonymized user are rejected
+	anonymizeUser(t, appInstance, defaults.user.id, defaults.user.password)
 
 	e.Request(method, path).
 		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
 		WithJSON(&dto.UserUpdateRequest{}).
 		Expect().
-		Status(http.StatusNotFound).
+		Status(http.StatusUnauthorized).
 		JSON().
 		Object().
-		Equal(response.Error(response.StatusNotFound))
+		Equal(response.Error(response.StatusTokenInvalid))
 }
 
 func TestHandleUserUpdate_ValidateRequest(t *testing.T) {
@@ -756,8 +753,35 @@ func TestHandleUserUpdate_ValidateRequest(t *testing.T) {
 	}
 }
 
+// anonymizeUser deletes the user right away instead of waiting for the grace
+// period to pass.
+func anonymizeUser(
+	t *testing.T,
+	appInstance *app.Application,
+	userID int,
+	password string,
+) {
+	ctx := context.Background()
+
+	err := appInstance.UserDelete(
+		ctx,
+		userID,
+		&dto.UserDeleteRequest{Password: password},
+	)
+	require.NoError(t, err)
+
+	deletion := config.Config.Account.Deletion
+	config.Config.Account.Deletion.GracePeriodInDays = 0
+	t.Cleanup(func() { config.Config.Account.Deletion = deletion })
+
+	anonymized, err := appInstance.UsersAnonymize(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, anonymized)
+}
+
 func TestHandleUserDelete(t *testing.T) {
 	require := require.New(t)
+	ctx := context.Background()
 
 	server, appInstance, defaults, teardown, err := setup(OptEnableDefaultUser)
 	require.NoError(err)
@@ -766,6 +790,7 @@ func TestHandleUserDelete(t *testing.T) {
 	e := httpexpect.New(t, server.URL)
 	path := "/v1/authorized/user/"
 	method := http.MethodDelete
+	restorePath := "/v1/authorized/user/restore/"
 
 	// incorrect password
 	e.Request(method, path).
@@ -777,6 +802,15 @@ func TestHandleUserDelete(t *testing.T) {
 		Object().
 		Equal(response.Error(response.StatusIncorrectPassword))
 
+	// nothing to restore
+	e.POST(restorePath).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
 	// delete user
 	e.Request(method, path).
 		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
@@ -787,23 +821,110 @@ func TestHandleUserDelete(t *testing.T) {
 		Object().
 		Equal(response.OK(nil))
 
-	// check deleted
-	userAfterDelete, err := appInstance.UserGet(
-		context.Background(),
+	// the user is let through only to restore their account
+	e.GET("/v1/authorized/user/{id}/").
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusForbidden).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusUserDeletionPending))
+
+	e.GET("/v1/user/refresh/").
+		WithHeader(echo.HeaderAuthorization, defaults.user.refreshAuth).
+		Expect().
+		Status(http.StatusForbidden).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusUserDeletionPending))
+
+	// the user stays untouched during the grace period
+	user, err := appInstance.UserGet(ctx, defaults.user.id)
+	require.NoError(err)
+	require.Equal(defaults.user.email, user.Email)
+	require.False(user.DeletedAt.Valid)
+
+	anonymized, err := appInstance.UsersAnonymize(ctx)
+	require.NoError(err)
+	require.Equal(0, anonymized)
+
+	// restore user
+	e.POST(restorePath).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(response.OK(nil))
+
+	e.POST(restorePath).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusNotFound))
+
+	// the user contributions outlive them
+	movieID, err := appInstance.MovieCreate(
+		ctx,
 		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
 	)
-	require.Nil(userAfterDelete)
-	require.Equal(app.ErrNotFound, err)
+	require.NoError(err)
 
-	// user not found
+	anonymizeUser(t, appInstance, defaults.user.id, defaults.user.password)
+
+	// check anonymized
+	user, err = appInstance.UserGet(ctx, defaults.user.id)
+	require.NoError(err)
+	require.True(user.DeletedAt.Valid)
+	require.NotEqual(defaults.user.email, user.Email)
+	require.Empty(user.HashedPassword)
+
+	movie, err := appInstance.MovieGet(ctx, movieID, false)
+	require.NoError(err)
+	require.Equal(defaults.user.id, movie.ContributedBy)
+
+	// the email is free to sign up with again
+	_, err = appInstance.UserCreate(
+		ctx,
+		&dto.UserCreateRequest{
+			Email:    defaults.user.email,
+			Password: defaults.user.password,
+		},
+	)
+	require.NoError(err)
+
+	// the tokens of the anonymized user are rejected
 	e.Request(method, path).
 		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
 		WithJSON(&dto.UserDeleteRequest{Password: defaults.user.password}).
 		Expect().
-		Status(http.StatusNotFound).
+		Status(http.StatusUnauthorized).
 		JSON().
 		Object().
-		Equal(response.Error(response.StatusNotFound))
+		Equal(response.Error(response.StatusTokenInvalid))
+
+	e.POST(restorePath).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusUnauthorized).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusTokenInvalid))
+
+	e.GET("/v1/user/refresh").
+		WithHeader(echo.HeaderAuthorization, defaults.user.refreshAuth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusTokenInvalid))
 }
 
 func TestHandleUserDelete_ValidateRequest(t *testing.T) {
@@ -934,26 +1055,22 @@ func TestHandleUserEmailUpdate(t *testing.T) {
 			Bio:            defaults.user.reqObject.Bio,
 			Birthdate:      defaults.user.reqObject.Birthdate,
 			Joindate:       gotUser.Joindate,
+			ContributedAt:  gotUser.ContributedAt,
 		},
 		gotUser,
 	)
 
-	// user not found
-	err = appInstance.UserDelete(
-		context.Background(),
-		defaults.user.id,
-		&dto.UserDeleteRequest{Password: defaults.user.password},
-	)
-	require.NoError(err)
+	// the tokens of the anonymized user are rejected
+	anonymizeUser(t, appInstance, defaults.user.id, defaults.user.password)
 
 	e.Request(method, path).
 		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
 		WithJSON(userEmailUpdateReq).
 		Expect().
-		Status(http.StatusNotFound).
+		Status(http.StatusUnauthorized).
 		JSON().
 		Object().
-		Equal(response.Error(response.StatusNotFound))
+		Equal(response.Error(response.StatusTokenInvalid))
 }
 
 func TestHandleUserEmailUpdate_ValidateRequest(t *testing.T) {
@@ -1108,17 +1225,13 @@ func TestHandleUserPasswordUpdate(t *testing.T) {
 			Bio:            defaults.user.reqObject.Bio,
 			Birthdate:      defaults.user.reqObject.Birthdate,
 			Joindate:       gotUser.Joindate,
+			ContributedAt:  gotUser.ContributedAt,
 		},
 		gotUser,
 	)
 
-	// user not found
-	err = appInstance.UserDelete(
-		context.Background(),
-		defaults.user.id,
-		&dto.UserDeleteRequest{Password: newPassword},
-	)
-	require.NoError(err)
+	// the tokens of the anonymized user are rejected
+	anonymizeUser(t, appInstance, defaults.user.id, newPassword)
 
 	e.Request(method, path).
 		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
@@ -1127,10 +1240,10 @@ func TestHandleUserPasswordUpdate(t *testing.T) {
 			NewPassword:     newPassword,
 		}).
 		Expect().
-		Status(http.StatusNotFound).
+		Status(http.StatusUnauthorized).
 		JSON().
 		Object().
-		Equal(response.Error(response.StatusNotFound))
+		Equal(response.Error(response.StatusTokenInvalid))
 }
 
 func TestHandleUserPasswordUpdate_ValidateRequest(t *testing.T) {
@@ -1302,6 +1415,117 @@ func TestHandleUserLogin(t *testing.T) {
 	payloadObj.Value("refresh_token").String().NotEmpty()
 }
 
+func TestHandleUserLogin_RateLimit(t *testing.T) {
+	require := require.New(t)
+
+	server, _, defaults, teardown, err := setup(
+		OptEnableDefaultUser | OptEnableRateLimit,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/user/login"
+	method := http.MethodPost
+	burst := config.Config.RateLimit.Login.Burst
+
+	loginReq := dto.UserLoginRequest{
+		Email:    defaults.user.email,
+		Password: "1nc0RR3ct_pa$$",
+	}
+
+	// the burst is let through
+	for remaining := burst - 1; remaining >= 0; remaining-- {
+		resp := e.Request(method, path).
+			WithJSON(loginReq).
+			Expect().
+			Status(http.StatusBadRequest)
+		resp.Header(appServer.HeaderXRateLimitLimit).
+			Equal(strconv.Itoa(burst))
+		resp.Header(appServer.HeaderXRateLimitRemaining).
+			Equal(strconv.Itoa(remaining))
+		resp.Header(echo.HeaderRetryAfter).Empty()
+	}
+
+	// then the client is throttled
+	resp := e.Request(method, path).
+		WithJSON(loginReq).
+		Expect().
+		Status(http.StatusTooManyRequests)
+	resp.Header(appServer.HeaderXRateLimitRemaining).Equal("0")
+	resp.Header(echo.HeaderRetryAfter).NotEmpty()
+	resp.JSON().
+		Object().
+		Equal(response.Error(response.StatusTooManyRequests))
+
+	// whatever client ip it claims to have
+	e.Request(method, path).
+		WithHeader(echo.HeaderXForwardedFor, "203.0.113.1").
+		WithHeader(echo.HeaderXRealIP, "203.0.113.2").
+		WithJSON(loginReq).
+		Expect().
+		Status(http.StatusTooManyRequests)
+
+	// while the other route groups are not
+	e.GET("/v1/authorized/user/{id}").
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		Header(appServer.HeaderXRateLimitLimit).
+		Equal(strconv.Itoa(config.Config.RateLimit.Read.Burst))
+}
+
+func TestHandleUserLogin_RateLimitBehindProxy(t *testing.T) {
+	require := require.New(t)
+
+	// the test server is reached through the loopback
+	trustedProxies := config.Config.Servic.Server.TrustedProxies
+	config.Config.Servic.Server.TrustedProxies = []string{"127.0.0.1/32", "::1/128"}
+	t.Cleanup(func() {
+		config.Config.Servic.Server.TrustedProxies = trustedProxies
+	})
+
+	server, _, defaults, teardown, err := setup(
+		OptEnableDefaultUser | OptEnableRateLimit,
+	)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/user/login"
+	method := http.MethodPost
+	burst := config.Config.RateLimit.Login.Burst
+
+	loginReq := dto.UserLoginRequest{
+		Email:    defaults.user.email,
+		Password: "1nc0RR3ct_pa$$",
+	}
+
+	// the client is told by the address the proxy forwarded it for
+	for i := 0; i < burst; i++ {
+		e.Request(method, path).
+			WithHeader(echo.HeaderXForwardedFor, "203.0.113.1").
+			WithJSON(loginReq).
+			Expect().
+			Status(http.StatusBadRequest)
+	}
+
+	// the entries it adds itself ahead of it are not believed
+	e.Request(method, path).
+		WithHeader(echo.HeaderXForwardedFor, "198.51.100.1, 203.0.113.1").
+		WithJSON(loginReq).
+		Expect().
+		Status(http.StatusTooManyRequests)
+
+	// while the other clients behind the proxy are let through
+	e.Request(method, path).
+		WithHeader(echo.HeaderXForwardedFor, "203.0.113.2").
+		WithJSON(loginReq).
+		Expect().
+		Status(http.StatusBadRequest)
+}
+
 func TestHandleUserLogin_ValidateRequest(t *testing.T) {
 	require := require.New(t)
 
@@ -1491,3 +1715,92 @@ func TestHandleUserRefreshToken(t *testing.T) {
 		Value("payload").
 		String().NotEmpty()
 }
+
+func TestHandleUserAuditsGetAll(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown, err := setup(OptEnableDefaultUser)
+	require.NoError(err)
+	t.Cleanup(func() { teardown() })
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/user/audits"
+	method := http.MethodGet
+
+	// no audits yet
+	e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(
+			response.Paginated(
+				config.Config.Pagination.Page.MinValue,
+				config.Config.Pagination.PageSize.DefaultValue,
+				nil,
+				0,
+			),
+		)
+
+	// change email and then password
+	err = appInstance.UserEmailUpdate(
+		ctx,
+		defaults.user.id,
+		&dto.UserEmailUpdateRequest{Email: "email@gmail.com"},
+	)
+	require.NoError(err)
+	err = appInstance.UserPasswordUpdate(
+		ctx,
+		defaults.user.id,
+		&dto.UserPasswordUpdateRequest{
+			CurrentPassword: defaults.user.password,
+			NewPassword:     "new_pa$$W0RD1",
+		},
+	)
+	require.NoError(err)
+
+	expAudits, total, err := appInstance.UserAuditsGetAll(
+		ctx,
+		defaults.user.id,
+		0,
+		config.Config.Pagination.PageSize.DefaultValue,
+	)
+	require.NoError(err)
+	require.Equal(2, total)
+	require.Equal("email@gmail.com", expAudits[0].Email)
+	require.Equal(defaults.user.email, expAudits[1].Email)
+
+	// password hashes are not audited
+	audits := e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	audits.Equal(
+		response.Paginated(
+			config.Config.Pagination.Page.MinValue,
+			config.Config.Pagination.PageSize.DefaultValue,
+			expAudits,
+			total,
+		),
+	)
+	audits.Value("payload").
+		Array().
+		Element(0).
+		Object().
+		NotContainsKey("hashed_password")
+
+	// the tokens of the anonymized user are rejected
+	anonymizeUser(t, appInstance, defaults.user.id, "new_pa$$W0RD1")
+
+	e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusUnauthorized).
+		JSON().
+		Object().
+		Equal(response.Error(response.StatusTokenInvalid))
+}
@@ -0,0 +1,59 @@
+package storage_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocal(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	local, err := storage.NewLocal(t.TempDir())
+	require.NoError(err)
+
+	// no blob yet
+	_, err = local.Get(ctx, "key")
+	require.Equal(storage.ErrNotFound, err)
+	err = local.Delete(ctx, "key")
+	require.Equal(storage.ErrNotFound, err)
+
+	// put and get blob
+	err = local.Put(ctx, "key", strings.NewReader("content"))
+	require.NoError(err)
+
+	blob, err := local.Get(ctx, "key")
+	require.NoError(err)
+	content, err := io.ReadAll(blob)
+	require.NoError(err)
+	require.NoError(blob.Close())
+	require.Equal("content", string(content))
+
+	// delete blob
+	err = local.Delete(ctx, "key")
+	require.NoError(err)
+	_, err = local.Get(ctx, "key")
+	require.Equal(storage.ErrNotFound, err)
+}
+
+func TestLocalInvalidKey(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	local, err := storage.NewLocal(t.TempDir())
+	require.NoError(err)
+
+	for _, key := range []string{"", "../key", "dir/key", `dir\key`, ".key"} {
+		err = local.Put(ctx, key, strings.NewReader("content"))
+		require.Equal(storage.ErrInvalidKey, err, key)
+		_, err = local.Get(ctx, key)
+		require.Equal(storage.ErrInvalidKey, err, key)
+		err = local.Delete(ctx, key)
+		require.Equal(storage.ErrInvalidKey, err, key)
+	}
+}
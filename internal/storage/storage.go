@@ -0,0 +1,15 @@
+package storage
+
+import (
+	"context"
+	"io"
+)
+
+//go:generate mockgen -destination mock_storage/mock_service.go . Service
+
+// Service stores blobs by key. Keys are flat, slash-free names.
+type Service interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,10 @@
+package testutils
+
+import "net/http"
+
+// RoundTripperFunc lets a func stand for the transport of a client
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,29 @@
+package testutils
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// MemoryExporter keeps the spans exported past its shutdown
+type MemoryExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+func NewMemoryExporter() MemoryExporter {
+	return MemoryExporter{tracetest.NewInMemoryExporter()}
+}
+
+func (MemoryExporter) Shutdown(context.Context) error { return nil }
+
+// AttributeOf returns the value of the attribute of span keyed key
+func AttributeOf(span tracetest.SpanStub, key string) attribute.Value {
+	for _, attr := range span.Attributes {
+		if string(attr.Key) == key {
+			return attr.Value
+		}
+	}
+	return attribute.Value{}
+}
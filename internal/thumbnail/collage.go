@@ -0,0 +1,62 @@
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"io"
+)
+
+var ErrNoImages = errors.New("thumbnail: no images")
+
+// Collage builds a square jpeg cover of size pixels: a 2x2 grid of the first
+// four images, or just the first image if there are fewer than four. Images
+// are center cropped to squares.
+func Collage(srcs []io.Reader, size int) ([]byte, error) {
+	if len(srcs) == 0 {
+		return nil, ErrNoImages
+	}
+
+	grid := 1
+	if len(srcs) >= 4 {
+		grid = 2
+	}
+	tile := atLeastOne(size / grid)
+
+	dst := image.NewRGBA(image.Rect(0, 0, tile*grid, tile*grid))
+	for i := 0; i < grid*grid; i++ {
+		img, _, err := image.Decode(srcs[i])
+		if err != nil {
+			return nil, err
+		}
+		x, y := (i%grid)*tile, (i/grid)*tile
+		drawSquare(dst, image.Rect(x, y, x+tile, y+tile), img)
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, nil); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// drawSquare scales the centered square of img into rect.
+func drawSquare(dst draw.Image, rect image.Rectangle, img image.Image) {
+	bounds := img.Bounds()
+	side := bounds.Dx()
+	if bounds.Dy() < side {
+		side = bounds.Dy()
+	}
+	minX := bounds.Min.X + (bounds.Dx()-side)/2
+	minY := bounds.Min.Y + (bounds.Dy()-side)/2
+
+	for y := 0; y < rect.Dy(); y++ {
+		srcY := minY + y*side/rect.Dy()
+		for x := 0; x < rect.Dx(); x++ {
+			srcX := minX + x*side/rect.Dx()
+			dst.Set(rect.Min.X+x, rect.Min.Y+y, img.At(srcX, srcY))
+		}
+	}
+}
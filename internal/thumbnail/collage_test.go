@@ -0,0 +1,89 @@
+package thumbnail_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/thumbnail"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollage(t *testing.T) {
+	t.Parallel()
+
+	colors := []color.RGBA{
+		{R: 255, A: 255},
+		{G: 255, A: 255},
+		{B: 255, A: 255},
+		{R: 255, G: 255, A: 255},
+	}
+
+	encode := func(c color.RGBA, width, height int) io.Reader {
+		src := image.NewRGBA(image.Rect(0, 0, width, height))
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				src.Set(x, y, c)
+			}
+		}
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, src))
+		return &buf
+	}
+
+	type TestCase struct {
+		name     string
+		nImages  int
+		expTiles int
+	}
+
+	testCases := []TestCase{
+		{name: "single image", nImages: 1, expTiles: 1},
+		{name: "fewer than four", nImages: 3, expTiles: 1},
+		{name: "grid", nImages: 4, expTiles: 4},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			srcs := make([]io.Reader, tc.nImages)
+			for i := range srcs {
+				srcs[i] = encode(colors[i], 40+i*10, 30)
+			}
+
+			cover, err := thumbnail.Collage(srcs, 100)
+			require.NoError(err)
+
+			img, err := jpeg.Decode(bytes.NewReader(cover))
+			require.NoError(err)
+			require.Equal(100, img.Bounds().Dx())
+			require.Equal(100, img.Bounds().Dy())
+
+			// the center of each tile keeps the color of its image
+			grid := 1
+			if tc.expTiles == 4 {
+				grid = 2
+			}
+			tile := 100 / grid
+			for i := 0; i < tc.expTiles; i++ {
+				x, y := (i%grid)*tile+tile/2, (i/grid)*tile+tile/2
+				r, g, b, _ := img.At(x, y).RGBA()
+				require.InDelta(colors[i].R, r>>8, 16)
+				require.InDelta(colors[i].G, g>>8, 16)
+				require.InDelta(colors[i].B, b>>8, 16)
+			}
+		})
+	}
+}
+
+func TestCollageNoImages(t *testing.T) {
+	_, err := thumbnail.Collage(nil, 100)
+	require.Equal(t, thumbnail.ErrNoImages, err)
+}
@@ -0,0 +1,74 @@
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+)
+
+var ErrTooManyPixels = errors.New("thumbnail: too many pixels")
+
+// Generate decodes a jpeg, png or gif image and returns a jpeg encoded copy
+// scaled down to fit in a maxDimension square. Smaller images are not scaled.
+// Images of more than maxPixels pixels fail with ErrTooManyPixels before
+// being decoded, as a small file could declare a huge image.
+func Generate(src io.Reader, maxDimension int, maxPixels int) ([]byte, error) {
+	// the header read for the dimensions is read again by the decoding
+	var header bytes.Buffer
+	imageConfig, _, err := image.DecodeConfig(io.TeeReader(src, &header))
+	if err != nil {
+		return nil, err
+	}
+	if imageConfig.Width*imageConfig.Height > maxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(io.MultiReader(&header, src))
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > maxDimension || height > maxDimension {
+		if width >= height {
+			height = atLeastOne(height * maxDimension / width)
+			width = maxDimension
+		} else {
+			width = atLeastOne(width * maxDimension / height)
+			height = maxDimension
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	if width == bounds.Dx() && height == bounds.Dy() {
+		draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Src)
+	} else {
+		// nearest neighbour is good enough for a preview
+		for y := 0; y < height; y++ {
+			srcY := bounds.Min.Y + y*bounds.Dy()/height
+			for x := 0; x < width; x++ {
+				srcX := bounds.Min.X + x*bounds.Dx()/width
+				dst.Set(x, y, img.At(srcX, srcY))
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, nil); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func atLeastOne(n int) int {
+	if n < 1 {
+		return 1
+	}
+	return n
+}
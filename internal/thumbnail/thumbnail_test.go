@@ -0,0 +1,84 @@
+package thumbnail_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/thumbnail"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	type TestCase struct {
+		name          string
+		width, height int
+		maxDimension  int
+		expW, expH    int
+	}
+
+	testCases := []TestCase{
+		{name: "landscape", width: 400, height: 200, maxDimension: 100, expW: 100, expH: 50},
+		{name: "portrait", width: 200, height: 400, maxDimension: 100, expW: 50, expH: 100},
+		{name: "thin", width: 1000, height: 2, maxDimension: 100, expW: 100, expH: 1},
+		{name: "small", width: 20, height: 10, maxDimension: 100, expW: 20, expH: 10},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			src := image.NewRGBA(image.Rect(0, 0, tc.width, tc.height))
+			for y := 0; y < tc.height; y++ {
+				for x := 0; x < tc.width; x++ {
+					src.Set(x, y, color.RGBA{R: 255, A: 255})
+				}
+			}
+			var buf bytes.Buffer
+			require.NoError(png.Encode(&buf, src))
+
+			thumb, err := thumbnail.Generate(&buf, tc.maxDimension, 400*400)
+			require.NoError(err)
+
+			img, err := jpeg.Decode(bytes.NewReader(thumb))
+			require.NoError(err)
+			require.Equal(tc.expW, img.Bounds().Dx())
+			require.Equal(tc.expH, img.Bounds().Dy())
+		})
+	}
+}
+
+func TestGenerateInvalidImage(t *testing.T) {
+	_, err := thumbnail.Generate(strings.NewReader("not an image"), 100, 100)
+	require.Equal(t, image.ErrFormat, err)
+}
+
+func TestGenerateTooManyPixels(t *testing.T) {
+	require := require.New(t)
+
+	// a png header declaring a 100000x100000 image, which is never decoded
+	var buf bytes.Buffer
+	require.NoError(png.Encode(&buf, image.NewGray(image.Rect(0, 0, 1, 1))))
+	header := buf.Bytes()[:33]
+	binary.BigEndian.PutUint32(header[16:20], 100000)
+	binary.BigEndian.PutUint32(header[20:24], 100000)
+	binary.BigEndian.PutUint32(header[29:33], crc32.ChecksumIEEE(header[12:29]))
+	_, err := thumbnail.Generate(bytes.NewReader(header), 100, 1000*1000)
+	require.Equal(thumbnail.ErrTooManyPixels, err)
+
+	// while the images within the limit are decoded
+	buf.Reset()
+	require.NoError(png.Encode(&buf, image.NewGray(image.Rect(0, 0, 1000, 1000))))
+	_, err = thumbnail.Generate(&buf, 100, 1000*1000)
+	require.NoError(err)
+}
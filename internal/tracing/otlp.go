@@ -0,0 +1,41 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// NewOTLP returns an exporter posting the spans to an OpenTelemetry collector
+// by the OTLP/HTTP protocol. endpoint is the url of the traces of the
+// collector such as http://localhost:4318/v1/traces, sent to in the clear
+// over http.
+func NewOTLP(endpoint string) (sdktrace.SpanExporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("tracing.NewOTLP: no host in %q", endpoint)
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(u.Host)}
+	if u.Path != "" {
+		opts = append(opts, otlptracehttp.WithURLPath(u.Path))
+	}
+	switch u.Scheme {
+	case "http":
+		opts = append(opts, otlptracehttp.WithInsecure())
+	case "https":
+	default:
+		return nil, fmt.Errorf(
+			"tracing.NewOTLP: unknown scheme %q in %q",
+			u.Scheme,
+			endpoint,
+		)
+	}
+	return otlptracehttp.New(context.Background(), opts...)
+}
@@ -0,0 +1,343 @@
+// tracegen generates a decorator of an interface recording a span for each
+// call to its methods taking a context. The integer params named after ids or
+// numbers are recorded as the attributes of the spans.
+//
+//	tracegen -source app.go -type Service -prefix app -destination service_traced.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const tracingImportPath = "github.com/aria3ppp/watch-server/internal/tracing"
+
+func main() {
+	source := flag.String("source", "", "file declaring the interface")
+	typeName := flag.String("type", "", "name of the interface")
+	prefix := flag.String("prefix", "", "prefix of the span names")
+	destination := flag.String("destination", "", "file to write to")
+	flag.Parse()
+	if *source == "" || *typeName == "" || *prefix == "" || *destination == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	code, err := generate(*source, *typeName, *prefix)
+	if err != nil {
+		log.Fatalf("tracegen: %s", err)
+	}
+	if err := os.WriteFile(*destination, code, 0o644); err != nil {
+		log.Fatalf("tracegen: %s", err)
+	}
+}
+
+func generate(source, typeName, prefix string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, source, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var iface *ast.InterfaceType
+	ast.Inspect(file, func(node ast.Node) bool {
+		if spec, ok := node.(*ast.TypeSpec); ok && spec.Name.Name == typeName {
+			iface, _ = spec.Type.(*ast.InterfaceType)
+			return false
+		}
+		return true
+	})
+	if iface == nil {
+		return nil, fmt.Errorf("interface %s not found in %s", typeName, source)
+	}
+
+	g := &generator{
+		fset:       fset,
+		typeName:   typeName,
+		structName: "traced" + typeName,
+		prefix:     prefix,
+		imports:    importsOf(file),
+		used:       map[string]bool{},
+	}
+	for _, field := range iface.Methods.List {
+		funcType, ok := field.Type.(*ast.FuncType)
+		if !ok {
+			return nil, fmt.Errorf(
+				"embedded %s in %s is not supported",
+				g.expr(field.Type),
+				typeName,
+			)
+		}
+		for _, name := range field.Names {
+			g.method(name.Name, funcType)
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by tracegen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", file.Name.Name)
+	// the standard library imports go first, as goimports groups them
+	std := []string{}
+	others := []string{
+		strconv.Quote(tracingImportPath),
+		strconv.Quote("go.opentelemetry.io/otel/attribute"),
+	}
+	for _, spec := range file.Imports {
+		if !g.used[importNameOf(spec)] {
+			continue
+		}
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		line := spec.Path.Value
+		if spec.Name != nil {
+			line = spec.Name.Name + " " + line
+		}
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			others = append(others, line)
+		} else {
+			std = append(std, line)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+	fmt.Fprintf(&out, "import (\n")
+	for _, line := range std {
+		fmt.Fprintf(&out, "\t%s\n", line)
+	}
+	fmt.Fprintf(&out, "\n")
+	for _, line := range others {
+		fmt.Fprintf(&out, "\t%s\n", line)
+	}
+	fmt.Fprintf(&out, ")\n\n")
+	fmt.Fprintf(
+		&out,
+		"// %s records a span for each call to the methods of next\n",
+		g.structName,
+	)
+	fmt.Fprintf(&out, "type %s struct {\n\tnext %s\n}\n\n", g.structName, typeName)
+	fmt.Fprintf(&out, "var _ %s = &%s{}\n", typeName, g.structName)
+	out.Write(g.body.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+type generator struct {
+	fset       *token.FileSet
+	typeName   string
+	structName string
+	prefix     string
+	// the names the imports of the source are referred to by
+	imports map[string]bool
+	// the names of the imports the generated code uses
+	used map[string]bool
+	body bytes.Buffer
+}
+
+func (g *generator) method(name string, funcType *ast.FuncType) {
+	type param struct {
+		name, typ string
+		variadic  bool
+		attribute string
+	}
+
+	var params []param
+	for _, field := range fieldsOf(funcType.Params) {
+		_, variadic := field.Type.(*ast.Ellipsis)
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{{Name: "_"}}
+		}
+		for _, ident := range names {
+			p := param{
+				name:     ident.Name,
+				typ:      g.expr(field.Type),
+				variadic: variadic,
+			}
+			if p.name == "_" {
+				p.name = "p" + strconv.Itoa(len(params))
+			}
+			p.attribute = attributeOf(p.name, p.typ)
+			params = append(params, p)
+		}
+	}
+
+	var results []string
+	for _, field := range fieldsOf(funcType.Results) {
+		count := len(field.Names)
+		if count == 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			results = append(results, g.expr(field.Type))
+		}
+	}
+
+	traced := len(params) > 0 && params[0].typ == "context.Context"
+	returnsErr := len(results) > 0 && results[len(results)-1] == "error"
+
+	var paramList, argList []string
+	for i, p := range params {
+		typ := p.typ
+		arg := p.name
+		if traced && i == 0 {
+			p.name, arg = "ctx", "ctx"
+		}
+		if p.variadic {
+			arg += "..."
+		}
+		paramList = append(paramList, p.name+" "+typ)
+		argList = append(argList, arg)
+	}
+
+	var resultList string
+	if len(results) > 0 {
+		names := make([]string, len(results))
+		for i, typ := range results {
+			names[i] = "_ " + typ
+		}
+		if traced && returnsErr {
+			names[len(names)-1] = "err error"
+		}
+		resultList = "(" + strings.Join(names, ", ") + ")"
+	}
+
+	call := fmt.Sprintf("s.next.%s(%s)", name, strings.Join(argList, ", "))
+	if len(results) > 0 {
+		call = "return " + call
+	}
+
+	fmt.Fprintf(
+		&g.body,
+		"\nfunc (s *%s) %s(%s) %s {\n",
+		g.structName,
+		name,
+		strings.Join(paramList, ", "),
+		resultList,
+	)
+	if traced {
+		var attrs []string
+		for _, p := range params[1:] {
+			if p.attribute != "" {
+				attrs = append(attrs, p.attribute)
+			}
+		}
+		spanArgs := append(
+			[]string{"ctx", strconv.Quote(g.prefix + "." + name)},
+			attrs...,
+		)
+		fmt.Fprintf(
+			&g.body,
+			"ctx, span := tracing.Start(%s)\n",
+			strings.Join(spanArgs, ", "),
+		)
+		if returnsErr {
+			fmt.Fprintf(&g.body, "defer func() { tracing.End(span, err) }()\n")
+		} else {
+			fmt.Fprintf(&g.body, "defer span.End()\n")
+		}
+	}
+	fmt.Fprintf(&g.body, "%s\n}\n", call)
+}
+
+// expr prints expr as in the source, noting the imports it refers to
+func (g *generator) expr(expr ast.Expr) string {
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok && g.imports[ident.Name] {
+				g.used[ident.Name] = true
+			}
+		}
+		return true
+	})
+	var buf bytes.Buffer
+	printer.Fprint(&buf, g.fset, expr)
+	return buf.String()
+}
+
+func fieldsOf(list *ast.FieldList) []*ast.Field {
+	if list == nil {
+		return nil
+	}
+	return list.List
+}
+
+// attributeOf returns the attribute recording the param if it's an id or a
+// number, the empty string otherwise.
+func attributeOf(name, typ string) string {
+	lower := strings.ToLower(name)
+	isID := lower == "id" || strings.HasSuffix(name, "ID")
+	isIDs := lower == "ids" || strings.HasSuffix(name, "IDs")
+	isNumber := strings.HasSuffix(name, "Number")
+	key := strconv.Quote(snakeCaseOf(name))
+
+	switch {
+	case typ == "int" && (isID || isNumber):
+		return fmt.Sprintf("attribute.Int(%s, %s)", key, name)
+	case typ == "[]int" && isIDs:
+		return fmt.Sprintf("attribute.IntSlice(%s, %s)", key, name)
+	default:
+		return ""
+	}
+}
+
+// snakeCaseOf returns the snake case of the camel case name, taking the
+// initialisms ID and IDs as words
+func snakeCaseOf(name string) string {
+	name = strings.ReplaceAll(name, "IDs", "Ids")
+	name = strings.ReplaceAll(name, "ID", "Id")
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// importsOf returns the names the imports of file are referred to by
+func importsOf(file *ast.File) map[string]bool {
+	names := make(map[string]bool)
+	for _, spec := range file.Imports {
+		names[importNameOf(spec)] = true
+	}
+	return names
+}
+
+// importNameOf returns the name the import is referred to by, assuming the
+// packages are named after their paths less the major version suffix.
+func importNameOf(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+	importPath, _ := strconv.Unquote(spec.Path.Value)
+	name := path.Base(importPath)
+	if len(name) > 1 && name[0] == 'v' && isDigits(name[1:]) {
+		name = path.Base(path.Dir(importPath))
+	}
+	return name
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
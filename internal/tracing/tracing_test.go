@@ -0,0 +1,117 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aria3ppp/watch-server/internal/testutils"
+	"github.com/aria3ppp/watch-server/internal/tracing"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	require := require.New(t)
+
+	exporter := testutils.NewMemoryExporter()
+	shutdown := tracing.Setup(exporter, 1)
+	t.Cleanup(func() { tracing.Setup(nil, 1) })
+
+	// an elasticsearch request made within a failed span
+	var traceparent string
+	transport := tracing.InstrumentElasticsearch(
+		testutils.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			traceparent = req.Header.Get("traceparent")
+			return &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Status:     "503 Service Unavailable",
+				Body:       http.NoBody,
+			}, nil
+		}),
+	)
+
+	ctx, parent := tracing.Start(
+		context.Background(),
+		"app.SeriesGet",
+		attribute.Int("id", 1),
+	)
+	req := httptest.NewRequest(http.MethodGet, "/series/_search", nil).
+		WithContext(ctx)
+	_, err := transport.RoundTrip(req)
+	require.NoError(err)
+	// the request given is left as is
+	require.Empty(req.Header.Get("traceparent"))
+	tracing.End(parent, errors.New("not found"))
+
+	// the spans are exported on shutdown
+	require.NoError(shutdown(context.Background()))
+	spans := make(map[string]tracetest.SpanStub)
+	for _, span := range exporter.GetSpans() {
+		spans[span.Name] = span
+	}
+	require.Len(spans, 2)
+
+	appSpan := spans["app.SeriesGet"]
+	require.False(appSpan.Parent.IsValid())
+	require.Equal(attribute.Int64Value(1), testutils.AttributeOf(appSpan, "id"))
+	require.Equal(codes.Error, appSpan.Status.Code)
+	require.Equal("not found", appSpan.Status.Description)
+
+	esSpan := spans["elasticsearch GET"]
+	require.Equal(appSpan.SpanContext.TraceID(), esSpan.SpanContext.TraceID())
+	require.Equal(appSpan.SpanContext.SpanID(), esSpan.Parent.SpanID())
+	require.Equal(trace.SpanKindClient, esSpan.SpanKind)
+	require.Equal(
+		attribute.StringValue("/series/_search"),
+		testutils.AttributeOf(esSpan, "http.target"),
+	)
+	require.Equal(
+		attribute.Int64Value(503),
+		testutils.AttributeOf(esSpan, "http.status_code"),
+	)
+	require.Equal(codes.Error, esSpan.Status.Code)
+
+	// elasticsearch got the trace context of its span
+	require.Equal(
+		"00-"+esSpan.SpanContext.TraceID().String()+
+			"-"+esSpan.SpanContext.SpanID().String()+"-01",
+		traceparent,
+	)
+}
+
+func TestNewOTLP(t *testing.T) {
+	require := require.New(t)
+
+	// the spans are posted to the endpoint as protobuf
+	var path, contentType string
+	collector := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path = r.URL.Path
+			contentType = r.Header.Get("Content-Type")
+		}),
+	)
+	t.Cleanup(collector.Close)
+
+	exporter, err := tracing.NewOTLP(collector.URL + "/v1/traces")
+	require.NoError(err)
+	shutdown := tracing.Setup(exporter, 1)
+	t.Cleanup(func() { tracing.Setup(nil, 1) })
+
+	_, span := tracing.Start(context.Background(), "span")
+	span.End()
+	require.NoError(shutdown(context.Background()))
+	require.Equal("/v1/traces", path)
+	require.Equal("application/x-protobuf", contentType)
+
+	// invalid endpoints
+	for _, endpoint := range []string{"localhost:4318", "grpc://localhost:4317"} {
+		_, err := tracing.NewOTLP(endpoint)
+		require.Error(err, endpoint)
+	}
+}
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// elasticsearchTransport traces the round trips to Elasticsearch
+type elasticsearchTransport struct {
+	next http.RoundTripper
+}
+
+// InstrumentElasticsearch returns next recording a client span for each
+// Elasticsearch request it makes, within the trace of the request context.
+func InstrumentElasticsearch(next http.RoundTripper) http.RoundTripper {
+	return &elasticsearchTransport{next: next}
+}
+
+func (t *elasticsearchTransport) RoundTrip(
+	req *http.Request,
+) (*http.Response, error) {
+	ctx, span := Tracer().Start(
+		req.Context(),
+		"elasticsearch "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemElasticsearch,
+			semconv.HTTPMethodKey.String(req.Method),
+			semconv.HTTPTargetKey.String(req.URL.Path),
+		),
+	)
+	defer span.End()
+
+	// a round tripper must not modify the request it was given
+	req = req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return res, err
+	}
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(res.StatusCode))
+	if res.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, res.Status)
+	}
+	return res, err
+}
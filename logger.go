@@ -1,12 +1,14 @@
 package main
 
 import (
+	"context"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"time"
 
+	"github.com/aria3ppp/watch-server/internal/logging"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -44,11 +46,20 @@ func newLogger(file *os.File) *zap.Logger {
 	)
 }
 
-// esCustomLogger implements the elastictransport.Logger interface.
-type esCustomLogger struct {
-	*zap.Logger
+// jobContext returns the context of a run of a background job, carrying the
+// logger tagged with the job name for the layers the run goes through.
+func jobContext(logger *zap.Logger, job string) context.Context {
+	return logging.WithLogger(
+		context.Background(),
+		logger.With(zap.String("job", job)),
+	)
 }
 
+// esCustomLogger implements the elastictransport.Logger interface. The round
+// trips are logged through the logger of the request they're made for, the
+// global logger outside of requests.
+type esCustomLogger struct{}
+
 // LogRoundTrip prints the information about request and response.
 func (l *esCustomLogger) LogRoundTrip(
 	req *http.Request,
@@ -89,7 +100,8 @@ func (l *esCustomLogger) LogRoundTrip(
 
 	// Log event.
 	//
-	if ce := l.Check(lvl, req.URL.String()); ce != nil {
+	logger := logging.FromContext(req.Context())
+	if ce := logger.Check(lvl, req.URL.String()); ce != nil {
 		ce.Write(
 			zap.String("method", req.Method),
 			zap.Int("status_code", res.StatusCode),
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
 	"time"
@@ -10,10 +12,14 @@ import (
 	"github.com/aria3ppp/watch-server/internal/app"
 	"github.com/aria3ppp/watch-server/internal/config"
 	"github.com/aria3ppp/watch-server/internal/hasher"
+	"github.com/aria3ppp/watch-server/internal/metrics"
+	"github.com/aria3ppp/watch-server/internal/notifier"
 	"github.com/aria3ppp/watch-server/internal/repo"
 	"github.com/aria3ppp/watch-server/internal/search"
 	"github.com/aria3ppp/watch-server/internal/server"
+	"github.com/aria3ppp/watch-server/internal/storage"
 	"github.com/aria3ppp/watch-server/internal/token"
+	"github.com/aria3ppp/watch-server/internal/tracing"
 	"github.com/elastic/elastic-transport-go/v8/elastictransport"
 	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/golang-jwt/jwt/v4"
@@ -43,6 +49,33 @@ func main() {
 	}
 
 	logger := newLogger(logFile)
+	// the logger of the code running outside of requests
+	zap.ReplaceGlobals(logger)
+
+	spanExporter, err := newSpanExporter(
+		config.Config.Servic.Tracing.Exporter,
+		config.Config.Servic.Tracing.OTLP.Endpoint,
+	)
+	if err != nil {
+		logger.Panic("failed creating span exporter", zap.Error(err))
+	}
+	shutdownTracing := tracing.Setup(
+		spanExporter,
+		config.Config.Servic.Tracing.SampleRatio,
+	)
+	// export the spans not exported yet on the way out
+	defer func() {
+		ctx, cancel := context.WithTimeout(
+			context.Background(),
+			time.Second*time.Duration(
+				config.Config.Servic.Server.ShutdownTimeoutInSeconds,
+			),
+		)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Error("failed shutting down tracing", zap.Error(err))
+		}
+	}()
 
 	db, err := sql.Open("postgres", config.Config.Servic.Database.DSN)
 	if err != nil {
@@ -53,7 +86,36 @@ func main() {
 		logger.Panic("failed ping database connection", zap.Error(err))
 	}
 
-	repository := repo.NewRepository(db)
+	if err := metrics.RegisterDB(db); err != nil {
+		logger.Panic("failed registering database metrics", zap.Error(err))
+	}
+
+	repository := repo.NewTracedServiceTx(repo.NewRepository(db))
+
+	// the prune-audits subcommand only needs the repository
+	if len(os.Args) > 1 && os.Args[1] == pruneAuditsCommand {
+		err := pruneAudits(
+			app.NewApplication(repository, nil, nil, nil, nil, nil),
+			os.Stdout,
+		)
+		if err != nil {
+			logger.Fatal("failed pruning audits", zap.Error(err))
+		}
+		return
+	}
+
+	// so does the anonymize-users subcommand
+	if len(os.Args) > 1 && os.Args[1] == anonymizeUsersCommand {
+		err := anonymizeUsers(
+			app.NewApplication(repository, nil, nil, nil, nil, nil),
+			os.Stdout,
+		)
+		if err != nil {
+			logger.Fatal("failed anonymizing users", zap.Error(err))
+		}
+		return
+	}
+
 	hasher := hasher.NewBcrypt()
 
 	tokenService := token.NewJWT(
@@ -71,7 +133,7 @@ func main() {
 
 	var esLogger elastictransport.Logger
 	if config.Config.Servic.Server.Production {
-		esLogger = &esCustomLogger{logger}
+		esLogger = &esCustomLogger{}
 	} else {
 		esLogger = &elastictransport.ColorLogger{
 			Output:             os.Stdout,
@@ -81,6 +143,9 @@ func main() {
 	}
 	esClient, err := elasticsearch.NewClient(elasticsearch.Config{
 		Logger: esLogger,
+		Transport: tracing.InstrumentElasticsearch(
+			metrics.InstrumentElasticsearch(http.DefaultTransport),
+		),
 	})
 	if err != nil {
 		logger.Panic("failed creating elasticsearch client", zap.Error(err))
@@ -93,13 +158,69 @@ func main() {
 		)
 	}
 
+	storageService, err := storage.NewLocal(
+		config.Config.Servic.Storage.Local.Root,
+	)
+	if err != nil {
+		logger.Panic("failed creating local storage", zap.Error(err))
+	}
+
 	application := app.NewApplication(
 		repository,
 		tokenService,
 		searchService,
 		hasher,
+		storageService,
+		notifier.NewLog(logger),
 	)
 
-	server := server.NewServer(application, echo.New(), tokenService, logger)
+	if interval := config.Config.Audit.Retention.IntervalInMinutes; interval > 0 {
+		go runAuditsPruning(
+			application,
+			time.Minute*time.Duration(interval),
+			logger,
+		)
+	}
+
+	if interval := config.Config.Account.Deletion.IntervalInMinutes; interval > 0 {
+		go runUsersAnonymization(
+			application,
+			time.Minute*time.Duration(interval),
+			logger,
+		)
+	}
+
+	if interval := config.Config.Idempotency.IntervalInMinutes; interval > 0 {
+		go runIdempotencyKeysPruning(
+			application,
+			time.Minute*time.Duration(interval),
+			logger,
+		)
+	}
+
+	rateLimiter, err := newRateLimiter(
+		config.Config.RateLimit.Store,
+		config.Config.RateLimit.MaxBuckets,
+		db,
+	)
+	if err != nil {
+		logger.Panic("failed creating rate limiter", zap.Error(err))
+	}
+
+	if interval := config.Config.RateLimit.IntervalInMinutes; interval > 0 {
+		go runRateLimitBucketsPruning(
+			rateLimiter,
+			time.Minute*time.Duration(interval),
+			logger,
+		)
+	}
+
+	server := server.NewServer(
+		app.NewTracedService(application),
+		echo.New(),
+		tokenService,
+		rateLimiter,
+		logger,
+	)
 	server.Run(":" + strconv.Itoa(int(config.Config.Servic.Server.Port)))
 }
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP FUNCTION IF EXISTS seasons_function_triggers_on_update;
+DROP TABLE IF EXISTS seasons_audit;
+DROP TABLE IF EXISTS seasons;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,70 @@
+BEGIN;
+
+-- create seasons table
+CREATE TABLE IF NOT EXISTS seasons (
+    series_id INT NOT NULL,
+    season_number INT NOT NULL,
+
+    -- a season could be created implicitly by putting an episode
+    -- so everything except the key is nullable
+    title VARCHAR(100),
+    descriptions VARCHAR(500),
+
+    date_started DATE,
+    date_ended DATE,
+
+    PRIMARY KEY (series_id, season_number)
+
+    -- contributed_by INT NOT NULL,
+    -- contributed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+	-- invalidation VARCHAR(100)
+);
+
+call add_contribution_and_invalidation_columns(
+	p_table => 'seasons',
+	p_contributer_table => 'users',
+	p_contributer_table_pk => 'id',
+	
+	p_column_contributed_by_name => 'contributed_by',
+	p_column_contributed_by_type => 'INT NOT NULL',
+	p_column_contributed_by_fk_constraint_name => 'seasons_contributed_by_fk_users',
+	
+	p_column_contributed_at_name => 'contributed_at',
+	p_column_contributed_at_type => 'TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP',
+	
+	p_column_invalidation_name => 'invalidation',
+	p_column_invalidation_type => 'VARCHAR(100)'
+);
+
+call create_audit_table(
+	p_table => 'seasons',
+	p_audit_table_name => 'seasons_audit',
+	p_audit_table_pk_columns_order_sep_by_comma => 'series_id, season_number, contributed_by, contributed_at'
+);
+
+call build_trigger_audit_on_update(
+	p_table => 'seasons',
+	p_table_contributed_at_column => 'contributed_at',
+	p_audit_table_name => 'seasons_audit',
+	p_trigger_name => 'seasons_trigger_audit_on_update',
+	p_trigger_function_name => 'seasons_function_triggers_on_update'
+);
+
+-- create index on title
+CREATE INDEX seasons_idx_title ON seasons (title);
+
+-- add series_id foreign key constraint
+ALTER TABLE IF EXISTS seasons
+    ADD CONSTRAINT seasons_fk_serieses
+    FOREIGN KEY (series_id)
+    REFERENCES serieses(id);
+
+-- backfill seasons already referenced by episodes
+INSERT INTO seasons (series_id, season_number, contributed_by)
+SELECT series_id, season_number, MIN(contributed_by)
+FROM films
+WHERE series_id IS NOT NULL AND season_number IS NOT NULL AND episode_number IS NOT NULL
+GROUP BY series_id, season_number
+ON CONFLICT DO NOTHING;
+
+COMMIT;
\ No newline at end of file
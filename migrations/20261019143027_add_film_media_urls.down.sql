@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP FUNCTION IF EXISTS film_media_urls_function_triggers_on_update;
+DROP TABLE IF EXISTS film_media_urls_audit;
+DROP TABLE IF EXISTS film_media_urls;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,67 @@
+BEGIN;
+
+-- create film_media_urls table
+CREATE TABLE IF NOT EXISTS film_media_urls (
+    id SERIAL PRIMARY KEY,
+    film_id INT NOT NULL,
+
+    -- key of the blob on the storage
+    media_url VARCHAR(200) NOT NULL,
+    media_type VARCHAR(10) NOT NULL CHECK (media_type IN ('image', 'video')),
+    mime_type VARCHAR(100) NOT NULL,
+    size_in_bytes BIGINT NOT NULL,
+
+    -- null for videos
+    thumbnail_url VARCHAR(200)
+
+    -- contributed_by INT NOT NULL,
+    -- contributed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+	-- invalidation VARCHAR(100)
+);
+
+call add_contribution_and_invalidation_columns(
+	p_table => 'film_media_urls',
+	p_contributer_table => 'users',
+	p_contributer_table_pk => 'id',
+	
+	p_column_contributed_by_name => 'contributed_by',
+	p_column_contributed_by_type => 'INT NOT NULL',
+	p_column_contributed_by_fk_constraint_name => 'film_media_urls_contributed_by_fk_users',
+	
+	p_column_contributed_at_name => 'contributed_at',
+	p_column_contributed_at_type => 'TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP',
+	
+	p_column_invalidation_name => 'invalidation',
+	p_column_invalidation_type => 'VARCHAR(100)'
+);
+
+call create_audit_table(
+	p_table => 'film_media_urls',
+	p_audit_table_name => 'film_media_urls_audit',
+	p_audit_table_pk_columns_order_sep_by_comma => 'id, contributed_by, contributed_at'
+);
+
+call build_trigger_audit_on_update(
+	p_table => 'film_media_urls',
+	p_table_contributed_at_column => 'contributed_at',
+	p_audit_table_name => 'film_media_urls_audit',
+	p_trigger_name => 'film_media_urls_trigger_audit_on_update',
+	p_trigger_function_name => 'film_media_urls_function_triggers_on_update'
+);
+
+-- create index on film_id
+CREATE INDEX film_media_urls_idx_film_id ON film_media_urls (film_id);
+
+-- blobs are looked up by url before being removed from the storage
+CREATE INDEX film_media_urls_idx_media_url ON film_media_urls (media_url);
+CREATE INDEX film_media_urls_idx_thumbnail_url ON film_media_urls (thumbnail_url);
+CREATE INDEX film_media_urls_audit_idx_media_url ON film_media_urls_audit (media_url);
+CREATE INDEX film_media_urls_audit_idx_thumbnail_url ON film_media_urls_audit (thumbnail_url);
+
+-- add film_id foreign key constraint
+ALTER TABLE IF EXISTS film_media_urls
+    ADD CONSTRAINT film_media_urls_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id);
+
+COMMIT;
\ No newline at end of file
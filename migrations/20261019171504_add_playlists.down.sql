@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS watchlists;
+DROP TABLE IF EXISTS playlist_films;
+DROP TABLE IF EXISTS playlists;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,69 @@
+BEGIN;
+
+-- create playlists table
+CREATE TABLE IF NOT EXISTS playlists (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    descriptions VARCHAR(500),
+    is_public BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- create index on user_id
+CREATE INDEX playlists_idx_user_id ON playlists (user_id);
+
+-- add user_id foreign key constraint
+ALTER TABLE IF EXISTS playlists
+    ADD CONSTRAINT playlists_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+-- create playlist_films table
+CREATE TABLE IF NOT EXISTS playlist_films (
+    playlist_id INT NOT NULL,
+    film_id INT NOT NULL,
+    -- films are listed in ascending position order
+    position INT NOT NULL,
+    added_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (playlist_id, film_id)
+);
+
+-- add playlist_id and film_id foreign key constraints
+ALTER TABLE IF EXISTS playlist_films
+    ADD CONSTRAINT playlist_films_fk_playlists
+    FOREIGN KEY (playlist_id)
+    REFERENCES playlists(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS playlist_films
+    ADD CONSTRAINT playlist_films_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id);
+
+-- create watchlists table
+CREATE TABLE IF NOT EXISTS watchlists (
+    user_id INT NOT NULL,
+    film_id INT NOT NULL,
+    added_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- null if not watched
+    watched_at TIMESTAMPTZ,
+
+    PRIMARY KEY (user_id, film_id)
+);
+
+-- add user_id and film_id foreign key constraints
+ALTER TABLE IF EXISTS watchlists
+    ADD CONSTRAINT watchlists_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS watchlists
+    ADD CONSTRAINT watchlists_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id);
+
+COMMIT;
\ No newline at end of file
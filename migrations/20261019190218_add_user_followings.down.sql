@@ -0,0 +1,14 @@
+BEGIN;
+
+DROP INDEX IF EXISTS watchlists_idx_watched_at;
+DROP INDEX IF EXISTS film_media_urls_audit_idx_contributed_by;
+DROP INDEX IF EXISTS film_media_urls_idx_contributed_by;
+DROP INDEX IF EXISTS seasons_audit_idx_contributed_by;
+DROP INDEX IF EXISTS seasons_idx_contributed_by;
+DROP INDEX IF EXISTS films_audit_idx_contributed_by;
+DROP INDEX IF EXISTS films_idx_contributed_by;
+DROP INDEX IF EXISTS serieses_audit_idx_contributed_by;
+DROP INDEX IF EXISTS serieses_idx_contributed_by;
+DROP TABLE IF EXISTS user_followings;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,40 @@
+BEGIN;
+
+-- create user_followings table
+CREATE TABLE IF NOT EXISTS user_followings (
+    follower_id INT NOT NULL,
+    followed_id INT NOT NULL,
+    followed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (follower_id, followed_id),
+    CHECK (follower_id <> followed_id)
+);
+
+-- create index on followed_id to list followers
+CREATE INDEX user_followings_idx_followed_id ON user_followings (followed_id);
+
+-- add follower_id and followed_id foreign key constraints
+ALTER TABLE IF EXISTS user_followings
+    ADD CONSTRAINT user_followings_follower_id_fk_users
+    FOREIGN KEY (follower_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS user_followings
+    ADD CONSTRAINT user_followings_followed_id_fk_users
+    FOREIGN KEY (followed_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+-- the feed scans activities by contributor
+CREATE INDEX serieses_idx_contributed_by ON serieses (contributed_by, contributed_at);
+CREATE INDEX serieses_audit_idx_contributed_by ON serieses_audit (contributed_by, contributed_at);
+CREATE INDEX films_idx_contributed_by ON films (contributed_by, contributed_at);
+CREATE INDEX films_audit_idx_contributed_by ON films_audit (contributed_by, contributed_at);
+CREATE INDEX seasons_idx_contributed_by ON seasons (contributed_by, contributed_at);
+CREATE INDEX seasons_audit_idx_contributed_by ON seasons_audit (contributed_by, contributed_at);
+CREATE INDEX film_media_urls_idx_contributed_by ON film_media_urls (contributed_by, contributed_at);
+CREATE INDEX film_media_urls_audit_idx_contributed_by ON film_media_urls_audit (contributed_by, contributed_at);
+CREATE INDEX watchlists_idx_watched_at ON watchlists (user_id, watched_at) WHERE watched_at IS NOT NULL;
+
+COMMIT;
\ No newline at end of file
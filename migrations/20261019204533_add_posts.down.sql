@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TABLE IF EXISTS post_users;
+DROP TABLE IF EXISTS post_serieses;
+DROP TABLE IF EXISTS post_films;
+DROP TRIGGER IF EXISTS posts_trigger_revision_on_update ON posts;
+DROP FUNCTION IF EXISTS posts_function_triggers_on_update;
+DROP TABLE IF EXISTS post_revisions;
+DROP TABLE IF EXISTS posts;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,130 @@
+BEGIN;
+
+-- create posts table
+CREATE TABLE IF NOT EXISTS posts (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+
+    -- non-null for replies
+    post_id INT,
+
+    -- markdown body
+    body TEXT NOT NULL,
+    posted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- null if not edited
+    edited_at TIMESTAMPTZ
+);
+
+-- create index on user_id and post_id
+CREATE INDEX posts_idx_user_id ON posts (user_id);
+CREATE INDEX posts_idx_post_id ON posts (post_id);
+
+-- add user_id and post_id foreign key constraints
+ALTER TABLE IF EXISTS posts
+    ADD CONSTRAINT posts_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS posts
+    ADD CONSTRAINT posts_fk_posts
+    FOREIGN KEY (post_id)
+    REFERENCES posts(id)
+    ON DELETE CASCADE;
+
+-- create post_revisions table holding the previous bodies of edited posts
+CREATE TABLE IF NOT EXISTS post_revisions (
+    post_id INT NOT NULL,
+    body TEXT NOT NULL,
+    revised_at TIMESTAMPTZ NOT NULL,
+
+    PRIMARY KEY (post_id, revised_at)
+);
+
+ALTER TABLE IF EXISTS post_revisions
+    ADD CONSTRAINT post_revisions_fk_posts
+    FOREIGN KEY (post_id)
+    REFERENCES posts(id)
+    ON DELETE CASCADE;
+
+-- keep the old body and stamp the edit time on body updates
+CREATE OR REPLACE FUNCTION posts_function_triggers_on_update() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+BEGIN
+    INSERT INTO post_revisions (post_id, body, revised_at)
+        VALUES (OLD.id, OLD.body, COALESCE(OLD.edited_at, OLD.posted_at));
+    NEW.edited_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER posts_trigger_revision_on_update
+    BEFORE UPDATE OF body ON posts
+    FOR EACH ROW
+    WHEN (OLD.body IS DISTINCT FROM NEW.body)
+    EXECUTE FUNCTION posts_function_triggers_on_update();
+
+-- create post references tables
+CREATE TABLE IF NOT EXISTS post_films (
+    post_id INT NOT NULL,
+    film_id INT NOT NULL,
+
+    PRIMARY KEY (post_id, film_id)
+);
+
+CREATE INDEX post_films_idx_film_id ON post_films (film_id);
+
+ALTER TABLE IF EXISTS post_films
+    ADD CONSTRAINT post_films_fk_posts
+    FOREIGN KEY (post_id)
+    REFERENCES posts(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS post_films
+    ADD CONSTRAINT post_films_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id);
+
+CREATE TABLE IF NOT EXISTS post_serieses (
+    post_id INT NOT NULL,
+    series_id INT NOT NULL,
+
+    PRIMARY KEY (post_id, series_id)
+);
+
+CREATE INDEX post_serieses_idx_series_id ON post_serieses (series_id);
+
+ALTER TABLE IF EXISTS post_serieses
+    ADD CONSTRAINT post_serieses_fk_posts
+    FOREIGN KEY (post_id)
+    REFERENCES posts(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS post_serieses
+    ADD CONSTRAINT post_serieses_fk_serieses
+    FOREIGN KEY (series_id)
+    REFERENCES serieses(id);
+
+CREATE TABLE IF NOT EXISTS post_users (
+    post_id INT NOT NULL,
+    user_id INT NOT NULL,
+
+    PRIMARY KEY (post_id, user_id)
+);
+
+CREATE INDEX post_users_idx_user_id ON post_users (user_id);
+
+ALTER TABLE IF EXISTS post_users
+    ADD CONSTRAINT post_users_fk_posts
+    FOREIGN KEY (post_id)
+    REFERENCES posts(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS post_users
+    ADD CONSTRAINT post_users_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+COMMIT;
\ No newline at end of file
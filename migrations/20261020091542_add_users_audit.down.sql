@@ -0,0 +1,105 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS users_trigger_audit_on_update ON users;
+DROP FUNCTION IF EXISTS users_function_triggers_on_update;
+DROP TABLE IF EXISTS users_audit;
+ALTER TABLE IF EXISTS users DROP COLUMN IF EXISTS contributed_at;
+
+DROP PROCEDURE IF EXISTS create_audit_table;
+DROP PROCEDURE IF EXISTS build_trigger_audit_on_update;
+
+-- create an audit table with a primary key index on specified columns
+create or replace procedure create_audit_table(
+	p_table text,
+	p_audit_table_name text,
+	p_audit_table_pk_columns_order_sep_by_comma text
+)
+language plpgsql
+as $$
+declare
+	v_row RECORD;
+    v_CREATE_AUDIT_TABLE_BODY TEXT;
+	v_CREATE_AUDIT_TABLE_CMD TEXT;
+begin
+	perform from information_schema.tables
+	where table_name = p_table and table_type = 'BASE TABLE';
+	
+	if not found then
+		raise exception 'table name "%" not found', p_table;
+	end if;
+
+    v_CREATE_AUDIT_TABLE_BODY = '';
+	
+	for v_row in
+		select column_name, data_type, is_nullable
+		from information_schema.columns
+		where table_name = p_table
+		order by ordinal_position
+	loop
+	
+		v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || quote_ident(v_row.column_name) || ' ' || v_row.data_type;
+		
+		if v_row.is_nullable = 'NO' then
+			v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || ' NOT NULL';
+		end if;
+
+		v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || ', ';
+		
+	end loop;
+
+	-- set primary key
+	v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || 'PRIMARY KEY (' || p_audit_table_pk_columns_order_sep_by_comma || ')';
+    
+	-- build create audit table command
+	v_CREATE_AUDIT_TABLE_CMD = 'CREATE TABLE IF NOT EXISTS ' || quote_ident(p_audit_table_name) || ' (' || v_CREATE_AUDIT_TABLE_BODY || ')';
+		
+	-- create the audit table
+	execute v_CREATE_AUDIT_TABLE_CMD;
+	
+end;
+$$;
+
+-- build a trigger that audit old records on update
+create or replace procedure build_trigger_audit_on_update(
+	p_table text,
+	p_table_contributed_at_column text,
+	p_audit_table_name text,
+	p_trigger_name text,
+	p_trigger_function_name text
+)
+language plpgsql
+as $body$
+declare
+	v_trigger_func_body text;
+	v_trigger_func_cmd text;
+	v_create_trigger_on_table_cmd text;
+begin
+	-- build trigger function
+	v_trigger_func_body = 'BEGIN '
+			|| 'INSERT INTO ' || quote_ident(p_audit_table_name) || ' SELECT OLD.*; '
+			|| 'NEW.' || p_table_contributed_at_column || ' = CURRENT_TIMESTAMP; '
+			|| 'RETURN NEW; '
+			|| 'END;';
+	
+	v_trigger_func_cmd = 'CREATE OR REPLACE FUNCTION ' || p_trigger_function_name || '() RETURNS TRIGGER ' 
+						|| 'LANGUAGE plpgsql AS $$ ' || v_trigger_func_body || ' $$';
+	
+	-- raise notice 'trigger func cmd: %', v_trigger_func_cmd;
+	
+	-- create trigger function
+	execute v_trigger_func_cmd;
+	
+	-- build trigger on table
+	v_create_trigger_on_table_cmd = 'CREATE TRIGGER ' || p_trigger_name || ' '
+									|| 'BEFORE UPDATE ON ' || p_table || ' '
+									|| 'FOR EACH ROW EXECUTE FUNCTION ' || p_trigger_function_name || '()';
+									
+	-- raise notice 'create trigger cmd: %', v_create_trigger_on_table_cmd;
+	
+	-- create trigger on table
+	execute v_create_trigger_on_table_cmd;
+	
+end;
+$body$;
+
+COMMIT;
@@ -0,0 +1,130 @@
+BEGIN;
+
+-- let audit tables leave out columns not meant to be kept in history
+DROP PROCEDURE IF EXISTS create_audit_table;
+
+create or replace procedure create_audit_table(
+	p_table text,
+	p_audit_table_name text,
+	p_audit_table_pk_columns_order_sep_by_comma text,
+	p_excluded_columns text[] default '{}'
+)
+language plpgsql
+as $$
+declare
+	v_row RECORD;
+    v_CREATE_AUDIT_TABLE_BODY TEXT;
+	v_CREATE_AUDIT_TABLE_CMD TEXT;
+begin
+	perform from information_schema.tables
+	where table_name = p_table and table_type = 'BASE TABLE';
+	
+	if not found then
+		raise exception 'table name "%" not found', p_table;
+	end if;
+
+    v_CREATE_AUDIT_TABLE_BODY = '';
+	
+	for v_row in
+		select column_name, data_type, is_nullable
+		from information_schema.columns
+		where table_name = p_table and column_name <> all(p_excluded_columns)
+		order by ordinal_position
+	loop
+	
+		v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || quote_ident(v_row.column_name) || ' ' || v_row.data_type;
+		
+		if v_row.is_nullable = 'NO' then
+			v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || ' NOT NULL';
+		end if;
+
+		v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || ', ';
+		
+	end loop;
+
+	-- set primary key
+	v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || 'PRIMARY KEY (' || p_audit_table_pk_columns_order_sep_by_comma || ')';
+    
+	-- build create audit table command
+	v_CREATE_AUDIT_TABLE_CMD = 'CREATE TABLE IF NOT EXISTS ' || quote_ident(p_audit_table_name) || ' (' || v_CREATE_AUDIT_TABLE_BODY || ')';
+		
+	-- create the audit table
+	execute v_CREATE_AUDIT_TABLE_CMD;
+	
+end;
+$$;
+
+DROP PROCEDURE IF EXISTS build_trigger_audit_on_update;
+
+create or replace procedure build_trigger_audit_on_update(
+	p_table text,
+	p_table_contributed_at_column text,
+	p_audit_table_name text,
+	p_trigger_name text,
+	p_trigger_function_name text,
+	p_excluded_columns text[] default '{}'
+)
+language plpgsql
+as $body$
+declare
+	v_audit_columns text;
+	v_old_columns text;
+	v_trigger_func_body text;
+	v_trigger_func_cmd text;
+	v_create_trigger_on_table_cmd text;
+begin
+	-- list the audited columns explicitly as excluded ones break SELECT OLD.*
+	select
+		string_agg(quote_ident(column_name), ', ' order by ordinal_position),
+		string_agg('OLD.' || quote_ident(column_name), ', ' order by ordinal_position)
+	into v_audit_columns, v_old_columns
+	from information_schema.columns
+	where table_name = p_table and column_name <> all(p_excluded_columns);
+
+	-- build trigger function
+	v_trigger_func_body = 'BEGIN '
+			|| 'INSERT INTO ' || quote_ident(p_audit_table_name) || ' (' || v_audit_columns || ') '
+			|| 'SELECT ' || v_old_columns || '; '
+			|| 'NEW.' || p_table_contributed_at_column || ' = CURRENT_TIMESTAMP; '
+			|| 'RETURN NEW; '
+			|| 'END;';
+	
+	v_trigger_func_cmd = 'CREATE OR REPLACE FUNCTION ' || p_trigger_function_name || '() RETURNS TRIGGER ' 
+						|| 'LANGUAGE plpgsql AS $$ ' || v_trigger_func_body || ' $$';
+	
+	-- create trigger function
+	execute v_trigger_func_cmd;
+	
+	-- build trigger on table
+	v_create_trigger_on_table_cmd = 'CREATE TRIGGER ' || p_trigger_name || ' '
+									|| 'BEFORE UPDATE ON ' || p_table || ' '
+									|| 'FOR EACH ROW EXECUTE FUNCTION ' || p_trigger_function_name || '()';
+	
+	-- create trigger on table
+	execute v_create_trigger_on_table_cmd;
+	
+end;
+$body$;
+
+-- users have no contributor but their profile changes are audited by time
+ALTER TABLE IF EXISTS users
+	ADD COLUMN contributed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+-- password hashes are never kept in history
+call create_audit_table(
+	p_table => 'users',
+	p_audit_table_name => 'users_audit',
+	p_audit_table_pk_columns_order_sep_by_comma => 'id, contributed_at',
+	p_excluded_columns => '{hashed_password}'
+);
+
+call build_trigger_audit_on_update(
+	p_table => 'users',
+	p_table_contributed_at_column => 'contributed_at',
+	p_audit_table_name => 'users_audit',
+	p_trigger_name => 'users_trigger_audit_on_update',
+	p_trigger_function_name => 'users_function_triggers_on_update',
+	p_excluded_columns => '{hashed_password}'
+);
+
+COMMIT;
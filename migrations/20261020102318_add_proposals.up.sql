@@ -0,0 +1,54 @@
+BEGIN;
+
+-- create proposals table, edits of untrusted contributors wait here for a
+-- moderator to approve or reject them
+CREATE TABLE IF NOT EXISTS proposals (
+    id SERIAL PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('movie_update', 'episode_put')),
+
+    -- the movie of a movie update
+    film_id INT,
+    -- the episode position of an episode put, the episode might not exist yet
+    series_id INT,
+    season_number INT,
+    episode_number INT,
+
+    -- the proposed request body
+    changes JSONB NOT NULL,
+
+    proposed_by INT NOT NULL,
+    proposed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    status VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    -- null while pending
+    reviewed_by INT,
+    reviewed_at TIMESTAMPTZ
+);
+
+-- the queue is listed by status oldest first
+CREATE INDEX proposals_idx_status_proposed_at ON proposals (status, proposed_at);
+
+-- add foreign key constraints
+ALTER TABLE IF EXISTS proposals
+    ADD CONSTRAINT proposals_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id);
+
+ALTER TABLE IF EXISTS proposals
+    ADD CONSTRAINT proposals_fk_serieses
+    FOREIGN KEY (series_id)
+    REFERENCES serieses(id);
+
+ALTER TABLE IF EXISTS proposals
+    ADD CONSTRAINT proposals_proposed_by_fk_users
+    FOREIGN KEY (proposed_by)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS proposals
+    ADD CONSTRAINT proposals_reviewed_by_fk_users
+    FOREIGN KEY (reviewed_by)
+    REFERENCES users(id)
+    ON DELETE SET NULL;
+
+COMMIT;
@@ -0,0 +1,33 @@
+BEGIN;
+
+ALTER TABLE IF EXISTS proposals
+    DROP COLUMN IF EXISTS edit_summary,
+    DROP COLUMN IF EXISTS request_id,
+    DROP COLUMN IF EXISTS client_ip,
+    DROP COLUMN IF EXISTS user_agent;
+
+ALTER TABLE IF EXISTS serieses_audit
+    DROP COLUMN IF EXISTS edit_summary,
+    DROP COLUMN IF EXISTS request_id,
+    DROP COLUMN IF EXISTS client_ip,
+    DROP COLUMN IF EXISTS user_agent;
+
+ALTER TABLE IF EXISTS serieses
+    DROP COLUMN IF EXISTS edit_summary,
+    DROP COLUMN IF EXISTS request_id,
+    DROP COLUMN IF EXISTS client_ip,
+    DROP COLUMN IF EXISTS user_agent;
+
+ALTER TABLE IF EXISTS films_audit
+    DROP COLUMN IF EXISTS edit_summary,
+    DROP COLUMN IF EXISTS request_id,
+    DROP COLUMN IF EXISTS client_ip,
+    DROP COLUMN IF EXISTS user_agent;
+
+ALTER TABLE IF EXISTS films
+    DROP COLUMN IF EXISTS edit_summary,
+    DROP COLUMN IF EXISTS request_id,
+    DROP COLUMN IF EXISTS client_ip,
+    DROP COLUMN IF EXISTS user_agent;
+
+COMMIT;
@@ -0,0 +1,37 @@
+BEGIN;
+
+-- record the request each contribution was made through, the audit triggers
+-- copy OLD.* positionally so the columns are appended to each table and its
+-- audit table in the same order
+ALTER TABLE IF EXISTS films
+    ADD COLUMN edit_summary VARCHAR(200),
+    ADD COLUMN request_id VARCHAR(100),
+    ADD COLUMN client_ip VARCHAR(45),
+    ADD COLUMN user_agent VARCHAR(300);
+
+ALTER TABLE IF EXISTS films_audit
+    ADD COLUMN edit_summary VARCHAR(200),
+    ADD COLUMN request_id VARCHAR(100),
+    ADD COLUMN client_ip VARCHAR(45),
+    ADD COLUMN user_agent VARCHAR(300);
+
+ALTER TABLE IF EXISTS serieses
+    ADD COLUMN edit_summary VARCHAR(200),
+    ADD COLUMN request_id VARCHAR(100),
+    ADD COLUMN client_ip VARCHAR(45),
+    ADD COLUMN user_agent VARCHAR(300);
+
+ALTER TABLE IF EXISTS serieses_audit
+    ADD COLUMN edit_summary VARCHAR(200),
+    ADD COLUMN request_id VARCHAR(100),
+    ADD COLUMN client_ip VARCHAR(45),
+    ADD COLUMN user_agent VARCHAR(300);
+
+-- proposals keep the metadata of the proposing request until approved
+ALTER TABLE IF EXISTS proposals
+    ADD COLUMN edit_summary VARCHAR(200),
+    ADD COLUMN request_id VARCHAR(100),
+    ADD COLUMN client_ip VARCHAR(45),
+    ADD COLUMN user_agent VARCHAR(300);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS user_deletions;
+
+ALTER TABLE IF EXISTS users DROP COLUMN IF EXISTS deleted_at;
+
+COMMIT;
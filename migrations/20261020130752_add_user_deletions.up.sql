@@ -0,0 +1,27 @@
+BEGIN;
+
+-- deleted users are kept as anonymized tombstones so their contributions and
+-- audits keep referencing them, deleted_at is set once anonymized
+-- users_audit is left as is since the profile history of a deleted user is
+-- dropped anyway
+ALTER TABLE IF EXISTS users
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- create user_deletions table, deletions wait here for the grace period in
+-- which they could be cancelled
+CREATE TABLE IF NOT EXISTS user_deletions (
+    user_id INT PRIMARY KEY,
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- due deletions are scanned by request time
+CREATE INDEX user_deletions_idx_requested_at ON user_deletions (requested_at);
+
+-- add user_id foreign key constraint
+ALTER TABLE IF EXISTS user_deletions
+    ADD CONSTRAINT user_deletions_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+-- create idempotency_keys table, the first response to a request made with
+-- an Idempotency-Key header is kept here to be replayed to its retries. The
+-- keys expire so they're left out of the user relations.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    user_id INT NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    -- hash of the method, path and body of the request
+    request_hash VARCHAR(64) NOT NULL,
+    -- null while the request is in flight
+    response_status INT,
+    response_body TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, key)
+);
+
+-- expired keys are pruned by creation time
+CREATE INDEX idempotency_keys_idx_created_at ON idempotency_keys (created_at);
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+-- create rate_limit_buckets table, the token buckets throttling the requests
+-- when the rate limit store is postgres. A missing bucket is a full one so
+-- the buckets are pruned once full_at passed.
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key VARCHAR(255) PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    full_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX rate_limit_buckets_idx_full_at ON rate_limit_buckets (full_at);
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP FUNCTION IF EXISTS artist_image_urls_function_triggers_on_update;
+DROP TABLE IF EXISTS artist_image_urls_audit;
+DROP TABLE IF EXISTS artist_image_urls;
+
+DROP FUNCTION IF EXISTS artists_function_triggers_on_update;
+DROP TABLE IF EXISTS artists_audit;
+DROP TABLE IF EXISTS artists;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,108 @@
+BEGIN;
+
+-- create artists table
+CREATE TABLE IF NOT EXISTS artists (
+    id SERIAL PRIMARY KEY,
+
+    first_name VARCHAR(20) NOT NULL,
+    last_name VARCHAR(20),
+    bio VARCHAR(500),
+    birthdate DATE
+
+    -- contributed_by INT NOT NULL,
+    -- contributed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+	-- invalidation VARCHAR(100)
+);
+
+call add_contribution_and_invalidation_columns(
+	p_table => 'artists',
+	p_contributer_table => 'users',
+	p_contributer_table_pk => 'id',
+	
+	p_column_contributed_by_name => 'contributed_by',
+	p_column_contributed_by_type => 'INT NOT NULL',
+	p_column_contributed_by_fk_constraint_name => 'artists_contributed_by_fk_users',
+	
+	p_column_contributed_at_name => 'contributed_at',
+	p_column_contributed_at_type => 'TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP',
+	
+	p_column_invalidation_name => 'invalidation',
+	p_column_invalidation_type => 'VARCHAR(100)'
+);
+
+call create_audit_table(
+	p_table => 'artists',
+	p_audit_table_name => 'artists_audit',
+	p_audit_table_pk_columns_order_sep_by_comma => 'id, contributed_by, contributed_at'
+);
+
+call build_trigger_audit_on_update(
+	p_table => 'artists',
+	p_table_contributed_at_column => 'contributed_at',
+	p_audit_table_name => 'artists_audit',
+	p_trigger_name => 'artists_trigger_audit_on_update',
+	p_trigger_function_name => 'artists_function_triggers_on_update'
+);
+
+-- create artist_image_urls table
+CREATE TABLE IF NOT EXISTS artist_image_urls (
+    id SERIAL PRIMARY KEY,
+    artist_id INT NOT NULL,
+
+    -- keys of the blobs on the storage
+    image_url VARCHAR(200) NOT NULL,
+    mime_type VARCHAR(100) NOT NULL,
+    size_in_bytes BIGINT NOT NULL,
+    thumbnail_url VARCHAR(200) NOT NULL
+
+    -- contributed_by INT NOT NULL,
+    -- contributed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+	-- invalidation VARCHAR(100)
+);
+
+call add_contribution_and_invalidation_columns(
+	p_table => 'artist_image_urls',
+	p_contributer_table => 'users',
+	p_contributer_table_pk => 'id',
+	
+	p_column_contributed_by_name => 'contributed_by',
+	p_column_contributed_by_type => 'INT NOT NULL',
+	p_column_contributed_by_fk_constraint_name => 'artist_image_urls_contributed_by_fk_users',
+	
+	p_column_contributed_at_name => 'contributed_at',
+	p_column_contributed_at_type => 'TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP',
+	
+	p_column_invalidation_name => 'invalidation',
+	p_column_invalidation_type => 'VARCHAR(100)'
+);
+
+call create_audit_table(
+	p_table => 'artist_image_urls',
+	p_audit_table_name => 'artist_image_urls_audit',
+	p_audit_table_pk_columns_order_sep_by_comma => 'id, contributed_by, contributed_at'
+);
+
+call build_trigger_audit_on_update(
+	p_table => 'artist_image_urls',
+	p_table_contributed_at_column => 'contributed_at',
+	p_audit_table_name => 'artist_image_urls_audit',
+	p_trigger_name => 'artist_image_urls_trigger_audit_on_update',
+	p_trigger_function_name => 'artist_image_urls_function_triggers_on_update'
+);
+
+-- create index on artist_id
+CREATE INDEX artist_image_urls_idx_artist_id ON artist_image_urls (artist_id);
+
+-- blobs are looked up by url before being removed from the storage
+CREATE INDEX artist_image_urls_idx_image_url ON artist_image_urls (image_url);
+CREATE INDEX artist_image_urls_idx_thumbnail_url ON artist_image_urls (thumbnail_url);
+CREATE INDEX artist_image_urls_audit_idx_image_url ON artist_image_urls_audit (image_url);
+CREATE INDEX artist_image_urls_audit_idx_thumbnail_url ON artist_image_urls_audit (thumbnail_url);
+
+-- add artist_id foreign key constraint
+ALTER TABLE IF EXISTS artist_image_urls
+    ADD CONSTRAINT artist_image_urls_fk_artists
+    FOREIGN KEY (artist_id)
+    REFERENCES artists(id);
+
+COMMIT;
\ No newline at end of file
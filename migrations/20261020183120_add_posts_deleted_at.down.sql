@@ -0,0 +1,14 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS posts_trigger_revision_on_update ON posts;
+
+CREATE TRIGGER posts_trigger_revision_on_update
+    BEFORE UPDATE OF body ON posts
+    FOR EACH ROW
+    WHEN (OLD.body IS DISTINCT FROM NEW.body)
+    EXECUTE FUNCTION posts_function_triggers_on_update();
+
+ALTER TABLE IF EXISTS posts
+    DROP COLUMN IF EXISTS deleted_at;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,17 @@
+BEGIN;
+
+-- deleted posts are kept as tombstones with their body cleared so the
+-- replies keep their thread, deleted_at is set once cleared
+ALTER TABLE IF EXISTS posts
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- clearing the body of a deleted post is not an edit
+DROP TRIGGER IF EXISTS posts_trigger_revision_on_update ON posts;
+
+CREATE TRIGGER posts_trigger_revision_on_update
+    BEFORE UPDATE OF body ON posts
+    FOR EACH ROW
+    WHEN (OLD.body IS DISTINCT FROM NEW.body AND NEW.deleted_at IS NULL)
+    EXECUTE FUNCTION posts_function_triggers_on_update();
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,13 @@
+BEGIN;
+
+DO $$
+BEGIN
+	execute replace(
+		pg_get_functiondef('users_function_triggers_on_update'::regproc),
+		'clock_timestamp()',
+		'CURRENT_TIMESTAMP'
+	);
+END;
+$$;
+
+COMMIT;
\ No newline at end of file
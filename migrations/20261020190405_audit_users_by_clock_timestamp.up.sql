@@ -0,0 +1,16 @@
+BEGIN;
+
+-- CURRENT_TIMESTAMP is fixed for a whole transaction, so a second update of a
+-- user in the same transaction audited the profile under a contributed_at
+-- already taken. clock_timestamp() moves on with every update.
+DO $$
+BEGIN
+	execute replace(
+		pg_get_functiondef('users_function_triggers_on_update'::regproc),
+		'CURRENT_TIMESTAMP',
+		'clock_timestamp()'
+	);
+END;
+$$;
+
+COMMIT;
\ No newline at end of file
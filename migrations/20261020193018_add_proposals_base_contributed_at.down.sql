@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE IF EXISTS proposals
+    DROP COLUMN IF EXISTS base_contributed_at;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,9 @@
+BEGIN;
+
+-- the version of the proposed on record, told by its contribution time, an
+-- approval applies only while the record is still at it. null for an episode
+-- put proposing a new episode.
+ALTER TABLE IF EXISTS proposals
+    ADD COLUMN base_contributed_at TIMESTAMPTZ;
+
+COMMIT;
\ No newline at end of file
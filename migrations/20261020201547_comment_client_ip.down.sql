@@ -0,0 +1,9 @@
+BEGIN;
+
+COMMENT ON COLUMN proposals.client_ip IS NULL;
+COMMENT ON COLUMN serieses_audit.client_ip IS NULL;
+COMMENT ON COLUMN serieses.client_ip IS NULL;
+COMMENT ON COLUMN films_audit.client_ip IS NULL;
+COMMENT ON COLUMN films.client_ip IS NULL;
+
+COMMIT;
\ No newline at end of file
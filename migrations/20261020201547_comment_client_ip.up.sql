@@ -0,0 +1,11 @@
+BEGIN;
+
+-- document how the recorded client ips are told, they're only as trustworthy
+-- as the proxies configured as trusted
+COMMENT ON COLUMN films.client_ip IS 'peer address of the contributing request, or its X-Forwarded-For entry appended by the nearest untrusted hop when behind the configured trusted proxies';
+COMMENT ON COLUMN films_audit.client_ip IS 'peer address of the contributing request, or its X-Forwarded-For entry appended by the nearest untrusted hop when behind the configured trusted proxies';
+COMMENT ON COLUMN serieses.client_ip IS 'peer address of the contributing request, or its X-Forwarded-For entry appended by the nearest untrusted hop when behind the configured trusted proxies';
+COMMENT ON COLUMN serieses_audit.client_ip IS 'peer address of the contributing request, or its X-Forwarded-For entry appended by the nearest untrusted hop when behind the configured trusted proxies';
+COMMENT ON COLUMN proposals.client_ip IS 'peer address of the proposing request, or its X-Forwarded-For entry appended by the nearest untrusted hop when behind the configured trusted proxies';
+
+COMMIT;
\ No newline at end of file
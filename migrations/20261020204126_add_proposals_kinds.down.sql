@@ -0,0 +1,16 @@
+BEGIN;
+
+DELETE FROM proposals
+    WHERE kind NOT IN ('movie_update', 'episode_put');
+
+ALTER TABLE IF EXISTS proposals
+    DROP COLUMN IF EXISTS revert_contributed_at;
+
+ALTER TABLE IF EXISTS proposals
+    DROP CONSTRAINT IF EXISTS proposals_kind_check;
+
+ALTER TABLE IF EXISTS proposals
+    ADD CONSTRAINT proposals_kind_check
+    CHECK (kind IN ('movie_update', 'episode_put'));
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,24 @@
+BEGIN;
+
+-- the episode updates, season episode puts and reverts of untrusted
+-- contributors are proposed too
+ALTER TABLE IF EXISTS proposals
+    DROP CONSTRAINT IF EXISTS proposals_kind_check;
+
+ALTER TABLE IF EXISTS proposals
+    ADD CONSTRAINT proposals_kind_check
+    CHECK (kind IN (
+        'movie_update',
+        'episode_put',
+        'episode_update',
+        'season_episodes_put',
+        'movie_revert',
+        'episode_revert'
+    ));
+
+-- the contribution time of the audited revision a revert restores, null for
+-- the other kinds
+ALTER TABLE IF EXISTS proposals
+    ADD COLUMN revert_contributed_at TIMESTAMPTZ;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS proposals_idx_proposed_by_status;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,6 @@
+BEGIN;
+
+-- the trust of a contributor is told by their approved proposals
+CREATE INDEX proposals_idx_proposed_by_status ON proposals (proposed_by, status);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE IF EXISTS idempotency_keys
+    DROP COLUMN IF EXISTS reserved_at;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,8 @@
+BEGIN;
+
+-- the lease of the request a key is reserved for, a key still in flight past
+-- it is taken over by a retry as its request is taken to have died
+ALTER TABLE IF EXISTS idempotency_keys
+    ADD COLUMN reserved_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS post_artists;
+
+COMMIT;
\ No newline at end of file
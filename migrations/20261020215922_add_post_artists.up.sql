@@ -0,0 +1,24 @@
+BEGIN;
+
+-- create post_artists table referencing the artists of a post
+CREATE TABLE IF NOT EXISTS post_artists (
+    post_id INT NOT NULL,
+    artist_id INT NOT NULL,
+
+    PRIMARY KEY (post_id, artist_id)
+);
+
+CREATE INDEX post_artists_idx_artist_id ON post_artists (artist_id);
+
+ALTER TABLE IF EXISTS post_artists
+    ADD CONSTRAINT post_artists_fk_posts
+    FOREIGN KEY (post_id)
+    REFERENCES posts(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS post_artists
+    ADD CONSTRAINT post_artists_fk_artists
+    FOREIGN KEY (artist_id)
+    REFERENCES artists(id);
+
+COMMIT;
\ No newline at end of file
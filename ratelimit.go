@@ -0,0 +1,53 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/logging"
+	"github.com/aria3ppp/watch-server/internal/ratelimit"
+	"go.uber.org/zap"
+)
+
+// newRateLimiter returns the rate limit store named by store
+func newRateLimiter(
+	store string,
+	maxBuckets int,
+	db *sql.DB,
+) (ratelimit.Service, error) {
+	switch store {
+	case "memory":
+		return ratelimit.NewMemory(maxBuckets), nil
+	case "postgres":
+		return ratelimit.NewPostgres(db), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit store %q", store)
+	}
+}
+
+// runRateLimitBucketsPruning deletes the refilled rate limit buckets every
+// interval for as long as the process runs.
+func runRateLimitBucketsPruning(
+	rateLimiter ratelimit.Service,
+	interval time.Duration,
+	logger *zap.Logger,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := jobContext(logger, "rate_limit_buckets_pruning")
+		pruned, err := rateLimiter.Prune(ctx)
+		if err != nil {
+			logging.FromContext(ctx).Error(
+				"rate limit buckets pruning failed",
+				zap.Error(err),
+			)
+			continue
+		}
+		logging.FromContext(ctx).Info(
+			"rate limit buckets pruned",
+			zap.Int("count", pruned),
+		)
+	}
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/aria3ppp/watch-server/internal/tracing"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// newSpanExporter returns the span exporter named by exporter, nil if the
+// spans are not exported
+func newSpanExporter(
+	exporter string,
+	otlpEndpoint string,
+) (sdktrace.SpanExporter, error) {
+	switch exporter {
+	case "none":
+		return nil, nil
+	case "otlp":
+		if otlpEndpoint == "" {
+			return nil, fmt.Errorf("otlp span exporter endpoint not set")
+		}
+		return tracing.NewOTLP(otlpEndpoint)
+	default:
+		return nil, fmt.Errorf("unknown span exporter %q", exporter)
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aria3ppp/watch-server/internal/app"
+	"github.com/aria3ppp/watch-server/internal/logging"
+	"go.uber.org/zap"
+)
+
+const anonymizeUsersCommand = "anonymize-users"
+
+// anonymizeUsers anonymizes the users due once and writes a report to w.
+func anonymizeUsers(application app.Service, w io.Writer) error {
+	anonymized, err := application.UsersAnonymize(context.Background())
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "%d users anonymized\n", anonymized)
+	return nil
+}
+
+// runUsersAnonymization anonymizes the users due every interval for as long
+// as the process runs.
+func runUsersAnonymization(
+	application app.Service,
+	interval time.Duration,
+	logger *zap.Logger,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := jobContext(logger, "users_anonymization")
+		anonymized, err := application.UsersAnonymize(ctx)
+		if err != nil {
+			logging.FromContext(ctx).Error(
+				"users anonymization failed",
+				zap.Error(err),
+			)
+			continue
+		}
+		logging.FromContext(ctx).Info(
+			"users anonymized",
+			zap.Int("count", anonymized),
+		)
+	}
+}